@@ -0,0 +1,260 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"go.infratographer.com/x/gidx"
+	"go.infratographer.com/x/oauth2x"
+	"go.infratographer.com/x/viperx"
+	"gopkg.in/yaml.v3"
+
+	"go.infratographer.com/load-balancer-api/internal/config"
+	"go.infratographer.com/load-balancer-api/internal/graphclient"
+)
+
+const (
+	defaultAPIEndpoint = "http://localhost:7608/query"
+
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+// clientFlags are shared by every client command tree so the flags are only
+// bound to viper once.
+var clientFlags = pflag.NewFlagSet("client", pflag.ExitOnError)
+
+func init() {
+	clientFlags.String("api-endpoint", defaultAPIEndpoint, "graphql endpoint of the load balancer api")
+	viperx.MustBindFlag(viper.GetViper(), "client.endpoint", clientFlags.Lookup("api-endpoint"))
+
+	clientFlags.StringP("output", "o", outputTable, "output format (table, json, yaml)")
+	viperx.MustBindFlag(viper.GetViper(), "client.output", clientFlags.Lookup("output"))
+
+	clientFlags.String("oidc-client-id", "", "oidc client identifier")
+	clientFlags.String("oidc-client-secret", "", "oidc client secret")
+	clientFlags.String("oidc-client-issuer", "", "oidc issuer")
+}
+
+// registerClientCommand adds the shared client flags to cmd and registers it
+// with the root command.
+func registerClientCommand(cmd *cobra.Command) {
+	cmd.PersistentFlags().AddFlagSet(clientFlags)
+	cmd.PersistentPreRun = func(cmd *cobra.Command, _ []string) {
+		// arguments and flags are valid at this point, errors returned from
+		// the api should not print the usage
+		cmd.SilenceUsage = true
+
+		bindClientOIDCFlags()
+	}
+
+	rootCmd.AddCommand(cmd)
+}
+
+// bindClientOIDCFlags binds the oidc client flags to viper. The serve command
+// binds the same keys to its own flags, so the binding happens once a client
+// command runs and the app config is reloaded afterwards.
+func bindClientOIDCFlags() {
+	viperx.MustBindFlag(viper.GetViper(), "oidc.client.id", clientFlags.Lookup("oidc-client-id"))
+	viperx.MustBindFlag(viper.GetViper(), "oidc.client.secret", clientFlags.Lookup("oidc-client-secret"))
+	viperx.MustBindFlag(viper.GetViper(), "oidc.client.issuer", clientFlags.Lookup("oidc-client-issuer"))
+
+	setupAppConfig()
+}
+
+// newGraphClient returns a client for the configured api endpoint. When an
+// oidc issuer is configured requests are authenticated using client credentials.
+func newGraphClient(ctx context.Context) (graphclient.GraphClient, error) {
+	httpClient := http.DefaultClient
+
+	if config.AppConfig.OIDCClient.Config.Issuer != "" {
+		oidcTS, err := oauth2x.NewClientCredentialsTokenSrc(ctx, config.AppConfig.OIDCClient.Config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create oauth2 token source: %w", err)
+		}
+
+		httpClient = oauth2x.NewClient(ctx, oidcTS)
+	}
+
+	return graphclient.NewClient(httpClient, viper.GetString("client.endpoint")), nil
+}
+
+// pageInfo is the page information of a connection.
+type pageInfo struct {
+	HasNextPage bool
+	EndCursor   *string
+}
+
+// listPages calls fetch with the cursor of each page of a connection, starting
+// at the first page, until the connection has no further pages.
+func listPages(fetch func(after *string) (pageInfo, error)) error {
+	var after *string
+
+	for {
+		page, err := fetch(after)
+		if err != nil {
+			return err
+		}
+
+		if !page.HasNextPage || page.EndCursor == nil {
+			return nil
+		}
+
+		after = page.EndCursor
+	}
+}
+
+// table is the tabular representation of a command result.
+type table struct {
+	header []string
+	rows   [][]string
+}
+
+// printOutput writes v to the command output in the requested format. The table
+// is used for the default table format.
+func printOutput(cmd *cobra.Command, v any, tbl table) error {
+	return writeOutput(cmd.OutOrStdout(), viper.GetString("client.output"), v, tbl)
+}
+
+func writeOutput(w io.Writer, format string, v any, tbl table) error {
+	switch format {
+	case outputTable:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0) //nolint:gomnd // padding between columns
+
+		fmt.Fprintln(tw, strings.Join(tbl.header, "\t"))
+
+		for _, row := range tbl.rows {
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+
+		return tw.Flush()
+	case outputJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(v)
+	case outputYAML:
+		// round trip through json so the yaml output uses the same field names
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+
+		var out any
+		if err := json.Unmarshal(raw, &out); err != nil {
+			return err
+		}
+
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2) //nolint:gomnd // yaml indentation
+
+		if err := enc.Encode(out); err != nil {
+			return err
+		}
+
+		return enc.Close()
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedOutput, format)
+	}
+}
+
+// parseIDArg parses the id passed as the first command argument.
+func parseIDArg(args []string) (gidx.PrefixedID, error) {
+	return parseIDValue("id", args[0])
+}
+
+// parseIDFlag parses the id passed to the named flag.
+func parseIDFlag(cmd *cobra.Command, name string) (gidx.PrefixedID, error) {
+	value, err := cmd.Flags().GetString(name)
+	if err != nil {
+		return "", err
+	}
+
+	return parseIDValue(name, value)
+}
+
+// parseIDsFlag parses the ids passed to the named string slice flag.
+func parseIDsFlag(cmd *cobra.Command, name string) ([]gidx.PrefixedID, error) {
+	values, err := cmd.Flags().GetStringSlice(name)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]gidx.PrefixedID, 0, len(values))
+
+	for _, value := range values {
+		id, err := parseIDValue(name, value)
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func parseIDValue(name, value string) (gidx.PrefixedID, error) {
+	id, err := gidx.Parse(value)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s: %s", ErrInvalidID, name, err)
+	}
+
+	return id, nil
+}
+
+// addExpectedVersionFlag adds the expected-version flag to update and delete
+// commands, which makes the change fail when the resource was changed since.
+func addExpectedVersionFlag(cmds ...*cobra.Command) {
+	for _, cmd := range cmds {
+		cmd.Flags().Int64("expected-version", 0, "only apply the change when the resource is at this version")
+	}
+}
+
+// changedString returns a pointer to the flag value when the flag was set.
+func changedString(cmd *cobra.Command, name string) *string {
+	if !cmd.Flags().Changed(name) {
+		return nil
+	}
+
+	value, _ := cmd.Flags().GetString(name)
+
+	return &value
+}
+
+// changedInt64 returns a pointer to the flag value when the flag was set.
+func changedInt64(cmd *cobra.Command, name string) *int64 {
+	if !cmd.Flags().Changed(name) {
+		return nil
+	}
+
+	value, _ := cmd.Flags().GetInt64(name)
+
+	return &value
+}
+
+// changedBool returns a pointer to the flag value when the flag was set.
+func changedBool(cmd *cobra.Command, name string) *bool {
+	if !cmd.Flags().Changed(name) {
+		return nil
+	}
+
+	value, _ := cmd.Flags().GetBool(name)
+
+	return &value
+}
+
+// formatTime formats timestamps for table output.
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
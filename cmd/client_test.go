@@ -0,0 +1,263 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.infratographer.com/x/gidx"
+)
+
+// graphRequest is the request body sent by the graph client.
+type graphRequest struct {
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// pageServer answers every list request with the next page of a connection.
+// Each page holds the given edges, and the cursor of a page is its index.
+type pageServer struct {
+	t       *testing.T
+	wrap    func(connection string) string
+	pages   []string
+	cursors []any
+}
+
+func (s *pageServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req graphRequest
+
+	require.NoError(s.t, json.NewDecoder(r.Body).Decode(&req))
+
+	after := req.Variables["after"]
+	s.cursors = append(s.cursors, after)
+
+	page := 0
+
+	if after != nil {
+		_, err := fmt.Sscanf(after.(string), "cursor-%d", &page)
+		require.NoError(s.t, err)
+
+		page++
+	}
+
+	require.Less(s.t, page, len(s.pages), "requested a page past the last page")
+
+	connection := fmt.Sprintf(`{"pageInfo":{"hasNextPage":%t,"endCursor":"cursor-%d"},"edges":%s}`,
+		page < len(s.pages)-1, page, s.pages[page])
+
+	fmt.Fprintf(w, `{"data":%s}`, s.wrap(connection))
+}
+
+// runClientCommand runs the command given by args against the api at endpoint
+// and returns its output.
+func runClientCommand(t *testing.T, endpoint string, args ...string) (string, error) {
+	out := new(bytes.Buffer)
+
+	rootCmd.SetOut(out)
+	rootCmd.SetErr(new(bytes.Buffer))
+	rootCmd.SetArgs(append(args, "--api-endpoint", endpoint))
+
+	t.Cleanup(func() {
+		rootCmd.SetOut(nil)
+		rootCmd.SetErr(nil)
+		rootCmd.SetArgs(nil)
+	})
+
+	err := rootCmd.ExecuteContext(context.Background())
+
+	return out.String(), err
+}
+
+func nameEdges(ids ...gidx.PrefixedID) string {
+	edges := make([]string, 0, len(ids))
+
+	for i, id := range ids {
+		edges = append(edges, fmt.Sprintf(`{"node":{"id":%q,"name":"node-%d"}}`, id, i))
+	}
+
+	return "[" + strings.Join(edges, ",") + "]"
+}
+
+func TestListCommandsFetchEveryPage(t *testing.T) {
+	ownerID := gidx.MustNewID("testtnt")
+	lbID := gidx.MustNewID("loadbal")
+	poolID := gidx.MustNewID("loadpol")
+
+	lbs := []gidx.PrefixedID{gidx.MustNewID("loadbal"), gidx.MustNewID("loadbal"), gidx.MustNewID("loadbal")}
+	providers := []gidx.PrefixedID{gidx.MustNewID("loadpvd"), gidx.MustNewID("loadpvd")}
+	pools := []gidx.PrefixedID{gidx.MustNewID("loadpol"), gidx.MustNewID("loadpol")}
+	ports := []gidx.PrefixedID{gidx.MustNewID("loadprt"), gidx.MustNewID("loadprt")}
+	origins := []gidx.PrefixedID{gidx.MustNewID("loadogn"), gidx.MustNewID("loadogn")}
+
+	entities := func(field string) func(string) string {
+		return func(connection string) string {
+			return fmt.Sprintf(`{"_entities":[{%q:%s}]}`, field, connection)
+		}
+	}
+
+	testCases := []struct {
+		name  string
+		args  []string
+		wrap  func(string) string
+		pages []string
+		rows  []string
+	}{
+		{
+			name:  "load balancers",
+			args:  []string{"lb", "list", "--owner", ownerID.String()},
+			wrap:  entities("loadBalancers"),
+			pages: []string{nameEdges(lbs[0], lbs[1]), nameEdges(lbs[2])},
+			rows:  []string{lbs[0].String(), lbs[1].String(), lbs[2].String()},
+		},
+		{
+			name:  "providers",
+			args:  []string{"provider", "list", "--owner", ownerID.String()},
+			wrap:  entities("loadBalancersProviders"),
+			pages: []string{nameEdges(providers[0]), nameEdges(providers[1])},
+			rows:  []string{providers[0].String(), providers[1].String()},
+		},
+		{
+			name: "pools",
+			args: []string{"pool", "list", "--owner", ownerID.String()},
+			wrap: entities("loadBalancerPools"),
+			pages: []string{
+				fmt.Sprintf(`[{"node":{"id":%q,"name":"pool-a","protocol":"tcp","ownerID":%q}}]`, pools[0], ownerID),
+				fmt.Sprintf(`[{"node":{"id":%q,"name":"pool-b","protocol":"udp","ownerID":%q}}]`, pools[1], ownerID),
+			},
+			rows: []string{pools[0].String() + "  pool-a  tcp", pools[1].String() + "  pool-b  udp"},
+		},
+		{
+			name: "ports",
+			args: []string{"port", "list", "--lb", lbID.String()},
+			wrap: func(connection string) string {
+				return fmt.Sprintf(`{"loadBalancer":{"ports":%s}}`, connection)
+			},
+			pages: []string{
+				fmt.Sprintf(`[{"node":{"id":%q,"number":80,"name":"http"}}]`, ports[0]),
+				fmt.Sprintf(`[{"node":{"id":%q,"number":443,"name":"https"}}]`, ports[1]),
+			},
+			rows: []string{ports[0].String() + "  80      http", ports[1].String() + "  443     https"},
+		},
+		{
+			name: "origins",
+			args: []string{"origin", "list", "--pool", poolID.String()},
+			wrap: func(connection string) string {
+				return fmt.Sprintf(`{"loadBalancerPool":{"origins":%s}}`, connection)
+			},
+			pages: []string{
+				fmt.Sprintf(`[{"node":{"id":%q,"name":"a","target":"1.2.3.4","portNumber":80,"weight":100,"active":true}}]`, origins[0]),
+				fmt.Sprintf(`[{"node":{"id":%q,"name":"b","target":"1.2.3.5","portNumber":80,"weight":50,"active":false}}]`, origins[1]),
+			},
+			rows: []string{origins[0].String(), origins[1].String()},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := &pageServer{t: t, wrap: tt.wrap, pages: tt.pages}
+			ts := httptest.NewServer(srv)
+			defer ts.Close()
+
+			out, err := runClientCommand(t, ts.URL, append(tt.args, "--output", "table")...)
+			require.NoError(t, err)
+
+			// one request per page, each continuing from the previous page
+			expectedCursors := []any{nil}
+			for i := 0; i < len(tt.pages)-1; i++ {
+				expectedCursors = append(expectedCursors, fmt.Sprintf("cursor-%d", i))
+			}
+
+			assert.Equal(t, expectedCursors, srv.cursors)
+
+			lines := strings.Split(strings.TrimSpace(out), "\n")
+			require.Len(t, lines, len(tt.rows)+1)
+
+			for i, row := range tt.rows {
+				assert.Contains(t, lines[i+1], row)
+			}
+		})
+	}
+}
+
+func TestListCommandJSONOutput(t *testing.T) {
+	ownerID := gidx.MustNewID("testtnt")
+	lbs := []gidx.PrefixedID{gidx.MustNewID("loadbal"), gidx.MustNewID("loadbal")}
+
+	srv := &pageServer{
+		t: t,
+		wrap: func(connection string) string {
+			return fmt.Sprintf(`{"_entities":[{"loadBalancers":%s}]}`, connection)
+		},
+		pages: []string{nameEdges(lbs[0]), nameEdges(lbs[1])},
+	}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	out, err := runClientCommand(t, ts.URL, "lb", "list", "--owner", ownerID.String(), "--output", "json")
+	require.NoError(t, err)
+
+	var nodes []struct {
+		ID   gidx.PrefixedID `json:"id"`
+		Name string          `json:"name"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(out), &nodes))
+	require.Len(t, nodes, 2)
+	assert.Equal(t, lbs[0], nodes[0].ID)
+	assert.Equal(t, lbs[1], nodes[1].ID)
+}
+
+func TestListCommandError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"errors":[{"message":"permission denied"}],"data":null}`)
+	}))
+	defer ts.Close()
+
+	_, err := runClientCommand(t, ts.URL, "lb", "list", "--owner", gidx.MustNewID("testtnt").String(), "--output", "table")
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "permission denied")
+}
+
+func TestClientCommandInvalidID(t *testing.T) {
+	_, err := runClientCommand(t, "http://localhost:0/query", "lb", "list", "--owner", "not-an-id", "--output", "table")
+	require.ErrorIs(t, err, ErrInvalidID)
+}
+
+func TestWriteOutput(t *testing.T) {
+	v := map[string]string{"id": "loadbal-test"}
+	tbl := table{header: []string{"ID", "NAME"}, rows: [][]string{{"loadbal-test", "lb"}}}
+
+	testCases := []struct {
+		format   string
+		expected string
+		err      error
+	}{
+		{format: outputTable, expected: "ID            NAME\nloadbal-test  lb\n"},
+		{format: outputJSON, expected: "{\n  \"id\": \"loadbal-test\"\n}\n"},
+		{format: outputYAML, expected: "id: loadbal-test\n"},
+		{format: "xml", err: ErrUnsupportedOutput},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.format, func(t *testing.T) {
+			out := new(bytes.Buffer)
+
+			err := writeOutput(out, tt.format, v, tbl)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, out.String())
+		})
+	}
+}
@@ -5,4 +5,13 @@ import "errors"
 var (
 	// ErrAuditFilePathRequired is returned when a audit file path is missing
 	ErrAuditFilePathRequired = errors.New("audit file path is required and cannot be empty")
+
+	// ErrUnsupportedOutput is returned when an unknown output format is requested
+	ErrUnsupportedOutput = errors.New("unsupported output format")
+
+	// ErrInvalidID is returned when an id argument or flag is not a valid id
+	ErrInvalidID = errors.New("invalid id")
+
+	// ErrInvalidProtocol is returned when an unknown pool protocol is provided
+	ErrInvalidProtocol = errors.New("invalid pool protocol")
 )
@@ -0,0 +1,229 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"go.infratographer.com/load-balancer-api/internal/graphclient"
+)
+
+var lbCmd = &cobra.Command{
+	Use:     "lb",
+	Aliases: []string{"loadbalancer"},
+	Short:   "Manage load balancers",
+}
+
+var lbListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the load balancers of an owner",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		ownerID, err := parseIDFlag(cmd, "owner")
+		if err != nil {
+			return err
+		}
+
+		includeDeleted, _ := cmd.Flags().GetBool("include-deleted")
+
+		client, err := newGraphClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		tbl := table{header: []string{"ID", "NAME"}}
+		lbs := []any{}
+
+		err = listPages(func(after *string) (pageInfo, error) {
+			resp, err := client.GetOwnerLoadBalancers(cmd.Context(), ownerID, nil, &includeDeleted, after)
+			if err != nil {
+				return pageInfo{}, err
+			}
+
+			var page pageInfo
+
+			for _, owner := range resp.Entities {
+				for _, edge := range owner.LoadBalancers.Edges {
+					tbl.rows = append(tbl.rows, []string{edge.Node.ID.String(), edge.Node.Name})
+					lbs = append(lbs, edge.Node)
+				}
+
+				page = pageInfo(owner.LoadBalancers.PageInfo)
+			}
+
+			return page, nil
+		})
+		if err != nil {
+			return err
+		}
+
+		return printOutput(cmd, lbs, tbl)
+	},
+}
+
+var lbGetCmd = &cobra.Command{
+	Use:   "get <id>",
+	Short: "Get a load balancer",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseIDArg(args)
+		if err != nil {
+			return err
+		}
+
+		client, err := newGraphClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.GetLoadBalancer(cmd.Context(), id)
+		if err != nil {
+			return err
+		}
+
+		lb := resp.LoadBalancer
+
+		return printOutput(cmd, lb, table{
+			header: []string{"ID", "NAME", "OWNER", "LOCATION", "PROVIDER", "CREATED", "UPDATED"},
+			rows: [][]string{{
+				lb.ID.String(),
+				lb.Name,
+				lb.Owner.ID.String(),
+				lb.Location.ID.String(),
+				lb.LoadBalancerProvider.ID.String(),
+				formatTime(lb.CreatedAt),
+				formatTime(lb.UpdatedAt),
+			}},
+		})
+	},
+}
+
+var lbCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a load balancer",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		input := graphclient.CreateLoadBalancerInput{}
+
+		input.Name, _ = cmd.Flags().GetString("name")
+
+		var err error
+
+		if input.OwnerID, err = parseIDFlag(cmd, "owner"); err != nil {
+			return err
+		}
+
+		if input.LocationID, err = parseIDFlag(cmd, "location"); err != nil {
+			return err
+		}
+
+		if input.ProviderID, err = parseIDFlag(cmd, "provider"); err != nil {
+			return err
+		}
+
+		client, err := newGraphClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.LoadBalancerCreate(cmd.Context(), input)
+		if err != nil {
+			return err
+		}
+
+		lb := resp.LoadBalancerCreate.LoadBalancer
+
+		return printOutput(cmd, lb, table{
+			header: []string{"ID", "NAME", "OWNER", "LOCATION", "PROVIDER", "CREATED"},
+			rows: [][]string{{
+				lb.ID.String(),
+				lb.Name,
+				lb.Owner.ID.String(),
+				lb.Location.ID.String(),
+				lb.LoadBalancerProvider.ID.String(),
+				formatTime(lb.CreatedAt),
+			}},
+		})
+	},
+}
+
+var lbUpdateCmd = &cobra.Command{
+	Use:   "update <id>",
+	Short: "Update a load balancer",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseIDArg(args)
+		if err != nil {
+			return err
+		}
+
+		input := graphclient.UpdateLoadBalancerInput{
+			Name: changedString(cmd, "name"),
+		}
+
+		client, err := newGraphClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.LoadBalancerUpdate(cmd.Context(), id, input, changedInt64(cmd, "expected-version"))
+		if err != nil {
+			return err
+		}
+
+		lb := resp.LoadBalancerUpdate.LoadBalancer
+
+		return printOutput(cmd, lb, table{
+			header: []string{"ID", "NAME", "UPDATED"},
+			rows:   [][]string{{lb.ID.String(), lb.Name, formatTime(lb.UpdatedAt)}},
+		})
+	},
+}
+
+var lbDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Delete a load balancer",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseIDArg(args)
+		if err != nil {
+			return err
+		}
+
+		client, err := newGraphClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.LoadBalancerDelete(cmd.Context(), id, changedInt64(cmd, "expected-version"))
+		if err != nil {
+			return err
+		}
+
+		return printOutput(cmd, resp.LoadBalancerDelete, table{
+			header: []string{"DELETED ID"},
+			rows:   [][]string{{resp.LoadBalancerDelete.DeletedID.String()}},
+		})
+	},
+}
+
+func init() {
+	registerClientCommand(lbCmd)
+
+	lbCmd.AddCommand(lbListCmd, lbGetCmd, lbCreateCmd, lbUpdateCmd, lbDeleteCmd)
+
+	lbListCmd.Flags().String("owner", "", "id of the owner to list load balancers for")
+	lbListCmd.Flags().Bool("include-deleted", false, "include soft deleted load balancers")
+	cobra.CheckErr(lbListCmd.MarkFlagRequired("owner"))
+
+	lbCreateCmd.Flags().String("name", "", "name of the load balancer")
+	lbCreateCmd.Flags().String("owner", "", "id of the owner of the load balancer")
+	lbCreateCmd.Flags().String("location", "", "id of the location of the load balancer")
+	lbCreateCmd.Flags().String("provider", "", "id of the load balancer provider")
+
+	for _, name := range []string{"name", "owner", "location", "provider"} {
+		cobra.CheckErr(lbCreateCmd.MarkFlagRequired(name))
+	}
+
+	lbUpdateCmd.Flags().String("name", "", "new name of the load balancer")
+
+	addExpectedVersionFlag(lbUpdateCmd, lbDeleteCmd)
+}
@@ -0,0 +1,261 @@
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"go.infratographer.com/load-balancer-api/internal/graphclient"
+)
+
+var originCmd = &cobra.Command{
+	Use:   "origin",
+	Short: "Manage load balancer pool origins",
+}
+
+var originListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the origins of a pool",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		poolID, err := parseIDFlag(cmd, "pool")
+		if err != nil {
+			return err
+		}
+
+		includeDeleted, _ := cmd.Flags().GetBool("include-deleted")
+
+		client, err := newGraphClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		tbl := table{header: []string{"ID", "NAME", "TARGET", "PORT", "WEIGHT", "ACTIVE"}}
+		origins := []any{}
+
+		err = listPages(func(after *string) (pageInfo, error) {
+			resp, err := client.GetLoadBalancerPoolOrigins(cmd.Context(), poolID, &includeDeleted, after)
+			if err != nil {
+				return pageInfo{}, err
+			}
+
+			for _, edge := range resp.LoadBalancerPool.Origins.Edges {
+				o := edge.Node
+				tbl.rows = append(tbl.rows, []string{
+					o.ID.String(),
+					o.Name,
+					o.Target,
+					strconv.FormatInt(o.PortNumber, 10),
+					strconv.FormatInt(o.Weight, 10),
+					strconv.FormatBool(o.Active),
+				})
+				origins = append(origins, o)
+			}
+
+			return pageInfo(resp.LoadBalancerPool.Origins.PageInfo), nil
+		})
+		if err != nil {
+			return err
+		}
+
+		return printOutput(cmd, origins, tbl)
+	},
+}
+
+var originGetCmd = &cobra.Command{
+	Use:   "get <id>",
+	Short: "Get an origin of a pool",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseIDArg(args)
+		if err != nil {
+			return err
+		}
+
+		poolID, err := parseIDFlag(cmd, "pool")
+		if err != nil {
+			return err
+		}
+
+		client, err := newGraphClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.GetLoadBalancerPoolOrigin(cmd.Context(), poolID, id)
+		if err != nil {
+			return err
+		}
+
+		tbl := table{header: []string{"ID", "NAME", "TARGET", "PORT", "WEIGHT", "ACTIVE", "POOL", "CREATED", "UPDATED"}}
+
+		for _, edge := range resp.LoadBalancerPool.Origins.Edges {
+			o := edge.Node
+			tbl.rows = append(tbl.rows, []string{
+				o.ID.String(),
+				o.Name,
+				o.Target,
+				strconv.FormatInt(o.PortNumber, 10),
+				strconv.FormatInt(o.Weight, 10),
+				strconv.FormatBool(o.Active),
+				o.PoolID.String(),
+				formatTime(o.CreatedAt),
+				formatTime(o.UpdatedAt),
+			})
+		}
+
+		return printOutput(cmd, resp.LoadBalancerPool.Origins, tbl)
+	},
+}
+
+var originCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create an origin in a pool",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		input := graphclient.CreateLoadBalancerOriginInput{
+			Weight: changedInt64(cmd, "weight"),
+			Active: changedBool(cmd, "active"),
+		}
+
+		input.Name, _ = cmd.Flags().GetString("name")
+		input.Target, _ = cmd.Flags().GetString("target")
+		input.PortNumber, _ = cmd.Flags().GetInt64("port-number")
+
+		var err error
+
+		if input.PoolID, err = parseIDFlag(cmd, "pool"); err != nil {
+			return err
+		}
+
+		client, err := newGraphClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.LoadBalancerOriginCreate(cmd.Context(), input)
+		if err != nil {
+			return err
+		}
+
+		o := resp.LoadBalancerOriginCreate.LoadBalancerOrigin
+
+		return printOutput(cmd, o, table{
+			header: []string{"ID", "NAME", "TARGET", "PORT", "WEIGHT", "ACTIVE", "POOL", "CREATED"},
+			rows: [][]string{{
+				o.ID.String(),
+				o.Name,
+				o.Target,
+				strconv.FormatInt(o.PortNumber, 10),
+				strconv.FormatInt(o.Weight, 10),
+				strconv.FormatBool(o.Active),
+				o.PoolID.String(),
+				formatTime(o.CreatedAt),
+			}},
+		})
+	},
+}
+
+var originUpdateCmd = &cobra.Command{
+	Use:   "update <id>",
+	Short: "Update an origin",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseIDArg(args)
+		if err != nil {
+			return err
+		}
+
+		input := graphclient.UpdateLoadBalancerOriginInput{
+			Name:       changedString(cmd, "name"),
+			Target:     changedString(cmd, "target"),
+			PortNumber: changedInt64(cmd, "port-number"),
+			Weight:     changedInt64(cmd, "weight"),
+			Active:     changedBool(cmd, "active"),
+		}
+
+		client, err := newGraphClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.LoadBalancerOriginUpdate(cmd.Context(), id, input, changedInt64(cmd, "expected-version"))
+		if err != nil {
+			return err
+		}
+
+		o := resp.LoadBalancerOriginUpdate.LoadBalancerOrigin
+
+		return printOutput(cmd, o, table{
+			header: []string{"ID", "NAME", "TARGET", "PORT", "WEIGHT", "ACTIVE", "UPDATED"},
+			rows: [][]string{{
+				o.ID.String(),
+				o.Name,
+				o.Target,
+				strconv.FormatInt(o.PortNumber, 10),
+				strconv.FormatInt(o.Weight, 10),
+				strconv.FormatBool(o.Active),
+				formatTime(o.UpdatedAt),
+			}},
+		})
+	},
+}
+
+var originDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Delete an origin",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseIDArg(args)
+		if err != nil {
+			return err
+		}
+
+		client, err := newGraphClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.LoadBalancerOriginDelete(cmd.Context(), id, changedInt64(cmd, "expected-version"))
+		if err != nil {
+			return err
+		}
+
+		return printOutput(cmd, resp.LoadBalancerOriginDelete, table{
+			header: []string{"DELETED ID"},
+			rows:   [][]string{{resp.LoadBalancerOriginDelete.DeletedID.String()}},
+		})
+	},
+}
+
+func init() {
+	registerClientCommand(originCmd)
+
+	originCmd.AddCommand(originListCmd, originGetCmd, originCreateCmd, originUpdateCmd, originDeleteCmd)
+
+	originListCmd.Flags().String("pool", "", "id of the pool to list origins for")
+	originListCmd.Flags().Bool("include-deleted", false, "include soft deleted origins")
+	cobra.CheckErr(originListCmd.MarkFlagRequired("pool"))
+
+	originGetCmd.Flags().String("pool", "", "id of the pool the origin belongs to")
+	cobra.CheckErr(originGetCmd.MarkFlagRequired("pool"))
+
+	originCreateCmd.Flags().String("pool", "", "id of the pool to add the origin to")
+	originCreateCmd.Flags().String("name", "", "name of the origin")
+	originCreateCmd.Flags().String("target", "", "ip address of the origin")
+	originCreateCmd.Flags().Int64("port-number", 0, "port number of the origin")
+	originCreateCmd.Flags().Int64("weight", 0, "weight of the origin")
+	originCreateCmd.Flags().Bool("active", true, "whether the origin is active")
+
+	for _, name := range []string{"pool", "name", "target", "port-number"} {
+		cobra.CheckErr(originCreateCmd.MarkFlagRequired(name))
+	}
+
+	originUpdateCmd.Flags().String("name", "", "new name of the origin")
+	originUpdateCmd.Flags().String("target", "", "new ip address of the origin")
+	originUpdateCmd.Flags().Int64("port-number", 0, "new port number of the origin")
+	originUpdateCmd.Flags().Int64("weight", 0, "new weight of the origin")
+	originUpdateCmd.Flags().Bool("active", true, "whether the origin is active")
+
+	addExpectedVersionFlag(originUpdateCmd, originDeleteCmd)
+}
@@ -0,0 +1,263 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"go.infratographer.com/load-balancer-api/internal/graphclient"
+)
+
+var poolCmd = &cobra.Command{
+	Use:   "pool",
+	Short: "Manage load balancer pools",
+}
+
+var poolListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the pools of an owner",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		ownerID, err := parseIDFlag(cmd, "owner")
+		if err != nil {
+			return err
+		}
+
+		client, err := newGraphClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		tbl := table{header: []string{"ID", "NAME", "PROTOCOL"}}
+		pools := []any{}
+
+		err = listPages(func(after *string) (pageInfo, error) {
+			resp, err := client.GetOwnerLoadBalancerPools(cmd.Context(), ownerID, after)
+			if err != nil {
+				return pageInfo{}, err
+			}
+
+			var page pageInfo
+
+			for _, owner := range resp.Entities {
+				for _, edge := range owner.LoadBalancerPools.Edges {
+					tbl.rows = append(tbl.rows, []string{edge.Node.ID.String(), edge.Node.Name, edge.Node.Protocol.String()})
+					pools = append(pools, edge.Node)
+				}
+
+				page = pageInfo(owner.LoadBalancerPools.PageInfo)
+			}
+
+			return page, nil
+		})
+		if err != nil {
+			return err
+		}
+
+		return printOutput(cmd, pools, tbl)
+	},
+}
+
+var poolGetCmd = &cobra.Command{
+	Use:   "get <id>",
+	Short: "Get a pool",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseIDArg(args)
+		if err != nil {
+			return err
+		}
+
+		client, err := newGraphClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.GetLoadBalancerPool(cmd.Context(), id)
+		if err != nil {
+			return err
+		}
+
+		pool := resp.LoadBalancerPool
+
+		return printOutput(cmd, pool, table{
+			header: []string{"ID", "NAME", "PROTOCOL", "OWNER", "CREATED", "UPDATED"},
+			rows: [][]string{{
+				pool.ID.String(),
+				pool.Name,
+				pool.Protocol.String(),
+				pool.OwnerID.String(),
+				formatTime(pool.CreatedAt),
+				formatTime(pool.UpdatedAt),
+			}},
+		})
+	},
+}
+
+var poolCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a pool",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		input := graphclient.CreateLoadBalancerPoolInput{}
+
+		input.Name, _ = cmd.Flags().GetString("name")
+
+		protocol, _ := cmd.Flags().GetString("protocol")
+
+		var err error
+
+		if input.Protocol, err = parseProtocol(protocol); err != nil {
+			return err
+		}
+
+		if input.OwnerID, err = parseIDFlag(cmd, "owner"); err != nil {
+			return err
+		}
+
+		if input.PortIDs, err = parseIDsFlag(cmd, "port"); err != nil {
+			return err
+		}
+
+		client, err := newGraphClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.LoadBalancerPoolCreate(cmd.Context(), input)
+		if err != nil {
+			return err
+		}
+
+		pool := resp.LoadBalancerPoolCreate.LoadBalancerPool
+
+		return printOutput(cmd, pool, table{
+			header: []string{"ID", "NAME", "PROTOCOL", "OWNER", "CREATED"},
+			rows: [][]string{{
+				pool.ID.String(),
+				pool.Name,
+				pool.Protocol.String(),
+				pool.OwnerID.String(),
+				formatTime(pool.CreatedAt),
+			}},
+		})
+	},
+}
+
+var poolUpdateCmd = &cobra.Command{
+	Use:   "update <id>",
+	Short: "Update a pool",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseIDArg(args)
+		if err != nil {
+			return err
+		}
+
+		input := graphclient.UpdateLoadBalancerPoolInput{
+			Name: changedString(cmd, "name"),
+		}
+
+		if value := changedString(cmd, "protocol"); value != nil {
+			protocol, err := parseProtocol(*value)
+			if err != nil {
+				return err
+			}
+
+			input.Protocol = &protocol
+		}
+
+		if input.AddPortIDs, err = parseIDsFlag(cmd, "add-port"); err != nil {
+			return err
+		}
+
+		if input.RemovePortIDs, err = parseIDsFlag(cmd, "remove-port"); err != nil {
+			return err
+		}
+
+		client, err := newGraphClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.LoadBalancerPoolUpdate(cmd.Context(), id, input, changedInt64(cmd, "expected-version"))
+		if err != nil {
+			return err
+		}
+
+		pool := resp.LoadBalancerPoolUpdate.LoadBalancerPool
+
+		return printOutput(cmd, pool, table{
+			header: []string{"ID", "NAME", "PROTOCOL", "UPDATED"},
+			rows:   [][]string{{pool.ID.String(), pool.Name, pool.Protocol.String(), formatTime(pool.UpdatedAt)}},
+		})
+	},
+}
+
+var poolDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Delete a pool",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseIDArg(args)
+		if err != nil {
+			return err
+		}
+
+		client, err := newGraphClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.LoadBalancerPoolDelete(cmd.Context(), id, changedInt64(cmd, "expected-version"))
+		if err != nil {
+			return err
+		}
+
+		deletedID := ""
+		if resp.LoadBalancerPoolDelete.DeletedID != nil {
+			deletedID = resp.LoadBalancerPoolDelete.DeletedID.String()
+		}
+
+		return printOutput(cmd, resp.LoadBalancerPoolDelete, table{
+			header: []string{"DELETED ID"},
+			rows:   [][]string{{deletedID}},
+		})
+	},
+}
+
+// parseProtocol converts the protocol flag value into a pool protocol.
+func parseProtocol(value string) (graphclient.LoadBalancerPoolProtocol, error) {
+	protocol := graphclient.LoadBalancerPoolProtocol(strings.ToLower(value))
+	if !protocol.IsValid() {
+		return "", fmt.Errorf("%w: %s", ErrInvalidProtocol, value)
+	}
+
+	return protocol, nil
+}
+
+func init() {
+	registerClientCommand(poolCmd)
+
+	poolCmd.AddCommand(poolListCmd, poolGetCmd, poolCreateCmd, poolUpdateCmd, poolDeleteCmd)
+
+	poolListCmd.Flags().String("owner", "", "id of the owner to list pools for")
+	cobra.CheckErr(poolListCmd.MarkFlagRequired("owner"))
+
+	poolCreateCmd.Flags().String("name", "", "name of the pool")
+	poolCreateCmd.Flags().String("protocol", "tcp", "protocol of the pool (tcp, udp)")
+	poolCreateCmd.Flags().String("owner", "", "id of the owner of the pool")
+	poolCreateCmd.Flags().StringSlice("port", nil, "ids of the ports to assign the pool to")
+
+	for _, name := range []string{"name", "owner"} {
+		cobra.CheckErr(poolCreateCmd.MarkFlagRequired(name))
+	}
+
+	poolUpdateCmd.Flags().String("name", "", "new name of the pool")
+	poolUpdateCmd.Flags().String("protocol", "", "new protocol of the pool (tcp, udp)")
+	poolUpdateCmd.Flags().StringSlice("add-port", nil, "ids of ports to assign the pool to")
+	poolUpdateCmd.Flags().StringSlice("remove-port", nil, "ids of ports to remove the pool from")
+
+	addExpectedVersionFlag(poolUpdateCmd, poolDeleteCmd)
+}
@@ -0,0 +1,250 @@
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"go.infratographer.com/load-balancer-api/internal/graphclient"
+)
+
+var portCmd = &cobra.Command{
+	Use:   "port",
+	Short: "Manage load balancer ports",
+}
+
+var portListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the ports of a load balancer",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		lbID, err := parseIDFlag(cmd, "lb")
+		if err != nil {
+			return err
+		}
+
+		includeDeleted, _ := cmd.Flags().GetBool("include-deleted")
+
+		client, err := newGraphClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		tbl := table{header: []string{"ID", "NUMBER", "NAME"}}
+		ports := []any{}
+
+		err = listPages(func(after *string) (pageInfo, error) {
+			resp, err := client.GetLoadBalancerPorts(cmd.Context(), lbID, &includeDeleted, after)
+			if err != nil {
+				return pageInfo{}, err
+			}
+
+			for _, edge := range resp.LoadBalancer.Ports.Edges {
+				tbl.rows = append(tbl.rows, []string{
+					edge.Node.ID.String(),
+					strconv.FormatInt(edge.Node.Number, 10),
+					stringValue(edge.Node.Name),
+				})
+				ports = append(ports, edge.Node)
+			}
+
+			return pageInfo(resp.LoadBalancer.Ports.PageInfo), nil
+		})
+		if err != nil {
+			return err
+		}
+
+		return printOutput(cmd, ports, tbl)
+	},
+}
+
+var portGetCmd = &cobra.Command{
+	Use:   "get <id>",
+	Short: "Get a port",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseIDArg(args)
+		if err != nil {
+			return err
+		}
+
+		client, err := newGraphClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.GetLoadBalancerPort(cmd.Context(), id)
+		if err != nil {
+			return err
+		}
+
+		port := resp.LoadBalancerPort
+
+		return printOutput(cmd, port, table{
+			header: []string{"ID", "NUMBER", "NAME", "LOAD BALANCER", "CREATED", "UPDATED"},
+			rows: [][]string{{
+				port.ID.String(),
+				strconv.FormatInt(port.Number, 10),
+				stringValue(port.Name),
+				port.LoadBalancerID.String(),
+				formatTime(port.CreatedAt),
+				formatTime(port.UpdatedAt),
+			}},
+		})
+	},
+}
+
+var portCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a port on a load balancer",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		input := graphclient.CreateLoadBalancerPortInput{
+			Name: changedString(cmd, "name"),
+		}
+
+		input.Number, _ = cmd.Flags().GetInt64("number")
+
+		var err error
+
+		if input.LoadBalancerID, err = parseIDFlag(cmd, "lb"); err != nil {
+			return err
+		}
+
+		if input.PoolIDs, err = parseIDsFlag(cmd, "pool"); err != nil {
+			return err
+		}
+
+		client, err := newGraphClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.LoadBalancerPortCreate(cmd.Context(), input)
+		if err != nil {
+			return err
+		}
+
+		port := resp.LoadBalancerPortCreate.LoadBalancerPort
+
+		return printOutput(cmd, port, table{
+			header: []string{"ID", "NUMBER", "NAME", "LOAD BALANCER", "CREATED"},
+			rows: [][]string{{
+				port.ID.String(),
+				strconv.FormatInt(port.Number, 10),
+				stringValue(port.Name),
+				port.LoadBalancer.ID.String(),
+				formatTime(port.CreatedAt),
+			}},
+		})
+	},
+}
+
+var portUpdateCmd = &cobra.Command{
+	Use:   "update <id>",
+	Short: "Update a port",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseIDArg(args)
+		if err != nil {
+			return err
+		}
+
+		input := graphclient.UpdateLoadBalancerPortInput{
+			Number: changedInt64(cmd, "number"),
+			Name:   changedString(cmd, "name"),
+		}
+
+		if input.AddPoolIDs, err = parseIDsFlag(cmd, "add-pool"); err != nil {
+			return err
+		}
+
+		if input.RemovePoolIDs, err = parseIDsFlag(cmd, "remove-pool"); err != nil {
+			return err
+		}
+
+		client, err := newGraphClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.LoadBalancerPortUpdate(cmd.Context(), id, input, changedInt64(cmd, "expected-version"))
+		if err != nil {
+			return err
+		}
+
+		port := resp.LoadBalancerPortUpdate.LoadBalancerPort
+
+		return printOutput(cmd, port, table{
+			header: []string{"ID", "NUMBER", "NAME", "UPDATED"},
+			rows: [][]string{{
+				port.ID.String(),
+				strconv.FormatInt(port.Number, 10),
+				stringValue(port.Name),
+				formatTime(port.UpdatedAt),
+			}},
+		})
+	},
+}
+
+var portDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Delete a port",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseIDArg(args)
+		if err != nil {
+			return err
+		}
+
+		client, err := newGraphClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.LoadBalancerPortDelete(cmd.Context(), id, changedInt64(cmd, "expected-version"))
+		if err != nil {
+			return err
+		}
+
+		return printOutput(cmd, resp.LoadBalancerPortDelete, table{
+			header: []string{"DELETED ID"},
+			rows:   [][]string{{resp.LoadBalancerPortDelete.DeletedID.String()}},
+		})
+	},
+}
+
+// stringValue returns the value of an optional string for table output.
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+func init() {
+	registerClientCommand(portCmd)
+
+	portCmd.AddCommand(portListCmd, portGetCmd, portCreateCmd, portUpdateCmd, portDeleteCmd)
+
+	portListCmd.Flags().String("lb", "", "id of the load balancer to list ports for")
+	portListCmd.Flags().Bool("include-deleted", false, "include soft deleted ports")
+	cobra.CheckErr(portListCmd.MarkFlagRequired("lb"))
+
+	portCreateCmd.Flags().String("lb", "", "id of the load balancer to add the port to")
+	portCreateCmd.Flags().Int64("number", 0, "port number")
+	portCreateCmd.Flags().String("name", "", "name of the port")
+	portCreateCmd.Flags().StringSlice("pool", nil, "ids of the pools to assign to the port")
+
+	for _, name := range []string{"lb", "number"} {
+		cobra.CheckErr(portCreateCmd.MarkFlagRequired(name))
+	}
+
+	portUpdateCmd.Flags().Int64("number", 0, "new port number")
+	portUpdateCmd.Flags().String("name", "", "new name of the port")
+	portUpdateCmd.Flags().StringSlice("add-pool", nil, "ids of pools to assign to the port")
+	portUpdateCmd.Flags().StringSlice("remove-pool", nil, "ids of pools to remove from the port")
+
+	addExpectedVersionFlag(portUpdateCmd, portDeleteCmd)
+}
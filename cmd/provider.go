@@ -0,0 +1,206 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"go.infratographer.com/load-balancer-api/internal/graphclient"
+)
+
+var providerCmd = &cobra.Command{
+	Use:   "provider",
+	Short: "Manage load balancer providers",
+}
+
+var providerListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the load balancer providers of an owner",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		ownerID, err := parseIDFlag(cmd, "owner")
+		if err != nil {
+			return err
+		}
+
+		client, err := newGraphClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		tbl := table{header: []string{"ID", "NAME"}}
+		providers := []any{}
+
+		err = listPages(func(after *string) (pageInfo, error) {
+			resp, err := client.GetOwnerLoadBalancerProviders(cmd.Context(), ownerID, nil, after)
+			if err != nil {
+				return pageInfo{}, err
+			}
+
+			var page pageInfo
+
+			for _, owner := range resp.Entities {
+				for _, edge := range owner.LoadBalancersProviders.Edges {
+					tbl.rows = append(tbl.rows, []string{edge.Node.ID.String(), edge.Node.Name})
+					providers = append(providers, edge.Node)
+				}
+
+				page = pageInfo(owner.LoadBalancersProviders.PageInfo)
+			}
+
+			return page, nil
+		})
+		if err != nil {
+			return err
+		}
+
+		return printOutput(cmd, providers, tbl)
+	},
+}
+
+var providerGetCmd = &cobra.Command{
+	Use:   "get <id>",
+	Short: "Get a load balancer provider",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseIDArg(args)
+		if err != nil {
+			return err
+		}
+
+		client, err := newGraphClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.GetLoadBalancerProvider(cmd.Context(), id)
+		if err != nil {
+			return err
+		}
+
+		prov := resp.LoadBalancerProvider
+
+		return printOutput(cmd, prov, table{
+			header: []string{"ID", "NAME", "OWNER", "CREATED", "UPDATED"},
+			rows: [][]string{{
+				prov.ID.String(),
+				prov.Name,
+				prov.Owner.ID.String(),
+				formatTime(prov.CreatedAt),
+				formatTime(prov.UpdatedAt),
+			}},
+		})
+	},
+}
+
+var providerCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a load balancer provider",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		input := graphclient.CreateLoadBalancerProviderInput{}
+
+		input.Name, _ = cmd.Flags().GetString("name")
+
+		var err error
+
+		if input.OwnerID, err = parseIDFlag(cmd, "owner"); err != nil {
+			return err
+		}
+
+		client, err := newGraphClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.LoadBalancerProviderCreate(cmd.Context(), input)
+		if err != nil {
+			return err
+		}
+
+		prov := resp.LoadBalancerProviderCreate.LoadBalancerProvider
+
+		return printOutput(cmd, prov, table{
+			header: []string{"ID", "NAME", "OWNER", "CREATED"},
+			rows:   [][]string{{prov.ID.String(), prov.Name, prov.Owner.ID.String(), formatTime(prov.CreatedAt)}},
+		})
+	},
+}
+
+var providerUpdateCmd = &cobra.Command{
+	Use:   "update <id>",
+	Short: "Update a load balancer provider",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseIDArg(args)
+		if err != nil {
+			return err
+		}
+
+		input := graphclient.UpdateLoadBalancerProviderInput{
+			Name: changedString(cmd, "name"),
+		}
+
+		client, err := newGraphClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.LoadBalancerProviderUpdate(cmd.Context(), id, input, changedInt64(cmd, "expected-version"))
+		if err != nil {
+			return err
+		}
+
+		prov := resp.LoadBalancerProviderUpdate.LoadBalancerProvider
+
+		return printOutput(cmd, prov, table{
+			header: []string{"ID", "NAME", "UPDATED"},
+			rows:   [][]string{{prov.ID.String(), prov.Name, formatTime(prov.UpdatedAt)}},
+		})
+	},
+}
+
+var providerDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Delete a load balancer provider",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseIDArg(args)
+		if err != nil {
+			return err
+		}
+
+		client, err := newGraphClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.LoadBalancerProviderDelete(cmd.Context(), id, changedInt64(cmd, "expected-version"))
+		if err != nil {
+			return err
+		}
+
+		return printOutput(cmd, resp.LoadBalancerProviderDelete, table{
+			header: []string{"DELETED ID"},
+			rows:   [][]string{{resp.LoadBalancerProviderDelete.DeletedID.String()}},
+		})
+	},
+}
+
+func init() {
+	registerClientCommand(providerCmd)
+
+	providerCmd.AddCommand(providerListCmd, providerGetCmd, providerCreateCmd, providerUpdateCmd, providerDeleteCmd)
+
+	providerListCmd.Flags().String("owner", "", "id of the owner to list providers for")
+	cobra.CheckErr(providerListCmd.MarkFlagRequired("owner"))
+
+	providerCreateCmd.Flags().String("name", "", "name of the provider")
+	providerCreateCmd.Flags().String("owner", "", "id of the owner of the provider")
+
+	for _, name := range []string{"name", "owner"} {
+		cobra.CheckErr(providerCreateCmd.MarkFlagRequired(name))
+	}
+
+	providerUpdateCmd.Flags().String("name", "", "new name of the provider")
+
+	addExpectedVersionFlag(providerUpdateCmd, providerDeleteCmd)
+}
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.infratographer.com/x/crdbx"
+	"go.infratographer.com/x/viperx"
+
+	"go.infratographer.com/load-balancer-api/internal/config"
+	ent "go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/purge"
+)
+
+var purgeDryRun bool
+
+var purgeCmd = &cobra.Command{
+	Use:   "purge",
+	Short: "Permanently remove soft deleted records older than the retention period",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return purgeDeleted(cmd.Context())
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(purgeCmd)
+
+	purgeCmd.Flags().Duration("retention", purge.DefaultRetention, "how long soft deleted records are kept before being purged")
+	viperx.MustBindFlag(viper.GetViper(), "purge.retention", purgeCmd.Flags().Lookup("retention"))
+
+	purgeCmd.Flags().Int("batch-size", purge.DefaultBatchSize, "number of records removed per delete statement")
+	viperx.MustBindFlag(viper.GetViper(), "purge.batch-size", purgeCmd.Flags().Lookup("batch-size"))
+
+	purgeCmd.Flags().BoolVar(&purgeDryRun, "dry-run", false, "report the number of records that would be purged per table without removing them")
+}
+
+func purgeDeleted(ctx context.Context) error {
+	config.AppConfig.Purge.Retention = viper.GetDuration("purge.retention")
+	config.AppConfig.Purge.BatchSize = viper.GetInt("purge.batch-size")
+
+	db, err := crdbx.NewDB(config.AppConfig.CRDB, config.AppConfig.Tracing.Enabled)
+	if err != nil {
+		logger.Fatalw("failed to connect to database", "error", err)
+	}
+
+	defer db.Close()
+
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.Postgres, db)))
+	defer client.Close()
+
+	p := purge.New(client,
+		purge.WithLogger(logger.Named("purge")),
+		purge.WithRetention(config.AppConfig.Purge.Retention),
+		purge.WithBatchSize(config.AppConfig.Purge.BatchSize),
+		purge.WithDryRun(purgeDryRun),
+	)
+
+	res, err := p.Run(ctx)
+	if err != nil {
+		logger.Errorw("failed to purge soft deleted records", "error", err)
+		return err
+	}
+
+	logger.Infow("purge complete",
+		"dry-run", purgeDryRun,
+		"origins", res.Origins,
+		"ports", res.Ports,
+		"pools", res.Pools,
+		"load-balancers", res.LoadBalancers,
+		"idempotency-keys", res.IdempotencyKeys,
+	)
+
+	return nil
+}
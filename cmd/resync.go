@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.infratographer.com/x/crdbx"
+	"go.infratographer.com/x/events"
+	"go.infratographer.com/x/gidx"
+	"go.infratographer.com/x/viperx"
+
+	"go.infratographer.com/load-balancer-api/internal/config"
+	ent "go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/resync"
+)
+
+var (
+	resyncOwnerID    string
+	resyncLocationID string
+	resyncProviderID string
+	resyncCursor     string
+)
+
+var resyncCmd = &cobra.Command{
+	Use:   "resync",
+	Short: "Republish change messages for all live load balancers, ports, pools and origins",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runResync(cmd.Context())
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(resyncCmd)
+
+	resyncCmd.Flags().Int("rate", resync.DefaultRate, "maximum number of messages published per second, 0 for no limit")
+	viperx.MustBindFlag(viper.GetViper(), "resync.rate", resyncCmd.Flags().Lookup("rate"))
+
+	resyncCmd.Flags().Int("batch-size", resync.DefaultBatchSize, "number of resources loaded per query")
+	viperx.MustBindFlag(viper.GetViper(), "resync.batch-size", resyncCmd.Flags().Lookup("batch-size"))
+
+	resyncCmd.Flags().StringVar(&resyncOwnerID, "owner", "", "only resync the resources of this owner")
+	resyncCmd.Flags().StringVar(&resyncLocationID, "location", "", "only resync the resources in this location")
+	resyncCmd.Flags().StringVar(&resyncProviderID, "provider", "", "only resync the resources of this provider")
+	resyncCmd.Flags().StringVar(&resyncCursor, "cursor", "", "resume an interrupted resync after the cursor it logged")
+}
+
+func runResync(ctx context.Context) error {
+	config.AppConfig.Resync.Rate = viper.GetInt("resync.rate")
+	config.AppConfig.Resync.BatchSize = viper.GetInt("resync.batch-size")
+
+	var (
+		filter resync.Filter
+		cursor gidx.PrefixedID
+	)
+
+	for _, f := range []struct {
+		value string
+		dst   *gidx.PrefixedID
+	}{
+		{resyncOwnerID, &filter.OwnerID},
+		{resyncLocationID, &filter.LocationID},
+		{resyncProviderID, &filter.ProviderID},
+		{resyncCursor, &cursor},
+	} {
+		if f.value == "" {
+			continue
+		}
+
+		id, err := gidx.Parse(f.value)
+		if err != nil {
+			logger.Errorw("invalid id", "error", err, "id", f.value)
+			return err
+		}
+
+		*f.dst = id
+	}
+
+	events, err := events.NewConnection(config.AppConfig.Events, events.WithLogger(logger))
+	if err != nil {
+		logger.Fatalw("failed to initialize events", "error", err)
+	}
+
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		_ = events.Shutdown(ctx)
+	}()
+
+	db, err := crdbx.NewDB(config.AppConfig.CRDB, config.AppConfig.Tracing.Enabled)
+	if err != nil {
+		logger.Fatalw("failed to connect to database", "error", err)
+	}
+
+	defer db.Close()
+
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.Postgres, db)))
+	defer client.Close()
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	opts := []resync.Option{
+		resync.WithLogger(logger.Named("resync")),
+		resync.WithRate(config.AppConfig.Resync.Rate),
+		resync.WithBatchSize(config.AppConfig.Resync.BatchSize),
+	}
+
+	if config.AppConfig.EventSnapshots.Enabled {
+		opts = append(opts, resync.WithSnapshots(config.AppConfig.EventSnapshots.MaxSize))
+	}
+
+	r := resync.New(client, events, opts...)
+
+	res, err := r.Run(ctx, filter, cursor, 0)
+	if err != nil {
+		// the cursor lets the resync be resumed with --cursor
+		logger.Errorw("failed to resync", "error", err, "published", res.Published, "cursor", res.Cursor)
+		return err
+	}
+
+	logger.Infow("resync complete", "published", res.Published)
+
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"go.infratographer.com/x/crdbx"
+	"go.infratographer.com/x/events"
 	"go.infratographer.com/x/goosex"
 	"go.infratographer.com/x/loggingx"
 	"go.infratographer.com/x/otelx"
@@ -19,6 +20,7 @@ import (
 
 	dbm "go.infratographer.com/load-balancer-api/db"
 	"go.infratographer.com/load-balancer-api/internal/config"
+	"go.infratographer.com/load-balancer-api/internal/snapshot"
 )
 
 // TODO: update app name
@@ -51,6 +53,15 @@ func init() {
 	// Logging flags
 	loggingx.MustViperFlags(viper.GetViper(), rootCmd.PersistentFlags())
 
+	// Events flags, shared by the serve and worker commands
+	events.MustViperFlags(viper.GetViper(), rootCmd.PersistentFlags(), appName)
+
+	rootCmd.PersistentFlags().Bool("event-snapshots", false, "embed the full state of changed resources in published change messages")
+	viperx.MustBindFlag(viper.GetViper(), "event-snapshots.enabled", rootCmd.PersistentFlags().Lookup("event-snapshots"))
+
+	rootCmd.PersistentFlags().Int("event-snapshot-max-size", snapshot.DefaultMaxSize, "maximum size in bytes of an embedded snapshot, larger snapshots are left out")
+	viperx.MustBindFlag(viper.GetViper(), "event-snapshots.max-size", rootCmd.PersistentFlags().Lookup("event-snapshot-max-size"))
+
 	// Register version command
 	versionx.RegisterCobraCommand(rootCmd, func() { versionx.PrintVersion(logger) })
 	otelx.MustViperFlags(viper.GetViper(), rootCmd.Flags())
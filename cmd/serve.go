@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"os"
 	"os/signal"
 	"strconv"
@@ -10,9 +12,11 @@ import (
 
 	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	_ "github.com/mattn/go-sqlite3" // sqlite3 driver
+	"github.com/nats-io/nats.go"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"go.infratographer.com/permissions-api/pkg/permissions"
@@ -27,10 +31,13 @@ import (
 
 	metadata "go.infratographer.com/metadata-api/pkg/client"
 
+	"go.infratographer.com/load-balancer-api/internal/changefeed"
 	"go.infratographer.com/load-balancer-api/internal/config"
 	ent "go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/eventhooks"
+	"go.infratographer.com/load-balancer-api/internal/ent/schema/validations"
 	"go.infratographer.com/load-balancer-api/internal/graphapi"
-	"go.infratographer.com/load-balancer-api/internal/manualhooks"
+	"go.infratographer.com/load-balancer-api/internal/outbox"
 
 	"go.infratographer.com/x/events"
 )
@@ -39,6 +46,8 @@ const (
 	defaultLBAPIListenAddr = ":7608"
 	shutdownTimeout        = 10 * time.Second
 	defaultTimeout         = 5 * time.Second
+
+	changeFeedInactiveThreshold = 5 * time.Minute
 )
 
 var (
@@ -69,7 +78,6 @@ func init() {
 
 	echox.MustViperFlags(viper.GetViper(), serveCmd.Flags(), defaultLBAPIListenAddr)
 	echojwtx.MustViperFlags(viper.GetViper(), serveCmd.Flags())
-	events.MustViperFlags(viper.GetViper(), serveCmd.Flags(), appName)
 	oauth2x.MustViperFlags(viper.GetViper(), serveCmd.Flags())
 	permissions.MustViperFlags(viper.GetViper(), serveCmd.Flags())
 
@@ -87,6 +95,30 @@ func init() {
 	serveCmd.Flags().BoolVar(&enablePlayground, "playground", false, "enable the graph playground")
 	serveCmd.Flags().StringVar(&pidFileName, "pid-file", "", "path to the pid file")
 	serveCmd.Flags().IntSlice("restricted-ports", []int{}, "ports that are restricted from being used by the load balancer (e.g. 22, 8086, etc.)")
+
+	serveCmd.Flags().Duration("idempotency-key-ttl", graphapi.DefaultIdempotencyKeyTTL, "how long idempotency keys of create mutations are remembered")
+	viperx.MustBindFlag(viper.GetViper(), "idempotency-key-ttl", serveCmd.Flags().Lookup("idempotency-key-ttl"))
+
+	serveCmd.Flags().Duration("idempotency-key-lease", graphapi.DefaultIdempotencyKeyLease, "how long a create mutation holds its idempotency key before a retry may claim it again")
+	viperx.MustBindFlag(viper.GetViper(), "idempotency-key-lease", serveCmd.Flags().Lookup("idempotency-key-lease"))
+
+	serveCmd.Flags().Int("query-max-depth", graphapi.DefaultMaxQueryDepth, "deepest selection a graphql operation may have, 0 disables the limit")
+	viperx.MustBindFlag(viper.GetViper(), "query-limits.max-depth", serveCmd.Flags().Lookup("query-max-depth"))
+
+	serveCmd.Flags().Int("query-max-complexity", graphapi.DefaultMaxQueryComplexity, "highest complexity a graphql operation may have, 0 disables the limit")
+	viperx.MustBindFlag(viper.GetViper(), "query-limits.max-complexity", serveCmd.Flags().Lookup("query-max-complexity"))
+
+	serveCmd.Flags().Int("query-max-page-size", graphapi.DefaultMaxPageSize, "largest first or last a connection may ask for and the page size of connections that give neither, 0 disables the limit")
+	viperx.MustBindFlag(viper.GetViper(), "query-limits.max-page-size", serveCmd.Flags().Lookup("query-max-page-size"))
+
+	serveCmd.Flags().Int("persisted-query-cache-size", graphapi.DefaultPersistedQueryCacheSize, "number of automatic persisted queries kept in memory")
+	viperx.MustBindFlag(viper.GetViper(), "persisted-queries.cache-size", serveCmd.Flags().Lookup("persisted-query-cache-size"))
+
+	serveCmd.Flags().String("persisted-query-allowlist-dir", "", "only accept the operations of the .graphql files in this directory")
+	viperx.MustBindFlag(viper.GetViper(), "persisted-queries.allowlist-dir", serveCmd.Flags().Lookup("persisted-query-allowlist-dir"))
+
+	serveCmd.Flags().StringSlice("origin-denied-cidrs", validations.DefaultDeniedTargetCIDRs, "CIDR ranges origin targets are not allowed to be within")
+	viperx.MustBindFlag(viper.GetViper(), "origin-target-policy.denied-cidrs", serveCmd.Flags().Lookup("origin-denied-cidrs"))
 }
 
 // Write a pid file, but first make sure it doesn't exist with a running pid.
@@ -113,10 +145,56 @@ func writePidFile(pidFile string) error {
 	return os.WriteFile(pidFile, []byte(strconv.Itoa(os.Getpid())), 0o664) // nolint: mnd
 }
 
+// newMetadataClient returns a client for the metadata service, or nil when no supergraph is configured
+func newMetadataClient(ctx context.Context) *metadata.Client {
+	// TODO - @rizzza - supergraph client
+	if config.AppConfig.Supergraph.URL == "" {
+		return nil
+	}
+
+	if config.AppConfig.OIDCClient.Config.Issuer == "" {
+		return metadata.New(config.AppConfig.Supergraph.URL)
+	}
+
+	oidcTS, err := oauth2x.NewClientCredentialsTokenSrc(ctx, config.AppConfig.OIDCClient.Config)
+	if err != nil {
+		logger.Fatalw("failed to create oauth2 token source", "error", err)
+	}
+
+	oauthHTTPClient := oauth2x.NewClient(ctx, oidcTS)
+	oauthHTTPClient.Timeout = config.AppConfig.Supergraph.Timeout
+
+	return metadata.New(config.AppConfig.Supergraph.URL,
+		metadata.WithHTTPClient(oauthHTTPClient),
+	)
+}
+
+// newChangeFeedConnection returns the events connection the change feed reads the change topics
+// with. Each replica consumes with its own queue group so that every replica receives all
+// changes, starting with the changes published after it started. The consumers of stopped
+// replicas are removed once they have been inactive for changeFeedInactiveThreshold.
+func newChangeFeedConnection() (events.Connection, error) {
+	suffix := make([]byte, 8)
+	if _, err := rand.Read(suffix); err != nil {
+		return nil, err
+	}
+
+	cfg := config.AppConfig.Events
+	cfg.NATS.QueueGroup = appName + "-changefeed-" + hex.EncodeToString(suffix)
+	cfg.NATS.SubscriberDeliveryPolicy = "new"
+
+	return events.NewConnection(cfg,
+		events.WithLogger(logger.Named("changefeed")),
+		events.WithNATSOptions(events.WithNATSSubscribeOptions(nats.InactiveThreshold(changeFeedInactiveThreshold))),
+	)
+}
+
 func serve(ctx context.Context) error {
 	var resolverOpts []graphapi.Option
 
 	config.AppConfig.LoadBalancerLimit = viper.GetInt("load-balancer-limit")
+	config.AppConfig.IdempotencyKeyTTL = viper.GetDuration("idempotency-key-ttl")
+	config.AppConfig.IdempotencyKeyLease = viper.GetDuration("idempotency-key-lease")
 	if err := viper.UnmarshalKey("extraRelations", &config.AppConfig.ExtraPermissionRelations); err != nil {
 		logger.Fatalw("error unmarshaling extraRelations from config", "error", err)
 	}
@@ -147,6 +225,19 @@ func serve(ctx context.Context) error {
 
 	entDB := entsql.OpenDB(dialect.Postgres, db)
 
+	// graphql subscriptions are served from the change topics, so they include the changes
+	// published by every replica
+	feedEvents, err := newChangeFeedConnection()
+	if err != nil {
+		logger.Fatalw("failed to initialize change feed events", "error", err)
+	}
+
+	changes := changefeed.New(changefeed.WithLogger(logger.Named("changefeed")))
+
+	if err := changes.Start(ctx, feedEvents, changefeed.DefaultTopics...); err != nil {
+		logger.Fatalw("failed to start change feed", "error", err)
+	}
+
 	cOpts := []ent.Option{ent.Driver(entDB), ent.EventsPublisher(events)}
 
 	if config.AppConfig.Logging.Debug {
@@ -159,35 +250,37 @@ func serve(ctx context.Context) error {
 	client := ent.NewClient(cOpts...)
 	defer client.Close()
 
-	// TODO - @rizzza - supergraph client
-	var metadataClient *metadata.Client
+	if metadataClient := newMetadataClient(ctx); metadataClient != nil {
+		resolverOpts = append(resolverOpts, graphapi.WithMetadataClient(metadataClient))
+	}
 
-	if config.AppConfig.Supergraph.URL != "" {
-		if config.AppConfig.OIDCClient.Config.Issuer != "" {
-			oidcTS, err := oauth2x.NewClientCredentialsTokenSrc(ctx, config.AppConfig.OIDCClient.Config)
-			if err != nil {
-				logger.Fatalw("failed to create oauth2 token source", "error", err)
-			}
+	resolverOpts = append(resolverOpts,
+		graphapi.WithChangeFeed(changes),
+		graphapi.WithQueryLimits(graphapi.QueryLimits{
+			MaxDepth:      config.AppConfig.QueryLimits.MaxDepth,
+			MaxComplexity: config.AppConfig.QueryLimits.MaxComplexity,
+			MaxPageSize:   config.AppConfig.QueryLimits.MaxPageSize,
+		}),
+	)
 
-			oauthHTTPClient := oauth2x.NewClient(ctx, oidcTS)
-			oauthHTTPClient.Timeout = config.AppConfig.Supergraph.Timeout
+	if size := config.AppConfig.PersistedQueries.CacheSize; size > 0 {
+		resolverOpts = append(resolverOpts, graphapi.WithPersistedQueryCache(lru.New(size)))
+	}
 
-			metadataClient = metadata.New(config.AppConfig.Supergraph.URL,
-				metadata.WithHTTPClient(oauthHTTPClient),
-			)
-		} else {
-			metadataClient = metadata.New(config.AppConfig.Supergraph.URL)
+	if dir := config.AppConfig.PersistedQueries.AllowlistDir; dir != "" {
+		allowlist, err := graphapi.LoadPersistedQueryAllowlist(dir)
+		if err != nil {
+			logger.Fatalw("failed to load persisted query allowlist", "error", err)
 		}
-	}
 
-	if metadataClient != nil {
-		resolverOpts = append(resolverOpts, graphapi.WithMetadataClient(metadataClient))
+		logger.Infow("persisted query allowlist enabled", "dir", dir, "queries", allowlist.Len())
+
+		resolverOpts = append(resolverOpts, graphapi.WithPersistedQueryAllowlist(allowlist))
 	}
 
-	// TODO: fix generated pubsubhooks
-	// eventhooks.PubsubHooks(client)
+	pending := outbox.New(client, events, outbox.WithLogger(logger.Named("outbox")))
 
-	manualhooks.PubsubHooks(client)
+	eventhooks.EventHooks(client, eventhooks.WithLogger(logger), eventhooks.WithOutbox(pending))
 
 	// Run the automatic migration tool to create all schema resources.
 	if err := client.Schema.Create(ctx); err != nil {
@@ -197,6 +290,14 @@ func serve(ctx context.Context) error {
 
 	config.AppConfig.RestrictedPorts = viper.GetIntSlice("restricted-ports")
 
+	if err := viper.UnmarshalKey("origin-target-policy", &config.AppConfig.OriginTargetPolicy); err != nil {
+		logger.Fatalw("error unmarshaling origin-target-policy from config", "error", err)
+	}
+
+	if err := validations.OriginTargetPolicy(config.AppConfig.OriginTargetPolicy); err != nil {
+		logger.Fatalw("invalid origin target policy", "error", err)
+	}
+
 	var middleware []echo.MiddlewareFunc
 
 	// jwt auth middleware
@@ -238,8 +339,11 @@ func serve(ctx context.Context) error {
 		defer cancel()
 
 		_ = events.Shutdown(ctx)
+		_ = feedEvents.Shutdown(ctx)
 	}()
 
+	go pending.Run(ctx)
+
 	go func() {
 		if err := srv.Run(); err != nil {
 			logger.Fatal("failed to run server", zap.Error(err))
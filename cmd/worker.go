@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/x/crdbx"
+	"go.infratographer.com/x/events"
+	"go.infratographer.com/x/viperx"
+
+	"go.infratographer.com/load-balancer-api/internal/config"
+	ent "go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/eventhooks"
+	"go.infratographer.com/load-balancer-api/internal/outbox"
+	"go.infratographer.com/load-balancer-api/internal/ownerdeletion"
+	"go.infratographer.com/load-balancer-api/internal/worker"
+)
+
+var workerCmd = &cobra.Command{
+	Use:   "worker",
+	Short: "Consume change and event messages published by other services",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runWorker(cmd.Context())
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(workerCmd)
+
+	workerCmd.Flags().StringSlice("change-topics", []string{}, "change topics to subscribe to, prefixed with the event type (e.g. delete.tenant for removing the resources of deleted owners)")
+	viperx.MustBindFlag(viper.GetViper(), "worker.change-topics", workerCmd.Flags().Lookup("change-topics"))
+
+	workerCmd.Flags().StringSlice("event-topics", []string{}, "event topics to subscribe to, prefixed with the event type (e.g. *.ipam-address)")
+	viperx.MustBindFlag(viper.GetViper(), "worker.event-topics", workerCmd.Flags().Lookup("event-topics"))
+
+	workerCmd.Flags().Int("max-deliveries", worker.DefaultMaxDeliveries, "how often a message is delivered before it is given up on")
+	viperx.MustBindFlag(viper.GetViper(), "worker.max-deliveries", workerCmd.Flags().Lookup("max-deliveries"))
+
+	workerCmd.Flags().Duration("retry-delay", worker.DefaultRetryDelay, "delay before a failed message is redelivered, multiplied by the number of deliveries")
+	viperx.MustBindFlag(viper.GetViper(), "worker.retry-delay", workerCmd.Flags().Lookup("retry-delay"))
+}
+
+func runWorker(ctx context.Context) error {
+	config.AppConfig.Worker.ChangeTopics = viper.GetStringSlice("worker.change-topics")
+	config.AppConfig.Worker.EventTopics = viper.GetStringSlice("worker.event-topics")
+	config.AppConfig.Worker.MaxDeliveries = viper.GetInt("worker.max-deliveries")
+	config.AppConfig.Worker.RetryDelay = viper.GetDuration("worker.retry-delay")
+
+	events, err := events.NewConnection(config.AppConfig.Events, events.WithLogger(logger))
+	if err != nil {
+		logger.Fatalw("failed to initialize events", "error", err)
+	}
+
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		_ = events.Shutdown(ctx)
+	}()
+
+	db, err := crdbx.NewDB(config.AppConfig.CRDB, config.AppConfig.Tracing.Enabled)
+	if err != nil {
+		logger.Fatalw("failed to connect to database", "error", err)
+	}
+
+	defer db.Close()
+
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.Postgres, db)), ent.EventsPublisher(events))
+	defer client.Close()
+
+	pending := outbox.New(client, events, outbox.WithLogger(logger.Named("outbox")))
+
+	eventhooks.EventHooks(client, eventhooks.WithLogger(logger), eventhooks.WithOutbox(pending))
+
+	perms, err := permissions.New(config.AppConfig.Permissions,
+		permissions.WithLogger(logger),
+		permissions.WithEventsPublisher(events),
+	)
+	if err != nil {
+		logger.Fatalw("failed to initialize permissions", "error", err)
+	}
+
+	// there is no request the permissions middleware could add the relationship handler to
+	ctx = context.WithValue(ctx, permissions.AuthRelationshipRequestHandlerCtxKey, perms)
+
+	cleanerOpts := []ownerdeletion.Option{ownerdeletion.WithLogger(logger.Named("ownerdeletion"))}
+
+	if metadataClient := newMetadataClient(ctx); metadataClient != nil {
+		cleanerOpts = append(cleanerOpts, ownerdeletion.WithMetadataClient(metadataClient))
+	}
+
+	cleaner := ownerdeletion.New(client, cleanerOpts...)
+
+	// messages being handled are finished before the worker returns
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if err := cleaner.Resume(ctx); err != nil {
+		logger.Errorw("failed to resume owner deletions", "error", err)
+	}
+
+	go pending.Run(ctx)
+
+	w := worker.New(events,
+		worker.WithLogger(logger.Named("worker")),
+		worker.WithChangeTopics(config.AppConfig.Worker.ChangeTopics...),
+		worker.WithEventTopics(config.AppConfig.Worker.EventTopics...),
+		worker.WithMaxDeliveries(config.AppConfig.Worker.MaxDeliveries),
+		worker.WithRetryDelay(config.AppConfig.Worker.RetryDelay),
+		worker.WithChangeHandler(cleaner.HandleChange),
+	)
+
+	if err := w.Run(ctx); err != nil {
+		logger.Errorw("failed to run worker", "error", err)
+		return err
+	}
+
+	return nil
+}
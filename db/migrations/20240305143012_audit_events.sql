@@ -0,0 +1,19 @@
+-- +goose Up
+-- create "audit_events" table
+CREATE TABLE "audit_events" ("id" character varying NOT NULL, "created_at" timestamptz NOT NULL, "actor" character varying NOT NULL, "operation" character varying NOT NULL, "subject_id" character varying NOT NULL, "related_ids" jsonb NOT NULL, "fields" jsonb NOT NULL, "changeset" jsonb NOT NULL, PRIMARY KEY ("id"));
+-- create index "auditevent_actor" to table: "audit_events"
+CREATE INDEX "auditevent_actor" ON "audit_events" ("actor");
+-- create index "auditevent_created_at" to table: "audit_events"
+CREATE INDEX "auditevent_created_at" ON "audit_events" ("created_at");
+-- create index "auditevent_subject_id" to table: "audit_events"
+CREATE INDEX "auditevent_subject_id" ON "audit_events" ("subject_id");
+
+-- +goose Down
+-- reverse: create index "auditevent_subject_id" to table: "audit_events"
+DROP INDEX "auditevent_subject_id";
+-- reverse: create index "auditevent_created_at" to table: "audit_events"
+DROP INDEX "auditevent_created_at";
+-- reverse: create index "auditevent_actor" to table: "audit_events"
+DROP INDEX "auditevent_actor";
+-- reverse: create "audit_events" table
+DROP TABLE "audit_events";
@@ -0,0 +1,11 @@
+-- +goose Up
+-- drop index "port_load_balancer_id_number" from table: "ports"
+DROP INDEX "port_load_balancer_id_number";
+-- create index "port_load_balancer_id_number" to table: "ports"
+CREATE UNIQUE INDEX "port_load_balancer_id_number" ON "ports" ("load_balancer_id", "number") WHERE (deleted_at IS NULL);
+
+-- +goose Down
+-- reverse: create index "port_load_balancer_id_number" to table: "ports"
+DROP INDEX "port_load_balancer_id_number";
+-- reverse: drop index "port_load_balancer_id_number" from table: "ports"
+CREATE UNIQUE INDEX "port_load_balancer_id_number" ON "ports" ("load_balancer_id", "number");
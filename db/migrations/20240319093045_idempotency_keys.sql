@@ -0,0 +1,15 @@
+-- +goose Up
+-- create "idempotency_keys" table
+CREATE TABLE "idempotency_keys" ("id" character varying NOT NULL, "key" character varying NOT NULL, "owner_id" character varying NOT NULL, "request_hash" character varying NOT NULL, "resource_id" character varying NULL, "created_at" timestamptz NOT NULL, "expires_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "idempotencykey_expires_at" to table: "idempotency_keys"
+CREATE INDEX "idempotencykey_expires_at" ON "idempotency_keys" ("expires_at");
+-- create index "idempotencykey_owner_id_key" to table: "idempotency_keys"
+CREATE UNIQUE INDEX "idempotencykey_owner_id_key" ON "idempotency_keys" ("owner_id", "key");
+
+-- +goose Down
+-- reverse: create index "idempotencykey_owner_id_key" to table: "idempotency_keys"
+DROP INDEX "idempotencykey_owner_id_key";
+-- reverse: create index "idempotencykey_expires_at" to table: "idempotency_keys"
+DROP INDEX "idempotencykey_expires_at";
+-- reverse: create "idempotency_keys" table
+DROP TABLE "idempotency_keys";
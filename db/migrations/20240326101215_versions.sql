@@ -0,0 +1,23 @@
+-- +goose Up
+-- modify "load_balancers" table
+ALTER TABLE "load_balancers" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
+-- modify "origins" table
+ALTER TABLE "origins" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
+-- modify "pools" table
+ALTER TABLE "pools" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
+-- modify "ports" table
+ALTER TABLE "ports" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
+-- modify "providers" table
+ALTER TABLE "providers" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
+
+-- +goose Down
+-- reverse: modify "providers" table
+ALTER TABLE "providers" DROP COLUMN "version";
+-- reverse: modify "ports" table
+ALTER TABLE "ports" DROP COLUMN "version";
+-- reverse: modify "pools" table
+ALTER TABLE "pools" DROP COLUMN "version";
+-- reverse: modify "origins" table
+ALTER TABLE "origins" DROP COLUMN "version";
+-- reverse: modify "load_balancers" table
+ALTER TABLE "load_balancers" DROP COLUMN "version";
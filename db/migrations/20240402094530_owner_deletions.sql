@@ -0,0 +1,15 @@
+-- +goose Up
+-- create "owner_deletions" table
+CREATE TABLE "owner_deletions" ("id" character varying NOT NULL, "owner_id" character varying NOT NULL, "started_at" timestamptz NOT NULL, "completed_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- create index "owner_deletions_owner_id_key" to table: "owner_deletions"
+CREATE UNIQUE INDEX "owner_deletions_owner_id_key" ON "owner_deletions" ("owner_id");
+-- create index "ownerdeletion_completed_at" to table: "owner_deletions"
+CREATE INDEX "ownerdeletion_completed_at" ON "owner_deletions" ("completed_at");
+
+-- +goose Down
+-- reverse: create index "ownerdeletion_completed_at" to table: "owner_deletions"
+DROP INDEX "ownerdeletion_completed_at";
+-- reverse: create index "owner_deletions_owner_id_key" to table: "owner_deletions"
+DROP INDEX "owner_deletions_owner_id_key";
+-- reverse: create "owner_deletions" table
+DROP TABLE "owner_deletions";
@@ -0,0 +1,7 @@
+-- +goose Up
+-- create index "auditevent_related_ids" to table: "audit_events"
+CREATE INDEX "auditevent_related_ids" ON "audit_events" USING GIN ("related_ids");
+
+-- +goose Down
+-- reverse: create index "auditevent_related_ids" to table: "audit_events"
+DROP INDEX "auditevent_related_ids";
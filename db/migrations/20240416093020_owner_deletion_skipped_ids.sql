@@ -0,0 +1,7 @@
+-- +goose Up
+-- modify "owner_deletions" table
+ALTER TABLE "owner_deletions" ADD COLUMN "skipped_ids" jsonb NULL;
+
+-- +goose Down
+-- reverse: modify "owner_deletions" table
+ALTER TABLE "owner_deletions" DROP COLUMN "skipped_ids";
@@ -0,0 +1,11 @@
+-- +goose Up
+-- create "pending_changes" table
+CREATE TABLE "pending_changes" ("id" character varying NOT NULL, "subject" character varying NOT NULL, "message" jsonb NOT NULL, "attempts" bigint NOT NULL DEFAULT 0, "last_error" character varying NULL, "created_at" timestamptz NOT NULL, "next_attempt_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "pendingchange_next_attempt_at" to table: "pending_changes"
+CREATE INDEX "pendingchange_next_attempt_at" ON "pending_changes" ("next_attempt_at");
+
+-- +goose Down
+-- reverse: create index "pendingchange_next_attempt_at" to table: "pending_changes"
+DROP INDEX "pendingchange_next_attempt_at";
+-- reverse: create "pending_changes" table
+DROP TABLE "pending_changes";
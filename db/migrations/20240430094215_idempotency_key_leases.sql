@@ -0,0 +1,7 @@
+-- +goose Up
+-- modify "idempotency_keys" table
+ALTER TABLE "idempotency_keys" ADD COLUMN "lease_expires_at" timestamptz NULL;
+
+-- +goose Down
+-- reverse: modify "idempotency_keys" table
+ALTER TABLE "idempotency_keys" DROP COLUMN "lease_expires_at";
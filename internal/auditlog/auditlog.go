@@ -0,0 +1,63 @@
+// Package auditlog persists the field level changesets of load balancer resources as audit events
+package auditlog
+
+import (
+	"context"
+	"fmt"
+
+	"go.infratographer.com/x/echojwtx"
+	"go.infratographer.com/x/events"
+	"go.infratographer.com/x/gidx"
+	"golang.org/x/exp/slices"
+
+	"go.infratographer.com/load-balancer-api/internal/ent/generated"
+)
+
+const unknownActor = "unknown-actor"
+
+// RecordChange records the message of the generated event hooks, see Record
+func RecordChange(ctx context.Context, c *generated.Client, msg *events.ChangeMessage, _ any) error {
+	if err := Record(ctx, c, *msg); err != nil {
+		return fmt.Errorf("failed to record audit event: %w", err)
+	}
+
+	return nil
+}
+
+// Record persists the change message as an audit event. The client should be the client of
+// the mutation that produced the change so the audit event is stored in the same transaction.
+func Record(ctx context.Context, c *generated.Client, msg events.ChangeMessage) error {
+	actor := unknownActor
+
+	if id, ok := ctx.Value(echojwtx.ActorCtxKey).(string); ok {
+		actor = id
+	}
+
+	relatedIDs := []gidx.PrefixedID{msg.SubjectID}
+
+	for _, id := range msg.AdditionalSubjectIDs {
+		if !slices.Contains(relatedIDs, id) {
+			relatedIDs = append(relatedIDs, id)
+		}
+	}
+
+	changeset := msg.FieldChanges
+	if changeset == nil {
+		changeset = []events.FieldChange{}
+	}
+
+	fields := make([]string, 0, len(changeset))
+	for _, fc := range changeset {
+		fields = append(fields, fc.Field)
+	}
+
+	return c.AuditEvent.Create().
+		SetCreatedAt(msg.Timestamp).
+		SetActor(actor).
+		SetOperation(msg.EventType).
+		SetSubjectID(msg.SubjectID).
+		SetRelatedIds(relatedIDs).
+		SetFields(fields).
+		SetChangeset(changeset).
+		Exec(ctx)
+}
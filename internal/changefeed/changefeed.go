@@ -0,0 +1,156 @@
+// Package changefeed fans out the change messages received from the message bus to in-process subscribers
+package changefeed
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.infratographer.com/x/events"
+	"go.uber.org/zap"
+)
+
+// DefaultBufferSize is the number of changes buffered for each subscriber
+const DefaultBufferSize = 64
+
+// DefaultTopics are the change topics of every event type for the resources published by the ent hooks
+var DefaultTopics = []string{
+	"*.load-balancer",
+	"*.load-balancer-origin",
+	"*.load-balancer-pool",
+	"*.load-balancer-port",
+	"*.load-balancer-provider",
+}
+
+// Change is a change message together with the message bus subject it was received on
+type Change struct {
+	Topic   string
+	Message events.ChangeMessage
+}
+
+// Feed delivers every change received on its change topics to all current subscribers. As the
+// changes are read from the message bus, subscribers receive the changes made by every process
+// publishing to it, as long as each feed reads the topics with its own consumer.
+type Feed struct {
+	logger     *zap.SugaredLogger
+	bufferSize int
+
+	mu   sync.RWMutex
+	subs map[chan Change]struct{}
+}
+
+// Option is a function that modifies a feed
+type Option func(*Feed)
+
+// WithLogger sets the logger of the feed
+func WithLogger(l *zap.SugaredLogger) Option {
+	return func(f *Feed) {
+		f.logger = l
+	}
+}
+
+// WithBufferSize sets the number of changes buffered for each subscriber
+func WithBufferSize(size int) Option {
+	return func(f *Feed) {
+		f.bufferSize = size
+	}
+}
+
+// New returns a feed without subscribers
+func New(opts ...Option) *Feed {
+	f := &Feed{
+		logger:     zap.NewNop().Sugar(),
+		bufferSize: DefaultBufferSize,
+		subs:       map[chan Change]struct{}{},
+	}
+
+	for _, opt := range opts {
+		opt(f)
+	}
+
+	return f
+}
+
+// Start subscribes to the given change topics and delivers the received changes to the
+// subscribers of the feed until ctx is done. It returns once all subscriptions are started.
+func (f *Feed) Start(ctx context.Context, sub events.Subscriber, topics ...string) error {
+	ctx, cancel := context.WithCancel(ctx)
+
+	for _, topic := range topics {
+		msgs, err := sub.SubscribeChanges(ctx, topic)
+		if err != nil {
+			// stop the subscriptions already started
+			cancel()
+
+			return fmt.Errorf("subscribing to change topic %s: %w", topic, err)
+		}
+
+		go f.receive(ctx, msgs)
+	}
+
+	// the subscriptions stop with the parent context
+	context.AfterFunc(ctx, cancel)
+
+	f.logger.Infow("change feed started", "topics", topics)
+
+	return nil
+}
+
+// receive delivers the changes received on a subscription until ctx is done
+func (f *Feed) receive(ctx context.Context, msgs <-chan events.Message[events.ChangeMessage]) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-msgs:
+			if !ok {
+				return
+			}
+
+			if err := msg.Error(); err != nil {
+				f.logger.Warnw("skipping change that could not be decoded", "error", err, "topic", msg.Topic())
+			} else {
+				f.publish(Change{Topic: msg.Topic(), Message: msg.Message()})
+			}
+
+			// the feed only serves live subscribers, a change is never delivered again
+			if err := msg.Ack(); err != nil {
+				f.logger.Warnw("failed to acknowledge change", "error", err, "topic", msg.Topic())
+			}
+		}
+	}
+}
+
+// Subscribe returns a channel receiving all changes received from now on. The channel is
+// closed once ctx is done. Changes are dropped when the subscriber does not keep up.
+func (f *Feed) Subscribe(ctx context.Context) <-chan Change {
+	ch := make(chan Change, f.bufferSize)
+
+	f.mu.Lock()
+	f.subs[ch] = struct{}{}
+	f.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		f.mu.Lock()
+		delete(f.subs, ch)
+		close(ch)
+		f.mu.Unlock()
+	}()
+
+	return ch
+}
+
+func (f *Feed) publish(change Change) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	for ch := range f.subs {
+		select {
+		case ch <- change:
+		default:
+			f.logger.Warnw("dropping change for slow subscriber", "topic", change.Topic, "subjectID", change.Message.SubjectID)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package changefeed_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.infratographer.com/x/events"
+	"go.infratographer.com/x/gidx"
+	"go.infratographer.com/x/testing/eventtools"
+
+	"go.infratographer.com/load-balancer-api/internal/changefeed"
+)
+
+const changeTimeout = 5 * time.Second
+
+// newConnection returns a connection to the test nats server consuming with the given queue group
+func newConnection(t *testing.T, srv *eventtools.TestNats, queueGroup string) events.Connection {
+	cfg := srv.Config
+	cfg.NATS.QueueGroup = queueGroup
+	cfg.NATS.SubscriberDeliveryPolicy = "new"
+
+	conn, err := events.NewConnection(cfg)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = conn.Shutdown(context.Background())
+	})
+
+	return conn
+}
+
+func receive(t *testing.T, changes <-chan changefeed.Change) changefeed.Change {
+	select {
+	case change, ok := <-changes:
+		require.True(t, ok, "changes closed unexpectedly")
+
+		return change
+	case <-time.After(changeTimeout):
+		require.FailNow(t, "no change received")
+	}
+
+	return changefeed.Change{}
+}
+
+func TestFeed(t *testing.T) {
+	srv, err := eventtools.NewNatsServer()
+	require.NoError(t, err)
+
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	publisher := newConnection(t, srv, "publisher")
+
+	// every replica reads the changes with its own consumer and receives all of them
+	feeds := []*changefeed.Feed{
+		changefeed.New(changefeed.WithBufferSize(1)),
+		changefeed.New(changefeed.WithBufferSize(1)),
+	}
+
+	var replicas []<-chan changefeed.Change
+
+	for i, feed := range feeds {
+		require.NoError(t, feed.Start(ctx, newConnection(t, srv, "replica-"+string(rune('a'+i))), changefeed.DefaultTopics...))
+
+		replicas = append(replicas, feed.Subscribe(ctx))
+	}
+
+	first := events.ChangeMessage{SubjectID: gidx.MustNewID("loadbal"), EventType: "create"}
+	second := events.ChangeMessage{SubjectID: gidx.MustNewID("loadpol"), EventType: "update"}
+
+	_, err = publisher.PublishChange(ctx, "load-balancer", first)
+	require.NoError(t, err)
+
+	for _, changes := range replicas {
+		change := receive(t, changes)
+		assert.Equal(t, eventtools.Prefix+".changes.create.load-balancer", change.Topic)
+		assert.Equal(t, first.SubjectID, change.Message.SubjectID)
+		assert.Equal(t, first.EventType, change.Message.EventType)
+	}
+
+	_, err = publisher.PublishChange(ctx, "load-balancer-pool", second)
+	require.NoError(t, err)
+
+	for _, changes := range replicas {
+		change := receive(t, changes)
+		assert.Equal(t, eventtools.Prefix+".changes.update.load-balancer-pool", change.Topic)
+		assert.Equal(t, second.SubjectID, change.Message.SubjectID)
+	}
+
+	cancel()
+
+	for _, changes := range replicas {
+		select {
+		case _, ok := <-changes:
+			assert.False(t, ok, "no further changes expected")
+		case <-time.After(changeTimeout):
+			require.FailNow(t, "channel not closed after the context was canceled")
+		}
+	}
+}
+
+func TestFeedDropsChangesForSlowSubscribers(t *testing.T) {
+	srv, err := eventtools.NewNatsServer()
+	require.NoError(t, err)
+
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	publisher := newConnection(t, srv, "publisher")
+
+	feed := changefeed.New(changefeed.WithBufferSize(1))
+	require.NoError(t, feed.Start(ctx, newConnection(t, srv, "replica"), changefeed.DefaultTopics...))
+
+	slow := feed.Subscribe(ctx)
+
+	first := events.ChangeMessage{SubjectID: gidx.MustNewID("loadbal"), EventType: "create"}
+	second := events.ChangeMessage{SubjectID: gidx.MustNewID("loadbal"), EventType: "create"}
+
+	_, err = publisher.PublishChange(ctx, "load-balancer", first)
+	require.NoError(t, err)
+
+	// the buffer of the slow subscriber is full, the second change is dropped for it
+	// but still delivered to other subscribers
+	require.Eventually(t, func() bool { return len(slow) == 1 }, changeTimeout, 10*time.Millisecond)
+
+	fast := feed.Subscribe(ctx)
+
+	_, err = publisher.PublishChange(ctx, "load-balancer", second)
+	require.NoError(t, err)
+
+	assert.Equal(t, second.SubjectID, receive(t, fast).Message.SubjectID)
+	assert.Equal(t, first.SubjectID, receive(t, slow).Message.SubjectID)
+
+	select {
+	case change := <-slow:
+		assert.Failf(t, "unexpected change", "change %s should have been dropped", change.Message.SubjectID)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestFeedStartFailure(t *testing.T) {
+	srv, err := eventtools.NewNatsServer()
+	require.NoError(t, err)
+
+	defer srv.Close()
+
+	conn := newConnection(t, srv, "replica")
+	_ = conn.Shutdown(context.Background())
+
+	feed := changefeed.New()
+	assert.Error(t, feed.Start(context.Background(), conn, changefeed.DefaultTopics...))
+}
@@ -30,6 +30,15 @@ var AppConfig struct {
 	RestrictedPorts          []int
 	Supergraph               SupergraphConfig
 	ExtraPermissionRelations map[string][]PermissionRelation
+	Purge                    PurgeConfig
+	OriginTargetPolicy       OriginTargetPolicyConfig `mapstructure:"origin-target-policy"`
+	IdempotencyKeyTTL        time.Duration            `mapstructure:"idempotency-key-ttl"`
+	IdempotencyKeyLease      time.Duration            `mapstructure:"idempotency-key-lease"`
+	Worker                   WorkerConfig
+	Resync                   ResyncConfig
+	EventSnapshots           EventSnapshotsConfig   `mapstructure:"event-snapshots"`
+	QueryLimits              QueryLimitsConfig      `mapstructure:"query-limits"`
+	PersistedQueries         PersistedQueriesConfig `mapstructure:"persisted-queries"`
 }
 
 // MetadataConfig stores the configuration for metadata
@@ -43,6 +52,32 @@ type SupergraphConfig struct {
 	Timeout time.Duration
 }
 
+// PurgeConfig stores the configuration for purging soft deleted records
+type PurgeConfig struct {
+	Retention time.Duration
+	BatchSize int `mapstructure:"batch-size"`
+}
+
+// WorkerConfig stores the configuration for the event consumer worker
+type WorkerConfig struct {
+	ChangeTopics  []string      `mapstructure:"change-topics"`
+	EventTopics   []string      `mapstructure:"event-topics"`
+	MaxDeliveries int           `mapstructure:"max-deliveries"`
+	RetryDelay    time.Duration `mapstructure:"retry-delay"`
+}
+
+// OriginTargetPolicyConfig stores the ranges origin targets are not allowed to be within
+type OriginTargetPolicyConfig struct {
+	DeniedCIDRs []string `mapstructure:"denied-cidrs"`
+	Providers   []ProviderTargetPolicyConfig
+}
+
+// ProviderTargetPolicyConfig stores additional denied ranges for a single provider, added to the global list
+type ProviderTargetPolicyConfig struct {
+	ID          gidx.PrefixedID
+	DeniedCIDRs []string `mapstructure:"denied-cidrs"`
+}
+
 // OIDCClientConfig stores the configuration for an OIDC client
 type OIDCClientConfig struct {
 	Config oauth2x.Config `mapstructure:"client"`
@@ -53,3 +88,43 @@ type PermissionRelation struct {
 	Relation  string
 	SubjectID string
 }
+
+// ExtraRelationships returns the extra permission relations configured for the resource type
+func ExtraRelationships(resourceType string) []events.AuthRelationshipRelation {
+	relations := []events.AuthRelationshipRelation{}
+
+	for _, p := range AppConfig.ExtraPermissionRelations[resourceType] {
+		relations = append(relations, events.AuthRelationshipRelation{
+			Relation:  p.Relation,
+			SubjectID: gidx.PrefixedID(p.SubjectID),
+		})
+	}
+
+	return relations
+}
+
+// ResyncConfig stores the configuration for republishing live resources
+type ResyncConfig struct {
+	Rate      int
+	BatchSize int `mapstructure:"batch-size"`
+}
+
+// EventSnapshotsConfig stores the configuration for embedding resource snapshots in published changes
+type EventSnapshotsConfig struct {
+	Enabled bool
+	MaxSize int `mapstructure:"max-size"`
+}
+
+// QueryLimitsConfig stores the limits graphql operations are checked against, zero disables a limit
+type QueryLimitsConfig struct {
+	MaxDepth      int `mapstructure:"max-depth"`
+	MaxComplexity int `mapstructure:"max-complexity"`
+	MaxPageSize   int `mapstructure:"max-page-size"`
+}
+
+// PersistedQueriesConfig stores the configuration for automatic persisted queries
+type PersistedQueriesConfig struct {
+	CacheSize int `mapstructure:"cache-size"`
+	// AllowlistDir enables the allowlist, only the operations of the .graphql files in it are accepted
+	AllowlistDir string `mapstructure:"allowlist-dir"`
+}
@@ -3,13 +3,17 @@
 package main
 
 import (
+	"fmt"
 	"log"
 
 	"entgo.io/contrib/entgql"
 	"entgo.io/ent/entc"
 	"entgo.io/ent/entc/gen"
 	"entgo.io/ent/schema/field"
+	"github.com/vektah/gqlparser/v2/ast"
 	"go.infratographer.com/x/entx"
+
+	"go.infratographer.com/load-balancer-api/x/pubsubinfo"
 )
 
 func main() {
@@ -22,15 +26,16 @@ func main() {
 		log.Fatalf("creating entx extension: %v", err)
 	}
 
-	// load-balancer-api currently uses manual maintained hooks, however, if the schema changes, we'll need to enable the pubsubExt
-	// to re-generate the hooks and merge the generated changes with the manual hooks.
-
-	// pubsubExt, err := pubsubinfo.NewExtension(
-	// 	pubsubinfo.WithEventHooks(),
-	// )
-	// if err != nil {
-	// 	log.Fatalf("creating pubsubinfo extension: %v", err)
-	// }
+	pubsubExt, err := pubsubinfo.NewExtension(
+		pubsubinfo.WithEventHooks(
+			pubsubinfo.WithMessageHandler("go.infratographer.com/load-balancer-api/internal/auditlog", "RecordChange"),
+			pubsubinfo.WithMessageHandler("go.infratographer.com/load-balancer-api/internal/snapshot", "AttachChange"),
+			pubsubinfo.WithExtraRelations("go.infratographer.com/load-balancer-api/internal/config", "ExtraRelationships"),
+		),
+	)
+	if err != nil {
+		log.Fatalf("creating pubsubinfo extension: %v", err)
+	}
 
 	gqlExt, err := entgql.NewExtension(
 		// Tell Ent to generate a GraphQL schema for
@@ -40,6 +45,10 @@ func main() {
 		entgql.WithConfigPath("gqlgen.yml"),
 		entgql.WithWhereInputs(true),
 		entgql.WithSchemaHook(xExt.GQLSchemaHooks()...),
+		entgql.WithSchemaHook(
+			includeDeletedArg("LoadBalancer", "ports"),
+			includeDeletedArg("LoadBalancerPool", "origins"),
+		),
 	)
 	if err != nil {
 		log.Fatalf("creating entgql extension: %v", err)
@@ -49,7 +58,7 @@ func main() {
 		entc.Extensions(
 			xExt,
 			gqlExt,
-			// pubsubExt,
+			pubsubExt,
 		),
 		entc.TemplateDir("./internal/ent/templates"),
 		entc.FeatureNames("intercept"),
@@ -71,3 +80,35 @@ func main() {
 		log.Fatalf("running ent codegen: %v", err)
 	}
 }
+
+// includeDeletedArg adds an includeDeleted argument to a connection field and forces
+// a resolver for the field so soft deleted records can be returned when requested.
+func includeDeletedArg(typeName, fieldName string) entgql.SchemaHook {
+	return func(_ *gen.Graph, s *ast.Schema) error {
+		t, ok := s.Types[typeName]
+		if !ok {
+			return fmt.Errorf("failed to find %s type in schema", typeName)
+		}
+
+		f := t.Fields.ForName(fieldName)
+		if f == nil {
+			return fmt.Errorf("failed to find %s field on %s type in schema", fieldName, typeName)
+		}
+
+		f.Arguments = append(f.Arguments, &ast.ArgumentDefinition{
+			Name:         "includeDeleted",
+			Description:  "Include soft deleted elements in the list.",
+			Type:         ast.NamedType("Boolean", nil),
+			DefaultValue: &ast.Value{Raw: "false", Kind: ast.BooleanValue},
+		})
+
+		f.Directives = append(f.Directives, &ast.Directive{
+			Name: "goField",
+			Arguments: ast.ArgumentList{
+				{Name: "forceResolver", Value: &ast.Value{Raw: "true", Kind: ast.BooleanValue}},
+			},
+		})
+
+		return nil
+	}
+}
@@ -0,0 +1,3 @@
+// Package eventhooks tests the event hooks generated into internal/ent/generated/eventhooks. The
+// tests live outside the generated directory so regenerating the hooks leaves them in place.
+package eventhooks
@@ -1,7 +1,8 @@
-package manualhooks_test
+package eventhooks_test
 
 import (
 	"context"
+	"errors"
 	"os"
 	"testing"
 	"time"
@@ -14,7 +15,10 @@ import (
 	"go.infratographer.com/x/gidx"
 
 	"go.infratographer.com/load-balancer-api/internal/config"
-	"go.infratographer.com/load-balancer-api/internal/manualhooks"
+	ent "go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/eventhooks"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/loadbalancer"
+	"go.infratographer.com/load-balancer-api/internal/snapshot"
 	"go.infratographer.com/load-balancer-api/internal/testutils"
 )
 
@@ -51,7 +55,7 @@ func Test_LoadbalancerCreateHook(t *testing.T) {
 	changesChannel, err := testutils.EventsConn.SubscribeChanges(ctx, "create.load-balancer")
 	require.NoError(t, err, "failed to subscribe to changes")
 
-	testutils.EntClient.LoadBalancer.Use(manualhooks.LoadBalancerHooks()...)
+	testutils.EntClient.LoadBalancer.Use(eventhooks.LoadBalancerHooks()...)
 
 	// Act
 	lb := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
@@ -94,7 +98,7 @@ func Test_LoadbalancerCreateHook_Permissions(t *testing.T) {
 	}
 
 	// Mock Events
-	testutils.EntClient.LoadBalancer.Use(manualhooks.LoadBalancerHooks()...)
+	testutils.EntClient.LoadBalancer.Use(eventhooks.LoadBalancerHooks()...)
 
 	// Act
 	lb := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
@@ -127,7 +131,7 @@ func Test_LoadbalancerUpdateHook(t *testing.T) {
 
 	lb := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
 
-	testutils.EntClient.LoadBalancer.Use(manualhooks.LoadBalancerHooks()...)
+	testutils.EntClient.LoadBalancer.Use(eventhooks.LoadBalancerHooks()...)
 
 	// Act
 	testutils.EntClient.LoadBalancer.UpdateOne(lb).SetName(("other-lb-name")).ExecX(ctx)
@@ -152,7 +156,7 @@ func Test_LoadbalancerDeleteHook(t *testing.T) {
 
 	lb := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
 
-	testutils.EntClient.LoadBalancer.Use(manualhooks.LoadBalancerHooks()...)
+	testutils.EntClient.LoadBalancer.Use(eventhooks.LoadBalancerHooks()...)
 
 	// Act
 	testutils.EntClient.LoadBalancer.DeleteOneID(lb.ID).ExecX(ctx)
@@ -179,7 +183,7 @@ func Test_OriginCreateHook(t *testing.T) {
 	pool := (&testutils.PoolBuilder{}).MustNew(ctx)
 	(&testutils.PortBuilder{PoolIDs: []gidx.PrefixedID{pool.ID}, LoadBalancerID: lb.ID}).MustNew(ctx)
 
-	testutils.EntClient.Origin.Use(manualhooks.OriginHooks()...)
+	testutils.EntClient.Origin.Use(eventhooks.OriginHooks()...)
 
 	// Act
 	origin := (&testutils.OriginBuilder{PoolID: pool.ID}).MustNew(ctx)
@@ -195,6 +199,28 @@ func Test_OriginCreateHook(t *testing.T) {
 	assert.Equal(t, createEventType, msg.Message().EventType)
 }
 
+func Test_OriginCreateHook_Permissions(t *testing.T) {
+	// Arrange
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx := perms.ContextWithHandler(context.Background())
+
+	pool := (&testutils.PoolBuilder{}).MustNew(ctx)
+
+	testutils.EntClient.Origin.Use(eventhooks.OriginHooks()...)
+
+	// Act
+	origin := (&testutils.OriginBuilder{PoolID: pool.ID}).MustNew(ctx)
+
+	// Assert
+	expectedRelation := events.AuthRelationshipRelation{
+		Relation: "pool", SubjectID: pool.ID,
+	}
+
+	perms.AssertCalled(t, "CreateAuthRelationships", "load-balancer-origin", origin.ID, expectedRelation)
+}
+
 func Test_OriginUpdateHook(t *testing.T) {
 	// Arrange
 	ctx := testutils.MockPermissions(context.Background())
@@ -207,7 +233,7 @@ func Test_OriginUpdateHook(t *testing.T) {
 	(&testutils.PortBuilder{PoolIDs: []gidx.PrefixedID{pool.ID}, LoadBalancerID: lb.ID}).MustNew(ctx)
 	origin := (&testutils.OriginBuilder{PoolID: pool.ID}).MustNew(ctx)
 
-	testutils.EntClient.Origin.Use(manualhooks.OriginHooks()...)
+	testutils.EntClient.Origin.Use(eventhooks.OriginHooks()...)
 
 	// Act
 	testutils.EntClient.Origin.UpdateOne(origin).SetName("other-origin-name").ExecX(ctx)
@@ -235,7 +261,7 @@ func Test_OriginDeleteHook(t *testing.T) {
 	(&testutils.PortBuilder{PoolIDs: []gidx.PrefixedID{pool.ID}, LoadBalancerID: lb.ID}).MustNew(ctx)
 	origin := (&testutils.OriginBuilder{PoolID: pool.ID}).MustNew(ctx)
 
-	testutils.EntClient.Origin.Use(manualhooks.OriginHooks()...)
+	testutils.EntClient.Origin.Use(eventhooks.OriginHooks()...)
 
 	// Act
 	testutils.EntClient.Origin.DeleteOne(origin).ExecX(ctx)
@@ -258,7 +284,7 @@ func Test_PoolCreateHook(t *testing.T) {
 	changesChannel, err := testutils.EventsConn.SubscribeChanges(ctx, "create.load-balancer-pool")
 	require.NoError(t, err, "failed to subscribe to changes")
 
-	testutils.EntClient.Pool.Use(manualhooks.PoolHooks()...)
+	testutils.EntClient.Pool.Use(eventhooks.PoolHooks()...)
 
 	// Act
 	pool := (&testutils.PoolBuilder{}).MustNew(ctx)
@@ -286,7 +312,7 @@ func Test_PoolUpdateHook(t *testing.T) {
 	port := (&testutils.PortBuilder{PoolIDs: []gidx.PrefixedID{pool.ID}, LoadBalancerID: lb.ID}).MustNew(ctx)
 	origin := (&testutils.OriginBuilder{PoolID: pool.ID}).MustNew(ctx)
 
-	testutils.EntClient.Pool.Use(manualhooks.PoolHooks()...)
+	testutils.EntClient.Pool.Use(eventhooks.PoolHooks()...)
 
 	// Act
 	testutils.EntClient.Pool.UpdateOne(pool).SetName("other-pool-name").ExecX(ctx)
@@ -313,7 +339,7 @@ func Test_PoolDeleteHook(t *testing.T) {
 	pool := (&testutils.PoolBuilder{}).MustNew(ctx)
 	(&testutils.PortBuilder{PoolIDs: []gidx.PrefixedID{pool.ID}, LoadBalancerID: lb.ID}).MustNew(ctx)
 
-	testutils.EntClient.Pool.Use(manualhooks.PoolHooks()...)
+	testutils.EntClient.Pool.Use(eventhooks.PoolHooks()...)
 
 	// Act
 	testutils.EntClient.Pool.DeleteOne(pool).ExecX(ctx)
@@ -336,7 +362,7 @@ func Test_PortCreateHook(t *testing.T) {
 	changesChannel, err := testutils.EventsConn.SubscribeChanges(ctx, "create.load-balancer-port")
 	require.NoError(t, err, "failed to subscribe to changes")
 
-	testutils.EntClient.Port.Use(manualhooks.PortHooks()...)
+	testutils.EntClient.Port.Use(eventhooks.PortHooks()...)
 
 	t.Run("with pool", func(t *testing.T) {
 		// Act
@@ -390,6 +416,28 @@ func Test_PortCreateHook(t *testing.T) {
 	})
 }
 
+func Test_PortCreateHook_Permissions(t *testing.T) {
+	// Arrange
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx := perms.ContextWithHandler(context.Background())
+
+	lb := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+
+	testutils.EntClient.Port.Use(eventhooks.PortHooks()...)
+
+	// Act
+	port := (&testutils.PortBuilder{PoolIDs: []gidx.PrefixedID{}, LoadBalancerID: lb.ID}).MustNew(ctx)
+
+	// Assert
+	expectedRelation := events.AuthRelationshipRelation{
+		Relation: "loadbalancer", SubjectID: lb.ID,
+	}
+
+	perms.AssertCalled(t, "CreateAuthRelationships", "load-balancer-port", port.ID, expectedRelation)
+}
+
 func Test_PortUpdateHook(t *testing.T) {
 	// Arrange
 	ctx := testutils.MockPermissions(context.Background())
@@ -401,7 +449,7 @@ func Test_PortUpdateHook(t *testing.T) {
 	pool := (&testutils.PoolBuilder{}).MustNew(ctx)
 	port := (&testutils.PortBuilder{PoolIDs: []gidx.PrefixedID{pool.ID}, LoadBalancerID: lb.ID}).MustNew(ctx)
 
-	testutils.EntClient.Port.Use(manualhooks.PortHooks()...)
+	testutils.EntClient.Port.Use(eventhooks.PortHooks()...)
 
 	// Act
 	testutils.EntClient.Port.UpdateOne(port).SetName("other-port-name").ExecX(ctx)
@@ -428,7 +476,7 @@ func Test_PortDeleteHook(t *testing.T) {
 	pool := (&testutils.PoolBuilder{}).MustNew(ctx)
 	port := (&testutils.PortBuilder{PoolIDs: []gidx.PrefixedID{pool.ID}, LoadBalancerID: lb.ID}).MustNew(ctx)
 
-	testutils.EntClient.Port.Use(manualhooks.PortHooks()...)
+	testutils.EntClient.Port.Use(eventhooks.PortHooks()...)
 
 	// Act
 	testutils.EntClient.Port.DeleteOne(port).ExecX(ctx)
@@ -444,6 +492,129 @@ func Test_PortDeleteHook(t *testing.T) {
 	assert.Equal(t, deleteEventType, msg.Message().EventType)
 }
 
+func Test_ProviderCreateHook(t *testing.T) {
+	// Arrange
+	ctx := testutils.MockPermissions(context.Background())
+
+	changesChannel, err := testutils.EventsConn.SubscribeChanges(ctx, "create.load-balancer-provider")
+	require.NoError(t, err, "failed to subscribe to changes")
+
+	testutils.EntClient.Provider.Use(eventhooks.ProviderHooks()...)
+
+	// Act
+	prov := (&testutils.ProviderBuilder{}).MustNew(ctx)
+
+	msg := testutils.ChannelReceiveWithTimeout[events.Message[events.ChangeMessage]](t, changesChannel, defaultTimeout)
+
+	// Assert
+	expectedAdditionalSubjectIDs := []gidx.PrefixedID{prov.OwnerID}
+	actualAdditionalSubjectIDs := msg.Message().AdditionalSubjectIDs
+
+	assert.ElementsMatch(t, expectedAdditionalSubjectIDs, actualAdditionalSubjectIDs)
+	assert.Equal(t, prov.ID, msg.Message().SubjectID)
+	assert.Equal(t, createEventType, msg.Message().EventType)
+}
+
+func Test_ProviderCreateHook_Permissions(t *testing.T) {
+	// Arrange
+	const ownerString = "owner"
+	const parentString = "parent"
+	const subj1 = "subjct-1234567"
+
+	// Mock Permissions
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx := perms.ContextWithHandler(context.Background())
+
+	// Populate actual config
+	config.AppConfig.ExtraPermissionRelations = map[string][]config.PermissionRelation{
+		"provider": {
+			config.PermissionRelation{
+				Relation: parentString, SubjectID: subj1,
+			},
+		},
+	}
+
+	// Mock Events
+	testutils.EntClient.Provider.Use(eventhooks.ProviderHooks()...)
+
+	// Act
+	prov := (&testutils.ProviderBuilder{}).MustNew(ctx)
+
+	expectedRelation1 := events.AuthRelationshipRelation{
+		Relation: ownerString, SubjectID: prov.OwnerID,
+	}
+
+	expectedRelation2 := events.AuthRelationshipRelation{
+		Relation: parentString, SubjectID: subj1,
+	}
+
+	// Assert
+	perms.AssertCalled(t, "CreateAuthRelationships", "load-balancer-provider", prov.ID, expectedRelation1, expectedRelation2)
+
+	// Cleanup
+	config.AppConfig.ExtraPermissionRelations = map[string][]config.PermissionRelation{}
+}
+
+func Test_ProviderUpdateHook(t *testing.T) {
+	// Arrange
+	ctx := testutils.MockPermissions(context.Background())
+
+	changesChannel, err := testutils.EventsConn.SubscribeChanges(ctx, "update.load-balancer-provider")
+	require.NoError(t, err, "failed to subscribe to changes")
+
+	prov := (&testutils.ProviderBuilder{}).MustNew(ctx)
+
+	testutils.EntClient.Provider.Use(eventhooks.ProviderHooks()...)
+
+	// Act
+	testutils.EntClient.Provider.UpdateOne(prov).SetName("other-provider-name").ExecX(ctx)
+
+	msg := testutils.ChannelReceiveWithTimeout[events.Message[events.ChangeMessage]](t, changesChannel, defaultTimeout)
+
+	// Assert
+	expectedAdditionalSubjectIDs := []gidx.PrefixedID{prov.OwnerID}
+	actualAdditionalSubjectIDs := msg.Message().AdditionalSubjectIDs
+
+	assert.ElementsMatch(t, expectedAdditionalSubjectIDs, actualAdditionalSubjectIDs)
+	assert.Equal(t, prov.ID, msg.Message().SubjectID)
+	assert.Equal(t, updateEventType, msg.Message().EventType)
+}
+
+func Test_ProviderDeleteHook(t *testing.T) {
+	// Arrange
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx := perms.ContextWithHandler(context.Background())
+
+	changesChannel, err := testutils.EventsConn.SubscribeChanges(ctx, "delete.load-balancer-provider")
+	require.NoError(t, err, "failed to subscribe to changes")
+
+	prov := (&testutils.ProviderBuilder{}).MustNew(ctx)
+
+	testutils.EntClient.Provider.Use(eventhooks.ProviderHooks()...)
+
+	// Act
+	testutils.EntClient.Provider.DeleteOne(prov).ExecX(ctx)
+
+	msg := testutils.ChannelReceiveWithTimeout[events.Message[events.ChangeMessage]](t, changesChannel, defaultTimeout)
+
+	// Assert
+	expectedAdditionalSubjectIDs := []gidx.PrefixedID{prov.OwnerID}
+	actualAdditionalSubjectIDs := msg.Message().AdditionalSubjectIDs
+
+	assert.ElementsMatch(t, expectedAdditionalSubjectIDs, actualAdditionalSubjectIDs)
+	assert.Equal(t, prov.ID, msg.Message().SubjectID)
+	assert.Equal(t, deleteEventType, msg.Message().EventType)
+
+	perms.AssertCalled(t, "DeleteAuthRelationships", "load-balancer-provider", prov.ID, events.AuthRelationshipRelation{
+		Relation: "owner", SubjectID: prov.OwnerID,
+	})
+}
+
 func Test_MultipleLoadbalancersSharedPoolAddOrigin(t *testing.T) {
 	// Scenario: 2 loadbalancers in different locations, with the same owner, share a pool.
 	// An origin is added to the shared pool.
@@ -463,7 +634,7 @@ func Test_MultipleLoadbalancersSharedPoolAddOrigin(t *testing.T) {
 	_ = (&testutils.PortBuilder{PoolIDs: []gidx.PrefixedID{pool.ID}, LoadBalancerID: lb1.ID}).MustNew(ctx)
 	_ = (&testutils.PortBuilder{PoolIDs: []gidx.PrefixedID{pool.ID}, LoadBalancerID: lb2.ID}).MustNew(ctx)
 
-	testutils.EntClient.Origin.Use(manualhooks.OriginHooks()...)
+	testutils.EntClient.Origin.Use(eventhooks.OriginHooks()...)
 
 	// Act - add another origin to the pool
 	ogn := (&testutils.OriginBuilder{PoolID: pool.ID}).MustNew(ctx)
@@ -508,7 +679,7 @@ func Test_MultipleLoadbalancersSharedPoolDeleteOrigin(t *testing.T) {
 	_ = (&testutils.OriginBuilder{PoolID: pool.ID}).MustNew(ctx)
 	ogn2 := (&testutils.OriginBuilder{PoolID: pool.ID}).MustNew(ctx)
 
-	testutils.EntClient.Origin.Use(manualhooks.OriginHooks()...)
+	testutils.EntClient.Origin.Use(eventhooks.OriginHooks()...)
 
 	// Act - update the pool to remove an origin
 	testutils.EntClient.Origin.DeleteOne(ogn2).ExecX(ctx)
@@ -531,3 +702,328 @@ func Test_MultipleLoadbalancersSharedPoolDeleteOrigin(t *testing.T) {
 	assert.Equal(t, ogn2.ID, msg.Message().SubjectID)
 	assert.Equal(t, deleteEventType, msg.Message().EventType)
 }
+
+func Test_TransactionChangesPublishedAfterCommit(t *testing.T) {
+	// Arrange
+	ctx := testutils.MockPermissions(context.Background())
+
+	changesChannel, err := testutils.EventsConn.SubscribeChanges(ctx, "create.load-balancer")
+	require.NoError(t, err, "failed to subscribe to changes")
+
+	testutils.EntClient.LoadBalancer.Use(eventhooks.LoadBalancerHooks()...)
+
+	prov := (&testutils.ProviderBuilder{}).MustNew(ctx)
+
+	tx, err := testutils.EntClient.Tx(ctx)
+	require.NoError(t, err, "failed to begin transaction")
+
+	// Act
+	lb := tx.LoadBalancer.Create().
+		SetName("lb-tx").
+		SetOwnerID(gidx.MustNewID(ownerPrefix)).
+		SetLocationID(gidx.MustNewID(locationPrefix)).
+		SetProvider(prov).
+		SaveX(ctx)
+
+	// Assert
+	assertNoChange(t, changesChannel, lb.ID)
+
+	require.NoError(t, tx.Commit())
+
+	msg := receiveChange(t, changesChannel, lb.ID)
+	assert.Equal(t, createEventType, msg.Message().EventType)
+}
+
+func Test_TransactionChangesDroppedOnRollback(t *testing.T) {
+	// Arrange
+	ctx := testutils.MockPermissions(context.Background())
+
+	changesChannel, err := testutils.EventsConn.SubscribeChanges(ctx, "create.load-balancer")
+	require.NoError(t, err, "failed to subscribe to changes")
+
+	testutils.EntClient.LoadBalancer.Use(eventhooks.LoadBalancerHooks()...)
+
+	prov := (&testutils.ProviderBuilder{}).MustNew(ctx)
+
+	tx, err := testutils.EntClient.Tx(ctx)
+	require.NoError(t, err, "failed to begin transaction")
+
+	// Act
+	lb := tx.LoadBalancer.Create().
+		SetName("lb-tx").
+		SetOwnerID(gidx.MustNewID(ownerPrefix)).
+		SetLocationID(gidx.MustNewID(locationPrefix)).
+		SetProvider(prov).
+		SaveX(ctx)
+
+	require.NoError(t, tx.Rollback())
+
+	// Assert
+	assertNoChange(t, changesChannel, lb.ID)
+}
+
+func Test_CreateFailsWhenRelationshipsFail(t *testing.T) {
+	// Arrange
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(errPermissionsUnavailable)
+
+	ctx := perms.ContextWithHandler(context.Background())
+
+	client := newHookedClient(t, testutils.EventsConn)
+
+	prov := (&testutils.ProviderBuilder{}).MustNew(testutils.MockPermissions(context.Background()))
+
+	// Act
+	_, err := client.LoadBalancer.Create().
+		SetName("lb-relationships").
+		SetOwnerID(gidx.MustNewID(ownerPrefix)).
+		SetLocationID(gidx.MustNewID(locationPrefix)).
+		SetProvider(prov).
+		Save(ctx)
+
+	// Assert
+	assert.ErrorIs(t, err, errPermissionsUnavailable)
+}
+
+func Test_CreateFailsWhenPublishFails(t *testing.T) {
+	// Arrange
+	ctx := testutils.MockPermissions(context.Background())
+
+	client := newHookedClient(t, failingPublisher{testutils.EventsConn})
+
+	prov := (&testutils.ProviderBuilder{}).MustNew(ctx)
+
+	// Act
+	_, err := client.LoadBalancer.Create().
+		SetName("lb-publish").
+		SetOwnerID(gidx.MustNewID(ownerPrefix)).
+		SetLocationID(gidx.MustNewID(locationPrefix)).
+		SetProvider(prov).
+		Save(ctx)
+
+	// Assert
+	assert.ErrorIs(t, err, errNATSUnavailable)
+}
+
+func Test_TransactionRolledBackWhenRelationshipsFail(t *testing.T) {
+	// Arrange
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(errPermissionsUnavailable)
+
+	ctx := perms.ContextWithHandler(context.Background())
+
+	changesChannel, err := testutils.EventsConn.SubscribeChanges(ctx, "create.load-balancer")
+	require.NoError(t, err, "failed to subscribe to changes")
+
+	client := newHookedClient(t, testutils.EventsConn)
+
+	prov := (&testutils.ProviderBuilder{}).MustNew(testutils.MockPermissions(context.Background()))
+
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err, "failed to begin transaction")
+
+	lb := tx.LoadBalancer.Create().
+		SetName("lb-tx").
+		SetOwnerID(gidx.MustNewID(ownerPrefix)).
+		SetLocationID(gidx.MustNewID(locationPrefix)).
+		SetProvider(prov).
+		SaveX(ctx)
+
+	// Act
+	err = tx.Commit()
+
+	// Assert
+	assert.ErrorIs(t, err, errPermissionsUnavailable)
+	assert.False(t, client.LoadBalancer.Query().Where(loadbalancer.ID(lb.ID)).ExistX(ctx), "the change is rolled back")
+
+	// the change is not published when its relationships could not be written
+	assertNoChange(t, changesChannel, lb.ID)
+}
+
+func Test_TransactionChangeStoredInOutboxWhenPublishFails(t *testing.T) {
+	// Arrange
+	ctx := testutils.MockPermissions(context.Background())
+
+	outbox := &fakeOutbox{}
+
+	client := newHookedClient(t, failingPublisher{testutils.EventsConn}, eventhooks.WithOutbox(outbox))
+
+	prov := (&testutils.ProviderBuilder{}).MustNew(ctx)
+
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err, "failed to begin transaction")
+
+	lb := tx.LoadBalancer.Create().
+		SetName("lb-tx").
+		SetOwnerID(gidx.MustNewID(ownerPrefix)).
+		SetLocationID(gidx.MustNewID(locationPrefix)).
+		SetProvider(prov).
+		SaveX(ctx)
+
+	// Act
+	err = tx.Commit()
+
+	// Assert
+	require.NoError(t, err, "the change is committed and published later")
+	assert.True(t, client.LoadBalancer.Query().Where(loadbalancer.ID(lb.ID)).ExistX(ctx))
+
+	require.Len(t, outbox.changes, 1)
+	assert.Equal(t, "load-balancer", outbox.changes[0].subject)
+	assert.Equal(t, lb.ID, outbox.changes[0].msg.SubjectID)
+	assert.Equal(t, createEventType, outbox.changes[0].msg.EventType)
+}
+
+func Test_TransactionCommitReturnsPublishErrorWithoutOutbox(t *testing.T) {
+	// Arrange
+	ctx := testutils.MockPermissions(context.Background())
+
+	client := newHookedClient(t, failingPublisher{testutils.EventsConn})
+
+	prov := (&testutils.ProviderBuilder{}).MustNew(ctx)
+
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err, "failed to begin transaction")
+
+	lb := tx.LoadBalancer.Create().
+		SetName("lb-tx").
+		SetOwnerID(gidx.MustNewID(ownerPrefix)).
+		SetLocationID(gidx.MustNewID(locationPrefix)).
+		SetProvider(prov).
+		SaveX(ctx)
+
+	// Act
+	err = tx.Commit()
+
+	// Assert
+	assert.ErrorIs(t, err, errNATSUnavailable)
+	assert.True(t, client.LoadBalancer.Query().Where(loadbalancer.ID(lb.ID)).ExistX(ctx), "the change is committed")
+}
+
+func Test_EventSnapshots(t *testing.T) {
+	// Arrange
+	ctx := testutils.MockPermissions(context.Background())
+
+	config.AppConfig.EventSnapshots = config.EventSnapshotsConfig{Enabled: true}
+	defer func() { config.AppConfig.EventSnapshots = config.EventSnapshotsConfig{} }()
+
+	changesChannel, err := testutils.EventsConn.SubscribeChanges(ctx, "update.load-balancer")
+	require.NoError(t, err, "failed to subscribe to changes")
+
+	testutils.EntClient.LoadBalancer.Use(eventhooks.LoadBalancerHooks()...)
+
+	lb := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+	p := (&testutils.PoolBuilder{}).MustNew(ctx)
+	prt := (&testutils.PortBuilder{LoadBalancerID: lb.ID, PoolIDs: []gidx.PrefixedID{p.ID}}).MustNew(ctx)
+
+	t.Run("load balancers are embedded with their tree", func(t *testing.T) {
+		// Act
+		testutils.EntClient.LoadBalancer.UpdateOne(lb).SetName("snapshot-lb").ExecX(ctx)
+
+		msg := receiveChange(t, changesChannel, lb.ID)
+
+		// Assert
+		data, ok := msg.Message().AdditionalData[snapshot.AdditionalDataKey].(map[string]interface{})
+		require.True(t, ok, "snapshot missing")
+
+		assert.Equal(t, "snapshot-lb", data["name"])
+
+		edges, ok := data["edges"].(map[string]interface{})
+		require.True(t, ok)
+
+		ports, ok := edges["ports"].([]interface{})
+		require.True(t, ok)
+		require.Len(t, ports, 1)
+		assert.Equal(t, prt.ID.String(), ports[0].(map[string]interface{})["id"])
+
+		assert.NotContains(t, msg.Message().AdditionalData, snapshot.TruncatedKey)
+	})
+
+	t.Run("snapshots over the size limit are left out", func(t *testing.T) {
+		config.AppConfig.EventSnapshots.MaxSize = 10
+
+		// the hooks may be registered more than once, use another load balancer so earlier changes aren't received
+		lb := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+
+		// Act
+		testutils.EntClient.LoadBalancer.UpdateOne(lb).SetName("truncated-lb").ExecX(ctx)
+
+		msg := receiveChange(t, changesChannel, lb.ID)
+
+		// Assert
+		assert.NotContains(t, msg.Message().AdditionalData, snapshot.AdditionalDataKey)
+		assert.Equal(t, true, msg.Message().AdditionalData[snapshot.TruncatedKey])
+	})
+}
+
+// receiveChange returns the next change message for the given subject, skipping changes for other subjects
+func receiveChange(t *testing.T, changes <-chan events.Message[events.ChangeMessage], subjectID gidx.PrefixedID) events.Message[events.ChangeMessage] {
+	for {
+		msg := testutils.ChannelReceiveWithTimeout[events.Message[events.ChangeMessage]](t, changes, defaultTimeout)
+		if msg.Message().SubjectID == subjectID {
+			return msg
+		}
+	}
+}
+
+// assertNoChange fails the test if a change message for the given subject is received within a second
+func assertNoChange(t *testing.T, changes <-chan events.Message[events.ChangeMessage], subjectID gidx.PrefixedID) {
+	timeout := time.After(time.Second)
+
+	for {
+		select {
+		case msg := <-changes:
+			if msg.Message().SubjectID == subjectID {
+				t.Fatalf("unexpected change published for %s", subjectID)
+			}
+		case <-timeout:
+			return
+		}
+	}
+}
+
+var (
+	errPermissionsUnavailable = errors.New("permissions-api unavailable")
+	errNATSUnavailable        = errors.New("nats unavailable")
+)
+
+// newHookedClient returns a client publishing with the publisher that only has the load balancer
+// hooks, so the hooks registered on the shared client don't interfere
+func newHookedClient(t *testing.T, publisher events.Connection, opts ...eventhooks.Option) *ent.Client {
+	t.Helper()
+
+	dia, uri, _ := testutils.ParseDBURI(context.Background())
+
+	client, err := ent.Open(dia, uri, ent.EventsPublisher(publisher))
+	require.NoError(t, err)
+
+	t.Cleanup(func() { client.Close() })
+
+	client.LoadBalancer.Use(eventhooks.LoadBalancerHooks(opts...)...)
+
+	return client
+}
+
+// failingPublisher is an events connection that can't publish changes
+type failingPublisher struct {
+	events.Connection
+}
+
+func (failingPublisher) PublishChange(context.Context, string, events.ChangeMessage) (events.Message[events.ChangeMessage], error) {
+	return nil, errNATSUnavailable
+}
+
+type outboxChange struct {
+	subject string
+	msg     events.ChangeMessage
+}
+
+// fakeOutbox records the changes added to it
+type fakeOutbox struct {
+	changes []outboxChange
+}
+
+func (o *fakeOutbox) Add(_ context.Context, subject string, msg events.ChangeMessage) error {
+	o.changes = append(o.changes, outboxChange{subject: subject, msg: msg})
+
+	return nil
+}
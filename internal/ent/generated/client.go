@@ -29,8 +29,12 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/auditevent"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/idempotencykey"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/loadbalancer"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/origin"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/ownerdeletion"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/pendingchange"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/pool"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/port"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/provider"
@@ -43,10 +47,18 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AuditEvent is the client for interacting with the AuditEvent builders.
+	AuditEvent *AuditEventClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
 	// LoadBalancer is the client for interacting with the LoadBalancer builders.
 	LoadBalancer *LoadBalancerClient
 	// Origin is the client for interacting with the Origin builders.
 	Origin *OriginClient
+	// OwnerDeletion is the client for interacting with the OwnerDeletion builders.
+	OwnerDeletion *OwnerDeletionClient
+	// PendingChange is the client for interacting with the PendingChange builders.
+	PendingChange *PendingChangeClient
 	// Pool is the client for interacting with the Pool builders.
 	Pool *PoolClient
 	// Port is the client for interacting with the Port builders.
@@ -66,8 +78,12 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AuditEvent = NewAuditEventClient(c.config)
+	c.IdempotencyKey = NewIdempotencyKeyClient(c.config)
 	c.LoadBalancer = NewLoadBalancerClient(c.config)
 	c.Origin = NewOriginClient(c.config)
+	c.OwnerDeletion = NewOwnerDeletionClient(c.config)
+	c.PendingChange = NewPendingChangeClient(c.config)
 	c.Pool = NewPoolClient(c.config)
 	c.Port = NewPortClient(c.config)
 	c.Provider = NewProviderClient(c.config)
@@ -162,13 +178,17 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:          ctx,
-		config:       cfg,
-		LoadBalancer: NewLoadBalancerClient(cfg),
-		Origin:       NewOriginClient(cfg),
-		Pool:         NewPoolClient(cfg),
-		Port:         NewPortClient(cfg),
-		Provider:     NewProviderClient(cfg),
+		ctx:            ctx,
+		config:         cfg,
+		AuditEvent:     NewAuditEventClient(cfg),
+		IdempotencyKey: NewIdempotencyKeyClient(cfg),
+		LoadBalancer:   NewLoadBalancerClient(cfg),
+		Origin:         NewOriginClient(cfg),
+		OwnerDeletion:  NewOwnerDeletionClient(cfg),
+		PendingChange:  NewPendingChangeClient(cfg),
+		Pool:           NewPoolClient(cfg),
+		Port:           NewPortClient(cfg),
+		Provider:       NewProviderClient(cfg),
 	}, nil
 }
 
@@ -186,20 +206,24 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:          ctx,
-		config:       cfg,
-		LoadBalancer: NewLoadBalancerClient(cfg),
-		Origin:       NewOriginClient(cfg),
-		Pool:         NewPoolClient(cfg),
-		Port:         NewPortClient(cfg),
-		Provider:     NewProviderClient(cfg),
+		ctx:            ctx,
+		config:         cfg,
+		AuditEvent:     NewAuditEventClient(cfg),
+		IdempotencyKey: NewIdempotencyKeyClient(cfg),
+		LoadBalancer:   NewLoadBalancerClient(cfg),
+		Origin:         NewOriginClient(cfg),
+		OwnerDeletion:  NewOwnerDeletionClient(cfg),
+		PendingChange:  NewPendingChangeClient(cfg),
+		Pool:           NewPoolClient(cfg),
+		Port:           NewPortClient(cfg),
+		Provider:       NewProviderClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		LoadBalancer.
+//		AuditEvent.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -221,30 +245,40 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.LoadBalancer.Use(hooks...)
-	c.Origin.Use(hooks...)
-	c.Pool.Use(hooks...)
-	c.Port.Use(hooks...)
-	c.Provider.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.AuditEvent, c.IdempotencyKey, c.LoadBalancer, c.Origin, c.OwnerDeletion,
+		c.PendingChange, c.Pool, c.Port, c.Provider,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.LoadBalancer.Intercept(interceptors...)
-	c.Origin.Intercept(interceptors...)
-	c.Pool.Intercept(interceptors...)
-	c.Port.Intercept(interceptors...)
-	c.Provider.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.AuditEvent, c.IdempotencyKey, c.LoadBalancer, c.Origin, c.OwnerDeletion,
+		c.PendingChange, c.Pool, c.Port, c.Provider,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AuditEventMutation:
+		return c.AuditEvent.mutate(ctx, m)
+	case *IdempotencyKeyMutation:
+		return c.IdempotencyKey.mutate(ctx, m)
 	case *LoadBalancerMutation:
 		return c.LoadBalancer.mutate(ctx, m)
 	case *OriginMutation:
 		return c.Origin.mutate(ctx, m)
+	case *OwnerDeletionMutation:
+		return c.OwnerDeletion.mutate(ctx, m)
+	case *PendingChangeMutation:
+		return c.PendingChange.mutate(ctx, m)
 	case *PoolMutation:
 		return c.Pool.mutate(ctx, m)
 	case *PortMutation:
@@ -256,6 +290,272 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// AuditEventClient is a client for the AuditEvent schema.
+type AuditEventClient struct {
+	config
+}
+
+// NewAuditEventClient returns a client for the AuditEvent from the given config.
+func NewAuditEventClient(c config) *AuditEventClient {
+	return &AuditEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditevent.Hooks(f(g(h())))`.
+func (c *AuditEventClient) Use(hooks ...Hook) {
+	c.hooks.AuditEvent = append(c.hooks.AuditEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditevent.Intercept(f(g(h())))`.
+func (c *AuditEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditEvent = append(c.inters.AuditEvent, interceptors...)
+}
+
+// Create returns a builder for creating a AuditEvent entity.
+func (c *AuditEventClient) Create() *AuditEventCreate {
+	mutation := newAuditEventMutation(c.config, OpCreate)
+	return &AuditEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditEvent entities.
+func (c *AuditEventClient) CreateBulk(builders ...*AuditEventCreate) *AuditEventCreateBulk {
+	return &AuditEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditEventClient) MapCreateBulk(slice any, setFunc func(*AuditEventCreate, int)) *AuditEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditEventCreateBulk{err: fmt.Errorf("calling to AuditEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditEvent.
+func (c *AuditEventClient) Update() *AuditEventUpdate {
+	mutation := newAuditEventMutation(c.config, OpUpdate)
+	return &AuditEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditEventClient) UpdateOne(ae *AuditEvent) *AuditEventUpdateOne {
+	mutation := newAuditEventMutation(c.config, OpUpdateOne, withAuditEvent(ae))
+	return &AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditEventClient) UpdateOneID(id gidx.PrefixedID) *AuditEventUpdateOne {
+	mutation := newAuditEventMutation(c.config, OpUpdateOne, withAuditEventID(id))
+	return &AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditEvent.
+func (c *AuditEventClient) Delete() *AuditEventDelete {
+	mutation := newAuditEventMutation(c.config, OpDelete)
+	return &AuditEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditEventClient) DeleteOne(ae *AuditEvent) *AuditEventDeleteOne {
+	return c.DeleteOneID(ae.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditEventClient) DeleteOneID(id gidx.PrefixedID) *AuditEventDeleteOne {
+	builder := c.Delete().Where(auditevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditEventDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditEvent.
+func (c *AuditEventClient) Query() *AuditEventQuery {
+	return &AuditEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditEvent entity by its id.
+func (c *AuditEventClient) Get(ctx context.Context, id gidx.PrefixedID) (*AuditEvent, error) {
+	return c.Query().Where(auditevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditEventClient) GetX(ctx context.Context, id gidx.PrefixedID) *AuditEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditEventClient) Hooks() []Hook {
+	return c.hooks.AuditEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditEventClient) Interceptors() []Interceptor {
+	return c.inters.AuditEvent
+}
+
+func (c *AuditEventClient) mutate(ctx context.Context, m *AuditEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown AuditEvent mutation op: %q", m.Op())
+	}
+}
+
+// IdempotencyKeyClient is a client for the IdempotencyKey schema.
+type IdempotencyKeyClient struct {
+	config
+}
+
+// NewIdempotencyKeyClient returns a client for the IdempotencyKey from the given config.
+func NewIdempotencyKeyClient(c config) *IdempotencyKeyClient {
+	return &IdempotencyKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `idempotencykey.Hooks(f(g(h())))`.
+func (c *IdempotencyKeyClient) Use(hooks ...Hook) {
+	c.hooks.IdempotencyKey = append(c.hooks.IdempotencyKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `idempotencykey.Intercept(f(g(h())))`.
+func (c *IdempotencyKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IdempotencyKey = append(c.inters.IdempotencyKey, interceptors...)
+}
+
+// Create returns a builder for creating a IdempotencyKey entity.
+func (c *IdempotencyKeyClient) Create() *IdempotencyKeyCreate {
+	mutation := newIdempotencyKeyMutation(c.config, OpCreate)
+	return &IdempotencyKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IdempotencyKey entities.
+func (c *IdempotencyKeyClient) CreateBulk(builders ...*IdempotencyKeyCreate) *IdempotencyKeyCreateBulk {
+	return &IdempotencyKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IdempotencyKeyClient) MapCreateBulk(slice any, setFunc func(*IdempotencyKeyCreate, int)) *IdempotencyKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IdempotencyKeyCreateBulk{err: fmt.Errorf("calling to IdempotencyKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IdempotencyKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IdempotencyKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Update() *IdempotencyKeyUpdate {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdate)
+	return &IdempotencyKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IdempotencyKeyClient) UpdateOne(ik *IdempotencyKey) *IdempotencyKeyUpdateOne {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdateOne, withIdempotencyKey(ik))
+	return &IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IdempotencyKeyClient) UpdateOneID(id gidx.PrefixedID) *IdempotencyKeyUpdateOne {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdateOne, withIdempotencyKeyID(id))
+	return &IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Delete() *IdempotencyKeyDelete {
+	mutation := newIdempotencyKeyMutation(c.config, OpDelete)
+	return &IdempotencyKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IdempotencyKeyClient) DeleteOne(ik *IdempotencyKey) *IdempotencyKeyDeleteOne {
+	return c.DeleteOneID(ik.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IdempotencyKeyClient) DeleteOneID(id gidx.PrefixedID) *IdempotencyKeyDeleteOne {
+	builder := c.Delete().Where(idempotencykey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IdempotencyKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Query() *IdempotencyKeyQuery {
+	return &IdempotencyKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIdempotencyKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IdempotencyKey entity by its id.
+func (c *IdempotencyKeyClient) Get(ctx context.Context, id gidx.PrefixedID) (*IdempotencyKey, error) {
+	return c.Query().Where(idempotencykey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IdempotencyKeyClient) GetX(ctx context.Context, id gidx.PrefixedID) *IdempotencyKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *IdempotencyKeyClient) Hooks() []Hook {
+	return c.hooks.IdempotencyKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *IdempotencyKeyClient) Interceptors() []Interceptor {
+	return c.inters.IdempotencyKey
+}
+
+func (c *IdempotencyKeyClient) mutate(ctx context.Context, m *IdempotencyKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IdempotencyKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IdempotencyKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IdempotencyKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown IdempotencyKey mutation op: %q", m.Op())
+	}
+}
+
 // LoadBalancerClient is a client for the LoadBalancer schema.
 type LoadBalancerClient struct {
 	config
@@ -574,6 +874,272 @@ func (c *OriginClient) mutate(ctx context.Context, m *OriginMutation) (Value, er
 	}
 }
 
+// OwnerDeletionClient is a client for the OwnerDeletion schema.
+type OwnerDeletionClient struct {
+	config
+}
+
+// NewOwnerDeletionClient returns a client for the OwnerDeletion from the given config.
+func NewOwnerDeletionClient(c config) *OwnerDeletionClient {
+	return &OwnerDeletionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ownerdeletion.Hooks(f(g(h())))`.
+func (c *OwnerDeletionClient) Use(hooks ...Hook) {
+	c.hooks.OwnerDeletion = append(c.hooks.OwnerDeletion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ownerdeletion.Intercept(f(g(h())))`.
+func (c *OwnerDeletionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OwnerDeletion = append(c.inters.OwnerDeletion, interceptors...)
+}
+
+// Create returns a builder for creating a OwnerDeletion entity.
+func (c *OwnerDeletionClient) Create() *OwnerDeletionCreate {
+	mutation := newOwnerDeletionMutation(c.config, OpCreate)
+	return &OwnerDeletionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OwnerDeletion entities.
+func (c *OwnerDeletionClient) CreateBulk(builders ...*OwnerDeletionCreate) *OwnerDeletionCreateBulk {
+	return &OwnerDeletionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OwnerDeletionClient) MapCreateBulk(slice any, setFunc func(*OwnerDeletionCreate, int)) *OwnerDeletionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OwnerDeletionCreateBulk{err: fmt.Errorf("calling to OwnerDeletionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OwnerDeletionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OwnerDeletionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OwnerDeletion.
+func (c *OwnerDeletionClient) Update() *OwnerDeletionUpdate {
+	mutation := newOwnerDeletionMutation(c.config, OpUpdate)
+	return &OwnerDeletionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OwnerDeletionClient) UpdateOne(od *OwnerDeletion) *OwnerDeletionUpdateOne {
+	mutation := newOwnerDeletionMutation(c.config, OpUpdateOne, withOwnerDeletion(od))
+	return &OwnerDeletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OwnerDeletionClient) UpdateOneID(id gidx.PrefixedID) *OwnerDeletionUpdateOne {
+	mutation := newOwnerDeletionMutation(c.config, OpUpdateOne, withOwnerDeletionID(id))
+	return &OwnerDeletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OwnerDeletion.
+func (c *OwnerDeletionClient) Delete() *OwnerDeletionDelete {
+	mutation := newOwnerDeletionMutation(c.config, OpDelete)
+	return &OwnerDeletionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OwnerDeletionClient) DeleteOne(od *OwnerDeletion) *OwnerDeletionDeleteOne {
+	return c.DeleteOneID(od.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OwnerDeletionClient) DeleteOneID(id gidx.PrefixedID) *OwnerDeletionDeleteOne {
+	builder := c.Delete().Where(ownerdeletion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OwnerDeletionDeleteOne{builder}
+}
+
+// Query returns a query builder for OwnerDeletion.
+func (c *OwnerDeletionClient) Query() *OwnerDeletionQuery {
+	return &OwnerDeletionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOwnerDeletion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OwnerDeletion entity by its id.
+func (c *OwnerDeletionClient) Get(ctx context.Context, id gidx.PrefixedID) (*OwnerDeletion, error) {
+	return c.Query().Where(ownerdeletion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OwnerDeletionClient) GetX(ctx context.Context, id gidx.PrefixedID) *OwnerDeletion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OwnerDeletionClient) Hooks() []Hook {
+	return c.hooks.OwnerDeletion
+}
+
+// Interceptors returns the client interceptors.
+func (c *OwnerDeletionClient) Interceptors() []Interceptor {
+	return c.inters.OwnerDeletion
+}
+
+func (c *OwnerDeletionClient) mutate(ctx context.Context, m *OwnerDeletionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OwnerDeletionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OwnerDeletionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OwnerDeletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OwnerDeletionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown OwnerDeletion mutation op: %q", m.Op())
+	}
+}
+
+// PendingChangeClient is a client for the PendingChange schema.
+type PendingChangeClient struct {
+	config
+}
+
+// NewPendingChangeClient returns a client for the PendingChange from the given config.
+func NewPendingChangeClient(c config) *PendingChangeClient {
+	return &PendingChangeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pendingchange.Hooks(f(g(h())))`.
+func (c *PendingChangeClient) Use(hooks ...Hook) {
+	c.hooks.PendingChange = append(c.hooks.PendingChange, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pendingchange.Intercept(f(g(h())))`.
+func (c *PendingChangeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PendingChange = append(c.inters.PendingChange, interceptors...)
+}
+
+// Create returns a builder for creating a PendingChange entity.
+func (c *PendingChangeClient) Create() *PendingChangeCreate {
+	mutation := newPendingChangeMutation(c.config, OpCreate)
+	return &PendingChangeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PendingChange entities.
+func (c *PendingChangeClient) CreateBulk(builders ...*PendingChangeCreate) *PendingChangeCreateBulk {
+	return &PendingChangeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PendingChangeClient) MapCreateBulk(slice any, setFunc func(*PendingChangeCreate, int)) *PendingChangeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PendingChangeCreateBulk{err: fmt.Errorf("calling to PendingChangeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PendingChangeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PendingChangeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PendingChange.
+func (c *PendingChangeClient) Update() *PendingChangeUpdate {
+	mutation := newPendingChangeMutation(c.config, OpUpdate)
+	return &PendingChangeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PendingChangeClient) UpdateOne(pc *PendingChange) *PendingChangeUpdateOne {
+	mutation := newPendingChangeMutation(c.config, OpUpdateOne, withPendingChange(pc))
+	return &PendingChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PendingChangeClient) UpdateOneID(id gidx.PrefixedID) *PendingChangeUpdateOne {
+	mutation := newPendingChangeMutation(c.config, OpUpdateOne, withPendingChangeID(id))
+	return &PendingChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PendingChange.
+func (c *PendingChangeClient) Delete() *PendingChangeDelete {
+	mutation := newPendingChangeMutation(c.config, OpDelete)
+	return &PendingChangeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PendingChangeClient) DeleteOne(pc *PendingChange) *PendingChangeDeleteOne {
+	return c.DeleteOneID(pc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PendingChangeClient) DeleteOneID(id gidx.PrefixedID) *PendingChangeDeleteOne {
+	builder := c.Delete().Where(pendingchange.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PendingChangeDeleteOne{builder}
+}
+
+// Query returns a query builder for PendingChange.
+func (c *PendingChangeClient) Query() *PendingChangeQuery {
+	return &PendingChangeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePendingChange},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PendingChange entity by its id.
+func (c *PendingChangeClient) Get(ctx context.Context, id gidx.PrefixedID) (*PendingChange, error) {
+	return c.Query().Where(pendingchange.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PendingChangeClient) GetX(ctx context.Context, id gidx.PrefixedID) *PendingChange {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PendingChangeClient) Hooks() []Hook {
+	return c.hooks.PendingChange
+}
+
+// Interceptors returns the client interceptors.
+func (c *PendingChangeClient) Interceptors() []Interceptor {
+	return c.inters.PendingChange
+}
+
+func (c *PendingChangeClient) mutate(ctx context.Context, m *PendingChangeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PendingChangeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PendingChangeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PendingChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PendingChangeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown PendingChange mutation op: %q", m.Op())
+	}
+}
+
 // PoolClient is a client for the Pool schema.
 type PoolClient struct {
 	config
@@ -1062,9 +1628,11 @@ func (c *ProviderClient) mutate(ctx context.Context, m *ProviderMutation) (Value
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		LoadBalancer, Origin, Pool, Port, Provider []ent.Hook
+		AuditEvent, IdempotencyKey, LoadBalancer, Origin, OwnerDeletion, PendingChange,
+		Pool, Port, Provider []ent.Hook
 	}
 	inters struct {
-		LoadBalancer, Origin, Pool, Port, Provider []ent.Interceptor
+		AuditEvent, IdempotencyKey, LoadBalancer, Origin, OwnerDeletion, PendingChange,
+		Pool, Port, Provider []ent.Interceptor
 	}
 )
@@ -26,8 +26,12 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/auditevent"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/idempotencykey"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/loadbalancer"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/origin"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/ownerdeletion"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/pendingchange"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/pool"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/port"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/provider"
@@ -91,11 +95,15 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			loadbalancer.Table: loadbalancer.ValidColumn,
-			origin.Table:       origin.ValidColumn,
-			pool.Table:         pool.ValidColumn,
-			port.Table:         port.ValidColumn,
-			provider.Table:     provider.ValidColumn,
+			auditevent.Table:     auditevent.ValidColumn,
+			idempotencykey.Table: idempotencykey.ValidColumn,
+			loadbalancer.Table:   loadbalancer.ValidColumn,
+			origin.Table:         origin.ValidColumn,
+			ownerdeletion.Table:  ownerdeletion.ValidColumn,
+			pendingchange.Table:  pendingchange.ValidColumn,
+			pool.Table:           pool.ValidColumn,
+			port.Table:           port.ValidColumn,
+			provider.Table:       provider.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
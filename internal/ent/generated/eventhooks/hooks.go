@@ -0,0 +1,1839 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package eventhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/x/events"
+	"go.infratographer.com/x/gidx"
+	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
+
+	"go.infratographer.com/load-balancer-api/internal/auditlog"
+	"go.infratographer.com/load-balancer-api/internal/config"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/hook"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/loadbalancer"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/origin"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/pool"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/port"
+	"go.infratographer.com/load-balancer-api/internal/snapshot"
+)
+
+// LoadBalancerHooks returns the hooks publishing the changes of LoadBalancer objects
+func LoadBalancerHooks(opts ...Option) []ent.Hook {
+	o := newOptions(opts...)
+
+	cuhook := hook.On(
+		func(next ent.Mutator) ent.Mutator {
+			return hook.LoadBalancerFunc(func(ctx context.Context, m *generated.LoadBalancerMutation) (ent.Value, error) {
+				var err error
+				additionalSubjects := []gidx.PrefixedID{}
+				relationships := []events.AuthRelationshipRelation{}
+
+				objID, ok := m.ID()
+				if !ok {
+					return nil, fmt.Errorf("object doesn't have an id %s", objID)
+				}
+
+				changeset := []events.FieldChange{}
+				cv_created_at := ""
+				created_at, ok := m.CreatedAt()
+
+				if ok {
+					cv_created_at = created_at.Format(time.RFC3339)
+					pv_created_at := ""
+					if !m.Op().Is(ent.OpCreate) {
+						ov, err := m.OldCreatedAt(ctx)
+						if err != nil {
+							pv_created_at = "<unknown>"
+						} else {
+							pv_created_at = ov.Format(time.RFC3339)
+						}
+					}
+
+					changeset = append(changeset, events.FieldChange{
+						Field:         "created_at",
+						PreviousValue: pv_created_at,
+						CurrentValue:  cv_created_at,
+					})
+				}
+
+				cv_updated_at := ""
+				updated_at, ok := m.UpdatedAt()
+
+				if ok {
+					cv_updated_at = updated_at.Format(time.RFC3339)
+					pv_updated_at := ""
+					if !m.Op().Is(ent.OpCreate) {
+						ov, err := m.OldUpdatedAt(ctx)
+						if err != nil {
+							pv_updated_at = "<unknown>"
+						} else {
+							pv_updated_at = ov.Format(time.RFC3339)
+						}
+					}
+
+					changeset = append(changeset, events.FieldChange{
+						Field:         "updated_at",
+						PreviousValue: pv_updated_at,
+						CurrentValue:  cv_updated_at,
+					})
+				}
+
+				cv_name := ""
+				name, ok := m.Name()
+
+				if ok {
+					cv_name = fmt.Sprintf("%s", fmt.Sprint(name))
+					pv_name := ""
+					if !m.Op().Is(ent.OpCreate) {
+						ov, err := m.OldName(ctx)
+						if err != nil {
+							pv_name = "<unknown>"
+						} else {
+							pv_name = fmt.Sprintf("%s", fmt.Sprint(ov))
+						}
+					}
+
+					changeset = append(changeset, events.FieldChange{
+						Field:         "name",
+						PreviousValue: pv_name,
+						CurrentValue:  cv_name,
+					})
+				}
+
+				cv_owner_id := ""
+				owner_id, ok := m.OwnerID()
+				if !ok && !m.Op().Is(ent.OpCreate) {
+					// since we are doing an update or delete and these fields didn't change, load the "old" value
+					owner_id, err = m.OldOwnerID(ctx)
+					if err != nil {
+						return nil, err
+					}
+				}
+
+				additionalSubjects = addSubjects(additionalSubjects, owner_id)
+				relationships = append(relationships, events.AuthRelationshipRelation{
+					Relation:  "owner",
+					SubjectID: owner_id,
+				})
+
+				if ok {
+					cv_owner_id = fmt.Sprintf("%s", fmt.Sprint(owner_id))
+					pv_owner_id := ""
+					if !m.Op().Is(ent.OpCreate) {
+						ov, err := m.OldOwnerID(ctx)
+						if err != nil {
+							pv_owner_id = "<unknown>"
+						} else {
+							pv_owner_id = fmt.Sprintf("%s", fmt.Sprint(ov))
+						}
+					}
+
+					changeset = append(changeset, events.FieldChange{
+						Field:         "owner_id",
+						PreviousValue: pv_owner_id,
+						CurrentValue:  cv_owner_id,
+					})
+				}
+
+				cv_location_id := ""
+				location_id, ok := m.LocationID()
+				if !ok && !m.Op().Is(ent.OpCreate) {
+					// since we are doing an update or delete and these fields didn't change, load the "old" value
+					location_id, err = m.OldLocationID(ctx)
+					if err != nil {
+						return nil, err
+					}
+				}
+
+				additionalSubjects = addSubjects(additionalSubjects, location_id)
+
+				if ok {
+					cv_location_id = fmt.Sprintf("%s", fmt.Sprint(location_id))
+					pv_location_id := ""
+					if !m.Op().Is(ent.OpCreate) {
+						ov, err := m.OldLocationID(ctx)
+						if err != nil {
+							pv_location_id = "<unknown>"
+						} else {
+							pv_location_id = fmt.Sprintf("%s", fmt.Sprint(ov))
+						}
+					}
+
+					changeset = append(changeset, events.FieldChange{
+						Field:         "location_id",
+						PreviousValue: pv_location_id,
+						CurrentValue:  cv_location_id,
+					})
+				}
+
+				cv_provider_id := ""
+				provider_id, ok := m.ProviderID()
+				if !ok && !m.Op().Is(ent.OpCreate) {
+					// since we are doing an update or delete and these fields didn't change, load the "old" value
+					provider_id, err = m.OldProviderID(ctx)
+					if err != nil {
+						return nil, err
+					}
+				}
+
+				additionalSubjects = addSubjects(additionalSubjects, provider_id)
+
+				if ok {
+					cv_provider_id = fmt.Sprintf("%s", fmt.Sprint(provider_id))
+					pv_provider_id := ""
+					if !m.Op().Is(ent.OpCreate) {
+						ov, err := m.OldProviderID(ctx)
+						if err != nil {
+							pv_provider_id = "<unknown>"
+						} else {
+							pv_provider_id = fmt.Sprintf("%s", fmt.Sprint(ov))
+						}
+					}
+
+					changeset = append(changeset, events.FieldChange{
+						Field:         "provider_id",
+						PreviousValue: pv_provider_id,
+						CurrentValue:  cv_provider_id,
+					})
+				}
+
+				// add the extra relationships from the config
+				relationships = append(relationships, config.ExtraRelationships("loadbalancer")...)
+
+				msg := events.ChangeMessage{
+					EventType:            eventType(m.Op()),
+					SubjectID:            objID,
+					AdditionalSubjectIDs: additionalSubjects,
+					Timestamp:            time.Now().UTC(),
+					FieldChanges:         changeset,
+				}
+
+				// complete the mutation before we process the event
+				retValue, err := next.Mutate(ctx, m)
+				if err != nil {
+					return retValue, err
+				}
+
+				// Ensure we have additional relevant subjects in the msg
+				related, err := loadBalancerRelatedSubjects(ctx, m.Client(), objID, false)
+				if err != nil {
+					return nil, err
+				}
+
+				msg.AdditionalSubjectIDs = addSubjects(msg.AdditionalSubjectIDs, related...)
+
+				if err := auditlog.RecordChange(ctx, m.Client(), &msg, nil); err != nil {
+					return nil, err
+				}
+
+				if err := snapshot.AttachChange(ctx, m.Client(), &msg, nil); err != nil {
+					return nil, err
+				}
+
+				c := change{
+					subject:   "load-balancer",
+					msg:       msg,
+					publisher: m.EventsPublisher,
+					relationships: func(ctx context.Context) error {
+						if len(relationships) != 0 && m.Op().Is(ent.OpCreate) {
+							if err := permissions.CreateAuthRelationships(ctx, "load-balancer", objID, relationships...); err != nil {
+								return fmt.Errorf("relationship request failed with error: %w", err)
+							}
+						}
+
+						return nil
+					},
+				}
+
+				if err := sendChange(ctx, m, o, c); err != nil {
+					return nil, err
+				}
+
+				return retValue, nil
+			})
+		},
+		ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne,
+	)
+
+	// Delete Hook
+	dhook := hook.On(
+		func(next ent.Mutator) ent.Mutator {
+			return hook.LoadBalancerFunc(func(ctx context.Context, m *generated.LoadBalancerMutation) (ent.Value, error) {
+				additionalSubjects := []gidx.PrefixedID{}
+
+				objID, ok := m.ID()
+				if !ok {
+					return nil, fmt.Errorf("object doesn't have an id %s", objID)
+				}
+
+				dbObj, err := m.Client().LoadBalancer.Get(ctx, objID)
+				if err != nil {
+					return nil, fmt.Errorf("failed to load object to get values for event, err %w", err)
+				}
+				additionalSubjects = addSubjects(additionalSubjects, dbObj.OwnerID)
+				additionalSubjects = addSubjects(additionalSubjects, dbObj.LocationID)
+				additionalSubjects = addSubjects(additionalSubjects, dbObj.ProviderID)
+
+				// we have all the info we need, now complete the mutation before we process the event
+				retValue, err := next.Mutate(ctx, m)
+				if err != nil {
+					return retValue, err
+				}
+
+				msg := events.ChangeMessage{
+					EventType:            string(events.DeleteChangeType),
+					SubjectID:            objID,
+					AdditionalSubjectIDs: additionalSubjects,
+					Timestamp:            time.Now().UTC(),
+				}
+
+				if err := auditlog.RecordChange(ctx, m.Client(), &msg, dbObj); err != nil {
+					return nil, err
+				}
+
+				if err := snapshot.AttachChange(ctx, m.Client(), &msg, dbObj); err != nil {
+					return nil, err
+				}
+
+				c := change{
+					subject:   "load-balancer",
+					msg:       msg,
+					publisher: m.EventsPublisher,
+					relationships: func(ctx context.Context) error {
+						return nil
+					},
+				}
+
+				if err := sendChange(ctx, m, o, c); err != nil {
+					return nil, err
+				}
+
+				return retValue, nil
+			})
+		},
+		ent.OpDelete|ent.OpDeleteOne,
+	)
+
+	// only trigger create/update hook when the deleted_at field is not set
+	cuhook = hook.If(
+		cuhook,
+		hook.Not(
+			hook.And(
+				hook.Or(
+					hook.HasOp(ent.OpUpdate),
+					hook.HasOp(ent.OpUpdateOne),
+				),
+				hook.HasFields("deleted_at"),
+			),
+		),
+	)
+
+	return []ent.Hook{cuhook, dhook}
+}
+
+// loadBalancerRelatedSubjects loads the subjects related to the LoadBalancer through its edges
+func loadBalancerRelatedSubjects(ctx context.Context, c *generated.Client, id gidx.PrefixedID, deleted bool) ([]gidx.PrefixedID, error) {
+	subjects := []gidx.PrefixedID{}
+
+	if !deleted {
+		rows, err := c.LoadBalancer.Query().Where(loadbalancer.IDEQ(id)).All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load related subjects: %w", err)
+		}
+
+		for _, row := range rows {
+			subjects = addSubjects(subjects, row.ID)
+		}
+	}
+
+	if !deleted {
+		rows, err := c.LoadBalancer.Query().Where(loadbalancer.IDEQ(id)).QueryPorts().All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load related subjects: %w", err)
+		}
+
+		for _, row := range rows {
+			subjects = addSubjects(subjects, row.ID)
+		}
+	}
+
+	return subjects, nil
+}
+
+// OriginHooks returns the hooks publishing the changes of Origin objects
+func OriginHooks(opts ...Option) []ent.Hook {
+	o := newOptions(opts...)
+
+	cuhook := hook.On(
+		func(next ent.Mutator) ent.Mutator {
+			return hook.OriginFunc(func(ctx context.Context, m *generated.OriginMutation) (ent.Value, error) {
+				var err error
+				additionalSubjects := []gidx.PrefixedID{}
+				relationships := []events.AuthRelationshipRelation{}
+
+				objID, ok := m.ID()
+				if !ok {
+					return nil, fmt.Errorf("object doesn't have an id %s", objID)
+				}
+
+				changeset := []events.FieldChange{}
+				cv_created_at := ""
+				created_at, ok := m.CreatedAt()
+
+				if ok {
+					cv_created_at = created_at.Format(time.RFC3339)
+					pv_created_at := ""
+					if !m.Op().Is(ent.OpCreate) {
+						ov, err := m.OldCreatedAt(ctx)
+						if err != nil {
+							pv_created_at = "<unknown>"
+						} else {
+							pv_created_at = ov.Format(time.RFC3339)
+						}
+					}
+
+					changeset = append(changeset, events.FieldChange{
+						Field:         "created_at",
+						PreviousValue: pv_created_at,
+						CurrentValue:  cv_created_at,
+					})
+				}
+
+				cv_updated_at := ""
+				updated_at, ok := m.UpdatedAt()
+
+				if ok {
+					cv_updated_at = updated_at.Format(time.RFC3339)
+					pv_updated_at := ""
+					if !m.Op().Is(ent.OpCreate) {
+						ov, err := m.OldUpdatedAt(ctx)
+						if err != nil {
+							pv_updated_at = "<unknown>"
+						} else {
+							pv_updated_at = ov.Format(time.RFC3339)
+						}
+					}
+
+					changeset = append(changeset, events.FieldChange{
+						Field:         "updated_at",
+						PreviousValue: pv_updated_at,
+						CurrentValue:  cv_updated_at,
+					})
+				}
+
+				cv_name := ""
+				name, ok := m.Name()
+
+				if ok {
+					cv_name = fmt.Sprintf("%s", fmt.Sprint(name))
+					pv_name := ""
+					if !m.Op().Is(ent.OpCreate) {
+						ov, err := m.OldName(ctx)
+						if err != nil {
+							pv_name = "<unknown>"
+						} else {
+							pv_name = fmt.Sprintf("%s", fmt.Sprint(ov))
+						}
+					}
+
+					changeset = append(changeset, events.FieldChange{
+						Field:         "name",
+						PreviousValue: pv_name,
+						CurrentValue:  cv_name,
+					})
+				}
+
+				cv_weight := ""
+				weight, ok := m.Weight()
+
+				if ok {
+					cv_weight = fmt.Sprintf("%s", fmt.Sprint(weight))
+					pv_weight := ""
+					if !m.Op().Is(ent.OpCreate) {
+						ov, err := m.OldWeight(ctx)
+						if err != nil {
+							pv_weight = "<unknown>"
+						} else {
+							pv_weight = fmt.Sprintf("%s", fmt.Sprint(ov))
+						}
+					}
+
+					changeset = append(changeset, events.FieldChange{
+						Field:         "weight",
+						PreviousValue: pv_weight,
+						CurrentValue:  cv_weight,
+					})
+				}
+
+				cv_target := ""
+				target, ok := m.Target()
+
+				if ok {
+					cv_target = fmt.Sprintf("%s", fmt.Sprint(target))
+					pv_target := ""
+					if !m.Op().Is(ent.OpCreate) {
+						ov, err := m.OldTarget(ctx)
+						if err != nil {
+							pv_target = "<unknown>"
+						} else {
+							pv_target = fmt.Sprintf("%s", fmt.Sprint(ov))
+						}
+					}
+
+					changeset = append(changeset, events.FieldChange{
+						Field:         "target",
+						PreviousValue: pv_target,
+						CurrentValue:  cv_target,
+					})
+				}
+
+				cv_port_number := ""
+				port_number, ok := m.PortNumber()
+
+				if ok {
+					cv_port_number = fmt.Sprintf("%s", fmt.Sprint(port_number))
+					pv_port_number := ""
+					if !m.Op().Is(ent.OpCreate) {
+						ov, err := m.OldPortNumber(ctx)
+						if err != nil {
+							pv_port_number = "<unknown>"
+						} else {
+							pv_port_number = fmt.Sprintf("%s", fmt.Sprint(ov))
+						}
+					}
+
+					changeset = append(changeset, events.FieldChange{
+						Field:         "port_number",
+						PreviousValue: pv_port_number,
+						CurrentValue:  cv_port_number,
+					})
+				}
+
+				cv_active := ""
+				active, ok := m.Active()
+
+				if ok {
+					cv_active = fmt.Sprintf("%s", fmt.Sprint(active))
+					pv_active := ""
+					if !m.Op().Is(ent.OpCreate) {
+						ov, err := m.OldActive(ctx)
+						if err != nil {
+							pv_active = "<unknown>"
+						} else {
+							pv_active = fmt.Sprintf("%s", fmt.Sprint(ov))
+						}
+					}
+
+					changeset = append(changeset, events.FieldChange{
+						Field:         "active",
+						PreviousValue: pv_active,
+						CurrentValue:  cv_active,
+					})
+				}
+
+				cv_pool_id := ""
+				pool_id, ok := m.PoolID()
+				if !ok && !m.Op().Is(ent.OpCreate) {
+					// since we are doing an update or delete and these fields didn't change, load the "old" value
+					pool_id, err = m.OldPoolID(ctx)
+					if err != nil {
+						return nil, err
+					}
+				}
+
+				additionalSubjects = addSubjects(additionalSubjects, pool_id)
+				relationships = append(relationships, events.AuthRelationshipRelation{
+					Relation:  "pool",
+					SubjectID: pool_id,
+				})
+
+				if ok {
+					cv_pool_id = fmt.Sprintf("%s", fmt.Sprint(pool_id))
+					pv_pool_id := ""
+					if !m.Op().Is(ent.OpCreate) {
+						ov, err := m.OldPoolID(ctx)
+						if err != nil {
+							pv_pool_id = "<unknown>"
+						} else {
+							pv_pool_id = fmt.Sprintf("%s", fmt.Sprint(ov))
+						}
+					}
+
+					changeset = append(changeset, events.FieldChange{
+						Field:         "pool_id",
+						PreviousValue: pv_pool_id,
+						CurrentValue:  cv_pool_id,
+					})
+				}
+
+				msg := events.ChangeMessage{
+					EventType:            eventType(m.Op()),
+					SubjectID:            objID,
+					AdditionalSubjectIDs: additionalSubjects,
+					Timestamp:            time.Now().UTC(),
+					FieldChanges:         changeset,
+				}
+
+				// complete the mutation before we process the event
+				retValue, err := next.Mutate(ctx, m)
+				if err != nil {
+					return retValue, err
+				}
+
+				// Ensure we have additional relevant subjects in the msg
+				related, err := originRelatedSubjects(ctx, m.Client(), objID, false)
+				if err != nil {
+					return nil, err
+				}
+
+				msg.AdditionalSubjectIDs = addSubjects(msg.AdditionalSubjectIDs, related...)
+
+				if err := auditlog.RecordChange(ctx, m.Client(), &msg, nil); err != nil {
+					return nil, err
+				}
+
+				if err := snapshot.AttachChange(ctx, m.Client(), &msg, nil); err != nil {
+					return nil, err
+				}
+
+				c := change{
+					subject:   "load-balancer-origin",
+					msg:       msg,
+					publisher: m.EventsPublisher,
+					relationships: func(ctx context.Context) error {
+						if len(relationships) != 0 && m.Op().Is(ent.OpCreate) {
+							if err := permissions.CreateAuthRelationships(ctx, "load-balancer-origin", objID, relationships...); err != nil {
+								return fmt.Errorf("relationship request failed with error: %w", err)
+							}
+						}
+
+						return nil
+					},
+				}
+
+				if err := sendChange(ctx, m, o, c); err != nil {
+					return nil, err
+				}
+
+				return retValue, nil
+			})
+		},
+		ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne,
+	)
+
+	// Delete Hook
+	dhook := hook.On(
+		func(next ent.Mutator) ent.Mutator {
+			return hook.OriginFunc(func(ctx context.Context, m *generated.OriginMutation) (ent.Value, error) {
+				additionalSubjects := []gidx.PrefixedID{}
+				relationships := []events.AuthRelationshipRelation{}
+
+				objID, ok := m.ID()
+				if !ok {
+					return nil, fmt.Errorf("object doesn't have an id %s", objID)
+				}
+
+				dbObj, err := m.Client().Origin.Get(ctx, objID)
+				if err != nil {
+					return nil, fmt.Errorf("failed to load object to get values for event, err %w", err)
+				}
+				additionalSubjects = addSubjects(additionalSubjects, dbObj.PoolID)
+				relationships = append(relationships, events.AuthRelationshipRelation{
+					Relation:  "pool",
+					SubjectID: dbObj.PoolID,
+				})
+
+				// Ensure we have additional relevant subjects in the msg
+				related, err := originRelatedSubjects(ctx, m.Client(), objID, true)
+				if err != nil {
+					return nil, err
+				}
+
+				additionalSubjects = addSubjects(additionalSubjects, related...)
+
+				// we have all the info we need, now complete the mutation before we process the event
+				retValue, err := next.Mutate(ctx, m)
+				if err != nil {
+					return retValue, err
+				}
+
+				msg := events.ChangeMessage{
+					EventType:            string(events.DeleteChangeType),
+					SubjectID:            objID,
+					AdditionalSubjectIDs: additionalSubjects,
+					Timestamp:            time.Now().UTC(),
+				}
+
+				if err := auditlog.RecordChange(ctx, m.Client(), &msg, dbObj); err != nil {
+					return nil, err
+				}
+
+				if err := snapshot.AttachChange(ctx, m.Client(), &msg, dbObj); err != nil {
+					return nil, err
+				}
+
+				c := change{
+					subject:   "load-balancer-origin",
+					msg:       msg,
+					publisher: m.EventsPublisher,
+					relationships: func(ctx context.Context) error {
+						if len(relationships) != 0 {
+							if err := permissions.DeleteAuthRelationships(ctx, "load-balancer-origin", objID, relationships...); err != nil {
+								return fmt.Errorf("relationship request failed with error: %w", err)
+							}
+						}
+
+						return nil
+					},
+				}
+
+				if err := sendChange(ctx, m, o, c); err != nil {
+					return nil, err
+				}
+
+				return retValue, nil
+			})
+		},
+		ent.OpDelete|ent.OpDeleteOne,
+	)
+
+	// only trigger create/update hook when the deleted_at field is not set
+	cuhook = hook.If(
+		cuhook,
+		hook.Not(
+			hook.And(
+				hook.Or(
+					hook.HasOp(ent.OpUpdate),
+					hook.HasOp(ent.OpUpdateOne),
+				),
+				hook.HasFields("deleted_at"),
+			),
+		),
+	)
+
+	return []ent.Hook{cuhook, dhook}
+}
+
+// originRelatedSubjects loads the subjects related to the Origin through its edges
+func originRelatedSubjects(ctx context.Context, c *generated.Client, id gidx.PrefixedID, _ bool) ([]gidx.PrefixedID, error) {
+	subjects := []gidx.PrefixedID{}
+
+	{
+		rows, err := c.Origin.Query().Where(origin.IDEQ(id)).QueryPool().QueryPorts().QueryLoadBalancer().All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load related subjects: %w", err)
+		}
+
+		for _, row := range rows {
+			subjects = addSubjects(subjects, row.ID, row.LocationID, row.ProviderID)
+		}
+	}
+
+	{
+		rows, err := c.Origin.Query().Where(origin.IDEQ(id)).QueryPool().QueryPorts().QueryPools().All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load related subjects: %w", err)
+		}
+
+		for _, row := range rows {
+			subjects = addSubjects(subjects, row.ID, row.OwnerID)
+		}
+	}
+
+	return subjects, nil
+}
+
+// PoolHooks returns the hooks publishing the changes of Pool objects
+func PoolHooks(opts ...Option) []ent.Hook {
+	o := newOptions(opts...)
+
+	cuhook := hook.On(
+		func(next ent.Mutator) ent.Mutator {
+			return hook.PoolFunc(func(ctx context.Context, m *generated.PoolMutation) (ent.Value, error) {
+				var err error
+				additionalSubjects := []gidx.PrefixedID{}
+				relationships := []events.AuthRelationshipRelation{}
+
+				objID, ok := m.ID()
+				if !ok {
+					return nil, fmt.Errorf("object doesn't have an id %s", objID)
+				}
+
+				changeset := []events.FieldChange{}
+				cv_created_at := ""
+				created_at, ok := m.CreatedAt()
+
+				if ok {
+					cv_created_at = created_at.Format(time.RFC3339)
+					pv_created_at := ""
+					if !m.Op().Is(ent.OpCreate) {
+						ov, err := m.OldCreatedAt(ctx)
+						if err != nil {
+							pv_created_at = "<unknown>"
+						} else {
+							pv_created_at = ov.Format(time.RFC3339)
+						}
+					}
+
+					changeset = append(changeset, events.FieldChange{
+						Field:         "created_at",
+						PreviousValue: pv_created_at,
+						CurrentValue:  cv_created_at,
+					})
+				}
+
+				cv_updated_at := ""
+				updated_at, ok := m.UpdatedAt()
+
+				if ok {
+					cv_updated_at = updated_at.Format(time.RFC3339)
+					pv_updated_at := ""
+					if !m.Op().Is(ent.OpCreate) {
+						ov, err := m.OldUpdatedAt(ctx)
+						if err != nil {
+							pv_updated_at = "<unknown>"
+						} else {
+							pv_updated_at = ov.Format(time.RFC3339)
+						}
+					}
+
+					changeset = append(changeset, events.FieldChange{
+						Field:         "updated_at",
+						PreviousValue: pv_updated_at,
+						CurrentValue:  cv_updated_at,
+					})
+				}
+
+				cv_name := ""
+				name, ok := m.Name()
+
+				if ok {
+					cv_name = fmt.Sprintf("%s", fmt.Sprint(name))
+					pv_name := ""
+					if !m.Op().Is(ent.OpCreate) {
+						ov, err := m.OldName(ctx)
+						if err != nil {
+							pv_name = "<unknown>"
+						} else {
+							pv_name = fmt.Sprintf("%s", fmt.Sprint(ov))
+						}
+					}
+
+					changeset = append(changeset, events.FieldChange{
+						Field:         "name",
+						PreviousValue: pv_name,
+						CurrentValue:  cv_name,
+					})
+				}
+
+				cv_protocol := ""
+				protocol, ok := m.Protocol()
+
+				if ok {
+					cv_protocol = fmt.Sprintf("%s", fmt.Sprint(protocol))
+					pv_protocol := ""
+					if !m.Op().Is(ent.OpCreate) {
+						ov, err := m.OldProtocol(ctx)
+						if err != nil {
+							pv_protocol = "<unknown>"
+						} else {
+							pv_protocol = fmt.Sprintf("%s", fmt.Sprint(ov))
+						}
+					}
+
+					changeset = append(changeset, events.FieldChange{
+						Field:         "protocol",
+						PreviousValue: pv_protocol,
+						CurrentValue:  cv_protocol,
+					})
+				}
+
+				cv_owner_id := ""
+				owner_id, ok := m.OwnerID()
+				if !ok && !m.Op().Is(ent.OpCreate) {
+					// since we are doing an update or delete and these fields didn't change, load the "old" value
+					owner_id, err = m.OldOwnerID(ctx)
+					if err != nil {
+						return nil, err
+					}
+				}
+
+				additionalSubjects = addSubjects(additionalSubjects, owner_id)
+				relationships = append(relationships, events.AuthRelationshipRelation{
+					Relation:  "owner",
+					SubjectID: owner_id,
+				})
+
+				if ok {
+					cv_owner_id = fmt.Sprintf("%s", fmt.Sprint(owner_id))
+					pv_owner_id := ""
+					if !m.Op().Is(ent.OpCreate) {
+						ov, err := m.OldOwnerID(ctx)
+						if err != nil {
+							pv_owner_id = "<unknown>"
+						} else {
+							pv_owner_id = fmt.Sprintf("%s", fmt.Sprint(ov))
+						}
+					}
+
+					changeset = append(changeset, events.FieldChange{
+						Field:         "owner_id",
+						PreviousValue: pv_owner_id,
+						CurrentValue:  cv_owner_id,
+					})
+				}
+
+				msg := events.ChangeMessage{
+					EventType:            eventType(m.Op()),
+					SubjectID:            objID,
+					AdditionalSubjectIDs: additionalSubjects,
+					Timestamp:            time.Now().UTC(),
+					FieldChanges:         changeset,
+				}
+
+				// complete the mutation before we process the event
+				retValue, err := next.Mutate(ctx, m)
+				if err != nil {
+					return retValue, err
+				}
+
+				// Ensure we have additional relevant subjects in the msg
+				related, err := poolRelatedSubjects(ctx, m.Client(), objID, false)
+				if err != nil {
+					return nil, err
+				}
+
+				msg.AdditionalSubjectIDs = addSubjects(msg.AdditionalSubjectIDs, related...)
+
+				if err := auditlog.RecordChange(ctx, m.Client(), &msg, nil); err != nil {
+					return nil, err
+				}
+
+				if err := snapshot.AttachChange(ctx, m.Client(), &msg, nil); err != nil {
+					return nil, err
+				}
+
+				c := change{
+					subject:   "load-balancer-pool",
+					msg:       msg,
+					publisher: m.EventsPublisher,
+					relationships: func(ctx context.Context) error {
+						if len(relationships) != 0 && m.Op().Is(ent.OpCreate) {
+							if err := permissions.CreateAuthRelationships(ctx, "load-balancer-pool", objID, relationships...); err != nil {
+								return fmt.Errorf("relationship request failed with error: %w", err)
+							}
+						}
+
+						return nil
+					},
+				}
+
+				if err := sendChange(ctx, m, o, c); err != nil {
+					return nil, err
+				}
+
+				return retValue, nil
+			})
+		},
+		ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne,
+	)
+
+	// Delete Hook
+	dhook := hook.On(
+		func(next ent.Mutator) ent.Mutator {
+			return hook.PoolFunc(func(ctx context.Context, m *generated.PoolMutation) (ent.Value, error) {
+				additionalSubjects := []gidx.PrefixedID{}
+				relationships := []events.AuthRelationshipRelation{}
+
+				objID, ok := m.ID()
+				if !ok {
+					return nil, fmt.Errorf("object doesn't have an id %s", objID)
+				}
+
+				dbObj, err := m.Client().Pool.Get(ctx, objID)
+				if err != nil {
+					return nil, fmt.Errorf("failed to load object to get values for event, err %w", err)
+				}
+				additionalSubjects = addSubjects(additionalSubjects, dbObj.OwnerID)
+				relationships = append(relationships, events.AuthRelationshipRelation{
+					Relation:  "owner",
+					SubjectID: dbObj.OwnerID,
+				})
+
+				// Ensure we have additional relevant subjects in the msg
+				related, err := poolRelatedSubjects(ctx, m.Client(), objID, true)
+				if err != nil {
+					return nil, err
+				}
+
+				additionalSubjects = addSubjects(additionalSubjects, related...)
+
+				// we have all the info we need, now complete the mutation before we process the event
+				retValue, err := next.Mutate(ctx, m)
+				if err != nil {
+					return retValue, err
+				}
+
+				msg := events.ChangeMessage{
+					EventType:            string(events.DeleteChangeType),
+					SubjectID:            objID,
+					AdditionalSubjectIDs: additionalSubjects,
+					Timestamp:            time.Now().UTC(),
+				}
+
+				if err := auditlog.RecordChange(ctx, m.Client(), &msg, dbObj); err != nil {
+					return nil, err
+				}
+
+				if err := snapshot.AttachChange(ctx, m.Client(), &msg, dbObj); err != nil {
+					return nil, err
+				}
+
+				c := change{
+					subject:   "load-balancer-pool",
+					msg:       msg,
+					publisher: m.EventsPublisher,
+					relationships: func(ctx context.Context) error {
+						if len(relationships) != 0 {
+							if err := permissions.DeleteAuthRelationships(ctx, "load-balancer-pool", objID, relationships...); err != nil {
+								return fmt.Errorf("relationship request failed with error: %w", err)
+							}
+						}
+
+						return nil
+					},
+				}
+
+				if err := sendChange(ctx, m, o, c); err != nil {
+					return nil, err
+				}
+
+				return retValue, nil
+			})
+		},
+		ent.OpDelete|ent.OpDeleteOne,
+	)
+
+	// only trigger create/update hook when the deleted_at field is not set
+	cuhook = hook.If(
+		cuhook,
+		hook.Not(
+			hook.And(
+				hook.Or(
+					hook.HasOp(ent.OpUpdate),
+					hook.HasOp(ent.OpUpdateOne),
+				),
+				hook.HasFields("deleted_at"),
+			),
+		),
+	)
+
+	return []ent.Hook{cuhook, dhook}
+}
+
+// poolRelatedSubjects loads the subjects related to the Pool through its edges
+func poolRelatedSubjects(ctx context.Context, c *generated.Client, id gidx.PrefixedID, deleted bool) ([]gidx.PrefixedID, error) {
+	subjects := []gidx.PrefixedID{}
+
+	if !deleted {
+		rows, err := c.Pool.Query().Where(pool.IDEQ(id)).QueryPorts().All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load related subjects: %w", err)
+		}
+
+		for _, row := range rows {
+			subjects = addSubjects(subjects, row.ID)
+		}
+	}
+
+	{
+		rows, err := c.Pool.Query().Where(pool.IDEQ(id)).QueryPorts().QueryLoadBalancer().All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load related subjects: %w", err)
+		}
+
+		for _, row := range rows {
+			subjects = addSubjects(subjects, row.ID, row.LocationID, row.ProviderID)
+		}
+	}
+
+	if !deleted {
+		rows, err := c.Pool.Query().Where(pool.IDEQ(id)).QueryPorts().QueryPools().QueryOrigins().All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load related subjects: %w", err)
+		}
+
+		for _, row := range rows {
+			subjects = addSubjects(subjects, row.ID)
+		}
+	}
+
+	return subjects, nil
+}
+
+// PortHooks returns the hooks publishing the changes of Port objects
+func PortHooks(opts ...Option) []ent.Hook {
+	o := newOptions(opts...)
+
+	cuhook := hook.On(
+		func(next ent.Mutator) ent.Mutator {
+			return hook.PortFunc(func(ctx context.Context, m *generated.PortMutation) (ent.Value, error) {
+				var err error
+				additionalSubjects := []gidx.PrefixedID{}
+				relationships := []events.AuthRelationshipRelation{}
+
+				objID, ok := m.ID()
+				if !ok {
+					return nil, fmt.Errorf("object doesn't have an id %s", objID)
+				}
+
+				changeset := []events.FieldChange{}
+				cv_created_at := ""
+				created_at, ok := m.CreatedAt()
+
+				if ok {
+					cv_created_at = created_at.Format(time.RFC3339)
+					pv_created_at := ""
+					if !m.Op().Is(ent.OpCreate) {
+						ov, err := m.OldCreatedAt(ctx)
+						if err != nil {
+							pv_created_at = "<unknown>"
+						} else {
+							pv_created_at = ov.Format(time.RFC3339)
+						}
+					}
+
+					changeset = append(changeset, events.FieldChange{
+						Field:         "created_at",
+						PreviousValue: pv_created_at,
+						CurrentValue:  cv_created_at,
+					})
+				}
+
+				cv_updated_at := ""
+				updated_at, ok := m.UpdatedAt()
+
+				if ok {
+					cv_updated_at = updated_at.Format(time.RFC3339)
+					pv_updated_at := ""
+					if !m.Op().Is(ent.OpCreate) {
+						ov, err := m.OldUpdatedAt(ctx)
+						if err != nil {
+							pv_updated_at = "<unknown>"
+						} else {
+							pv_updated_at = ov.Format(time.RFC3339)
+						}
+					}
+
+					changeset = append(changeset, events.FieldChange{
+						Field:         "updated_at",
+						PreviousValue: pv_updated_at,
+						CurrentValue:  cv_updated_at,
+					})
+				}
+
+				cv_number := ""
+				number, ok := m.Number()
+
+				if ok {
+					cv_number = fmt.Sprintf("%s", fmt.Sprint(number))
+					pv_number := ""
+					if !m.Op().Is(ent.OpCreate) {
+						ov, err := m.OldNumber(ctx)
+						if err != nil {
+							pv_number = "<unknown>"
+						} else {
+							pv_number = fmt.Sprintf("%s", fmt.Sprint(ov))
+						}
+					}
+
+					changeset = append(changeset, events.FieldChange{
+						Field:         "number",
+						PreviousValue: pv_number,
+						CurrentValue:  cv_number,
+					})
+				}
+
+				cv_name := ""
+				name, ok := m.Name()
+
+				if ok {
+					cv_name = fmt.Sprintf("%s", fmt.Sprint(name))
+					pv_name := ""
+					if !m.Op().Is(ent.OpCreate) {
+						ov, err := m.OldName(ctx)
+						if err != nil {
+							pv_name = "<unknown>"
+						} else {
+							pv_name = fmt.Sprintf("%s", fmt.Sprint(ov))
+						}
+					}
+
+					changeset = append(changeset, events.FieldChange{
+						Field:         "name",
+						PreviousValue: pv_name,
+						CurrentValue:  cv_name,
+					})
+				}
+
+				cv_load_balancer_id := ""
+				load_balancer_id, ok := m.LoadBalancerID()
+				if !ok && !m.Op().Is(ent.OpCreate) {
+					// since we are doing an update or delete and these fields didn't change, load the "old" value
+					load_balancer_id, err = m.OldLoadBalancerID(ctx)
+					if err != nil {
+						return nil, err
+					}
+				}
+
+				additionalSubjects = addSubjects(additionalSubjects, load_balancer_id)
+				relationships = append(relationships, events.AuthRelationshipRelation{
+					Relation:  "loadbalancer",
+					SubjectID: load_balancer_id,
+				})
+
+				if ok {
+					cv_load_balancer_id = fmt.Sprintf("%s", fmt.Sprint(load_balancer_id))
+					pv_load_balancer_id := ""
+					if !m.Op().Is(ent.OpCreate) {
+						ov, err := m.OldLoadBalancerID(ctx)
+						if err != nil {
+							pv_load_balancer_id = "<unknown>"
+						} else {
+							pv_load_balancer_id = fmt.Sprintf("%s", fmt.Sprint(ov))
+						}
+					}
+
+					changeset = append(changeset, events.FieldChange{
+						Field:         "load_balancer_id",
+						PreviousValue: pv_load_balancer_id,
+						CurrentValue:  cv_load_balancer_id,
+					})
+				}
+
+				msg := events.ChangeMessage{
+					EventType:            eventType(m.Op()),
+					SubjectID:            objID,
+					AdditionalSubjectIDs: additionalSubjects,
+					Timestamp:            time.Now().UTC(),
+					FieldChanges:         changeset,
+				}
+
+				// complete the mutation before we process the event
+				retValue, err := next.Mutate(ctx, m)
+				if err != nil {
+					return retValue, err
+				}
+
+				// Ensure we have additional relevant subjects in the msg
+				related, err := portRelatedSubjects(ctx, m.Client(), objID, false)
+				if err != nil {
+					return nil, err
+				}
+
+				msg.AdditionalSubjectIDs = addSubjects(msg.AdditionalSubjectIDs, related...)
+
+				if err := auditlog.RecordChange(ctx, m.Client(), &msg, nil); err != nil {
+					return nil, err
+				}
+
+				if err := snapshot.AttachChange(ctx, m.Client(), &msg, nil); err != nil {
+					return nil, err
+				}
+
+				c := change{
+					subject:   "load-balancer-port",
+					msg:       msg,
+					publisher: m.EventsPublisher,
+					relationships: func(ctx context.Context) error {
+						if len(relationships) != 0 && m.Op().Is(ent.OpCreate) {
+							if err := permissions.CreateAuthRelationships(ctx, "load-balancer-port", objID, relationships...); err != nil {
+								return fmt.Errorf("relationship request failed with error: %w", err)
+							}
+						}
+
+						return nil
+					},
+				}
+
+				if err := sendChange(ctx, m, o, c); err != nil {
+					return nil, err
+				}
+
+				return retValue, nil
+			})
+		},
+		ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne,
+	)
+
+	// Delete Hook
+	dhook := hook.On(
+		func(next ent.Mutator) ent.Mutator {
+			return hook.PortFunc(func(ctx context.Context, m *generated.PortMutation) (ent.Value, error) {
+				additionalSubjects := []gidx.PrefixedID{}
+				relationships := []events.AuthRelationshipRelation{}
+
+				objID, ok := m.ID()
+				if !ok {
+					return nil, fmt.Errorf("object doesn't have an id %s", objID)
+				}
+
+				dbObj, err := m.Client().Port.Get(ctx, objID)
+				if err != nil {
+					return nil, fmt.Errorf("failed to load object to get values for event, err %w", err)
+				}
+				additionalSubjects = addSubjects(additionalSubjects, dbObj.LoadBalancerID)
+				relationships = append(relationships, events.AuthRelationshipRelation{
+					Relation:  "loadbalancer",
+					SubjectID: dbObj.LoadBalancerID,
+				})
+
+				// Ensure we have additional relevant subjects in the msg
+				related, err := portRelatedSubjects(ctx, m.Client(), objID, true)
+				if err != nil {
+					return nil, err
+				}
+
+				additionalSubjects = addSubjects(additionalSubjects, related...)
+
+				// we have all the info we need, now complete the mutation before we process the event
+				retValue, err := next.Mutate(ctx, m)
+				if err != nil {
+					return retValue, err
+				}
+
+				msg := events.ChangeMessage{
+					EventType:            string(events.DeleteChangeType),
+					SubjectID:            objID,
+					AdditionalSubjectIDs: additionalSubjects,
+					Timestamp:            time.Now().UTC(),
+				}
+
+				if err := auditlog.RecordChange(ctx, m.Client(), &msg, dbObj); err != nil {
+					return nil, err
+				}
+
+				if err := snapshot.AttachChange(ctx, m.Client(), &msg, dbObj); err != nil {
+					return nil, err
+				}
+
+				c := change{
+					subject:   "load-balancer-port",
+					msg:       msg,
+					publisher: m.EventsPublisher,
+					relationships: func(ctx context.Context) error {
+						if len(relationships) != 0 {
+							if err := permissions.DeleteAuthRelationships(ctx, "load-balancer-port", objID, relationships...); err != nil {
+								return fmt.Errorf("relationship request failed with error: %w", err)
+							}
+						}
+
+						return nil
+					},
+				}
+
+				if err := sendChange(ctx, m, o, c); err != nil {
+					return nil, err
+				}
+
+				return retValue, nil
+			})
+		},
+		ent.OpDelete|ent.OpDeleteOne,
+	)
+
+	// only trigger create/update hook when the deleted_at field is not set
+	cuhook = hook.If(
+		cuhook,
+		hook.Not(
+			hook.And(
+				hook.Or(
+					hook.HasOp(ent.OpUpdate),
+					hook.HasOp(ent.OpUpdateOne),
+				),
+				hook.HasFields("deleted_at"),
+			),
+		),
+	)
+
+	return []ent.Hook{cuhook, dhook}
+}
+
+// portRelatedSubjects loads the subjects related to the Port through its edges
+func portRelatedSubjects(ctx context.Context, c *generated.Client, id gidx.PrefixedID, deleted bool) ([]gidx.PrefixedID, error) {
+	subjects := []gidx.PrefixedID{}
+
+	{
+		rows, err := c.Port.Query().Where(port.IDEQ(id)).QueryLoadBalancer().All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load related subjects: %w", err)
+		}
+
+		for _, row := range rows {
+			subjects = addSubjects(subjects, row.LocationID, row.OwnerID, row.ProviderID)
+		}
+	}
+
+	if !deleted {
+		rows, err := c.Port.Query().Where(port.IDEQ(id)).QueryPools().All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load related subjects: %w", err)
+		}
+
+		for _, row := range rows {
+			subjects = addSubjects(subjects, row.ID, row.OwnerID)
+		}
+	}
+
+	return subjects, nil
+}
+
+// ProviderHooks returns the hooks publishing the changes of Provider objects
+func ProviderHooks(opts ...Option) []ent.Hook {
+	o := newOptions(opts...)
+
+	cuhook := hook.On(
+		func(next ent.Mutator) ent.Mutator {
+			return hook.ProviderFunc(func(ctx context.Context, m *generated.ProviderMutation) (ent.Value, error) {
+				var err error
+				additionalSubjects := []gidx.PrefixedID{}
+				relationships := []events.AuthRelationshipRelation{}
+
+				objID, ok := m.ID()
+				if !ok {
+					return nil, fmt.Errorf("object doesn't have an id %s", objID)
+				}
+
+				changeset := []events.FieldChange{}
+				cv_created_at := ""
+				created_at, ok := m.CreatedAt()
+
+				if ok {
+					cv_created_at = created_at.Format(time.RFC3339)
+					pv_created_at := ""
+					if !m.Op().Is(ent.OpCreate) {
+						ov, err := m.OldCreatedAt(ctx)
+						if err != nil {
+							pv_created_at = "<unknown>"
+						} else {
+							pv_created_at = ov.Format(time.RFC3339)
+						}
+					}
+
+					changeset = append(changeset, events.FieldChange{
+						Field:         "created_at",
+						PreviousValue: pv_created_at,
+						CurrentValue:  cv_created_at,
+					})
+				}
+
+				cv_updated_at := ""
+				updated_at, ok := m.UpdatedAt()
+
+				if ok {
+					cv_updated_at = updated_at.Format(time.RFC3339)
+					pv_updated_at := ""
+					if !m.Op().Is(ent.OpCreate) {
+						ov, err := m.OldUpdatedAt(ctx)
+						if err != nil {
+							pv_updated_at = "<unknown>"
+						} else {
+							pv_updated_at = ov.Format(time.RFC3339)
+						}
+					}
+
+					changeset = append(changeset, events.FieldChange{
+						Field:         "updated_at",
+						PreviousValue: pv_updated_at,
+						CurrentValue:  cv_updated_at,
+					})
+				}
+
+				cv_name := ""
+				name, ok := m.Name()
+
+				if ok {
+					cv_name = fmt.Sprintf("%s", fmt.Sprint(name))
+					pv_name := ""
+					if !m.Op().Is(ent.OpCreate) {
+						ov, err := m.OldName(ctx)
+						if err != nil {
+							pv_name = "<unknown>"
+						} else {
+							pv_name = fmt.Sprintf("%s", fmt.Sprint(ov))
+						}
+					}
+
+					changeset = append(changeset, events.FieldChange{
+						Field:         "name",
+						PreviousValue: pv_name,
+						CurrentValue:  cv_name,
+					})
+				}
+
+				cv_owner_id := ""
+				owner_id, ok := m.OwnerID()
+				if !ok && !m.Op().Is(ent.OpCreate) {
+					// since we are doing an update or delete and these fields didn't change, load the "old" value
+					owner_id, err = m.OldOwnerID(ctx)
+					if err != nil {
+						return nil, err
+					}
+				}
+
+				additionalSubjects = addSubjects(additionalSubjects, owner_id)
+				relationships = append(relationships, events.AuthRelationshipRelation{
+					Relation:  "owner",
+					SubjectID: owner_id,
+				})
+
+				if ok {
+					cv_owner_id = fmt.Sprintf("%s", fmt.Sprint(owner_id))
+					pv_owner_id := ""
+					if !m.Op().Is(ent.OpCreate) {
+						ov, err := m.OldOwnerID(ctx)
+						if err != nil {
+							pv_owner_id = "<unknown>"
+						} else {
+							pv_owner_id = fmt.Sprintf("%s", fmt.Sprint(ov))
+						}
+					}
+
+					changeset = append(changeset, events.FieldChange{
+						Field:         "owner_id",
+						PreviousValue: pv_owner_id,
+						CurrentValue:  cv_owner_id,
+					})
+				}
+
+				// add the extra relationships from the config
+				relationships = append(relationships, config.ExtraRelationships("provider")...)
+
+				msg := events.ChangeMessage{
+					EventType:            eventType(m.Op()),
+					SubjectID:            objID,
+					AdditionalSubjectIDs: additionalSubjects,
+					Timestamp:            time.Now().UTC(),
+					FieldChanges:         changeset,
+				}
+
+				// complete the mutation before we process the event
+				retValue, err := next.Mutate(ctx, m)
+				if err != nil {
+					return retValue, err
+				}
+
+				if err := auditlog.RecordChange(ctx, m.Client(), &msg, nil); err != nil {
+					return nil, err
+				}
+
+				if err := snapshot.AttachChange(ctx, m.Client(), &msg, nil); err != nil {
+					return nil, err
+				}
+
+				c := change{
+					subject:   "load-balancer-provider",
+					msg:       msg,
+					publisher: m.EventsPublisher,
+					relationships: func(ctx context.Context) error {
+						if len(relationships) != 0 && m.Op().Is(ent.OpCreate) {
+							if err := permissions.CreateAuthRelationships(ctx, "load-balancer-provider", objID, relationships...); err != nil {
+								return fmt.Errorf("relationship request failed with error: %w", err)
+							}
+						}
+
+						return nil
+					},
+				}
+
+				if err := sendChange(ctx, m, o, c); err != nil {
+					return nil, err
+				}
+
+				return retValue, nil
+			})
+		},
+		ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne,
+	)
+
+	// Delete Hook
+	dhook := hook.On(
+		func(next ent.Mutator) ent.Mutator {
+			return hook.ProviderFunc(func(ctx context.Context, m *generated.ProviderMutation) (ent.Value, error) {
+				additionalSubjects := []gidx.PrefixedID{}
+				relationships := []events.AuthRelationshipRelation{}
+
+				objID, ok := m.ID()
+				if !ok {
+					return nil, fmt.Errorf("object doesn't have an id %s", objID)
+				}
+
+				dbObj, err := m.Client().Provider.Get(ctx, objID)
+				if err != nil {
+					return nil, fmt.Errorf("failed to load object to get values for event, err %w", err)
+				}
+				additionalSubjects = addSubjects(additionalSubjects, dbObj.OwnerID)
+				relationships = append(relationships, events.AuthRelationshipRelation{
+					Relation:  "owner",
+					SubjectID: dbObj.OwnerID,
+				})
+
+				// remove the extra relationships from the config
+				relationships = append(relationships, config.ExtraRelationships("provider")...)
+
+				// we have all the info we need, now complete the mutation before we process the event
+				retValue, err := next.Mutate(ctx, m)
+				if err != nil {
+					return retValue, err
+				}
+
+				msg := events.ChangeMessage{
+					EventType:            string(events.DeleteChangeType),
+					SubjectID:            objID,
+					AdditionalSubjectIDs: additionalSubjects,
+					Timestamp:            time.Now().UTC(),
+				}
+
+				if err := auditlog.RecordChange(ctx, m.Client(), &msg, dbObj); err != nil {
+					return nil, err
+				}
+
+				if err := snapshot.AttachChange(ctx, m.Client(), &msg, dbObj); err != nil {
+					return nil, err
+				}
+
+				c := change{
+					subject:   "load-balancer-provider",
+					msg:       msg,
+					publisher: m.EventsPublisher,
+					relationships: func(ctx context.Context) error {
+						if len(relationships) != 0 {
+							if err := permissions.DeleteAuthRelationships(ctx, "load-balancer-provider", objID, relationships...); err != nil {
+								return fmt.Errorf("relationship request failed with error: %w", err)
+							}
+						}
+
+						return nil
+					},
+				}
+
+				if err := sendChange(ctx, m, o, c); err != nil {
+					return nil, err
+				}
+
+				return retValue, nil
+			})
+		},
+		ent.OpDelete|ent.OpDeleteOne,
+	)
+
+	// only trigger create/update hook when the deleted_at field is not set
+	cuhook = hook.If(
+		cuhook,
+		hook.Not(
+			hook.And(
+				hook.Or(
+					hook.HasOp(ent.OpUpdate),
+					hook.HasOp(ent.OpUpdateOne),
+				),
+				hook.HasFields("deleted_at"),
+			),
+		),
+	)
+
+	return []ent.Hook{cuhook, dhook}
+}
+
+// Option configures the event hooks
+type Option func(*options)
+
+type options struct {
+	logger *zap.SugaredLogger
+	outbox Outbox
+}
+
+// Outbox stores the committed changes that could not be published, so they are published later
+type Outbox interface {
+	Add(ctx context.Context, subject string, msg events.ChangeMessage) error
+}
+
+// WithLogger sets the logger changes that could not be published are logged to
+func WithLogger(l *zap.SugaredLogger) Option {
+	return func(o *options) {
+		o.logger = l
+	}
+}
+
+// WithOutbox sets the outbox committed changes that could not be published are stored in. Without
+// an outbox the commit returns the publish errors.
+func WithOutbox(ob Outbox) Option {
+	return func(o *options) {
+		o.outbox = ob
+	}
+}
+
+func newOptions(opts ...Option) options {
+	o := options{logger: zap.NewNop().Sugar()}
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
+
+// EventHooks registers the event hooks with the client
+func EventHooks(c *generated.Client, opts ...Option) {
+	c.LoadBalancer.Use(LoadBalancerHooks(opts...)...)
+	c.Origin.Use(OriginHooks(opts...)...)
+	c.Pool.Use(PoolHooks(opts...)...)
+	c.Port.Use(PortHooks(opts...)...)
+	c.Provider.Use(ProviderHooks(opts...)...)
+}
+
+func eventType(op ent.Op) string {
+	switch op {
+	case ent.OpCreate:
+		return string(events.CreateChangeType)
+	case ent.OpUpdate, ent.OpUpdateOne:
+		return string(events.UpdateChangeType)
+	case ent.OpDelete, ent.OpDeleteOne:
+		return string(events.DeleteChangeType)
+	default:
+		return "unknown"
+	}
+}
+
+// addSubjects appends the subjects that are set and not in the list yet
+func addSubjects(subjects []gidx.PrefixedID, ids ...gidx.PrefixedID) []gidx.PrefixedID {
+	for _, id := range ids {
+		if id != gidx.NullPrefixedID && !slices.Contains(subjects, id) {
+			subjects = append(subjects, id)
+		}
+	}
+
+	return subjects
+}
+
+// txMutation is implemented by every generated mutation and reports the transaction it runs in
+type txMutation interface {
+	Tx() (*generated.Tx, error)
+}
+
+type commitQueueKey struct{}
+
+// change is the work done for a stored object: writing its auth relationships and publishing
+// its change message
+type change struct {
+	subject       string
+	msg           events.ChangeMessage
+	publisher     events.Publisher
+	relationships func(ctx context.Context) error
+}
+
+func (c change) publish(ctx context.Context) error {
+	if _, err := c.publisher.PublishChange(ctx, c.subject, c.msg); err != nil {
+		return fmt.Errorf("failed to publish change: %w", err)
+	}
+
+	return nil
+}
+
+// sendChange writes the relationships of the change and publishes it. When the mutation is not
+// part of a transaction both happen right away and their errors fail the mutation.
+//
+// In a transaction the relationships are written right before the commit, and a failure rolls
+// the transaction back. The change is published once the commit succeeded, so that events are
+// never sent for changes that end up being rolled back. A change that can't be published is
+// stored in the outbox to be published later, or returned from the commit without an outbox.
+func sendChange(ctx context.Context, m txMutation, o options, c change) error {
+	tx, err := m.Tx()
+	if err != nil {
+		if err := c.relationships(ctx); err != nil {
+			return err
+		}
+
+		return c.publish(ctx)
+	}
+
+	tx.OnCommit(func(next generated.Committer) generated.Committer {
+		return generated.CommitFunc(func(commitCtx context.Context, tx *generated.Tx) error {
+			// commit hooks wrap each other in the order the mutations happened, so writing the
+			// relationships before calling the next hook writes them all before the commit
+			if err := c.relationships(ctx); err != nil {
+				if rerr := tx.Rollback(); rerr != nil {
+					return errors.Join(err, fmt.Errorf("failed to rollback transaction: %w", rerr))
+				}
+
+				return err
+			}
+
+			// the outermost hook owns the queue and publishes everything in the order the
+			// mutations happened once the commit succeeded
+			queue, ok := commitCtx.Value(commitQueueKey{}).(*[]change)
+			if ok {
+				*queue = append(*queue, c)
+
+				return next.Commit(commitCtx, tx)
+			}
+
+			queue = &[]change{c}
+
+			if err := next.Commit(context.WithValue(commitCtx, commitQueueKey{}, queue), tx); err != nil {
+				return err
+			}
+
+			var errs []error
+
+			for _, c := range *queue {
+				if err := c.publish(ctx); err != nil {
+					errs = append(errs, o.store(ctx, c, err))
+				}
+			}
+
+			return errors.Join(errs...)
+		})
+	})
+
+	return nil
+}
+
+// store adds a committed change that could not be published to the outbox, returning the
+// publish error when there is no outbox or the change could not be added
+func (o options) store(ctx context.Context, c change, err error) error {
+	if o.outbox == nil {
+		return err
+	}
+
+	if oerr := o.outbox.Add(context.WithoutCancel(ctx), c.subject, c.msg); oerr != nil {
+		return errors.Join(err, fmt.Errorf("failed to store change in the outbox: %w", oerr))
+	}
+
+	o.logger.Warnw("committed change could not be published and was stored in the outbox",
+		"eventType", c.msg.EventType, "subjectID", c.msg.SubjectID, "error", err)
+
+	return nil
+}
@@ -24,6 +24,7 @@ import (
 	"entgo.io/contrib/entgql"
 	"entgo.io/ent/dialect/sql"
 	"github.com/99designs/gqlgen/graphql"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/auditevent"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/loadbalancer"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/origin"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/pool"
@@ -32,6 +33,112 @@ import (
 	"go.infratographer.com/x/gidx"
 )
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (ae *AuditEventQuery) CollectFields(ctx context.Context, satisfies ...string) (*AuditEventQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return ae, nil
+	}
+	if err := ae.collectField(ctx, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return ae, nil
+}
+
+func (ae *AuditEventQuery) collectField(ctx context.Context, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(auditevent.Columns))
+		selectedFields = []string{auditevent.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "createdAt":
+			if _, ok := fieldSeen[auditevent.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, auditevent.FieldCreatedAt)
+				fieldSeen[auditevent.FieldCreatedAt] = struct{}{}
+			}
+		case "actor":
+			if _, ok := fieldSeen[auditevent.FieldActor]; !ok {
+				selectedFields = append(selectedFields, auditevent.FieldActor)
+				fieldSeen[auditevent.FieldActor] = struct{}{}
+			}
+		case "operation":
+			if _, ok := fieldSeen[auditevent.FieldOperation]; !ok {
+				selectedFields = append(selectedFields, auditevent.FieldOperation)
+				fieldSeen[auditevent.FieldOperation] = struct{}{}
+			}
+		case "subjectID":
+			if _, ok := fieldSeen[auditevent.FieldSubjectID]; !ok {
+				selectedFields = append(selectedFields, auditevent.FieldSubjectID)
+				fieldSeen[auditevent.FieldSubjectID] = struct{}{}
+			}
+		case "changeset":
+			if _, ok := fieldSeen[auditevent.FieldChangeset]; !ok {
+				selectedFields = append(selectedFields, auditevent.FieldChangeset)
+				fieldSeen[auditevent.FieldChangeset] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		ae.Select(selectedFields...)
+	}
+	return nil
+}
+
+type auditeventPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []AuditEventPaginateOption
+}
+
+func newAuditEventPaginateArgs(rv map[string]any) *auditeventPaginateArgs {
+	args := &auditeventPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &AuditEventOrder{Field: &AuditEventOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithAuditEventOrder(order))
+			}
+		case *AuditEventOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithAuditEventOrder(v))
+			}
+		}
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (lb *LoadBalancerQuery) CollectFields(ctx context.Context, satisfies ...string) (*LoadBalancerQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -171,6 +278,11 @@ func (lb *LoadBalancerQuery) collectField(ctx context.Context, opCtx *graphql.Op
 				selectedFields = append(selectedFields, loadbalancer.FieldUpdatedBy)
 				fieldSeen[loadbalancer.FieldUpdatedBy] = struct{}{}
 			}
+		case "version":
+			if _, ok := fieldSeen[loadbalancer.FieldVersion]; !ok {
+				selectedFields = append(selectedFields, loadbalancer.FieldVersion)
+				fieldSeen[loadbalancer.FieldVersion] = struct{}{}
+			}
 		case "deletedAt":
 			if _, ok := fieldSeen[loadbalancer.FieldDeletedAt]; !ok {
 				selectedFields = append(selectedFields, loadbalancer.FieldDeletedAt)
@@ -314,6 +426,11 @@ func (o *OriginQuery) collectField(ctx context.Context, opCtx *graphql.Operation
 				selectedFields = append(selectedFields, origin.FieldUpdatedBy)
 				fieldSeen[origin.FieldUpdatedBy] = struct{}{}
 			}
+		case "version":
+			if _, ok := fieldSeen[origin.FieldVersion]; !ok {
+				selectedFields = append(selectedFields, origin.FieldVersion)
+				fieldSeen[origin.FieldVersion] = struct{}{}
+			}
 		case "name":
 			if _, ok := fieldSeen[origin.FieldName]; !ok {
 				selectedFields = append(selectedFields, origin.FieldName)
@@ -544,6 +661,11 @@ func (po *PoolQuery) collectField(ctx context.Context, opCtx *graphql.OperationC
 				selectedFields = append(selectedFields, pool.FieldUpdatedBy)
 				fieldSeen[pool.FieldUpdatedBy] = struct{}{}
 			}
+		case "version":
+			if _, ok := fieldSeen[pool.FieldVersion]; !ok {
+				selectedFields = append(selectedFields, pool.FieldVersion)
+				fieldSeen[pool.FieldVersion] = struct{}{}
+			}
 		case "deletedAt":
 			if _, ok := fieldSeen[pool.FieldDeletedAt]; !ok {
 				selectedFields = append(selectedFields, pool.FieldDeletedAt)
@@ -709,6 +831,11 @@ func (po *PortQuery) collectField(ctx context.Context, opCtx *graphql.OperationC
 				selectedFields = append(selectedFields, port.FieldUpdatedBy)
 				fieldSeen[port.FieldUpdatedBy] = struct{}{}
 			}
+		case "version":
+			if _, ok := fieldSeen[port.FieldVersion]; !ok {
+				selectedFields = append(selectedFields, port.FieldVersion)
+				fieldSeen[port.FieldVersion] = struct{}{}
+			}
 		case "number":
 			if _, ok := fieldSeen[port.FieldNumber]; !ok {
 				selectedFields = append(selectedFields, port.FieldNumber)
@@ -922,6 +1049,11 @@ func (pr *ProviderQuery) collectField(ctx context.Context, opCtx *graphql.Operat
 				selectedFields = append(selectedFields, provider.FieldUpdatedBy)
 				fieldSeen[provider.FieldUpdatedBy] = struct{}{}
 			}
+		case "version":
+			if _, ok := fieldSeen[provider.FieldVersion]; !ok {
+				selectedFields = append(selectedFields, provider.FieldVersion)
+				fieldSeen[provider.FieldVersion] = struct{}{}
+			}
 		case "name":
 			if _, ok := fieldSeen[provider.FieldName]; !ok {
 				selectedFields = append(selectedFields, provider.FieldName)
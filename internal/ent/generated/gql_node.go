@@ -23,6 +23,7 @@ import (
 	"entgo.io/contrib/entgql"
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/hashicorp/go-multierror"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/auditevent"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/loadbalancer"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/origin"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/pool"
@@ -36,6 +37,9 @@ type Noder interface {
 	IsNode()
 }
 
+// IsNode implements the Node interface check for GQLGen.
+func (n *AuditEvent) IsNode() {}
+
 // IsNode implements the Node interface check for GQLGen.
 func (n *LoadBalancer) IsNode() {}
 
@@ -109,6 +113,22 @@ func (c *Client) Noder(ctx context.Context, id gidx.PrefixedID, opts ...NodeOpti
 
 func (c *Client) noder(ctx context.Context, table string, id gidx.PrefixedID) (Noder, error) {
 	switch table {
+	case auditevent.Table:
+		var uid gidx.PrefixedID
+		if err := uid.UnmarshalGQL(id); err != nil {
+			return nil, err
+		}
+		query := c.AuditEvent.Query().
+			Where(auditevent.ID(uid))
+		query, err := query.CollectFields(ctx, "AuditEvent")
+		if err != nil {
+			return nil, err
+		}
+		n, err := query.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
 	case loadbalancer.Table:
 		var uid gidx.PrefixedID
 		if err := uid.UnmarshalGQL(id); err != nil {
@@ -262,6 +282,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []gidx.PrefixedID
 		idmap[id] = append(idmap[id], &noders[i])
 	}
 	switch table {
+	case auditevent.Table:
+		query := c.AuditEvent.Query().
+			Where(auditevent.IDIn(ids...))
+		query, err := query.CollectFields(ctx, "AuditEvent")
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case loadbalancer.Table:
 		query := c.LoadBalancer.Query().
 			Where(loadbalancer.IDIn(ids...))
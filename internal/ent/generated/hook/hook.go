@@ -23,6 +23,30 @@ import (
 	"go.infratographer.com/load-balancer-api/internal/ent/generated"
 )
 
+// The AuditEventFunc type is an adapter to allow the use of ordinary
+// function as AuditEvent mutator.
+type AuditEventFunc func(context.Context, *generated.AuditEventMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditEventFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.AuditEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.AuditEventMutation", m)
+}
+
+// The IdempotencyKeyFunc type is an adapter to allow the use of ordinary
+// function as IdempotencyKey mutator.
+type IdempotencyKeyFunc func(context.Context, *generated.IdempotencyKeyMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IdempotencyKeyFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.IdempotencyKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.IdempotencyKeyMutation", m)
+}
+
 // The LoadBalancerFunc type is an adapter to allow the use of ordinary
 // function as LoadBalancer mutator.
 type LoadBalancerFunc func(context.Context, *generated.LoadBalancerMutation) (generated.Value, error)
@@ -47,6 +71,30 @@ func (f OriginFunc) Mutate(ctx context.Context, m generated.Mutation) (generated
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.OriginMutation", m)
 }
 
+// The OwnerDeletionFunc type is an adapter to allow the use of ordinary
+// function as OwnerDeletion mutator.
+type OwnerDeletionFunc func(context.Context, *generated.OwnerDeletionMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OwnerDeletionFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.OwnerDeletionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.OwnerDeletionMutation", m)
+}
+
+// The PendingChangeFunc type is an adapter to allow the use of ordinary
+// function as PendingChange mutator.
+type PendingChangeFunc func(context.Context, *generated.PendingChangeMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PendingChangeFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.PendingChangeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.PendingChangeMutation", m)
+}
+
 // The PoolFunc type is an adapter to allow the use of ordinary
 // function as Pool mutator.
 type PoolFunc func(context.Context, *generated.PoolMutation) (generated.Value, error)
@@ -0,0 +1,295 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/idempotencykey"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/predicate"
+	"go.infratographer.com/x/gidx"
+)
+
+// IdempotencyKeyUpdate is the builder for updating IdempotencyKey entities.
+type IdempotencyKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *IdempotencyKeyMutation
+}
+
+// Where appends a list predicates to the IdempotencyKeyUpdate builder.
+func (iku *IdempotencyKeyUpdate) Where(ps ...predicate.IdempotencyKey) *IdempotencyKeyUpdate {
+	iku.mutation.Where(ps...)
+	return iku
+}
+
+// SetResourceID sets the "resource_id" field.
+func (iku *IdempotencyKeyUpdate) SetResourceID(gi gidx.PrefixedID) *IdempotencyKeyUpdate {
+	iku.mutation.SetResourceID(gi)
+	return iku
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (iku *IdempotencyKeyUpdate) SetNillableResourceID(gi *gidx.PrefixedID) *IdempotencyKeyUpdate {
+	if gi != nil {
+		iku.SetResourceID(*gi)
+	}
+	return iku
+}
+
+// ClearResourceID clears the value of the "resource_id" field.
+func (iku *IdempotencyKeyUpdate) ClearResourceID() *IdempotencyKeyUpdate {
+	iku.mutation.ClearResourceID()
+	return iku
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (iku *IdempotencyKeyUpdate) SetLeaseExpiresAt(t time.Time) *IdempotencyKeyUpdate {
+	iku.mutation.SetLeaseExpiresAt(t)
+	return iku
+}
+
+// SetNillableLeaseExpiresAt sets the "lease_expires_at" field if the given value is not nil.
+func (iku *IdempotencyKeyUpdate) SetNillableLeaseExpiresAt(t *time.Time) *IdempotencyKeyUpdate {
+	if t != nil {
+		iku.SetLeaseExpiresAt(*t)
+	}
+	return iku
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (iku *IdempotencyKeyUpdate) ClearLeaseExpiresAt() *IdempotencyKeyUpdate {
+	iku.mutation.ClearLeaseExpiresAt()
+	return iku
+}
+
+// Mutation returns the IdempotencyKeyMutation object of the builder.
+func (iku *IdempotencyKeyUpdate) Mutation() *IdempotencyKeyMutation {
+	return iku.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iku *IdempotencyKeyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, iku.sqlSave, iku.mutation, iku.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iku *IdempotencyKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := iku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iku *IdempotencyKeyUpdate) Exec(ctx context.Context) error {
+	_, err := iku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iku *IdempotencyKeyUpdate) ExecX(ctx context.Context) {
+	if err := iku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (iku *IdempotencyKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(idempotencykey.Table, idempotencykey.Columns, sqlgraph.NewFieldSpec(idempotencykey.FieldID, field.TypeString))
+	if ps := iku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iku.mutation.ResourceID(); ok {
+		_spec.SetField(idempotencykey.FieldResourceID, field.TypeString, value)
+	}
+	if iku.mutation.ResourceIDCleared() {
+		_spec.ClearField(idempotencykey.FieldResourceID, field.TypeString)
+	}
+	if value, ok := iku.mutation.LeaseExpiresAt(); ok {
+		_spec.SetField(idempotencykey.FieldLeaseExpiresAt, field.TypeTime, value)
+	}
+	if iku.mutation.LeaseExpiresAtCleared() {
+		_spec.ClearField(idempotencykey.FieldLeaseExpiresAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{idempotencykey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iku.mutation.done = true
+	return n, nil
+}
+
+// IdempotencyKeyUpdateOne is the builder for updating a single IdempotencyKey entity.
+type IdempotencyKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *IdempotencyKeyMutation
+}
+
+// SetResourceID sets the "resource_id" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetResourceID(gi gidx.PrefixedID) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetResourceID(gi)
+	return ikuo
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (ikuo *IdempotencyKeyUpdateOne) SetNillableResourceID(gi *gidx.PrefixedID) *IdempotencyKeyUpdateOne {
+	if gi != nil {
+		ikuo.SetResourceID(*gi)
+	}
+	return ikuo
+}
+
+// ClearResourceID clears the value of the "resource_id" field.
+func (ikuo *IdempotencyKeyUpdateOne) ClearResourceID() *IdempotencyKeyUpdateOne {
+	ikuo.mutation.ClearResourceID()
+	return ikuo
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetLeaseExpiresAt(t time.Time) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetLeaseExpiresAt(t)
+	return ikuo
+}
+
+// SetNillableLeaseExpiresAt sets the "lease_expires_at" field if the given value is not nil.
+func (ikuo *IdempotencyKeyUpdateOne) SetNillableLeaseExpiresAt(t *time.Time) *IdempotencyKeyUpdateOne {
+	if t != nil {
+		ikuo.SetLeaseExpiresAt(*t)
+	}
+	return ikuo
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (ikuo *IdempotencyKeyUpdateOne) ClearLeaseExpiresAt() *IdempotencyKeyUpdateOne {
+	ikuo.mutation.ClearLeaseExpiresAt()
+	return ikuo
+}
+
+// Mutation returns the IdempotencyKeyMutation object of the builder.
+func (ikuo *IdempotencyKeyUpdateOne) Mutation() *IdempotencyKeyMutation {
+	return ikuo.mutation
+}
+
+// Where appends a list predicates to the IdempotencyKeyUpdate builder.
+func (ikuo *IdempotencyKeyUpdateOne) Where(ps ...predicate.IdempotencyKey) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.Where(ps...)
+	return ikuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ikuo *IdempotencyKeyUpdateOne) Select(field string, fields ...string) *IdempotencyKeyUpdateOne {
+	ikuo.fields = append([]string{field}, fields...)
+	return ikuo
+}
+
+// Save executes the query and returns the updated IdempotencyKey entity.
+func (ikuo *IdempotencyKeyUpdateOne) Save(ctx context.Context) (*IdempotencyKey, error) {
+	return withHooks(ctx, ikuo.sqlSave, ikuo.mutation, ikuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ikuo *IdempotencyKeyUpdateOne) SaveX(ctx context.Context) *IdempotencyKey {
+	node, err := ikuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ikuo *IdempotencyKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := ikuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ikuo *IdempotencyKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := ikuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ikuo *IdempotencyKeyUpdateOne) sqlSave(ctx context.Context) (_node *IdempotencyKey, err error) {
+	_spec := sqlgraph.NewUpdateSpec(idempotencykey.Table, idempotencykey.Columns, sqlgraph.NewFieldSpec(idempotencykey.FieldID, field.TypeString))
+	id, ok := ikuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "IdempotencyKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ikuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, idempotencykey.FieldID)
+		for _, f := range fields {
+			if !idempotencykey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != idempotencykey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ikuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ikuo.mutation.ResourceID(); ok {
+		_spec.SetField(idempotencykey.FieldResourceID, field.TypeString, value)
+	}
+	if ikuo.mutation.ResourceIDCleared() {
+		_spec.ClearField(idempotencykey.FieldResourceID, field.TypeString)
+	}
+	if value, ok := ikuo.mutation.LeaseExpiresAt(); ok {
+		_spec.SetField(idempotencykey.FieldLeaseExpiresAt, field.TypeTime, value)
+	}
+	if ikuo.mutation.LeaseExpiresAtCleared() {
+		_spec.ClearField(idempotencykey.FieldLeaseExpiresAt, field.TypeTime)
+	}
+	_node = &IdempotencyKey{config: ikuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ikuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{idempotencykey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ikuo.mutation.done = true
+	return _node, nil
+}
@@ -22,8 +22,12 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/auditevent"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/idempotencykey"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/loadbalancer"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/origin"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/ownerdeletion"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/pendingchange"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/pool"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/port"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/predicate"
@@ -86,6 +90,60 @@ func (f TraverseFunc) Traverse(ctx context.Context, q generated.Query) error {
 	return f(ctx, query)
 }
 
+// The AuditEventFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AuditEventFunc func(context.Context, *generated.AuditEventQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f AuditEventFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.AuditEventQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.AuditEventQuery", q)
+}
+
+// The TraverseAuditEvent type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAuditEvent func(context.Context, *generated.AuditEventQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAuditEvent) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAuditEvent) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.AuditEventQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.AuditEventQuery", q)
+}
+
+// The IdempotencyKeyFunc type is an adapter to allow the use of ordinary function as a Querier.
+type IdempotencyKeyFunc func(context.Context, *generated.IdempotencyKeyQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f IdempotencyKeyFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.IdempotencyKeyQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.IdempotencyKeyQuery", q)
+}
+
+// The TraverseIdempotencyKey type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseIdempotencyKey func(context.Context, *generated.IdempotencyKeyQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseIdempotencyKey) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseIdempotencyKey) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.IdempotencyKeyQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.IdempotencyKeyQuery", q)
+}
+
 // The LoadBalancerFunc type is an adapter to allow the use of ordinary function as a Querier.
 type LoadBalancerFunc func(context.Context, *generated.LoadBalancerQuery) (generated.Value, error)
 
@@ -140,6 +198,60 @@ func (f TraverseOrigin) Traverse(ctx context.Context, q generated.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *generated.OriginQuery", q)
 }
 
+// The OwnerDeletionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type OwnerDeletionFunc func(context.Context, *generated.OwnerDeletionQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f OwnerDeletionFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.OwnerDeletionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.OwnerDeletionQuery", q)
+}
+
+// The TraverseOwnerDeletion type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseOwnerDeletion func(context.Context, *generated.OwnerDeletionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseOwnerDeletion) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseOwnerDeletion) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.OwnerDeletionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.OwnerDeletionQuery", q)
+}
+
+// The PendingChangeFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PendingChangeFunc func(context.Context, *generated.PendingChangeQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f PendingChangeFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.PendingChangeQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.PendingChangeQuery", q)
+}
+
+// The TraversePendingChange type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePendingChange func(context.Context, *generated.PendingChangeQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePendingChange) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePendingChange) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.PendingChangeQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.PendingChangeQuery", q)
+}
+
 // The PoolFunc type is an adapter to allow the use of ordinary function as a Querier.
 type PoolFunc func(context.Context, *generated.PoolQuery) (generated.Value, error)
 
@@ -224,10 +336,18 @@ func (f TraverseProvider) Traverse(ctx context.Context, q generated.Query) error
 // NewQuery returns the generic Query interface for the given typed query.
 func NewQuery(q generated.Query) (Query, error) {
 	switch q := q.(type) {
+	case *generated.AuditEventQuery:
+		return &query[*generated.AuditEventQuery, predicate.AuditEvent, auditevent.OrderOption]{typ: generated.TypeAuditEvent, tq: q}, nil
+	case *generated.IdempotencyKeyQuery:
+		return &query[*generated.IdempotencyKeyQuery, predicate.IdempotencyKey, idempotencykey.OrderOption]{typ: generated.TypeIdempotencyKey, tq: q}, nil
 	case *generated.LoadBalancerQuery:
 		return &query[*generated.LoadBalancerQuery, predicate.LoadBalancer, loadbalancer.OrderOption]{typ: generated.TypeLoadBalancer, tq: q}, nil
 	case *generated.OriginQuery:
 		return &query[*generated.OriginQuery, predicate.Origin, origin.OrderOption]{typ: generated.TypeOrigin, tq: q}, nil
+	case *generated.OwnerDeletionQuery:
+		return &query[*generated.OwnerDeletionQuery, predicate.OwnerDeletion, ownerdeletion.OrderOption]{typ: generated.TypeOwnerDeletion, tq: q}, nil
+	case *generated.PendingChangeQuery:
+		return &query[*generated.PendingChangeQuery, predicate.PendingChange, pendingchange.OrderOption]{typ: generated.TypePendingChange, tq: q}, nil
 	case *generated.PoolQuery:
 		return &query[*generated.PoolQuery, predicate.Pool, pool.OrderOption]{typ: generated.TypePool, tq: q}, nil
 	case *generated.PortQuery:
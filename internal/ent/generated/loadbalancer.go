@@ -42,6 +42,8 @@ type LoadBalancer struct {
 	CreatedBy string `json:"created_by,omitempty"`
 	// UpdatedBy holds the value of the "updated_by" field.
 	UpdatedBy string `json:"updated_by,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
 	// DeletedAt holds the value of the "deleted_at" field.
 	DeletedAt time.Time `json:"deleted_at,omitempty"`
 	// DeletedBy holds the value of the "deleted_by" field.
@@ -104,6 +106,8 @@ func (*LoadBalancer) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case loadbalancer.FieldID, loadbalancer.FieldOwnerID, loadbalancer.FieldLocationID, loadbalancer.FieldProviderID:
 			values[i] = new(gidx.PrefixedID)
+		case loadbalancer.FieldVersion:
+			values[i] = new(sql.NullInt64)
 		case loadbalancer.FieldCreatedBy, loadbalancer.FieldUpdatedBy, loadbalancer.FieldDeletedBy, loadbalancer.FieldName:
 			values[i] = new(sql.NullString)
 		case loadbalancer.FieldCreatedAt, loadbalancer.FieldUpdatedAt, loadbalancer.FieldDeletedAt:
@@ -153,6 +157,12 @@ func (lb *LoadBalancer) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				lb.UpdatedBy = value.String
 			}
+		case loadbalancer.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				lb.Version = int(value.Int64)
+			}
 		case loadbalancer.FieldDeletedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
@@ -247,6 +257,9 @@ func (lb *LoadBalancer) String() string {
 	builder.WriteString("updated_by=")
 	builder.WriteString(lb.UpdatedBy)
 	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", lb.Version))
+	builder.WriteString(", ")
 	builder.WriteString("deleted_at=")
 	builder.WriteString(lb.DeletedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
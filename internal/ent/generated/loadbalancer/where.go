@@ -90,6 +90,11 @@ func UpdatedBy(v string) predicate.LoadBalancer {
 	return predicate.LoadBalancer(sql.FieldEQ(FieldUpdatedBy, v))
 }
 
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.LoadBalancer {
+	return predicate.LoadBalancer(sql.FieldEQ(FieldVersion, v))
+}
+
 // DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
 func DeletedAt(v time.Time) predicate.LoadBalancer {
 	return predicate.LoadBalancer(sql.FieldEQ(FieldDeletedAt, v))
@@ -350,6 +355,46 @@ func UpdatedByContainsFold(v string) predicate.LoadBalancer {
 	return predicate.LoadBalancer(sql.FieldContainsFold(FieldUpdatedBy, v))
 }
 
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.LoadBalancer {
+	return predicate.LoadBalancer(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.LoadBalancer {
+	return predicate.LoadBalancer(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.LoadBalancer {
+	return predicate.LoadBalancer(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.LoadBalancer {
+	return predicate.LoadBalancer(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.LoadBalancer {
+	return predicate.LoadBalancer(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.LoadBalancer {
+	return predicate.LoadBalancer(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.LoadBalancer {
+	return predicate.LoadBalancer(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.LoadBalancer {
+	return predicate.LoadBalancer(sql.FieldLTE(FieldVersion, v))
+}
+
 // DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
 func DeletedAtEQ(v time.Time) predicate.LoadBalancer {
 	return predicate.LoadBalancer(sql.FieldEQ(FieldDeletedAt, v))
@@ -93,6 +93,20 @@ func (lbc *LoadBalancerCreate) SetNillableUpdatedBy(s *string) *LoadBalancerCrea
 	return lbc
 }
 
+// SetVersion sets the "version" field.
+func (lbc *LoadBalancerCreate) SetVersion(i int) *LoadBalancerCreate {
+	lbc.mutation.SetVersion(i)
+	return lbc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (lbc *LoadBalancerCreate) SetNillableVersion(i *int) *LoadBalancerCreate {
+	if i != nil {
+		lbc.SetVersion(*i)
+	}
+	return lbc
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (lbc *LoadBalancerCreate) SetDeletedAt(t time.Time) *LoadBalancerCreate {
 	lbc.mutation.SetDeletedAt(t)
@@ -230,6 +244,10 @@ func (lbc *LoadBalancerCreate) defaults() error {
 		v := loadbalancer.DefaultUpdatedAt()
 		lbc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := lbc.mutation.Version(); !ok {
+		v := loadbalancer.DefaultVersion
+		lbc.mutation.SetVersion(v)
+	}
 	if _, ok := lbc.mutation.ID(); !ok {
 		if loadbalancer.DefaultID == nil {
 			return fmt.Errorf("generated: uninitialized loadbalancer.DefaultID (forgotten import generated/runtime?)")
@@ -248,6 +266,9 @@ func (lbc *LoadBalancerCreate) check() error {
 	if _, ok := lbc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "LoadBalancer.updated_at"`)}
 	}
+	if _, ok := lbc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`generated: missing required field "LoadBalancer.version"`)}
+	}
 	if _, ok := lbc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`generated: missing required field "LoadBalancer.name"`)}
 	}
@@ -334,6 +355,10 @@ func (lbc *LoadBalancerCreate) createSpec() (*LoadBalancer, *sqlgraph.CreateSpec
 		_spec.SetField(loadbalancer.FieldUpdatedBy, field.TypeString, value)
 		_node.UpdatedBy = value
 	}
+	if value, ok := lbc.mutation.Version(); ok {
+		_spec.SetField(loadbalancer.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
 	if value, ok := lbc.mutation.DeletedAt(); ok {
 		_spec.SetField(loadbalancer.FieldDeletedAt, field.TypeTime, value)
 		_node.DeletedAt = value
@@ -64,6 +64,27 @@ func (lbu *LoadBalancerUpdate) ClearUpdatedBy() *LoadBalancerUpdate {
 	return lbu
 }
 
+// SetVersion sets the "version" field.
+func (lbu *LoadBalancerUpdate) SetVersion(i int) *LoadBalancerUpdate {
+	lbu.mutation.ResetVersion()
+	lbu.mutation.SetVersion(i)
+	return lbu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (lbu *LoadBalancerUpdate) SetNillableVersion(i *int) *LoadBalancerUpdate {
+	if i != nil {
+		lbu.SetVersion(*i)
+	}
+	return lbu
+}
+
+// AddVersion adds i to the "version" field.
+func (lbu *LoadBalancerUpdate) AddVersion(i int) *LoadBalancerUpdate {
+	lbu.mutation.AddVersion(i)
+	return lbu
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (lbu *LoadBalancerUpdate) SetDeletedAt(t time.Time) *LoadBalancerUpdate {
 	lbu.mutation.SetDeletedAt(t)
@@ -230,6 +251,12 @@ func (lbu *LoadBalancerUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if lbu.mutation.UpdatedByCleared() {
 		_spec.ClearField(loadbalancer.FieldUpdatedBy, field.TypeString)
 	}
+	if value, ok := lbu.mutation.Version(); ok {
+		_spec.SetField(loadbalancer.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := lbu.mutation.AddedVersion(); ok {
+		_spec.AddField(loadbalancer.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := lbu.mutation.DeletedAt(); ok {
 		_spec.SetField(loadbalancer.FieldDeletedAt, field.TypeTime, value)
 	}
@@ -330,6 +357,27 @@ func (lbuo *LoadBalancerUpdateOne) ClearUpdatedBy() *LoadBalancerUpdateOne {
 	return lbuo
 }
 
+// SetVersion sets the "version" field.
+func (lbuo *LoadBalancerUpdateOne) SetVersion(i int) *LoadBalancerUpdateOne {
+	lbuo.mutation.ResetVersion()
+	lbuo.mutation.SetVersion(i)
+	return lbuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (lbuo *LoadBalancerUpdateOne) SetNillableVersion(i *int) *LoadBalancerUpdateOne {
+	if i != nil {
+		lbuo.SetVersion(*i)
+	}
+	return lbuo
+}
+
+// AddVersion adds i to the "version" field.
+func (lbuo *LoadBalancerUpdateOne) AddVersion(i int) *LoadBalancerUpdateOne {
+	lbuo.mutation.AddVersion(i)
+	return lbuo
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (lbuo *LoadBalancerUpdateOne) SetDeletedAt(t time.Time) *LoadBalancerUpdateOne {
 	lbuo.mutation.SetDeletedAt(t)
@@ -526,6 +574,12 @@ func (lbuo *LoadBalancerUpdateOne) sqlSave(ctx context.Context) (_node *LoadBala
 	if lbuo.mutation.UpdatedByCleared() {
 		_spec.ClearField(loadbalancer.FieldUpdatedBy, field.TypeString)
 	}
+	if value, ok := lbuo.mutation.Version(); ok {
+		_spec.SetField(loadbalancer.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := lbuo.mutation.AddedVersion(); ok {
+		_spec.AddField(loadbalancer.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := lbuo.mutation.DeletedAt(); ok {
 		_spec.SetField(loadbalancer.FieldDeletedAt, field.TypeTime, value)
 	}
@@ -17,11 +17,85 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// AuditEventsColumns holds the columns for the "audit_events" table.
+	AuditEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "actor", Type: field.TypeString},
+		{Name: "operation", Type: field.TypeString},
+		{Name: "subject_id", Type: field.TypeString},
+		{Name: "related_ids", Type: field.TypeJSON},
+		{Name: "fields", Type: field.TypeJSON},
+		{Name: "changeset", Type: field.TypeJSON},
+	}
+	// AuditEventsTable holds the schema information for the "audit_events" table.
+	AuditEventsTable = &schema.Table{
+		Name:       "audit_events",
+		Columns:    AuditEventsColumns,
+		PrimaryKey: []*schema.Column{AuditEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditevent_subject_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[4]},
+			},
+			{
+				Name:    "auditevent_related_ids",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[5]},
+				Annotation: &entsql.IndexAnnotation{
+					Types: map[string]string{
+						"postgres": "GIN",
+					},
+				},
+			},
+			{
+				Name:    "auditevent_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[1]},
+			},
+			{
+				Name:    "auditevent_actor",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[2]},
+			},
+		},
+	}
+	// IdempotencyKeysColumns holds the columns for the "idempotency_keys" table.
+	IdempotencyKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "key", Type: field.TypeString, Size: 255},
+		{Name: "owner_id", Type: field.TypeString},
+		{Name: "request_hash", Type: field.TypeString},
+		{Name: "resource_id", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "lease_expires_at", Type: field.TypeTime, Nullable: true},
+	}
+	// IdempotencyKeysTable holds the schema information for the "idempotency_keys" table.
+	IdempotencyKeysTable = &schema.Table{
+		Name:       "idempotency_keys",
+		Columns:    IdempotencyKeysColumns,
+		PrimaryKey: []*schema.Column{IdempotencyKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idempotencykey_owner_id_key",
+				Unique:  true,
+				Columns: []*schema.Column{IdempotencyKeysColumns[2], IdempotencyKeysColumns[1]},
+			},
+			{
+				Name:    "idempotencykey_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{IdempotencyKeysColumns[6]},
+			},
+		},
+	}
 	// LoadBalancersColumns holds the columns for the "load_balancers" table.
 	LoadBalancersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -29,6 +103,7 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "created_by", Type: field.TypeString, Nullable: true},
 		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
 		{Name: "name", Type: field.TypeString, Size: 2147483647},
@@ -44,7 +119,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "load_balancers_providers_provider",
-				Columns:    []*schema.Column{LoadBalancersColumns[10]},
+				Columns:    []*schema.Column{LoadBalancersColumns[11]},
 				RefColumns: []*schema.Column{ProvidersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -63,17 +138,17 @@ var (
 			{
 				Name:    "loadbalancer_provider_id",
 				Unique:  false,
-				Columns: []*schema.Column{LoadBalancersColumns[10]},
+				Columns: []*schema.Column{LoadBalancersColumns[11]},
 			},
 			{
 				Name:    "loadbalancer_location_id",
 				Unique:  false,
-				Columns: []*schema.Column{LoadBalancersColumns[9]},
+				Columns: []*schema.Column{LoadBalancersColumns[10]},
 			},
 			{
 				Name:    "loadbalancer_owner_id",
 				Unique:  false,
-				Columns: []*schema.Column{LoadBalancersColumns[8]},
+				Columns: []*schema.Column{LoadBalancersColumns[9]},
 			},
 		},
 	}
@@ -86,6 +161,7 @@ var (
 		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
 		{Name: "created_by", Type: field.TypeString, Nullable: true},
 		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "name", Type: field.TypeString},
 		{Name: "weight", Type: field.TypeInt32, Default: 100},
 		{Name: "target", Type: field.TypeString},
@@ -101,7 +177,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "origins_pools_pool",
-				Columns:    []*schema.Column{OriginsColumns[12]},
+				Columns:    []*schema.Column{OriginsColumns[13]},
 				RefColumns: []*schema.Column{PoolsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -120,7 +196,51 @@ var (
 			{
 				Name:    "origin_pool_id",
 				Unique:  false,
-				Columns: []*schema.Column{OriginsColumns[12]},
+				Columns: []*schema.Column{OriginsColumns[13]},
+			},
+		},
+	}
+	// OwnerDeletionsColumns holds the columns for the "owner_deletions" table.
+	OwnerDeletionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "owner_id", Type: field.TypeString, Unique: true},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "skipped_ids", Type: field.TypeJSON, Nullable: true},
+	}
+	// OwnerDeletionsTable holds the schema information for the "owner_deletions" table.
+	OwnerDeletionsTable = &schema.Table{
+		Name:       "owner_deletions",
+		Columns:    OwnerDeletionsColumns,
+		PrimaryKey: []*schema.Column{OwnerDeletionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ownerdeletion_completed_at",
+				Unique:  false,
+				Columns: []*schema.Column{OwnerDeletionsColumns[3]},
+			},
+		},
+	}
+	// PendingChangesColumns holds the columns for the "pending_changes" table.
+	PendingChangesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "message", Type: field.TypeJSON},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+	}
+	// PendingChangesTable holds the schema information for the "pending_changes" table.
+	PendingChangesTable = &schema.Table{
+		Name:       "pending_changes",
+		Columns:    PendingChangesColumns,
+		PrimaryKey: []*schema.Column{PendingChangesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pendingchange_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{PendingChangesColumns[6]},
 			},
 		},
 	}
@@ -131,6 +251,7 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "created_by", Type: field.TypeString, Nullable: true},
 		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
 		{Name: "name", Type: field.TypeString},
@@ -156,7 +277,7 @@ var (
 			{
 				Name:    "pool_owner_id",
 				Unique:  false,
-				Columns: []*schema.Column{PoolsColumns[9]},
+				Columns: []*schema.Column{PoolsColumns[10]},
 			},
 		},
 	}
@@ -169,6 +290,7 @@ var (
 		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
 		{Name: "created_by", Type: field.TypeString, Nullable: true},
 		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "number", Type: field.TypeInt},
 		{Name: "name", Type: field.TypeString, Nullable: true},
 		{Name: "load_balancer_id", Type: field.TypeString},
@@ -181,7 +303,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "ports_load_balancers_load_balancer",
-				Columns:    []*schema.Column{PortsColumns[9]},
+				Columns:    []*schema.Column{PortsColumns[10]},
 				RefColumns: []*schema.Column{LoadBalancersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -200,12 +322,15 @@ var (
 			{
 				Name:    "port_load_balancer_id",
 				Unique:  false,
-				Columns: []*schema.Column{PortsColumns[9]},
+				Columns: []*schema.Column{PortsColumns[10]},
 			},
 			{
 				Name:    "port_load_balancer_id_number",
 				Unique:  true,
-				Columns: []*schema.Column{PortsColumns[9], PortsColumns[7]},
+				Columns: []*schema.Column{PortsColumns[10], PortsColumns[8]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at IS NULL",
+				},
 			},
 		},
 	}
@@ -218,6 +343,7 @@ var (
 		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
 		{Name: "created_by", Type: field.TypeString, Nullable: true},
 		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "name", Type: field.TypeString},
 		{Name: "owner_id", Type: field.TypeString},
 	}
@@ -240,7 +366,7 @@ var (
 			{
 				Name:    "provider_owner_id",
 				Unique:  false,
-				Columns: []*schema.Column{ProvidersColumns[8]},
+				Columns: []*schema.Column{ProvidersColumns[9]},
 			},
 		},
 	}
@@ -271,8 +397,12 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AuditEventsTable,
+		IdempotencyKeysTable,
 		LoadBalancersTable,
 		OriginsTable,
+		OwnerDeletionsTable,
+		PendingChangesTable,
 		PoolsTable,
 		PortsTable,
 		ProvidersTable,
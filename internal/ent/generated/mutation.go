@@ -25,12 +25,17 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/auditevent"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/idempotencykey"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/loadbalancer"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/origin"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/ownerdeletion"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/pendingchange"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/pool"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/port"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/predicate"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/provider"
+	"go.infratographer.com/x/events"
 	"go.infratographer.com/x/gidx"
 )
 
@@ -43,50 +48,50 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeLoadBalancer = "LoadBalancer"
-	TypeOrigin       = "Origin"
-	TypePool         = "Pool"
-	TypePort         = "Port"
-	TypeProvider     = "Provider"
+	TypeAuditEvent     = "AuditEvent"
+	TypeIdempotencyKey = "IdempotencyKey"
+	TypeLoadBalancer   = "LoadBalancer"
+	TypeOrigin         = "Origin"
+	TypeOwnerDeletion  = "OwnerDeletion"
+	TypePendingChange  = "PendingChange"
+	TypePool           = "Pool"
+	TypePort           = "Port"
+	TypeProvider       = "Provider"
 )
 
-// LoadBalancerMutation represents an operation that mutates the LoadBalancer nodes in the graph.
-type LoadBalancerMutation struct {
+// AuditEventMutation represents an operation that mutates the AuditEvent nodes in the graph.
+type AuditEventMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *gidx.PrefixedID
-	created_at      *time.Time
-	updated_at      *time.Time
-	created_by      *string
-	updated_by      *string
-	deleted_at      *time.Time
-	deleted_by      *string
-	name            *string
-	owner_id        *gidx.PrefixedID
-	location_id     *gidx.PrefixedID
-	clearedFields   map[string]struct{}
-	ports           map[gidx.PrefixedID]struct{}
-	removedports    map[gidx.PrefixedID]struct{}
-	clearedports    bool
-	provider        *gidx.PrefixedID
-	clearedprovider bool
-	done            bool
-	oldValue        func(context.Context) (*LoadBalancer, error)
-	predicates      []predicate.LoadBalancer
-}
-
-var _ ent.Mutation = (*LoadBalancerMutation)(nil)
-
-// loadbalancerOption allows management of the mutation configuration using functional options.
-type loadbalancerOption func(*LoadBalancerMutation)
-
-// newLoadBalancerMutation creates new mutation for the LoadBalancer entity.
-func newLoadBalancerMutation(c config, op Op, opts ...loadbalancerOption) *LoadBalancerMutation {
-	m := &LoadBalancerMutation{
+	op                Op
+	typ               string
+	id                *gidx.PrefixedID
+	created_at        *time.Time
+	actor             *string
+	operation         *string
+	subject_id        *gidx.PrefixedID
+	related_ids       *[]gidx.PrefixedID
+	appendrelated_ids []gidx.PrefixedID
+	fields            *[]string
+	appendfields      []string
+	changeset         *[]events.FieldChange
+	appendchangeset   []events.FieldChange
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*AuditEvent, error)
+	predicates        []predicate.AuditEvent
+}
+
+var _ ent.Mutation = (*AuditEventMutation)(nil)
+
+// auditeventOption allows management of the mutation configuration using functional options.
+type auditeventOption func(*AuditEventMutation)
+
+// newAuditEventMutation creates new mutation for the AuditEvent entity.
+func newAuditEventMutation(c config, op Op, opts ...auditeventOption) *AuditEventMutation {
+	m := &AuditEventMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeLoadBalancer,
+		typ:           TypeAuditEvent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -95,20 +100,20 @@ func newLoadBalancerMutation(c config, op Op, opts ...loadbalancerOption) *LoadB
 	return m
 }
 
-// withLoadBalancerID sets the ID field of the mutation.
-func withLoadBalancerID(id gidx.PrefixedID) loadbalancerOption {
-	return func(m *LoadBalancerMutation) {
+// withAuditEventID sets the ID field of the mutation.
+func withAuditEventID(id gidx.PrefixedID) auditeventOption {
+	return func(m *AuditEventMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *LoadBalancer
+			value *AuditEvent
 		)
-		m.oldValue = func(ctx context.Context) (*LoadBalancer, error) {
+		m.oldValue = func(ctx context.Context) (*AuditEvent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().LoadBalancer.Get(ctx, id)
+					value, err = m.Client().AuditEvent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -117,10 +122,10 @@ func withLoadBalancerID(id gidx.PrefixedID) loadbalancerOption {
 	}
 }
 
-// withLoadBalancer sets the old LoadBalancer of the mutation.
-func withLoadBalancer(node *LoadBalancer) loadbalancerOption {
-	return func(m *LoadBalancerMutation) {
-		m.oldValue = func(context.Context) (*LoadBalancer, error) {
+// withAuditEvent sets the old AuditEvent of the mutation.
+func withAuditEvent(node *AuditEvent) auditeventOption {
+	return func(m *AuditEventMutation) {
+		m.oldValue = func(context.Context) (*AuditEvent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -129,7 +134,7 @@ func withLoadBalancer(node *LoadBalancer) loadbalancerOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m LoadBalancerMutation) Client() *Client {
+func (m AuditEventMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -137,7 +142,7 @@ func (m LoadBalancerMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m LoadBalancerMutation) Tx() (*Tx, error) {
+func (m AuditEventMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("generated: mutation is not running in a transaction")
 	}
@@ -147,14 +152,14 @@ func (m LoadBalancerMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of LoadBalancer entities.
-func (m *LoadBalancerMutation) SetID(id gidx.PrefixedID) {
+// operation is only accepted on creation of AuditEvent entities.
+func (m *AuditEventMutation) SetID(id gidx.PrefixedID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *LoadBalancerMutation) ID() (id gidx.PrefixedID, exists bool) {
+func (m *AuditEventMutation) ID() (id gidx.PrefixedID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -165,7 +170,7 @@ func (m *LoadBalancerMutation) ID() (id gidx.PrefixedID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *LoadBalancerMutation) IDs(ctx context.Context) ([]gidx.PrefixedID, error) {
+func (m *AuditEventMutation) IDs(ctx context.Context) ([]gidx.PrefixedID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -174,19 +179,19 @@ func (m *LoadBalancerMutation) IDs(ctx context.Context) ([]gidx.PrefixedID, erro
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().LoadBalancer.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuditEvent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *LoadBalancerMutation) SetCreatedAt(t time.Time) {
+func (m *AuditEventMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *LoadBalancerMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *AuditEventMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -194,10 +199,10 @@ func (m *LoadBalancerMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the LoadBalancer entity.
-// If the LoadBalancer object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoadBalancerMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuditEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -212,476 +217,3124 @@ func (m *LoadBalancerMutation) OldCreatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *LoadBalancerMutation) ResetCreatedAt() {
+func (m *AuditEventMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *LoadBalancerMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetActor sets the "actor" field.
+func (m *AuditEventMutation) SetActor(s string) {
+	m.actor = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *LoadBalancerMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Actor returns the value of the "actor" field in the mutation.
+func (m *AuditEventMutation) Actor() (r string, exists bool) {
+	v := m.actor
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the LoadBalancer entity.
-// If the LoadBalancer object wasn't provided to the builder, the object is fetched from the database.
+// OldActor returns the old "actor" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoadBalancerMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuditEventMutation) OldActor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldActor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Actor, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *LoadBalancerMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetActor resets all changes to the "actor" field.
+func (m *AuditEventMutation) ResetActor() {
+	m.actor = nil
 }
 
-// SetCreatedBy sets the "created_by" field.
-func (m *LoadBalancerMutation) SetCreatedBy(s string) {
-	m.created_by = &s
+// SetOperation sets the "operation" field.
+func (m *AuditEventMutation) SetOperation(s string) {
+	m.operation = &s
 }
 
-// CreatedBy returns the value of the "created_by" field in the mutation.
-func (m *LoadBalancerMutation) CreatedBy() (r string, exists bool) {
-	v := m.created_by
+// Operation returns the value of the "operation" field in the mutation.
+func (m *AuditEventMutation) Operation() (r string, exists bool) {
+	v := m.operation
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedBy returns the old "created_by" field's value of the LoadBalancer entity.
-// If the LoadBalancer object wasn't provided to the builder, the object is fetched from the database.
+// OldOperation returns the old "operation" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoadBalancerMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+func (m *AuditEventMutation) OldOperation(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldOperation is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+		return v, errors.New("OldOperation requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldOperation: %w", err)
 	}
-	return oldValue.CreatedBy, nil
-}
-
-// ClearCreatedBy clears the value of the "created_by" field.
-func (m *LoadBalancerMutation) ClearCreatedBy() {
-	m.created_by = nil
-	m.clearedFields[loadbalancer.FieldCreatedBy] = struct{}{}
-}
-
-// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
-func (m *LoadBalancerMutation) CreatedByCleared() bool {
-	_, ok := m.clearedFields[loadbalancer.FieldCreatedBy]
-	return ok
+	return oldValue.Operation, nil
 }
 
-// ResetCreatedBy resets all changes to the "created_by" field.
-func (m *LoadBalancerMutation) ResetCreatedBy() {
-	m.created_by = nil
-	delete(m.clearedFields, loadbalancer.FieldCreatedBy)
+// ResetOperation resets all changes to the "operation" field.
+func (m *AuditEventMutation) ResetOperation() {
+	m.operation = nil
 }
 
-// SetUpdatedBy sets the "updated_by" field.
-func (m *LoadBalancerMutation) SetUpdatedBy(s string) {
-	m.updated_by = &s
+// SetSubjectID sets the "subject_id" field.
+func (m *AuditEventMutation) SetSubjectID(gi gidx.PrefixedID) {
+	m.subject_id = &gi
 }
 
-// UpdatedBy returns the value of the "updated_by" field in the mutation.
-func (m *LoadBalancerMutation) UpdatedBy() (r string, exists bool) {
-	v := m.updated_by
+// SubjectID returns the value of the "subject_id" field in the mutation.
+func (m *AuditEventMutation) SubjectID() (r gidx.PrefixedID, exists bool) {
+	v := m.subject_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedBy returns the old "updated_by" field's value of the LoadBalancer entity.
-// If the LoadBalancer object wasn't provided to the builder, the object is fetched from the database.
+// OldSubjectID returns the old "subject_id" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoadBalancerMutation) OldUpdatedBy(ctx context.Context) (v string, err error) {
+func (m *AuditEventMutation) OldSubjectID(ctx context.Context) (v gidx.PrefixedID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldSubjectID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
+		return v, errors.New("OldSubjectID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldSubjectID: %w", err)
 	}
-	return oldValue.UpdatedBy, nil
-}
-
-// ClearUpdatedBy clears the value of the "updated_by" field.
-func (m *LoadBalancerMutation) ClearUpdatedBy() {
-	m.updated_by = nil
-	m.clearedFields[loadbalancer.FieldUpdatedBy] = struct{}{}
-}
-
-// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
-func (m *LoadBalancerMutation) UpdatedByCleared() bool {
-	_, ok := m.clearedFields[loadbalancer.FieldUpdatedBy]
-	return ok
+	return oldValue.SubjectID, nil
 }
 
-// ResetUpdatedBy resets all changes to the "updated_by" field.
-func (m *LoadBalancerMutation) ResetUpdatedBy() {
-	m.updated_by = nil
-	delete(m.clearedFields, loadbalancer.FieldUpdatedBy)
+// ResetSubjectID resets all changes to the "subject_id" field.
+func (m *AuditEventMutation) ResetSubjectID() {
+	m.subject_id = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *LoadBalancerMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetRelatedIds sets the "related_ids" field.
+func (m *AuditEventMutation) SetRelatedIds(gi []gidx.PrefixedID) {
+	m.related_ids = &gi
+	m.appendrelated_ids = nil
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *LoadBalancerMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// RelatedIds returns the value of the "related_ids" field in the mutation.
+func (m *AuditEventMutation) RelatedIds() (r []gidx.PrefixedID, exists bool) {
+	v := m.related_ids
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the LoadBalancer entity.
-// If the LoadBalancer object wasn't provided to the builder, the object is fetched from the database.
+// OldRelatedIds returns the old "related_ids" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoadBalancerMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuditEventMutation) OldRelatedIds(ctx context.Context) (v []gidx.PrefixedID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRelatedIds is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldRelatedIds requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRelatedIds: %w", err)
 	}
-	return oldValue.DeletedAt, nil
+	return oldValue.RelatedIds, nil
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *LoadBalancerMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[loadbalancer.FieldDeletedAt] = struct{}{}
+// AppendRelatedIds adds gi to the "related_ids" field.
+func (m *AuditEventMutation) AppendRelatedIds(gi []gidx.PrefixedID) {
+	m.appendrelated_ids = append(m.appendrelated_ids, gi...)
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *LoadBalancerMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[loadbalancer.FieldDeletedAt]
-	return ok
+// AppendedRelatedIds returns the list of values that were appended to the "related_ids" field in this mutation.
+func (m *AuditEventMutation) AppendedRelatedIds() ([]gidx.PrefixedID, bool) {
+	if len(m.appendrelated_ids) == 0 {
+		return nil, false
+	}
+	return m.appendrelated_ids, true
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *LoadBalancerMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, loadbalancer.FieldDeletedAt)
+// ResetRelatedIds resets all changes to the "related_ids" field.
+func (m *AuditEventMutation) ResetRelatedIds() {
+	m.related_ids = nil
+	m.appendrelated_ids = nil
 }
 
-// SetDeletedBy sets the "deleted_by" field.
-func (m *LoadBalancerMutation) SetDeletedBy(s string) {
-	m.deleted_by = &s
+// SetFields sets the "fields" field.
+func (m *AuditEventMutation) SetFields(s []string) {
+	m.fields = &s
+	m.appendfields = nil
 }
 
-// DeletedBy returns the value of the "deleted_by" field in the mutation.
-func (m *LoadBalancerMutation) DeletedBy() (r string, exists bool) {
-	v := m.deleted_by
+// GetFields returns the value of the "fields" field in the mutation.
+func (m *AuditEventMutation) GetFields() (r []string, exists bool) {
+	v := m.fields
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedBy returns the old "deleted_by" field's value of the LoadBalancer entity.
-// If the LoadBalancer object wasn't provided to the builder, the object is fetched from the database.
+// OldFields returns the old "fields" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoadBalancerMutation) OldDeletedBy(ctx context.Context) (v string, err error) {
+func (m *AuditEventMutation) OldFields(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldFields is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
+		return v, errors.New("OldFields requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldFields: %w", err)
 	}
-	return oldValue.DeletedBy, nil
+	return oldValue.Fields, nil
 }
 
-// ClearDeletedBy clears the value of the "deleted_by" field.
-func (m *LoadBalancerMutation) ClearDeletedBy() {
-	m.deleted_by = nil
-	m.clearedFields[loadbalancer.FieldDeletedBy] = struct{}{}
+// AppendFields adds s to the "fields" field.
+func (m *AuditEventMutation) AppendFields(s []string) {
+	m.appendfields = append(m.appendfields, s...)
 }
 
-// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
-func (m *LoadBalancerMutation) DeletedByCleared() bool {
-	_, ok := m.clearedFields[loadbalancer.FieldDeletedBy]
-	return ok
+// AppendedFields returns the list of values that were appended to the "fields" field in this mutation.
+func (m *AuditEventMutation) AppendedFields() ([]string, bool) {
+	if len(m.appendfields) == 0 {
+		return nil, false
+	}
+	return m.appendfields, true
 }
 
-// ResetDeletedBy resets all changes to the "deleted_by" field.
-func (m *LoadBalancerMutation) ResetDeletedBy() {
-	m.deleted_by = nil
-	delete(m.clearedFields, loadbalancer.FieldDeletedBy)
+// ResetFields resets all changes to the "fields" field.
+func (m *AuditEventMutation) ResetFields() {
+	m.fields = nil
+	m.appendfields = nil
 }
 
-// SetName sets the "name" field.
-func (m *LoadBalancerMutation) SetName(s string) {
-	m.name = &s
+// SetChangeset sets the "changeset" field.
+func (m *AuditEventMutation) SetChangeset(ec []events.FieldChange) {
+	m.changeset = &ec
+	m.appendchangeset = nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *LoadBalancerMutation) Name() (r string, exists bool) {
-	v := m.name
+// Changeset returns the value of the "changeset" field in the mutation.
+func (m *AuditEventMutation) Changeset() (r []events.FieldChange, exists bool) {
+	v := m.changeset
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the LoadBalancer entity.
-// If the LoadBalancer object wasn't provided to the builder, the object is fetched from the database.
+// OldChangeset returns the old "changeset" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoadBalancerMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *AuditEventMutation) OldChangeset(ctx context.Context) (v []events.FieldChange, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldChangeset is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldChangeset requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldChangeset: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Changeset, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *LoadBalancerMutation) ResetName() {
-	m.name = nil
+// AppendChangeset adds ec to the "changeset" field.
+func (m *AuditEventMutation) AppendChangeset(ec []events.FieldChange) {
+	m.appendchangeset = append(m.appendchangeset, ec...)
 }
 
-// SetOwnerID sets the "owner_id" field.
-func (m *LoadBalancerMutation) SetOwnerID(gi gidx.PrefixedID) {
-	m.owner_id = &gi
+// AppendedChangeset returns the list of values that were appended to the "changeset" field in this mutation.
+func (m *AuditEventMutation) AppendedChangeset() ([]events.FieldChange, bool) {
+	if len(m.appendchangeset) == 0 {
+		return nil, false
+	}
+	return m.appendchangeset, true
 }
 
-// OwnerID returns the value of the "owner_id" field in the mutation.
-func (m *LoadBalancerMutation) OwnerID() (r gidx.PrefixedID, exists bool) {
-	v := m.owner_id
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetChangeset resets all changes to the "changeset" field.
+func (m *AuditEventMutation) ResetChangeset() {
+	m.changeset = nil
+	m.appendchangeset = nil
 }
 
-// OldOwnerID returns the old "owner_id" field's value of the LoadBalancer entity.
-// If the LoadBalancer object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoadBalancerMutation) OldOwnerID(ctx context.Context) (v gidx.PrefixedID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwnerID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+// Where appends a list predicates to the AuditEventMutation builder.
+func (m *AuditEventMutation) Where(ps ...predicate.AuditEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AuditEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AuditEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.OwnerID, nil
+	m.Where(p...)
 }
 
-// ResetOwnerID resets all changes to the "owner_id" field.
-func (m *LoadBalancerMutation) ResetOwnerID() {
-	m.owner_id = nil
+// Op returns the operation name.
+func (m *AuditEventMutation) Op() Op {
+	return m.op
 }
 
-// SetLocationID sets the "location_id" field.
-func (m *LoadBalancerMutation) SetLocationID(gi gidx.PrefixedID) {
-	m.location_id = &gi
+// SetOp allows setting the mutation operation.
+func (m *AuditEventMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// LocationID returns the value of the "location_id" field in the mutation.
-func (m *LoadBalancerMutation) LocationID() (r gidx.PrefixedID, exists bool) {
-	v := m.location_id
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (AuditEvent).
+func (m *AuditEventMutation) Type() string {
+	return m.typ
 }
 
-// OldLocationID returns the old "location_id" field's value of the LoadBalancer entity.
-// If the LoadBalancer object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoadBalancerMutation) OldLocationID(ctx context.Context) (v gidx.PrefixedID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLocationID is only allowed on UpdateOne operations")
-	}
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuditEventMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.created_at != nil {
+		fields = append(fields, auditevent.FieldCreatedAt)
+	}
+	if m.actor != nil {
+		fields = append(fields, auditevent.FieldActor)
+	}
+	if m.operation != nil {
+		fields = append(fields, auditevent.FieldOperation)
+	}
+	if m.subject_id != nil {
+		fields = append(fields, auditevent.FieldSubjectID)
+	}
+	if m.related_ids != nil {
+		fields = append(fields, auditevent.FieldRelatedIds)
+	}
+	if m.fields != nil {
+		fields = append(fields, auditevent.FieldFields)
+	}
+	if m.changeset != nil {
+		fields = append(fields, auditevent.FieldChangeset)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuditEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case auditevent.FieldCreatedAt:
+		return m.CreatedAt()
+	case auditevent.FieldActor:
+		return m.Actor()
+	case auditevent.FieldOperation:
+		return m.Operation()
+	case auditevent.FieldSubjectID:
+		return m.SubjectID()
+	case auditevent.FieldRelatedIds:
+		return m.RelatedIds()
+	case auditevent.FieldFields:
+		return m.GetFields()
+	case auditevent.FieldChangeset:
+		return m.Changeset()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuditEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case auditevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case auditevent.FieldActor:
+		return m.OldActor(ctx)
+	case auditevent.FieldOperation:
+		return m.OldOperation(ctx)
+	case auditevent.FieldSubjectID:
+		return m.OldSubjectID(ctx)
+	case auditevent.FieldRelatedIds:
+		return m.OldRelatedIds(ctx)
+	case auditevent.FieldFields:
+		return m.OldFields(ctx)
+	case auditevent.FieldChangeset:
+		return m.OldChangeset(ctx)
+	}
+	return nil, fmt.Errorf("unknown AuditEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case auditevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case auditevent.FieldActor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActor(v)
+		return nil
+	case auditevent.FieldOperation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOperation(v)
+		return nil
+	case auditevent.FieldSubjectID:
+		v, ok := value.(gidx.PrefixedID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubjectID(v)
+		return nil
+	case auditevent.FieldRelatedIds:
+		v, ok := value.([]gidx.PrefixedID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRelatedIds(v)
+		return nil
+	case auditevent.FieldFields:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFields(v)
+		return nil
+	case auditevent.FieldChangeset:
+		v, ok := value.([]events.FieldChange)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChangeset(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuditEventMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuditEventMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown AuditEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuditEventMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuditEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AuditEventMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown AuditEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AuditEventMutation) ResetField(name string) error {
+	switch name {
+	case auditevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case auditevent.FieldActor:
+		m.ResetActor()
+		return nil
+	case auditevent.FieldOperation:
+		m.ResetOperation()
+		return nil
+	case auditevent.FieldSubjectID:
+		m.ResetSubjectID()
+		return nil
+	case auditevent.FieldRelatedIds:
+		m.ResetRelatedIds()
+		return nil
+	case auditevent.FieldFields:
+		m.ResetFields()
+		return nil
+	case auditevent.FieldChangeset:
+		m.ResetChangeset()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AuditEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AuditEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AuditEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AuditEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AuditEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AuditEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AuditEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuditEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditEvent edge %s", name)
+}
+
+// IdempotencyKeyMutation represents an operation that mutates the IdempotencyKey nodes in the graph.
+type IdempotencyKeyMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *gidx.PrefixedID
+	key              *string
+	owner_id         *gidx.PrefixedID
+	request_hash     *string
+	resource_id      *gidx.PrefixedID
+	created_at       *time.Time
+	expires_at       *time.Time
+	lease_expires_at *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*IdempotencyKey, error)
+	predicates       []predicate.IdempotencyKey
+}
+
+var _ ent.Mutation = (*IdempotencyKeyMutation)(nil)
+
+// idempotencykeyOption allows management of the mutation configuration using functional options.
+type idempotencykeyOption func(*IdempotencyKeyMutation)
+
+// newIdempotencyKeyMutation creates new mutation for the IdempotencyKey entity.
+func newIdempotencyKeyMutation(c config, op Op, opts ...idempotencykeyOption) *IdempotencyKeyMutation {
+	m := &IdempotencyKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIdempotencyKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIdempotencyKeyID sets the ID field of the mutation.
+func withIdempotencyKeyID(id gidx.PrefixedID) idempotencykeyOption {
+	return func(m *IdempotencyKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IdempotencyKey
+		)
+		m.oldValue = func(ctx context.Context) (*IdempotencyKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IdempotencyKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIdempotencyKey sets the old IdempotencyKey of the mutation.
+func withIdempotencyKey(node *IdempotencyKey) idempotencykeyOption {
+	return func(m *IdempotencyKeyMutation) {
+		m.oldValue = func(context.Context) (*IdempotencyKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IdempotencyKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IdempotencyKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of IdempotencyKey entities.
+func (m *IdempotencyKeyMutation) SetID(id gidx.PrefixedID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IdempotencyKeyMutation) ID() (id gidx.PrefixedID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IdempotencyKeyMutation) IDs(ctx context.Context) ([]gidx.PrefixedID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []gidx.PrefixedID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().IdempotencyKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKey sets the "key" field.
+func (m *IdempotencyKeyMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *IdempotencyKeyMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *IdempotencyKeyMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (m *IdempotencyKeyMutation) SetOwnerID(gi gidx.PrefixedID) {
+	m.owner_id = &gi
+}
+
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *IdempotencyKeyMutation) OwnerID() (r gidx.PrefixedID, exists bool) {
+	v := m.owner_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerID returns the old "owner_id" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldOwnerID(ctx context.Context) (v gidx.PrefixedID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
+}
+
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *IdempotencyKeyMutation) ResetOwnerID() {
+	m.owner_id = nil
+}
+
+// SetRequestHash sets the "request_hash" field.
+func (m *IdempotencyKeyMutation) SetRequestHash(s string) {
+	m.request_hash = &s
+}
+
+// RequestHash returns the value of the "request_hash" field in the mutation.
+func (m *IdempotencyKeyMutation) RequestHash() (r string, exists bool) {
+	v := m.request_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestHash returns the old "request_hash" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldRequestHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestHash: %w", err)
+	}
+	return oldValue.RequestHash, nil
+}
+
+// ResetRequestHash resets all changes to the "request_hash" field.
+func (m *IdempotencyKeyMutation) ResetRequestHash() {
+	m.request_hash = nil
+}
+
+// SetResourceID sets the "resource_id" field.
+func (m *IdempotencyKeyMutation) SetResourceID(gi gidx.PrefixedID) {
+	m.resource_id = &gi
+}
+
+// ResourceID returns the value of the "resource_id" field in the mutation.
+func (m *IdempotencyKeyMutation) ResourceID() (r gidx.PrefixedID, exists bool) {
+	v := m.resource_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceID returns the old "resource_id" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldResourceID(ctx context.Context) (v gidx.PrefixedID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
+	}
+	return oldValue.ResourceID, nil
+}
+
+// ClearResourceID clears the value of the "resource_id" field.
+func (m *IdempotencyKeyMutation) ClearResourceID() {
+	m.resource_id = nil
+	m.clearedFields[idempotencykey.FieldResourceID] = struct{}{}
+}
+
+// ResourceIDCleared returns if the "resource_id" field was cleared in this mutation.
+func (m *IdempotencyKeyMutation) ResourceIDCleared() bool {
+	_, ok := m.clearedFields[idempotencykey.FieldResourceID]
+	return ok
+}
+
+// ResetResourceID resets all changes to the "resource_id" field.
+func (m *IdempotencyKeyMutation) ResetResourceID() {
+	m.resource_id = nil
+	delete(m.clearedFields, idempotencykey.FieldResourceID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *IdempotencyKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *IdempotencyKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *IdempotencyKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *IdempotencyKeyMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *IdempotencyKeyMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *IdempotencyKeyMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (m *IdempotencyKeyMutation) SetLeaseExpiresAt(t time.Time) {
+	m.lease_expires_at = &t
+}
+
+// LeaseExpiresAt returns the value of the "lease_expires_at" field in the mutation.
+func (m *IdempotencyKeyMutation) LeaseExpiresAt() (r time.Time, exists bool) {
+	v := m.lease_expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeaseExpiresAt returns the old "lease_expires_at" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldLeaseExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeaseExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeaseExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeaseExpiresAt: %w", err)
+	}
+	return oldValue.LeaseExpiresAt, nil
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (m *IdempotencyKeyMutation) ClearLeaseExpiresAt() {
+	m.lease_expires_at = nil
+	m.clearedFields[idempotencykey.FieldLeaseExpiresAt] = struct{}{}
+}
+
+// LeaseExpiresAtCleared returns if the "lease_expires_at" field was cleared in this mutation.
+func (m *IdempotencyKeyMutation) LeaseExpiresAtCleared() bool {
+	_, ok := m.clearedFields[idempotencykey.FieldLeaseExpiresAt]
+	return ok
+}
+
+// ResetLeaseExpiresAt resets all changes to the "lease_expires_at" field.
+func (m *IdempotencyKeyMutation) ResetLeaseExpiresAt() {
+	m.lease_expires_at = nil
+	delete(m.clearedFields, idempotencykey.FieldLeaseExpiresAt)
+}
+
+// Where appends a list predicates to the IdempotencyKeyMutation builder.
+func (m *IdempotencyKeyMutation) Where(ps ...predicate.IdempotencyKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IdempotencyKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IdempotencyKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IdempotencyKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IdempotencyKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IdempotencyKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (IdempotencyKey).
+func (m *IdempotencyKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IdempotencyKeyMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.key != nil {
+		fields = append(fields, idempotencykey.FieldKey)
+	}
+	if m.owner_id != nil {
+		fields = append(fields, idempotencykey.FieldOwnerID)
+	}
+	if m.request_hash != nil {
+		fields = append(fields, idempotencykey.FieldRequestHash)
+	}
+	if m.resource_id != nil {
+		fields = append(fields, idempotencykey.FieldResourceID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, idempotencykey.FieldCreatedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, idempotencykey.FieldExpiresAt)
+	}
+	if m.lease_expires_at != nil {
+		fields = append(fields, idempotencykey.FieldLeaseExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IdempotencyKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case idempotencykey.FieldKey:
+		return m.Key()
+	case idempotencykey.FieldOwnerID:
+		return m.OwnerID()
+	case idempotencykey.FieldRequestHash:
+		return m.RequestHash()
+	case idempotencykey.FieldResourceID:
+		return m.ResourceID()
+	case idempotencykey.FieldCreatedAt:
+		return m.CreatedAt()
+	case idempotencykey.FieldExpiresAt:
+		return m.ExpiresAt()
+	case idempotencykey.FieldLeaseExpiresAt:
+		return m.LeaseExpiresAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IdempotencyKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case idempotencykey.FieldKey:
+		return m.OldKey(ctx)
+	case idempotencykey.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	case idempotencykey.FieldRequestHash:
+		return m.OldRequestHash(ctx)
+	case idempotencykey.FieldResourceID:
+		return m.OldResourceID(ctx)
+	case idempotencykey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case idempotencykey.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case idempotencykey.FieldLeaseExpiresAt:
+		return m.OldLeaseExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdempotencyKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case idempotencykey.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case idempotencykey.FieldOwnerID:
+		v, ok := value.(gidx.PrefixedID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
+	case idempotencykey.FieldRequestHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestHash(v)
+		return nil
+	case idempotencykey.FieldResourceID:
+		v, ok := value.(gidx.PrefixedID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceID(v)
+		return nil
+	case idempotencykey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case idempotencykey.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case idempotencykey.FieldLeaseExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeaseExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IdempotencyKeyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IdempotencyKeyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdempotencyKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown IdempotencyKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IdempotencyKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(idempotencykey.FieldResourceID) {
+		fields = append(fields, idempotencykey.FieldResourceID)
+	}
+	if m.FieldCleared(idempotencykey.FieldLeaseExpiresAt) {
+		fields = append(fields, idempotencykey.FieldLeaseExpiresAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IdempotencyKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IdempotencyKeyMutation) ClearField(name string) error {
+	switch name {
+	case idempotencykey.FieldResourceID:
+		m.ClearResourceID()
+		return nil
+	case idempotencykey.FieldLeaseExpiresAt:
+		m.ClearLeaseExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IdempotencyKeyMutation) ResetField(name string) error {
+	switch name {
+	case idempotencykey.FieldKey:
+		m.ResetKey()
+		return nil
+	case idempotencykey.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	case idempotencykey.FieldRequestHash:
+		m.ResetRequestHash()
+		return nil
+	case idempotencykey.FieldResourceID:
+		m.ResetResourceID()
+		return nil
+	case idempotencykey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case idempotencykey.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case idempotencykey.FieldLeaseExpiresAt:
+		m.ResetLeaseExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IdempotencyKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IdempotencyKeyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IdempotencyKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IdempotencyKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IdempotencyKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IdempotencyKeyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IdempotencyKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown IdempotencyKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IdempotencyKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown IdempotencyKey edge %s", name)
+}
+
+// LoadBalancerMutation represents an operation that mutates the LoadBalancer nodes in the graph.
+type LoadBalancerMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *gidx.PrefixedID
+	created_at      *time.Time
+	updated_at      *time.Time
+	created_by      *string
+	updated_by      *string
+	version         *int
+	addversion      *int
+	deleted_at      *time.Time
+	deleted_by      *string
+	name            *string
+	owner_id        *gidx.PrefixedID
+	location_id     *gidx.PrefixedID
+	clearedFields   map[string]struct{}
+	ports           map[gidx.PrefixedID]struct{}
+	removedports    map[gidx.PrefixedID]struct{}
+	clearedports    bool
+	provider        *gidx.PrefixedID
+	clearedprovider bool
+	done            bool
+	oldValue        func(context.Context) (*LoadBalancer, error)
+	predicates      []predicate.LoadBalancer
+}
+
+var _ ent.Mutation = (*LoadBalancerMutation)(nil)
+
+// loadbalancerOption allows management of the mutation configuration using functional options.
+type loadbalancerOption func(*LoadBalancerMutation)
+
+// newLoadBalancerMutation creates new mutation for the LoadBalancer entity.
+func newLoadBalancerMutation(c config, op Op, opts ...loadbalancerOption) *LoadBalancerMutation {
+	m := &LoadBalancerMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLoadBalancer,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLoadBalancerID sets the ID field of the mutation.
+func withLoadBalancerID(id gidx.PrefixedID) loadbalancerOption {
+	return func(m *LoadBalancerMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LoadBalancer
+		)
+		m.oldValue = func(ctx context.Context) (*LoadBalancer, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LoadBalancer.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLoadBalancer sets the old LoadBalancer of the mutation.
+func withLoadBalancer(node *LoadBalancer) loadbalancerOption {
+	return func(m *LoadBalancerMutation) {
+		m.oldValue = func(context.Context) (*LoadBalancer, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LoadBalancerMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LoadBalancerMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of LoadBalancer entities.
+func (m *LoadBalancerMutation) SetID(id gidx.PrefixedID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LoadBalancerMutation) ID() (id gidx.PrefixedID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LoadBalancerMutation) IDs(ctx context.Context) ([]gidx.PrefixedID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []gidx.PrefixedID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LoadBalancer.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LoadBalancerMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LoadBalancerMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the LoadBalancer entity.
+// If the LoadBalancer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoadBalancerMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LoadBalancerMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *LoadBalancerMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *LoadBalancerMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the LoadBalancer entity.
+// If the LoadBalancer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoadBalancerMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *LoadBalancerMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *LoadBalancerMutation) SetCreatedBy(s string) {
+	m.created_by = &s
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *LoadBalancerMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the LoadBalancer entity.
+// If the LoadBalancer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoadBalancerMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *LoadBalancerMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.clearedFields[loadbalancer.FieldCreatedBy] = struct{}{}
+}
+
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *LoadBalancerMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[loadbalancer.FieldCreatedBy]
+	return ok
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *LoadBalancerMutation) ResetCreatedBy() {
+	m.created_by = nil
+	delete(m.clearedFields, loadbalancer.FieldCreatedBy)
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (m *LoadBalancerMutation) SetUpdatedBy(s string) {
+	m.updated_by = &s
+}
+
+// UpdatedBy returns the value of the "updated_by" field in the mutation.
+func (m *LoadBalancerMutation) UpdatedBy() (r string, exists bool) {
+	v := m.updated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedBy returns the old "updated_by" field's value of the LoadBalancer entity.
+// If the LoadBalancer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoadBalancerMutation) OldUpdatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
+	}
+	return oldValue.UpdatedBy, nil
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (m *LoadBalancerMutation) ClearUpdatedBy() {
+	m.updated_by = nil
+	m.clearedFields[loadbalancer.FieldUpdatedBy] = struct{}{}
+}
+
+// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
+func (m *LoadBalancerMutation) UpdatedByCleared() bool {
+	_, ok := m.clearedFields[loadbalancer.FieldUpdatedBy]
+	return ok
+}
+
+// ResetUpdatedBy resets all changes to the "updated_by" field.
+func (m *LoadBalancerMutation) ResetUpdatedBy() {
+	m.updated_by = nil
+	delete(m.clearedFields, loadbalancer.FieldUpdatedBy)
+}
+
+// SetVersion sets the "version" field.
+func (m *LoadBalancerMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *LoadBalancerMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the LoadBalancer entity.
+// If the LoadBalancer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoadBalancerMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *LoadBalancerMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *LoadBalancerMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *LoadBalancerMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *LoadBalancerMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *LoadBalancerMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the LoadBalancer entity.
+// If the LoadBalancer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoadBalancerMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *LoadBalancerMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[loadbalancer.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *LoadBalancerMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[loadbalancer.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *LoadBalancerMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, loadbalancer.FieldDeletedAt)
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (m *LoadBalancerMutation) SetDeletedBy(s string) {
+	m.deleted_by = &s
+}
+
+// DeletedBy returns the value of the "deleted_by" field in the mutation.
+func (m *LoadBalancerMutation) DeletedBy() (r string, exists bool) {
+	v := m.deleted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedBy returns the old "deleted_by" field's value of the LoadBalancer entity.
+// If the LoadBalancer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoadBalancerMutation) OldDeletedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
+	}
+	return oldValue.DeletedBy, nil
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (m *LoadBalancerMutation) ClearDeletedBy() {
+	m.deleted_by = nil
+	m.clearedFields[loadbalancer.FieldDeletedBy] = struct{}{}
+}
+
+// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
+func (m *LoadBalancerMutation) DeletedByCleared() bool {
+	_, ok := m.clearedFields[loadbalancer.FieldDeletedBy]
+	return ok
+}
+
+// ResetDeletedBy resets all changes to the "deleted_by" field.
+func (m *LoadBalancerMutation) ResetDeletedBy() {
+	m.deleted_by = nil
+	delete(m.clearedFields, loadbalancer.FieldDeletedBy)
+}
+
+// SetName sets the "name" field.
+func (m *LoadBalancerMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *LoadBalancerMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the LoadBalancer entity.
+// If the LoadBalancer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoadBalancerMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *LoadBalancerMutation) ResetName() {
+	m.name = nil
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (m *LoadBalancerMutation) SetOwnerID(gi gidx.PrefixedID) {
+	m.owner_id = &gi
+}
+
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *LoadBalancerMutation) OwnerID() (r gidx.PrefixedID, exists bool) {
+	v := m.owner_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerID returns the old "owner_id" field's value of the LoadBalancer entity.
+// If the LoadBalancer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoadBalancerMutation) OldOwnerID(ctx context.Context) (v gidx.PrefixedID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
+}
+
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *LoadBalancerMutation) ResetOwnerID() {
+	m.owner_id = nil
+}
+
+// SetLocationID sets the "location_id" field.
+func (m *LoadBalancerMutation) SetLocationID(gi gidx.PrefixedID) {
+	m.location_id = &gi
+}
+
+// LocationID returns the value of the "location_id" field in the mutation.
+func (m *LoadBalancerMutation) LocationID() (r gidx.PrefixedID, exists bool) {
+	v := m.location_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocationID returns the old "location_id" field's value of the LoadBalancer entity.
+// If the LoadBalancer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoadBalancerMutation) OldLocationID(ctx context.Context) (v gidx.PrefixedID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocationID: %w", err)
+	}
+	return oldValue.LocationID, nil
+}
+
+// ResetLocationID resets all changes to the "location_id" field.
+func (m *LoadBalancerMutation) ResetLocationID() {
+	m.location_id = nil
+}
+
+// SetProviderID sets the "provider_id" field.
+func (m *LoadBalancerMutation) SetProviderID(gi gidx.PrefixedID) {
+	m.provider = &gi
+}
+
+// ProviderID returns the value of the "provider_id" field in the mutation.
+func (m *LoadBalancerMutation) ProviderID() (r gidx.PrefixedID, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProviderID returns the old "provider_id" field's value of the LoadBalancer entity.
+// If the LoadBalancer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoadBalancerMutation) OldProviderID(ctx context.Context) (v gidx.PrefixedID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProviderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProviderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProviderID: %w", err)
+	}
+	return oldValue.ProviderID, nil
+}
+
+// ResetProviderID resets all changes to the "provider_id" field.
+func (m *LoadBalancerMutation) ResetProviderID() {
+	m.provider = nil
+}
+
+// AddPortIDs adds the "ports" edge to the Port entity by ids.
+func (m *LoadBalancerMutation) AddPortIDs(ids ...gidx.PrefixedID) {
+	if m.ports == nil {
+		m.ports = make(map[gidx.PrefixedID]struct{})
+	}
+	for i := range ids {
+		m.ports[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPorts clears the "ports" edge to the Port entity.
+func (m *LoadBalancerMutation) ClearPorts() {
+	m.clearedports = true
+}
+
+// PortsCleared reports if the "ports" edge to the Port entity was cleared.
+func (m *LoadBalancerMutation) PortsCleared() bool {
+	return m.clearedports
+}
+
+// RemovePortIDs removes the "ports" edge to the Port entity by IDs.
+func (m *LoadBalancerMutation) RemovePortIDs(ids ...gidx.PrefixedID) {
+	if m.removedports == nil {
+		m.removedports = make(map[gidx.PrefixedID]struct{})
+	}
+	for i := range ids {
+		delete(m.ports, ids[i])
+		m.removedports[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPorts returns the removed IDs of the "ports" edge to the Port entity.
+func (m *LoadBalancerMutation) RemovedPortsIDs() (ids []gidx.PrefixedID) {
+	for id := range m.removedports {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PortsIDs returns the "ports" edge IDs in the mutation.
+func (m *LoadBalancerMutation) PortsIDs() (ids []gidx.PrefixedID) {
+	for id := range m.ports {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPorts resets all changes to the "ports" edge.
+func (m *LoadBalancerMutation) ResetPorts() {
+	m.ports = nil
+	m.clearedports = false
+	m.removedports = nil
+}
+
+// ClearProvider clears the "provider" edge to the Provider entity.
+func (m *LoadBalancerMutation) ClearProvider() {
+	m.clearedprovider = true
+	m.clearedFields[loadbalancer.FieldProviderID] = struct{}{}
+}
+
+// ProviderCleared reports if the "provider" edge to the Provider entity was cleared.
+func (m *LoadBalancerMutation) ProviderCleared() bool {
+	return m.clearedprovider
+}
+
+// ProviderIDs returns the "provider" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProviderID instead. It exists only for internal usage by the builders.
+func (m *LoadBalancerMutation) ProviderIDs() (ids []gidx.PrefixedID) {
+	if id := m.provider; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProvider resets all changes to the "provider" edge.
+func (m *LoadBalancerMutation) ResetProvider() {
+	m.provider = nil
+	m.clearedprovider = false
+}
+
+// Where appends a list predicates to the LoadBalancerMutation builder.
+func (m *LoadBalancerMutation) Where(ps ...predicate.LoadBalancer) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LoadBalancerMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LoadBalancerMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LoadBalancer, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LoadBalancerMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LoadBalancerMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LoadBalancer).
+func (m *LoadBalancerMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LoadBalancerMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.created_at != nil {
+		fields = append(fields, loadbalancer.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, loadbalancer.FieldUpdatedAt)
+	}
+	if m.created_by != nil {
+		fields = append(fields, loadbalancer.FieldCreatedBy)
+	}
+	if m.updated_by != nil {
+		fields = append(fields, loadbalancer.FieldUpdatedBy)
+	}
+	if m.version != nil {
+		fields = append(fields, loadbalancer.FieldVersion)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, loadbalancer.FieldDeletedAt)
+	}
+	if m.deleted_by != nil {
+		fields = append(fields, loadbalancer.FieldDeletedBy)
+	}
+	if m.name != nil {
+		fields = append(fields, loadbalancer.FieldName)
+	}
+	if m.owner_id != nil {
+		fields = append(fields, loadbalancer.FieldOwnerID)
+	}
+	if m.location_id != nil {
+		fields = append(fields, loadbalancer.FieldLocationID)
+	}
+	if m.provider != nil {
+		fields = append(fields, loadbalancer.FieldProviderID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LoadBalancerMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case loadbalancer.FieldCreatedAt:
+		return m.CreatedAt()
+	case loadbalancer.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case loadbalancer.FieldCreatedBy:
+		return m.CreatedBy()
+	case loadbalancer.FieldUpdatedBy:
+		return m.UpdatedBy()
+	case loadbalancer.FieldVersion:
+		return m.Version()
+	case loadbalancer.FieldDeletedAt:
+		return m.DeletedAt()
+	case loadbalancer.FieldDeletedBy:
+		return m.DeletedBy()
+	case loadbalancer.FieldName:
+		return m.Name()
+	case loadbalancer.FieldOwnerID:
+		return m.OwnerID()
+	case loadbalancer.FieldLocationID:
+		return m.LocationID()
+	case loadbalancer.FieldProviderID:
+		return m.ProviderID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LoadBalancerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case loadbalancer.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case loadbalancer.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case loadbalancer.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case loadbalancer.FieldUpdatedBy:
+		return m.OldUpdatedBy(ctx)
+	case loadbalancer.FieldVersion:
+		return m.OldVersion(ctx)
+	case loadbalancer.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case loadbalancer.FieldDeletedBy:
+		return m.OldDeletedBy(ctx)
+	case loadbalancer.FieldName:
+		return m.OldName(ctx)
+	case loadbalancer.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	case loadbalancer.FieldLocationID:
+		return m.OldLocationID(ctx)
+	case loadbalancer.FieldProviderID:
+		return m.OldProviderID(ctx)
+	}
+	return nil, fmt.Errorf("unknown LoadBalancer field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoadBalancerMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case loadbalancer.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case loadbalancer.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case loadbalancer.FieldCreatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case loadbalancer.FieldUpdatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedBy(v)
+		return nil
+	case loadbalancer.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case loadbalancer.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case loadbalancer.FieldDeletedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedBy(v)
+		return nil
+	case loadbalancer.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case loadbalancer.FieldOwnerID:
+		v, ok := value.(gidx.PrefixedID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
+	case loadbalancer.FieldLocationID:
+		v, ok := value.(gidx.PrefixedID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocationID(v)
+		return nil
+	case loadbalancer.FieldProviderID:
+		v, ok := value.(gidx.PrefixedID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProviderID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LoadBalancer field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LoadBalancerMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, loadbalancer.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LoadBalancerMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case loadbalancer.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoadBalancerMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case loadbalancer.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LoadBalancer numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LoadBalancerMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(loadbalancer.FieldCreatedBy) {
+		fields = append(fields, loadbalancer.FieldCreatedBy)
+	}
+	if m.FieldCleared(loadbalancer.FieldUpdatedBy) {
+		fields = append(fields, loadbalancer.FieldUpdatedBy)
+	}
+	if m.FieldCleared(loadbalancer.FieldDeletedAt) {
+		fields = append(fields, loadbalancer.FieldDeletedAt)
+	}
+	if m.FieldCleared(loadbalancer.FieldDeletedBy) {
+		fields = append(fields, loadbalancer.FieldDeletedBy)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LoadBalancerMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LoadBalancerMutation) ClearField(name string) error {
+	switch name {
+	case loadbalancer.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case loadbalancer.FieldUpdatedBy:
+		m.ClearUpdatedBy()
+		return nil
+	case loadbalancer.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case loadbalancer.FieldDeletedBy:
+		m.ClearDeletedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown LoadBalancer nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LoadBalancerMutation) ResetField(name string) error {
+	switch name {
+	case loadbalancer.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case loadbalancer.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case loadbalancer.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case loadbalancer.FieldUpdatedBy:
+		m.ResetUpdatedBy()
+		return nil
+	case loadbalancer.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case loadbalancer.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case loadbalancer.FieldDeletedBy:
+		m.ResetDeletedBy()
+		return nil
+	case loadbalancer.FieldName:
+		m.ResetName()
+		return nil
+	case loadbalancer.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	case loadbalancer.FieldLocationID:
+		m.ResetLocationID()
+		return nil
+	case loadbalancer.FieldProviderID:
+		m.ResetProviderID()
+		return nil
+	}
+	return fmt.Errorf("unknown LoadBalancer field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LoadBalancerMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.ports != nil {
+		edges = append(edges, loadbalancer.EdgePorts)
+	}
+	if m.provider != nil {
+		edges = append(edges, loadbalancer.EdgeProvider)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LoadBalancerMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case loadbalancer.EdgePorts:
+		ids := make([]ent.Value, 0, len(m.ports))
+		for id := range m.ports {
+			ids = append(ids, id)
+		}
+		return ids
+	case loadbalancer.EdgeProvider:
+		if id := m.provider; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LoadBalancerMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedports != nil {
+		edges = append(edges, loadbalancer.EdgePorts)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LoadBalancerMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case loadbalancer.EdgePorts:
+		ids := make([]ent.Value, 0, len(m.removedports))
+		for id := range m.removedports {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LoadBalancerMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedports {
+		edges = append(edges, loadbalancer.EdgePorts)
+	}
+	if m.clearedprovider {
+		edges = append(edges, loadbalancer.EdgeProvider)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LoadBalancerMutation) EdgeCleared(name string) bool {
+	switch name {
+	case loadbalancer.EdgePorts:
+		return m.clearedports
+	case loadbalancer.EdgeProvider:
+		return m.clearedprovider
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LoadBalancerMutation) ClearEdge(name string) error {
+	switch name {
+	case loadbalancer.EdgeProvider:
+		m.ClearProvider()
+		return nil
+	}
+	return fmt.Errorf("unknown LoadBalancer unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LoadBalancerMutation) ResetEdge(name string) error {
+	switch name {
+	case loadbalancer.EdgePorts:
+		m.ResetPorts()
+		return nil
+	case loadbalancer.EdgeProvider:
+		m.ResetProvider()
+		return nil
+	}
+	return fmt.Errorf("unknown LoadBalancer edge %s", name)
+}
+
+// OriginMutation represents an operation that mutates the Origin nodes in the graph.
+type OriginMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *gidx.PrefixedID
+	created_at     *time.Time
+	updated_at     *time.Time
+	deleted_at     *time.Time
+	deleted_by     *string
+	created_by     *string
+	updated_by     *string
+	version        *int
+	addversion     *int
+	name           *string
+	weight         *int32
+	addweight      *int32
+	target         *string
+	port_number    *int
+	addport_number *int
+	active         *bool
+	clearedFields  map[string]struct{}
+	pool           *gidx.PrefixedID
+	clearedpool    bool
+	done           bool
+	oldValue       func(context.Context) (*Origin, error)
+	predicates     []predicate.Origin
+}
+
+var _ ent.Mutation = (*OriginMutation)(nil)
+
+// originOption allows management of the mutation configuration using functional options.
+type originOption func(*OriginMutation)
+
+// newOriginMutation creates new mutation for the Origin entity.
+func newOriginMutation(c config, op Op, opts ...originOption) *OriginMutation {
+	m := &OriginMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOrigin,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOriginID sets the ID field of the mutation.
+func withOriginID(id gidx.PrefixedID) originOption {
+	return func(m *OriginMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Origin
+		)
+		m.oldValue = func(ctx context.Context) (*Origin, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Origin.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOrigin sets the old Origin of the mutation.
+func withOrigin(node *Origin) originOption {
+	return func(m *OriginMutation) {
+		m.oldValue = func(context.Context) (*Origin, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OriginMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OriginMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Origin entities.
+func (m *OriginMutation) SetID(id gidx.PrefixedID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OriginMutation) ID() (id gidx.PrefixedID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OriginMutation) IDs(ctx context.Context) ([]gidx.PrefixedID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []gidx.PrefixedID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Origin.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OriginMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OriginMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Origin entity.
+// If the Origin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OriginMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OriginMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *OriginMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *OriginMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Origin entity.
+// If the Origin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OriginMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *OriginMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *OriginMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *OriginMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Origin entity.
+// If the Origin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OriginMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *OriginMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[origin.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *OriginMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[origin.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *OriginMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, origin.FieldDeletedAt)
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (m *OriginMutation) SetDeletedBy(s string) {
+	m.deleted_by = &s
+}
+
+// DeletedBy returns the value of the "deleted_by" field in the mutation.
+func (m *OriginMutation) DeletedBy() (r string, exists bool) {
+	v := m.deleted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedBy returns the old "deleted_by" field's value of the Origin entity.
+// If the Origin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OriginMutation) OldDeletedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
+	}
+	return oldValue.DeletedBy, nil
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (m *OriginMutation) ClearDeletedBy() {
+	m.deleted_by = nil
+	m.clearedFields[origin.FieldDeletedBy] = struct{}{}
+}
+
+// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
+func (m *OriginMutation) DeletedByCleared() bool {
+	_, ok := m.clearedFields[origin.FieldDeletedBy]
+	return ok
+}
+
+// ResetDeletedBy resets all changes to the "deleted_by" field.
+func (m *OriginMutation) ResetDeletedBy() {
+	m.deleted_by = nil
+	delete(m.clearedFields, origin.FieldDeletedBy)
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *OriginMutation) SetCreatedBy(s string) {
+	m.created_by = &s
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *OriginMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the Origin entity.
+// If the Origin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OriginMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *OriginMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.clearedFields[origin.FieldCreatedBy] = struct{}{}
+}
+
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *OriginMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[origin.FieldCreatedBy]
+	return ok
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *OriginMutation) ResetCreatedBy() {
+	m.created_by = nil
+	delete(m.clearedFields, origin.FieldCreatedBy)
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (m *OriginMutation) SetUpdatedBy(s string) {
+	m.updated_by = &s
+}
+
+// UpdatedBy returns the value of the "updated_by" field in the mutation.
+func (m *OriginMutation) UpdatedBy() (r string, exists bool) {
+	v := m.updated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedBy returns the old "updated_by" field's value of the Origin entity.
+// If the Origin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OriginMutation) OldUpdatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
+	}
+	return oldValue.UpdatedBy, nil
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (m *OriginMutation) ClearUpdatedBy() {
+	m.updated_by = nil
+	m.clearedFields[origin.FieldUpdatedBy] = struct{}{}
+}
+
+// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
+func (m *OriginMutation) UpdatedByCleared() bool {
+	_, ok := m.clearedFields[origin.FieldUpdatedBy]
+	return ok
+}
+
+// ResetUpdatedBy resets all changes to the "updated_by" field.
+func (m *OriginMutation) ResetUpdatedBy() {
+	m.updated_by = nil
+	delete(m.clearedFields, origin.FieldUpdatedBy)
+}
+
+// SetVersion sets the "version" field.
+func (m *OriginMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *OriginMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Origin entity.
+// If the Origin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OriginMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLocationID requires an ID field in the mutation")
+		return v, errors.New("OldVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLocationID: %w", err)
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
 	}
-	return oldValue.LocationID, nil
+	return oldValue.Version, nil
 }
 
-// ResetLocationID resets all changes to the "location_id" field.
-func (m *LoadBalancerMutation) ResetLocationID() {
-	m.location_id = nil
+// AddVersion adds i to the "version" field.
+func (m *OriginMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
 }
 
-// SetProviderID sets the "provider_id" field.
-func (m *LoadBalancerMutation) SetProviderID(gi gidx.PrefixedID) {
-	m.provider = &gi
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *OriginMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ProviderID returns the value of the "provider_id" field in the mutation.
-func (m *LoadBalancerMutation) ProviderID() (r gidx.PrefixedID, exists bool) {
-	v := m.provider
+// ResetVersion resets all changes to the "version" field.
+func (m *OriginMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetName sets the "name" field.
+func (m *OriginMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *OriginMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProviderID returns the old "provider_id" field's value of the LoadBalancer entity.
-// If the LoadBalancer object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Origin entity.
+// If the Origin object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoadBalancerMutation) OldProviderID(ctx context.Context) (v gidx.PrefixedID, err error) {
+func (m *OriginMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProviderID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProviderID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProviderID: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.ProviderID, nil
+	return oldValue.Name, nil
 }
 
-// ResetProviderID resets all changes to the "provider_id" field.
-func (m *LoadBalancerMutation) ResetProviderID() {
-	m.provider = nil
+// ResetName resets all changes to the "name" field.
+func (m *OriginMutation) ResetName() {
+	m.name = nil
 }
 
-// AddPortIDs adds the "ports" edge to the Port entity by ids.
-func (m *LoadBalancerMutation) AddPortIDs(ids ...gidx.PrefixedID) {
-	if m.ports == nil {
-		m.ports = make(map[gidx.PrefixedID]struct{})
+// SetWeight sets the "weight" field.
+func (m *OriginMutation) SetWeight(i int32) {
+	m.weight = &i
+	m.addweight = nil
+}
+
+// Weight returns the value of the "weight" field in the mutation.
+func (m *OriginMutation) Weight() (r int32, exists bool) {
+	v := m.weight
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.ports[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldWeight returns the old "weight" field's value of the Origin entity.
+// If the Origin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OriginMutation) OldWeight(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWeight is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWeight: %w", err)
+	}
+	return oldValue.Weight, nil
 }
 
-// ClearPorts clears the "ports" edge to the Port entity.
-func (m *LoadBalancerMutation) ClearPorts() {
-	m.clearedports = true
+// AddWeight adds i to the "weight" field.
+func (m *OriginMutation) AddWeight(i int32) {
+	if m.addweight != nil {
+		*m.addweight += i
+	} else {
+		m.addweight = &i
+	}
+}
+
+// AddedWeight returns the value that was added to the "weight" field in this mutation.
+func (m *OriginMutation) AddedWeight() (r int32, exists bool) {
+	v := m.addweight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWeight resets all changes to the "weight" field.
+func (m *OriginMutation) ResetWeight() {
+	m.weight = nil
+	m.addweight = nil
+}
+
+// SetTarget sets the "target" field.
+func (m *OriginMutation) SetTarget(s string) {
+	m.target = &s
+}
+
+// Target returns the value of the "target" field in the mutation.
+func (m *OriginMutation) Target() (r string, exists bool) {
+	v := m.target
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTarget returns the old "target" field's value of the Origin entity.
+// If the Origin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OriginMutation) OldTarget(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTarget is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTarget requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTarget: %w", err)
+	}
+	return oldValue.Target, nil
+}
+
+// ResetTarget resets all changes to the "target" field.
+func (m *OriginMutation) ResetTarget() {
+	m.target = nil
+}
+
+// SetPortNumber sets the "port_number" field.
+func (m *OriginMutation) SetPortNumber(i int) {
+	m.port_number = &i
+	m.addport_number = nil
+}
+
+// PortNumber returns the value of the "port_number" field in the mutation.
+func (m *OriginMutation) PortNumber() (r int, exists bool) {
+	v := m.port_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPortNumber returns the old "port_number" field's value of the Origin entity.
+// If the Origin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OriginMutation) OldPortNumber(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPortNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPortNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPortNumber: %w", err)
+	}
+	return oldValue.PortNumber, nil
+}
+
+// AddPortNumber adds i to the "port_number" field.
+func (m *OriginMutation) AddPortNumber(i int) {
+	if m.addport_number != nil {
+		*m.addport_number += i
+	} else {
+		m.addport_number = &i
+	}
+}
+
+// AddedPortNumber returns the value that was added to the "port_number" field in this mutation.
+func (m *OriginMutation) AddedPortNumber() (r int, exists bool) {
+	v := m.addport_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPortNumber resets all changes to the "port_number" field.
+func (m *OriginMutation) ResetPortNumber() {
+	m.port_number = nil
+	m.addport_number = nil
+}
+
+// SetActive sets the "active" field.
+func (m *OriginMutation) SetActive(b bool) {
+	m.active = &b
+}
+
+// Active returns the value of the "active" field in the mutation.
+func (m *OriginMutation) Active() (r bool, exists bool) {
+	v := m.active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActive returns the old "active" field's value of the Origin entity.
+// If the Origin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OriginMutation) OldActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+	}
+	return oldValue.Active, nil
+}
+
+// ResetActive resets all changes to the "active" field.
+func (m *OriginMutation) ResetActive() {
+	m.active = nil
 }
 
-// PortsCleared reports if the "ports" edge to the Port entity was cleared.
-func (m *LoadBalancerMutation) PortsCleared() bool {
-	return m.clearedports
+// SetPoolID sets the "pool_id" field.
+func (m *OriginMutation) SetPoolID(gi gidx.PrefixedID) {
+	m.pool = &gi
 }
 
-// RemovePortIDs removes the "ports" edge to the Port entity by IDs.
-func (m *LoadBalancerMutation) RemovePortIDs(ids ...gidx.PrefixedID) {
-	if m.removedports == nil {
-		m.removedports = make(map[gidx.PrefixedID]struct{})
-	}
-	for i := range ids {
-		delete(m.ports, ids[i])
-		m.removedports[ids[i]] = struct{}{}
+// PoolID returns the value of the "pool_id" field in the mutation.
+func (m *OriginMutation) PoolID() (r gidx.PrefixedID, exists bool) {
+	v := m.pool
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedPorts returns the removed IDs of the "ports" edge to the Port entity.
-func (m *LoadBalancerMutation) RemovedPortsIDs() (ids []gidx.PrefixedID) {
-	for id := range m.removedports {
-		ids = append(ids, id)
+// OldPoolID returns the old "pool_id" field's value of the Origin entity.
+// If the Origin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OriginMutation) OldPoolID(ctx context.Context) (v gidx.PrefixedID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPoolID is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// PortsIDs returns the "ports" edge IDs in the mutation.
-func (m *LoadBalancerMutation) PortsIDs() (ids []gidx.PrefixedID) {
-	for id := range m.ports {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPoolID requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPoolID: %w", err)
+	}
+	return oldValue.PoolID, nil
 }
 
-// ResetPorts resets all changes to the "ports" edge.
-func (m *LoadBalancerMutation) ResetPorts() {
-	m.ports = nil
-	m.clearedports = false
-	m.removedports = nil
+// ResetPoolID resets all changes to the "pool_id" field.
+func (m *OriginMutation) ResetPoolID() {
+	m.pool = nil
 }
 
-// ClearProvider clears the "provider" edge to the Provider entity.
-func (m *LoadBalancerMutation) ClearProvider() {
-	m.clearedprovider = true
-	m.clearedFields[loadbalancer.FieldProviderID] = struct{}{}
+// ClearPool clears the "pool" edge to the Pool entity.
+func (m *OriginMutation) ClearPool() {
+	m.clearedpool = true
+	m.clearedFields[origin.FieldPoolID] = struct{}{}
 }
 
-// ProviderCleared reports if the "provider" edge to the Provider entity was cleared.
-func (m *LoadBalancerMutation) ProviderCleared() bool {
-	return m.clearedprovider
+// PoolCleared reports if the "pool" edge to the Pool entity was cleared.
+func (m *OriginMutation) PoolCleared() bool {
+	return m.clearedpool
 }
 
-// ProviderIDs returns the "provider" edge IDs in the mutation.
+// PoolIDs returns the "pool" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ProviderID instead. It exists only for internal usage by the builders.
-func (m *LoadBalancerMutation) ProviderIDs() (ids []gidx.PrefixedID) {
-	if id := m.provider; id != nil {
+// PoolID instead. It exists only for internal usage by the builders.
+func (m *OriginMutation) PoolIDs() (ids []gidx.PrefixedID) {
+	if id := m.pool; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetProvider resets all changes to the "provider" edge.
-func (m *LoadBalancerMutation) ResetProvider() {
-	m.provider = nil
-	m.clearedprovider = false
+// ResetPool resets all changes to the "pool" edge.
+func (m *OriginMutation) ResetPool() {
+	m.pool = nil
+	m.clearedpool = false
 }
 
-// Where appends a list predicates to the LoadBalancerMutation builder.
-func (m *LoadBalancerMutation) Where(ps ...predicate.LoadBalancer) {
+// Where appends a list predicates to the OriginMutation builder.
+func (m *OriginMutation) Where(ps ...predicate.Origin) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the LoadBalancerMutation builder. Using this method,
+// WhereP appends storage-level predicates to the OriginMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *LoadBalancerMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.LoadBalancer, len(ps))
+func (m *OriginMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Origin, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -689,54 +3342,63 @@ func (m *LoadBalancerMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *LoadBalancerMutation) Op() Op {
+func (m *OriginMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *LoadBalancerMutation) SetOp(op Op) {
+func (m *OriginMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (LoadBalancer).
-func (m *LoadBalancerMutation) Type() string {
+// Type returns the node type of this mutation (Origin).
+func (m *OriginMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *LoadBalancerMutation) Fields() []string {
-	fields := make([]string, 0, 10)
+func (m *OriginMutation) Fields() []string {
+	fields := make([]string, 0, 13)
 	if m.created_at != nil {
-		fields = append(fields, loadbalancer.FieldCreatedAt)
+		fields = append(fields, origin.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, loadbalancer.FieldUpdatedAt)
+		fields = append(fields, origin.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, origin.FieldDeletedAt)
+	}
+	if m.deleted_by != nil {
+		fields = append(fields, origin.FieldDeletedBy)
 	}
 	if m.created_by != nil {
-		fields = append(fields, loadbalancer.FieldCreatedBy)
+		fields = append(fields, origin.FieldCreatedBy)
 	}
 	if m.updated_by != nil {
-		fields = append(fields, loadbalancer.FieldUpdatedBy)
-	}
-	if m.deleted_at != nil {
-		fields = append(fields, loadbalancer.FieldDeletedAt)
+		fields = append(fields, origin.FieldUpdatedBy)
 	}
-	if m.deleted_by != nil {
-		fields = append(fields, loadbalancer.FieldDeletedBy)
+	if m.version != nil {
+		fields = append(fields, origin.FieldVersion)
 	}
 	if m.name != nil {
-		fields = append(fields, loadbalancer.FieldName)
+		fields = append(fields, origin.FieldName)
 	}
-	if m.owner_id != nil {
-		fields = append(fields, loadbalancer.FieldOwnerID)
+	if m.weight != nil {
+		fields = append(fields, origin.FieldWeight)
 	}
-	if m.location_id != nil {
-		fields = append(fields, loadbalancer.FieldLocationID)
+	if m.target != nil {
+		fields = append(fields, origin.FieldTarget)
 	}
-	if m.provider != nil {
-		fields = append(fields, loadbalancer.FieldProviderID)
+	if m.port_number != nil {
+		fields = append(fields, origin.FieldPortNumber)
+	}
+	if m.active != nil {
+		fields = append(fields, origin.FieldActive)
+	}
+	if m.pool != nil {
+		fields = append(fields, origin.FieldPoolID)
 	}
 	return fields
 }
@@ -744,28 +3406,34 @@ func (m *LoadBalancerMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *LoadBalancerMutation) Field(name string) (ent.Value, bool) {
+func (m *OriginMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case loadbalancer.FieldCreatedAt:
+	case origin.FieldCreatedAt:
 		return m.CreatedAt()
-	case loadbalancer.FieldUpdatedAt:
+	case origin.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case loadbalancer.FieldCreatedBy:
-		return m.CreatedBy()
-	case loadbalancer.FieldUpdatedBy:
-		return m.UpdatedBy()
-	case loadbalancer.FieldDeletedAt:
+	case origin.FieldDeletedAt:
 		return m.DeletedAt()
-	case loadbalancer.FieldDeletedBy:
+	case origin.FieldDeletedBy:
 		return m.DeletedBy()
-	case loadbalancer.FieldName:
+	case origin.FieldCreatedBy:
+		return m.CreatedBy()
+	case origin.FieldUpdatedBy:
+		return m.UpdatedBy()
+	case origin.FieldVersion:
+		return m.Version()
+	case origin.FieldName:
 		return m.Name()
-	case loadbalancer.FieldOwnerID:
-		return m.OwnerID()
-	case loadbalancer.FieldLocationID:
-		return m.LocationID()
-	case loadbalancer.FieldProviderID:
-		return m.ProviderID()
+	case origin.FieldWeight:
+		return m.Weight()
+	case origin.FieldTarget:
+		return m.Target()
+	case origin.FieldPortNumber:
+		return m.PortNumber()
+	case origin.FieldActive:
+		return m.Active()
+	case origin.FieldPoolID:
+		return m.PoolID()
 	}
 	return nil, false
 }
@@ -773,241 +3441,307 @@ func (m *LoadBalancerMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *LoadBalancerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *OriginMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case loadbalancer.FieldCreatedAt:
+	case origin.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case loadbalancer.FieldUpdatedAt:
+	case origin.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case loadbalancer.FieldCreatedBy:
-		return m.OldCreatedBy(ctx)
-	case loadbalancer.FieldUpdatedBy:
-		return m.OldUpdatedBy(ctx)
-	case loadbalancer.FieldDeletedAt:
+	case origin.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case loadbalancer.FieldDeletedBy:
+	case origin.FieldDeletedBy:
 		return m.OldDeletedBy(ctx)
-	case loadbalancer.FieldName:
+	case origin.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case origin.FieldUpdatedBy:
+		return m.OldUpdatedBy(ctx)
+	case origin.FieldVersion:
+		return m.OldVersion(ctx)
+	case origin.FieldName:
 		return m.OldName(ctx)
-	case loadbalancer.FieldOwnerID:
-		return m.OldOwnerID(ctx)
-	case loadbalancer.FieldLocationID:
-		return m.OldLocationID(ctx)
-	case loadbalancer.FieldProviderID:
-		return m.OldProviderID(ctx)
+	case origin.FieldWeight:
+		return m.OldWeight(ctx)
+	case origin.FieldTarget:
+		return m.OldTarget(ctx)
+	case origin.FieldPortNumber:
+		return m.OldPortNumber(ctx)
+	case origin.FieldActive:
+		return m.OldActive(ctx)
+	case origin.FieldPoolID:
+		return m.OldPoolID(ctx)
 	}
-	return nil, fmt.Errorf("unknown LoadBalancer field %s", name)
+	return nil, fmt.Errorf("unknown Origin field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LoadBalancerMutation) SetField(name string, value ent.Value) error {
+func (m *OriginMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case loadbalancer.FieldCreatedAt:
+	case origin.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case loadbalancer.FieldUpdatedAt:
+	case origin.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case loadbalancer.FieldCreatedBy:
+	case origin.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case origin.FieldDeletedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedBy(v)
+		return nil
+	case origin.FieldCreatedBy:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedBy(v)
 		return nil
-	case loadbalancer.FieldUpdatedBy:
+	case origin.FieldUpdatedBy:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedBy(v)
 		return nil
-	case loadbalancer.FieldDeletedAt:
-		v, ok := value.(time.Time)
+	case origin.FieldVersion:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetVersion(v)
 		return nil
-	case loadbalancer.FieldDeletedBy:
+	case origin.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedBy(v)
+		m.SetName(v)
+		return nil
+	case origin.FieldWeight:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWeight(v)
 		return nil
-	case loadbalancer.FieldName:
+	case origin.FieldTarget:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetTarget(v)
 		return nil
-	case loadbalancer.FieldOwnerID:
-		v, ok := value.(gidx.PrefixedID)
+	case origin.FieldPortNumber:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOwnerID(v)
+		m.SetPortNumber(v)
 		return nil
-	case loadbalancer.FieldLocationID:
-		v, ok := value.(gidx.PrefixedID)
+	case origin.FieldActive:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLocationID(v)
+		m.SetActive(v)
 		return nil
-	case loadbalancer.FieldProviderID:
+	case origin.FieldPoolID:
 		v, ok := value.(gidx.PrefixedID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProviderID(v)
+		m.SetPoolID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown LoadBalancer field %s", name)
+	return fmt.Errorf("unknown Origin field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *LoadBalancerMutation) AddedFields() []string {
-	return nil
+func (m *OriginMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, origin.FieldVersion)
+	}
+	if m.addweight != nil {
+		fields = append(fields, origin.FieldWeight)
+	}
+	if m.addport_number != nil {
+		fields = append(fields, origin.FieldPortNumber)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *LoadBalancerMutation) AddedField(name string) (ent.Value, bool) {
+func (m *OriginMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case origin.FieldVersion:
+		return m.AddedVersion()
+	case origin.FieldWeight:
+		return m.AddedWeight()
+	case origin.FieldPortNumber:
+		return m.AddedPortNumber()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LoadBalancerMutation) AddField(name string, value ent.Value) error {
+func (m *OriginMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case origin.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	case origin.FieldWeight:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWeight(v)
+		return nil
+	case origin.FieldPortNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPortNumber(v)
+		return nil
 	}
-	return fmt.Errorf("unknown LoadBalancer numeric field %s", name)
+	return fmt.Errorf("unknown Origin numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *LoadBalancerMutation) ClearedFields() []string {
+func (m *OriginMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(loadbalancer.FieldCreatedBy) {
-		fields = append(fields, loadbalancer.FieldCreatedBy)
+	if m.FieldCleared(origin.FieldDeletedAt) {
+		fields = append(fields, origin.FieldDeletedAt)
 	}
-	if m.FieldCleared(loadbalancer.FieldUpdatedBy) {
-		fields = append(fields, loadbalancer.FieldUpdatedBy)
+	if m.FieldCleared(origin.FieldDeletedBy) {
+		fields = append(fields, origin.FieldDeletedBy)
 	}
-	if m.FieldCleared(loadbalancer.FieldDeletedAt) {
-		fields = append(fields, loadbalancer.FieldDeletedAt)
+	if m.FieldCleared(origin.FieldCreatedBy) {
+		fields = append(fields, origin.FieldCreatedBy)
 	}
-	if m.FieldCleared(loadbalancer.FieldDeletedBy) {
-		fields = append(fields, loadbalancer.FieldDeletedBy)
+	if m.FieldCleared(origin.FieldUpdatedBy) {
+		fields = append(fields, origin.FieldUpdatedBy)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *LoadBalancerMutation) FieldCleared(name string) bool {
+func (m *OriginMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *LoadBalancerMutation) ClearField(name string) error {
+func (m *OriginMutation) ClearField(name string) error {
 	switch name {
-	case loadbalancer.FieldCreatedBy:
-		m.ClearCreatedBy()
-		return nil
-	case loadbalancer.FieldUpdatedBy:
-		m.ClearUpdatedBy()
-		return nil
-	case loadbalancer.FieldDeletedAt:
+	case origin.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
-	case loadbalancer.FieldDeletedBy:
+	case origin.FieldDeletedBy:
 		m.ClearDeletedBy()
 		return nil
+	case origin.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case origin.FieldUpdatedBy:
+		m.ClearUpdatedBy()
+		return nil
 	}
-	return fmt.Errorf("unknown LoadBalancer nullable field %s", name)
+	return fmt.Errorf("unknown Origin nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *LoadBalancerMutation) ResetField(name string) error {
+func (m *OriginMutation) ResetField(name string) error {
 	switch name {
-	case loadbalancer.FieldCreatedAt:
+	case origin.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case loadbalancer.FieldUpdatedAt:
+	case origin.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case loadbalancer.FieldCreatedBy:
+	case origin.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case origin.FieldDeletedBy:
+		m.ResetDeletedBy()
+		return nil
+	case origin.FieldCreatedBy:
 		m.ResetCreatedBy()
 		return nil
-	case loadbalancer.FieldUpdatedBy:
+	case origin.FieldUpdatedBy:
 		m.ResetUpdatedBy()
 		return nil
-	case loadbalancer.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
-	case loadbalancer.FieldDeletedBy:
-		m.ResetDeletedBy()
+	case origin.FieldVersion:
+		m.ResetVersion()
 		return nil
-	case loadbalancer.FieldName:
+	case origin.FieldName:
 		m.ResetName()
 		return nil
-	case loadbalancer.FieldOwnerID:
-		m.ResetOwnerID()
+	case origin.FieldWeight:
+		m.ResetWeight()
 		return nil
-	case loadbalancer.FieldLocationID:
-		m.ResetLocationID()
+	case origin.FieldTarget:
+		m.ResetTarget()
 		return nil
-	case loadbalancer.FieldProviderID:
-		m.ResetProviderID()
+	case origin.FieldPortNumber:
+		m.ResetPortNumber()
+		return nil
+	case origin.FieldActive:
+		m.ResetActive()
+		return nil
+	case origin.FieldPoolID:
+		m.ResetPoolID()
 		return nil
 	}
-	return fmt.Errorf("unknown LoadBalancer field %s", name)
+	return fmt.Errorf("unknown Origin field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *LoadBalancerMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.ports != nil {
-		edges = append(edges, loadbalancer.EdgePorts)
-	}
-	if m.provider != nil {
-		edges = append(edges, loadbalancer.EdgeProvider)
+func (m *OriginMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.pool != nil {
+		edges = append(edges, origin.EdgePool)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *LoadBalancerMutation) AddedIDs(name string) []ent.Value {
+func (m *OriginMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case loadbalancer.EdgePorts:
-		ids := make([]ent.Value, 0, len(m.ports))
-		for id := range m.ports {
-			ids = append(ids, id)
-		}
-		return ids
-	case loadbalancer.EdgeProvider:
-		if id := m.provider; id != nil {
+	case origin.EdgePool:
+		if id := m.pool; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -1015,115 +3749,86 @@ func (m *LoadBalancerMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *LoadBalancerMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedports != nil {
-		edges = append(edges, loadbalancer.EdgePorts)
-	}
+func (m *OriginMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *LoadBalancerMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case loadbalancer.EdgePorts:
-		ids := make([]ent.Value, 0, len(m.removedports))
-		for id := range m.removedports {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *OriginMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *LoadBalancerMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedports {
-		edges = append(edges, loadbalancer.EdgePorts)
-	}
-	if m.clearedprovider {
-		edges = append(edges, loadbalancer.EdgeProvider)
+func (m *OriginMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedpool {
+		edges = append(edges, origin.EdgePool)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *LoadBalancerMutation) EdgeCleared(name string) bool {
+func (m *OriginMutation) EdgeCleared(name string) bool {
 	switch name {
-	case loadbalancer.EdgePorts:
-		return m.clearedports
-	case loadbalancer.EdgeProvider:
-		return m.clearedprovider
+	case origin.EdgePool:
+		return m.clearedpool
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *LoadBalancerMutation) ClearEdge(name string) error {
-	switch name {
-	case loadbalancer.EdgeProvider:
-		m.ClearProvider()
-		return nil
-	}
-	return fmt.Errorf("unknown LoadBalancer unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *LoadBalancerMutation) ResetEdge(name string) error {
+func (m *OriginMutation) ClearEdge(name string) error {
 	switch name {
-	case loadbalancer.EdgePorts:
-		m.ResetPorts()
-		return nil
-	case loadbalancer.EdgeProvider:
-		m.ResetProvider()
+	case origin.EdgePool:
+		m.ClearPool()
 		return nil
 	}
-	return fmt.Errorf("unknown LoadBalancer edge %s", name)
-}
-
-// OriginMutation represents an operation that mutates the Origin nodes in the graph.
-type OriginMutation struct {
-	config
-	op             Op
-	typ            string
-	id             *gidx.PrefixedID
-	created_at     *time.Time
-	updated_at     *time.Time
-	deleted_at     *time.Time
-	deleted_by     *string
-	created_by     *string
-	updated_by     *string
-	name           *string
-	weight         *int32
-	addweight      *int32
-	target         *string
-	port_number    *int
-	addport_number *int
-	active         *bool
-	clearedFields  map[string]struct{}
-	pool           *gidx.PrefixedID
-	clearedpool    bool
-	done           bool
-	oldValue       func(context.Context) (*Origin, error)
-	predicates     []predicate.Origin
+	return fmt.Errorf("unknown Origin unique edge %s", name)
 }
 
-var _ ent.Mutation = (*OriginMutation)(nil)
-
-// originOption allows management of the mutation configuration using functional options.
-type originOption func(*OriginMutation)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OriginMutation) ResetEdge(name string) error {
+	switch name {
+	case origin.EdgePool:
+		m.ResetPool()
+		return nil
+	}
+	return fmt.Errorf("unknown Origin edge %s", name)
+}
 
-// newOriginMutation creates new mutation for the Origin entity.
-func newOriginMutation(c config, op Op, opts ...originOption) *OriginMutation {
-	m := &OriginMutation{
+// OwnerDeletionMutation represents an operation that mutates the OwnerDeletion nodes in the graph.
+type OwnerDeletionMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *gidx.PrefixedID
+	owner_id          *gidx.PrefixedID
+	started_at        *time.Time
+	completed_at      *time.Time
+	skipped_ids       *[]gidx.PrefixedID
+	appendskipped_ids []gidx.PrefixedID
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*OwnerDeletion, error)
+	predicates        []predicate.OwnerDeletion
+}
+
+var _ ent.Mutation = (*OwnerDeletionMutation)(nil)
+
+// ownerdeletionOption allows management of the mutation configuration using functional options.
+type ownerdeletionOption func(*OwnerDeletionMutation)
+
+// newOwnerDeletionMutation creates new mutation for the OwnerDeletion entity.
+func newOwnerDeletionMutation(c config, op Op, opts ...ownerdeletionOption) *OwnerDeletionMutation {
+	m := &OwnerDeletionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeOrigin,
+		typ:           TypeOwnerDeletion,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1132,20 +3837,20 @@ func newOriginMutation(c config, op Op, opts ...originOption) *OriginMutation {
 	return m
 }
 
-// withOriginID sets the ID field of the mutation.
-func withOriginID(id gidx.PrefixedID) originOption {
-	return func(m *OriginMutation) {
+// withOwnerDeletionID sets the ID field of the mutation.
+func withOwnerDeletionID(id gidx.PrefixedID) ownerdeletionOption {
+	return func(m *OwnerDeletionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Origin
+			value *OwnerDeletion
 		)
-		m.oldValue = func(ctx context.Context) (*Origin, error) {
+		m.oldValue = func(ctx context.Context) (*OwnerDeletion, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Origin.Get(ctx, id)
+					value, err = m.Client().OwnerDeletion.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1154,10 +3859,10 @@ func withOriginID(id gidx.PrefixedID) originOption {
 	}
 }
 
-// withOrigin sets the old Origin of the mutation.
-func withOrigin(node *Origin) originOption {
-	return func(m *OriginMutation) {
-		m.oldValue = func(context.Context) (*Origin, error) {
+// withOwnerDeletion sets the old OwnerDeletion of the mutation.
+func withOwnerDeletion(node *OwnerDeletion) ownerdeletionOption {
+	return func(m *OwnerDeletionMutation) {
+		m.oldValue = func(context.Context) (*OwnerDeletion, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1166,7 +3871,7 @@ func withOrigin(node *Origin) originOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m OriginMutation) Client() *Client {
+func (m OwnerDeletionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1174,7 +3879,7 @@ func (m OriginMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m OriginMutation) Tx() (*Tx, error) {
+func (m OwnerDeletionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("generated: mutation is not running in a transaction")
 	}
@@ -1184,14 +3889,14 @@ func (m OriginMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Origin entities.
-func (m *OriginMutation) SetID(id gidx.PrefixedID) {
+// operation is only accepted on creation of OwnerDeletion entities.
+func (m *OwnerDeletionMutation) SetID(id gidx.PrefixedID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *OriginMutation) ID() (id gidx.PrefixedID, exists bool) {
+func (m *OwnerDeletionMutation) ID() (id gidx.PrefixedID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1202,7 +3907,7 @@ func (m *OriginMutation) ID() (id gidx.PrefixedID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *OriginMutation) IDs(ctx context.Context) ([]gidx.PrefixedID, error) {
+func (m *OwnerDeletionMutation) IDs(ctx context.Context) ([]gidx.PrefixedID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1211,572 +3916,824 @@ func (m *OriginMutation) IDs(ctx context.Context) ([]gidx.PrefixedID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Origin.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().OwnerDeletion.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *OriginMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetOwnerID sets the "owner_id" field.
+func (m *OwnerDeletionMutation) SetOwnerID(gi gidx.PrefixedID) {
+	m.owner_id = &gi
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *OriginMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *OwnerDeletionMutation) OwnerID() (r gidx.PrefixedID, exists bool) {
+	v := m.owner_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Origin entity.
-// If the Origin object wasn't provided to the builder, the object is fetched from the database.
+// OldOwnerID returns the old "owner_id" field's value of the OwnerDeletion entity.
+// If the OwnerDeletion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OriginMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *OwnerDeletionMutation) OldOwnerID(ctx context.Context) (v gidx.PrefixedID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.OwnerID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *OriginMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *OwnerDeletionMutation) ResetOwnerID() {
+	m.owner_id = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *OriginMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetStartedAt sets the "started_at" field.
+func (m *OwnerDeletionMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *OriginMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *OwnerDeletionMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Origin entity.
-// If the Origin object wasn't provided to the builder, the object is fetched from the database.
+// OldStartedAt returns the old "started_at" field's value of the OwnerDeletion entity.
+// If the OwnerDeletion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OriginMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *OwnerDeletionMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.StartedAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *OriginMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *OwnerDeletionMutation) ResetStartedAt() {
+	m.started_at = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *OriginMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetCompletedAt sets the "completed_at" field.
+func (m *OwnerDeletionMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *OriginMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *OwnerDeletionMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Origin entity.
-// If the Origin object wasn't provided to the builder, the object is fetched from the database.
+// OldCompletedAt returns the old "completed_at" field's value of the OwnerDeletion entity.
+// If the OwnerDeletion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OriginMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
+func (m *OwnerDeletionMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
 	}
-	return oldValue.DeletedAt, nil
+	return oldValue.CompletedAt, nil
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *OriginMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[origin.FieldDeletedAt] = struct{}{}
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *OwnerDeletionMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[ownerdeletion.FieldCompletedAt] = struct{}{}
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *OriginMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[origin.FieldDeletedAt]
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *OwnerDeletionMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[ownerdeletion.FieldCompletedAt]
 	return ok
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *OriginMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, origin.FieldDeletedAt)
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *OwnerDeletionMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, ownerdeletion.FieldCompletedAt)
 }
 
-// SetDeletedBy sets the "deleted_by" field.
-func (m *OriginMutation) SetDeletedBy(s string) {
-	m.deleted_by = &s
+// SetSkippedIds sets the "skipped_ids" field.
+func (m *OwnerDeletionMutation) SetSkippedIds(gi []gidx.PrefixedID) {
+	m.skipped_ids = &gi
+	m.appendskipped_ids = nil
 }
 
-// DeletedBy returns the value of the "deleted_by" field in the mutation.
-func (m *OriginMutation) DeletedBy() (r string, exists bool) {
-	v := m.deleted_by
+// SkippedIds returns the value of the "skipped_ids" field in the mutation.
+func (m *OwnerDeletionMutation) SkippedIds() (r []gidx.PrefixedID, exists bool) {
+	v := m.skipped_ids
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedBy returns the old "deleted_by" field's value of the Origin entity.
-// If the Origin object wasn't provided to the builder, the object is fetched from the database.
+// OldSkippedIds returns the old "skipped_ids" field's value of the OwnerDeletion entity.
+// If the OwnerDeletion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OriginMutation) OldDeletedBy(ctx context.Context) (v string, err error) {
+func (m *OwnerDeletionMutation) OldSkippedIds(ctx context.Context) (v []gidx.PrefixedID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldSkippedIds is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
+		return v, errors.New("OldSkippedIds requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldSkippedIds: %w", err)
 	}
-	return oldValue.DeletedBy, nil
+	return oldValue.SkippedIds, nil
 }
 
-// ClearDeletedBy clears the value of the "deleted_by" field.
-func (m *OriginMutation) ClearDeletedBy() {
-	m.deleted_by = nil
-	m.clearedFields[origin.FieldDeletedBy] = struct{}{}
+// AppendSkippedIds adds gi to the "skipped_ids" field.
+func (m *OwnerDeletionMutation) AppendSkippedIds(gi []gidx.PrefixedID) {
+	m.appendskipped_ids = append(m.appendskipped_ids, gi...)
+}
+
+// AppendedSkippedIds returns the list of values that were appended to the "skipped_ids" field in this mutation.
+func (m *OwnerDeletionMutation) AppendedSkippedIds() ([]gidx.PrefixedID, bool) {
+	if len(m.appendskipped_ids) == 0 {
+		return nil, false
+	}
+	return m.appendskipped_ids, true
+}
+
+// ClearSkippedIds clears the value of the "skipped_ids" field.
+func (m *OwnerDeletionMutation) ClearSkippedIds() {
+	m.skipped_ids = nil
+	m.appendskipped_ids = nil
+	m.clearedFields[ownerdeletion.FieldSkippedIds] = struct{}{}
+}
+
+// SkippedIdsCleared returns if the "skipped_ids" field was cleared in this mutation.
+func (m *OwnerDeletionMutation) SkippedIdsCleared() bool {
+	_, ok := m.clearedFields[ownerdeletion.FieldSkippedIds]
+	return ok
+}
+
+// ResetSkippedIds resets all changes to the "skipped_ids" field.
+func (m *OwnerDeletionMutation) ResetSkippedIds() {
+	m.skipped_ids = nil
+	m.appendskipped_ids = nil
+	delete(m.clearedFields, ownerdeletion.FieldSkippedIds)
+}
+
+// Where appends a list predicates to the OwnerDeletionMutation builder.
+func (m *OwnerDeletionMutation) Where(ps ...predicate.OwnerDeletion) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OwnerDeletionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OwnerDeletionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OwnerDeletion, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OwnerDeletionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OwnerDeletionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OwnerDeletion).
+func (m *OwnerDeletionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OwnerDeletionMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.owner_id != nil {
+		fields = append(fields, ownerdeletion.FieldOwnerID)
+	}
+	if m.started_at != nil {
+		fields = append(fields, ownerdeletion.FieldStartedAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, ownerdeletion.FieldCompletedAt)
+	}
+	if m.skipped_ids != nil {
+		fields = append(fields, ownerdeletion.FieldSkippedIds)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OwnerDeletionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case ownerdeletion.FieldOwnerID:
+		return m.OwnerID()
+	case ownerdeletion.FieldStartedAt:
+		return m.StartedAt()
+	case ownerdeletion.FieldCompletedAt:
+		return m.CompletedAt()
+	case ownerdeletion.FieldSkippedIds:
+		return m.SkippedIds()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OwnerDeletionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case ownerdeletion.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	case ownerdeletion.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case ownerdeletion.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case ownerdeletion.FieldSkippedIds:
+		return m.OldSkippedIds(ctx)
+	}
+	return nil, fmt.Errorf("unknown OwnerDeletion field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OwnerDeletionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case ownerdeletion.FieldOwnerID:
+		v, ok := value.(gidx.PrefixedID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
+	case ownerdeletion.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case ownerdeletion.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	case ownerdeletion.FieldSkippedIds:
+		v, ok := value.([]gidx.PrefixedID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSkippedIds(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OwnerDeletion field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OwnerDeletionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OwnerDeletionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OwnerDeletionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown OwnerDeletion numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OwnerDeletionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(ownerdeletion.FieldCompletedAt) {
+		fields = append(fields, ownerdeletion.FieldCompletedAt)
+	}
+	if m.FieldCleared(ownerdeletion.FieldSkippedIds) {
+		fields = append(fields, ownerdeletion.FieldSkippedIds)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OwnerDeletionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OwnerDeletionMutation) ClearField(name string) error {
+	switch name {
+	case ownerdeletion.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	case ownerdeletion.FieldSkippedIds:
+		m.ClearSkippedIds()
+		return nil
+	}
+	return fmt.Errorf("unknown OwnerDeletion nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OwnerDeletionMutation) ResetField(name string) error {
+	switch name {
+	case ownerdeletion.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	case ownerdeletion.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case ownerdeletion.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case ownerdeletion.FieldSkippedIds:
+		m.ResetSkippedIds()
+		return nil
+	}
+	return fmt.Errorf("unknown OwnerDeletion field %s", name)
 }
 
-// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
-func (m *OriginMutation) DeletedByCleared() bool {
-	_, ok := m.clearedFields[origin.FieldDeletedBy]
-	return ok
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OwnerDeletionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetDeletedBy resets all changes to the "deleted_by" field.
-func (m *OriginMutation) ResetDeletedBy() {
-	m.deleted_by = nil
-	delete(m.clearedFields, origin.FieldDeletedBy)
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OwnerDeletionMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// SetCreatedBy sets the "created_by" field.
-func (m *OriginMutation) SetCreatedBy(s string) {
-	m.created_by = &s
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OwnerDeletionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// CreatedBy returns the value of the "created_by" field in the mutation.
-func (m *OriginMutation) CreatedBy() (r string, exists bool) {
-	v := m.created_by
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OwnerDeletionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// OldCreatedBy returns the old "created_by" field's value of the Origin entity.
-// If the Origin object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OriginMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
-	}
-	return oldValue.CreatedBy, nil
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OwnerDeletionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ClearCreatedBy clears the value of the "created_by" field.
-func (m *OriginMutation) ClearCreatedBy() {
-	m.created_by = nil
-	m.clearedFields[origin.FieldCreatedBy] = struct{}{}
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OwnerDeletionMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
-func (m *OriginMutation) CreatedByCleared() bool {
-	_, ok := m.clearedFields[origin.FieldCreatedBy]
-	return ok
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OwnerDeletionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown OwnerDeletion unique edge %s", name)
 }
 
-// ResetCreatedBy resets all changes to the "created_by" field.
-func (m *OriginMutation) ResetCreatedBy() {
-	m.created_by = nil
-	delete(m.clearedFields, origin.FieldCreatedBy)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OwnerDeletionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown OwnerDeletion edge %s", name)
 }
 
-// SetUpdatedBy sets the "updated_by" field.
-func (m *OriginMutation) SetUpdatedBy(s string) {
-	m.updated_by = &s
+// PendingChangeMutation represents an operation that mutates the PendingChange nodes in the graph.
+type PendingChangeMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *gidx.PrefixedID
+	subject         *string
+	message         *events.ChangeMessage
+	attempts        *int
+	addattempts     *int
+	last_error      *string
+	created_at      *time.Time
+	next_attempt_at *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*PendingChange, error)
+	predicates      []predicate.PendingChange
 }
 
-// UpdatedBy returns the value of the "updated_by" field in the mutation.
-func (m *OriginMutation) UpdatedBy() (r string, exists bool) {
-	v := m.updated_by
-	if v == nil {
-		return
-	}
-	return *v, true
-}
+var _ ent.Mutation = (*PendingChangeMutation)(nil)
 
-// OldUpdatedBy returns the old "updated_by" field's value of the Origin entity.
-// If the Origin object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OriginMutation) OldUpdatedBy(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
+// pendingchangeOption allows management of the mutation configuration using functional options.
+type pendingchangeOption func(*PendingChangeMutation)
+
+// newPendingChangeMutation creates new mutation for the PendingChange entity.
+func newPendingChangeMutation(c config, op Op, opts ...pendingchangeOption) *PendingChangeMutation {
+	m := &PendingChangeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePendingChange,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
+	for _, opt := range opts {
+		opt(m)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
+	return m
+}
+
+// withPendingChangeID sets the ID field of the mutation.
+func withPendingChangeID(id gidx.PrefixedID) pendingchangeOption {
+	return func(m *PendingChangeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PendingChange
+		)
+		m.oldValue = func(ctx context.Context) (*PendingChange, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PendingChange.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.UpdatedBy, nil
 }
 
-// ClearUpdatedBy clears the value of the "updated_by" field.
-func (m *OriginMutation) ClearUpdatedBy() {
-	m.updated_by = nil
-	m.clearedFields[origin.FieldUpdatedBy] = struct{}{}
+// withPendingChange sets the old PendingChange of the mutation.
+func withPendingChange(node *PendingChange) pendingchangeOption {
+	return func(m *PendingChangeMutation) {
+		m.oldValue = func(context.Context) (*PendingChange, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
-func (m *OriginMutation) UpdatedByCleared() bool {
-	_, ok := m.clearedFields[origin.FieldUpdatedBy]
-	return ok
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PendingChangeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// ResetUpdatedBy resets all changes to the "updated_by" field.
-func (m *OriginMutation) ResetUpdatedBy() {
-	m.updated_by = nil
-	delete(m.clearedFields, origin.FieldUpdatedBy)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PendingChangeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// SetName sets the "name" field.
-func (m *OriginMutation) SetName(s string) {
-	m.name = &s
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PendingChange entities.
+func (m *PendingChangeMutation) SetID(id gidx.PrefixedID) {
+	m.id = &id
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *OriginMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PendingChangeMutation) ID() (id gidx.PrefixedID, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// OldName returns the old "name" field's value of the Origin entity.
-// If the Origin object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OriginMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PendingChangeMutation) IDs(ctx context.Context) ([]gidx.PrefixedID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []gidx.PrefixedID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PendingChange.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *OriginMutation) ResetName() {
-	m.name = nil
 }
 
-// SetWeight sets the "weight" field.
-func (m *OriginMutation) SetWeight(i int32) {
-	m.weight = &i
-	m.addweight = nil
+// SetSubject sets the "subject" field.
+func (m *PendingChangeMutation) SetSubject(s string) {
+	m.subject = &s
 }
 
-// Weight returns the value of the "weight" field in the mutation.
-func (m *OriginMutation) Weight() (r int32, exists bool) {
-	v := m.weight
+// Subject returns the value of the "subject" field in the mutation.
+func (m *PendingChangeMutation) Subject() (r string, exists bool) {
+	v := m.subject
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWeight returns the old "weight" field's value of the Origin entity.
-// If the Origin object wasn't provided to the builder, the object is fetched from the database.
+// OldSubject returns the old "subject" field's value of the PendingChange entity.
+// If the PendingChange object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OriginMutation) OldWeight(ctx context.Context) (v int32, err error) {
+func (m *PendingChangeMutation) OldSubject(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWeight is only allowed on UpdateOne operations")
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWeight requires an ID field in the mutation")
+		return v, errors.New("OldSubject requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWeight: %w", err)
-	}
-	return oldValue.Weight, nil
-}
-
-// AddWeight adds i to the "weight" field.
-func (m *OriginMutation) AddWeight(i int32) {
-	if m.addweight != nil {
-		*m.addweight += i
-	} else {
-		m.addweight = &i
-	}
-}
-
-// AddedWeight returns the value that was added to the "weight" field in this mutation.
-func (m *OriginMutation) AddedWeight() (r int32, exists bool) {
-	v := m.addweight
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
 	}
-	return *v, true
+	return oldValue.Subject, nil
 }
 
-// ResetWeight resets all changes to the "weight" field.
-func (m *OriginMutation) ResetWeight() {
-	m.weight = nil
-	m.addweight = nil
+// ResetSubject resets all changes to the "subject" field.
+func (m *PendingChangeMutation) ResetSubject() {
+	m.subject = nil
 }
 
-// SetTarget sets the "target" field.
-func (m *OriginMutation) SetTarget(s string) {
-	m.target = &s
+// SetMessage sets the "message" field.
+func (m *PendingChangeMutation) SetMessage(em events.ChangeMessage) {
+	m.message = &em
 }
 
-// Target returns the value of the "target" field in the mutation.
-func (m *OriginMutation) Target() (r string, exists bool) {
-	v := m.target
+// Message returns the value of the "message" field in the mutation.
+func (m *PendingChangeMutation) Message() (r events.ChangeMessage, exists bool) {
+	v := m.message
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTarget returns the old "target" field's value of the Origin entity.
-// If the Origin object wasn't provided to the builder, the object is fetched from the database.
+// OldMessage returns the old "message" field's value of the PendingChange entity.
+// If the PendingChange object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OriginMutation) OldTarget(ctx context.Context) (v string, err error) {
+func (m *PendingChangeMutation) OldMessage(ctx context.Context) (v events.ChangeMessage, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTarget is only allowed on UpdateOne operations")
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTarget requires an ID field in the mutation")
+		return v, errors.New("OldMessage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTarget: %w", err)
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
 	}
-	return oldValue.Target, nil
+	return oldValue.Message, nil
 }
 
-// ResetTarget resets all changes to the "target" field.
-func (m *OriginMutation) ResetTarget() {
-	m.target = nil
+// ResetMessage resets all changes to the "message" field.
+func (m *PendingChangeMutation) ResetMessage() {
+	m.message = nil
 }
 
-// SetPortNumber sets the "port_number" field.
-func (m *OriginMutation) SetPortNumber(i int) {
-	m.port_number = &i
-	m.addport_number = nil
+// SetAttempts sets the "attempts" field.
+func (m *PendingChangeMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// PortNumber returns the value of the "port_number" field in the mutation.
-func (m *OriginMutation) PortNumber() (r int, exists bool) {
-	v := m.port_number
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *PendingChangeMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPortNumber returns the old "port_number" field's value of the Origin entity.
-// If the Origin object wasn't provided to the builder, the object is fetched from the database.
+// OldAttempts returns the old "attempts" field's value of the PendingChange entity.
+// If the PendingChange object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OriginMutation) OldPortNumber(ctx context.Context) (v int, err error) {
+func (m *PendingChangeMutation) OldAttempts(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPortNumber is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPortNumber requires an ID field in the mutation")
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPortNumber: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
 	}
-	return oldValue.PortNumber, nil
+	return oldValue.Attempts, nil
 }
 
-// AddPortNumber adds i to the "port_number" field.
-func (m *OriginMutation) AddPortNumber(i int) {
-	if m.addport_number != nil {
-		*m.addport_number += i
+// AddAttempts adds i to the "attempts" field.
+func (m *PendingChangeMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
 	} else {
-		m.addport_number = &i
+		m.addattempts = &i
 	}
 }
 
-// AddedPortNumber returns the value that was added to the "port_number" field in this mutation.
-func (m *OriginMutation) AddedPortNumber() (r int, exists bool) {
-	v := m.addport_number
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *PendingChangeMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetPortNumber resets all changes to the "port_number" field.
-func (m *OriginMutation) ResetPortNumber() {
-	m.port_number = nil
-	m.addport_number = nil
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *PendingChangeMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
 }
 
-// SetActive sets the "active" field.
-func (m *OriginMutation) SetActive(b bool) {
-	m.active = &b
+// SetLastError sets the "last_error" field.
+func (m *PendingChangeMutation) SetLastError(s string) {
+	m.last_error = &s
 }
 
-// Active returns the value of the "active" field in the mutation.
-func (m *OriginMutation) Active() (r bool, exists bool) {
-	v := m.active
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *PendingChangeMutation) LastError() (r string, exists bool) {
+	v := m.last_error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActive returns the old "active" field's value of the Origin entity.
-// If the Origin object wasn't provided to the builder, the object is fetched from the database.
+// OldLastError returns the old "last_error" field's value of the PendingChange entity.
+// If the PendingChange object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OriginMutation) OldActive(ctx context.Context) (v bool, err error) {
+func (m *PendingChangeMutation) OldLastError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActive requires an ID field in the mutation")
+		return v, errors.New("OldLastError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
 	}
-	return oldValue.Active, nil
+	return oldValue.LastError, nil
 }
 
-// ResetActive resets all changes to the "active" field.
-func (m *OriginMutation) ResetActive() {
-	m.active = nil
+// ClearLastError clears the value of the "last_error" field.
+func (m *PendingChangeMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[pendingchange.FieldLastError] = struct{}{}
 }
 
-// SetPoolID sets the "pool_id" field.
-func (m *OriginMutation) SetPoolID(gi gidx.PrefixedID) {
-	m.pool = &gi
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *PendingChangeMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[pendingchange.FieldLastError]
+	return ok
 }
 
-// PoolID returns the value of the "pool_id" field in the mutation.
-func (m *OriginMutation) PoolID() (r gidx.PrefixedID, exists bool) {
-	v := m.pool
+// ResetLastError resets all changes to the "last_error" field.
+func (m *PendingChangeMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, pendingchange.FieldLastError)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PendingChangeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PendingChangeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPoolID returns the old "pool_id" field's value of the Origin entity.
-// If the Origin object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the PendingChange entity.
+// If the PendingChange object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OriginMutation) OldPoolID(ctx context.Context) (v gidx.PrefixedID, err error) {
+func (m *PendingChangeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPoolID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPoolID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPoolID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.PoolID, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetPoolID resets all changes to the "pool_id" field.
-func (m *OriginMutation) ResetPoolID() {
-	m.pool = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PendingChangeMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ClearPool clears the "pool" edge to the Pool entity.
-func (m *OriginMutation) ClearPool() {
-	m.clearedpool = true
-	m.clearedFields[origin.FieldPoolID] = struct{}{}
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *PendingChangeMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
 }
 
-// PoolCleared reports if the "pool" edge to the Pool entity was cleared.
-func (m *OriginMutation) PoolCleared() bool {
-	return m.clearedpool
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *PendingChangeMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// PoolIDs returns the "pool" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PoolID instead. It exists only for internal usage by the builders.
-func (m *OriginMutation) PoolIDs() (ids []gidx.PrefixedID) {
-	if id := m.pool; id != nil {
-		ids = append(ids, *id)
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the PendingChange entity.
+// If the PendingChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PendingChangeMutation) OldNextAttemptAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
+	}
+	return oldValue.NextAttemptAt, nil
 }
 
-// ResetPool resets all changes to the "pool" edge.
-func (m *OriginMutation) ResetPool() {
-	m.pool = nil
-	m.clearedpool = false
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *PendingChangeMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
 }
 
-// Where appends a list predicates to the OriginMutation builder.
-func (m *OriginMutation) Where(ps ...predicate.Origin) {
+// Where appends a list predicates to the PendingChangeMutation builder.
+func (m *PendingChangeMutation) Where(ps ...predicate.PendingChange) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the OriginMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PendingChangeMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *OriginMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Origin, len(ps))
+func (m *PendingChangeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PendingChange, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1784,60 +4741,42 @@ func (m *OriginMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *OriginMutation) Op() Op {
+func (m *PendingChangeMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *OriginMutation) SetOp(op Op) {
+func (m *PendingChangeMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Origin).
-func (m *OriginMutation) Type() string {
+// Type returns the node type of this mutation (PendingChange).
+func (m *PendingChangeMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *OriginMutation) Fields() []string {
-	fields := make([]string, 0, 12)
-	if m.created_at != nil {
-		fields = append(fields, origin.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, origin.FieldUpdatedAt)
-	}
-	if m.deleted_at != nil {
-		fields = append(fields, origin.FieldDeletedAt)
-	}
-	if m.deleted_by != nil {
-		fields = append(fields, origin.FieldDeletedBy)
+func (m *PendingChangeMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.subject != nil {
+		fields = append(fields, pendingchange.FieldSubject)
 	}
-	if m.created_by != nil {
-		fields = append(fields, origin.FieldCreatedBy)
-	}
-	if m.updated_by != nil {
-		fields = append(fields, origin.FieldUpdatedBy)
-	}
-	if m.name != nil {
-		fields = append(fields, origin.FieldName)
+	if m.message != nil {
+		fields = append(fields, pendingchange.FieldMessage)
 	}
-	if m.weight != nil {
-		fields = append(fields, origin.FieldWeight)
-	}
-	if m.target != nil {
-		fields = append(fields, origin.FieldTarget)
+	if m.attempts != nil {
+		fields = append(fields, pendingchange.FieldAttempts)
 	}
-	if m.port_number != nil {
-		fields = append(fields, origin.FieldPortNumber)
+	if m.last_error != nil {
+		fields = append(fields, pendingchange.FieldLastError)
 	}
-	if m.active != nil {
-		fields = append(fields, origin.FieldActive)
+	if m.created_at != nil {
+		fields = append(fields, pendingchange.FieldCreatedAt)
 	}
-	if m.pool != nil {
-		fields = append(fields, origin.FieldPoolID)
+	if m.next_attempt_at != nil {
+		fields = append(fields, pendingchange.FieldNextAttemptAt)
 	}
 	return fields
 }
@@ -1845,32 +4784,20 @@ func (m *OriginMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *OriginMutation) Field(name string) (ent.Value, bool) {
+func (m *PendingChangeMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case origin.FieldCreatedAt:
+	case pendingchange.FieldSubject:
+		return m.Subject()
+	case pendingchange.FieldMessage:
+		return m.Message()
+	case pendingchange.FieldAttempts:
+		return m.Attempts()
+	case pendingchange.FieldLastError:
+		return m.LastError()
+	case pendingchange.FieldCreatedAt:
 		return m.CreatedAt()
-	case origin.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case origin.FieldDeletedAt:
-		return m.DeletedAt()
-	case origin.FieldDeletedBy:
-		return m.DeletedBy()
-	case origin.FieldCreatedBy:
-		return m.CreatedBy()
-	case origin.FieldUpdatedBy:
-		return m.UpdatedBy()
-	case origin.FieldName:
-		return m.Name()
-	case origin.FieldWeight:
-		return m.Weight()
-	case origin.FieldTarget:
-		return m.Target()
-	case origin.FieldPortNumber:
-		return m.PortNumber()
-	case origin.FieldActive:
-		return m.Active()
-	case origin.FieldPoolID:
-		return m.PoolID()
+	case pendingchange.FieldNextAttemptAt:
+		return m.NextAttemptAt()
 	}
 	return nil, false
 }
@@ -1878,138 +4805,81 @@ func (m *OriginMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *OriginMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PendingChangeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case origin.FieldCreatedAt:
+	case pendingchange.FieldSubject:
+		return m.OldSubject(ctx)
+	case pendingchange.FieldMessage:
+		return m.OldMessage(ctx)
+	case pendingchange.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case pendingchange.FieldLastError:
+		return m.OldLastError(ctx)
+	case pendingchange.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case origin.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case origin.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
-	case origin.FieldDeletedBy:
-		return m.OldDeletedBy(ctx)
-	case origin.FieldCreatedBy:
-		return m.OldCreatedBy(ctx)
-	case origin.FieldUpdatedBy:
-		return m.OldUpdatedBy(ctx)
-	case origin.FieldName:
-		return m.OldName(ctx)
-	case origin.FieldWeight:
-		return m.OldWeight(ctx)
-	case origin.FieldTarget:
-		return m.OldTarget(ctx)
-	case origin.FieldPortNumber:
-		return m.OldPortNumber(ctx)
-	case origin.FieldActive:
-		return m.OldActive(ctx)
-	case origin.FieldPoolID:
-		return m.OldPoolID(ctx)
+	case pendingchange.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Origin field %s", name)
+	return nil, fmt.Errorf("unknown PendingChange field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OriginMutation) SetField(name string, value ent.Value) error {
+func (m *PendingChangeMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case origin.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case origin.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case origin.FieldDeletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeletedAt(v)
-		return nil
-	case origin.FieldDeletedBy:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeletedBy(v)
-		return nil
-	case origin.FieldCreatedBy:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedBy(v)
-		return nil
-	case origin.FieldUpdatedBy:
+	case pendingchange.FieldSubject:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedBy(v)
+		m.SetSubject(v)
 		return nil
-	case origin.FieldName:
-		v, ok := value.(string)
+	case pendingchange.FieldMessage:
+		v, ok := value.(events.ChangeMessage)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetMessage(v)
 		return nil
-	case origin.FieldWeight:
-		v, ok := value.(int32)
+	case pendingchange.FieldAttempts:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetWeight(v)
+		m.SetAttempts(v)
 		return nil
-	case origin.FieldTarget:
+	case pendingchange.FieldLastError:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTarget(v)
-		return nil
-	case origin.FieldPortNumber:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPortNumber(v)
+		m.SetLastError(v)
 		return nil
-	case origin.FieldActive:
-		v, ok := value.(bool)
+	case pendingchange.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetActive(v)
+		m.SetCreatedAt(v)
 		return nil
-	case origin.FieldPoolID:
-		v, ok := value.(gidx.PrefixedID)
+	case pendingchange.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPoolID(v)
+		m.SetNextAttemptAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Origin field %s", name)
+	return fmt.Errorf("unknown PendingChange field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *OriginMutation) AddedFields() []string {
+func (m *PendingChangeMutation) AddedFields() []string {
 	var fields []string
-	if m.addweight != nil {
-		fields = append(fields, origin.FieldWeight)
-	}
-	if m.addport_number != nil {
-		fields = append(fields, origin.FieldPortNumber)
+	if m.addattempts != nil {
+		fields = append(fields, pendingchange.FieldAttempts)
 	}
 	return fields
 }
@@ -2017,12 +4887,10 @@ func (m *OriginMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *OriginMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PendingChangeMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case origin.FieldWeight:
-		return m.AddedWeight()
-	case origin.FieldPortNumber:
-		return m.AddedPortNumber()
+	case pendingchange.FieldAttempts:
+		return m.AddedAttempts()
 	}
 	return nil, false
 }
@@ -2030,188 +4898,119 @@ func (m *OriginMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OriginMutation) AddField(name string, value ent.Value) error {
+func (m *PendingChangeMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case origin.FieldWeight:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddWeight(v)
-		return nil
-	case origin.FieldPortNumber:
+	case pendingchange.FieldAttempts:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddPortNumber(v)
+		m.AddAttempts(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Origin numeric field %s", name)
+	return fmt.Errorf("unknown PendingChange numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *OriginMutation) ClearedFields() []string {
+func (m *PendingChangeMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(origin.FieldDeletedAt) {
-		fields = append(fields, origin.FieldDeletedAt)
-	}
-	if m.FieldCleared(origin.FieldDeletedBy) {
-		fields = append(fields, origin.FieldDeletedBy)
-	}
-	if m.FieldCleared(origin.FieldCreatedBy) {
-		fields = append(fields, origin.FieldCreatedBy)
-	}
-	if m.FieldCleared(origin.FieldUpdatedBy) {
-		fields = append(fields, origin.FieldUpdatedBy)
+	if m.FieldCleared(pendingchange.FieldLastError) {
+		fields = append(fields, pendingchange.FieldLastError)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *OriginMutation) FieldCleared(name string) bool {
+func (m *PendingChangeMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *OriginMutation) ClearField(name string) error {
+func (m *PendingChangeMutation) ClearField(name string) error {
 	switch name {
-	case origin.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
-	case origin.FieldDeletedBy:
-		m.ClearDeletedBy()
-		return nil
-	case origin.FieldCreatedBy:
-		m.ClearCreatedBy()
-		return nil
-	case origin.FieldUpdatedBy:
-		m.ClearUpdatedBy()
+	case pendingchange.FieldLastError:
+		m.ClearLastError()
 		return nil
 	}
-	return fmt.Errorf("unknown Origin nullable field %s", name)
+	return fmt.Errorf("unknown PendingChange nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *OriginMutation) ResetField(name string) error {
+func (m *PendingChangeMutation) ResetField(name string) error {
 	switch name {
-	case origin.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case origin.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case origin.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
-	case origin.FieldDeletedBy:
-		m.ResetDeletedBy()
-		return nil
-	case origin.FieldCreatedBy:
-		m.ResetCreatedBy()
-		return nil
-	case origin.FieldUpdatedBy:
-		m.ResetUpdatedBy()
-		return nil
-	case origin.FieldName:
-		m.ResetName()
+	case pendingchange.FieldSubject:
+		m.ResetSubject()
 		return nil
-	case origin.FieldWeight:
-		m.ResetWeight()
+	case pendingchange.FieldMessage:
+		m.ResetMessage()
 		return nil
-	case origin.FieldTarget:
-		m.ResetTarget()
+	case pendingchange.FieldAttempts:
+		m.ResetAttempts()
 		return nil
-	case origin.FieldPortNumber:
-		m.ResetPortNumber()
+	case pendingchange.FieldLastError:
+		m.ResetLastError()
 		return nil
-	case origin.FieldActive:
-		m.ResetActive()
+	case pendingchange.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case origin.FieldPoolID:
-		m.ResetPoolID()
+	case pendingchange.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Origin field %s", name)
+	return fmt.Errorf("unknown PendingChange field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *OriginMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.pool != nil {
-		edges = append(edges, origin.EdgePool)
-	}
+func (m *PendingChangeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *OriginMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case origin.EdgePool:
-		if id := m.pool; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *PendingChangeMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *OriginMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *PendingChangeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *OriginMutation) RemovedIDs(name string) []ent.Value {
+func (m *PendingChangeMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *OriginMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedpool {
-		edges = append(edges, origin.EdgePool)
-	}
+func (m *PendingChangeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *OriginMutation) EdgeCleared(name string) bool {
-	switch name {
-	case origin.EdgePool:
-		return m.clearedpool
-	}
+func (m *PendingChangeMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *OriginMutation) ClearEdge(name string) error {
-	switch name {
-	case origin.EdgePool:
-		m.ClearPool()
-		return nil
-	}
-	return fmt.Errorf("unknown Origin unique edge %s", name)
+func (m *PendingChangeMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PendingChange unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *OriginMutation) ResetEdge(name string) error {
-	switch name {
-	case origin.EdgePool:
-		m.ResetPool()
-		return nil
-	}
-	return fmt.Errorf("unknown Origin edge %s", name)
+func (m *PendingChangeMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PendingChange edge %s", name)
 }
 
 // PoolMutation represents an operation that mutates the Pool nodes in the graph.
@@ -2224,6 +5023,8 @@ type PoolMutation struct {
 	updated_at     *time.Time
 	created_by     *string
 	updated_by     *string
+	version        *int
+	addversion     *int
 	deleted_at     *time.Time
 	deleted_by     *string
 	name           *string
@@ -2515,6 +5316,62 @@ func (m *PoolMutation) ResetUpdatedBy() {
 	delete(m.clearedFields, pool.FieldUpdatedBy)
 }
 
+// SetVersion sets the "version" field.
+func (m *PoolMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *PoolMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Pool entity.
+// If the Pool object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PoolMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *PoolMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *PoolMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *PoolMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (m *PoolMutation) SetDeletedAt(t time.Time) {
 	m.deleted_at = &t
@@ -2863,7 +5720,7 @@ func (m *PoolMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *PoolMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+	fields := make([]string, 0, 10)
 	if m.created_at != nil {
 		fields = append(fields, pool.FieldCreatedAt)
 	}
@@ -2876,6 +5733,9 @@ func (m *PoolMutation) Fields() []string {
 	if m.updated_by != nil {
 		fields = append(fields, pool.FieldUpdatedBy)
 	}
+	if m.version != nil {
+		fields = append(fields, pool.FieldVersion)
+	}
 	if m.deleted_at != nil {
 		fields = append(fields, pool.FieldDeletedAt)
 	}
@@ -2907,6 +5767,8 @@ func (m *PoolMutation) Field(name string) (ent.Value, bool) {
 		return m.CreatedBy()
 	case pool.FieldUpdatedBy:
 		return m.UpdatedBy()
+	case pool.FieldVersion:
+		return m.Version()
 	case pool.FieldDeletedAt:
 		return m.DeletedAt()
 	case pool.FieldDeletedBy:
@@ -2934,6 +5796,8 @@ func (m *PoolMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldCreatedBy(ctx)
 	case pool.FieldUpdatedBy:
 		return m.OldUpdatedBy(ctx)
+	case pool.FieldVersion:
+		return m.OldVersion(ctx)
 	case pool.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
 	case pool.FieldDeletedBy:
@@ -2981,6 +5845,13 @@ func (m *PoolMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetUpdatedBy(v)
 		return nil
+	case pool.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
 	case pool.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -3023,13 +5894,21 @@ func (m *PoolMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *PoolMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, pool.FieldVersion)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *PoolMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case pool.FieldVersion:
+		return m.AddedVersion()
+	}
 	return nil, false
 }
 
@@ -3038,6 +5917,13 @@ func (m *PoolMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *PoolMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case pool.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Pool numeric field %s", name)
 }
@@ -3104,6 +5990,9 @@ func (m *PoolMutation) ResetField(name string) error {
 	case pool.FieldUpdatedBy:
 		m.ResetUpdatedBy()
 		return nil
+	case pool.FieldVersion:
+		m.ResetVersion()
+		return nil
 	case pool.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
@@ -3245,6 +6134,8 @@ type PortMutation struct {
 	deleted_by           *string
 	created_by           *string
 	updated_by           *string
+	version              *int
+	addversion           *int
 	number               *int
 	addnumber            *int
 	name                 *string
@@ -3631,6 +6522,62 @@ func (m *PortMutation) ResetUpdatedBy() {
 	delete(m.clearedFields, port.FieldUpdatedBy)
 }
 
+// SetVersion sets the "version" field.
+func (m *PortMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *PortMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Port entity.
+// If the Port object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PortMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *PortMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *PortMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *PortMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
 // SetNumber sets the "number" field.
 func (m *PortMutation) SetNumber(i int) {
 	m.number = &i
@@ -3887,7 +6834,7 @@ func (m *PortMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *PortMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+	fields := make([]string, 0, 10)
 	if m.created_at != nil {
 		fields = append(fields, port.FieldCreatedAt)
 	}
@@ -3906,6 +6853,9 @@ func (m *PortMutation) Fields() []string {
 	if m.updated_by != nil {
 		fields = append(fields, port.FieldUpdatedBy)
 	}
+	if m.version != nil {
+		fields = append(fields, port.FieldVersion)
+	}
 	if m.number != nil {
 		fields = append(fields, port.FieldNumber)
 	}
@@ -3935,6 +6885,8 @@ func (m *PortMutation) Field(name string) (ent.Value, bool) {
 		return m.CreatedBy()
 	case port.FieldUpdatedBy:
 		return m.UpdatedBy()
+	case port.FieldVersion:
+		return m.Version()
 	case port.FieldNumber:
 		return m.Number()
 	case port.FieldName:
@@ -3962,6 +6914,8 @@ func (m *PortMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldCreatedBy(ctx)
 	case port.FieldUpdatedBy:
 		return m.OldUpdatedBy(ctx)
+	case port.FieldVersion:
+		return m.OldVersion(ctx)
 	case port.FieldNumber:
 		return m.OldNumber(ctx)
 	case port.FieldName:
@@ -4019,6 +6973,13 @@ func (m *PortMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetUpdatedBy(v)
 		return nil
+	case port.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
 	case port.FieldNumber:
 		v, ok := value.(int)
 		if !ok {
@@ -4048,6 +7009,9 @@ func (m *PortMutation) SetField(name string, value ent.Value) error {
 // this mutation.
 func (m *PortMutation) AddedFields() []string {
 	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, port.FieldVersion)
+	}
 	if m.addnumber != nil {
 		fields = append(fields, port.FieldNumber)
 	}
@@ -4059,6 +7023,8 @@ func (m *PortMutation) AddedFields() []string {
 // was not set, or was not defined in the schema.
 func (m *PortMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
+	case port.FieldVersion:
+		return m.AddedVersion()
 	case port.FieldNumber:
 		return m.AddedNumber()
 	}
@@ -4070,6 +7036,13 @@ func (m *PortMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *PortMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case port.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
 	case port.FieldNumber:
 		v, ok := value.(int)
 		if !ok {
@@ -4155,6 +7128,9 @@ func (m *PortMutation) ResetField(name string) error {
 	case port.FieldUpdatedBy:
 		m.ResetUpdatedBy()
 		return nil
+	case port.FieldVersion:
+		m.ResetVersion()
+		return nil
 	case port.FieldNumber:
 		m.ResetNumber()
 		return nil
@@ -4282,6 +7258,8 @@ type ProviderMutation struct {
 	deleted_by            *string
 	created_by            *string
 	updated_by            *string
+	version               *int
+	addversion            *int
 	name                  *string
 	owner_id              *gidx.PrefixedID
 	clearedFields         map[string]struct{}
@@ -4665,6 +7643,62 @@ func (m *ProviderMutation) ResetUpdatedBy() {
 	delete(m.clearedFields, provider.FieldUpdatedBy)
 }
 
+// SetVersion sets the "version" field.
+func (m *ProviderMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *ProviderMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Provider entity.
+// If the Provider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProviderMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *ProviderMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *ProviderMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *ProviderMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
 // SetName sets the "name" field.
 func (m *ProviderMutation) SetName(s string) {
 	m.name = &s
@@ -4825,7 +7859,7 @@ func (m *ProviderMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ProviderMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+	fields := make([]string, 0, 9)
 	if m.created_at != nil {
 		fields = append(fields, provider.FieldCreatedAt)
 	}
@@ -4844,6 +7878,9 @@ func (m *ProviderMutation) Fields() []string {
 	if m.updated_by != nil {
 		fields = append(fields, provider.FieldUpdatedBy)
 	}
+	if m.version != nil {
+		fields = append(fields, provider.FieldVersion)
+	}
 	if m.name != nil {
 		fields = append(fields, provider.FieldName)
 	}
@@ -4870,6 +7907,8 @@ func (m *ProviderMutation) Field(name string) (ent.Value, bool) {
 		return m.CreatedBy()
 	case provider.FieldUpdatedBy:
 		return m.UpdatedBy()
+	case provider.FieldVersion:
+		return m.Version()
 	case provider.FieldName:
 		return m.Name()
 	case provider.FieldOwnerID:
@@ -4895,6 +7934,8 @@ func (m *ProviderMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldCreatedBy(ctx)
 	case provider.FieldUpdatedBy:
 		return m.OldUpdatedBy(ctx)
+	case provider.FieldVersion:
+		return m.OldVersion(ctx)
 	case provider.FieldName:
 		return m.OldName(ctx)
 	case provider.FieldOwnerID:
@@ -4950,6 +7991,13 @@ func (m *ProviderMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetUpdatedBy(v)
 		return nil
+	case provider.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
 	case provider.FieldName:
 		v, ok := value.(string)
 		if !ok {
@@ -4971,13 +8019,21 @@ func (m *ProviderMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *ProviderMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, provider.FieldVersion)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *ProviderMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case provider.FieldVersion:
+		return m.AddedVersion()
+	}
 	return nil, false
 }
 
@@ -4986,6 +8042,13 @@ func (m *ProviderMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *ProviderMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case provider.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Provider numeric field %s", name)
 }
@@ -5058,6 +8121,9 @@ func (m *ProviderMutation) ResetField(name string) error {
 	case provider.FieldUpdatedBy:
 		m.ResetUpdatedBy()
 		return nil
+	case provider.FieldVersion:
+		m.ResetVersion()
+		return nil
 	case provider.FieldName:
 		m.ResetName()
 		return nil
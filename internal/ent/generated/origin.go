@@ -45,6 +45,8 @@ type Origin struct {
 	CreatedBy string `json:"created_by,omitempty"`
 	// UpdatedBy holds the value of the "updated_by" field.
 	UpdatedBy string `json:"updated_by,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
 	// Weight holds the value of the "weight" field.
@@ -96,7 +98,7 @@ func (*Origin) scanValues(columns []string) ([]any, error) {
 			values[i] = new(gidx.PrefixedID)
 		case origin.FieldActive:
 			values[i] = new(sql.NullBool)
-		case origin.FieldWeight, origin.FieldPortNumber:
+		case origin.FieldVersion, origin.FieldWeight, origin.FieldPortNumber:
 			values[i] = new(sql.NullInt64)
 		case origin.FieldDeletedBy, origin.FieldCreatedBy, origin.FieldUpdatedBy, origin.FieldName, origin.FieldTarget:
 			values[i] = new(sql.NullString)
@@ -159,6 +161,12 @@ func (o *Origin) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				o.UpdatedBy = value.String
 			}
+		case origin.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				o.Version = int(value.Int64)
+			}
 		case origin.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -254,6 +262,9 @@ func (o *Origin) String() string {
 	builder.WriteString("updated_by=")
 	builder.WriteString(o.UpdatedBy)
 	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", o.Version))
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(o.Name)
 	builder.WriteString(", ")
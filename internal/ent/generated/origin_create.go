@@ -120,6 +120,20 @@ func (oc *OriginCreate) SetNillableUpdatedBy(s *string) *OriginCreate {
 	return oc
 }
 
+// SetVersion sets the "version" field.
+func (oc *OriginCreate) SetVersion(i int) *OriginCreate {
+	oc.mutation.SetVersion(i)
+	return oc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (oc *OriginCreate) SetNillableVersion(i *int) *OriginCreate {
+	if i != nil {
+		oc.SetVersion(*i)
+	}
+	return oc
+}
+
 // SetName sets the "name" field.
 func (oc *OriginCreate) SetName(s string) *OriginCreate {
 	oc.mutation.SetName(s)
@@ -242,6 +256,10 @@ func (oc *OriginCreate) defaults() error {
 		v := origin.DefaultUpdatedAt()
 		oc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := oc.mutation.Version(); !ok {
+		v := origin.DefaultVersion
+		oc.mutation.SetVersion(v)
+	}
 	if _, ok := oc.mutation.Weight(); !ok {
 		v := origin.DefaultWeight
 		oc.mutation.SetWeight(v)
@@ -268,6 +286,9 @@ func (oc *OriginCreate) check() error {
 	if _, ok := oc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "Origin.updated_at"`)}
 	}
+	if _, ok := oc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`generated: missing required field "Origin.version"`)}
+	}
 	if _, ok := oc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`generated: missing required field "Origin.name"`)}
 	}
@@ -368,6 +389,10 @@ func (oc *OriginCreate) createSpec() (*Origin, *sqlgraph.CreateSpec) {
 		_spec.SetField(origin.FieldUpdatedBy, field.TypeString, value)
 		_node.UpdatedBy = value
 	}
+	if value, ok := oc.mutation.Version(); ok {
+		_spec.SetField(origin.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
 	if value, ok := oc.mutation.Name(); ok {
 		_spec.SetField(origin.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -102,6 +102,27 @@ func (ou *OriginUpdate) ClearUpdatedBy() *OriginUpdate {
 	return ou
 }
 
+// SetVersion sets the "version" field.
+func (ou *OriginUpdate) SetVersion(i int) *OriginUpdate {
+	ou.mutation.ResetVersion()
+	ou.mutation.SetVersion(i)
+	return ou
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (ou *OriginUpdate) SetNillableVersion(i *int) *OriginUpdate {
+	if i != nil {
+		ou.SetVersion(*i)
+	}
+	return ou
+}
+
+// AddVersion adds i to the "version" field.
+func (ou *OriginUpdate) AddVersion(i int) *OriginUpdate {
+	ou.mutation.AddVersion(i)
+	return ou
+}
+
 // SetName sets the "name" field.
 func (ou *OriginUpdate) SetName(s string) *OriginUpdate {
 	ou.mutation.SetName(s)
@@ -268,6 +289,12 @@ func (ou *OriginUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ou.mutation.UpdatedByCleared() {
 		_spec.ClearField(origin.FieldUpdatedBy, field.TypeString)
 	}
+	if value, ok := ou.mutation.Version(); ok {
+		_spec.SetField(origin.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := ou.mutation.AddedVersion(); ok {
+		_spec.AddField(origin.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := ou.mutation.Name(); ok {
 		_spec.SetField(origin.FieldName, field.TypeString, value)
 	}
@@ -369,6 +396,27 @@ func (ouo *OriginUpdateOne) ClearUpdatedBy() *OriginUpdateOne {
 	return ouo
 }
 
+// SetVersion sets the "version" field.
+func (ouo *OriginUpdateOne) SetVersion(i int) *OriginUpdateOne {
+	ouo.mutation.ResetVersion()
+	ouo.mutation.SetVersion(i)
+	return ouo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (ouo *OriginUpdateOne) SetNillableVersion(i *int) *OriginUpdateOne {
+	if i != nil {
+		ouo.SetVersion(*i)
+	}
+	return ouo
+}
+
+// AddVersion adds i to the "version" field.
+func (ouo *OriginUpdateOne) AddVersion(i int) *OriginUpdateOne {
+	ouo.mutation.AddVersion(i)
+	return ouo
+}
+
 // SetName sets the "name" field.
 func (ouo *OriginUpdateOne) SetName(s string) *OriginUpdateOne {
 	ouo.mutation.SetName(s)
@@ -565,6 +613,12 @@ func (ouo *OriginUpdateOne) sqlSave(ctx context.Context) (_node *Origin, err err
 	if ouo.mutation.UpdatedByCleared() {
 		_spec.ClearField(origin.FieldUpdatedBy, field.TypeString)
 	}
+	if value, ok := ouo.mutation.Version(); ok {
+		_spec.SetField(origin.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := ouo.mutation.AddedVersion(); ok {
+		_spec.AddField(origin.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := ouo.mutation.Name(); ok {
 		_spec.SetField(origin.FieldName, field.TypeString, value)
 	}
@@ -0,0 +1,164 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package generated
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/ownerdeletion"
+	"go.infratographer.com/x/gidx"
+)
+
+// The removal of the resources of an owner that was deleted upstream.
+type OwnerDeletion struct {
+	config `json:"-"`
+	// ID of the ent.
+	// The ID for the owner deletion.
+	ID gidx.PrefixedID `json:"id,omitempty"`
+	// The ID of the deleted owner.
+	OwnerID gidx.PrefixedID `json:"owner_id,omitempty"`
+	// The time the removal of the owner's resources started.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// The time all resources of the owner were removed, empty while the deletion is in progress.
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// The IDs of the pools and providers that were kept because resources of other owners still use them.
+	SkippedIds   []gidx.PrefixedID `json:"skipped_ids,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OwnerDeletion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ownerdeletion.FieldSkippedIds:
+			values[i] = new([]byte)
+		case ownerdeletion.FieldID, ownerdeletion.FieldOwnerID:
+			values[i] = new(gidx.PrefixedID)
+		case ownerdeletion.FieldStartedAt, ownerdeletion.FieldCompletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OwnerDeletion fields.
+func (od *OwnerDeletion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ownerdeletion.FieldID:
+			if value, ok := values[i].(*gidx.PrefixedID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				od.ID = *value
+			}
+		case ownerdeletion.FieldOwnerID:
+			if value, ok := values[i].(*gidx.PrefixedID); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value != nil {
+				od.OwnerID = *value
+			}
+		case ownerdeletion.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				od.StartedAt = value.Time
+			}
+		case ownerdeletion.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				od.CompletedAt = new(time.Time)
+				*od.CompletedAt = value.Time
+			}
+		case ownerdeletion.FieldSkippedIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field skipped_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &od.SkippedIds); err != nil {
+					return fmt.Errorf("unmarshal field skipped_ids: %w", err)
+				}
+			}
+		default:
+			od.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OwnerDeletion.
+// This includes values selected through modifiers, order, etc.
+func (od *OwnerDeletion) Value(name string) (ent.Value, error) {
+	return od.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this OwnerDeletion.
+// Note that you need to call OwnerDeletion.Unwrap() before calling this method if this OwnerDeletion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (od *OwnerDeletion) Update() *OwnerDeletionUpdateOne {
+	return NewOwnerDeletionClient(od.config).UpdateOne(od)
+}
+
+// Unwrap unwraps the OwnerDeletion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (od *OwnerDeletion) Unwrap() *OwnerDeletion {
+	_tx, ok := od.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: OwnerDeletion is not a transactional entity")
+	}
+	od.config.driver = _tx.drv
+	return od
+}
+
+// String implements the fmt.Stringer.
+func (od *OwnerDeletion) String() string {
+	var builder strings.Builder
+	builder.WriteString("OwnerDeletion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", od.ID))
+	builder.WriteString("owner_id=")
+	builder.WriteString(fmt.Sprintf("%v", od.OwnerID))
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(od.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := od.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("skipped_ids=")
+	builder.WriteString(fmt.Sprintf("%v", od.SkippedIds))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// IsEntity implement fedruntime.Entity
+func (od OwnerDeletion) IsEntity() {}
+
+// OwnerDeletions is a parsable slice of OwnerDeletion.
+type OwnerDeletions []*OwnerDeletion
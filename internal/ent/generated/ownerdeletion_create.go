@@ -0,0 +1,286 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/ownerdeletion"
+	"go.infratographer.com/x/gidx"
+)
+
+// OwnerDeletionCreate is the builder for creating a OwnerDeletion entity.
+type OwnerDeletionCreate struct {
+	config
+	mutation *OwnerDeletionMutation
+	hooks    []Hook
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (odc *OwnerDeletionCreate) SetOwnerID(gi gidx.PrefixedID) *OwnerDeletionCreate {
+	odc.mutation.SetOwnerID(gi)
+	return odc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (odc *OwnerDeletionCreate) SetStartedAt(t time.Time) *OwnerDeletionCreate {
+	odc.mutation.SetStartedAt(t)
+	return odc
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (odc *OwnerDeletionCreate) SetNillableStartedAt(t *time.Time) *OwnerDeletionCreate {
+	if t != nil {
+		odc.SetStartedAt(*t)
+	}
+	return odc
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (odc *OwnerDeletionCreate) SetCompletedAt(t time.Time) *OwnerDeletionCreate {
+	odc.mutation.SetCompletedAt(t)
+	return odc
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (odc *OwnerDeletionCreate) SetNillableCompletedAt(t *time.Time) *OwnerDeletionCreate {
+	if t != nil {
+		odc.SetCompletedAt(*t)
+	}
+	return odc
+}
+
+// SetSkippedIds sets the "skipped_ids" field.
+func (odc *OwnerDeletionCreate) SetSkippedIds(gi []gidx.PrefixedID) *OwnerDeletionCreate {
+	odc.mutation.SetSkippedIds(gi)
+	return odc
+}
+
+// SetID sets the "id" field.
+func (odc *OwnerDeletionCreate) SetID(gi gidx.PrefixedID) *OwnerDeletionCreate {
+	odc.mutation.SetID(gi)
+	return odc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (odc *OwnerDeletionCreate) SetNillableID(gi *gidx.PrefixedID) *OwnerDeletionCreate {
+	if gi != nil {
+		odc.SetID(*gi)
+	}
+	return odc
+}
+
+// Mutation returns the OwnerDeletionMutation object of the builder.
+func (odc *OwnerDeletionCreate) Mutation() *OwnerDeletionMutation {
+	return odc.mutation
+}
+
+// Save creates the OwnerDeletion in the database.
+func (odc *OwnerDeletionCreate) Save(ctx context.Context) (*OwnerDeletion, error) {
+	odc.defaults()
+	return withHooks(ctx, odc.sqlSave, odc.mutation, odc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (odc *OwnerDeletionCreate) SaveX(ctx context.Context) *OwnerDeletion {
+	v, err := odc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (odc *OwnerDeletionCreate) Exec(ctx context.Context) error {
+	_, err := odc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (odc *OwnerDeletionCreate) ExecX(ctx context.Context) {
+	if err := odc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (odc *OwnerDeletionCreate) defaults() {
+	if _, ok := odc.mutation.StartedAt(); !ok {
+		v := ownerdeletion.DefaultStartedAt()
+		odc.mutation.SetStartedAt(v)
+	}
+	if _, ok := odc.mutation.ID(); !ok {
+		v := ownerdeletion.DefaultID()
+		odc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (odc *OwnerDeletionCreate) check() error {
+	if _, ok := odc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`generated: missing required field "OwnerDeletion.owner_id"`)}
+	}
+	if v, ok := odc.mutation.OwnerID(); ok {
+		if err := ownerdeletion.OwnerIDValidator(string(v)); err != nil {
+			return &ValidationError{Name: "owner_id", err: fmt.Errorf(`generated: validator failed for field "OwnerDeletion.owner_id": %w`, err)}
+		}
+	}
+	if _, ok := odc.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`generated: missing required field "OwnerDeletion.started_at"`)}
+	}
+	return nil
+}
+
+func (odc *OwnerDeletionCreate) sqlSave(ctx context.Context) (*OwnerDeletion, error) {
+	if err := odc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := odc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, odc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*gidx.PrefixedID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	odc.mutation.id = &_node.ID
+	odc.mutation.done = true
+	return _node, nil
+}
+
+func (odc *OwnerDeletionCreate) createSpec() (*OwnerDeletion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OwnerDeletion{config: odc.config}
+		_spec = sqlgraph.NewCreateSpec(ownerdeletion.Table, sqlgraph.NewFieldSpec(ownerdeletion.FieldID, field.TypeString))
+	)
+	if id, ok := odc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := odc.mutation.OwnerID(); ok {
+		_spec.SetField(ownerdeletion.FieldOwnerID, field.TypeString, value)
+		_node.OwnerID = value
+	}
+	if value, ok := odc.mutation.StartedAt(); ok {
+		_spec.SetField(ownerdeletion.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := odc.mutation.CompletedAt(); ok {
+		_spec.SetField(ownerdeletion.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if value, ok := odc.mutation.SkippedIds(); ok {
+		_spec.SetField(ownerdeletion.FieldSkippedIds, field.TypeJSON, value)
+		_node.SkippedIds = value
+	}
+	return _node, _spec
+}
+
+// OwnerDeletionCreateBulk is the builder for creating many OwnerDeletion entities in bulk.
+type OwnerDeletionCreateBulk struct {
+	config
+	err      error
+	builders []*OwnerDeletionCreate
+}
+
+// Save creates the OwnerDeletion entities in the database.
+func (odcb *OwnerDeletionCreateBulk) Save(ctx context.Context) ([]*OwnerDeletion, error) {
+	if odcb.err != nil {
+		return nil, odcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(odcb.builders))
+	nodes := make([]*OwnerDeletion, len(odcb.builders))
+	mutators := make([]Mutator, len(odcb.builders))
+	for i := range odcb.builders {
+		func(i int, root context.Context) {
+			builder := odcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OwnerDeletionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, odcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, odcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, odcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (odcb *OwnerDeletionCreateBulk) SaveX(ctx context.Context) []*OwnerDeletion {
+	v, err := odcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (odcb *OwnerDeletionCreateBulk) Exec(ctx context.Context) error {
+	_, err := odcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (odcb *OwnerDeletionCreateBulk) ExecX(ctx context.Context) {
+	if err := odcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/ownerdeletion"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/predicate"
+)
+
+// OwnerDeletionDelete is the builder for deleting a OwnerDeletion entity.
+type OwnerDeletionDelete struct {
+	config
+	hooks    []Hook
+	mutation *OwnerDeletionMutation
+}
+
+// Where appends a list predicates to the OwnerDeletionDelete builder.
+func (odd *OwnerDeletionDelete) Where(ps ...predicate.OwnerDeletion) *OwnerDeletionDelete {
+	odd.mutation.Where(ps...)
+	return odd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (odd *OwnerDeletionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, odd.sqlExec, odd.mutation, odd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (odd *OwnerDeletionDelete) ExecX(ctx context.Context) int {
+	n, err := odd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (odd *OwnerDeletionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(ownerdeletion.Table, sqlgraph.NewFieldSpec(ownerdeletion.FieldID, field.TypeString))
+	if ps := odd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, odd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	odd.mutation.done = true
+	return affected, err
+}
+
+// OwnerDeletionDeleteOne is the builder for deleting a single OwnerDeletion entity.
+type OwnerDeletionDeleteOne struct {
+	odd *OwnerDeletionDelete
+}
+
+// Where appends a list predicates to the OwnerDeletionDelete builder.
+func (oddo *OwnerDeletionDeleteOne) Where(ps ...predicate.OwnerDeletion) *OwnerDeletionDeleteOne {
+	oddo.odd.mutation.Where(ps...)
+	return oddo
+}
+
+// Exec executes the deletion query.
+func (oddo *OwnerDeletionDeleteOne) Exec(ctx context.Context) error {
+	n, err := oddo.odd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{ownerdeletion.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oddo *OwnerDeletionDeleteOne) ExecX(ctx context.Context) {
+	if err := oddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,554 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/ownerdeletion"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/predicate"
+	"go.infratographer.com/x/gidx"
+)
+
+// OwnerDeletionQuery is the builder for querying OwnerDeletion entities.
+type OwnerDeletionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []ownerdeletion.OrderOption
+	inters     []Interceptor
+	predicates []predicate.OwnerDeletion
+	modifiers  []func(*sql.Selector)
+	loadTotal  []func(context.Context, []*OwnerDeletion) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OwnerDeletionQuery builder.
+func (odq *OwnerDeletionQuery) Where(ps ...predicate.OwnerDeletion) *OwnerDeletionQuery {
+	odq.predicates = append(odq.predicates, ps...)
+	return odq
+}
+
+// Limit the number of records to be returned by this query.
+func (odq *OwnerDeletionQuery) Limit(limit int) *OwnerDeletionQuery {
+	odq.ctx.Limit = &limit
+	return odq
+}
+
+// Offset to start from.
+func (odq *OwnerDeletionQuery) Offset(offset int) *OwnerDeletionQuery {
+	odq.ctx.Offset = &offset
+	return odq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (odq *OwnerDeletionQuery) Unique(unique bool) *OwnerDeletionQuery {
+	odq.ctx.Unique = &unique
+	return odq
+}
+
+// Order specifies how the records should be ordered.
+func (odq *OwnerDeletionQuery) Order(o ...ownerdeletion.OrderOption) *OwnerDeletionQuery {
+	odq.order = append(odq.order, o...)
+	return odq
+}
+
+// First returns the first OwnerDeletion entity from the query.
+// Returns a *NotFoundError when no OwnerDeletion was found.
+func (odq *OwnerDeletionQuery) First(ctx context.Context) (*OwnerDeletion, error) {
+	nodes, err := odq.Limit(1).All(setContextOp(ctx, odq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{ownerdeletion.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (odq *OwnerDeletionQuery) FirstX(ctx context.Context) *OwnerDeletion {
+	node, err := odq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first OwnerDeletion ID from the query.
+// Returns a *NotFoundError when no OwnerDeletion ID was found.
+func (odq *OwnerDeletionQuery) FirstID(ctx context.Context) (id gidx.PrefixedID, err error) {
+	var ids []gidx.PrefixedID
+	if ids, err = odq.Limit(1).IDs(setContextOp(ctx, odq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{ownerdeletion.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (odq *OwnerDeletionQuery) FirstIDX(ctx context.Context) gidx.PrefixedID {
+	id, err := odq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single OwnerDeletion entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one OwnerDeletion entity is found.
+// Returns a *NotFoundError when no OwnerDeletion entities are found.
+func (odq *OwnerDeletionQuery) Only(ctx context.Context) (*OwnerDeletion, error) {
+	nodes, err := odq.Limit(2).All(setContextOp(ctx, odq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{ownerdeletion.Label}
+	default:
+		return nil, &NotSingularError{ownerdeletion.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (odq *OwnerDeletionQuery) OnlyX(ctx context.Context) *OwnerDeletion {
+	node, err := odq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only OwnerDeletion ID in the query.
+// Returns a *NotSingularError when more than one OwnerDeletion ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (odq *OwnerDeletionQuery) OnlyID(ctx context.Context) (id gidx.PrefixedID, err error) {
+	var ids []gidx.PrefixedID
+	if ids, err = odq.Limit(2).IDs(setContextOp(ctx, odq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{ownerdeletion.Label}
+	default:
+		err = &NotSingularError{ownerdeletion.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (odq *OwnerDeletionQuery) OnlyIDX(ctx context.Context) gidx.PrefixedID {
+	id, err := odq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of OwnerDeletions.
+func (odq *OwnerDeletionQuery) All(ctx context.Context) ([]*OwnerDeletion, error) {
+	ctx = setContextOp(ctx, odq.ctx, "All")
+	if err := odq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*OwnerDeletion, *OwnerDeletionQuery]()
+	return withInterceptors[[]*OwnerDeletion](ctx, odq, qr, odq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (odq *OwnerDeletionQuery) AllX(ctx context.Context) []*OwnerDeletion {
+	nodes, err := odq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of OwnerDeletion IDs.
+func (odq *OwnerDeletionQuery) IDs(ctx context.Context) (ids []gidx.PrefixedID, err error) {
+	if odq.ctx.Unique == nil && odq.path != nil {
+		odq.Unique(true)
+	}
+	ctx = setContextOp(ctx, odq.ctx, "IDs")
+	if err = odq.Select(ownerdeletion.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (odq *OwnerDeletionQuery) IDsX(ctx context.Context) []gidx.PrefixedID {
+	ids, err := odq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (odq *OwnerDeletionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, odq.ctx, "Count")
+	if err := odq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, odq, querierCount[*OwnerDeletionQuery](), odq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (odq *OwnerDeletionQuery) CountX(ctx context.Context) int {
+	count, err := odq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (odq *OwnerDeletionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, odq.ctx, "Exist")
+	switch _, err := odq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("generated: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (odq *OwnerDeletionQuery) ExistX(ctx context.Context) bool {
+	exist, err := odq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OwnerDeletionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (odq *OwnerDeletionQuery) Clone() *OwnerDeletionQuery {
+	if odq == nil {
+		return nil
+	}
+	return &OwnerDeletionQuery{
+		config:     odq.config,
+		ctx:        odq.ctx.Clone(),
+		order:      append([]ownerdeletion.OrderOption{}, odq.order...),
+		inters:     append([]Interceptor{}, odq.inters...),
+		predicates: append([]predicate.OwnerDeletion{}, odq.predicates...),
+		// clone intermediate query.
+		sql:  odq.sql.Clone(),
+		path: odq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		OwnerID gidx.PrefixedID `json:"owner_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.OwnerDeletion.Query().
+//		GroupBy(ownerdeletion.FieldOwnerID).
+//		Aggregate(generated.Count()).
+//		Scan(ctx, &v)
+func (odq *OwnerDeletionQuery) GroupBy(field string, fields ...string) *OwnerDeletionGroupBy {
+	odq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &OwnerDeletionGroupBy{build: odq}
+	grbuild.flds = &odq.ctx.Fields
+	grbuild.label = ownerdeletion.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		OwnerID gidx.PrefixedID `json:"owner_id,omitempty"`
+//	}
+//
+//	client.OwnerDeletion.Query().
+//		Select(ownerdeletion.FieldOwnerID).
+//		Scan(ctx, &v)
+func (odq *OwnerDeletionQuery) Select(fields ...string) *OwnerDeletionSelect {
+	odq.ctx.Fields = append(odq.ctx.Fields, fields...)
+	sbuild := &OwnerDeletionSelect{OwnerDeletionQuery: odq}
+	sbuild.label = ownerdeletion.Label
+	sbuild.flds, sbuild.scan = &odq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a OwnerDeletionSelect configured with the given aggregations.
+func (odq *OwnerDeletionQuery) Aggregate(fns ...AggregateFunc) *OwnerDeletionSelect {
+	return odq.Select().Aggregate(fns...)
+}
+
+func (odq *OwnerDeletionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range odq.inters {
+		if inter == nil {
+			return fmt.Errorf("generated: uninitialized interceptor (forgotten import generated/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, odq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range odq.ctx.Fields {
+		if !ownerdeletion.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+		}
+	}
+	if odq.path != nil {
+		prev, err := odq.path(ctx)
+		if err != nil {
+			return err
+		}
+		odq.sql = prev
+	}
+	return nil
+}
+
+func (odq *OwnerDeletionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OwnerDeletion, error) {
+	var (
+		nodes = []*OwnerDeletion{}
+		_spec = odq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*OwnerDeletion).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &OwnerDeletion{config: odq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(odq.modifiers) > 0 {
+		_spec.Modifiers = odq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, odq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	for i := range odq.loadTotal {
+		if err := odq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (odq *OwnerDeletionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := odq.querySpec()
+	if len(odq.modifiers) > 0 {
+		_spec.Modifiers = odq.modifiers
+	}
+	_spec.Node.Columns = odq.ctx.Fields
+	if len(odq.ctx.Fields) > 0 {
+		_spec.Unique = odq.ctx.Unique != nil && *odq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, odq.driver, _spec)
+}
+
+func (odq *OwnerDeletionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(ownerdeletion.Table, ownerdeletion.Columns, sqlgraph.NewFieldSpec(ownerdeletion.FieldID, field.TypeString))
+	_spec.From = odq.sql
+	if unique := odq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if odq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := odq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ownerdeletion.FieldID)
+		for i := range fields {
+			if fields[i] != ownerdeletion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := odq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := odq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := odq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := odq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (odq *OwnerDeletionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(odq.driver.Dialect())
+	t1 := builder.Table(ownerdeletion.Table)
+	columns := odq.ctx.Fields
+	if len(columns) == 0 {
+		columns = ownerdeletion.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if odq.sql != nil {
+		selector = odq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if odq.ctx.Unique != nil && *odq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range odq.predicates {
+		p(selector)
+	}
+	for _, p := range odq.order {
+		p(selector)
+	}
+	if offset := odq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := odq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// OwnerDeletionGroupBy is the group-by builder for OwnerDeletion entities.
+type OwnerDeletionGroupBy struct {
+	selector
+	build *OwnerDeletionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (odgb *OwnerDeletionGroupBy) Aggregate(fns ...AggregateFunc) *OwnerDeletionGroupBy {
+	odgb.fns = append(odgb.fns, fns...)
+	return odgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (odgb *OwnerDeletionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, odgb.build.ctx, "GroupBy")
+	if err := odgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OwnerDeletionQuery, *OwnerDeletionGroupBy](ctx, odgb.build, odgb, odgb.build.inters, v)
+}
+
+func (odgb *OwnerDeletionGroupBy) sqlScan(ctx context.Context, root *OwnerDeletionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(odgb.fns))
+	for _, fn := range odgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*odgb.flds)+len(odgb.fns))
+		for _, f := range *odgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*odgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := odgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// OwnerDeletionSelect is the builder for selecting fields of OwnerDeletion entities.
+type OwnerDeletionSelect struct {
+	*OwnerDeletionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ods *OwnerDeletionSelect) Aggregate(fns ...AggregateFunc) *OwnerDeletionSelect {
+	ods.fns = append(ods.fns, fns...)
+	return ods
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ods *OwnerDeletionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ods.ctx, "Select")
+	if err := ods.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OwnerDeletionQuery, *OwnerDeletionSelect](ctx, ods.OwnerDeletionQuery, ods, ods.inters, v)
+}
+
+func (ods *OwnerDeletionSelect) sqlScan(ctx context.Context, root *OwnerDeletionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ods.fns))
+	for _, fn := range ods.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ods.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ods.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
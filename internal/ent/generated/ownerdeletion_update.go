@@ -0,0 +1,302 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/ownerdeletion"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/predicate"
+	"go.infratographer.com/x/gidx"
+)
+
+// OwnerDeletionUpdate is the builder for updating OwnerDeletion entities.
+type OwnerDeletionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OwnerDeletionMutation
+}
+
+// Where appends a list predicates to the OwnerDeletionUpdate builder.
+func (odu *OwnerDeletionUpdate) Where(ps ...predicate.OwnerDeletion) *OwnerDeletionUpdate {
+	odu.mutation.Where(ps...)
+	return odu
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (odu *OwnerDeletionUpdate) SetCompletedAt(t time.Time) *OwnerDeletionUpdate {
+	odu.mutation.SetCompletedAt(t)
+	return odu
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (odu *OwnerDeletionUpdate) SetNillableCompletedAt(t *time.Time) *OwnerDeletionUpdate {
+	if t != nil {
+		odu.SetCompletedAt(*t)
+	}
+	return odu
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (odu *OwnerDeletionUpdate) ClearCompletedAt() *OwnerDeletionUpdate {
+	odu.mutation.ClearCompletedAt()
+	return odu
+}
+
+// SetSkippedIds sets the "skipped_ids" field.
+func (odu *OwnerDeletionUpdate) SetSkippedIds(gi []gidx.PrefixedID) *OwnerDeletionUpdate {
+	odu.mutation.SetSkippedIds(gi)
+	return odu
+}
+
+// AppendSkippedIds appends gi to the "skipped_ids" field.
+func (odu *OwnerDeletionUpdate) AppendSkippedIds(gi []gidx.PrefixedID) *OwnerDeletionUpdate {
+	odu.mutation.AppendSkippedIds(gi)
+	return odu
+}
+
+// ClearSkippedIds clears the value of the "skipped_ids" field.
+func (odu *OwnerDeletionUpdate) ClearSkippedIds() *OwnerDeletionUpdate {
+	odu.mutation.ClearSkippedIds()
+	return odu
+}
+
+// Mutation returns the OwnerDeletionMutation object of the builder.
+func (odu *OwnerDeletionUpdate) Mutation() *OwnerDeletionMutation {
+	return odu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (odu *OwnerDeletionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, odu.sqlSave, odu.mutation, odu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (odu *OwnerDeletionUpdate) SaveX(ctx context.Context) int {
+	affected, err := odu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (odu *OwnerDeletionUpdate) Exec(ctx context.Context) error {
+	_, err := odu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (odu *OwnerDeletionUpdate) ExecX(ctx context.Context) {
+	if err := odu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (odu *OwnerDeletionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(ownerdeletion.Table, ownerdeletion.Columns, sqlgraph.NewFieldSpec(ownerdeletion.FieldID, field.TypeString))
+	if ps := odu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := odu.mutation.CompletedAt(); ok {
+		_spec.SetField(ownerdeletion.FieldCompletedAt, field.TypeTime, value)
+	}
+	if odu.mutation.CompletedAtCleared() {
+		_spec.ClearField(ownerdeletion.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := odu.mutation.SkippedIds(); ok {
+		_spec.SetField(ownerdeletion.FieldSkippedIds, field.TypeJSON, value)
+	}
+	if value, ok := odu.mutation.AppendedSkippedIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, ownerdeletion.FieldSkippedIds, value)
+		})
+	}
+	if odu.mutation.SkippedIdsCleared() {
+		_spec.ClearField(ownerdeletion.FieldSkippedIds, field.TypeJSON)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, odu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ownerdeletion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	odu.mutation.done = true
+	return n, nil
+}
+
+// OwnerDeletionUpdateOne is the builder for updating a single OwnerDeletion entity.
+type OwnerDeletionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OwnerDeletionMutation
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (oduo *OwnerDeletionUpdateOne) SetCompletedAt(t time.Time) *OwnerDeletionUpdateOne {
+	oduo.mutation.SetCompletedAt(t)
+	return oduo
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (oduo *OwnerDeletionUpdateOne) SetNillableCompletedAt(t *time.Time) *OwnerDeletionUpdateOne {
+	if t != nil {
+		oduo.SetCompletedAt(*t)
+	}
+	return oduo
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (oduo *OwnerDeletionUpdateOne) ClearCompletedAt() *OwnerDeletionUpdateOne {
+	oduo.mutation.ClearCompletedAt()
+	return oduo
+}
+
+// SetSkippedIds sets the "skipped_ids" field.
+func (oduo *OwnerDeletionUpdateOne) SetSkippedIds(gi []gidx.PrefixedID) *OwnerDeletionUpdateOne {
+	oduo.mutation.SetSkippedIds(gi)
+	return oduo
+}
+
+// AppendSkippedIds appends gi to the "skipped_ids" field.
+func (oduo *OwnerDeletionUpdateOne) AppendSkippedIds(gi []gidx.PrefixedID) *OwnerDeletionUpdateOne {
+	oduo.mutation.AppendSkippedIds(gi)
+	return oduo
+}
+
+// ClearSkippedIds clears the value of the "skipped_ids" field.
+func (oduo *OwnerDeletionUpdateOne) ClearSkippedIds() *OwnerDeletionUpdateOne {
+	oduo.mutation.ClearSkippedIds()
+	return oduo
+}
+
+// Mutation returns the OwnerDeletionMutation object of the builder.
+func (oduo *OwnerDeletionUpdateOne) Mutation() *OwnerDeletionMutation {
+	return oduo.mutation
+}
+
+// Where appends a list predicates to the OwnerDeletionUpdate builder.
+func (oduo *OwnerDeletionUpdateOne) Where(ps ...predicate.OwnerDeletion) *OwnerDeletionUpdateOne {
+	oduo.mutation.Where(ps...)
+	return oduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (oduo *OwnerDeletionUpdateOne) Select(field string, fields ...string) *OwnerDeletionUpdateOne {
+	oduo.fields = append([]string{field}, fields...)
+	return oduo
+}
+
+// Save executes the query and returns the updated OwnerDeletion entity.
+func (oduo *OwnerDeletionUpdateOne) Save(ctx context.Context) (*OwnerDeletion, error) {
+	return withHooks(ctx, oduo.sqlSave, oduo.mutation, oduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oduo *OwnerDeletionUpdateOne) SaveX(ctx context.Context) *OwnerDeletion {
+	node, err := oduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (oduo *OwnerDeletionUpdateOne) Exec(ctx context.Context) error {
+	_, err := oduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oduo *OwnerDeletionUpdateOne) ExecX(ctx context.Context) {
+	if err := oduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (oduo *OwnerDeletionUpdateOne) sqlSave(ctx context.Context) (_node *OwnerDeletion, err error) {
+	_spec := sqlgraph.NewUpdateSpec(ownerdeletion.Table, ownerdeletion.Columns, sqlgraph.NewFieldSpec(ownerdeletion.FieldID, field.TypeString))
+	id, ok := oduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "OwnerDeletion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := oduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ownerdeletion.FieldID)
+		for _, f := range fields {
+			if !ownerdeletion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != ownerdeletion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := oduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oduo.mutation.CompletedAt(); ok {
+		_spec.SetField(ownerdeletion.FieldCompletedAt, field.TypeTime, value)
+	}
+	if oduo.mutation.CompletedAtCleared() {
+		_spec.ClearField(ownerdeletion.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := oduo.mutation.SkippedIds(); ok {
+		_spec.SetField(ownerdeletion.FieldSkippedIds, field.TypeJSON, value)
+	}
+	if value, ok := oduo.mutation.AppendedSkippedIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, ownerdeletion.FieldSkippedIds, value)
+		})
+	}
+	if oduo.mutation.SkippedIdsCleared() {
+		_spec.ClearField(ownerdeletion.FieldSkippedIds, field.TypeJSON)
+	}
+	_node = &OwnerDeletion{config: oduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, oduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ownerdeletion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	oduo.mutation.done = true
+	return _node, nil
+}
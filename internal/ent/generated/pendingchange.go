@@ -0,0 +1,188 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package generated
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/pendingchange"
+	"go.infratographer.com/x/events"
+	"go.infratographer.com/x/gidx"
+)
+
+// A committed change that could not be published yet.
+type PendingChange struct {
+	config `json:"-"`
+	// ID of the ent.
+	// The ID for the pending change.
+	ID gidx.PrefixedID `json:"id,omitempty"`
+	// The subject name the change is published to.
+	Subject string `json:"subject,omitempty"`
+	// The change message to publish.
+	Message events.ChangeMessage `json:"message,omitempty"`
+	// The number of times publishing the change was attempted.
+	Attempts int `json:"attempts,omitempty"`
+	// The error of the last attempt to publish the change.
+	LastError string `json:"last_error,omitempty"`
+	// The time the change was committed.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// The time after which publishing the change is attempted again.
+	NextAttemptAt time.Time `json:"next_attempt_at,omitempty"`
+	selectValues  sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PendingChange) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pendingchange.FieldMessage:
+			values[i] = new([]byte)
+		case pendingchange.FieldID:
+			values[i] = new(gidx.PrefixedID)
+		case pendingchange.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case pendingchange.FieldSubject, pendingchange.FieldLastError:
+			values[i] = new(sql.NullString)
+		case pendingchange.FieldCreatedAt, pendingchange.FieldNextAttemptAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PendingChange fields.
+func (pc *PendingChange) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pendingchange.FieldID:
+			if value, ok := values[i].(*gidx.PrefixedID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pc.ID = *value
+			}
+		case pendingchange.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				pc.Subject = value.String
+			}
+		case pendingchange.FieldMessage:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pc.Message); err != nil {
+					return fmt.Errorf("unmarshal field message: %w", err)
+				}
+			}
+		case pendingchange.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				pc.Attempts = int(value.Int64)
+			}
+		case pendingchange.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				pc.LastError = value.String
+			}
+		case pendingchange.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pc.CreatedAt = value.Time
+			}
+		case pendingchange.FieldNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_at", values[i])
+			} else if value.Valid {
+				pc.NextAttemptAt = value.Time
+			}
+		default:
+			pc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PendingChange.
+// This includes values selected through modifiers, order, etc.
+func (pc *PendingChange) Value(name string) (ent.Value, error) {
+	return pc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PendingChange.
+// Note that you need to call PendingChange.Unwrap() before calling this method if this PendingChange
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pc *PendingChange) Update() *PendingChangeUpdateOne {
+	return NewPendingChangeClient(pc.config).UpdateOne(pc)
+}
+
+// Unwrap unwraps the PendingChange entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pc *PendingChange) Unwrap() *PendingChange {
+	_tx, ok := pc.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: PendingChange is not a transactional entity")
+	}
+	pc.config.driver = _tx.drv
+	return pc
+}
+
+// String implements the fmt.Stringer.
+func (pc *PendingChange) String() string {
+	var builder strings.Builder
+	builder.WriteString("PendingChange(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pc.ID))
+	builder.WriteString("subject=")
+	builder.WriteString(pc.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(fmt.Sprintf("%v", pc.Message))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", pc.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(pc.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("next_attempt_at=")
+	builder.WriteString(pc.NextAttemptAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// IsEntity implement fedruntime.Entity
+func (pc PendingChange) IsEntity() {}
+
+// PendingChanges is a parsable slice of PendingChange.
+type PendingChanges []*PendingChange
@@ -0,0 +1,345 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/pendingchange"
+	"go.infratographer.com/x/events"
+	"go.infratographer.com/x/gidx"
+)
+
+// PendingChangeCreate is the builder for creating a PendingChange entity.
+type PendingChangeCreate struct {
+	config
+	mutation *PendingChangeMutation
+	hooks    []Hook
+}
+
+// SetSubject sets the "subject" field.
+func (pcc *PendingChangeCreate) SetSubject(s string) *PendingChangeCreate {
+	pcc.mutation.SetSubject(s)
+	return pcc
+}
+
+// SetMessage sets the "message" field.
+func (pcc *PendingChangeCreate) SetMessage(em events.ChangeMessage) *PendingChangeCreate {
+	pcc.mutation.SetMessage(em)
+	return pcc
+}
+
+// SetAttempts sets the "attempts" field.
+func (pcc *PendingChangeCreate) SetAttempts(i int) *PendingChangeCreate {
+	pcc.mutation.SetAttempts(i)
+	return pcc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (pcc *PendingChangeCreate) SetNillableAttempts(i *int) *PendingChangeCreate {
+	if i != nil {
+		pcc.SetAttempts(*i)
+	}
+	return pcc
+}
+
+// SetLastError sets the "last_error" field.
+func (pcc *PendingChangeCreate) SetLastError(s string) *PendingChangeCreate {
+	pcc.mutation.SetLastError(s)
+	return pcc
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (pcc *PendingChangeCreate) SetNillableLastError(s *string) *PendingChangeCreate {
+	if s != nil {
+		pcc.SetLastError(*s)
+	}
+	return pcc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pcc *PendingChangeCreate) SetCreatedAt(t time.Time) *PendingChangeCreate {
+	pcc.mutation.SetCreatedAt(t)
+	return pcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pcc *PendingChangeCreate) SetNillableCreatedAt(t *time.Time) *PendingChangeCreate {
+	if t != nil {
+		pcc.SetCreatedAt(*t)
+	}
+	return pcc
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (pcc *PendingChangeCreate) SetNextAttemptAt(t time.Time) *PendingChangeCreate {
+	pcc.mutation.SetNextAttemptAt(t)
+	return pcc
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (pcc *PendingChangeCreate) SetNillableNextAttemptAt(t *time.Time) *PendingChangeCreate {
+	if t != nil {
+		pcc.SetNextAttemptAt(*t)
+	}
+	return pcc
+}
+
+// SetID sets the "id" field.
+func (pcc *PendingChangeCreate) SetID(gi gidx.PrefixedID) *PendingChangeCreate {
+	pcc.mutation.SetID(gi)
+	return pcc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (pcc *PendingChangeCreate) SetNillableID(gi *gidx.PrefixedID) *PendingChangeCreate {
+	if gi != nil {
+		pcc.SetID(*gi)
+	}
+	return pcc
+}
+
+// Mutation returns the PendingChangeMutation object of the builder.
+func (pcc *PendingChangeCreate) Mutation() *PendingChangeMutation {
+	return pcc.mutation
+}
+
+// Save creates the PendingChange in the database.
+func (pcc *PendingChangeCreate) Save(ctx context.Context) (*PendingChange, error) {
+	pcc.defaults()
+	return withHooks(ctx, pcc.sqlSave, pcc.mutation, pcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pcc *PendingChangeCreate) SaveX(ctx context.Context) *PendingChange {
+	v, err := pcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcc *PendingChangeCreate) Exec(ctx context.Context) error {
+	_, err := pcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcc *PendingChangeCreate) ExecX(ctx context.Context) {
+	if err := pcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pcc *PendingChangeCreate) defaults() {
+	if _, ok := pcc.mutation.Attempts(); !ok {
+		v := pendingchange.DefaultAttempts
+		pcc.mutation.SetAttempts(v)
+	}
+	if _, ok := pcc.mutation.CreatedAt(); !ok {
+		v := pendingchange.DefaultCreatedAt()
+		pcc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pcc.mutation.NextAttemptAt(); !ok {
+		v := pendingchange.DefaultNextAttemptAt()
+		pcc.mutation.SetNextAttemptAt(v)
+	}
+	if _, ok := pcc.mutation.ID(); !ok {
+		v := pendingchange.DefaultID()
+		pcc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pcc *PendingChangeCreate) check() error {
+	if _, ok := pcc.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`generated: missing required field "PendingChange.subject"`)}
+	}
+	if v, ok := pcc.mutation.Subject(); ok {
+		if err := pendingchange.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`generated: validator failed for field "PendingChange.subject": %w`, err)}
+		}
+	}
+	if _, ok := pcc.mutation.Message(); !ok {
+		return &ValidationError{Name: "message", err: errors.New(`generated: missing required field "PendingChange.message"`)}
+	}
+	if v, ok := pcc.mutation.Message(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`generated: validator failed for field "PendingChange.message": %w`, err)}
+		}
+	}
+	if _, ok := pcc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`generated: missing required field "PendingChange.attempts"`)}
+	}
+	if _, ok := pcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "PendingChange.created_at"`)}
+	}
+	if _, ok := pcc.mutation.NextAttemptAt(); !ok {
+		return &ValidationError{Name: "next_attempt_at", err: errors.New(`generated: missing required field "PendingChange.next_attempt_at"`)}
+	}
+	return nil
+}
+
+func (pcc *PendingChangeCreate) sqlSave(ctx context.Context) (*PendingChange, error) {
+	if err := pcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*gidx.PrefixedID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	pcc.mutation.id = &_node.ID
+	pcc.mutation.done = true
+	return _node, nil
+}
+
+func (pcc *PendingChangeCreate) createSpec() (*PendingChange, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PendingChange{config: pcc.config}
+		_spec = sqlgraph.NewCreateSpec(pendingchange.Table, sqlgraph.NewFieldSpec(pendingchange.FieldID, field.TypeString))
+	)
+	if id, ok := pcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := pcc.mutation.Subject(); ok {
+		_spec.SetField(pendingchange.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := pcc.mutation.Message(); ok {
+		_spec.SetField(pendingchange.FieldMessage, field.TypeJSON, value)
+		_node.Message = value
+	}
+	if value, ok := pcc.mutation.Attempts(); ok {
+		_spec.SetField(pendingchange.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := pcc.mutation.LastError(); ok {
+		_spec.SetField(pendingchange.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := pcc.mutation.CreatedAt(); ok {
+		_spec.SetField(pendingchange.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pcc.mutation.NextAttemptAt(); ok {
+		_spec.SetField(pendingchange.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = value
+	}
+	return _node, _spec
+}
+
+// PendingChangeCreateBulk is the builder for creating many PendingChange entities in bulk.
+type PendingChangeCreateBulk struct {
+	config
+	err      error
+	builders []*PendingChangeCreate
+}
+
+// Save creates the PendingChange entities in the database.
+func (pccb *PendingChangeCreateBulk) Save(ctx context.Context) ([]*PendingChange, error) {
+	if pccb.err != nil {
+		return nil, pccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pccb.builders))
+	nodes := make([]*PendingChange, len(pccb.builders))
+	mutators := make([]Mutator, len(pccb.builders))
+	for i := range pccb.builders {
+		func(i int, root context.Context) {
+			builder := pccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PendingChangeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pccb *PendingChangeCreateBulk) SaveX(ctx context.Context) []*PendingChange {
+	v, err := pccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pccb *PendingChangeCreateBulk) Exec(ctx context.Context) error {
+	_, err := pccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pccb *PendingChangeCreateBulk) ExecX(ctx context.Context) {
+	if err := pccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
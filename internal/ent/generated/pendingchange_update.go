@@ -0,0 +1,330 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/pendingchange"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/predicate"
+)
+
+// PendingChangeUpdate is the builder for updating PendingChange entities.
+type PendingChangeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PendingChangeMutation
+}
+
+// Where appends a list predicates to the PendingChangeUpdate builder.
+func (pcu *PendingChangeUpdate) Where(ps ...predicate.PendingChange) *PendingChangeUpdate {
+	pcu.mutation.Where(ps...)
+	return pcu
+}
+
+// SetAttempts sets the "attempts" field.
+func (pcu *PendingChangeUpdate) SetAttempts(i int) *PendingChangeUpdate {
+	pcu.mutation.ResetAttempts()
+	pcu.mutation.SetAttempts(i)
+	return pcu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (pcu *PendingChangeUpdate) SetNillableAttempts(i *int) *PendingChangeUpdate {
+	if i != nil {
+		pcu.SetAttempts(*i)
+	}
+	return pcu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (pcu *PendingChangeUpdate) AddAttempts(i int) *PendingChangeUpdate {
+	pcu.mutation.AddAttempts(i)
+	return pcu
+}
+
+// SetLastError sets the "last_error" field.
+func (pcu *PendingChangeUpdate) SetLastError(s string) *PendingChangeUpdate {
+	pcu.mutation.SetLastError(s)
+	return pcu
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (pcu *PendingChangeUpdate) SetNillableLastError(s *string) *PendingChangeUpdate {
+	if s != nil {
+		pcu.SetLastError(*s)
+	}
+	return pcu
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (pcu *PendingChangeUpdate) ClearLastError() *PendingChangeUpdate {
+	pcu.mutation.ClearLastError()
+	return pcu
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (pcu *PendingChangeUpdate) SetNextAttemptAt(t time.Time) *PendingChangeUpdate {
+	pcu.mutation.SetNextAttemptAt(t)
+	return pcu
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (pcu *PendingChangeUpdate) SetNillableNextAttemptAt(t *time.Time) *PendingChangeUpdate {
+	if t != nil {
+		pcu.SetNextAttemptAt(*t)
+	}
+	return pcu
+}
+
+// Mutation returns the PendingChangeMutation object of the builder.
+func (pcu *PendingChangeUpdate) Mutation() *PendingChangeMutation {
+	return pcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pcu *PendingChangeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pcu.sqlSave, pcu.mutation, pcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcu *PendingChangeUpdate) SaveX(ctx context.Context) int {
+	affected, err := pcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pcu *PendingChangeUpdate) Exec(ctx context.Context) error {
+	_, err := pcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcu *PendingChangeUpdate) ExecX(ctx context.Context) {
+	if err := pcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (pcu *PendingChangeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(pendingchange.Table, pendingchange.Columns, sqlgraph.NewFieldSpec(pendingchange.FieldID, field.TypeString))
+	if ps := pcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pcu.mutation.Attempts(); ok {
+		_spec.SetField(pendingchange.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := pcu.mutation.AddedAttempts(); ok {
+		_spec.AddField(pendingchange.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := pcu.mutation.LastError(); ok {
+		_spec.SetField(pendingchange.FieldLastError, field.TypeString, value)
+	}
+	if pcu.mutation.LastErrorCleared() {
+		_spec.ClearField(pendingchange.FieldLastError, field.TypeString)
+	}
+	if value, ok := pcu.mutation.NextAttemptAt(); ok {
+		_spec.SetField(pendingchange.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pendingchange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pcu.mutation.done = true
+	return n, nil
+}
+
+// PendingChangeUpdateOne is the builder for updating a single PendingChange entity.
+type PendingChangeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PendingChangeMutation
+}
+
+// SetAttempts sets the "attempts" field.
+func (pcuo *PendingChangeUpdateOne) SetAttempts(i int) *PendingChangeUpdateOne {
+	pcuo.mutation.ResetAttempts()
+	pcuo.mutation.SetAttempts(i)
+	return pcuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (pcuo *PendingChangeUpdateOne) SetNillableAttempts(i *int) *PendingChangeUpdateOne {
+	if i != nil {
+		pcuo.SetAttempts(*i)
+	}
+	return pcuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (pcuo *PendingChangeUpdateOne) AddAttempts(i int) *PendingChangeUpdateOne {
+	pcuo.mutation.AddAttempts(i)
+	return pcuo
+}
+
+// SetLastError sets the "last_error" field.
+func (pcuo *PendingChangeUpdateOne) SetLastError(s string) *PendingChangeUpdateOne {
+	pcuo.mutation.SetLastError(s)
+	return pcuo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (pcuo *PendingChangeUpdateOne) SetNillableLastError(s *string) *PendingChangeUpdateOne {
+	if s != nil {
+		pcuo.SetLastError(*s)
+	}
+	return pcuo
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (pcuo *PendingChangeUpdateOne) ClearLastError() *PendingChangeUpdateOne {
+	pcuo.mutation.ClearLastError()
+	return pcuo
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (pcuo *PendingChangeUpdateOne) SetNextAttemptAt(t time.Time) *PendingChangeUpdateOne {
+	pcuo.mutation.SetNextAttemptAt(t)
+	return pcuo
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (pcuo *PendingChangeUpdateOne) SetNillableNextAttemptAt(t *time.Time) *PendingChangeUpdateOne {
+	if t != nil {
+		pcuo.SetNextAttemptAt(*t)
+	}
+	return pcuo
+}
+
+// Mutation returns the PendingChangeMutation object of the builder.
+func (pcuo *PendingChangeUpdateOne) Mutation() *PendingChangeMutation {
+	return pcuo.mutation
+}
+
+// Where appends a list predicates to the PendingChangeUpdate builder.
+func (pcuo *PendingChangeUpdateOne) Where(ps ...predicate.PendingChange) *PendingChangeUpdateOne {
+	pcuo.mutation.Where(ps...)
+	return pcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pcuo *PendingChangeUpdateOne) Select(field string, fields ...string) *PendingChangeUpdateOne {
+	pcuo.fields = append([]string{field}, fields...)
+	return pcuo
+}
+
+// Save executes the query and returns the updated PendingChange entity.
+func (pcuo *PendingChangeUpdateOne) Save(ctx context.Context) (*PendingChange, error) {
+	return withHooks(ctx, pcuo.sqlSave, pcuo.mutation, pcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcuo *PendingChangeUpdateOne) SaveX(ctx context.Context) *PendingChange {
+	node, err := pcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pcuo *PendingChangeUpdateOne) Exec(ctx context.Context) error {
+	_, err := pcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcuo *PendingChangeUpdateOne) ExecX(ctx context.Context) {
+	if err := pcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (pcuo *PendingChangeUpdateOne) sqlSave(ctx context.Context) (_node *PendingChange, err error) {
+	_spec := sqlgraph.NewUpdateSpec(pendingchange.Table, pendingchange.Columns, sqlgraph.NewFieldSpec(pendingchange.FieldID, field.TypeString))
+	id, ok := pcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "PendingChange.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pendingchange.FieldID)
+		for _, f := range fields {
+			if !pendingchange.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != pendingchange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pcuo.mutation.Attempts(); ok {
+		_spec.SetField(pendingchange.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := pcuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(pendingchange.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := pcuo.mutation.LastError(); ok {
+		_spec.SetField(pendingchange.FieldLastError, field.TypeString, value)
+	}
+	if pcuo.mutation.LastErrorCleared() {
+		_spec.ClearField(pendingchange.FieldLastError, field.TypeString)
+	}
+	if value, ok := pcuo.mutation.NextAttemptAt(); ok {
+		_spec.SetField(pendingchange.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	_node = &PendingChange{config: pcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pendingchange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pcuo.mutation.done = true
+	return _node, nil
+}
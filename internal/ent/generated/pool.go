@@ -40,6 +40,8 @@ type Pool struct {
 	CreatedBy string `json:"created_by,omitempty"`
 	// UpdatedBy holds the value of the "updated_by" field.
 	UpdatedBy string `json:"updated_by,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
 	// DeletedAt holds the value of the "deleted_at" field.
 	DeletedAt time.Time `json:"deleted_at,omitempty"`
 	// DeletedBy holds the value of the "deleted_by" field.
@@ -97,6 +99,8 @@ func (*Pool) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case pool.FieldID, pool.FieldOwnerID:
 			values[i] = new(gidx.PrefixedID)
+		case pool.FieldVersion:
+			values[i] = new(sql.NullInt64)
 		case pool.FieldCreatedBy, pool.FieldUpdatedBy, pool.FieldDeletedBy, pool.FieldName, pool.FieldProtocol:
 			values[i] = new(sql.NullString)
 		case pool.FieldCreatedAt, pool.FieldUpdatedAt, pool.FieldDeletedAt:
@@ -146,6 +150,12 @@ func (po *Pool) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				po.UpdatedBy = value.String
 			}
+		case pool.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				po.Version = int(value.Int64)
+			}
 		case pool.FieldDeletedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
@@ -234,6 +244,9 @@ func (po *Pool) String() string {
 	builder.WriteString("updated_by=")
 	builder.WriteString(po.UpdatedBy)
 	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", po.Version))
+	builder.WriteString(", ")
 	builder.WriteString("deleted_at=")
 	builder.WriteString(po.DeletedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
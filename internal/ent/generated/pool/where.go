@@ -90,6 +90,11 @@ func UpdatedBy(v string) predicate.Pool {
 	return predicate.Pool(sql.FieldEQ(FieldUpdatedBy, v))
 }
 
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Pool {
+	return predicate.Pool(sql.FieldEQ(FieldVersion, v))
+}
+
 // DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
 func DeletedAt(v time.Time) predicate.Pool {
 	return predicate.Pool(sql.FieldEQ(FieldDeletedAt, v))
@@ -340,6 +345,46 @@ func UpdatedByContainsFold(v string) predicate.Pool {
 	return predicate.Pool(sql.FieldContainsFold(FieldUpdatedBy, v))
 }
 
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Pool {
+	return predicate.Pool(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Pool {
+	return predicate.Pool(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Pool {
+	return predicate.Pool(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Pool {
+	return predicate.Pool(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Pool {
+	return predicate.Pool(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Pool {
+	return predicate.Pool(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Pool {
+	return predicate.Pool(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Pool {
+	return predicate.Pool(sql.FieldLTE(FieldVersion, v))
+}
+
 // DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
 func DeletedAtEQ(v time.Time) predicate.Pool {
 	return predicate.Pool(sql.FieldEQ(FieldDeletedAt, v))
@@ -93,6 +93,20 @@ func (pc *PoolCreate) SetNillableUpdatedBy(s *string) *PoolCreate {
 	return pc
 }
 
+// SetVersion sets the "version" field.
+func (pc *PoolCreate) SetVersion(i int) *PoolCreate {
+	pc.mutation.SetVersion(i)
+	return pc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (pc *PoolCreate) SetNillableVersion(i *int) *PoolCreate {
+	if i != nil {
+		pc.SetVersion(*i)
+	}
+	return pc
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (pc *PoolCreate) SetDeletedAt(t time.Time) *PoolCreate {
 	pc.mutation.SetDeletedAt(t)
@@ -234,6 +248,10 @@ func (pc *PoolCreate) defaults() error {
 		v := pool.DefaultUpdatedAt()
 		pc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := pc.mutation.Version(); !ok {
+		v := pool.DefaultVersion
+		pc.mutation.SetVersion(v)
+	}
 	if _, ok := pc.mutation.ID(); !ok {
 		if pool.DefaultID == nil {
 			return fmt.Errorf("generated: uninitialized pool.DefaultID (forgotten import generated/runtime?)")
@@ -252,6 +270,9 @@ func (pc *PoolCreate) check() error {
 	if _, ok := pc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "Pool.updated_at"`)}
 	}
+	if _, ok := pc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`generated: missing required field "Pool.version"`)}
+	}
 	if _, ok := pc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`generated: missing required field "Pool.name"`)}
 	}
@@ -327,6 +348,10 @@ func (pc *PoolCreate) createSpec() (*Pool, *sqlgraph.CreateSpec) {
 		_spec.SetField(pool.FieldUpdatedBy, field.TypeString, value)
 		_node.UpdatedBy = value
 	}
+	if value, ok := pc.mutation.Version(); ok {
+		_spec.SetField(pool.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
 	if value, ok := pc.mutation.DeletedAt(); ok {
 		_spec.SetField(pool.FieldDeletedAt, field.TypeTime, value)
 		_node.DeletedAt = value
@@ -65,6 +65,27 @@ func (pu *PoolUpdate) ClearUpdatedBy() *PoolUpdate {
 	return pu
 }
 
+// SetVersion sets the "version" field.
+func (pu *PoolUpdate) SetVersion(i int) *PoolUpdate {
+	pu.mutation.ResetVersion()
+	pu.mutation.SetVersion(i)
+	return pu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (pu *PoolUpdate) SetNillableVersion(i *int) *PoolUpdate {
+	if i != nil {
+		pu.SetVersion(*i)
+	}
+	return pu
+}
+
+// AddVersion adds i to the "version" field.
+func (pu *PoolUpdate) AddVersion(i int) *PoolUpdate {
+	pu.mutation.AddVersion(i)
+	return pu
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (pu *PoolUpdate) SetDeletedAt(t time.Time) *PoolUpdate {
 	pu.mutation.SetDeletedAt(t)
@@ -275,6 +296,12 @@ func (pu *PoolUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if pu.mutation.UpdatedByCleared() {
 		_spec.ClearField(pool.FieldUpdatedBy, field.TypeString)
 	}
+	if value, ok := pu.mutation.Version(); ok {
+		_spec.SetField(pool.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedVersion(); ok {
+		_spec.AddField(pool.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := pu.mutation.DeletedAt(); ok {
 		_spec.SetField(pool.FieldDeletedAt, field.TypeTime, value)
 	}
@@ -423,6 +450,27 @@ func (puo *PoolUpdateOne) ClearUpdatedBy() *PoolUpdateOne {
 	return puo
 }
 
+// SetVersion sets the "version" field.
+func (puo *PoolUpdateOne) SetVersion(i int) *PoolUpdateOne {
+	puo.mutation.ResetVersion()
+	puo.mutation.SetVersion(i)
+	return puo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (puo *PoolUpdateOne) SetNillableVersion(i *int) *PoolUpdateOne {
+	if i != nil {
+		puo.SetVersion(*i)
+	}
+	return puo
+}
+
+// AddVersion adds i to the "version" field.
+func (puo *PoolUpdateOne) AddVersion(i int) *PoolUpdateOne {
+	puo.mutation.AddVersion(i)
+	return puo
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (puo *PoolUpdateOne) SetDeletedAt(t time.Time) *PoolUpdateOne {
 	puo.mutation.SetDeletedAt(t)
@@ -663,6 +711,12 @@ func (puo *PoolUpdateOne) sqlSave(ctx context.Context) (_node *Pool, err error)
 	if puo.mutation.UpdatedByCleared() {
 		_spec.ClearField(pool.FieldUpdatedBy, field.TypeString)
 	}
+	if value, ok := puo.mutation.Version(); ok {
+		_spec.SetField(pool.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedVersion(); ok {
+		_spec.AddField(pool.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := puo.mutation.DeletedAt(); ok {
 		_spec.SetField(pool.FieldDeletedAt, field.TypeTime, value)
 	}
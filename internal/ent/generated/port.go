@@ -45,6 +45,8 @@ type Port struct {
 	CreatedBy string `json:"created_by,omitempty"`
 	// UpdatedBy holds the value of the "updated_by" field.
 	UpdatedBy string `json:"updated_by,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
 	// Number holds the value of the "number" field.
 	Number int `json:"number,omitempty"`
 	// Name holds the value of the "name" field.
@@ -101,7 +103,7 @@ func (*Port) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case port.FieldID, port.FieldLoadBalancerID:
 			values[i] = new(gidx.PrefixedID)
-		case port.FieldNumber:
+		case port.FieldVersion, port.FieldNumber:
 			values[i] = new(sql.NullInt64)
 		case port.FieldDeletedBy, port.FieldCreatedBy, port.FieldUpdatedBy, port.FieldName:
 			values[i] = new(sql.NullString)
@@ -164,6 +166,12 @@ func (po *Port) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				po.UpdatedBy = value.String
 			}
+		case port.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				po.Version = int(value.Int64)
+			}
 		case port.FieldNumber:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field number", values[i])
@@ -246,6 +254,9 @@ func (po *Port) String() string {
 	builder.WriteString("updated_by=")
 	builder.WriteString(po.UpdatedBy)
 	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", po.Version))
+	builder.WriteString(", ")
 	builder.WriteString("number=")
 	builder.WriteString(fmt.Sprintf("%v", po.Number))
 	builder.WriteString(", ")
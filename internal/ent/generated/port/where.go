@@ -100,6 +100,11 @@ func UpdatedBy(v string) predicate.Port {
 	return predicate.Port(sql.FieldEQ(FieldUpdatedBy, v))
 }
 
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Port {
+	return predicate.Port(sql.FieldEQ(FieldVersion, v))
+}
+
 // Number applies equality check predicate on the "number" field. It's identical to NumberEQ.
 func Number(v int) predicate.Port {
 	return predicate.Port(sql.FieldEQ(FieldNumber, v))
@@ -470,6 +475,46 @@ func UpdatedByContainsFold(v string) predicate.Port {
 	return predicate.Port(sql.FieldContainsFold(FieldUpdatedBy, v))
 }
 
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Port {
+	return predicate.Port(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Port {
+	return predicate.Port(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Port {
+	return predicate.Port(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Port {
+	return predicate.Port(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Port {
+	return predicate.Port(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Port {
+	return predicate.Port(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Port {
+	return predicate.Port(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Port {
+	return predicate.Port(sql.FieldLTE(FieldVersion, v))
+}
+
 // NumberEQ applies the EQ predicate on the "number" field.
 func NumberEQ(v int) predicate.Port {
 	return predicate.Port(sql.FieldEQ(FieldNumber, v))
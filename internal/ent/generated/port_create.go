@@ -121,6 +121,20 @@ func (pc *PortCreate) SetNillableUpdatedBy(s *string) *PortCreate {
 	return pc
 }
 
+// SetVersion sets the "version" field.
+func (pc *PortCreate) SetVersion(i int) *PortCreate {
+	pc.mutation.SetVersion(i)
+	return pc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (pc *PortCreate) SetNillableVersion(i *int) *PortCreate {
+	if i != nil {
+		pc.SetVersion(*i)
+	}
+	return pc
+}
+
 // SetNumber sets the "number" field.
 func (pc *PortCreate) SetNumber(i int) *PortCreate {
 	pc.mutation.SetNumber(i)
@@ -232,6 +246,10 @@ func (pc *PortCreate) defaults() error {
 		v := port.DefaultUpdatedAt()
 		pc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := pc.mutation.Version(); !ok {
+		v := port.DefaultVersion
+		pc.mutation.SetVersion(v)
+	}
 	if _, ok := pc.mutation.ID(); !ok {
 		if port.DefaultID == nil {
 			return fmt.Errorf("generated: uninitialized port.DefaultID (forgotten import generated/runtime?)")
@@ -250,6 +268,9 @@ func (pc *PortCreate) check() error {
 	if _, ok := pc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "Port.updated_at"`)}
 	}
+	if _, ok := pc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`generated: missing required field "Port.version"`)}
+	}
 	if _, ok := pc.mutation.Number(); !ok {
 		return &ValidationError{Name: "number", err: errors.New(`generated: missing required field "Port.number"`)}
 	}
@@ -333,6 +354,10 @@ func (pc *PortCreate) createSpec() (*Port, *sqlgraph.CreateSpec) {
 		_spec.SetField(port.FieldUpdatedBy, field.TypeString, value)
 		_node.UpdatedBy = value
 	}
+	if value, ok := pc.mutation.Version(); ok {
+		_spec.SetField(port.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
 	if value, ok := pc.mutation.Number(); ok {
 		_spec.SetField(port.FieldNumber, field.TypeInt, value)
 		_node.Number = value
@@ -104,6 +104,27 @@ func (pu *PortUpdate) ClearUpdatedBy() *PortUpdate {
 	return pu
 }
 
+// SetVersion sets the "version" field.
+func (pu *PortUpdate) SetVersion(i int) *PortUpdate {
+	pu.mutation.ResetVersion()
+	pu.mutation.SetVersion(i)
+	return pu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (pu *PortUpdate) SetNillableVersion(i *int) *PortUpdate {
+	if i != nil {
+		pu.SetVersion(*i)
+	}
+	return pu
+}
+
+// AddVersion adds i to the "version" field.
+func (pu *PortUpdate) AddVersion(i int) *PortUpdate {
+	pu.mutation.AddVersion(i)
+	return pu
+}
+
 // SetNumber sets the "number" field.
 func (pu *PortUpdate) SetNumber(i int) *PortUpdate {
 	pu.mutation.ResetNumber()
@@ -274,6 +295,12 @@ func (pu *PortUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if pu.mutation.UpdatedByCleared() {
 		_spec.ClearField(port.FieldUpdatedBy, field.TypeString)
 	}
+	if value, ok := pu.mutation.Version(); ok {
+		_spec.SetField(port.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedVersion(); ok {
+		_spec.AddField(port.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := pu.mutation.Number(); ok {
 		_spec.SetField(port.FieldNumber, field.TypeInt, value)
 	}
@@ -411,6 +438,27 @@ func (puo *PortUpdateOne) ClearUpdatedBy() *PortUpdateOne {
 	return puo
 }
 
+// SetVersion sets the "version" field.
+func (puo *PortUpdateOne) SetVersion(i int) *PortUpdateOne {
+	puo.mutation.ResetVersion()
+	puo.mutation.SetVersion(i)
+	return puo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (puo *PortUpdateOne) SetNillableVersion(i *int) *PortUpdateOne {
+	if i != nil {
+		puo.SetVersion(*i)
+	}
+	return puo
+}
+
+// AddVersion adds i to the "version" field.
+func (puo *PortUpdateOne) AddVersion(i int) *PortUpdateOne {
+	puo.mutation.AddVersion(i)
+	return puo
+}
+
 // SetNumber sets the "number" field.
 func (puo *PortUpdateOne) SetNumber(i int) *PortUpdateOne {
 	puo.mutation.ResetNumber()
@@ -611,6 +659,12 @@ func (puo *PortUpdateOne) sqlSave(ctx context.Context) (_node *Port, err error)
 	if puo.mutation.UpdatedByCleared() {
 		_spec.ClearField(port.FieldUpdatedBy, field.TypeString)
 	}
+	if value, ok := puo.mutation.Version(); ok {
+		_spec.SetField(port.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedVersion(); ok {
+		_spec.AddField(port.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := puo.mutation.Number(); ok {
 		_spec.SetField(port.FieldNumber, field.TypeInt, value)
 	}
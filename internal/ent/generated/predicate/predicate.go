@@ -20,12 +20,24 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AuditEvent is the predicate function for auditevent builders.
+type AuditEvent func(*sql.Selector)
+
+// IdempotencyKey is the predicate function for idempotencykey builders.
+type IdempotencyKey func(*sql.Selector)
+
 // LoadBalancer is the predicate function for loadbalancer builders.
 type LoadBalancer func(*sql.Selector)
 
 // Origin is the predicate function for origin builders.
 type Origin func(*sql.Selector)
 
+// OwnerDeletion is the predicate function for ownerdeletion builders.
+type OwnerDeletion func(*sql.Selector)
+
+// PendingChange is the predicate function for pendingchange builders.
+type PendingChange func(*sql.Selector)
+
 // Pool is the predicate function for pool builders.
 type Pool func(*sql.Selector)
 
@@ -45,6 +45,8 @@ type Provider struct {
 	CreatedBy string `json:"created_by,omitempty"`
 	// UpdatedBy holds the value of the "updated_by" field.
 	UpdatedBy string `json:"updated_by,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
 	// The name of the load balancer provider.
 	Name string `json:"name,omitempty"`
 	// The ID for the owner for this load balancer.
@@ -84,6 +86,8 @@ func (*Provider) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case provider.FieldID, provider.FieldOwnerID:
 			values[i] = new(gidx.PrefixedID)
+		case provider.FieldVersion:
+			values[i] = new(sql.NullInt64)
 		case provider.FieldDeletedBy, provider.FieldCreatedBy, provider.FieldUpdatedBy, provider.FieldName:
 			values[i] = new(sql.NullString)
 		case provider.FieldCreatedAt, provider.FieldUpdatedAt, provider.FieldDeletedAt:
@@ -145,6 +149,12 @@ func (pr *Provider) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pr.UpdatedBy = value.String
 			}
+		case provider.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				pr.Version = int(value.Int64)
+			}
 		case provider.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -216,6 +226,9 @@ func (pr *Provider) String() string {
 	builder.WriteString("updated_by=")
 	builder.WriteString(pr.UpdatedBy)
 	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Version))
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(pr.Name)
 	builder.WriteString(", ")
@@ -120,6 +120,20 @@ func (pc *ProviderCreate) SetNillableUpdatedBy(s *string) *ProviderCreate {
 	return pc
 }
 
+// SetVersion sets the "version" field.
+func (pc *ProviderCreate) SetVersion(i int) *ProviderCreate {
+	pc.mutation.SetVersion(i)
+	return pc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (pc *ProviderCreate) SetNillableVersion(i *int) *ProviderCreate {
+	if i != nil {
+		pc.SetVersion(*i)
+	}
+	return pc
+}
+
 // SetName sets the "name" field.
 func (pc *ProviderCreate) SetName(s string) *ProviderCreate {
 	pc.mutation.SetName(s)
@@ -212,6 +226,10 @@ func (pc *ProviderCreate) defaults() error {
 		v := provider.DefaultUpdatedAt()
 		pc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := pc.mutation.Version(); !ok {
+		v := provider.DefaultVersion
+		pc.mutation.SetVersion(v)
+	}
 	if _, ok := pc.mutation.ID(); !ok {
 		if provider.DefaultID == nil {
 			return fmt.Errorf("generated: uninitialized provider.DefaultID (forgotten import generated/runtime?)")
@@ -230,6 +248,9 @@ func (pc *ProviderCreate) check() error {
 	if _, ok := pc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "Provider.updated_at"`)}
 	}
+	if _, ok := pc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`generated: missing required field "Provider.version"`)}
+	}
 	if _, ok := pc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`generated: missing required field "Provider.name"`)}
 	}
@@ -305,6 +326,10 @@ func (pc *ProviderCreate) createSpec() (*Provider, *sqlgraph.CreateSpec) {
 		_spec.SetField(provider.FieldUpdatedBy, field.TypeString, value)
 		_node.UpdatedBy = value
 	}
+	if value, ok := pc.mutation.Version(); ok {
+		_spec.SetField(provider.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
 	if value, ok := pc.mutation.Name(); ok {
 		_spec.SetField(provider.FieldName, field.TypeString, value)
 		_node.Name = value
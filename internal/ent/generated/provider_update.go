@@ -104,6 +104,27 @@ func (pu *ProviderUpdate) ClearUpdatedBy() *ProviderUpdate {
 	return pu
 }
 
+// SetVersion sets the "version" field.
+func (pu *ProviderUpdate) SetVersion(i int) *ProviderUpdate {
+	pu.mutation.ResetVersion()
+	pu.mutation.SetVersion(i)
+	return pu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (pu *ProviderUpdate) SetNillableVersion(i *int) *ProviderUpdate {
+	if i != nil {
+		pu.SetVersion(*i)
+	}
+	return pu
+}
+
+// AddVersion adds i to the "version" field.
+func (pu *ProviderUpdate) AddVersion(i int) *ProviderUpdate {
+	pu.mutation.AddVersion(i)
+	return pu
+}
+
 // SetName sets the "name" field.
 func (pu *ProviderUpdate) SetName(s string) *ProviderUpdate {
 	pu.mutation.SetName(s)
@@ -239,6 +260,12 @@ func (pu *ProviderUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if pu.mutation.UpdatedByCleared() {
 		_spec.ClearField(provider.FieldUpdatedBy, field.TypeString)
 	}
+	if value, ok := pu.mutation.Version(); ok {
+		_spec.SetField(provider.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedVersion(); ok {
+		_spec.AddField(provider.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := pu.mutation.Name(); ok {
 		_spec.SetField(provider.FieldName, field.TypeString, value)
 	}
@@ -367,6 +394,27 @@ func (puo *ProviderUpdateOne) ClearUpdatedBy() *ProviderUpdateOne {
 	return puo
 }
 
+// SetVersion sets the "version" field.
+func (puo *ProviderUpdateOne) SetVersion(i int) *ProviderUpdateOne {
+	puo.mutation.ResetVersion()
+	puo.mutation.SetVersion(i)
+	return puo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (puo *ProviderUpdateOne) SetNillableVersion(i *int) *ProviderUpdateOne {
+	if i != nil {
+		puo.SetVersion(*i)
+	}
+	return puo
+}
+
+// AddVersion adds i to the "version" field.
+func (puo *ProviderUpdateOne) AddVersion(i int) *ProviderUpdateOne {
+	puo.mutation.AddVersion(i)
+	return puo
+}
+
 // SetName sets the "name" field.
 func (puo *ProviderUpdateOne) SetName(s string) *ProviderUpdateOne {
 	puo.mutation.SetName(s)
@@ -532,6 +580,12 @@ func (puo *ProviderUpdateOne) sqlSave(ctx context.Context) (_node *Provider, err
 	if puo.mutation.UpdatedByCleared() {
 		_spec.ClearField(provider.FieldUpdatedBy, field.TypeString)
 	}
+	if value, ok := puo.mutation.Version(); ok {
+		_spec.SetField(provider.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedVersion(); ok {
+		_spec.AddField(provider.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := puo.mutation.Name(); ok {
 		_spec.SetField(provider.FieldName, field.TypeString, value)
 	}
@@ -19,8 +19,12 @@ package runtime
 import (
 	"time"
 
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/auditevent"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/idempotencykey"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/loadbalancer"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/origin"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/ownerdeletion"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/pendingchange"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/pool"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/port"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/provider"
@@ -32,15 +36,73 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	auditeventFields := schema.AuditEvent{}.Fields()
+	_ = auditeventFields
+	// auditeventDescCreatedAt is the schema descriptor for created_at field.
+	auditeventDescCreatedAt := auditeventFields[1].Descriptor()
+	// auditevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditevent.DefaultCreatedAt = auditeventDescCreatedAt.Default.(func() time.Time)
+	// auditeventDescOperation is the schema descriptor for operation field.
+	auditeventDescOperation := auditeventFields[3].Descriptor()
+	// auditevent.OperationValidator is a validator for the "operation" field. It is called by the builders before save.
+	auditevent.OperationValidator = auditeventDescOperation.Validators[0].(func(string) error)
+	// auditeventDescSubjectID is the schema descriptor for subject_id field.
+	auditeventDescSubjectID := auditeventFields[4].Descriptor()
+	// auditevent.SubjectIDValidator is a validator for the "subject_id" field. It is called by the builders before save.
+	auditevent.SubjectIDValidator = auditeventDescSubjectID.Validators[0].(func(string) error)
+	// auditeventDescID is the schema descriptor for id field.
+	auditeventDescID := auditeventFields[0].Descriptor()
+	// auditevent.DefaultID holds the default value on creation for the id field.
+	auditevent.DefaultID = auditeventDescID.Default.(func() gidx.PrefixedID)
+	idempotencykeyFields := schema.IdempotencyKey{}.Fields()
+	_ = idempotencykeyFields
+	// idempotencykeyDescKey is the schema descriptor for key field.
+	idempotencykeyDescKey := idempotencykeyFields[1].Descriptor()
+	// idempotencykey.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	idempotencykey.KeyValidator = func() func(string) error {
+		validators := idempotencykeyDescKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(key string) error {
+			for _, fn := range fns {
+				if err := fn(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// idempotencykeyDescOwnerID is the schema descriptor for owner_id field.
+	idempotencykeyDescOwnerID := idempotencykeyFields[2].Descriptor()
+	// idempotencykey.OwnerIDValidator is a validator for the "owner_id" field. It is called by the builders before save.
+	idempotencykey.OwnerIDValidator = idempotencykeyDescOwnerID.Validators[0].(func(string) error)
+	// idempotencykeyDescRequestHash is the schema descriptor for request_hash field.
+	idempotencykeyDescRequestHash := idempotencykeyFields[3].Descriptor()
+	// idempotencykey.RequestHashValidator is a validator for the "request_hash" field. It is called by the builders before save.
+	idempotencykey.RequestHashValidator = idempotencykeyDescRequestHash.Validators[0].(func(string) error)
+	// idempotencykeyDescCreatedAt is the schema descriptor for created_at field.
+	idempotencykeyDescCreatedAt := idempotencykeyFields[5].Descriptor()
+	// idempotencykey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	idempotencykey.DefaultCreatedAt = idempotencykeyDescCreatedAt.Default.(func() time.Time)
+	// idempotencykeyDescID is the schema descriptor for id field.
+	idempotencykeyDescID := idempotencykeyFields[0].Descriptor()
+	// idempotencykey.DefaultID holds the default value on creation for the id field.
+	idempotencykey.DefaultID = idempotencykeyDescID.Default.(func() gidx.PrefixedID)
 	loadbalancerMixin := schema.LoadBalancer{}.Mixin()
 	loadbalancerMixinHooks1 := loadbalancerMixin[1].Hooks()
 	loadbalancerMixinHooks2 := loadbalancerMixin[2].Hooks()
+	loadbalancerMixinHooks3 := loadbalancerMixin[3].Hooks()
 	loadbalancer.Hooks[0] = loadbalancerMixinHooks1[0]
 	loadbalancer.Hooks[1] = loadbalancerMixinHooks2[0]
-	loadbalancerMixinInters2 := loadbalancerMixin[2].Interceptors()
-	loadbalancer.Interceptors[0] = loadbalancerMixinInters2[0]
+	loadbalancer.Hooks[2] = loadbalancerMixinHooks3[0]
+	loadbalancerMixinInters3 := loadbalancerMixin[3].Interceptors()
+	loadbalancer.Interceptors[0] = loadbalancerMixinInters3[0]
 	loadbalancerMixinFields0 := loadbalancerMixin[0].Fields()
 	_ = loadbalancerMixinFields0
+	loadbalancerMixinFields2 := loadbalancerMixin[2].Fields()
+	_ = loadbalancerMixinFields2
 	loadbalancerFields := schema.LoadBalancer{}.Fields()
 	_ = loadbalancerFields
 	// loadbalancerDescCreatedAt is the schema descriptor for created_at field.
@@ -53,6 +115,10 @@ func init() {
 	loadbalancer.DefaultUpdatedAt = loadbalancerDescUpdatedAt.Default.(func() time.Time)
 	// loadbalancer.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	loadbalancer.UpdateDefaultUpdatedAt = loadbalancerDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// loadbalancerDescVersion is the schema descriptor for version field.
+	loadbalancerDescVersion := loadbalancerMixinFields2[0].Descriptor()
+	// loadbalancer.DefaultVersion holds the default value on creation for the version field.
+	loadbalancer.DefaultVersion = loadbalancerDescVersion.Default.(int)
 	// loadbalancerDescName is the schema descriptor for name field.
 	loadbalancerDescName := loadbalancerFields[1].Descriptor()
 	// loadbalancer.NameValidator is a validator for the "name" field. It is called by the builders before save.
@@ -76,12 +142,16 @@ func init() {
 	originMixin := schema.Origin{}.Mixin()
 	originMixinHooks1 := originMixin[1].Hooks()
 	originMixinHooks2 := originMixin[2].Hooks()
+	originMixinHooks3 := originMixin[3].Hooks()
 	origin.Hooks[0] = originMixinHooks1[0]
 	origin.Hooks[1] = originMixinHooks2[0]
+	origin.Hooks[2] = originMixinHooks3[0]
 	originMixinInters1 := originMixin[1].Interceptors()
 	origin.Interceptors[0] = originMixinInters1[0]
 	originMixinFields0 := originMixin[0].Fields()
 	_ = originMixinFields0
+	originMixinFields3 := originMixin[3].Fields()
+	_ = originMixinFields3
 	originFields := schema.Origin{}.Fields()
 	_ = originFields
 	// originDescCreatedAt is the schema descriptor for created_at field.
@@ -94,6 +164,10 @@ func init() {
 	origin.DefaultUpdatedAt = originDescUpdatedAt.Default.(func() time.Time)
 	// origin.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	origin.UpdateDefaultUpdatedAt = originDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// originDescVersion is the schema descriptor for version field.
+	originDescVersion := originMixinFields3[0].Descriptor()
+	// origin.DefaultVersion holds the default value on creation for the version field.
+	origin.DefaultVersion = originDescVersion.Default.(int)
 	// originDescName is the schema descriptor for name field.
 	originDescName := originFields[1].Descriptor()
 	// origin.NameValidator is a validator for the "name" field. It is called by the builders before save.
@@ -150,15 +224,55 @@ func init() {
 	originDescID := originFields[0].Descriptor()
 	// origin.DefaultID holds the default value on creation for the id field.
 	origin.DefaultID = originDescID.Default.(func() gidx.PrefixedID)
+	ownerdeletionFields := schema.OwnerDeletion{}.Fields()
+	_ = ownerdeletionFields
+	// ownerdeletionDescOwnerID is the schema descriptor for owner_id field.
+	ownerdeletionDescOwnerID := ownerdeletionFields[1].Descriptor()
+	// ownerdeletion.OwnerIDValidator is a validator for the "owner_id" field. It is called by the builders before save.
+	ownerdeletion.OwnerIDValidator = ownerdeletionDescOwnerID.Validators[0].(func(string) error)
+	// ownerdeletionDescStartedAt is the schema descriptor for started_at field.
+	ownerdeletionDescStartedAt := ownerdeletionFields[2].Descriptor()
+	// ownerdeletion.DefaultStartedAt holds the default value on creation for the started_at field.
+	ownerdeletion.DefaultStartedAt = ownerdeletionDescStartedAt.Default.(func() time.Time)
+	// ownerdeletionDescID is the schema descriptor for id field.
+	ownerdeletionDescID := ownerdeletionFields[0].Descriptor()
+	// ownerdeletion.DefaultID holds the default value on creation for the id field.
+	ownerdeletion.DefaultID = ownerdeletionDescID.Default.(func() gidx.PrefixedID)
+	pendingchangeFields := schema.PendingChange{}.Fields()
+	_ = pendingchangeFields
+	// pendingchangeDescSubject is the schema descriptor for subject field.
+	pendingchangeDescSubject := pendingchangeFields[1].Descriptor()
+	// pendingchange.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	pendingchange.SubjectValidator = pendingchangeDescSubject.Validators[0].(func(string) error)
+	// pendingchangeDescAttempts is the schema descriptor for attempts field.
+	pendingchangeDescAttempts := pendingchangeFields[3].Descriptor()
+	// pendingchange.DefaultAttempts holds the default value on creation for the attempts field.
+	pendingchange.DefaultAttempts = pendingchangeDescAttempts.Default.(int)
+	// pendingchangeDescCreatedAt is the schema descriptor for created_at field.
+	pendingchangeDescCreatedAt := pendingchangeFields[5].Descriptor()
+	// pendingchange.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pendingchange.DefaultCreatedAt = pendingchangeDescCreatedAt.Default.(func() time.Time)
+	// pendingchangeDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	pendingchangeDescNextAttemptAt := pendingchangeFields[6].Descriptor()
+	// pendingchange.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	pendingchange.DefaultNextAttemptAt = pendingchangeDescNextAttemptAt.Default.(func() time.Time)
+	// pendingchangeDescID is the schema descriptor for id field.
+	pendingchangeDescID := pendingchangeFields[0].Descriptor()
+	// pendingchange.DefaultID holds the default value on creation for the id field.
+	pendingchange.DefaultID = pendingchangeDescID.Default.(func() gidx.PrefixedID)
 	poolMixin := schema.Pool{}.Mixin()
 	poolMixinHooks1 := poolMixin[1].Hooks()
 	poolMixinHooks2 := poolMixin[2].Hooks()
+	poolMixinHooks3 := poolMixin[3].Hooks()
 	pool.Hooks[0] = poolMixinHooks1[0]
 	pool.Hooks[1] = poolMixinHooks2[0]
-	poolMixinInters2 := poolMixin[2].Interceptors()
-	pool.Interceptors[0] = poolMixinInters2[0]
+	pool.Hooks[2] = poolMixinHooks3[0]
+	poolMixinInters3 := poolMixin[3].Interceptors()
+	pool.Interceptors[0] = poolMixinInters3[0]
 	poolMixinFields0 := poolMixin[0].Fields()
 	_ = poolMixinFields0
+	poolMixinFields2 := poolMixin[2].Fields()
+	_ = poolMixinFields2
 	poolFields := schema.Pool{}.Fields()
 	_ = poolFields
 	// poolDescCreatedAt is the schema descriptor for created_at field.
@@ -171,6 +285,10 @@ func init() {
 	pool.DefaultUpdatedAt = poolDescUpdatedAt.Default.(func() time.Time)
 	// pool.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	pool.UpdateDefaultUpdatedAt = poolDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// poolDescVersion is the schema descriptor for version field.
+	poolDescVersion := poolMixinFields2[0].Descriptor()
+	// pool.DefaultVersion holds the default value on creation for the version field.
+	pool.DefaultVersion = poolDescVersion.Default.(int)
 	// poolDescName is the schema descriptor for name field.
 	poolDescName := poolFields[1].Descriptor()
 	// pool.NameValidator is a validator for the "name" field. It is called by the builders before save.
@@ -186,12 +304,16 @@ func init() {
 	portMixin := schema.Port{}.Mixin()
 	portMixinHooks1 := portMixin[1].Hooks()
 	portMixinHooks2 := portMixin[2].Hooks()
+	portMixinHooks3 := portMixin[3].Hooks()
 	port.Hooks[0] = portMixinHooks1[0]
 	port.Hooks[1] = portMixinHooks2[0]
+	port.Hooks[2] = portMixinHooks3[0]
 	portMixinInters1 := portMixin[1].Interceptors()
 	port.Interceptors[0] = portMixinInters1[0]
 	portMixinFields0 := portMixin[0].Fields()
 	_ = portMixinFields0
+	portMixinFields3 := portMixin[3].Fields()
+	_ = portMixinFields3
 	portFields := schema.Port{}.Fields()
 	_ = portFields
 	// portDescCreatedAt is the schema descriptor for created_at field.
@@ -204,6 +326,10 @@ func init() {
 	port.DefaultUpdatedAt = portDescUpdatedAt.Default.(func() time.Time)
 	// port.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	port.UpdateDefaultUpdatedAt = portDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// portDescVersion is the schema descriptor for version field.
+	portDescVersion := portMixinFields3[0].Descriptor()
+	// port.DefaultVersion holds the default value on creation for the version field.
+	port.DefaultVersion = portDescVersion.Default.(int)
 	// portDescNumber is the schema descriptor for number field.
 	portDescNumber := portFields[1].Descriptor()
 	// port.NumberValidator is a validator for the "number" field. It is called by the builders before save.
@@ -238,12 +364,16 @@ func init() {
 	providerMixin := schema.Provider{}.Mixin()
 	providerMixinHooks1 := providerMixin[1].Hooks()
 	providerMixinHooks2 := providerMixin[2].Hooks()
+	providerMixinHooks3 := providerMixin[3].Hooks()
 	provider.Hooks[0] = providerMixinHooks1[0]
 	provider.Hooks[1] = providerMixinHooks2[0]
+	provider.Hooks[2] = providerMixinHooks3[0]
 	providerMixinInters1 := providerMixin[1].Interceptors()
 	provider.Interceptors[0] = providerMixinInters1[0]
 	providerMixinFields0 := providerMixin[0].Fields()
 	_ = providerMixinFields0
+	providerMixinFields3 := providerMixin[3].Fields()
+	_ = providerMixinFields3
 	providerFields := schema.Provider{}.Fields()
 	_ = providerFields
 	// providerDescCreatedAt is the schema descriptor for created_at field.
@@ -256,6 +386,10 @@ func init() {
 	provider.DefaultUpdatedAt = providerDescUpdatedAt.Default.(func() time.Time)
 	// provider.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	provider.UpdateDefaultUpdatedAt = providerDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// providerDescVersion is the schema descriptor for version field.
+	providerDescVersion := providerMixinFields3[0].Descriptor()
+	// provider.DefaultVersion holds the default value on creation for the version field.
+	provider.DefaultVersion = providerDescVersion.Default.(int)
 	// providerDescName is the schema descriptor for name field.
 	providerDescName := providerFields[1].Descriptor()
 	// provider.NameValidator is a validator for the "name" field. It is called by the builders before save.
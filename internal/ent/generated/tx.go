@@ -26,10 +26,18 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AuditEvent is the client for interacting with the AuditEvent builders.
+	AuditEvent *AuditEventClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
 	// LoadBalancer is the client for interacting with the LoadBalancer builders.
 	LoadBalancer *LoadBalancerClient
 	// Origin is the client for interacting with the Origin builders.
 	Origin *OriginClient
+	// OwnerDeletion is the client for interacting with the OwnerDeletion builders.
+	OwnerDeletion *OwnerDeletionClient
+	// PendingChange is the client for interacting with the PendingChange builders.
+	PendingChange *PendingChangeClient
 	// Pool is the client for interacting with the Pool builders.
 	Pool *PoolClient
 	// Port is the client for interacting with the Port builders.
@@ -167,8 +175,12 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AuditEvent = NewAuditEventClient(tx.config)
+	tx.IdempotencyKey = NewIdempotencyKeyClient(tx.config)
 	tx.LoadBalancer = NewLoadBalancerClient(tx.config)
 	tx.Origin = NewOriginClient(tx.config)
+	tx.OwnerDeletion = NewOwnerDeletionClient(tx.config)
+	tx.PendingChange = NewPendingChangeClient(tx.config)
 	tx.Pool = NewPoolClient(tx.config)
 	tx.Port = NewPortClient(tx.config)
 	tx.Provider = NewProviderClient(tx.config)
@@ -181,7 +193,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: LoadBalancer.QueryXXX(), the query will be executed
+// applies a query, for example: AuditEvent.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
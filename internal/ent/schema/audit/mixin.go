@@ -8,6 +8,8 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/mixin"
 	"go.infratographer.com/x/echojwtx"
+
+	"go.infratographer.com/load-balancer-api/x/pubsubinfo"
 )
 
 // Mixin provides auditing for all records where enabled. The created_at, created_by, updated_at, and updated_by records are automatically populated when this mixin is enabled.
@@ -23,12 +25,14 @@ func (Mixin) Fields() []ent.Field {
 			Annotations(
 				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
 				entgql.OrderField("CREATED_BY"),
+				pubsubinfo.EventsHookSkipField(),
 			).
 			Optional(),
 		field.String("updated_by").
 			Annotations(
 				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
 				entgql.OrderField("UPDATED_BY"),
+				pubsubinfo.EventsHookSkipField(),
 			).
 			Optional(),
 	}
@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"go.infratographer.com/x/events"
+	"go.infratographer.com/x/gidx"
+)
+
+// AuditEvent holds the schema definition for the AuditEvent entity.
+type AuditEvent struct {
+	ent.Schema
+}
+
+// Fields of the AuditEvent.
+func (AuditEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			GoType(gidx.PrefixedID("")).
+			DefaultFunc(func() gidx.PrefixedID { return gidx.MustNewID(AuditEventPrefix) }).
+			Unique().
+			Immutable().
+			Comment("The ID for the audit event."),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("The time the change was made.").
+			Annotations(
+				entgql.OrderField("CREATED_AT"),
+			),
+		field.String("actor").
+			Immutable().
+			Comment("The actor that made the change.").
+			Annotations(
+				entgql.OrderField("ACTOR"),
+			),
+		field.String("operation").
+			Immutable().
+			NotEmpty().
+			Comment("The operation that was performed, one of create, update or delete."),
+		field.String("subject_id").
+			GoType(gidx.PrefixedID("")).
+			Immutable().
+			NotEmpty().
+			Comment("The ID of the resource that was changed.").
+			Annotations(
+				entgql.Type("ID"),
+			),
+		field.JSON("related_ids", []gidx.PrefixedID{}).
+			Immutable().
+			Comment("The IDs of the changed resource and all resources related to it at the time of the change.").
+			Annotations(
+				entgql.Skip(),
+			),
+		field.JSON("fields", []string{}).
+			Immutable().
+			Comment("The names of the fields that were changed.").
+			Annotations(
+				entgql.Skip(),
+			),
+		field.JSON("changeset", []events.FieldChange{}).
+			Immutable().
+			Comment("The field changes, with their previous and current values.").
+			Annotations(
+				entgql.Type("[AuditFieldChange!]"),
+			),
+	}
+}
+
+// Indexes of the AuditEvent
+func (AuditEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("subject_id"),
+		// audit events are looked up by the resources they relate to with a jsonb containment query
+		index.Fields("related_ids").
+			Annotations(entsql.IndexTypes(map[string]string{dialect.Postgres: "GIN"})),
+		index.Fields("created_at"),
+		index.Fields("actor"),
+	}
+}
+
+// Annotations for the AuditEvent
+func (AuditEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		schema.Comment("A persisted field level change to a load balancer resource."),
+		prefixIDDirective(AuditEventPrefix),
+		entgql.RelayConnection(),
+		entgql.Skip(entgql.SkipWhereInput),
+	}
+}
@@ -13,4 +13,12 @@ const (
 	PortPrefix string = ApplicationPrefix + "prt"
 	// PoolPrefix is the prefix for all pool IDs
 	PoolPrefix string = ApplicationPrefix + "pol"
+	// AuditEventPrefix is the prefix for all audit event IDs
+	AuditEventPrefix string = ApplicationPrefix + "aud"
+	// IdempotencyKeyPrefix is the prefix for all idempotency key IDs
+	IdempotencyKeyPrefix string = ApplicationPrefix + "idk"
+	// OwnerDeletionPrefix is the prefix for all owner deletion IDs
+	OwnerDeletionPrefix string = ApplicationPrefix + "odl"
+	// PendingChangePrefix is the prefix for all pending change IDs
+	PendingChangePrefix string = ApplicationPrefix + "pch"
 )
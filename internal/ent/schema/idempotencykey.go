@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"go.infratographer.com/x/gidx"
+)
+
+// maxIdempotencyKeyLength is the maximum length of a client provided idempotency key
+const maxIdempotencyKeyLength = 255
+
+// IdempotencyKey holds the schema definition for the IdempotencyKey entity.
+type IdempotencyKey struct {
+	ent.Schema
+}
+
+// Fields of the IdempotencyKey.
+func (IdempotencyKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			GoType(gidx.PrefixedID("")).
+			DefaultFunc(func() gidx.PrefixedID { return gidx.MustNewID(IdempotencyKeyPrefix) }).
+			Unique().
+			Immutable().
+			Comment("The ID for the idempotency key."),
+		field.String("key").
+			Immutable().
+			NotEmpty().
+			MaxLen(maxIdempotencyKeyLength).
+			Comment("The idempotency key provided by the client."),
+		field.String("owner_id").
+			GoType(gidx.PrefixedID("")).
+			Immutable().
+			NotEmpty().
+			Comment("The ID of the owner the key is scoped to."),
+		field.String("request_hash").
+			Immutable().
+			NotEmpty().
+			Comment("The hash of the operation and input the key was first used with."),
+		field.String("resource_id").
+			GoType(gidx.PrefixedID("")).
+			Optional().
+			Comment("The ID of the created resource, empty while the request is in progress."),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("The time the key was first used."),
+		field.Time("expires_at").
+			Immutable().
+			Comment("The time after which the key may be reused."),
+		field.Time("lease_expires_at").
+			Optional().
+			Nillable().
+			Comment("The time after which a key still in progress may be claimed again, because the request holding it stopped."),
+	}
+}
+
+// Indexes of the IdempotencyKey
+func (IdempotencyKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_id", "key").Unique(),
+		index.Fields("expires_at"),
+	}
+}
+
+// Annotations for the IdempotencyKey
+func (IdempotencyKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		schema.Comment("A client provided key used to make create mutations safe to retry."),
+		entgql.Skip(entgql.SkipAll),
+	}
+}
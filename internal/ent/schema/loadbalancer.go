@@ -15,6 +15,7 @@ import (
 	"go.infratographer.com/load-balancer-api/internal/ent/schema/audit"
 	"go.infratographer.com/load-balancer-api/internal/ent/schema/softdelete"
 	"go.infratographer.com/load-balancer-api/internal/ent/schema/validations"
+	"go.infratographer.com/load-balancer-api/internal/ent/schema/version"
 	"go.infratographer.com/load-balancer-api/x/pubsubinfo"
 )
 
@@ -28,6 +29,7 @@ func (LoadBalancer) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		entx.NewTimestampMixin(),
 		audit.Mixin{},
+		version.Mixin{},
 		softdelete.Mixin{},
 	}
 }
@@ -70,6 +72,7 @@ func (LoadBalancer) Fields() []ent.Field {
 			Annotations(
 				entgql.Type("ID"),
 				entgql.Skip(^entgql.SkipMutationCreateInput),
+				pubsubinfo.EventsHookAdditionalSubject(""),
 			),
 		field.String("provider_id").
 			GoType(gidx.PrefixedID("")).
@@ -79,6 +82,7 @@ func (LoadBalancer) Fields() []ent.Field {
 			Annotations(
 				entgql.Type("ID"),
 				entgql.Skip(^entgql.SkipMutationCreateInput),
+				pubsubinfo.EventsHookAdditionalSubject(""),
 			),
 	}
 }
@@ -117,8 +121,14 @@ func (LoadBalancer) Annotations() []schema.Annotation {
 	return []schema.Annotation{
 		entx.GraphKeyDirective("id"),
 		pubsubinfo.EventsHookSubjectName("load-balancer"),
+		pubsubinfo.EventsHookRelatedSubjects("", "id").SkipOnDelete(),
+		pubsubinfo.EventsHookRelatedSubjects("ports", "id").SkipOnDelete(),
+		pubsubinfo.EventsHookExtraRelations("loadbalancer"),
+		// relationships are removed by the delete mutation and owner deletions before the load balancer is deleted
+		pubsubinfo.EventsHookKeepRelationshipsOnDelete(),
 		schema.Comment("Representation of a load balancer."),
 		prefixIDDirective(LoadBalancerPrefix),
+		multiEntityResolverDirective(),
 		entgql.Implements("IPAddressable"),
 		entgql.Implements("MetadataNode"),
 		entgql.RelayConnection(),
@@ -143,3 +153,14 @@ func prefixIDDirective(prefix string) entgql.Annotation {
 
 	return entgql.Directives(entgql.NewDirective("prefixedID", args...))
 }
+
+// multiEntityResolverDirective resolves all federation representations of a type with a single call
+func multiEntityResolverDirective() entgql.Annotation {
+	return entgql.Directives(entgql.NewDirective("entityResolver", &ast.Argument{
+		Name: "multi",
+		Value: &ast.Value{
+			Raw:  "true",
+			Kind: ast.BooleanValue,
+		},
+	}))
+}
@@ -14,6 +14,7 @@ import (
 	"go.infratographer.com/load-balancer-api/internal/ent/schema/audit"
 	"go.infratographer.com/load-balancer-api/internal/ent/schema/softdelete"
 	"go.infratographer.com/load-balancer-api/internal/ent/schema/validations"
+	"go.infratographer.com/load-balancer-api/internal/ent/schema/version"
 	"go.infratographer.com/load-balancer-api/x/pubsubinfo"
 )
 
@@ -30,6 +31,7 @@ func (Origin) Mixin() []ent.Mixin {
 		entx.NewTimestampMixin(),
 		softdelete.Mixin{},
 		audit.Mixin{},
+		version.Mixin{},
 	}
 }
 
@@ -76,6 +78,7 @@ func (Origin) Fields() []ent.Field {
 			Annotations(
 				entgql.Type("ID"),
 				entgql.Skip(entgql.SkipWhereInput, entgql.SkipMutationUpdateInput),
+				pubsubinfo.EventsHookAdditionalSubject("pool"),
 			),
 	}
 }
@@ -104,8 +107,11 @@ func (Origin) Annotations() []schema.Annotation {
 	return []schema.Annotation{
 		entx.GraphKeyDirective("id"),
 		pubsubinfo.EventsHookSubjectName("load-balancer-origin"),
+		pubsubinfo.EventsHookRelatedSubjects("pool.ports.load_balancer", "id", "location_id", "provider_id"),
+		pubsubinfo.EventsHookRelatedSubjects("pool.ports.pools", "id", "owner_id"),
 		entgql.Type("LoadBalancerOrigin"),
 		prefixIDDirective(OriginPrefix),
+		multiEntityResolverDirective(),
 		entgql.RelayConnection(),
 		entgql.Mutations(entgql.MutationCreate(), entgql.MutationUpdate()),
 	}
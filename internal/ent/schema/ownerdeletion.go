@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"go.infratographer.com/x/gidx"
+)
+
+// OwnerDeletion holds the schema definition for the OwnerDeletion entity.
+type OwnerDeletion struct {
+	ent.Schema
+}
+
+// Fields of the OwnerDeletion.
+func (OwnerDeletion) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			GoType(gidx.PrefixedID("")).
+			DefaultFunc(func() gidx.PrefixedID { return gidx.MustNewID(OwnerDeletionPrefix) }).
+			Unique().
+			Immutable().
+			Comment("The ID for the owner deletion."),
+		field.String("owner_id").
+			GoType(gidx.PrefixedID("")).
+			Unique().
+			Immutable().
+			NotEmpty().
+			Comment("The ID of the deleted owner."),
+		field.Time("started_at").
+			Default(time.Now).
+			Immutable().
+			Comment("The time the removal of the owner's resources started."),
+		field.Time("completed_at").
+			Optional().
+			Nillable().
+			Comment("The time all resources of the owner were removed, empty while the deletion is in progress."),
+		field.JSON("skipped_ids", []gidx.PrefixedID{}).
+			Optional().
+			Comment("The IDs of the pools and providers that were kept because resources of other owners still use them."),
+	}
+}
+
+// Indexes of the OwnerDeletion
+func (OwnerDeletion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("completed_at"),
+	}
+}
+
+// Annotations for the OwnerDeletion
+func (OwnerDeletion) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		schema.Comment("The removal of the resources of an owner that was deleted upstream."),
+		entgql.Skip(entgql.SkipAll),
+	}
+}
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"go.infratographer.com/x/events"
+	"go.infratographer.com/x/gidx"
+)
+
+// PendingChange holds the schema definition for the PendingChange entity.
+type PendingChange struct {
+	ent.Schema
+}
+
+// Fields of the PendingChange.
+func (PendingChange) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			GoType(gidx.PrefixedID("")).
+			DefaultFunc(func() gidx.PrefixedID { return gidx.MustNewID(PendingChangePrefix) }).
+			Unique().
+			Immutable().
+			Comment("The ID for the pending change."),
+		field.String("subject").
+			Immutable().
+			NotEmpty().
+			Comment("The subject name the change is published to."),
+		field.JSON("message", events.ChangeMessage{}).
+			Immutable().
+			Comment("The change message to publish."),
+		field.Int("attempts").
+			Default(0).
+			Comment("The number of times publishing the change was attempted."),
+		field.String("last_error").
+			Optional().
+			Comment("The error of the last attempt to publish the change."),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("The time the change was committed."),
+		field.Time("next_attempt_at").
+			Default(time.Now).
+			Comment("The time after which publishing the change is attempted again."),
+	}
+}
+
+// Indexes of the PendingChange
+func (PendingChange) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("next_attempt_at"),
+	}
+}
+
+// Annotations for the PendingChange
+func (PendingChange) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		schema.Comment("A committed change that could not be published yet."),
+		entgql.Skip(entgql.SkipAll),
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"go.infratographer.com/load-balancer-api/internal/ent/schema/audit"
 	"go.infratographer.com/load-balancer-api/internal/ent/schema/softdelete"
 	"go.infratographer.com/load-balancer-api/internal/ent/schema/validations"
+	"go.infratographer.com/load-balancer-api/internal/ent/schema/version"
 	"go.infratographer.com/load-balancer-api/x/pubsubinfo"
 )
 
@@ -27,6 +28,7 @@ func (Pool) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		entx.NewTimestampMixin(),
 		audit.Mixin{},
+		version.Mixin{},
 		softdelete.Mixin{},
 	}
 }
@@ -85,8 +87,12 @@ func (Pool) Annotations() []schema.Annotation {
 	return []schema.Annotation{
 		entx.GraphKeyDirective("id"),
 		pubsubinfo.EventsHookSubjectName("load-balancer-pool"),
+		pubsubinfo.EventsHookRelatedSubjects("ports", "id").SkipOnDelete(),
+		pubsubinfo.EventsHookRelatedSubjects("ports.load_balancer", "id", "location_id", "provider_id"),
+		pubsubinfo.EventsHookRelatedSubjects("ports.pools.origins", "id").SkipOnDelete(),
 		entgql.Type("LoadBalancerPool"),
 		prefixIDDirective(PoolPrefix),
+		multiEntityResolverDirective(),
 		entgql.RelayConnection(),
 		entgql.Mutations(entgql.MutationCreate(), entgql.MutationUpdate()),
 	}
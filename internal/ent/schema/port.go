@@ -3,6 +3,7 @@ package schema
 import (
 	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -14,6 +15,7 @@ import (
 	"go.infratographer.com/load-balancer-api/internal/ent/schema/audit"
 	"go.infratographer.com/load-balancer-api/internal/ent/schema/softdelete"
 	"go.infratographer.com/load-balancer-api/internal/ent/schema/validations"
+	"go.infratographer.com/load-balancer-api/internal/ent/schema/version"
 	"go.infratographer.com/load-balancer-api/x/pubsubinfo"
 )
 
@@ -33,6 +35,7 @@ func (Port) Mixin() []ent.Mixin {
 		entx.NewTimestampMixin(),
 		softdelete.Mixin{},
 		audit.Mixin{},
+		version.Mixin{},
 	}
 }
 
@@ -64,6 +67,7 @@ func (Port) Fields() []ent.Field {
 			Annotations(
 				entgql.Type("ID"),
 				entgql.Skip(entgql.SkipWhereInput, entgql.SkipMutationUpdateInput),
+				pubsubinfo.EventsHookAdditionalSubject("loadbalancer"),
 			),
 	}
 }
@@ -85,7 +89,10 @@ func (Port) Edges() []ent.Edge {
 func (Port) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("load_balancer_id"),
-		index.Fields("load_balancer_id", "number").Unique(),
+		// port numbers only need to be unique among ports that have not been soft deleted
+		index.Fields("load_balancer_id", "number").
+			Unique().
+			Annotations(entsql.IndexWhere("deleted_at IS NULL")),
 	}
 }
 
@@ -95,8 +102,11 @@ func (Port) Annotations() []schema.Annotation {
 		entx.GraphKeyDirective("id"),
 		entgql.Type("LoadBalancerPort"),
 		prefixIDDirective(PortPrefix),
+		multiEntityResolverDirective(),
 		entgql.RelayConnection(),
 		entgql.Mutations(entgql.MutationCreate(), entgql.MutationUpdate()),
 		pubsubinfo.EventsHookSubjectName("load-balancer-port"),
+		pubsubinfo.EventsHookRelatedSubjects("load_balancer", "location_id", "owner_id", "provider_id"),
+		pubsubinfo.EventsHookRelatedSubjects("pools", "id", "owner_id").SkipOnDelete(),
 	}
 }
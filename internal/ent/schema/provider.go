@@ -13,6 +13,7 @@ import (
 	"go.infratographer.com/load-balancer-api/internal/ent/schema/audit"
 	"go.infratographer.com/load-balancer-api/internal/ent/schema/softdelete"
 	"go.infratographer.com/load-balancer-api/internal/ent/schema/validations"
+	"go.infratographer.com/load-balancer-api/internal/ent/schema/version"
 	"go.infratographer.com/load-balancer-api/x/pubsubinfo"
 )
 
@@ -27,6 +28,7 @@ func (Provider) Mixin() []ent.Mixin {
 		entx.NewTimestampMixin(),
 		softdelete.Mixin{},
 		audit.Mixin{},
+		version.Mixin{},
 	}
 }
 
@@ -89,6 +91,9 @@ func (Provider) Annotations() []schema.Annotation {
 		schema.Comment("Representation of a load balancer provider. Load balancer providers are responsible for provisioning and managing load balancers"),
 		entgql.Type("LoadBalancerProvider"),
 		prefixIDDirective(LoadBalancerProviderPrefix),
+		multiEntityResolverDirective(),
+		pubsubinfo.EventsHookSubjectName("load-balancer-provider"),
+		pubsubinfo.EventsHookExtraRelations("provider"),
 		entgql.RelayConnection(),
 		entgql.Mutations(
 			entgql.MutationCreate().Description("Input information to create a load balancer provider."),
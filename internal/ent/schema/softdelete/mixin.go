@@ -15,6 +15,7 @@ import (
 	"go.infratographer.com/load-balancer-api/internal/ent/generated"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/hook"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/intercept"
+	"go.infratographer.com/load-balancer-api/x/pubsubinfo"
 
 	"go.infratographer.com/x/echojwtx"
 )
@@ -32,12 +33,14 @@ func (Mixin) Fields() []ent.Field {
 			Annotations(
 				entgql.OrderField("DELETED_AT"),
 				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+				pubsubinfo.EventsHookSkipField(),
 			),
 		field.String("deleted_by").
 			Optional().
 			Annotations(
 				entgql.OrderField("DELETED_BY"),
 				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+				pubsubinfo.EventsHookSkipField(),
 			),
 	}
 }
@@ -7,6 +7,9 @@ import (
 // ErrInvalidIPAddress is returned when the given string is not a valid IP address
 var ErrInvalidIPAddress = errors.New("invalid ip address")
 
+// ErrDeniedTarget is returned when the given origin target is within a denied range
+var ErrDeniedTarget = errors.New("origin target denied")
+
 // ErrRestrictedPort is returned when the given port is restricted
 var ErrRestrictedPort = errors.New("port number restricted")
 
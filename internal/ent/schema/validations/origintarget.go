@@ -0,0 +1,122 @@
+package validations
+
+import (
+	"fmt"
+	"net/netip"
+
+	"go.infratographer.com/x/gidx"
+	"golang.org/x/exp/slices"
+
+	"go.infratographer.com/load-balancer-api/internal/config"
+)
+
+// DefaultDeniedTargetCIDRs are the ranges origin targets may not be within
+// when no deny list has been configured. They cover addresses that are never a
+// valid tenant backend, such as loopback, link-local (including cloud metadata
+// services) and multicast.
+var DefaultDeniedTargetCIDRs = []string{
+	"0.0.0.0/8",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"224.0.0.0/4",
+	"240.0.0.0/4",
+	"::/128",
+	"::1/128",
+	"fe80::/10",
+	"ff00::/8",
+}
+
+// DeniedTargetError is returned when an origin target is within a denied range
+type DeniedTargetError struct {
+	Target string
+	CIDR   string
+}
+
+// Error implements the error interface
+func (e *DeniedTargetError) Error() string {
+	return fmt.Sprintf("%s: %s is within %s", ErrDeniedTarget, e.Target, e.CIDR)
+}
+
+// Unwrap returns ErrDeniedTarget
+func (e *DeniedTargetError) Unwrap() error {
+	return ErrDeniedTarget
+}
+
+// OriginTarget validates that the given origin target is not within the denied
+// ranges of the given providers. When no providers are given, the default deny
+// list is used. Targets which are not IP addresses are left to IPAddress.
+func OriginTarget(target string, providerIDs ...gidx.PrefixedID) error {
+	addr, err := netip.ParseAddr(target)
+	if err != nil {
+		return nil
+	}
+
+	addr = addr.WithZone("").Unmap()
+
+	lists := [][]string{}
+
+	if len(providerIDs) == 0 {
+		lists = append(lists, deniedTargetCIDRs(""))
+	}
+
+	for _, id := range providerIDs {
+		lists = append(lists, deniedTargetCIDRs(id))
+	}
+
+	for _, cidrs := range lists {
+		for _, cidr := range cidrs {
+			prefix, err := netip.ParsePrefix(cidr)
+			if err != nil {
+				return err
+			}
+
+			if prefix.Contains(addr) {
+				return &DeniedTargetError{Target: target, CIDR: prefix.String()}
+			}
+		}
+	}
+
+	return nil
+}
+
+// OriginTargetPolicy validates that all ranges of the given policy are valid CIDRs
+func OriginTargetPolicy(policy config.OriginTargetPolicyConfig) error {
+	cidrs := slices.Clone(policy.DeniedCIDRs)
+
+	for _, p := range policy.Providers {
+		cidrs = append(cidrs, p.DeniedCIDRs...)
+	}
+
+	for _, cidr := range cidrs {
+		if _, err := netip.ParsePrefix(cidr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deniedTargetCIDRs returns the deny list for the given provider. Provider overrides
+// are added to the global deny list, which defaults to DefaultDeniedTargetCIDRs.
+func deniedTargetCIDRs(providerID gidx.PrefixedID) []string {
+	policy := config.AppConfig.OriginTargetPolicy
+
+	cidrs := DefaultDeniedTargetCIDRs
+	if policy.DeniedCIDRs != nil {
+		cidrs = policy.DeniedCIDRs
+	}
+
+	if providerID == "" {
+		return cidrs
+	}
+
+	cidrs = slices.Clone(cidrs)
+
+	for _, p := range policy.Providers {
+		if p.ID == providerID {
+			cidrs = append(cidrs, p.DeniedCIDRs...)
+		}
+	}
+
+	return cidrs
+}
@@ -0,0 +1,2 @@
+// Package version provides a mixin that adds a version field, incremented on every update, to schemas where the mixin is configured.
+package version
@@ -0,0 +1,10 @@
+package version
+
+import (
+	"errors"
+)
+
+var (
+	// errUnexpectedMutation is returned when an unexpected mutation is encountered
+	errUnexpectedMutation = errors.New("unexpected mutation type")
+)
@@ -0,0 +1,56 @@
+package version
+
+import (
+	"context"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+
+	"go.infratographer.com/load-balancer-api/x/pubsubinfo"
+)
+
+// Mixin provides optimistic concurrency control for all records where enabled. The version field starts at 1 and is incremented by every update, including soft deletes.
+type Mixin struct {
+	mixin.Schema
+}
+
+// Fields of the Mixin
+func (Mixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("version").
+			Default(1).
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput, entgql.SkipWhereInput),
+				pubsubinfo.EventsHookSkipField(),
+			),
+	}
+}
+
+// Hooks of the Mixin
+func (Mixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		Hook,
+	}
+}
+
+// Hook increments the version field on updates
+func Hook(next ent.Mutator) ent.Mutator {
+	type Versioner interface {
+		AddVersion(int)
+	}
+
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		mv, ok := m.(Versioner)
+		if !ok {
+			return nil, errUnexpectedMutation
+		}
+
+		if m.Op().Is(ent.OpUpdateOne | ent.OpUpdate) {
+			mv.AddVersion(1)
+		}
+
+		return next.Mutate(ctx, m)
+	})
+}
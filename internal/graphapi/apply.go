@@ -0,0 +1,765 @@
+package graphapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/x/events"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/loadbalancer"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/pool"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/port"
+	"go.infratographer.com/load-balancer-api/internal/ent/schema/validations"
+)
+
+const (
+	applyResourceLoadBalancer = "load-balancer"
+	applyResourcePort         = "load-balancer-port"
+	applyResourcePool         = "load-balancer-pool"
+	applyResourceOrigin       = "load-balancer-origin"
+)
+
+// loadBalancerSpec is the desired state of a load balancer used by loadBalancerApply. The load balancer
+// is matched on id when set, otherwise on owner and name. Ports are matched on number, pools on name
+// and origins on name within their pool.
+type loadBalancerSpec struct {
+	ID         gidx.PrefixedID `json:"id,omitempty"`
+	Name       string          `json:"name"`
+	OwnerID    gidx.PrefixedID `json:"ownerID"`
+	LocationID gidx.PrefixedID `json:"locationID,omitempty"`
+	ProviderID gidx.PrefixedID `json:"providerID,omitempty"`
+	Ports      []portSpec      `json:"ports,omitempty"`
+	Pools      []poolSpec      `json:"pools,omitempty"`
+}
+
+type portSpec struct {
+	Number int      `json:"number"`
+	Name   string   `json:"name,omitempty"`
+	Pools  []string `json:"pools,omitempty"`
+}
+
+type poolSpec struct {
+	Name     string        `json:"name"`
+	Protocol pool.Protocol `json:"protocol"`
+	Origins  []originSpec  `json:"origins,omitempty"`
+}
+
+// originSpec is the desired state of an origin, weight and active are left unchanged when not set
+type originSpec struct {
+	Name       string `json:"name"`
+	Target     string `json:"target"`
+	PortNumber int    `json:"portNumber"`
+	Weight     *int32 `json:"weight,omitempty"`
+	Active     *bool  `json:"active,omitempty"`
+}
+
+// parseLoadBalancerSpec decodes a load balancer spec and checks it is consistent
+func parseLoadBalancerSpec(raw json.RawMessage) (*loadBalancerSpec, error) {
+	spec := &loadBalancerSpec{}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+
+	if err := dec.Decode(spec); err != nil {
+		return nil, newInvalidFieldError("spec", fmt.Errorf("%w: %s", ErrInvalidSpec, err.Error()))
+	}
+
+	if spec.ID != "" {
+		if err := validateGidx(spec.ID); err != nil {
+			return nil, newInvalidFieldError("spec.id", err)
+		}
+	}
+
+	if err := validateGidx(spec.OwnerID); err != nil {
+		return nil, newInvalidFieldError("spec.ownerID", err)
+	}
+
+	spec.Name = sanitizeField(spec.Name)
+
+	for i := range spec.Pools {
+		spec.Pools[i].Name = sanitizeField(spec.Pools[i].Name)
+
+		for j := range spec.Pools[i].Origins {
+			spec.Pools[i].Origins[j].Name = sanitizeField(spec.Pools[i].Origins[j].Name)
+		}
+	}
+
+	for i := range spec.Ports {
+		spec.Ports[i].Name = sanitizeField(spec.Ports[i].Name)
+
+		for j := range spec.Ports[i].Pools {
+			spec.Ports[i].Pools[j] = sanitizeField(spec.Ports[i].Pools[j])
+		}
+	}
+
+	pools := map[string]bool{}
+
+	for _, p := range spec.Pools {
+		if pools[p.Name] {
+			return nil, newInvalidFieldError("spec.pools", fmt.Errorf("%w: %s", ErrDuplicateSpecEntry, p.Name))
+		}
+
+		pools[p.Name] = false
+
+		origins := map[string]bool{}
+
+		for _, o := range p.Origins {
+			if origins[o.Name] {
+				return nil, newInvalidFieldError("spec.pools.origins", fmt.Errorf("%w: %s/%s", ErrDuplicateSpecEntry, p.Name, o.Name))
+			}
+
+			origins[o.Name] = true
+		}
+	}
+
+	numbers := map[int]bool{}
+
+	for _, p := range spec.Ports {
+		if numbers[p.Number] {
+			return nil, newInvalidFieldError("spec.ports", fmt.Errorf("%w: %d", ErrDuplicateSpecEntry, p.Number))
+		}
+
+		numbers[p.Number] = true
+
+		for _, name := range p.Pools {
+			if _, ok := pools[name]; !ok {
+				return nil, newInvalidFieldError("spec.ports.pools", fmt.Errorf("%w: %s", ErrPoolNotFound, name))
+			}
+
+			pools[name] = true
+		}
+	}
+
+	// pools are found through the ports of the load balancer, so a pool without ports would be lost
+	for _, p := range spec.Pools {
+		if !pools[p.Name] {
+			return nil, newInvalidFieldError("spec.pools", fmt.Errorf("%w: pool %s is not used by any port", ErrInvalidSpec, p.Name))
+		}
+	}
+
+	return spec, nil
+}
+
+// applyPlan is the ordered list of steps that bring a load balancer in line with a spec
+type applyPlan struct {
+	ownerID  gidx.PrefixedID
+	requests []permissions.AccessRequest
+	steps    []applyStep
+	state    *applyState
+}
+
+type applyStep struct {
+	change *LoadBalancerApplyChange
+	run    func(ctx context.Context, tx *generated.Tx, st *applyState) error
+}
+
+// applyState holds the IDs of existing and created resources while a plan is applied
+type applyState struct {
+	loadBalancerID gidx.PrefixedID
+	poolIDs        map[string]gidx.PrefixedID
+}
+
+// require adds the action on the resource to the checks made before the plan is applied. Load
+// balancer and pool actions are checked on the owner, port actions on the load balancer and origin
+// actions on the pool, as bound in the documented policy. Ports and origins created together with
+// their parent are covered by the create action of the parent, which has no ID to check them on yet.
+func (p *applyPlan) require(resource gidx.PrefixedID, action string) {
+	if resource == "" {
+		return
+	}
+
+	req := permissions.AccessRequest{ResourceID: resource, Action: action}
+
+	for _, r := range p.requests {
+		if r == req {
+			return
+		}
+	}
+
+	p.requests = append(p.requests, req)
+}
+
+func (p *applyPlan) add(action LoadBalancerApplyAction, resourceType string, id gidx.PrefixedID, key string, fields []*events.FieldChange, run func(ctx context.Context, tx *generated.Tx, st *applyState) error) {
+	change := &LoadBalancerApplyChange{
+		Action:       action,
+		ResourceType: resourceType,
+		Key:          key,
+		Fields:       fields,
+	}
+
+	if id != "" {
+		change.ID = &id
+	}
+
+	p.steps = append(p.steps, applyStep{change: change, run: run})
+}
+
+func (p *applyPlan) changes() []*LoadBalancerApplyChange {
+	changes := make([]*LoadBalancerApplyChange, len(p.steps))
+
+	for i, s := range p.steps {
+		changes[i] = s.change
+	}
+
+	return changes
+}
+
+// apply runs every step of the plan using the given transaction
+func (p *applyPlan) apply(ctx context.Context, tx *generated.Tx) error {
+	for _, s := range p.steps {
+		if err := s.run(ctx, tx, p.state); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func fieldChange(field string, previous, current any) *events.FieldChange {
+	return &events.FieldChange{
+		Field:         field,
+		PreviousValue: fmt.Sprint(previous),
+		CurrentValue:  fmt.Sprint(current),
+	}
+}
+
+// planLoadBalancerApply diffs the spec against the current state of the load balancer, nil when it
+// does not exist yet, and returns the steps needed to apply it. Creates and updates are ordered
+// parents first, deletes children first.
+func planLoadBalancerApply(ctx context.Context, c *generated.Client, spec *loadBalancerSpec, lb *generated.LoadBalancer) (*applyPlan, error) {
+	plan := &applyPlan{
+		ownerID: spec.OwnerID,
+		state:   &applyState{poolIDs: map[string]gidx.PrefixedID{}},
+	}
+
+	currentPorts := map[int]*generated.Port{}
+	currentPools := map[string]*generated.Pool{}
+
+	if lb == nil {
+		if err := planLoadBalancerCreate(plan, spec); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := planLoadBalancerUpdate(plan, spec, lb); err != nil {
+			return nil, err
+		}
+
+		ports, err := c.Port.Query().
+			Where(port.LoadBalancerIDEQ(lb.ID)).
+			WithPools(func(q *generated.PoolQuery) {
+				q.WithOrigins().WithPorts()
+			}).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range ports {
+			currentPorts[p.Number] = p
+
+			for _, pl := range p.Edges.Pools {
+				if existing, ok := currentPools[pl.Name]; ok && existing.ID != pl.ID {
+					return nil, newInvalidFieldError("spec.pools", fmt.Errorf("%w: %s", ErrAmbiguousSpecEntry, pl.Name))
+				}
+
+				currentPools[pl.Name] = pl
+				plan.state.poolIDs[pl.Name] = pl.ID
+			}
+		}
+	}
+
+	providerID := spec.ProviderID
+	if lb != nil {
+		providerID = lb.ProviderID
+	}
+
+	if err := validateSpecOriginTargets(spec, currentPools, providerID); err != nil {
+		return nil, err
+	}
+
+	for _, ps := range spec.Pools {
+		if err := planPool(plan, ps, currentPools[ps.Name], plan.state.loadBalancerID); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, ps := range spec.Ports {
+		planPort(plan, ps, currentPorts[ps.Number])
+	}
+
+	planRemovedPorts(plan, spec, currentPorts)
+
+	if lb != nil {
+		planRemovedPools(plan, spec, lb.ID, currentPools)
+	}
+
+	return plan, nil
+}
+
+// validateSpecOriginTargets checks the targets of new and changed origins against the deny policy of the provider
+func validateSpecOriginTargets(spec *loadBalancerSpec, currentPools map[string]*generated.Pool, providerID gidx.PrefixedID) error {
+	for _, ps := range spec.Pools {
+		current := map[string]string{}
+
+		if pl, ok := currentPools[ps.Name]; ok {
+			for _, o := range pl.Edges.Origins {
+				current[o.Name] = o.Target
+			}
+		}
+
+		for _, o := range ps.Origins {
+			if target, ok := current[o.Name]; ok && target == o.Target {
+				continue
+			}
+
+			if err := validations.OriginTarget(o.Target, providerID); err != nil {
+				return newInvalidFieldError("spec.pools.origins.target", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// findSpecLoadBalancer returns the load balancer matching the spec, or nil when it does not exist yet.
+// A load balancer given by id that the subject may not see is reported as not found, before any of
+// its fields are compared with the spec.
+func findSpecLoadBalancer(ctx context.Context, c *generated.Client, spec *loadBalancerSpec) (*generated.LoadBalancer, error) {
+	if spec.ID != "" {
+		lb, err := c.LoadBalancer.Get(ctx, spec.ID)
+		if err != nil {
+			if generated.IsNotFound(err) {
+				return nil, ErrLoadBalancerNotFound
+			}
+
+			return nil, err
+		}
+
+		if err := checkAccess(ctx, lb.OwnerID, actionLoadBalancerGet); err != nil {
+			if errors.Is(err, permissions.ErrPermissionDenied) {
+				return nil, ErrLoadBalancerNotFound
+			}
+
+			return nil, err
+		}
+
+		if lb.OwnerID != spec.OwnerID {
+			return nil, newInvalidFieldError("spec.ownerID", ErrImmutableField)
+		}
+
+		return lb, nil
+	}
+
+	lbs, err := c.LoadBalancer.Query().Where(loadbalancer.OwnerIDEQ(spec.OwnerID), loadbalancer.NameEQ(spec.Name)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(lbs) {
+	case 0:
+		return nil, nil
+	case 1:
+		return lbs[0], nil
+	default:
+		return nil, newInvalidFieldError("spec.name", fmt.Errorf("%w: %s", ErrAmbiguousSpecEntry, spec.Name))
+	}
+}
+
+func planLoadBalancerCreate(plan *applyPlan, spec *loadBalancerSpec) error {
+	if err := validateGidx(spec.LocationID); err != nil {
+		return newInvalidFieldError("spec.locationID", err)
+	}
+
+	if err := validateGidx(spec.ProviderID); err != nil {
+		return newInvalidFieldError("spec.providerID", err)
+	}
+
+	plan.require(plan.ownerID, actionLoadBalancerCreate)
+
+	fields := []*events.FieldChange{
+		fieldChange("name", "", spec.Name),
+		fieldChange("owner_id", "", spec.OwnerID),
+		fieldChange("location_id", "", spec.LocationID),
+		fieldChange("provider_id", "", spec.ProviderID),
+	}
+
+	plan.add(LoadBalancerApplyActionCreate, applyResourceLoadBalancer, "", spec.Name, fields, func(ctx context.Context, tx *generated.Tx, st *applyState) error {
+		lb, err := tx.LoadBalancer.Create().SetInput(generated.CreateLoadBalancerInput{
+			Name:       spec.Name,
+			OwnerID:    spec.OwnerID,
+			LocationID: spec.LocationID,
+			ProviderID: spec.ProviderID,
+		}).Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		st.loadBalancerID = lb.ID
+
+		return nil
+	})
+
+	return nil
+}
+
+func planLoadBalancerUpdate(plan *applyPlan, spec *loadBalancerSpec, lb *generated.LoadBalancer) error {
+	if spec.LocationID != "" && spec.LocationID != lb.LocationID {
+		return newInvalidFieldError("spec.locationID", ErrImmutableField)
+	}
+
+	if spec.ProviderID != "" && spec.ProviderID != lb.ProviderID {
+		return newInvalidFieldError("spec.providerID", ErrImmutableField)
+	}
+
+	plan.require(plan.ownerID, actionLoadBalancerUpdate)
+
+	plan.state.loadBalancerID = lb.ID
+
+	if spec.Name == lb.Name {
+		return nil
+	}
+
+	fields := []*events.FieldChange{fieldChange("name", lb.Name, spec.Name)}
+
+	plan.add(LoadBalancerApplyActionUpdate, applyResourceLoadBalancer, lb.ID, spec.Name, fields, func(ctx context.Context, tx *generated.Tx, _ *applyState) error {
+		return tx.LoadBalancer.UpdateOneID(lb.ID).SetName(spec.Name).Exec(ctx)
+	})
+
+	return nil
+}
+
+// planPool creates or updates the pool. Pools also used by ports of other load balancers can not
+// be changed, as the change would apply to those load balancers too.
+func planPool(plan *applyPlan, spec poolSpec, current *generated.Pool, loadBalancerID gidx.PrefixedID) error {
+	if current == nil {
+		plan.require(plan.ownerID, actionLoadBalancerPoolCreate)
+
+		fields := []*events.FieldChange{
+			fieldChange("name", "", spec.Name),
+			fieldChange("protocol", "", spec.Protocol),
+		}
+
+		plan.add(LoadBalancerApplyActionCreate, applyResourcePool, "", spec.Name, fields, func(ctx context.Context, tx *generated.Tx, st *applyState) error {
+			pl, err := tx.Pool.Create().SetInput(generated.CreateLoadBalancerPoolInput{
+				Name:     spec.Name,
+				Protocol: spec.Protocol,
+				OwnerID:  plan.ownerID,
+			}).Save(ctx)
+			if err != nil {
+				return err
+			}
+
+			st.poolIDs[spec.Name] = pl.ID
+
+			return nil
+		})
+
+		for _, o := range spec.Origins {
+			planOrigin(plan, spec.Name, "", o, nil)
+		}
+
+		return nil
+	}
+
+	steps := len(plan.steps)
+
+	if current.Protocol != spec.Protocol {
+		plan.require(plan.ownerID, actionLoadBalancerPoolUpdate)
+
+		fields := []*events.FieldChange{fieldChange("protocol", current.Protocol, spec.Protocol)}
+
+		plan.add(LoadBalancerApplyActionUpdate, applyResourcePool, current.ID, spec.Name, fields, func(ctx context.Context, tx *generated.Tx, _ *applyState) error {
+			return tx.Pool.UpdateOneID(current.ID).SetProtocol(spec.Protocol).Exec(ctx)
+		})
+	}
+
+	currentOrigins := map[string]*generated.Origin{}
+	for _, o := range current.Edges.Origins {
+		currentOrigins[o.Name] = o
+	}
+
+	wanted := map[string]bool{}
+
+	for _, o := range spec.Origins {
+		wanted[o.Name] = true
+
+		planOrigin(plan, spec.Name, current.ID, o, currentOrigins[o.Name])
+	}
+
+	for _, o := range sortedOrigins(current.Edges.Origins) {
+		if wanted[o.Name] {
+			continue
+		}
+
+		planOriginDelete(plan, spec.Name, current.ID, o)
+	}
+
+	if len(plan.steps) > steps && sharedPool(current, loadBalancerID) {
+		return newInvalidFieldError("spec.pools", fmt.Errorf("%w: %s", ErrSharedPool, spec.Name))
+	}
+
+	return nil
+}
+
+// sharedPool reports whether the pool is used by ports of other load balancers
+func sharedPool(pl *generated.Pool, loadBalancerID gidx.PrefixedID) bool {
+	for _, p := range pl.Edges.Ports {
+		if p.LoadBalancerID != loadBalancerID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// planOrigin creates or updates the origin of the pool, poolID is empty when the pool is created
+// by the plan
+func planOrigin(plan *applyPlan, poolName string, poolID gidx.PrefixedID, spec originSpec, current *generated.Origin) {
+	key := poolName + "/" + spec.Name
+
+	if current == nil {
+		fields := []*events.FieldChange{
+			fieldChange("name", "", spec.Name),
+			fieldChange("target", "", spec.Target),
+			fieldChange("port_number", "", spec.PortNumber),
+		}
+
+		if spec.Weight != nil {
+			fields = append(fields, fieldChange("weight", "", *spec.Weight))
+		}
+
+		if spec.Active != nil {
+			fields = append(fields, fieldChange("active", "", *spec.Active))
+		}
+
+		plan.require(poolID, actionLoadBalancerOriginCreate)
+
+		plan.add(LoadBalancerApplyActionCreate, applyResourceOrigin, "", key, fields, func(ctx context.Context, tx *generated.Tx, st *applyState) error {
+			return tx.Origin.Create().SetInput(generated.CreateLoadBalancerOriginInput{
+				Name:       spec.Name,
+				Weight:     spec.Weight,
+				Target:     spec.Target,
+				PortNumber: spec.PortNumber,
+				Active:     spec.Active,
+				PoolID:     st.poolIDs[poolName],
+			}).Exec(ctx)
+		})
+
+		return
+	}
+
+	input := generated.UpdateLoadBalancerOriginInput{}
+	fields := []*events.FieldChange{}
+
+	if current.Target != spec.Target {
+		input.Target = &spec.Target
+		fields = append(fields, fieldChange("target", current.Target, spec.Target))
+	}
+
+	if current.PortNumber != spec.PortNumber {
+		input.PortNumber = &spec.PortNumber
+		fields = append(fields, fieldChange("port_number", current.PortNumber, spec.PortNumber))
+	}
+
+	if spec.Weight != nil && current.Weight != *spec.Weight {
+		input.Weight = spec.Weight
+		fields = append(fields, fieldChange("weight", current.Weight, *spec.Weight))
+	}
+
+	if spec.Active != nil && current.Active != *spec.Active {
+		input.Active = spec.Active
+		fields = append(fields, fieldChange("active", current.Active, *spec.Active))
+	}
+
+	if len(fields) == 0 {
+		return
+	}
+
+	plan.require(poolID, actionLoadBalancerOriginUpdate)
+
+	plan.add(LoadBalancerApplyActionUpdate, applyResourceOrigin, current.ID, key, fields, func(ctx context.Context, tx *generated.Tx, _ *applyState) error {
+		return tx.Origin.UpdateOneID(current.ID).SetInput(input).Exec(ctx)
+	})
+}
+
+func planOriginDelete(plan *applyPlan, poolName string, poolID gidx.PrefixedID, current *generated.Origin) {
+	plan.require(poolID, actionLoadBalancerOriginDelete)
+
+	plan.add(LoadBalancerApplyActionDelete, applyResourceOrigin, current.ID, poolName+"/"+current.Name, []*events.FieldChange{}, func(ctx context.Context, tx *generated.Tx, _ *applyState) error {
+		return tx.Origin.DeleteOneID(current.ID).Exec(ctx)
+	})
+}
+
+func planPort(plan *applyPlan, spec portSpec, current *generated.Port) {
+	key := strconv.Itoa(spec.Number)
+
+	if current == nil {
+		fields := []*events.FieldChange{
+			fieldChange("number", "", spec.Number),
+			fieldChange("name", "", spec.Name),
+			fieldChange("pools", "", strings.Join(spec.Pools, ",")),
+		}
+
+		plan.require(plan.state.loadBalancerID, actionLoadBalancerPortCreate)
+
+		plan.add(LoadBalancerApplyActionCreate, applyResourcePort, "", key, fields, func(ctx context.Context, tx *generated.Tx, st *applyState) error {
+			poolIDs := make([]gidx.PrefixedID, len(spec.Pools))
+			for i, name := range spec.Pools {
+				poolIDs[i] = st.poolIDs[name]
+			}
+
+			return tx.Port.Create().SetInput(generated.CreateLoadBalancerPortInput{
+				Number:         spec.Number,
+				Name:           &spec.Name,
+				PoolIDs:        poolIDs,
+				LoadBalancerID: st.loadBalancerID,
+			}).Exec(ctx)
+		})
+
+		return
+	}
+
+	fields := []*events.FieldChange{}
+
+	if current.Name != spec.Name {
+		fields = append(fields, fieldChange("name", current.Name, spec.Name))
+	}
+
+	currentPools := map[string]gidx.PrefixedID{}
+	currentNames := []string{}
+
+	for _, pl := range current.Edges.Pools {
+		currentPools[pl.Name] = pl.ID
+		currentNames = append(currentNames, pl.Name)
+	}
+
+	wanted := map[string]bool{}
+	addPools := []string{}
+
+	for _, name := range spec.Pools {
+		wanted[name] = true
+
+		if _, ok := currentPools[name]; !ok {
+			addPools = append(addPools, name)
+		}
+	}
+
+	removePoolIDs := []gidx.PrefixedID{}
+
+	for _, name := range currentNames {
+		if !wanted[name] {
+			removePoolIDs = append(removePoolIDs, currentPools[name])
+		}
+	}
+
+	if len(addPools) != 0 || len(removePoolIDs) != 0 {
+		sort.Strings(currentNames)
+
+		wantedNames := append([]string{}, spec.Pools...)
+		sort.Strings(wantedNames)
+
+		fields = append(fields, fieldChange("pools", strings.Join(currentNames, ","), strings.Join(wantedNames, ",")))
+	}
+
+	if len(fields) == 0 {
+		return
+	}
+
+	plan.require(plan.state.loadBalancerID, actionLoadBalancerPortUpdate)
+
+	plan.add(LoadBalancerApplyActionUpdate, applyResourcePort, current.ID, key, fields, func(ctx context.Context, tx *generated.Tx, st *applyState) error {
+		addPoolIDs := make([]gidx.PrefixedID, len(addPools))
+		for i, name := range addPools {
+			addPoolIDs[i] = st.poolIDs[name]
+		}
+
+		return tx.Port.UpdateOneID(current.ID).
+			SetName(spec.Name).
+			AddPoolIDs(addPoolIDs...).
+			RemovePoolIDs(removePoolIDs...).
+			Exec(ctx)
+	})
+}
+
+func planRemovedPorts(plan *applyPlan, spec *loadBalancerSpec, current map[int]*generated.Port) {
+	wanted := map[int]bool{}
+	for _, p := range spec.Ports {
+		wanted[p.Number] = true
+	}
+
+	numbers := []int{}
+
+	for number := range current {
+		if !wanted[number] {
+			numbers = append(numbers, number)
+		}
+	}
+
+	sort.Ints(numbers)
+
+	for _, number := range numbers {
+		p := current[number]
+
+		plan.require(plan.state.loadBalancerID, actionLoadBalancerPortDelete)
+
+		plan.add(LoadBalancerApplyActionDelete, applyResourcePort, p.ID, strconv.Itoa(number), []*events.FieldChange{}, func(ctx context.Context, tx *generated.Tx, _ *applyState) error {
+			return tx.Port.DeleteOneID(p.ID).Exec(ctx)
+		})
+	}
+}
+
+// planRemovedPools deletes pools that are no longer in the spec. Pools that are still used by
+// ports of other load balancers are only detached from this load balancer's ports.
+func planRemovedPools(plan *applyPlan, spec *loadBalancerSpec, loadBalancerID gidx.PrefixedID, current map[string]*generated.Pool) {
+	wanted := map[string]bool{}
+	for _, p := range spec.Pools {
+		wanted[p.Name] = true
+	}
+
+	names := []string{}
+
+	for name := range current {
+		if !wanted[name] {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		pl := current[name]
+
+		if sharedPool(pl, loadBalancerID) {
+			continue
+		}
+
+		plan.require(plan.ownerID, actionLoadBalancerPoolDelete)
+
+		for _, o := range sortedOrigins(pl.Edges.Origins) {
+			planOriginDelete(plan, name, pl.ID, o)
+		}
+
+		plan.add(LoadBalancerApplyActionDelete, applyResourcePool, pl.ID, name, []*events.FieldChange{}, func(ctx context.Context, tx *generated.Tx, _ *applyState) error {
+			return tx.Pool.DeleteOneID(pl.ID).Exec(ctx)
+		})
+	}
+}
+
+func sortedOrigins(origins []*generated.Origin) []*generated.Origin {
+	sorted := append([]*generated.Origin{}, origins...)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	return sorted
+}
@@ -0,0 +1,157 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.38
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"go.infratographer.com/load-balancer-api/internal/config"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/loadbalancer"
+	"go.infratographer.com/load-balancer-api/pkg/metadata"
+)
+
+// LoadBalancerApply is the resolver for the loadBalancerApply field.
+func (r *mutationResolver) LoadBalancerApply(ctx context.Context, spec json.RawMessage, dryRun *bool) (*LoadBalancerApplyPayload, error) {
+	s, err := parseLoadBalancerSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := r.logger.With("ownerID", s.OwnerID, "loadbalancerName", s.Name)
+
+	// the spec is matched against the load balancers of the owner, so the subject has to be allowed
+	// to see them before anything about them is reported
+	if err := checkAccess(ctx, s.OwnerID, actionLoadBalancerGet); err != nil {
+		return nil, err
+	}
+
+	tx, err := r.client.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		logger.Errorw("failed to begin transaction", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	rollback := func() {
+		logger.Debugw("rolling back transaction")
+
+		if err := tx.Rollback(); err != nil {
+			logger.Errorw("failed to rollback transaction", "error", err)
+		}
+	}
+
+	planError := func(err error) error {
+		rollback()
+
+		var fieldErr *ErrInvalidField
+
+		if generated.IsNotFound(err) || errors.Is(err, ErrLoadBalancerNotFound) || errors.As(err, &fieldErr) {
+			return err
+		}
+
+		logger.Errorw("failed to plan loadbalancer apply", "error", err)
+
+		return ErrInternalServerError
+	}
+
+	// plan inside the transaction so the changes are applied to the state they were planned against
+	lb, err := findSpecLoadBalancer(ctx, tx.Client(), s)
+	if err != nil {
+		return nil, planError(err)
+	}
+
+	// checked before the spec is compared with the load balancer, so nothing about its
+	// configuration is revealed to a subject that may not change it
+	action := actionLoadBalancerCreate
+	if lb != nil {
+		action = actionLoadBalancerUpdate
+	}
+
+	if err := checkAccess(ctx, s.OwnerID, action); err != nil {
+		rollback()
+		return nil, err
+	}
+
+	plan, err := planLoadBalancerApply(ctx, tx.Client(), s, lb)
+	if err != nil {
+		return nil, planError(err)
+	}
+
+	if err := checkAll(ctx, plan.requests...); err != nil {
+		rollback()
+		return nil, err
+	}
+
+	created := plan.state.loadBalancerID == ""
+
+	if created && config.AppConfig.LoadBalancerLimit > 0 {
+		count, err := tx.LoadBalancer.Query().Where(loadbalancer.OwnerIDEQ(s.OwnerID)).Count(ctx)
+		if err != nil {
+			logger.Errorw("failed to query loadbalancer count", "error", err)
+		}
+
+		if count >= config.AppConfig.LoadBalancerLimit {
+			rollback()
+			return nil, ErrLoadBalancerLimitReached
+		}
+	}
+
+	payload := &LoadBalancerApplyPayload{
+		DryRun:  dryRun != nil && *dryRun,
+		Changes: plan.changes(),
+	}
+
+	if payload.DryRun {
+		rollback()
+	} else {
+		if err := plan.apply(ctx, tx); err != nil {
+			rollback()
+
+			switch {
+			case generated.IsConstraintError(err) && strings.Contains(err.Error(), "number"):
+				return nil, ErrPortNumberInUse
+			case generated.IsValidationError(err):
+				return nil, err
+			default:
+				logger.Errorw("failed to apply loadbalancer spec", "error", err)
+				return nil, ErrInternalServerError
+			}
+		}
+
+		logger.Debugw("committing transaction")
+
+		if err := tx.Commit(); err != nil {
+			logger.Errorw("failed to commit transaction", "error", err)
+			return nil, ErrInternalServerError
+		}
+
+		if len(payload.Changes) != 0 {
+			status := &metadata.LoadBalancerStatus{State: metadata.LoadBalancerStateUpdating}
+			if created {
+				status.State = metadata.LoadBalancerStateCreating
+			}
+
+			if err := r.LoadBalancerStatusUpdate(ctx, plan.state.loadBalancerID, status); err != nil {
+				logger.Errorw("failed to update loadbalancer metadata status", "error", err, "loadbalancerID", plan.state.loadBalancerID)
+			}
+		}
+	}
+
+	if plan.state.loadBalancerID != "" {
+		lb, err := r.client.LoadBalancer.Get(ctx, plan.state.loadBalancerID)
+		if err != nil {
+			logger.Errorw("failed to get loadbalancer", "error", err, "loadbalancerID", plan.state.loadBalancerID)
+			return nil, ErrInternalServerError
+		}
+
+		payload.LoadBalancer = lb
+	}
+
+	return payload, nil
+}
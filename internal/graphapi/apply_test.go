@@ -0,0 +1,570 @@
+package graphapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/permissions-api/pkg/permissions/mockpermissions"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/load-balancer-api/internal/config"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/loadbalancer"
+	"go.infratographer.com/load-balancer-api/internal/graphclient"
+	"go.infratographer.com/load-balancer-api/internal/testutils"
+)
+
+func TestMutate_LoadBalancerApply(t *testing.T) {
+	config.AppConfig.RestrictedPorts = []int{1234}
+
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	prov := (&testutils.ProviderBuilder{}).MustNew(ctx)
+	ownerID := gidx.MustNewID(ownerPrefix)
+	locationID := gidx.MustNewID(locationPrefix)
+
+	spec := map[string]any{
+		"name":       "lb-apply",
+		"ownerID":    ownerID,
+		"locationID": locationID,
+		"providerID": prov.ID,
+		"ports": []map[string]any{
+			{"number": 443, "name": "https", "pools": []string{"web"}},
+			{"number": 80, "name": "http", "pools": []string{"web"}},
+		},
+		"pools": []map[string]any{
+			{
+				"name":     "web",
+				"protocol": "tcp",
+				"origins": []map[string]any{
+					{"name": "a", "target": "1.2.3.4", "portNumber": 8080},
+					{"name": "b", "target": "1.2.3.5", "portNumber": 8080, "weight": 50},
+				},
+			},
+		},
+	}
+
+	updated := map[string]any{
+		"name":    "lb-apply-renamed",
+		"ownerID": ownerID,
+		"ports": []map[string]any{
+			{"number": 443, "name": "https", "pools": []string{"web", "api"}},
+			{"number": 8443, "pools": []string{"api"}},
+		},
+		"pools": []map[string]any{
+			{
+				"name":     "web",
+				"protocol": "tcp",
+				"origins": []map[string]any{
+					{"name": "a", "target": "1.2.3.6", "portNumber": 8080},
+				},
+			},
+			{
+				"name":     "api",
+				"protocol": "udp",
+				"origins": []map[string]any{
+					{"name": "c", "target": "1.2.3.7", "portNumber": 9000, "active": false},
+				},
+			},
+		},
+	}
+
+	removed := map[string]any{
+		"name":    "lb-apply-renamed",
+		"ownerID": ownerID,
+		"ports": []map[string]any{
+			{"number": 8443, "pools": []string{"api"}},
+		},
+		"pools": []map[string]any{
+			{
+				"name":     "api",
+				"protocol": "udp",
+				"origins": []map[string]any{
+					{"name": "c", "target": "1.2.3.7", "portNumber": 9000, "active": false},
+				},
+			},
+		},
+	}
+
+	// set once the load balancer is created, renaming a load balancer requires the id in the spec
+	var lbID gidx.PrefixedID
+
+	// the steps build on each other so they run in order
+	testCases := []struct {
+		TestName        string
+		Spec            map[string]any
+		WithID          bool
+		DryRun          bool
+		Checker         permissions.Checker
+		ExpectedChanges []string
+		ExpectedPorts   map[int64][]string
+		errorMsg        string
+	}{
+		{
+			TestName: "plans a new load balancer",
+			Spec:     spec,
+			DryRun:   true,
+			ExpectedChanges: []string{
+				"CREATE load-balancer lb-apply",
+				"CREATE load-balancer-pool web",
+				"CREATE load-balancer-origin web/a",
+				"CREATE load-balancer-origin web/b",
+				"CREATE load-balancer-port 443",
+				"CREATE load-balancer-port 80",
+			},
+		},
+		{
+			TestName: "fails to create without pool create permission",
+			Spec:     spec,
+			Checker:  denyActionChecker("loadbalancerpool_create"),
+			errorMsg: "subject doesn't have access",
+		},
+		{
+			TestName: "creates a new load balancer",
+			Spec:     spec,
+			Checker:  documentedPolicyChecker(),
+			ExpectedChanges: []string{
+				"CREATE load-balancer lb-apply",
+				"CREATE load-balancer-pool web",
+				"CREATE load-balancer-origin web/a",
+				"CREATE load-balancer-origin web/b",
+				"CREATE load-balancer-port 443",
+				"CREATE load-balancer-port 80",
+			},
+			ExpectedPorts: map[int64][]string{
+				443: {"web:a,b"},
+				80:  {"web:a,b"},
+			},
+		},
+		{
+			TestName:        "applying the same spec again changes nothing",
+			Spec:            spec,
+			ExpectedChanges: []string{},
+			ExpectedPorts: map[int64][]string{
+				443: {"web:a,b"},
+				80:  {"web:a,b"},
+			},
+		},
+		{
+			TestName: "fails to add a port without port create permission",
+			WithID:   true,
+			Spec:     updated,
+			Checker:  denyActionChecker("loadbalancerport_create"),
+			errorMsg: "subject doesn't have access",
+		},
+		{
+			TestName: "fails to change an origin without origin update permission",
+			WithID:   true,
+			Spec:     updated,
+			Checker:  denyActionChecker("loadbalancerorigin_update"),
+			errorMsg: "subject doesn't have access",
+		},
+		{
+			TestName: "plans updates",
+			WithID:   true,
+			Spec:     updated,
+			DryRun:   true,
+			ExpectedChanges: []string{
+				"UPDATE load-balancer lb-apply-renamed",
+				"UPDATE load-balancer-origin web/a",
+				"DELETE load-balancer-origin web/b",
+				"CREATE load-balancer-pool api",
+				"CREATE load-balancer-origin api/c",
+				"UPDATE load-balancer-port 443",
+				"CREATE load-balancer-port 8443",
+				"DELETE load-balancer-port 80",
+			},
+			ExpectedPorts: map[int64][]string{
+				443: {"web:a,b"},
+				80:  {"web:a,b"},
+			},
+		},
+		{
+			TestName: "applies updates",
+			WithID:   true,
+			Spec:     updated,
+			Checker:  documentedPolicyChecker(),
+			ExpectedChanges: []string{
+				"UPDATE load-balancer lb-apply-renamed",
+				"UPDATE load-balancer-origin web/a",
+				"DELETE load-balancer-origin web/b",
+				"CREATE load-balancer-pool api",
+				"CREATE load-balancer-origin api/c",
+				"UPDATE load-balancer-port 443",
+				"CREATE load-balancer-port 8443",
+				"DELETE load-balancer-port 80",
+			},
+			ExpectedPorts: map[int64][]string{
+				443:  {"api:c", "web:a"},
+				8443: {"api:c"},
+			},
+		},
+		{
+			TestName: "deletes ports and pools removed from the spec",
+			WithID:   true,
+			Spec:     removed,
+			Checker:  documentedPolicyChecker(),
+			ExpectedChanges: []string{
+				"DELETE load-balancer-port 443",
+				"DELETE load-balancer-origin web/a",
+				"DELETE load-balancer-pool web",
+			},
+			ExpectedPorts: map[int64][]string{
+				8443: {"api:c"},
+			},
+		},
+		{
+			TestName: "fails to change the location",
+			WithID:   true,
+			Spec: map[string]any{
+				"name":       "lb-apply-renamed",
+				"ownerID":    ownerID,
+				"locationID": gidx.MustNewID(locationPrefix),
+			},
+			errorMsg: "spec.locationID: field can not be changed",
+		},
+		{
+			TestName: "fails with duplicate port numbers",
+			Spec: map[string]any{
+				"name":    "lb-apply-renamed",
+				"ownerID": ownerID,
+				"ports":   []map[string]any{{"number": 22}, {"number": 22}},
+			},
+			errorMsg: "duplicate entry",
+		},
+		{
+			TestName: "fails with unknown pool",
+			Spec: map[string]any{
+				"name":    "lb-apply-renamed",
+				"ownerID": ownerID,
+				"ports":   []map[string]any{{"number": 22, "pools": []string{"missing"}}},
+			},
+			errorMsg: "one or more pools not found",
+		},
+		{
+			TestName: "fails with unknown fields",
+			Spec: map[string]any{
+				"name":    "lb-apply-renamed",
+				"ownerID": ownerID,
+				"listen":  []int{22},
+			},
+			errorMsg: "invalid load balancer spec",
+		},
+		{
+			TestName: "fails with restricted port",
+			WithID:   true,
+			Spec: map[string]any{
+				"name":    "lb-apply-renamed",
+				"ownerID": ownerID,
+				"ports":   []map[string]any{{"number": 1234}},
+			},
+			errorMsg: "port number restricted",
+		},
+		{
+			TestName: "fails with denied origin target",
+			WithID:   true,
+			Spec: map[string]any{
+				"name":    "lb-apply-renamed",
+				"ownerID": ownerID,
+				"ports":   []map[string]any{{"number": 443, "pools": []string{"pool"}}},
+				"pools": []map[string]any{{
+					"name":     "pool",
+					"protocol": "tcp",
+					"origins":  []map[string]any{{"name": "origin", "target": "169.254.169.254", "portNumber": 80}},
+				}},
+			},
+			errorMsg: "spec.pools.origins.target: origin target denied: 169.254.169.254 is within 169.254.0.0/16",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			spec := map[string]any{}
+			for k, v := range tt.Spec {
+				spec[k] = v
+			}
+
+			if tt.WithID {
+				spec["id"] = lbID
+			}
+
+			raw, err := json.Marshal(spec)
+			require.NoError(t, err)
+
+			reqCtx := ctx
+			if tt.Checker != nil {
+				reqCtx = context.WithValue(ctx, permissions.CheckerCtxKey, tt.Checker)
+			}
+
+			resp, err := graphTestClient().LoadBalancerApply(reqCtx, raw, &tt.DryRun)
+
+			if tt.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.errorMsg)
+				assert.Nil(t, resp)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+
+			payload := resp.LoadBalancerApply
+			assert.Equal(t, tt.DryRun, payload.DryRun)
+
+			changes := []string{}
+			for _, c := range payload.Changes {
+				changes = append(changes, string(c.Action)+" "+c.ResourceType+" "+c.Key)
+			}
+
+			assert.Equal(t, tt.ExpectedChanges, changes)
+
+			if tt.ExpectedPorts == nil {
+				assert.Nil(t, payload.LoadBalancer)
+
+				count := testutils.EntClient.LoadBalancer.Query().Where(loadbalancer.OwnerID(ownerID)).CountX(ctx)
+				assert.Zero(t, count)
+
+				return
+			}
+
+			require.NotNil(t, payload.LoadBalancer)
+
+			lbID = payload.LoadBalancer.ID
+
+			ports := map[int64][]string{}
+
+			for _, edge := range payload.LoadBalancer.Ports.Edges {
+				pools := []string{}
+
+				for _, pl := range edge.Node.Pools {
+					origins := []string{}
+					for _, o := range pl.Origins.Edges {
+						origins = append(origins, o.Node.Name)
+					}
+
+					sort.Strings(origins)
+
+					pools = append(pools, pl.Name+":"+strings.Join(origins, ","))
+				}
+
+				ports[edge.Node.Number] = pools
+			}
+
+			for number, pools := range tt.ExpectedPorts {
+				assert.ElementsMatch(t, pools, ports[number], "port %d", number)
+			}
+
+			assert.Len(t, ports, len(tt.ExpectedPorts))
+		})
+	}
+}
+
+func TestMutate_LoadBalancerApplyOtherOwner(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	lb := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+
+	// the subject may only see its own owner
+	ownerID := gidx.MustNewID(ownerPrefix)
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.Checker(func(_ context.Context, requests ...permissions.AccessRequest) error {
+		for _, req := range requests {
+			if req.ResourceID != ownerID {
+				return permissions.ErrPermissionDenied
+			}
+		}
+
+		return nil
+	}))
+
+	testCases := []struct {
+		TestName string
+		ID       gidx.PrefixedID
+	}{
+		{
+			TestName: "load balancer of another owner",
+			ID:       lb.ID,
+		},
+		{
+			TestName: "load balancer that does not exist",
+			ID:       gidx.MustNewID("loadbal"),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			raw, err := json.Marshal(map[string]any{
+				"id":      tt.ID,
+				"name":    lb.Name,
+				"ownerID": ownerID,
+			})
+			require.NoError(t, err)
+
+			// both are reported the same way, so the ids of other owners can't be probed
+			resp, err := graphTestClient().LoadBalancerApply(ctx, raw, newBool(true))
+			require.Error(t, err)
+			assert.ErrorContains(t, err, "load balancer not found")
+			assert.Nil(t, resp)
+		})
+	}
+}
+
+func TestMutate_LoadBalancerApplyOtherOwnerByName(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	lb := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+
+	testCases := []struct {
+		TestName string
+		Name     string
+		Checker  permissions.Checker
+	}{
+		{
+			TestName: "load balancer of another owner",
+			Name:     lb.Name,
+			Checker:  denyResourceActionChecker(lb.OwnerID, "loadbalancer_get"),
+		},
+		{
+			TestName: "load balancer that does not exist",
+			Name:     "lb-apply-missing",
+			Checker:  denyResourceActionChecker(lb.OwnerID, "loadbalancer_get"),
+		},
+		{
+			TestName: "load balancer the subject may not update",
+			Name:     lb.Name,
+			Checker:  denyResourceActionChecker(lb.OwnerID, "loadbalancer_update"),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			// a different location would be reported as an immutable field if the load balancer was compared
+			raw, err := json.Marshal(map[string]any{
+				"name":       tt.Name,
+				"ownerID":    lb.OwnerID,
+				"locationID": gidx.MustNewID(locationPrefix),
+				"providerID": lb.ProviderID,
+			})
+			require.NoError(t, err)
+
+			resp, err := graphTestClient().LoadBalancerApply(context.WithValue(ctx, permissions.CheckerCtxKey, tt.Checker), raw, newBool(true))
+			require.Error(t, err)
+			assert.ErrorContains(t, err, "subject doesn't have access")
+			assert.Nil(t, resp)
+		})
+	}
+}
+
+func TestMutate_LoadBalancerApplySanitizesNames(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	prov := (&testutils.ProviderBuilder{}).MustNew(ctx)
+
+	raw, err := json.Marshal(map[string]any{
+		"name":       " lb-sanitized\n",
+		"ownerID":    prov.OwnerID,
+		"locationID": gidx.MustNewID(locationPrefix),
+		"providerID": prov.ID,
+		"ports":      []map[string]any{{"number": 80, "name": "http\r\n", "pools": []string{" web\n"}}},
+		"pools": []map[string]any{{
+			"name":     "web ",
+			"protocol": "tcp",
+			"origins":  []map[string]any{{"name": "\ta\n", "target": "1.2.3.4", "portNumber": 80}},
+		}},
+	})
+	require.NoError(t, err)
+
+	resp, err := graphTestClient().LoadBalancerApply(ctx, raw, newBool(true))
+	require.NoError(t, err)
+
+	changes := []string{}
+	for _, c := range resp.LoadBalancerApply.Changes {
+		changes = append(changes, string(c.Action)+" "+c.ResourceType+" "+c.Key)
+	}
+
+	assert.Equal(t, []string{
+		"CREATE load-balancer lb-sanitized",
+		"CREATE load-balancer-pool web",
+		"CREATE load-balancer-origin web/a",
+		"CREATE load-balancer-port 80",
+	}, changes)
+}
+
+func TestMutate_LoadBalancerApplySharedPool(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	lb1 := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+	lb2 := (&testutils.LoadBalancerBuilder{OwnerID: lb1.OwnerID}).MustNew(ctx)
+	pool := (&testutils.PoolBuilder{Name: "shared", OwnerID: lb1.OwnerID, Protocol: "tcp"}).MustNew(ctx)
+	(&testutils.OriginBuilder{Name: "a", PoolID: pool.ID, Target: "1.2.3.4", PortNumber: 80}).MustNew(ctx)
+	(&testutils.PortBuilder{Name: "http", LoadBalancerID: lb1.ID, Number: 80, PoolIDs: []gidx.PrefixedID{pool.ID}}).MustNew(ctx)
+	(&testutils.PortBuilder{LoadBalancerID: lb2.ID, Number: 80, PoolIDs: []gidx.PrefixedID{pool.ID}}).MustNew(ctx)
+
+	apply := func(origins []map[string]any) (*graphclient.LoadBalancerApply, error) {
+		raw, err := json.Marshal(map[string]any{
+			"id":      lb1.ID,
+			"name":    lb1.Name,
+			"ownerID": lb1.OwnerID,
+			"ports":   []map[string]any{{"number": 80, "name": "http", "pools": []string{"shared"}}},
+			"pools":   []map[string]any{{"name": "shared", "protocol": "tcp", "origins": origins}},
+		})
+		require.NoError(t, err)
+
+		return graphTestClient().LoadBalancerApply(ctx, raw, newBool(true))
+	}
+
+	t.Run("unchanged shared pools are allowed", func(t *testing.T) {
+		resp, err := apply([]map[string]any{{"name": "a", "target": "1.2.3.4", "portNumber": 80}})
+		require.NoError(t, err)
+		assert.Empty(t, resp.LoadBalancerApply.Changes)
+	})
+
+	t.Run("changing a shared pool is rejected", func(t *testing.T) {
+		resp, err := apply([]map[string]any{{"name": "a", "target": "1.2.3.5", "portNumber": 80}})
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "spec.pools: pool is used by other load balancers: shared")
+		assert.Nil(t, resp)
+	})
+}
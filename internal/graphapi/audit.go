@@ -0,0 +1,98 @@
+package graphapi
+
+import (
+	"context"
+	"errors"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/auditevent"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/loadbalancer"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/predicate"
+	"go.infratographer.com/load-balancer-api/internal/ent/schema/softdelete"
+)
+
+// auditEvents returns the audit events related to the given resource ID that match the extra
+// predicates, newest first unless another order is requested
+func (r *Resolver) auditEvents(ctx context.Context, id gidx.PrefixedID, where []predicate.AuditEvent, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.AuditEventOrder, filter *AuditEventFilter) (*generated.AuditEventConnection, error) {
+	logger := r.logger.With("resourceID", id.String())
+
+	if orderBy == nil {
+		orderBy = &generated.AuditEventOrder{
+			Field:     generated.AuditEventOrderFieldCreatedAt,
+			Direction: entgql.OrderDirectionDesc,
+		}
+	}
+
+	query := r.client.AuditEvent.Query().Where(jsonContains(auditevent.FieldRelatedIds, id)).Where(where...)
+
+	if filter != nil {
+		if filter.Actor != nil {
+			query = query.Where(auditevent.Actor(*filter.Actor))
+		}
+
+		if filter.Field != nil {
+			query = query.Where(jsonContains(auditevent.FieldFields, *filter.Field))
+		}
+
+		if filter.Since != nil {
+			query = query.Where(auditevent.CreatedAtGTE(*filter.Since))
+		}
+
+		if filter.Until != nil {
+			query = query.Where(auditevent.CreatedAtLT(*filter.Until))
+		}
+	}
+
+	conn, err := query.Paginate(ctx, after, first, before, last, generated.WithAuditEventOrder(orderBy))
+	if err != nil {
+		logger.Errorw("failed to query audit events", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return conn, nil
+}
+
+// providerAuditEventsWhere limits the audit events of a provider to the changes of the provider
+// itself and of the resources of owners the caller may read the history of. Load balancers of
+// many owners share a provider, so being allowed to read its history must not expose theirs.
+func (r *Resolver) providerAuditEventsWhere(ctx context.Context, p *generated.Provider) ([]predicate.AuditEvent, error) {
+	owners := []gidx.PrefixedID{}
+
+	// owners of deleted load balancers still have events related to the provider
+	if err := r.client.LoadBalancer.Query().
+		Where(loadbalancer.ProviderID(p.ID)).
+		Unique(true).
+		Select(loadbalancer.FieldOwnerID).
+		Scan(softdelete.SkipSoftDelete(ctx), &owners); err != nil {
+		r.logger.Errorw("failed to query provider load balancer owners", "error", err, "loadbalancerProviderID", p.ID)
+		return nil, ErrInternalServerError
+	}
+
+	readable := []predicate.AuditEvent{auditevent.SubjectID(p.ID)}
+
+	for i, err := range checkEach(ctx, accessRequests(owners, actionLoadBalancerGetHistory)...) {
+		switch {
+		case err == nil:
+			readable = append(readable, jsonContains(auditevent.FieldRelatedIds, owners[i]))
+		case errors.Is(err, permissions.ErrPermissionDenied):
+			continue
+		default:
+			return nil, err
+		}
+	}
+
+	return []predicate.AuditEvent{auditevent.Or(readable...)}, nil
+}
+
+// jsonContains returns a predicate matching audit events where the JSON array column contains the value
+func jsonContains(column string, value any) predicate.AuditEvent {
+	return func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(column), value))
+	}
+}
@@ -0,0 +1,45 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.38
+
+import (
+	"context"
+
+	"entgo.io/contrib/entgql"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/x/gidx"
+)
+
+// AuditEvents is the resolver for the auditEvents field.
+func (r *loadBalancerResolver) AuditEvents(ctx context.Context, obj *generated.LoadBalancer, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.AuditEventOrder, filter *AuditEventFilter) (*generated.AuditEventConnection, error) {
+	if err := checkAccess(ctx, obj.OwnerID, actionLoadBalancerGetHistory); err != nil {
+		return nil, err
+	}
+
+	return r.auditEvents(ctx, obj.ID, nil, after, first, before, last, orderBy, filter)
+}
+
+// AuditEvents is the resolver for the auditEvents field.
+func (r *loadBalancerPoolResolver) AuditEvents(ctx context.Context, obj *generated.Pool, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.AuditEventOrder, filter *AuditEventFilter) (*generated.AuditEventConnection, error) {
+	if err := checkAccess(ctx, obj.OwnerID, actionLoadBalancerPoolGetHistory); err != nil {
+		return nil, err
+	}
+
+	return r.auditEvents(ctx, obj.ID, nil, after, first, before, last, orderBy, filter)
+}
+
+// AuditEvents is the resolver for the auditEvents field.
+func (r *loadBalancerProviderResolver) AuditEvents(ctx context.Context, obj *generated.Provider, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.AuditEventOrder, filter *AuditEventFilter) (*generated.AuditEventConnection, error) {
+	if err := checkAccess(ctx, obj.ID, actionLoadBalancerProviderGetHistory); err != nil {
+		return nil, err
+	}
+
+	where, err := r.providerAuditEventsWhere(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.auditEvents(ctx, obj.ID, where, after, first, before, last, orderBy, filter)
+}
@@ -0,0 +1,214 @@
+package graphapi_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/permissions-api/pkg/permissions/mockpermissions"
+	"go.infratographer.com/x/echojwtx"
+	"go.infratographer.com/x/events"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/load-balancer-api/internal/graphclient"
+	"go.infratographer.com/load-balancer-api/internal/testutils"
+)
+
+func TestQuery_PoolAuditEvents(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	creatorCtx := context.WithValue(ctx, echojwtx.ActorCtxKey, "idntusr-creator")
+	updaterCtx := context.WithValue(ctx, echojwtx.ActorCtxKey, "idntusr-updater")
+
+	start := time.Now().Add(-time.Second)
+
+	pool1 := (&testutils.PoolBuilder{}).MustNew(creatorCtx)
+	origin1 := (&testutils.OriginBuilder{PoolID: pool1.ID, Target: "1.2.3.4"}).MustNew(creatorCtx)
+
+	_, err := graphTestClient().LoadBalancerOriginUpdate(updaterCtx, origin1.ID, graphclient.UpdateLoadBalancerOriginInput{
+		Target: newString("5.6.7.8"),
+	}, nil)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		TestName         string
+		Filter           *graphclient.AuditEventFilter
+		Checker          permissions.Checker
+		ExpectedSubjects []gidx.PrefixedID
+		errorMsg         string
+	}{
+		{
+			TestName:         "all events",
+			ExpectedSubjects: []gidx.PrefixedID{origin1.ID, origin1.ID, pool1.ID},
+		},
+		{
+			TestName:         "filter by actor",
+			Filter:           &graphclient.AuditEventFilter{Actor: newString("idntusr-updater")},
+			ExpectedSubjects: []gidx.PrefixedID{origin1.ID},
+		},
+		{
+			TestName:         "filter by field",
+			Filter:           &graphclient.AuditEventFilter{Field: newString("target")},
+			ExpectedSubjects: []gidx.PrefixedID{origin1.ID, origin1.ID},
+		},
+		{
+			TestName:         "filter by time range",
+			Filter:           &graphclient.AuditEventFilter{Since: &start, Until: &start},
+			ExpectedSubjects: []gidx.PrefixedID{},
+		},
+		{
+			TestName: "permission denied",
+			Checker:  denyActionChecker("loadbalancerpool_get_history"),
+			errorMsg: permissions.ErrPermissionDenied.Error(),
+		},
+	}
+
+	for _, tt := range testCases {
+		// lint
+		tt := tt
+
+		t.Run(tt.TestName, func(t *testing.T) {
+			ctx := ctx
+			if tt.Checker != nil {
+				ctx = context.WithValue(ctx, permissions.CheckerCtxKey, tt.Checker)
+			}
+
+			resp, err := graphTestClient().GetLoadBalancerPoolAuditEvents(ctx, pool1.ID, tt.Filter)
+			if tt.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.errorMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+
+			subjects := []gidx.PrefixedID{}
+			for _, edge := range resp.LoadBalancerPool.AuditEvents.Edges {
+				subjects = append(subjects, edge.Node.SubjectID)
+			}
+
+			assert.Equal(t, tt.ExpectedSubjects, subjects)
+		})
+	}
+
+	t.Run("records the changeset", func(t *testing.T) {
+		resp, err := graphTestClient().GetLoadBalancerPoolAuditEvents(ctx, pool1.ID, &graphclient.AuditEventFilter{Actor: newString("idntusr-updater")})
+		require.NoError(t, err)
+		require.Len(t, resp.LoadBalancerPool.AuditEvents.Edges, 1)
+
+		event := resp.LoadBalancerPool.AuditEvents.Edges[0].Node
+		assert.Equal(t, string(events.UpdateChangeType), event.Operation)
+		assert.Equal(t, "idntusr-updater", event.Actor)
+		changes := map[string][2]string{}
+		for _, fc := range event.Changeset {
+			changes[fc.Field] = [2]string{fc.PreviousValue, fc.CurrentValue}
+		}
+
+		assert.Equal(t, [2]string{"1.2.3.4", "5.6.7.8"}, changes["target"])
+	})
+}
+
+func TestQuery_LoadBalancerAuditEvents(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	lb := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+	port := (&testutils.PortBuilder{LoadBalancerID: lb.ID, Number: 80}).MustNew(ctx)
+
+	lb.Update().SetName("renamed").ExecX(ctx)
+	EntClient.Port.DeleteOneID(port.ID).ExecX(ctx)
+
+	resp, err := graphTestClient().GetLoadBalancerAuditEvents(ctx, lb.ID, nil)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+
+	operations := map[gidx.PrefixedID][]string{}
+	for _, edge := range resp.LoadBalancer.AuditEvents.Edges {
+		operations[edge.Node.SubjectID] = append(operations[edge.Node.SubjectID], edge.Node.Operation)
+	}
+
+	assert.ElementsMatch(t, []string{"create", "update"}, operations[lb.ID])
+	assert.ElementsMatch(t, []string{"create", "delete"}, operations[port.ID])
+}
+
+func TestQuery_ProviderAuditEvents(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	prov := (&testutils.ProviderBuilder{}).MustNew(ctx)
+	lb1 := (&testutils.LoadBalancerBuilder{Provider: prov, OwnerID: gidx.MustNewID(ownerPrefix)}).MustNew(ctx)
+	lb2 := (&testutils.LoadBalancerBuilder{Provider: prov, OwnerID: gidx.MustNewID(ownerPrefix)}).MustNew(ctx)
+
+	testCases := []struct {
+		TestName         string
+		Checker          permissions.Checker
+		ExpectedSubjects []gidx.PrefixedID
+	}{
+		{
+			TestName:         "all owners readable",
+			ExpectedSubjects: []gidx.PrefixedID{prov.ID, lb1.ID, lb2.ID},
+		},
+		{
+			TestName: "events of other owners are filtered",
+			Checker: func(_ context.Context, requests ...permissions.AccessRequest) error {
+				for _, req := range requests {
+					if req.ResourceID == lb2.OwnerID {
+						return permissions.ErrPermissionDenied
+					}
+				}
+
+				return nil
+			},
+			ExpectedSubjects: []gidx.PrefixedID{prov.ID, lb1.ID},
+		},
+	}
+
+	for _, tt := range testCases {
+		// lint
+		tt := tt
+
+		t.Run(tt.TestName, func(t *testing.T) {
+			ctx := ctx
+			if tt.Checker != nil {
+				ctx = context.WithValue(ctx, permissions.CheckerCtxKey, tt.Checker)
+			}
+
+			resp, err := graphTestClient().GetLoadBalancerProviderAuditEvents(ctx, prov.ID, nil)
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+
+			subjects := []gidx.PrefixedID{}
+			for _, edge := range resp.LoadBalancerProvider.AuditEvents.Edges {
+				subjects = append(subjects, edge.Node.SubjectID)
+			}
+
+			assert.ElementsMatch(t, tt.ExpectedSubjects, subjects)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package graphapi
+
+import (
+	"context"
+
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/ent/schema/validations"
+)
+
+// createLoadBalancerWithChildren creates a load balancer along with its ports, pools and origins
+// using the given transaction. Pools are created with the same owner as the load balancer.
+func createLoadBalancerWithChildren(ctx context.Context, tx *generated.Tx, input CreateLoadBalancerWithChildrenInput) (*generated.LoadBalancer, error) {
+	lb, err := tx.LoadBalancer.Create().SetInput(generated.CreateLoadBalancerInput{
+		Name:       input.Name,
+		OwnerID:    input.OwnerID,
+		LocationID: input.LocationID,
+		ProviderID: input.ProviderID,
+	}).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, portInput := range input.Ports {
+		p, err := tx.Port.Create().SetInput(generated.CreateLoadBalancerPortInput{
+			Number:         portInput.Number,
+			Name:           portInput.Name,
+			LoadBalancerID: lb.ID,
+		}).Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, poolInput := range portInput.Pools {
+			pl, err := tx.Pool.Create().SetInput(generated.CreateLoadBalancerPoolInput{
+				Name:     poolInput.Name,
+				Protocol: poolInput.Protocol,
+				OwnerID:  input.OwnerID,
+				PortIDs:  []gidx.PrefixedID{p.ID},
+			}).Save(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, originInput := range poolInput.Origins {
+				if err := validations.OriginTarget(originInput.Target, input.ProviderID); err != nil {
+					return nil, newInvalidFieldError("target", err)
+				}
+
+				var weight *int32
+
+				if originInput.Weight != nil {
+					w := int32(*originInput.Weight)
+					weight = &w
+				}
+
+				if _, err := tx.Origin.Create().SetInput(generated.CreateLoadBalancerOriginInput{
+					Name:       originInput.Name,
+					Weight:     weight,
+					Target:     originInput.Target,
+					PortNumber: originInput.PortNumber,
+					Active:     originInput.Active,
+					PoolID:     pl.ID,
+				}).Save(ctx); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return lb, nil
+}
@@ -4,6 +4,59 @@ package graphapi
 // will be copied through when generating and any unknown code will be moved to the end.
 // Code generated by github.com/99designs/gqlgen version v0.17.38
 
+import (
+	"context"
+
+	"entgo.io/contrib/entgql"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/ent/schema/softdelete"
+	"go.infratographer.com/x/gidx"
+)
+
+// Ports is the resolver for the ports field.
+func (r *loadBalancerResolver) Ports(ctx context.Context, obj *generated.LoadBalancer, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.LoadBalancerPortOrder, where *generated.LoadBalancerPortWhereInput, includeDeleted *bool) (*generated.LoadBalancerPortConnection, error) {
+	if includeDeleted == nil || !*includeDeleted {
+		return obj.Ports(ctx, after, first, before, last, orderBy, where)
+	}
+
+	if err := checkAccess(ctx, obj.OwnerID, actionLoadBalancerGetHistory); err != nil {
+		return nil, err
+	}
+
+	ctx = softdelete.SkipSoftDelete(ctx)
+
+	// the first ports of every load balancer in the response are loaded together
+	if loadsBatched(after, first, before, last) && collectsEdges(ctx) {
+		if l := loaderFor(ctx, r.loadPorts(first, orderBy, where)); l != nil {
+			return l.load(ctx, obj.ID)
+		}
+	}
+
+	return obj.QueryPorts().Paginate(ctx, after, first, before, last, generated.WithLoadBalancerPortOrder(orderBy), generated.WithLoadBalancerPortFilter(where.Filter))
+}
+
+// Origins is the resolver for the origins field.
+func (r *loadBalancerPoolResolver) Origins(ctx context.Context, obj *generated.Pool, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.LoadBalancerOriginOrder, where *generated.LoadBalancerOriginWhereInput, includeDeleted *bool) (*generated.LoadBalancerOriginConnection, error) {
+	if includeDeleted == nil || !*includeDeleted {
+		return obj.Origins(ctx, after, first, before, last, orderBy, where)
+	}
+
+	if err := checkAccess(ctx, obj.OwnerID, actionLoadBalancerPoolGetHistory); err != nil {
+		return nil, err
+	}
+
+	ctx = softdelete.SkipSoftDelete(ctx)
+
+	// the first origins of every pool in the response are loaded together
+	if loadsBatched(after, first, before, last) && collectsEdges(ctx) {
+		if l := loaderFor(ctx, r.loadOrigins(first, orderBy, where)); l != nil {
+			return l.load(ctx, obj.ID)
+		}
+	}
+
+	return obj.QueryOrigins().Paginate(ctx, after, first, before, last, generated.WithLoadBalancerOriginOrder(orderBy), generated.WithLoadBalancerOriginFilter(where.Filter))
+}
+
 // LoadBalancer returns LoadBalancerResolver implementation.
 func (r *Resolver) LoadBalancer() LoadBalancerResolver { return &loadBalancerResolver{r} }
 
@@ -0,0 +1,60 @@
+package graphapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/x/gidx"
+)
+
+// representationIDs returns the ids of the given entity representations in order
+func representationIDs[R any](reps []*R, id func(*R) gidx.PrefixedID) []gidx.PrefixedID {
+	ids := make([]gidx.PrefixedID, len(reps))
+	for i, rep := range reps {
+		ids[i] = id(rep)
+	}
+
+	return ids
+}
+
+// orderEntities returns the nodes of a batched entity lookup in the order of the requested ids.
+// Entities that don't exist or fail the access check are returned as nil and an error is added
+// to the response for each of them, so the rest of the batch still resolves.
+func orderEntities[T any](ctx context.Context, ids []gidx.PrefixedID, nodes []*T, nodeID func(*T) gidx.PrefixedID, access func(*T) permissions.AccessRequest) []*T {
+	byID := make(map[gidx.PrefixedID]*T, len(nodes))
+	requests := make([]permissions.AccessRequest, len(nodes))
+
+	for i, n := range nodes {
+		byID[nodeID(n)] = n
+		requests[i] = access(n)
+	}
+
+	denied := map[gidx.PrefixedID]error{}
+
+	for i, err := range checkEach(ctx, requests...) {
+		if err != nil {
+			denied[nodeID(nodes[i])] = err
+		}
+	}
+
+	entities := make([]*T, len(ids))
+
+	for i, id := range ids {
+		n, ok := byID[id]
+		if !ok {
+			graphql.AddError(ctx, fmt.Errorf("resolving entity %s: %w", id, ErrEntityNotFound))
+			continue
+		}
+
+		if err, ok := denied[id]; ok {
+			graphql.AddError(ctx, fmt.Errorf("resolving entity %s: %w", id, err))
+			continue
+		}
+
+		entities[i] = n
+	}
+
+	return entities
+}
@@ -8,32 +8,88 @@ import (
 	"context"
 
 	"go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/loadbalancer"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/origin"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/pool"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/port"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/provider"
+	"go.infratographer.com/permissions-api/pkg/permissions"
 	"go.infratographer.com/x/gidx"
 )
 
-// FindLoadBalancerByID is the resolver for the findLoadBalancerByID field.
-func (r *entityResolver) FindLoadBalancerByID(ctx context.Context, id gidx.PrefixedID) (*generated.LoadBalancer, error) {
-	return r.client.LoadBalancer.Get(ctx, id)
+// FindManyLoadBalancerByIDs is the resolver for the findManyLoadBalancerByIDs field.
+func (r *entityResolver) FindManyLoadBalancerByIDs(ctx context.Context, reps []*LoadBalancerByIDsInput) ([]*generated.LoadBalancer, error) {
+	ids := representationIDs(reps, func(rep *LoadBalancerByIDsInput) gidx.PrefixedID { return rep.ID })
+
+	lbs, err := r.client.LoadBalancer.Query().Where(loadbalancer.IDIn(ids...)).All(ctx)
+	if err != nil {
+		r.logger.Errorw("failed to query loadbalancer entities", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return orderEntities(ctx, ids, lbs, func(lb *generated.LoadBalancer) gidx.PrefixedID { return lb.ID }, func(lb *generated.LoadBalancer) permissions.AccessRequest {
+		return permissions.AccessRequest{ResourceID: lb.OwnerID, Action: actionLoadBalancerGet}
+	}), nil
 }
 
-// FindLoadBalancerOriginByID is the resolver for the findLoadBalancerOriginByID field.
-func (r *entityResolver) FindLoadBalancerOriginByID(ctx context.Context, id gidx.PrefixedID) (*generated.Origin, error) {
-	return r.client.Origin.Get(ctx, id)
+// FindManyLoadBalancerOriginByIDs is the resolver for the findManyLoadBalancerOriginByIDs field.
+func (r *entityResolver) FindManyLoadBalancerOriginByIDs(ctx context.Context, reps []*LoadBalancerOriginByIDsInput) ([]*generated.Origin, error) {
+	ids := representationIDs(reps, func(rep *LoadBalancerOriginByIDsInput) gidx.PrefixedID { return rep.ID })
+
+	origins, err := r.client.Origin.Query().Where(origin.IDIn(ids...)).All(ctx)
+	if err != nil {
+		r.logger.Errorw("failed to query origin entities", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return orderEntities(ctx, ids, origins, func(o *generated.Origin) gidx.PrefixedID { return o.ID }, func(o *generated.Origin) permissions.AccessRequest {
+		return permissions.AccessRequest{ResourceID: o.PoolID, Action: actionLoadBalancerOriginGet}
+	}), nil
 }
 
-// FindLoadBalancerPoolByID is the resolver for the findLoadBalancerPoolByID field.
-func (r *entityResolver) FindLoadBalancerPoolByID(ctx context.Context, id gidx.PrefixedID) (*generated.Pool, error) {
-	return r.client.Pool.Get(ctx, id)
+// FindManyLoadBalancerPoolByIDs is the resolver for the findManyLoadBalancerPoolByIDs field.
+func (r *entityResolver) FindManyLoadBalancerPoolByIDs(ctx context.Context, reps []*LoadBalancerPoolByIDsInput) ([]*generated.Pool, error) {
+	ids := representationIDs(reps, func(rep *LoadBalancerPoolByIDsInput) gidx.PrefixedID { return rep.ID })
+
+	pools, err := r.client.Pool.Query().Where(pool.IDIn(ids...)).All(ctx)
+	if err != nil {
+		r.logger.Errorw("failed to query pool entities", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return orderEntities(ctx, ids, pools, func(p *generated.Pool) gidx.PrefixedID { return p.ID }, func(p *generated.Pool) permissions.AccessRequest {
+		return permissions.AccessRequest{ResourceID: p.OwnerID, Action: actionLoadBalancerPoolGet}
+	}), nil
 }
 
-// FindLoadBalancerPortByID is the resolver for the findLoadBalancerPortByID field.
-func (r *entityResolver) FindLoadBalancerPortByID(ctx context.Context, id gidx.PrefixedID) (*generated.Port, error) {
-	return r.client.Port.Get(ctx, id)
+// FindManyLoadBalancerPortByIDs is the resolver for the findManyLoadBalancerPortByIDs field.
+func (r *entityResolver) FindManyLoadBalancerPortByIDs(ctx context.Context, reps []*LoadBalancerPortByIDsInput) ([]*generated.Port, error) {
+	ids := representationIDs(reps, func(rep *LoadBalancerPortByIDsInput) gidx.PrefixedID { return rep.ID })
+
+	ports, err := r.client.Port.Query().Where(port.IDIn(ids...)).All(ctx)
+	if err != nil {
+		r.logger.Errorw("failed to query port entities", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return orderEntities(ctx, ids, ports, func(p *generated.Port) gidx.PrefixedID { return p.ID }, func(p *generated.Port) permissions.AccessRequest {
+		return permissions.AccessRequest{ResourceID: p.LoadBalancerID, Action: actionLoadBalancerPortGet}
+	}), nil
 }
 
-// FindLoadBalancerProviderByID is the resolver for the findLoadBalancerProviderByID field.
-func (r *entityResolver) FindLoadBalancerProviderByID(ctx context.Context, id gidx.PrefixedID) (*generated.Provider, error) {
-	return r.client.Provider.Get(ctx, id)
+// FindManyLoadBalancerProviderByIDs is the resolver for the findManyLoadBalancerProviderByIDs field.
+func (r *entityResolver) FindManyLoadBalancerProviderByIDs(ctx context.Context, reps []*LoadBalancerProviderByIDsInput) ([]*generated.Provider, error) {
+	ids := representationIDs(reps, func(rep *LoadBalancerProviderByIDsInput) gidx.PrefixedID { return rep.ID })
+
+	providers, err := r.client.Provider.Query().Where(provider.IDIn(ids...)).All(ctx)
+	if err != nil {
+		r.logger.Errorw("failed to query provider entities", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return orderEntities(ctx, ids, providers, func(p *generated.Provider) gidx.PrefixedID { return p.ID }, func(p *generated.Provider) permissions.AccessRequest {
+		return permissions.AccessRequest{ResourceID: p.ID, Action: actionLoadBalancerProviderGet}
+	}), nil
 }
 
 // FindLocationByID is the resolver for the findLocationByID field.
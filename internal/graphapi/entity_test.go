@@ -0,0 +1,217 @@
+package graphapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	gqlclient "github.com/99designs/gqlgen/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/permissions-api/pkg/permissions/mockpermissions"
+	"go.infratographer.com/x/gidx"
+	"go.uber.org/zap"
+
+	"go.infratographer.com/load-balancer-api/internal/graphapi"
+	"go.infratographer.com/load-balancer-api/internal/testutils"
+)
+
+const entitiesQuery = `query($representations: [_Any!]!) {
+  _entities(representations: $representations) {
+    ... on LoadBalancer { id }
+    ... on LoadBalancerPool { id }
+    ... on LoadBalancerPort { id }
+    ... on LoadBalancerOrigin { id }
+    ... on LoadBalancerProvider { id }
+  }
+}`
+
+type entitiesResult struct {
+	ids    []*gidx.PrefixedID
+	errors []string
+}
+
+// resolveEntities runs an _entities query for the given representations using the given permissions checker
+func resolveEntities(t *testing.T, checker permissions.Checker, representations ...map[string]any) entitiesResult {
+	t.Helper()
+
+	h := graphapi.NewResolver(EntClient, zap.NewNop().Sugar()).Handler(false).Handler()
+
+	// the permissions middleware is not part of the handler, so the checker is set on the request
+	withChecker := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), permissions.CheckerCtxKey, checker)))
+	})
+
+	resp, err := gqlclient.New(withChecker).RawPost(entitiesQuery, gqlclient.Var("representations", representations))
+	require.NoError(t, err)
+
+	var data struct {
+		Entities []*struct {
+			ID gidx.PrefixedID
+		} `json:"_entities"`
+	}
+
+	raw, err := json.Marshal(resp.Data)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(raw, &data))
+
+	result := entitiesResult{}
+
+	for _, e := range data.Entities {
+		if e == nil {
+			result.ids = append(result.ids, nil)
+			continue
+		}
+
+		id := e.ID
+		result.ids = append(result.ids, &id)
+	}
+
+	if len(resp.Errors) != 0 {
+		var errs []struct {
+			Message string
+		}
+
+		require.NoError(t, json.Unmarshal(resp.Errors, &errs))
+
+		for _, e := range errs {
+			result.errors = append(result.errors, e.Message)
+		}
+	}
+
+	return result
+}
+
+func representation(typeName string, id gidx.PrefixedID) map[string]any {
+	return map[string]any{"__typename": typeName, "id": id}
+}
+
+// denyResourceChecker returns a permissions checker that denies every action on the given resource
+func denyResourceChecker(resourceID gidx.PrefixedID) permissions.Checker {
+	return func(_ context.Context, requests ...permissions.AccessRequest) error {
+		for _, req := range requests {
+			if req.ResourceID == resourceID {
+				return permissions.ErrPermissionDenied
+			}
+		}
+
+		return nil
+	}
+}
+
+func TestEntities_LoadBalancer(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	allowed := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+	other := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+	denied := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+	missing := gidx.MustNewID(lbPrefix)
+
+	t.Run("resolves every representation in order", func(t *testing.T) {
+		result := resolveEntities(t, permissions.DefaultAllowChecker,
+			representation("LoadBalancer", other.ID),
+			representation("LoadBalancer", allowed.ID),
+		)
+
+		assert.Empty(t, result.errors)
+		require.Len(t, result.ids, 2)
+		assert.Equal(t, other.ID, *result.ids[0])
+		assert.Equal(t, allowed.ID, *result.ids[1])
+	})
+
+	t.Run("returns null with an error for denied and missing representations", func(t *testing.T) {
+		result := resolveEntities(t, denyResourceChecker(denied.OwnerID),
+			representation("LoadBalancer", denied.ID),
+			representation("LoadBalancer", allowed.ID),
+			representation("LoadBalancer", missing),
+		)
+
+		require.Len(t, result.ids, 3)
+		assert.Nil(t, result.ids[0])
+		assert.Equal(t, allowed.ID, *result.ids[1])
+		assert.Nil(t, result.ids[2])
+
+		require.Len(t, result.errors, 2)
+		assert.Contains(t, result.errors[0]+result.errors[1], denied.ID.String()+": "+permissions.ErrPermissionDenied.Error())
+		assert.Contains(t, result.errors[0]+result.errors[1], missing.String()+": "+graphapi.ErrEntityNotFound.Error())
+	})
+}
+
+func TestEntities_Children(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	lb := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+	pool := (&testutils.PoolBuilder{}).MustNew(ctx)
+	port := (&testutils.PortBuilder{LoadBalancerID: lb.ID, PoolIDs: []gidx.PrefixedID{pool.ID}}).MustNew(ctx)
+	origin := (&testutils.OriginBuilder{PoolID: pool.ID}).MustNew(ctx)
+	provider := (&testutils.ProviderBuilder{}).MustNew(ctx)
+
+	testCases := []struct {
+		TestName string
+		TypeName string
+		ID       gidx.PrefixedID
+		DenyID   gidx.PrefixedID
+	}{
+		{
+			TestName: "pool",
+			TypeName: "LoadBalancerPool",
+			ID:       pool.ID,
+			DenyID:   pool.OwnerID,
+		},
+		{
+			TestName: "port",
+			TypeName: "LoadBalancerPort",
+			ID:       port.ID,
+			DenyID:   lb.ID,
+		},
+		{
+			TestName: "origin",
+			TypeName: "LoadBalancerOrigin",
+			ID:       origin.ID,
+			DenyID:   pool.ID,
+		},
+		{
+			TestName: "provider",
+			TypeName: "LoadBalancerProvider",
+			ID:       provider.ID,
+			DenyID:   provider.ID,
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+
+		t.Run(tt.TestName, func(t *testing.T) {
+			result := resolveEntities(t, permissions.DefaultAllowChecker, representation(tt.TypeName, tt.ID))
+
+			assert.Empty(t, result.errors)
+			require.Len(t, result.ids, 1)
+			require.NotNil(t, result.ids[0])
+			assert.Equal(t, tt.ID, *result.ids[0])
+
+			result = resolveEntities(t, denyResourceChecker(tt.DenyID), representation(tt.TypeName, tt.ID))
+
+			require.Len(t, result.ids, 1)
+			assert.Nil(t, result.ids[0])
+			require.Len(t, result.errors, 1)
+			assert.Contains(t, result.errors[0], permissions.ErrPermissionDenied.Error())
+		})
+	}
+}
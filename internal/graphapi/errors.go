@@ -1,8 +1,48 @@
 package graphapi
 
 import (
+	"context"
 	"errors"
 	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/load-balancer-api/internal/ent/generated"
+)
+
+// Error codes attached to the extensions of errors returned by the graph api.
+const (
+	// ErrCodeNotFound is used when the requested resource does not exist.
+	ErrCodeNotFound = "NOT_FOUND"
+
+	// ErrCodeValidation is used when the provided input is invalid.
+	ErrCodeValidation = "VALIDATION"
+
+	// ErrCodePermissionDenied is used when the subject is not allowed to perform the action.
+	ErrCodePermissionDenied = "PERMISSION_DENIED"
+
+	// ErrCodeQuotaExceeded is used when a limit has been reached.
+	ErrCodeQuotaExceeded = "QUOTA_EXCEEDED"
+
+	// ErrCodeConflict is used when the request conflicts with an existing resource.
+	ErrCodeConflict = "CONFLICT"
+
+	// ErrCodeQueryLimitExceeded is used when an operation is over a query limit.
+	ErrCodeQueryLimitExceeded = "QUERY_LIMIT_EXCEEDED"
+
+	// ErrCodeQueryNotAllowed is used when a query is not in the persisted query allowlist.
+	ErrCodeQueryNotAllowed = "QUERY_NOT_ALLOWED"
+
+	// ErrCodeInternal is used for all other errors.
+	ErrCodeInternal = "INTERNAL"
+)
+
+const (
+	errExtensionCode  = "code"
+	errExtensionField = "field"
 )
 
 var (
@@ -18,6 +58,15 @@ var (
 	// ErrPoolNotFound is returned when one or more pools are not found
 	ErrPoolNotFound = errors.New("one or more pools not found")
 
+	// ErrLoadBalancerNotFound is returned when a load balancer does not exist or the subject may not see it.
+	ErrLoadBalancerNotFound = errors.New("load balancer not found")
+
+	// ErrEntityNotFound is returned when a federation entity representation does not match a resource.
+	ErrEntityNotFound = errors.New("entity not found")
+
+	// ErrWebsocketUnauthorized is returned when a websocket connection is initialized without valid credentials.
+	ErrWebsocketUnauthorized = errors.New("unauthorized")
+
 	// ErrInternalServerError is returned when an internal error occurs.
 	ErrInternalServerError = errors.New("internal server error")
 
@@ -29,6 +78,54 @@ var (
 
 	// ErrInvalidCharacters is returned when an invalid input is provided
 	ErrInvalidCharacters = errors.New("valid characters are A-Z a-z 0-9 _ -")
+
+	// ErrInvalidSpec is returned when a load balancer spec can not be parsed.
+	ErrInvalidSpec = errors.New("invalid load balancer spec")
+
+	// ErrDuplicateSpecEntry is returned when a load balancer spec contains the same port, pool or origin more than once.
+	ErrDuplicateSpecEntry = errors.New("duplicate entry")
+
+	// ErrImmutableField is returned when a load balancer spec changes a field that can not be updated.
+	ErrImmutableField = errors.New("field can not be changed")
+
+	// ErrSharedPool is returned when a load balancer spec changes a pool that is also used by ports of other load balancers.
+	ErrSharedPool = errors.New("pool is used by other load balancers")
+
+	// ErrAmbiguousSpecEntry is returned when more than one existing resource matches an entry in a load balancer spec.
+	ErrAmbiguousSpecEntry = errors.New("more than one existing resource matches")
+
+	// ErrIdempotencyKeyReused is returned when an idempotency key is used again with a different request.
+	ErrIdempotencyKeyReused = errors.New("idempotency key already used with a different request")
+
+	// ErrIdempotencyKeyInProgress is returned when a request with the same idempotency key is still in progress.
+	ErrIdempotencyKeyInProgress = errors.New("request with the same idempotency key is in progress")
+
+	// ErrVersionMismatch is returned when the expected version of a resource does not match its current version.
+	ErrVersionMismatch = errors.New("resource version does not match the expected version")
+
+	// ErrSubscriptionsUnavailable is returned when subscriptions are not served.
+	ErrSubscriptionsUnavailable = errors.New("subscriptions are not available")
+
+	// ErrResyncFilterRequired is returned when a resync is requested without an owner, location or provider.
+	ErrResyncFilterRequired = errors.New("an owner, location or provider is required")
+
+	// ErrInvalidResyncLimit is returned when the limit of a resync is not positive.
+	ErrInvalidResyncLimit = errors.New("must be greater than zero")
+
+	// ErrQueryTooDeep is returned when an operation selects fields deeper than the maximum depth.
+	ErrQueryTooDeep = errors.New("query is too deep")
+
+	// ErrQueryTooComplex is returned when the complexity of an operation is over the budget.
+	ErrQueryTooComplex = errors.New("query is too complex")
+
+	// ErrPageSizeTooLarge is returned when a connection asks for more nodes than the maximum page size.
+	ErrPageSizeTooLarge = errors.New("page size is too large")
+
+	// ErrQueryNotAllowed is returned when the allowlist is enabled and a query is not in it.
+	ErrQueryNotAllowed = errors.New("query is not in the persisted query allowlist")
+
+	// ErrNoPersistedQueries is returned when a persisted query allowlist directory has no .graphql files.
+	ErrNoPersistedQueries = errors.New("no .graphql files found")
 )
 
 // ErrInvalidField is returned when an invalid input is provided.
@@ -42,6 +139,98 @@ func (e *ErrInvalidField) Error() string {
 	return fmt.Sprintf("%s: %v", e.field, e.err)
 }
 
+// Unwrap returns the underlying error.
+func (e *ErrInvalidField) Unwrap() error {
+	return e.err
+}
+
+// Field returns the name of the invalid field.
+func (e *ErrInvalidField) Field() string {
+	return e.field
+}
+
 func newInvalidFieldError(field string, err error) *ErrInvalidField {
 	return &ErrInvalidField{field: field, err: err}
 }
+
+// errorPresenter attaches a stable error code and, when known, the invalid
+// field to the extensions of resolver errors.
+func errorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+
+	if _, ok := gqlErr.Extensions[errExtensionCode]; ok {
+		return gqlErr
+	}
+
+	if gqlErr.Extensions == nil {
+		gqlErr.Extensions = map[string]interface{}{}
+	}
+
+	gqlErr.Extensions[errExtensionCode] = errorCode(err)
+
+	if field := errorField(err); field != "" {
+		gqlErr.Extensions[errExtensionField] = field
+	}
+
+	return gqlErr
+}
+
+// errorCode returns the error code for err.
+func errorCode(err error) string {
+	var (
+		fieldErr *ErrInvalidField
+		idErr    *gidx.ErrInvalidID
+	)
+
+	switch {
+	case errors.As(err, &fieldErr),
+		errors.As(err, &idErr),
+		generated.IsValidationError(err),
+		errors.Is(err, ErrFieldEmpty),
+		errors.Is(err, ErrInvalidCharacters),
+		errors.Is(err, ErrResyncFilterRequired),
+		errors.Is(err, ErrRestrictedPortNumber):
+		return ErrCodeValidation
+	case generated.IsNotFound(err),
+		errors.Is(err, ErrPortNotFound),
+		errors.Is(err, ErrPoolNotFound),
+		errors.Is(err, ErrLoadBalancerNotFound),
+		errors.Is(err, ErrEntityNotFound):
+		return ErrCodeNotFound
+	case errors.Is(err, permissions.ErrPermissionDenied):
+		return ErrCodePermissionDenied
+	case errors.Is(err, ErrLoadBalancerLimitReached):
+		return ErrCodeQuotaExceeded
+	case generated.IsConstraintError(err),
+		errors.Is(err, ErrPortNumberInUse),
+		errors.Is(err, ErrIdempotencyKeyReused),
+		errors.Is(err, ErrIdempotencyKeyInProgress),
+		errors.Is(err, ErrVersionMismatch):
+		return ErrCodeConflict
+	case errors.Is(err, ErrQueryTooDeep),
+		errors.Is(err, ErrQueryTooComplex),
+		errors.Is(err, ErrPageSizeTooLarge):
+		return ErrCodeQueryLimitExceeded
+	case errors.Is(err, ErrQueryNotAllowed):
+		return ErrCodeQueryNotAllowed
+	default:
+		return ErrCodeInternal
+	}
+}
+
+// errorField returns the name of the invalid field for err, if any.
+func errorField(err error) string {
+	var (
+		fieldErr      *ErrInvalidField
+		validationErr *generated.ValidationError
+	)
+
+	switch {
+	case errors.As(err, &fieldErr):
+		return fieldErr.Field()
+	case errors.As(err, &validationErr):
+		return validationErr.Name
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,180 @@
+package graphapi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Yamashou/gqlgenc/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/permissions-api/pkg/permissions/mockpermissions"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/load-balancer-api/internal/config"
+	"go.infratographer.com/load-balancer-api/internal/graphapi"
+	"go.infratographer.com/load-balancer-api/internal/graphclient"
+	"go.infratographer.com/load-balancer-api/internal/testutils"
+)
+
+func TestErrorCodes(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	lb := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+	port := (&testutils.PortBuilder{LoadBalancerID: lb.ID, Number: 443}).MustNew(ctx)
+
+	config.AppConfig.RestrictedPorts = []int{1234}
+
+	testCases := []struct {
+		TestName      string
+		Do            func(ctx context.Context) error
+		Checker       permissions.Checker
+		LBLimit       int
+		ExpectedCode  string
+		ExpectedField string
+	}{
+		{
+			TestName: "not found",
+			Do: func(ctx context.Context) error {
+				_, err := graphTestClient().GetLoadBalancer(ctx, gidx.MustNewID(lbPrefix))
+				return err
+			},
+			ExpectedCode: graphapi.ErrCodeNotFound,
+		},
+		{
+			TestName: "invalid id",
+			Do: func(ctx context.Context) error {
+				_, err := graphTestClient().LoadBalancerPoolDelete(ctx, "badprefix-test", nil)
+				return err
+			},
+			ExpectedCode:  graphapi.ErrCodeValidation,
+			ExpectedField: "id",
+		},
+		{
+			TestName: "ent validation",
+			Do: func(ctx context.Context) error {
+				_, err := graphTestClient().LoadBalancerPortCreate(ctx, graphclient.CreateLoadBalancerPortInput{
+					LoadBalancerID: lb.ID,
+					Number:         70000,
+				})
+				return err
+			},
+			ExpectedCode:  graphapi.ErrCodeValidation,
+			ExpectedField: "number",
+		},
+		{
+			TestName: "restricted port",
+			Do: func(ctx context.Context) error {
+				_, err := graphTestClient().LoadBalancerPortCreate(ctx, graphclient.CreateLoadBalancerPortInput{
+					LoadBalancerID: lb.ID,
+					Number:         1234,
+				})
+				return err
+			},
+			ExpectedCode:  graphapi.ErrCodeValidation,
+			ExpectedField: "number",
+		},
+		{
+			TestName: "permission denied",
+			Do: func(ctx context.Context) error {
+				_, err := graphTestClient().GetLoadBalancer(ctx, lb.ID)
+				return err
+			},
+			Checker:      denyActionChecker("loadbalancer_get"),
+			ExpectedCode: graphapi.ErrCodePermissionDenied,
+		},
+		{
+			TestName: "quota exceeded",
+			Do: func(ctx context.Context) error {
+				_, err := graphTestClient().LoadBalancerCreate(ctx, graphclient.CreateLoadBalancerInput{
+					Name:       "lb",
+					OwnerID:    lb.OwnerID,
+					LocationID: lb.LocationID,
+					ProviderID: lb.ProviderID,
+				})
+				return err
+			},
+			LBLimit:      1,
+			ExpectedCode: graphapi.ErrCodeQuotaExceeded,
+		},
+		{
+			TestName: "conflict",
+			Do: func(ctx context.Context) error {
+				_, err := graphTestClient().LoadBalancerPortCreate(ctx, graphclient.CreateLoadBalancerPortInput{
+					LoadBalancerID: lb.ID,
+					Number:         int64(port.Number),
+				})
+				return err
+			},
+			ExpectedCode: graphapi.ErrCodeConflict,
+		},
+		{
+			TestName: "idempotency key reused",
+			Do: func(ctx context.Context) error {
+				key := "error-codes"
+
+				for _, name := range []string{"original", "changed"} {
+					if _, err := graphTestClient().LoadBalancerPortCreate(ctx, graphclient.CreateLoadBalancerPortInput{
+						LoadBalancerID: lb.ID,
+						Number:         8443,
+						Name:           &name,
+						IdempotencyKey: &key,
+					}); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			},
+			ExpectedCode: graphapi.ErrCodeConflict,
+		},
+		{
+			TestName: "version mismatch",
+			Do: func(ctx context.Context) error {
+				version := int64(0)
+
+				_, err := graphTestClient().LoadBalancerPortUpdate(ctx, port.ID, graphclient.UpdateLoadBalancerPortInput{}, &version)
+				return err
+			},
+			ExpectedCode: graphapi.ErrCodeConflict,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			ctx := ctx
+			if tt.Checker != nil {
+				ctx = context.WithValue(ctx, permissions.CheckerCtxKey, tt.Checker)
+			}
+
+			config.AppConfig.LoadBalancerLimit = tt.LBLimit
+			defer func() { config.AppConfig.LoadBalancerLimit = 0 }()
+
+			err := tt.Do(ctx)
+			require.Error(t, err)
+
+			var errResp *client.ErrorResponse
+			require.True(t, errors.As(err, &errResp))
+			require.NotNil(t, errResp.GqlErrors)
+			require.Len(t, *errResp.GqlErrors, 1)
+
+			gqlErr := (*errResp.GqlErrors)[0]
+			assert.Equal(t, tt.ExpectedCode, gqlErr.Extensions["code"])
+
+			if tt.ExpectedField != "" {
+				assert.Equal(t, tt.ExpectedField, gqlErr.Extensions["field"])
+			} else {
+				assert.NotContains(t, gqlErr.Extensions, "field")
+			}
+		})
+	}
+}
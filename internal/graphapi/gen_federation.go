@@ -65,6 +65,16 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 
 	isMulti := func(typeName string) bool {
 		switch typeName {
+		case "LoadBalancer":
+			return true
+		case "LoadBalancerOrigin":
+			return true
+		case "LoadBalancerPool":
+			return true
+		case "LoadBalancerPort":
+			return true
+		case "LoadBalancerProvider":
+			return true
 		default:
 			return false
 		}
@@ -80,161 +90,181 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 		}()
 
 		switch typeName {
-		case "LoadBalancer":
-			resolverName, err := entityResolverNameForLoadBalancer(ctx, rep)
+		case "Location":
+			resolverName, err := entityResolverNameForLocation(ctx, rep)
 			if err != nil {
-				return fmt.Errorf(`finding resolver for Entity "LoadBalancer": %w`, err)
+				return fmt.Errorf(`finding resolver for Entity "Location": %w`, err)
 			}
 			switch resolverName {
 
-			case "findLoadBalancerByID":
+			case "findLocationByID":
 				id0, err := ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, rep["id"])
 				if err != nil {
-					return fmt.Errorf(`unmarshalling param 0 for findLoadBalancerByID(): %w`, err)
+					return fmt.Errorf(`unmarshalling param 0 for findLocationByID(): %w`, err)
 				}
-				entity, err := ec.resolvers.Entity().FindLoadBalancerByID(ctx, id0)
+				entity, err := ec.resolvers.Entity().FindLocationByID(ctx, id0)
 				if err != nil {
-					return fmt.Errorf(`resolving Entity "LoadBalancer": %w`, err)
+					return fmt.Errorf(`resolving Entity "Location": %w`, err)
 				}
 
 				list[idx[i]] = entity
 				return nil
 			}
-		case "LoadBalancerOrigin":
-			resolverName, err := entityResolverNameForLoadBalancerOrigin(ctx, rep)
+		case "ResourceOwner":
+			resolverName, err := entityResolverNameForResourceOwner(ctx, rep)
 			if err != nil {
-				return fmt.Errorf(`finding resolver for Entity "LoadBalancerOrigin": %w`, err)
+				return fmt.Errorf(`finding resolver for Entity "ResourceOwner": %w`, err)
 			}
 			switch resolverName {
 
-			case "findLoadBalancerOriginByID":
+			case "findResourceOwnerByID":
 				id0, err := ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, rep["id"])
 				if err != nil {
-					return fmt.Errorf(`unmarshalling param 0 for findLoadBalancerOriginByID(): %w`, err)
+					return fmt.Errorf(`unmarshalling param 0 for findResourceOwnerByID(): %w`, err)
 				}
-				entity, err := ec.resolvers.Entity().FindLoadBalancerOriginByID(ctx, id0)
+				entity, err := ec.resolvers.Entity().FindResourceOwnerByID(ctx, id0)
 				if err != nil {
-					return fmt.Errorf(`resolving Entity "LoadBalancerOrigin": %w`, err)
+					return fmt.Errorf(`resolving Entity "ResourceOwner": %w`, err)
 				}
 
 				list[idx[i]] = entity
 				return nil
 			}
-		case "LoadBalancerPool":
-			resolverName, err := entityResolverNameForLoadBalancerPool(ctx, rep)
-			if err != nil {
-				return fmt.Errorf(`finding resolver for Entity "LoadBalancerPool": %w`, err)
+
+		}
+		return fmt.Errorf("%w: %s", ErrUnknownType, typeName)
+	}
+
+	resolveManyEntities := func(ctx context.Context, typeName string, reps []map[string]interface{}, idx []int) (err error) {
+		// we need to do our own panic handling, because we may be called in a
+		// goroutine, where the usual panic handling can't catch us
+		defer func() {
+			if r := recover(); r != nil {
+				err = ec.Recover(ctx, r)
 			}
-			switch resolverName {
+		}()
+
+		switch typeName {
+
+		case "LoadBalancer":
+			_reps := make([]*LoadBalancerByIDsInput, len(reps))
 
-			case "findLoadBalancerPoolByID":
+			for i, rep := range reps {
 				id0, err := ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, rep["id"])
 				if err != nil {
-					return fmt.Errorf(`unmarshalling param 0 for findLoadBalancerPoolByID(): %w`, err)
-				}
-				entity, err := ec.resolvers.Entity().FindLoadBalancerPoolByID(ctx, id0)
-				if err != nil {
-					return fmt.Errorf(`resolving Entity "LoadBalancerPool": %w`, err)
+					return errors.New(fmt.Sprintf("Field %s undefined in schema.", "id"))
 				}
 
-				list[idx[i]] = entity
-				return nil
+				_reps[i] = &LoadBalancerByIDsInput{
+					ID: id0,
+				}
 			}
-		case "LoadBalancerPort":
-			resolverName, err := entityResolverNameForLoadBalancerPort(ctx, rep)
+
+			entities, err := ec.resolvers.Entity().FindManyLoadBalancerByIDs(ctx, _reps)
 			if err != nil {
-				return fmt.Errorf(`finding resolver for Entity "LoadBalancerPort": %w`, err)
+				return err
 			}
-			switch resolverName {
 
-			case "findLoadBalancerPortByID":
+			for i, entity := range entities {
+				list[idx[i]] = entity
+			}
+			return nil
+
+		case "LoadBalancerOrigin":
+			_reps := make([]*LoadBalancerOriginByIDsInput, len(reps))
+
+			for i, rep := range reps {
 				id0, err := ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, rep["id"])
 				if err != nil {
-					return fmt.Errorf(`unmarshalling param 0 for findLoadBalancerPortByID(): %w`, err)
-				}
-				entity, err := ec.resolvers.Entity().FindLoadBalancerPortByID(ctx, id0)
-				if err != nil {
-					return fmt.Errorf(`resolving Entity "LoadBalancerPort": %w`, err)
+					return errors.New(fmt.Sprintf("Field %s undefined in schema.", "id"))
 				}
 
-				list[idx[i]] = entity
-				return nil
+				_reps[i] = &LoadBalancerOriginByIDsInput{
+					ID: id0,
+				}
 			}
-		case "LoadBalancerProvider":
-			resolverName, err := entityResolverNameForLoadBalancerProvider(ctx, rep)
+
+			entities, err := ec.resolvers.Entity().FindManyLoadBalancerOriginByIDs(ctx, _reps)
 			if err != nil {
-				return fmt.Errorf(`finding resolver for Entity "LoadBalancerProvider": %w`, err)
+				return err
 			}
-			switch resolverName {
 
-			case "findLoadBalancerProviderByID":
+			for i, entity := range entities {
+				list[idx[i]] = entity
+			}
+			return nil
+
+		case "LoadBalancerPool":
+			_reps := make([]*LoadBalancerPoolByIDsInput, len(reps))
+
+			for i, rep := range reps {
 				id0, err := ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, rep["id"])
 				if err != nil {
-					return fmt.Errorf(`unmarshalling param 0 for findLoadBalancerProviderByID(): %w`, err)
-				}
-				entity, err := ec.resolvers.Entity().FindLoadBalancerProviderByID(ctx, id0)
-				if err != nil {
-					return fmt.Errorf(`resolving Entity "LoadBalancerProvider": %w`, err)
+					return errors.New(fmt.Sprintf("Field %s undefined in schema.", "id"))
 				}
 
-				list[idx[i]] = entity
-				return nil
+				_reps[i] = &LoadBalancerPoolByIDsInput{
+					ID: id0,
+				}
 			}
-		case "Location":
-			resolverName, err := entityResolverNameForLocation(ctx, rep)
+
+			entities, err := ec.resolvers.Entity().FindManyLoadBalancerPoolByIDs(ctx, _reps)
 			if err != nil {
-				return fmt.Errorf(`finding resolver for Entity "Location": %w`, err)
+				return err
 			}
-			switch resolverName {
 
-			case "findLocationByID":
+			for i, entity := range entities {
+				list[idx[i]] = entity
+			}
+			return nil
+
+		case "LoadBalancerPort":
+			_reps := make([]*LoadBalancerPortByIDsInput, len(reps))
+
+			for i, rep := range reps {
 				id0, err := ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, rep["id"])
 				if err != nil {
-					return fmt.Errorf(`unmarshalling param 0 for findLocationByID(): %w`, err)
-				}
-				entity, err := ec.resolvers.Entity().FindLocationByID(ctx, id0)
-				if err != nil {
-					return fmt.Errorf(`resolving Entity "Location": %w`, err)
+					return errors.New(fmt.Sprintf("Field %s undefined in schema.", "id"))
 				}
 
-				list[idx[i]] = entity
-				return nil
+				_reps[i] = &LoadBalancerPortByIDsInput{
+					ID: id0,
+				}
 			}
-		case "ResourceOwner":
-			resolverName, err := entityResolverNameForResourceOwner(ctx, rep)
+
+			entities, err := ec.resolvers.Entity().FindManyLoadBalancerPortByIDs(ctx, _reps)
 			if err != nil {
-				return fmt.Errorf(`finding resolver for Entity "ResourceOwner": %w`, err)
+				return err
 			}
-			switch resolverName {
 
-			case "findResourceOwnerByID":
+			for i, entity := range entities {
+				list[idx[i]] = entity
+			}
+			return nil
+
+		case "LoadBalancerProvider":
+			_reps := make([]*LoadBalancerProviderByIDsInput, len(reps))
+
+			for i, rep := range reps {
 				id0, err := ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, rep["id"])
 				if err != nil {
-					return fmt.Errorf(`unmarshalling param 0 for findResourceOwnerByID(): %w`, err)
-				}
-				entity, err := ec.resolvers.Entity().FindResourceOwnerByID(ctx, id0)
-				if err != nil {
-					return fmt.Errorf(`resolving Entity "ResourceOwner": %w`, err)
+					return errors.New(fmt.Sprintf("Field %s undefined in schema.", "id"))
 				}
 
-				list[idx[i]] = entity
-				return nil
+				_reps[i] = &LoadBalancerProviderByIDsInput{
+					ID: id0,
+				}
 			}
 
-		}
-		return fmt.Errorf("%w: %s", ErrUnknownType, typeName)
-	}
-
-	resolveManyEntities := func(ctx context.Context, typeName string, reps []map[string]interface{}, idx []int) (err error) {
-		// we need to do our own panic handling, because we may be called in a
-		// goroutine, where the usual panic handling can't catch us
-		defer func() {
-			if r := recover(); r != nil {
-				err = ec.Recover(ctx, r)
+			entities, err := ec.resolvers.Entity().FindManyLoadBalancerProviderByIDs(ctx, _reps)
+			if err != nil {
+				return err
 			}
-		}()
 
-		switch typeName {
+			for i, entity := range entities {
+				list[idx[i]] = entity
+			}
+			return nil
 
 		default:
 			return errors.New("unknown type: " + typeName)
@@ -301,7 +331,7 @@ func entityResolverNameForLoadBalancer(ctx context.Context, rep map[string]inter
 		if _, ok = m["id"]; !ok {
 			break
 		}
-		return "findLoadBalancerByID", nil
+		return "findManyLoadBalancerByIDs", nil
 	}
 	return "", fmt.Errorf("%w for LoadBalancer", ErrTypeNotFound)
 }
@@ -318,7 +348,7 @@ func entityResolverNameForLoadBalancerOrigin(ctx context.Context, rep map[string
 		if _, ok = m["id"]; !ok {
 			break
 		}
-		return "findLoadBalancerOriginByID", nil
+		return "findManyLoadBalancerOriginByIDs", nil
 	}
 	return "", fmt.Errorf("%w for LoadBalancerOrigin", ErrTypeNotFound)
 }
@@ -335,7 +365,7 @@ func entityResolverNameForLoadBalancerPool(ctx context.Context, rep map[string]i
 		if _, ok = m["id"]; !ok {
 			break
 		}
-		return "findLoadBalancerPoolByID", nil
+		return "findManyLoadBalancerPoolByIDs", nil
 	}
 	return "", fmt.Errorf("%w for LoadBalancerPool", ErrTypeNotFound)
 }
@@ -352,7 +382,7 @@ func entityResolverNameForLoadBalancerPort(ctx context.Context, rep map[string]i
 		if _, ok = m["id"]; !ok {
 			break
 		}
-		return "findLoadBalancerPortByID", nil
+		return "findManyLoadBalancerPortByIDs", nil
 	}
 	return "", fmt.Errorf("%w for LoadBalancerPort", ErrTypeNotFound)
 }
@@ -369,7 +399,7 @@ func entityResolverNameForLoadBalancerProvider(ctx context.Context, rep map[stri
 		if _, ok = m["id"]; !ok {
 			break
 		}
-		return "findLoadBalancerProviderByID", nil
+		return "findManyLoadBalancerProviderByIDs", nil
 	}
 	return "", fmt.Errorf("%w for LoadBalancerProvider", ErrTypeNotFound)
 }
@@ -3,10 +3,122 @@
 package graphapi
 
 import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
 	"go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/pool"
+	"go.infratographer.com/x/events"
 	"go.infratographer.com/x/gidx"
 )
 
+// Filtering options for audit events.
+type AuditEventFilter struct {
+	// Only return events made by the given actor.
+	Actor *string `json:"actor,omitempty"`
+	// Only return events that changed the given field.
+	Field *string `json:"field,omitempty"`
+	// Only return events made at or after the given time.
+	Since *time.Time `json:"since,omitempty"`
+	// Only return events made before the given time.
+	Until *time.Time `json:"until,omitempty"`
+}
+
+// Input information to create an origin as part of loadBalancerCreateWithChildren.
+type CreateLoadBalancerChildOriginInput struct {
+	// The name of the origin.
+	Name string `json:"name"`
+	// The weight of the origin.
+	Weight *int `json:"weight,omitempty"`
+	// The target of the origin.
+	Target string `json:"target"`
+	// The port number of the origin.
+	PortNumber int `json:"portNumber"`
+	// Whether the origin is active.
+	Active *bool `json:"active,omitempty"`
+}
+
+// Input information to create a pool as part of loadBalancerCreateWithChildren.
+type CreateLoadBalancerChildPoolInput struct {
+	// The name of the pool.
+	Name string `json:"name"`
+	// The protocol for the pool.
+	Protocol pool.Protocol `json:"protocol"`
+	// The origins to create in the pool.
+	Origins []*CreateLoadBalancerChildOriginInput `json:"origins,omitempty"`
+}
+
+// Input information to create a port as part of loadBalancerCreateWithChildren.
+type CreateLoadBalancerChildPortInput struct {
+	// The port number.
+	Number int `json:"number"`
+	// The name of the port.
+	Name *string `json:"name,omitempty"`
+	// The pools to create and assign to the port.
+	Pools []*CreateLoadBalancerChildPoolInput `json:"pools,omitempty"`
+}
+
+// Input information to create a load balancer along with its ports, pools and origins.
+type CreateLoadBalancerWithChildrenInput struct {
+	// The name of the load balancer.
+	Name string `json:"name"`
+	// The ID for the owner for this load balancer and its pools.
+	OwnerID gidx.PrefixedID `json:"ownerID"`
+	// The ID for the location of this load balancer.
+	LocationID gidx.PrefixedID `json:"locationID"`
+	// The ID for the provider of this load balancer.
+	ProviderID gidx.PrefixedID `json:"providerID"`
+	// The ports to create on the load balancer.
+	Ports []*CreateLoadBalancerChildPortInput `json:"ports,omitempty"`
+	// A client provided key that makes the create safe to retry. Repeating the request with the same key returns the originally created resource.
+	IdempotencyKey *string `json:"idempotencyKey,omitempty"`
+}
+
+// A single change made, or planned, when applying a load balancer spec.
+type LoadBalancerApplyChange struct {
+	// The type of change.
+	Action LoadBalancerApplyAction `json:"action"`
+	// The type of resource being changed, one of load-balancer, load-balancer-port, load-balancer-pool or load-balancer-origin.
+	ResourceType string `json:"resourceType"`
+	// The ID of the existing resource. Not set for resources that are created.
+	ID *gidx.PrefixedID `json:"id,omitempty"`
+	// The key used to match the resource to the spec: the load balancer name, the port number, the pool name or
+	// the pool and origin names separated by a slash.
+	Key string `json:"key"`
+	// The fields that are changed.
+	Fields []*events.FieldChange `json:"fields"`
+}
+
+// Return response from loadBalancerApply
+type LoadBalancerApplyPayload struct {
+	// The load balancer. Not set on a dry run of a load balancer that does not exist yet.
+	LoadBalancer *generated.LoadBalancer `json:"loadBalancer,omitempty"`
+	// Whether the changes were only planned.
+	DryRun bool `json:"dryRun"`
+	// The changes made, or planned on a dry run, in the order they are applied.
+	Changes []*LoadBalancerApplyChange `json:"changes"`
+}
+
+type LoadBalancerByIDsInput struct {
+	ID gidx.PrefixedID `json:"ID"`
+}
+
+// A change to a load balancer or one of its ports, pools or origins.
+type LoadBalancerChange struct {
+	// The type of the change: create, update or delete.
+	EventType string `json:"eventType"`
+	// The ID of the changed resource, the load balancer or one of its ports, pools or origins.
+	SubjectID gidx.PrefixedID `json:"subjectID"`
+	// The ID of the load balancer the change applies to.
+	LoadBalancerID gidx.PrefixedID `json:"loadBalancerID"`
+	// The load balancer after the change, empty once it has been deleted.
+	LoadBalancer *generated.LoadBalancer `json:"loadBalancer,omitempty"`
+	// The time the change was made.
+	Timestamp time.Time `json:"timestamp"`
+}
+
 // Return response from loadBalancerCreate
 type LoadBalancerCreatePayload struct {
 	// The created load balancer.
@@ -19,6 +131,10 @@ type LoadBalancerDeletePayload struct {
 	DeletedID gidx.PrefixedID `json:"deletedID"`
 }
 
+type LoadBalancerOriginByIDsInput struct {
+	ID gidx.PrefixedID `json:"ID"`
+}
+
 // Return response from loadBalancerOriginCreate
 type LoadBalancerOriginCreatePayload struct {
 	// The created pool origin.
@@ -37,6 +153,10 @@ type LoadBalancerOriginUpdatePayload struct {
 	LoadBalancerOrigin *generated.Origin `json:"loadBalancerOrigin"`
 }
 
+type LoadBalancerPoolByIDsInput struct {
+	ID gidx.PrefixedID `json:"ID"`
+}
+
 // Return response from LoadBalancerPoolCreate
 type LoadBalancerPoolCreatePayload struct {
 	// The created pool.
@@ -55,6 +175,10 @@ type LoadBalancerPoolUpdatePayload struct {
 	LoadBalancerPool *generated.Pool `json:"loadBalancerPool"`
 }
 
+type LoadBalancerPortByIDsInput struct {
+	ID gidx.PrefixedID `json:"ID"`
+}
+
 // Return response from loadBalancerPortCreate
 type LoadBalancerPortCreatePayload struct {
 	// The created load balancer port.
@@ -73,6 +197,10 @@ type LoadBalancerPortUpdatePayload struct {
 	LoadBalancerPort *generated.Port `json:"loadBalancerPort"`
 }
 
+type LoadBalancerProviderByIDsInput struct {
+	ID gidx.PrefixedID `json:"ID"`
+}
+
 // Return response from loadBalancerProviderCreate
 type LoadBalancerProviderCreatePayload struct {
 	// The created load balancer provider.
@@ -91,6 +219,28 @@ type LoadBalancerProviderUpdatePayload struct {
 	LoadBalancerProvider *generated.Provider `json:"loadBalancerProvider"`
 }
 
+// Input information to resync load balancer resources.
+type LoadBalancerResyncInput struct {
+	// Only resync the resources of this owner.
+	OwnerID *gidx.PrefixedID `json:"ownerID,omitempty"`
+	// Only resync the resources in this location.
+	LocationID *gidx.PrefixedID `json:"locationID,omitempty"`
+	// Only resync the resources of this provider.
+	ProviderID *gidx.PrefixedID `json:"providerID,omitempty"`
+	// The cursor returned by a previous resync to continue after.
+	After *gidx.PrefixedID `json:"after,omitempty"`
+	// The maximum number of messages to publish. Defaults to 100 and is capped at 1000.
+	Limit *int `json:"limit,omitempty"`
+}
+
+// Return response from loadBalancerResync
+type LoadBalancerResyncPayload struct {
+	// The number of messages published.
+	Published int `json:"published"`
+	// The cursor to continue the resync from. Not set once all resources have been published.
+	Cursor *gidx.PrefixedID `json:"cursor,omitempty"`
+}
+
 // Return response from loadBalancerUpdate
 type LoadBalancerUpdatePayload struct {
 	// The updated load balancer.
@@ -105,3 +255,47 @@ type ResourceOwner struct {
 }
 
 func (ResourceOwner) IsEntity() {}
+
+// The type of change made to a resource when applying a load balancer spec.
+type LoadBalancerApplyAction string
+
+const (
+	LoadBalancerApplyActionCreate LoadBalancerApplyAction = "CREATE"
+	LoadBalancerApplyActionUpdate LoadBalancerApplyAction = "UPDATE"
+	LoadBalancerApplyActionDelete LoadBalancerApplyAction = "DELETE"
+)
+
+var AllLoadBalancerApplyAction = []LoadBalancerApplyAction{
+	LoadBalancerApplyActionCreate,
+	LoadBalancerApplyActionUpdate,
+	LoadBalancerApplyActionDelete,
+}
+
+func (e LoadBalancerApplyAction) IsValid() bool {
+	switch e {
+	case LoadBalancerApplyActionCreate, LoadBalancerApplyActionUpdate, LoadBalancerApplyActionDelete:
+		return true
+	}
+	return false
+}
+
+func (e LoadBalancerApplyAction) String() string {
+	return string(e)
+}
+
+func (e *LoadBalancerApplyAction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LoadBalancerApplyAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LoadBalancerApplyAction", str)
+	}
+	return nil
+}
+
+func (e LoadBalancerApplyAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
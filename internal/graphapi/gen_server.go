@@ -5,8 +5,10 @@ package graphapi
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -20,6 +22,8 @@ import (
 	"github.com/vektah/gqlparser/v2/ast"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/pool"
+	"go.infratographer.com/x/entx"
+	"go.infratographer.com/x/events"
 	"go.infratographer.com/x/gidx"
 )
 
@@ -49,6 +53,7 @@ type ResolverRoot interface {
 	Mutation() MutationResolver
 	Query() QueryResolver
 	ResourceOwner() ResourceOwnerResolver
+	Subscription() SubscriptionResolver
 }
 
 type DirectiveRoot struct {
@@ -56,29 +61,79 @@ type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	AuditEvent struct {
+		Actor     func(childComplexity int) int
+		Changeset func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Operation func(childComplexity int) int
+		SubjectID func(childComplexity int) int
+	}
+
+	AuditEventConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	AuditEventEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	AuditFieldChange struct {
+		CurrentValue  func(childComplexity int) int
+		Field         func(childComplexity int) int
+		PreviousValue func(childComplexity int) int
+	}
+
 	Entity struct {
-		FindLoadBalancerByID         func(childComplexity int, id gidx.PrefixedID) int
-		FindLoadBalancerOriginByID   func(childComplexity int, id gidx.PrefixedID) int
-		FindLoadBalancerPoolByID     func(childComplexity int, id gidx.PrefixedID) int
-		FindLoadBalancerPortByID     func(childComplexity int, id gidx.PrefixedID) int
-		FindLoadBalancerProviderByID func(childComplexity int, id gidx.PrefixedID) int
-		FindLocationByID             func(childComplexity int, id gidx.PrefixedID) int
-		FindResourceOwnerByID        func(childComplexity int, id gidx.PrefixedID) int
+		FindLocationByID                  func(childComplexity int, id gidx.PrefixedID) int
+		FindManyLoadBalancerByIDs         func(childComplexity int, reps []*LoadBalancerByIDsInput) int
+		FindManyLoadBalancerOriginByIDs   func(childComplexity int, reps []*LoadBalancerOriginByIDsInput) int
+		FindManyLoadBalancerPoolByIDs     func(childComplexity int, reps []*LoadBalancerPoolByIDsInput) int
+		FindManyLoadBalancerPortByIDs     func(childComplexity int, reps []*LoadBalancerPortByIDsInput) int
+		FindManyLoadBalancerProviderByIDs func(childComplexity int, reps []*LoadBalancerProviderByIDsInput) int
+		FindResourceOwnerByID             func(childComplexity int, id gidx.PrefixedID) int
 	}
 
 	LoadBalancer struct {
-		CreatedAt func(childComplexity int) int
-		CreatedBy func(childComplexity int) int
-		DeletedAt func(childComplexity int) int
-		DeletedBy func(childComplexity int) int
-		ID        func(childComplexity int) int
-		Location  func(childComplexity int) int
-		Name      func(childComplexity int) int
-		Owner     func(childComplexity int) int
-		Ports     func(childComplexity int, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.LoadBalancerPortOrder, where *generated.LoadBalancerPortWhereInput) int
-		Provider  func(childComplexity int) int
-		UpdatedAt func(childComplexity int) int
-		UpdatedBy func(childComplexity int) int
+		AuditEvents func(childComplexity int, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.AuditEventOrder, filter *AuditEventFilter) int
+		CreatedAt   func(childComplexity int) int
+		CreatedBy   func(childComplexity int) int
+		DeletedAt   func(childComplexity int) int
+		DeletedBy   func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Location    func(childComplexity int) int
+		Name        func(childComplexity int) int
+		Owner       func(childComplexity int) int
+		Ports       func(childComplexity int, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.LoadBalancerPortOrder, where *generated.LoadBalancerPortWhereInput, includeDeleted *bool) int
+		Provider    func(childComplexity int) int
+		UpdatedAt   func(childComplexity int) int
+		UpdatedBy   func(childComplexity int) int
+		Version     func(childComplexity int) int
+	}
+
+	LoadBalancerApplyChange struct {
+		Action       func(childComplexity int) int
+		Fields       func(childComplexity int) int
+		ID           func(childComplexity int) int
+		Key          func(childComplexity int) int
+		ResourceType func(childComplexity int) int
+	}
+
+	LoadBalancerApplyPayload struct {
+		Changes      func(childComplexity int) int
+		DryRun       func(childComplexity int) int
+		LoadBalancer func(childComplexity int) int
+	}
+
+	LoadBalancerChange struct {
+		EventType      func(childComplexity int) int
+		LoadBalancer   func(childComplexity int) int
+		LoadBalancerID func(childComplexity int) int
+		SubjectID      func(childComplexity int) int
+		Timestamp      func(childComplexity int) int
 	}
 
 	LoadBalancerConnection struct {
@@ -114,6 +169,7 @@ type ComplexityRoot struct {
 		Target     func(childComplexity int) int
 		UpdatedAt  func(childComplexity int) int
 		UpdatedBy  func(childComplexity int) int
+		Version    func(childComplexity int) int
 		Weight     func(childComplexity int) int
 	}
 
@@ -141,19 +197,21 @@ type ComplexityRoot struct {
 	}
 
 	LoadBalancerPool struct {
-		CreatedAt func(childComplexity int) int
-		CreatedBy func(childComplexity int) int
-		DeletedAt func(childComplexity int) int
-		DeletedBy func(childComplexity int) int
-		ID        func(childComplexity int) int
-		Name      func(childComplexity int) int
-		Origins   func(childComplexity int, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.LoadBalancerOriginOrder, where *generated.LoadBalancerOriginWhereInput) int
-		Owner     func(childComplexity int) int
-		OwnerID   func(childComplexity int) int
-		Ports     func(childComplexity int) int
-		Protocol  func(childComplexity int) int
-		UpdatedAt func(childComplexity int) int
-		UpdatedBy func(childComplexity int) int
+		AuditEvents func(childComplexity int, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.AuditEventOrder, filter *AuditEventFilter) int
+		CreatedAt   func(childComplexity int) int
+		CreatedBy   func(childComplexity int) int
+		DeletedAt   func(childComplexity int) int
+		DeletedBy   func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Name        func(childComplexity int) int
+		Origins     func(childComplexity int, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.LoadBalancerOriginOrder, where *generated.LoadBalancerOriginWhereInput, includeDeleted *bool) int
+		Owner       func(childComplexity int) int
+		OwnerID     func(childComplexity int) int
+		Ports       func(childComplexity int) int
+		Protocol    func(childComplexity int) int
+		UpdatedAt   func(childComplexity int) int
+		UpdatedBy   func(childComplexity int) int
+		Version     func(childComplexity int) int
 	}
 
 	LoadBalancerPoolConnection struct {
@@ -192,6 +250,7 @@ type ComplexityRoot struct {
 		Pools          func(childComplexity int) int
 		UpdatedAt      func(childComplexity int) int
 		UpdatedBy      func(childComplexity int) int
+		Version        func(childComplexity int) int
 	}
 
 	LoadBalancerPortConnection struct {
@@ -218,6 +277,7 @@ type ComplexityRoot struct {
 	}
 
 	LoadBalancerProvider struct {
+		AuditEvents   func(childComplexity int, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.AuditEventOrder, filter *AuditEventFilter) int
 		CreatedAt     func(childComplexity int) int
 		CreatedBy     func(childComplexity int) int
 		DeletedAt     func(childComplexity int) int
@@ -228,6 +288,7 @@ type ComplexityRoot struct {
 		Owner         func(childComplexity int) int
 		UpdatedAt     func(childComplexity int) int
 		UpdatedBy     func(childComplexity int) int
+		Version       func(childComplexity int) int
 	}
 
 	LoadBalancerProviderConnection struct {
@@ -253,6 +314,11 @@ type ComplexityRoot struct {
 		LoadBalancerProvider func(childComplexity int) int
 	}
 
+	LoadBalancerResyncPayload struct {
+		Cursor    func(childComplexity int) int
+		Published func(childComplexity int) int
+	}
+
 	LoadBalancerUpdatePayload struct {
 		LoadBalancer func(childComplexity int) int
 	}
@@ -263,21 +329,24 @@ type ComplexityRoot struct {
 	}
 
 	Mutation struct {
-		LoadBalancerCreate         func(childComplexity int, input generated.CreateLoadBalancerInput) int
-		LoadBalancerDelete         func(childComplexity int, id gidx.PrefixedID) int
-		LoadBalancerOriginCreate   func(childComplexity int, input generated.CreateLoadBalancerOriginInput) int
-		LoadBalancerOriginDelete   func(childComplexity int, id gidx.PrefixedID) int
-		LoadBalancerOriginUpdate   func(childComplexity int, id gidx.PrefixedID, input generated.UpdateLoadBalancerOriginInput) int
-		LoadBalancerPoolCreate     func(childComplexity int, input generated.CreateLoadBalancerPoolInput) int
-		LoadBalancerPoolDelete     func(childComplexity int, id gidx.PrefixedID) int
-		LoadBalancerPoolUpdate     func(childComplexity int, id gidx.PrefixedID, input generated.UpdateLoadBalancerPoolInput) int
-		LoadBalancerPortCreate     func(childComplexity int, input generated.CreateLoadBalancerPortInput) int
-		LoadBalancerPortDelete     func(childComplexity int, id gidx.PrefixedID) int
-		LoadBalancerPortUpdate     func(childComplexity int, id gidx.PrefixedID, input generated.UpdateLoadBalancerPortInput) int
-		LoadBalancerProviderCreate func(childComplexity int, input generated.CreateLoadBalancerProviderInput) int
-		LoadBalancerProviderDelete func(childComplexity int, id gidx.PrefixedID) int
-		LoadBalancerProviderUpdate func(childComplexity int, id gidx.PrefixedID, input generated.UpdateLoadBalancerProviderInput) int
-		LoadBalancerUpdate         func(childComplexity int, id gidx.PrefixedID, input generated.UpdateLoadBalancerInput) int
+		LoadBalancerApply              func(childComplexity int, spec json.RawMessage, dryRun *bool) int
+		LoadBalancerCreate             func(childComplexity int, input CreateLoadBalancerInput) int
+		LoadBalancerCreateWithChildren func(childComplexity int, input CreateLoadBalancerWithChildrenInput) int
+		LoadBalancerDelete             func(childComplexity int, id gidx.PrefixedID, expectedVersion *int) int
+		LoadBalancerOriginCreate       func(childComplexity int, input CreateLoadBalancerOriginInput) int
+		LoadBalancerOriginDelete       func(childComplexity int, id gidx.PrefixedID, expectedVersion *int) int
+		LoadBalancerOriginUpdate       func(childComplexity int, id gidx.PrefixedID, input generated.UpdateLoadBalancerOriginInput, expectedVersion *int) int
+		LoadBalancerPoolCreate         func(childComplexity int, input CreateLoadBalancerPoolInput) int
+		LoadBalancerPoolDelete         func(childComplexity int, id gidx.PrefixedID, expectedVersion *int) int
+		LoadBalancerPoolUpdate         func(childComplexity int, id gidx.PrefixedID, input generated.UpdateLoadBalancerPoolInput, expectedVersion *int) int
+		LoadBalancerPortCreate         func(childComplexity int, input CreateLoadBalancerPortInput) int
+		LoadBalancerPortDelete         func(childComplexity int, id gidx.PrefixedID, expectedVersion *int) int
+		LoadBalancerPortUpdate         func(childComplexity int, id gidx.PrefixedID, input generated.UpdateLoadBalancerPortInput, expectedVersion *int) int
+		LoadBalancerProviderCreate     func(childComplexity int, input CreateLoadBalancerProviderInput) int
+		LoadBalancerProviderDelete     func(childComplexity int, id gidx.PrefixedID, expectedVersion *int) int
+		LoadBalancerProviderUpdate     func(childComplexity int, id gidx.PrefixedID, input generated.UpdateLoadBalancerProviderInput, expectedVersion *int) int
+		LoadBalancerResync             func(childComplexity int, input LoadBalancerResyncInput) int
+		LoadBalancerUpdate             func(childComplexity int, id gidx.PrefixedID, input generated.UpdateLoadBalancerInput, expectedVersion *int) int
 	}
 
 	PageInfo struct {
@@ -288,20 +357,30 @@ type ComplexityRoot struct {
 	}
 
 	Query struct {
-		LoadBalancer         func(childComplexity int, id gidx.PrefixedID) int
-		LoadBalancerHistory  func(childComplexity int, id gidx.PrefixedID) int
-		LoadBalancerPool     func(childComplexity int, id gidx.PrefixedID) int
-		LoadBalancerPort     func(childComplexity int, id gidx.PrefixedID) int
-		LoadBalancerProvider func(childComplexity int, id gidx.PrefixedID) int
-		__resolve__service   func(childComplexity int) int
-		__resolve_entities   func(childComplexity int, representations []map[string]interface{}) int
+		LoadBalancer                func(childComplexity int, id gidx.PrefixedID) int
+		LoadBalancerHistory         func(childComplexity int, id gidx.PrefixedID) int
+		LoadBalancerOrigin          func(childComplexity int, id gidx.PrefixedID) int
+		LoadBalancerOriginHistory   func(childComplexity int, id gidx.PrefixedID) int
+		LoadBalancerPool            func(childComplexity int, id gidx.PrefixedID) int
+		LoadBalancerPoolHistory     func(childComplexity int, id gidx.PrefixedID) int
+		LoadBalancerPort            func(childComplexity int, id gidx.PrefixedID) int
+		LoadBalancerPortHistory     func(childComplexity int, id gidx.PrefixedID) int
+		LoadBalancerProvider        func(childComplexity int, id gidx.PrefixedID) int
+		LoadBalancerProviderHistory func(childComplexity int, id gidx.PrefixedID) int
+		__resolve__service          func(childComplexity int) int
+		__resolve_entities          func(childComplexity int, representations []map[string]interface{}) int
 	}
 
 	ResourceOwner struct {
 		ID                     func(childComplexity int) int
 		LoadBalancerPools      func(childComplexity int, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.LoadBalancerPoolOrder, where *generated.LoadBalancerPoolWhereInput) int
-		LoadBalancers          func(childComplexity int, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.LoadBalancerOrder, where *generated.LoadBalancerWhereInput) int
-		LoadBalancersProviders func(childComplexity int, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.LoadBalancerOrder, where *generated.LoadBalancerProviderWhereInput) int
+		LoadBalancers          func(childComplexity int, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.LoadBalancerOrder, where *generated.LoadBalancerWhereInput, includeDeleted *bool) int
+		LoadBalancersProviders func(childComplexity int, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.LoadBalancerProviderOrder, where *generated.LoadBalancerProviderWhereInput) int
+	}
+
+	Subscription struct {
+		LoadBalancerChanged       func(childComplexity int, id gidx.PrefixedID) int
+		OwnerLoadBalancersChanged func(childComplexity int, ownerID gidx.PrefixedID) int
 	}
 
 	_Service struct {
@@ -310,55 +389,73 @@ type ComplexityRoot struct {
 }
 
 type EntityResolver interface {
-	FindLoadBalancerByID(ctx context.Context, id gidx.PrefixedID) (*generated.LoadBalancer, error)
-	FindLoadBalancerOriginByID(ctx context.Context, id gidx.PrefixedID) (*generated.Origin, error)
-	FindLoadBalancerPoolByID(ctx context.Context, id gidx.PrefixedID) (*generated.Pool, error)
-	FindLoadBalancerPortByID(ctx context.Context, id gidx.PrefixedID) (*generated.Port, error)
-	FindLoadBalancerProviderByID(ctx context.Context, id gidx.PrefixedID) (*generated.Provider, error)
+	FindManyLoadBalancerByIDs(ctx context.Context, reps []*LoadBalancerByIDsInput) ([]*generated.LoadBalancer, error)
+	FindManyLoadBalancerOriginByIDs(ctx context.Context, reps []*LoadBalancerOriginByIDsInput) ([]*generated.Origin, error)
+	FindManyLoadBalancerPoolByIDs(ctx context.Context, reps []*LoadBalancerPoolByIDsInput) ([]*generated.Pool, error)
+	FindManyLoadBalancerPortByIDs(ctx context.Context, reps []*LoadBalancerPortByIDsInput) ([]*generated.Port, error)
+	FindManyLoadBalancerProviderByIDs(ctx context.Context, reps []*LoadBalancerProviderByIDsInput) ([]*generated.Provider, error)
 	FindLocationByID(ctx context.Context, id gidx.PrefixedID) (*Location, error)
 	FindResourceOwnerByID(ctx context.Context, id gidx.PrefixedID) (*ResourceOwner, error)
 }
 type LoadBalancerResolver interface {
+	Ports(ctx context.Context, obj *generated.LoadBalancer, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.LoadBalancerPortOrder, where *generated.LoadBalancerPortWhereInput, includeDeleted *bool) (*generated.LoadBalancerPortConnection, error)
+
+	AuditEvents(ctx context.Context, obj *generated.LoadBalancer, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.AuditEventOrder, filter *AuditEventFilter) (*generated.AuditEventConnection, error)
 	Location(ctx context.Context, obj *generated.LoadBalancer) (*Location, error)
 	Owner(ctx context.Context, obj *generated.LoadBalancer) (*ResourceOwner, error)
 }
 type LoadBalancerPoolResolver interface {
+	Origins(ctx context.Context, obj *generated.Pool, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.LoadBalancerOriginOrder, where *generated.LoadBalancerOriginWhereInput, includeDeleted *bool) (*generated.LoadBalancerOriginConnection, error)
+	AuditEvents(ctx context.Context, obj *generated.Pool, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.AuditEventOrder, filter *AuditEventFilter) (*generated.AuditEventConnection, error)
 	Owner(ctx context.Context, obj *generated.Pool) (*ResourceOwner, error)
 }
 type LoadBalancerProviderResolver interface {
+	AuditEvents(ctx context.Context, obj *generated.Provider, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.AuditEventOrder, filter *AuditEventFilter) (*generated.AuditEventConnection, error)
 	Owner(ctx context.Context, obj *generated.Provider) (*ResourceOwner, error)
 }
 type LocationResolver interface {
 	LoadBalancers(ctx context.Context, obj *Location, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.LoadBalancerOrder, where *generated.LoadBalancerWhereInput) (*generated.LoadBalancerConnection, error)
 }
 type MutationResolver interface {
-	LoadBalancerOriginCreate(ctx context.Context, input generated.CreateLoadBalancerOriginInput) (*LoadBalancerOriginCreatePayload, error)
-	LoadBalancerOriginUpdate(ctx context.Context, id gidx.PrefixedID, input generated.UpdateLoadBalancerOriginInput) (*LoadBalancerOriginUpdatePayload, error)
-	LoadBalancerOriginDelete(ctx context.Context, id gidx.PrefixedID) (*LoadBalancerOriginDeletePayload, error)
-	LoadBalancerCreate(ctx context.Context, input generated.CreateLoadBalancerInput) (*LoadBalancerCreatePayload, error)
-	LoadBalancerUpdate(ctx context.Context, id gidx.PrefixedID, input generated.UpdateLoadBalancerInput) (*LoadBalancerUpdatePayload, error)
-	LoadBalancerDelete(ctx context.Context, id gidx.PrefixedID) (*LoadBalancerDeletePayload, error)
-	LoadBalancerPoolCreate(ctx context.Context, input generated.CreateLoadBalancerPoolInput) (*LoadBalancerPoolCreatePayload, error)
-	LoadBalancerPoolUpdate(ctx context.Context, id gidx.PrefixedID, input generated.UpdateLoadBalancerPoolInput) (*LoadBalancerPoolUpdatePayload, error)
-	LoadBalancerPoolDelete(ctx context.Context, id gidx.PrefixedID) (*LoadBalancerPoolDeletePayload, error)
-	LoadBalancerPortCreate(ctx context.Context, input generated.CreateLoadBalancerPortInput) (*LoadBalancerPortCreatePayload, error)
-	LoadBalancerPortUpdate(ctx context.Context, id gidx.PrefixedID, input generated.UpdateLoadBalancerPortInput) (*LoadBalancerPortUpdatePayload, error)
-	LoadBalancerPortDelete(ctx context.Context, id gidx.PrefixedID) (*LoadBalancerPortDeletePayload, error)
-	LoadBalancerProviderCreate(ctx context.Context, input generated.CreateLoadBalancerProviderInput) (*LoadBalancerProviderCreatePayload, error)
-	LoadBalancerProviderUpdate(ctx context.Context, id gidx.PrefixedID, input generated.UpdateLoadBalancerProviderInput) (*LoadBalancerProviderUpdatePayload, error)
-	LoadBalancerProviderDelete(ctx context.Context, id gidx.PrefixedID) (*LoadBalancerProviderDeletePayload, error)
+	LoadBalancerOriginCreate(ctx context.Context, input CreateLoadBalancerOriginInput) (*LoadBalancerOriginCreatePayload, error)
+	LoadBalancerOriginUpdate(ctx context.Context, id gidx.PrefixedID, input generated.UpdateLoadBalancerOriginInput, expectedVersion *int) (*LoadBalancerOriginUpdatePayload, error)
+	LoadBalancerOriginDelete(ctx context.Context, id gidx.PrefixedID, expectedVersion *int) (*LoadBalancerOriginDeletePayload, error)
+	LoadBalancerApply(ctx context.Context, spec json.RawMessage, dryRun *bool) (*LoadBalancerApplyPayload, error)
+	LoadBalancerCreate(ctx context.Context, input CreateLoadBalancerInput) (*LoadBalancerCreatePayload, error)
+	LoadBalancerCreateWithChildren(ctx context.Context, input CreateLoadBalancerWithChildrenInput) (*LoadBalancerCreatePayload, error)
+	LoadBalancerUpdate(ctx context.Context, id gidx.PrefixedID, input generated.UpdateLoadBalancerInput, expectedVersion *int) (*LoadBalancerUpdatePayload, error)
+	LoadBalancerDelete(ctx context.Context, id gidx.PrefixedID, expectedVersion *int) (*LoadBalancerDeletePayload, error)
+	LoadBalancerPoolCreate(ctx context.Context, input CreateLoadBalancerPoolInput) (*LoadBalancerPoolCreatePayload, error)
+	LoadBalancerPoolUpdate(ctx context.Context, id gidx.PrefixedID, input generated.UpdateLoadBalancerPoolInput, expectedVersion *int) (*LoadBalancerPoolUpdatePayload, error)
+	LoadBalancerPoolDelete(ctx context.Context, id gidx.PrefixedID, expectedVersion *int) (*LoadBalancerPoolDeletePayload, error)
+	LoadBalancerPortCreate(ctx context.Context, input CreateLoadBalancerPortInput) (*LoadBalancerPortCreatePayload, error)
+	LoadBalancerPortUpdate(ctx context.Context, id gidx.PrefixedID, input generated.UpdateLoadBalancerPortInput, expectedVersion *int) (*LoadBalancerPortUpdatePayload, error)
+	LoadBalancerPortDelete(ctx context.Context, id gidx.PrefixedID, expectedVersion *int) (*LoadBalancerPortDeletePayload, error)
+	LoadBalancerProviderCreate(ctx context.Context, input CreateLoadBalancerProviderInput) (*LoadBalancerProviderCreatePayload, error)
+	LoadBalancerProviderUpdate(ctx context.Context, id gidx.PrefixedID, input generated.UpdateLoadBalancerProviderInput, expectedVersion *int) (*LoadBalancerProviderUpdatePayload, error)
+	LoadBalancerProviderDelete(ctx context.Context, id gidx.PrefixedID, expectedVersion *int) (*LoadBalancerProviderDeletePayload, error)
+	LoadBalancerResync(ctx context.Context, input LoadBalancerResyncInput) (*LoadBalancerResyncPayload, error)
 }
 type QueryResolver interface {
 	LoadBalancer(ctx context.Context, id gidx.PrefixedID) (*generated.LoadBalancer, error)
 	LoadBalancerHistory(ctx context.Context, id gidx.PrefixedID) (*generated.LoadBalancer, error)
+	LoadBalancerOrigin(ctx context.Context, id gidx.PrefixedID) (*generated.Origin, error)
+	LoadBalancerOriginHistory(ctx context.Context, id gidx.PrefixedID) (*generated.Origin, error)
 	LoadBalancerPool(ctx context.Context, id gidx.PrefixedID) (*generated.Pool, error)
+	LoadBalancerPoolHistory(ctx context.Context, id gidx.PrefixedID) (*generated.Pool, error)
 	LoadBalancerPort(ctx context.Context, id gidx.PrefixedID) (*generated.Port, error)
+	LoadBalancerPortHistory(ctx context.Context, id gidx.PrefixedID) (*generated.Port, error)
 	LoadBalancerProvider(ctx context.Context, id gidx.PrefixedID) (*generated.Provider, error)
+	LoadBalancerProviderHistory(ctx context.Context, id gidx.PrefixedID) (*generated.Provider, error)
 }
 type ResourceOwnerResolver interface {
-	LoadBalancers(ctx context.Context, obj *ResourceOwner, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.LoadBalancerOrder, where *generated.LoadBalancerWhereInput) (*generated.LoadBalancerConnection, error)
+	LoadBalancers(ctx context.Context, obj *ResourceOwner, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.LoadBalancerOrder, where *generated.LoadBalancerWhereInput, includeDeleted *bool) (*generated.LoadBalancerConnection, error)
 	LoadBalancerPools(ctx context.Context, obj *ResourceOwner, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.LoadBalancerPoolOrder, where *generated.LoadBalancerPoolWhereInput) (*generated.LoadBalancerPoolConnection, error)
-	LoadBalancersProviders(ctx context.Context, obj *ResourceOwner, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.LoadBalancerOrder, where *generated.LoadBalancerProviderWhereInput) (*generated.LoadBalancerProviderConnection, error)
+	LoadBalancersProviders(ctx context.Context, obj *ResourceOwner, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.LoadBalancerProviderOrder, where *generated.LoadBalancerProviderWhereInput) (*generated.LoadBalancerProviderConnection, error)
+}
+type SubscriptionResolver interface {
+	LoadBalancerChanged(ctx context.Context, id gidx.PrefixedID) (<-chan *LoadBalancerChange, error)
+	OwnerLoadBalancersChanged(ctx context.Context, ownerID gidx.PrefixedID) (<-chan *LoadBalancerChange, error)
 }
 
 type executableSchema struct {
@@ -376,77 +473,175 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
-	case "Entity.findLoadBalancerByID":
-		if e.complexity.Entity.FindLoadBalancerByID == nil {
+	case "AuditEvent.actor":
+		if e.complexity.AuditEvent.Actor == nil {
+			break
+		}
+
+		return e.complexity.AuditEvent.Actor(childComplexity), true
+
+	case "AuditEvent.changeset":
+		if e.complexity.AuditEvent.Changeset == nil {
+			break
+		}
+
+		return e.complexity.AuditEvent.Changeset(childComplexity), true
+
+	case "AuditEvent.createdAt":
+		if e.complexity.AuditEvent.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.AuditEvent.CreatedAt(childComplexity), true
+
+	case "AuditEvent.id":
+		if e.complexity.AuditEvent.ID == nil {
+			break
+		}
+
+		return e.complexity.AuditEvent.ID(childComplexity), true
+
+	case "AuditEvent.operation":
+		if e.complexity.AuditEvent.Operation == nil {
+			break
+		}
+
+		return e.complexity.AuditEvent.Operation(childComplexity), true
+
+	case "AuditEvent.subjectID":
+		if e.complexity.AuditEvent.SubjectID == nil {
+			break
+		}
+
+		return e.complexity.AuditEvent.SubjectID(childComplexity), true
+
+	case "AuditEventConnection.edges":
+		if e.complexity.AuditEventConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.AuditEventConnection.Edges(childComplexity), true
+
+	case "AuditEventConnection.pageInfo":
+		if e.complexity.AuditEventConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.AuditEventConnection.PageInfo(childComplexity), true
+
+	case "AuditEventConnection.totalCount":
+		if e.complexity.AuditEventConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.AuditEventConnection.TotalCount(childComplexity), true
+
+	case "AuditEventEdge.cursor":
+		if e.complexity.AuditEventEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.AuditEventEdge.Cursor(childComplexity), true
+
+	case "AuditEventEdge.node":
+		if e.complexity.AuditEventEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.AuditEventEdge.Node(childComplexity), true
+
+	case "AuditFieldChange.currentValue":
+		if e.complexity.AuditFieldChange.CurrentValue == nil {
+			break
+		}
+
+		return e.complexity.AuditFieldChange.CurrentValue(childComplexity), true
+
+	case "AuditFieldChange.field":
+		if e.complexity.AuditFieldChange.Field == nil {
+			break
+		}
+
+		return e.complexity.AuditFieldChange.Field(childComplexity), true
+
+	case "AuditFieldChange.previousValue":
+		if e.complexity.AuditFieldChange.PreviousValue == nil {
+			break
+		}
+
+		return e.complexity.AuditFieldChange.PreviousValue(childComplexity), true
+
+	case "Entity.findLocationByID":
+		if e.complexity.Entity.FindLocationByID == nil {
 			break
 		}
 
-		args, err := ec.field_Entity_findLoadBalancerByID_args(context.TODO(), rawArgs)
+		args, err := ec.field_Entity_findLocationByID_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Entity.FindLoadBalancerByID(childComplexity, args["id"].(gidx.PrefixedID)), true
+		return e.complexity.Entity.FindLocationByID(childComplexity, args["id"].(gidx.PrefixedID)), true
 
-	case "Entity.findLoadBalancerOriginByID":
-		if e.complexity.Entity.FindLoadBalancerOriginByID == nil {
+	case "Entity.findManyLoadBalancerByIDs":
+		if e.complexity.Entity.FindManyLoadBalancerByIDs == nil {
 			break
 		}
 
-		args, err := ec.field_Entity_findLoadBalancerOriginByID_args(context.TODO(), rawArgs)
+		args, err := ec.field_Entity_findManyLoadBalancerByIDs_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Entity.FindLoadBalancerOriginByID(childComplexity, args["id"].(gidx.PrefixedID)), true
+		return e.complexity.Entity.FindManyLoadBalancerByIDs(childComplexity, args["reps"].([]*LoadBalancerByIDsInput)), true
 
-	case "Entity.findLoadBalancerPoolByID":
-		if e.complexity.Entity.FindLoadBalancerPoolByID == nil {
+	case "Entity.findManyLoadBalancerOriginByIDs":
+		if e.complexity.Entity.FindManyLoadBalancerOriginByIDs == nil {
 			break
 		}
 
-		args, err := ec.field_Entity_findLoadBalancerPoolByID_args(context.TODO(), rawArgs)
+		args, err := ec.field_Entity_findManyLoadBalancerOriginByIDs_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Entity.FindLoadBalancerPoolByID(childComplexity, args["id"].(gidx.PrefixedID)), true
+		return e.complexity.Entity.FindManyLoadBalancerOriginByIDs(childComplexity, args["reps"].([]*LoadBalancerOriginByIDsInput)), true
 
-	case "Entity.findLoadBalancerPortByID":
-		if e.complexity.Entity.FindLoadBalancerPortByID == nil {
+	case "Entity.findManyLoadBalancerPoolByIDs":
+		if e.complexity.Entity.FindManyLoadBalancerPoolByIDs == nil {
 			break
 		}
 
-		args, err := ec.field_Entity_findLoadBalancerPortByID_args(context.TODO(), rawArgs)
+		args, err := ec.field_Entity_findManyLoadBalancerPoolByIDs_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Entity.FindLoadBalancerPortByID(childComplexity, args["id"].(gidx.PrefixedID)), true
+		return e.complexity.Entity.FindManyLoadBalancerPoolByIDs(childComplexity, args["reps"].([]*LoadBalancerPoolByIDsInput)), true
 
-	case "Entity.findLoadBalancerProviderByID":
-		if e.complexity.Entity.FindLoadBalancerProviderByID == nil {
+	case "Entity.findManyLoadBalancerPortByIDs":
+		if e.complexity.Entity.FindManyLoadBalancerPortByIDs == nil {
 			break
 		}
 
-		args, err := ec.field_Entity_findLoadBalancerProviderByID_args(context.TODO(), rawArgs)
+		args, err := ec.field_Entity_findManyLoadBalancerPortByIDs_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Entity.FindLoadBalancerProviderByID(childComplexity, args["id"].(gidx.PrefixedID)), true
+		return e.complexity.Entity.FindManyLoadBalancerPortByIDs(childComplexity, args["reps"].([]*LoadBalancerPortByIDsInput)), true
 
-	case "Entity.findLocationByID":
-		if e.complexity.Entity.FindLocationByID == nil {
+	case "Entity.findManyLoadBalancerProviderByIDs":
+		if e.complexity.Entity.FindManyLoadBalancerProviderByIDs == nil {
 			break
 		}
 
-		args, err := ec.field_Entity_findLocationByID_args(context.TODO(), rawArgs)
+		args, err := ec.field_Entity_findManyLoadBalancerProviderByIDs_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Entity.FindLocationByID(childComplexity, args["id"].(gidx.PrefixedID)), true
+		return e.complexity.Entity.FindManyLoadBalancerProviderByIDs(childComplexity, args["reps"].([]*LoadBalancerProviderByIDsInput)), true
 
 	case "Entity.findResourceOwnerByID":
 		if e.complexity.Entity.FindResourceOwnerByID == nil {
@@ -460,6 +655,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Entity.FindResourceOwnerByID(childComplexity, args["id"].(gidx.PrefixedID)), true
 
+	case "LoadBalancer.auditEvents":
+		if e.complexity.LoadBalancer.AuditEvents == nil {
+			break
+		}
+
+		args, err := ec.field_LoadBalancer_auditEvents_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.LoadBalancer.AuditEvents(childComplexity, args["after"].(*entgql.Cursor[gidx.PrefixedID]), args["first"].(*int), args["before"].(*entgql.Cursor[gidx.PrefixedID]), args["last"].(*int), args["orderBy"].(*generated.AuditEventOrder), args["filter"].(*AuditEventFilter)), true
+
 	case "LoadBalancer.createdAt":
 		if e.complexity.LoadBalancer.CreatedAt == nil {
 			break
@@ -526,7 +733,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.LoadBalancer.Ports(childComplexity, args["after"].(*entgql.Cursor[gidx.PrefixedID]), args["first"].(*int), args["before"].(*entgql.Cursor[gidx.PrefixedID]), args["last"].(*int), args["orderBy"].(*generated.LoadBalancerPortOrder), args["where"].(*generated.LoadBalancerPortWhereInput)), true
+		return e.complexity.LoadBalancer.Ports(childComplexity, args["after"].(*entgql.Cursor[gidx.PrefixedID]), args["first"].(*int), args["before"].(*entgql.Cursor[gidx.PrefixedID]), args["last"].(*int), args["orderBy"].(*generated.LoadBalancerPortOrder), args["where"].(*generated.LoadBalancerPortWhereInput), args["includeDeleted"].(*bool)), true
 
 	case "LoadBalancer.loadBalancerProvider":
 		if e.complexity.LoadBalancer.Provider == nil {
@@ -549,6 +756,104 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.LoadBalancer.UpdatedBy(childComplexity), true
 
+	case "LoadBalancer.version":
+		if e.complexity.LoadBalancer.Version == nil {
+			break
+		}
+
+		return e.complexity.LoadBalancer.Version(childComplexity), true
+
+	case "LoadBalancerApplyChange.action":
+		if e.complexity.LoadBalancerApplyChange.Action == nil {
+			break
+		}
+
+		return e.complexity.LoadBalancerApplyChange.Action(childComplexity), true
+
+	case "LoadBalancerApplyChange.fields":
+		if e.complexity.LoadBalancerApplyChange.Fields == nil {
+			break
+		}
+
+		return e.complexity.LoadBalancerApplyChange.Fields(childComplexity), true
+
+	case "LoadBalancerApplyChange.id":
+		if e.complexity.LoadBalancerApplyChange.ID == nil {
+			break
+		}
+
+		return e.complexity.LoadBalancerApplyChange.ID(childComplexity), true
+
+	case "LoadBalancerApplyChange.key":
+		if e.complexity.LoadBalancerApplyChange.Key == nil {
+			break
+		}
+
+		return e.complexity.LoadBalancerApplyChange.Key(childComplexity), true
+
+	case "LoadBalancerApplyChange.resourceType":
+		if e.complexity.LoadBalancerApplyChange.ResourceType == nil {
+			break
+		}
+
+		return e.complexity.LoadBalancerApplyChange.ResourceType(childComplexity), true
+
+	case "LoadBalancerApplyPayload.changes":
+		if e.complexity.LoadBalancerApplyPayload.Changes == nil {
+			break
+		}
+
+		return e.complexity.LoadBalancerApplyPayload.Changes(childComplexity), true
+
+	case "LoadBalancerApplyPayload.dryRun":
+		if e.complexity.LoadBalancerApplyPayload.DryRun == nil {
+			break
+		}
+
+		return e.complexity.LoadBalancerApplyPayload.DryRun(childComplexity), true
+
+	case "LoadBalancerApplyPayload.loadBalancer":
+		if e.complexity.LoadBalancerApplyPayload.LoadBalancer == nil {
+			break
+		}
+
+		return e.complexity.LoadBalancerApplyPayload.LoadBalancer(childComplexity), true
+
+	case "LoadBalancerChange.eventType":
+		if e.complexity.LoadBalancerChange.EventType == nil {
+			break
+		}
+
+		return e.complexity.LoadBalancerChange.EventType(childComplexity), true
+
+	case "LoadBalancerChange.loadBalancer":
+		if e.complexity.LoadBalancerChange.LoadBalancer == nil {
+			break
+		}
+
+		return e.complexity.LoadBalancerChange.LoadBalancer(childComplexity), true
+
+	case "LoadBalancerChange.loadBalancerID":
+		if e.complexity.LoadBalancerChange.LoadBalancerID == nil {
+			break
+		}
+
+		return e.complexity.LoadBalancerChange.LoadBalancerID(childComplexity), true
+
+	case "LoadBalancerChange.subjectID":
+		if e.complexity.LoadBalancerChange.SubjectID == nil {
+			break
+		}
+
+		return e.complexity.LoadBalancerChange.SubjectID(childComplexity), true
+
+	case "LoadBalancerChange.timestamp":
+		if e.complexity.LoadBalancerChange.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.LoadBalancerChange.Timestamp(childComplexity), true
+
 	case "LoadBalancerConnection.edges":
 		if e.complexity.LoadBalancerConnection.Edges == nil {
 			break
@@ -689,6 +994,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.LoadBalancerOrigin.UpdatedBy(childComplexity), true
 
+	case "LoadBalancerOrigin.version":
+		if e.complexity.LoadBalancerOrigin.Version == nil {
+			break
+		}
+
+		return e.complexity.LoadBalancerOrigin.Version(childComplexity), true
+
 	case "LoadBalancerOrigin.weight":
 		if e.complexity.LoadBalancerOrigin.Weight == nil {
 			break
@@ -752,6 +1064,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.LoadBalancerOriginUpdatePayload.LoadBalancerOrigin(childComplexity), true
 
+	case "LoadBalancerPool.auditEvents":
+		if e.complexity.LoadBalancerPool.AuditEvents == nil {
+			break
+		}
+
+		args, err := ec.field_LoadBalancerPool_auditEvents_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.LoadBalancerPool.AuditEvents(childComplexity, args["after"].(*entgql.Cursor[gidx.PrefixedID]), args["first"].(*int), args["before"].(*entgql.Cursor[gidx.PrefixedID]), args["last"].(*int), args["orderBy"].(*generated.AuditEventOrder), args["filter"].(*AuditEventFilter)), true
+
 	case "LoadBalancerPool.createdAt":
 		if e.complexity.LoadBalancerPool.CreatedAt == nil {
 			break
@@ -804,7 +1128,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.LoadBalancerPool.Origins(childComplexity, args["after"].(*entgql.Cursor[gidx.PrefixedID]), args["first"].(*int), args["before"].(*entgql.Cursor[gidx.PrefixedID]), args["last"].(*int), args["orderBy"].(*generated.LoadBalancerOriginOrder), args["where"].(*generated.LoadBalancerOriginWhereInput)), true
+		return e.complexity.LoadBalancerPool.Origins(childComplexity, args["after"].(*entgql.Cursor[gidx.PrefixedID]), args["first"].(*int), args["before"].(*entgql.Cursor[gidx.PrefixedID]), args["last"].(*int), args["orderBy"].(*generated.LoadBalancerOriginOrder), args["where"].(*generated.LoadBalancerOriginWhereInput), args["includeDeleted"].(*bool)), true
 
 	case "LoadBalancerPool.owner":
 		if e.complexity.LoadBalancerPool.Owner == nil {
@@ -848,6 +1172,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.LoadBalancerPool.UpdatedBy(childComplexity), true
 
+	case "LoadBalancerPool.version":
+		if e.complexity.LoadBalancerPool.Version == nil {
+			break
+		}
+
+		return e.complexity.LoadBalancerPool.Version(childComplexity), true
+
 	case "LoadBalancerPoolConnection.edges":
 		if e.complexity.LoadBalancerPoolConnection.Edges == nil {
 			break
@@ -988,6 +1319,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.LoadBalancerPort.UpdatedBy(childComplexity), true
 
+	case "LoadBalancerPort.version":
+		if e.complexity.LoadBalancerPort.Version == nil {
+			break
+		}
+
+		return e.complexity.LoadBalancerPort.Version(childComplexity), true
+
 	case "LoadBalancerPortConnection.edges":
 		if e.complexity.LoadBalancerPortConnection.Edges == nil {
 			break
@@ -1044,6 +1382,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.LoadBalancerPortUpdatePayload.LoadBalancerPort(childComplexity), true
 
+	case "LoadBalancerProvider.auditEvents":
+		if e.complexity.LoadBalancerProvider.AuditEvents == nil {
+			break
+		}
+
+		args, err := ec.field_LoadBalancerProvider_auditEvents_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.LoadBalancerProvider.AuditEvents(childComplexity, args["after"].(*entgql.Cursor[gidx.PrefixedID]), args["first"].(*int), args["before"].(*entgql.Cursor[gidx.PrefixedID]), args["last"].(*int), args["orderBy"].(*generated.AuditEventOrder), args["filter"].(*AuditEventFilter)), true
+
 	case "LoadBalancerProvider.createdAt":
 		if e.complexity.LoadBalancerProvider.CreatedAt == nil {
 			break
@@ -1119,6 +1469,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.LoadBalancerProvider.UpdatedBy(childComplexity), true
 
+	case "LoadBalancerProvider.version":
+		if e.complexity.LoadBalancerProvider.Version == nil {
+			break
+		}
+
+		return e.complexity.LoadBalancerProvider.Version(childComplexity), true
+
 	case "LoadBalancerProviderConnection.edges":
 		if e.complexity.LoadBalancerProviderConnection.Edges == nil {
 			break
@@ -1175,6 +1532,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.LoadBalancerProviderUpdatePayload.LoadBalancerProvider(childComplexity), true
 
+	case "LoadBalancerResyncPayload.cursor":
+		if e.complexity.LoadBalancerResyncPayload.Cursor == nil {
+			break
+		}
+
+		return e.complexity.LoadBalancerResyncPayload.Cursor(childComplexity), true
+
+	case "LoadBalancerResyncPayload.published":
+		if e.complexity.LoadBalancerResyncPayload.Published == nil {
+			break
+		}
+
+		return e.complexity.LoadBalancerResyncPayload.Published(childComplexity), true
+
 	case "LoadBalancerUpdatePayload.loadBalancer":
 		if e.complexity.LoadBalancerUpdatePayload.LoadBalancer == nil {
 			break
@@ -1201,6 +1572,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Location.LoadBalancers(childComplexity, args["after"].(*entgql.Cursor[gidx.PrefixedID]), args["first"].(*int), args["before"].(*entgql.Cursor[gidx.PrefixedID]), args["last"].(*int), args["orderBy"].(*generated.LoadBalancerOrder), args["where"].(*generated.LoadBalancerWhereInput)), true
 
+	case "Mutation.loadBalancerApply":
+		if e.complexity.Mutation.LoadBalancerApply == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_loadBalancerApply_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.LoadBalancerApply(childComplexity, args["spec"].(json.RawMessage), args["dryRun"].(*bool)), true
+
 	case "Mutation.loadBalancerCreate":
 		if e.complexity.Mutation.LoadBalancerCreate == nil {
 			break
@@ -1211,7 +1594,19 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.LoadBalancerCreate(childComplexity, args["input"].(generated.CreateLoadBalancerInput)), true
+		return e.complexity.Mutation.LoadBalancerCreate(childComplexity, args["input"].(CreateLoadBalancerInput)), true
+
+	case "Mutation.loadBalancerCreateWithChildren":
+		if e.complexity.Mutation.LoadBalancerCreateWithChildren == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_loadBalancerCreateWithChildren_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.LoadBalancerCreateWithChildren(childComplexity, args["input"].(CreateLoadBalancerWithChildrenInput)), true
 
 	case "Mutation.loadBalancerDelete":
 		if e.complexity.Mutation.LoadBalancerDelete == nil {
@@ -1223,7 +1618,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.LoadBalancerDelete(childComplexity, args["id"].(gidx.PrefixedID)), true
+		return e.complexity.Mutation.LoadBalancerDelete(childComplexity, args["id"].(gidx.PrefixedID), args["expectedVersion"].(*int)), true
 
 	case "Mutation.loadBalancerOriginCreate":
 		if e.complexity.Mutation.LoadBalancerOriginCreate == nil {
@@ -1235,7 +1630,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.LoadBalancerOriginCreate(childComplexity, args["input"].(generated.CreateLoadBalancerOriginInput)), true
+		return e.complexity.Mutation.LoadBalancerOriginCreate(childComplexity, args["input"].(CreateLoadBalancerOriginInput)), true
 
 	case "Mutation.loadBalancerOriginDelete":
 		if e.complexity.Mutation.LoadBalancerOriginDelete == nil {
@@ -1247,7 +1642,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.LoadBalancerOriginDelete(childComplexity, args["id"].(gidx.PrefixedID)), true
+		return e.complexity.Mutation.LoadBalancerOriginDelete(childComplexity, args["id"].(gidx.PrefixedID), args["expectedVersion"].(*int)), true
 
 	case "Mutation.loadBalancerOriginUpdate":
 		if e.complexity.Mutation.LoadBalancerOriginUpdate == nil {
@@ -1259,7 +1654,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.LoadBalancerOriginUpdate(childComplexity, args["id"].(gidx.PrefixedID), args["input"].(generated.UpdateLoadBalancerOriginInput)), true
+		return e.complexity.Mutation.LoadBalancerOriginUpdate(childComplexity, args["id"].(gidx.PrefixedID), args["input"].(generated.UpdateLoadBalancerOriginInput), args["expectedVersion"].(*int)), true
 
 	case "Mutation.loadBalancerPoolCreate":
 		if e.complexity.Mutation.LoadBalancerPoolCreate == nil {
@@ -1271,7 +1666,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.LoadBalancerPoolCreate(childComplexity, args["input"].(generated.CreateLoadBalancerPoolInput)), true
+		return e.complexity.Mutation.LoadBalancerPoolCreate(childComplexity, args["input"].(CreateLoadBalancerPoolInput)), true
 
 	case "Mutation.loadBalancerPoolDelete":
 		if e.complexity.Mutation.LoadBalancerPoolDelete == nil {
@@ -1283,7 +1678,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.LoadBalancerPoolDelete(childComplexity, args["id"].(gidx.PrefixedID)), true
+		return e.complexity.Mutation.LoadBalancerPoolDelete(childComplexity, args["id"].(gidx.PrefixedID), args["expectedVersion"].(*int)), true
 
 	case "Mutation.loadBalancerPoolUpdate":
 		if e.complexity.Mutation.LoadBalancerPoolUpdate == nil {
@@ -1295,7 +1690,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.LoadBalancerPoolUpdate(childComplexity, args["id"].(gidx.PrefixedID), args["input"].(generated.UpdateLoadBalancerPoolInput)), true
+		return e.complexity.Mutation.LoadBalancerPoolUpdate(childComplexity, args["id"].(gidx.PrefixedID), args["input"].(generated.UpdateLoadBalancerPoolInput), args["expectedVersion"].(*int)), true
 
 	case "Mutation.loadBalancerPortCreate":
 		if e.complexity.Mutation.LoadBalancerPortCreate == nil {
@@ -1307,7 +1702,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.LoadBalancerPortCreate(childComplexity, args["input"].(generated.CreateLoadBalancerPortInput)), true
+		return e.complexity.Mutation.LoadBalancerPortCreate(childComplexity, args["input"].(CreateLoadBalancerPortInput)), true
 
 	case "Mutation.loadBalancerPortDelete":
 		if e.complexity.Mutation.LoadBalancerPortDelete == nil {
@@ -1319,7 +1714,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.LoadBalancerPortDelete(childComplexity, args["id"].(gidx.PrefixedID)), true
+		return e.complexity.Mutation.LoadBalancerPortDelete(childComplexity, args["id"].(gidx.PrefixedID), args["expectedVersion"].(*int)), true
 
 	case "Mutation.loadBalancerPortUpdate":
 		if e.complexity.Mutation.LoadBalancerPortUpdate == nil {
@@ -1331,7 +1726,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.LoadBalancerPortUpdate(childComplexity, args["id"].(gidx.PrefixedID), args["input"].(generated.UpdateLoadBalancerPortInput)), true
+		return e.complexity.Mutation.LoadBalancerPortUpdate(childComplexity, args["id"].(gidx.PrefixedID), args["input"].(generated.UpdateLoadBalancerPortInput), args["expectedVersion"].(*int)), true
 
 	case "Mutation.loadBalancerProviderCreate":
 		if e.complexity.Mutation.LoadBalancerProviderCreate == nil {
@@ -1343,7 +1738,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.LoadBalancerProviderCreate(childComplexity, args["input"].(generated.CreateLoadBalancerProviderInput)), true
+		return e.complexity.Mutation.LoadBalancerProviderCreate(childComplexity, args["input"].(CreateLoadBalancerProviderInput)), true
 
 	case "Mutation.loadBalancerProviderDelete":
 		if e.complexity.Mutation.LoadBalancerProviderDelete == nil {
@@ -1355,7 +1750,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.LoadBalancerProviderDelete(childComplexity, args["id"].(gidx.PrefixedID)), true
+		return e.complexity.Mutation.LoadBalancerProviderDelete(childComplexity, args["id"].(gidx.PrefixedID), args["expectedVersion"].(*int)), true
 
 	case "Mutation.loadBalancerProviderUpdate":
 		if e.complexity.Mutation.LoadBalancerProviderUpdate == nil {
@@ -1367,7 +1762,19 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.LoadBalancerProviderUpdate(childComplexity, args["id"].(gidx.PrefixedID), args["input"].(generated.UpdateLoadBalancerProviderInput)), true
+		return e.complexity.Mutation.LoadBalancerProviderUpdate(childComplexity, args["id"].(gidx.PrefixedID), args["input"].(generated.UpdateLoadBalancerProviderInput), args["expectedVersion"].(*int)), true
+
+	case "Mutation.loadBalancerResync":
+		if e.complexity.Mutation.LoadBalancerResync == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_loadBalancerResync_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.LoadBalancerResync(childComplexity, args["input"].(LoadBalancerResyncInput)), true
 
 	case "Mutation.loadBalancerUpdate":
 		if e.complexity.Mutation.LoadBalancerUpdate == nil {
@@ -1379,7 +1786,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.LoadBalancerUpdate(childComplexity, args["id"].(gidx.PrefixedID), args["input"].(generated.UpdateLoadBalancerInput)), true
+		return e.complexity.Mutation.LoadBalancerUpdate(childComplexity, args["id"].(gidx.PrefixedID), args["input"].(generated.UpdateLoadBalancerInput), args["expectedVersion"].(*int)), true
 
 	case "PageInfo.endCursor":
 		if e.complexity.PageInfo.EndCursor == nil {
@@ -1433,67 +1840,127 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.LoadBalancerHistory(childComplexity, args["id"].(gidx.PrefixedID)), true
 
-	case "Query.loadBalancerPool":
-		if e.complexity.Query.LoadBalancerPool == nil {
+	case "Query.loadBalancerOrigin":
+		if e.complexity.Query.LoadBalancerOrigin == nil {
 			break
 		}
 
-		args, err := ec.field_Query_loadBalancerPool_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_loadBalancerOrigin_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.LoadBalancerPool(childComplexity, args["id"].(gidx.PrefixedID)), true
+		return e.complexity.Query.LoadBalancerOrigin(childComplexity, args["id"].(gidx.PrefixedID)), true
 
-	case "Query.loadBalancerPort":
-		if e.complexity.Query.LoadBalancerPort == nil {
+	case "Query.loadBalancerOriginHistory":
+		if e.complexity.Query.LoadBalancerOriginHistory == nil {
 			break
 		}
 
-		args, err := ec.field_Query_loadBalancerPort_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_loadBalancerOriginHistory_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.LoadBalancerPort(childComplexity, args["id"].(gidx.PrefixedID)), true
+		return e.complexity.Query.LoadBalancerOriginHistory(childComplexity, args["id"].(gidx.PrefixedID)), true
 
-	case "Query.loadBalancerProvider":
-		if e.complexity.Query.LoadBalancerProvider == nil {
+	case "Query.loadBalancerPool":
+		if e.complexity.Query.LoadBalancerPool == nil {
 			break
 		}
 
-		args, err := ec.field_Query_loadBalancerProvider_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_loadBalancerPool_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.LoadBalancerProvider(childComplexity, args["id"].(gidx.PrefixedID)), true
+		return e.complexity.Query.LoadBalancerPool(childComplexity, args["id"].(gidx.PrefixedID)), true
 
-	case "Query._service":
-		if e.complexity.Query.__resolve__service == nil {
+	case "Query.loadBalancerPoolHistory":
+		if e.complexity.Query.LoadBalancerPoolHistory == nil {
 			break
 		}
 
-		return e.complexity.Query.__resolve__service(childComplexity), true
+		args, err := ec.field_Query_loadBalancerPoolHistory_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Query._entities":
-		if e.complexity.Query.__resolve_entities == nil {
+		return e.complexity.Query.LoadBalancerPoolHistory(childComplexity, args["id"].(gidx.PrefixedID)), true
+
+	case "Query.loadBalancerPort":
+		if e.complexity.Query.LoadBalancerPort == nil {
 			break
 		}
 
-		args, err := ec.field_Query__entities_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_loadBalancerPort_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.__resolve_entities(childComplexity, args["representations"].([]map[string]interface{})), true
+		return e.complexity.Query.LoadBalancerPort(childComplexity, args["id"].(gidx.PrefixedID)), true
 
-	case "ResourceOwner.id":
-		if e.complexity.ResourceOwner.ID == nil {
+	case "Query.loadBalancerPortHistory":
+		if e.complexity.Query.LoadBalancerPortHistory == nil {
 			break
 		}
 
-		return e.complexity.ResourceOwner.ID(childComplexity), true
+		args, err := ec.field_Query_loadBalancerPortHistory_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.LoadBalancerPortHistory(childComplexity, args["id"].(gidx.PrefixedID)), true
+
+	case "Query.loadBalancerProvider":
+		if e.complexity.Query.LoadBalancerProvider == nil {
+			break
+		}
+
+		args, err := ec.field_Query_loadBalancerProvider_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.LoadBalancerProvider(childComplexity, args["id"].(gidx.PrefixedID)), true
+
+	case "Query.loadBalancerProviderHistory":
+		if e.complexity.Query.LoadBalancerProviderHistory == nil {
+			break
+		}
+
+		args, err := ec.field_Query_loadBalancerProviderHistory_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.LoadBalancerProviderHistory(childComplexity, args["id"].(gidx.PrefixedID)), true
+
+	case "Query._service":
+		if e.complexity.Query.__resolve__service == nil {
+			break
+		}
+
+		return e.complexity.Query.__resolve__service(childComplexity), true
+
+	case "Query._entities":
+		if e.complexity.Query.__resolve_entities == nil {
+			break
+		}
+
+		args, err := ec.field_Query__entities_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.__resolve_entities(childComplexity, args["representations"].([]map[string]interface{})), true
+
+	case "ResourceOwner.id":
+		if e.complexity.ResourceOwner.ID == nil {
+			break
+		}
+
+		return e.complexity.ResourceOwner.ID(childComplexity), true
 
 	case "ResourceOwner.loadBalancerPools":
 		if e.complexity.ResourceOwner.LoadBalancerPools == nil {
@@ -1517,7 +1984,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.ResourceOwner.LoadBalancers(childComplexity, args["after"].(*entgql.Cursor[gidx.PrefixedID]), args["first"].(*int), args["before"].(*entgql.Cursor[gidx.PrefixedID]), args["last"].(*int), args["orderBy"].(*generated.LoadBalancerOrder), args["where"].(*generated.LoadBalancerWhereInput)), true
+		return e.complexity.ResourceOwner.LoadBalancers(childComplexity, args["after"].(*entgql.Cursor[gidx.PrefixedID]), args["first"].(*int), args["before"].(*entgql.Cursor[gidx.PrefixedID]), args["last"].(*int), args["orderBy"].(*generated.LoadBalancerOrder), args["where"].(*generated.LoadBalancerWhereInput), args["includeDeleted"].(*bool)), true
 
 	case "ResourceOwner.loadBalancersProviders":
 		if e.complexity.ResourceOwner.LoadBalancersProviders == nil {
@@ -1529,7 +1996,31 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.ResourceOwner.LoadBalancersProviders(childComplexity, args["after"].(*entgql.Cursor[gidx.PrefixedID]), args["first"].(*int), args["before"].(*entgql.Cursor[gidx.PrefixedID]), args["last"].(*int), args["orderBy"].(*generated.LoadBalancerOrder), args["where"].(*generated.LoadBalancerProviderWhereInput)), true
+		return e.complexity.ResourceOwner.LoadBalancersProviders(childComplexity, args["after"].(*entgql.Cursor[gidx.PrefixedID]), args["first"].(*int), args["before"].(*entgql.Cursor[gidx.PrefixedID]), args["last"].(*int), args["orderBy"].(*generated.LoadBalancerProviderOrder), args["where"].(*generated.LoadBalancerProviderWhereInput)), true
+
+	case "Subscription.loadBalancerChanged":
+		if e.complexity.Subscription.LoadBalancerChanged == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_loadBalancerChanged_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.LoadBalancerChanged(childComplexity, args["id"].(gidx.PrefixedID)), true
+
+	case "Subscription.ownerLoadBalancersChanged":
+		if e.complexity.Subscription.OwnerLoadBalancersChanged == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_ownerLoadBalancersChanged_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.OwnerLoadBalancersChanged(childComplexity, args["ownerID"].(gidx.PrefixedID)), true
 
 	case "_Service.sdl":
 		if e.complexity._Service.SDL == nil {
@@ -1546,20 +2037,32 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	rc := graphql.GetOperationContext(ctx)
 	ec := executionContext{rc, e, 0, 0, make(chan graphql.DeferredResult)}
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputAuditEventFilter,
+		ec.unmarshalInputAuditEventOrder,
+		ec.unmarshalInputCreateLoadBalancerChildOriginInput,
+		ec.unmarshalInputCreateLoadBalancerChildPoolInput,
+		ec.unmarshalInputCreateLoadBalancerChildPortInput,
 		ec.unmarshalInputCreateLoadBalancerInput,
 		ec.unmarshalInputCreateLoadBalancerOriginInput,
 		ec.unmarshalInputCreateLoadBalancerPoolInput,
 		ec.unmarshalInputCreateLoadBalancerPortInput,
 		ec.unmarshalInputCreateLoadBalancerProviderInput,
+		ec.unmarshalInputCreateLoadBalancerWithChildrenInput,
+		ec.unmarshalInputLoadBalancerByIDsInput,
 		ec.unmarshalInputLoadBalancerOrder,
+		ec.unmarshalInputLoadBalancerOriginByIDsInput,
 		ec.unmarshalInputLoadBalancerOriginOrder,
 		ec.unmarshalInputLoadBalancerOriginWhereInput,
+		ec.unmarshalInputLoadBalancerPoolByIDsInput,
 		ec.unmarshalInputLoadBalancerPoolOrder,
 		ec.unmarshalInputLoadBalancerPoolWhereInput,
+		ec.unmarshalInputLoadBalancerPortByIDsInput,
 		ec.unmarshalInputLoadBalancerPortOrder,
 		ec.unmarshalInputLoadBalancerPortWhereInput,
+		ec.unmarshalInputLoadBalancerProviderByIDsInput,
 		ec.unmarshalInputLoadBalancerProviderOrder,
 		ec.unmarshalInputLoadBalancerProviderWhereInput,
+		ec.unmarshalInputLoadBalancerResyncInput,
 		ec.unmarshalInputLoadBalancerWhereInput,
 		ec.unmarshalInputUpdateLoadBalancerInput,
 		ec.unmarshalInputUpdateLoadBalancerOriginInput,
@@ -1611,6 +2114,23 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 			var buf bytes.Buffer
 			data.MarshalGQL(&buf)
 
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, rc.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
 			return &graphql.Response{
 				Data: buf.Bytes(),
 			}
@@ -1663,98 +2183,122 @@ func (ec *executionContext) introspectType(name string) (*introspection.Type, er
 }
 
 var sources = []*ast.Source{
-	{Name: "../../schema/ent.graphql", Input: `directive @goField(forceResolver: Boolean, name: String) on FIELD_DEFINITION | INPUT_FIELD_DEFINITION
-directive @goModel(model: String, models: [String!]) on OBJECT | INPUT_OBJECT | SCALAR | ENUM | INTERFACE | UNION
-"""
-Input information to create a load balancer.
-"""
-input CreateLoadBalancerInput {
-  """
-  The name of the load balancer.
-  """
-  name: String!
-  """
-  The ID for the owner for this load balancer.
-  """
-  ownerID: ID!
+	{Name: "../../schema/apply.graphql", Input: `extend type Mutation {
   """
-  The ID for the location of this load balancer.
+  Apply a declarative load balancer spec, creating, updating and deleting ports, pools and origins
+  so that they match the spec. All changes are applied in a single transaction.
   """
-  locationID: ID!
-  portIDs: [ID!]
-  providerID: ID!
+  loadBalancerApply(
+    """
+    The desired state of the load balancer.
+    """
+    spec: JSON!
+    """
+    Only plan the changes without applying them.
+    """
+    dryRun: Boolean = false
+  ): LoadBalancerApplyPayload!
 }
+
 """
-CreateLoadBalancerOriginInput is used for create LoadBalancerOrigin object.
-Input was generated by ent.
+The type of change made to a resource when applying a load balancer spec.
 """
-input CreateLoadBalancerOriginInput {
-  name: String!
-  weight: Int
-  target: String!
-  portNumber: Int!
-  active: Boolean
-  poolID: ID!
+enum LoadBalancerApplyAction {
+  CREATE
+  UPDATE
+  DELETE
 }
+
 """
-CreateLoadBalancerPoolInput is used for create LoadBalancerPool object.
-Input was generated by ent.
+A single change made, or planned, when applying a load balancer spec.
 """
-input CreateLoadBalancerPoolInput {
-  name: String!
-  protocol: LoadBalancerPoolProtocol!
-  ownerID: ID!
-  portIDs: [ID!]
-  originIDs: [ID!]
+type LoadBalancerApplyChange {
+  """
+  The type of change.
+  """
+  action: LoadBalancerApplyAction!
+  """
+  The type of resource being changed, one of load-balancer, load-balancer-port, load-balancer-pool or load-balancer-origin.
+  """
+  resourceType: String!
+  """
+  The ID of the existing resource. Not set for resources that are created.
+  """
+  id: ID
+  """
+  The key used to match the resource to the spec: the load balancer name, the port number, the pool name or
+  the pool and origin names separated by a slash.
+  """
+  key: String!
+  """
+  The fields that are changed.
+  """
+  fields: [AuditFieldChange!]!
 }
+
 """
-CreateLoadBalancerPortInput is used for create LoadBalancerPort object.
-Input was generated by ent.
+Return response from loadBalancerApply
 """
-input CreateLoadBalancerPortInput {
-  number: Int!
-  name: String
-  poolIDs: [ID!]
-  loadBalancerID: ID!
+type LoadBalancerApplyPayload {
+  """
+  The load balancer. Not set on a dry run of a load balancer that does not exist yet.
+  """
+  loadBalancer: LoadBalancer
+  """
+  Whether the changes were only planned.
+  """
+  dryRun: Boolean!
+  """
+  The changes made, or planned on a dry run, in the order they are applied.
+  """
+  changes: [LoadBalancerApplyChange!]!
 }
+`, BuiltIn: false},
+	{Name: "../../schema/audit.graphql", Input: `"""
+A single field change recorded in an audit event.
 """
-Input information to create a load balancer provider.
-"""
-input CreateLoadBalancerProviderInput {
+type AuditFieldChange {
   """
-  The name of the load balancer provider.
+  The name of the field that changed.
   """
-  name: String!
+  field: String!
   """
-  The ID for the owner for this load balancer.
+  The value of the field before the change.
   """
-  ownerID: ID!
+  previousValue: String!
+  """
+  The value of the field after the change.
+  """
+  currentValue: String!
 }
+
 """
-Define a Relay Cursor type:
-https://relay.dev/graphql/connections.htm#sec-Cursor
-"""
-scalar Cursor
-"""
-A valid JSON string.
+Filtering options for audit events.
 """
-scalar JSON
-type LoadBalancer implements Node & IPAddressable & MetadataNode @key(fields: "id") @prefixedID(prefix: "loadbal") {
+input AuditEventFilter {
   """
-  The ID for the load balancer.
+  Only return events made by the given actor.
   """
-  id: ID!
-  createdAt: Time!
-  updatedAt: Time!
-  createdBy: String
-  updatedBy: String
-  deletedAt: Time
-  deletedBy: String
+  actor: String
   """
-  The name of the load balancer.
+  Only return events that changed the given field.
   """
-  name: String!
-  ports(
+  field: String
+  """
+  Only return events made at or after the given time.
+  """
+  since: Time
+  """
+  Only return events made before the given time.
+  """
+  until: Time
+}
+
+extend type LoadBalancer {
+  """
+  The audit events for the load balancer and its ports.
+  """
+  auditEvents(
     """
     Returns the elements in the list that come after the specified cursor.
     """
@@ -1776,34 +2320,314 @@ type LoadBalancer implements Node & IPAddressable & MetadataNode @key(fields: "i
     last: Int
 
     """
-    Ordering options for LoadBalancerPorts returned from the connection.
+    Ordering options for AuditEvents returned from the connection.
     """
-    orderBy: LoadBalancerPortOrder
+    orderBy: AuditEventOrder
 
     """
-    Filtering options for LoadBalancerPorts returned from the connection.
+    Filtering options for AuditEvents returned from the connection.
     """
-    where: LoadBalancerPortWhereInput
-  ): LoadBalancerPortConnection!
+    filter: AuditEventFilter
+  ): AuditEventConnection! @goField(forceResolver: true)
+}
+
+extend type LoadBalancerPool {
   """
-  The load balancer provider for the load balancer.
+  The audit events for the load balancer pool and its origins.
   """
-  loadBalancerProvider: LoadBalancerProvider! @goField(name: "Provider", forceResolver: false)
+  auditEvents(
+    """
+    Returns the elements in the list that come after the specified cursor.
+    """
+    after: Cursor
+
+    """
+    Returns the first _n_ elements from the list.
+    """
+    first: Int
+
+    """
+    Returns the elements in the list that come before the specified cursor.
+    """
+    before: Cursor
+
+    """
+    Returns the last _n_ elements from the list.
+    """
+    last: Int
+
+    """
+    Ordering options for AuditEvents returned from the connection.
+    """
+    orderBy: AuditEventOrder
+
+    """
+    Filtering options for AuditEvents returned from the connection.
+    """
+    filter: AuditEventFilter
+  ): AuditEventConnection! @goField(forceResolver: true)
 }
-"""
-A connection to a list of items.
-"""
-type LoadBalancerConnection {
+
+extend type LoadBalancerProvider {
   """
-  A list of edges.
+  The audit events for the load balancer provider and the load balancers of owners the caller may read the history of.
   """
-  edges: [LoadBalancerEdge]
+  auditEvents(
+    """
+    Returns the elements in the list that come after the specified cursor.
+    """
+    after: Cursor
+
+    """
+    Returns the first _n_ elements from the list.
+    """
+    first: Int
+
+    """
+    Returns the elements in the list that come before the specified cursor.
+    """
+    before: Cursor
+
+    """
+    Returns the last _n_ elements from the list.
+    """
+    last: Int
+
+    """
+    Ordering options for AuditEvents returned from the connection.
+    """
+    orderBy: AuditEventOrder
+
+    """
+    Filtering options for AuditEvents returned from the connection.
+    """
+    filter: AuditEventFilter
+  ): AuditEventConnection! @goField(forceResolver: true)
+}
+`, BuiltIn: false},
+	{Name: "../../schema/ent.graphql", Input: `directive @goField(forceResolver: Boolean, name: String) on FIELD_DEFINITION | INPUT_FIELD_DEFINITION
+directive @goModel(model: String, models: [String!]) on OBJECT | INPUT_OBJECT | SCALAR | ENUM | INTERFACE | UNION
+type AuditEvent implements Node @prefixedID(prefix: "loadaud") {
   """
-  Information to aid in pagination.
+  The ID for the audit event.
   """
-  pageInfo: PageInfo!
+  id: ID!
   """
-  Identifies the total count of items in the connection.
+  The time the change was made.
+  """
+  createdAt: Time!
+  """
+  The actor that made the change.
+  """
+  actor: String!
+  """
+  The operation that was performed, one of create, update or delete.
+  """
+  operation: String!
+  """
+  The ID of the resource that was changed.
+  """
+  subjectID: ID!
+  """
+  The field changes, with their previous and current values.
+  """
+  changeset: [AuditFieldChange!]!
+}
+"""
+A connection to a list of items.
+"""
+type AuditEventConnection {
+  """
+  A list of edges.
+  """
+  edges: [AuditEventEdge]
+  """
+  Information to aid in pagination.
+  """
+  pageInfo: PageInfo!
+  """
+  Identifies the total count of items in the connection.
+  """
+  totalCount: Int!
+}
+"""
+An edge in a connection.
+"""
+type AuditEventEdge {
+  """
+  The item at the end of the edge.
+  """
+  node: AuditEvent
+  """
+  A cursor for use in pagination.
+  """
+  cursor: Cursor!
+}
+"""
+Ordering options for AuditEvent connections
+"""
+input AuditEventOrder {
+  """
+  The ordering direction.
+  """
+  direction: OrderDirection! = ASC
+  """
+  The field by which to order AuditEvents.
+  """
+  field: AuditEventOrderField!
+}
+"""
+Properties by which AuditEvent connections can be ordered.
+"""
+enum AuditEventOrderField {
+  CREATED_AT
+  ACTOR
+}
+"""
+Input information to create a load balancer.
+"""
+input CreateLoadBalancerInput {
+  """
+  The name of the load balancer.
+  """
+  name: String!
+  """
+  The ID for the owner for this load balancer.
+  """
+  ownerID: ID!
+  """
+  The ID for the location of this load balancer.
+  """
+  locationID: ID!
+  portIDs: [ID!]
+  providerID: ID!
+}
+"""
+CreateLoadBalancerOriginInput is used for create LoadBalancerOrigin object.
+Input was generated by ent.
+"""
+input CreateLoadBalancerOriginInput {
+  name: String!
+  weight: Int
+  target: String!
+  portNumber: Int!
+  active: Boolean
+  poolID: ID!
+}
+"""
+CreateLoadBalancerPoolInput is used for create LoadBalancerPool object.
+Input was generated by ent.
+"""
+input CreateLoadBalancerPoolInput {
+  name: String!
+  protocol: LoadBalancerPoolProtocol!
+  ownerID: ID!
+  portIDs: [ID!]
+  originIDs: [ID!]
+}
+"""
+CreateLoadBalancerPortInput is used for create LoadBalancerPort object.
+Input was generated by ent.
+"""
+input CreateLoadBalancerPortInput {
+  number: Int!
+  name: String
+  poolIDs: [ID!]
+  loadBalancerID: ID!
+}
+"""
+Input information to create a load balancer provider.
+"""
+input CreateLoadBalancerProviderInput {
+  """
+  The name of the load balancer provider.
+  """
+  name: String!
+  """
+  The ID for the owner for this load balancer.
+  """
+  ownerID: ID!
+}
+"""
+Define a Relay Cursor type:
+https://relay.dev/graphql/connections.htm#sec-Cursor
+"""
+scalar Cursor
+"""
+A valid JSON string.
+"""
+scalar JSON
+type LoadBalancer implements Node & IPAddressable & MetadataNode @key(fields: "id") @prefixedID(prefix: "loadbal") @entityResolver(multi: true) {
+  """
+  The ID for the load balancer.
+  """
+  id: ID!
+  createdAt: Time!
+  updatedAt: Time!
+  createdBy: String
+  updatedBy: String
+  version: Int!
+  deletedAt: Time
+  deletedBy: String
+  """
+  The name of the load balancer.
+  """
+  name: String!
+  ports(
+    """
+    Returns the elements in the list that come after the specified cursor.
+    """
+    after: Cursor
+
+    """
+    Returns the first _n_ elements from the list.
+    """
+    first: Int
+
+    """
+    Returns the elements in the list that come before the specified cursor.
+    """
+    before: Cursor
+
+    """
+    Returns the last _n_ elements from the list.
+    """
+    last: Int
+
+    """
+    Ordering options for LoadBalancerPorts returned from the connection.
+    """
+    orderBy: LoadBalancerPortOrder
+
+    """
+    Filtering options for LoadBalancerPorts returned from the connection.
+    """
+    where: LoadBalancerPortWhereInput
+
+    """
+    Include soft deleted elements in the list.
+    """
+    includeDeleted: Boolean = false
+  ): LoadBalancerPortConnection! @goField(forceResolver: true)
+  """
+  The load balancer provider for the load balancer.
+  """
+  loadBalancerProvider: LoadBalancerProvider! @goField(name: "Provider", forceResolver: false)
+}
+"""
+A connection to a list of items.
+"""
+type LoadBalancerConnection {
+  """
+  A list of edges.
+  """
+  edges: [LoadBalancerEdge]
+  """
+  Information to aid in pagination.
+  """
+  pageInfo: PageInfo!
+  """
+  Identifies the total count of items in the connection.
   """
   totalCount: Int!
 }
@@ -1847,7 +2671,7 @@ enum LoadBalancerOrderField {
   NAME
   OWNER
 }
-type LoadBalancerOrigin implements Node @key(fields: "id") @prefixedID(prefix: "loadogn") @goModel(model: "go.infratographer.com/load-balancer-api/internal/ent/generated.Origin") {
+type LoadBalancerOrigin implements Node @key(fields: "id") @prefixedID(prefix: "loadogn") @entityResolver(multi: true) @goModel(model: "go.infratographer.com/load-balancer-api/internal/ent/generated.Origin") {
   id: ID!
   createdAt: Time!
   updatedAt: Time!
@@ -1855,6 +2679,7 @@ type LoadBalancerOrigin implements Node @key(fields: "id") @prefixedID(prefix: "
   deletedBy: String
   createdBy: String
   updatedBy: String
+  version: Int!
   name: String!
   weight: Int!
   target: String!
@@ -2095,12 +2920,13 @@ input LoadBalancerOriginWhereInput {
   hasPool: Boolean
   hasPoolWith: [LoadBalancerPoolWhereInput!]
 }
-type LoadBalancerPool implements Node @key(fields: "id") @prefixedID(prefix: "loadpol") @goModel(model: "go.infratographer.com/load-balancer-api/internal/ent/generated.Pool") {
+type LoadBalancerPool implements Node @key(fields: "id") @prefixedID(prefix: "loadpol") @entityResolver(multi: true) @goModel(model: "go.infratographer.com/load-balancer-api/internal/ent/generated.Pool") {
   id: ID!
   createdAt: Time!
   updatedAt: Time!
   createdBy: String
   updatedBy: String
+  version: Int!
   deletedAt: Time
   deletedBy: String
   name: String!
@@ -2137,7 +2963,12 @@ type LoadBalancerPool implements Node @key(fields: "id") @prefixedID(prefix: "lo
     Filtering options for LoadBalancerOrigins returned from the connection.
     """
     where: LoadBalancerOriginWhereInput
-  ): LoadBalancerOriginConnection!
+
+    """
+    Include soft deleted elements in the list.
+    """
+    includeDeleted: Boolean = false
+  ): LoadBalancerOriginConnection! @goField(forceResolver: true)
 }
 """
 A connection to a list of items.
@@ -2344,7 +3175,7 @@ input LoadBalancerPoolWhereInput {
   hasOrigins: Boolean
   hasOriginsWith: [LoadBalancerOriginWhereInput!]
 }
-type LoadBalancerPort implements Node @key(fields: "id") @prefixedID(prefix: "loadprt") @goModel(model: "go.infratographer.com/load-balancer-api/internal/ent/generated.Port") {
+type LoadBalancerPort implements Node @key(fields: "id") @prefixedID(prefix: "loadprt") @entityResolver(multi: true) @goModel(model: "go.infratographer.com/load-balancer-api/internal/ent/generated.Port") {
   id: ID!
   createdAt: Time!
   updatedAt: Time!
@@ -2352,6 +3183,7 @@ type LoadBalancerPort implements Node @key(fields: "id") @prefixedID(prefix: "lo
   deletedBy: String
   createdBy: String
   updatedBy: String
+  version: Int!
   number: Int!
   name: String
   loadBalancerID: ID!
@@ -2562,7 +3394,7 @@ input LoadBalancerPortWhereInput {
   hasLoadBalancer: Boolean
   hasLoadBalancerWith: [LoadBalancerWhereInput!]
 }
-type LoadBalancerProvider implements Node @key(fields: "id") @prefixedID(prefix: "loadpvd") @goModel(model: "go.infratographer.com/load-balancer-api/internal/ent/generated.Provider") {
+type LoadBalancerProvider implements Node @key(fields: "id") @prefixedID(prefix: "loadpvd") @entityResolver(multi: true) @goModel(model: "go.infratographer.com/load-balancer-api/internal/ent/generated.Provider") {
   """
   The ID for the load balancer provider.
   """
@@ -2573,6 +3405,7 @@ type LoadBalancerProvider implements Node @key(fields: "id") @prefixedID(prefix:
   deletedBy: String
   createdBy: String
   updatedBy: String
+  version: Int!
   """
   The name of the load balancer provider.
   """
@@ -3073,16 +3906,79 @@ extend type Mutation {
     input: CreateLoadBalancerInput!
   ): LoadBalancerCreatePayload!
   """
+  Create a load balancer along with its ports, pools and origins in a single transaction.
+  """
+  loadBalancerCreateWithChildren(
+    input: CreateLoadBalancerWithChildrenInput!
+  ): LoadBalancerCreatePayload!
+  """
   Update a load balancer.
   """
   loadBalancerUpdate(
     id: ID!
     input: UpdateLoadBalancerInput!
+    """
+    The version of the resource the update is based on. When it does not match the current version the update fails with a CONFLICT error.
+    """
+    expectedVersion: Int
   ): LoadBalancerUpdatePayload!
   """
   Delete a load balancer.
   """
-  loadBalancerDelete(id: ID!): LoadBalancerDeletePayload!
+  loadBalancerDelete(
+    id: ID!
+    """
+    The version of the resource the delete is based on. When it does not match the current version the delete fails with a CONFLICT error.
+    """
+    expectedVersion: Int
+  ): LoadBalancerDeletePayload!
+}
+
+type Subscription {
+  """
+  Receive changes to a load balancer, its ports, pools and origins.
+  """
+  loadBalancerChanged(
+    """
+    The load balancer ID.
+    """
+    id: ID!
+  ): LoadBalancerChange!
+  """
+  Receive changes to the load balancers of an owner, their ports, pools and origins.
+  """
+  ownerLoadBalancersChanged(
+    """
+    The owner ID.
+    """
+    ownerID: ID!
+  ): LoadBalancerChange!
+}
+
+"""
+A change to a load balancer or one of its ports, pools or origins.
+"""
+type LoadBalancerChange {
+  """
+  The type of the change: create, update or delete.
+  """
+  eventType: String!
+  """
+  The ID of the changed resource, the load balancer or one of its ports, pools or origins.
+  """
+  subjectID: ID!
+  """
+  The ID of the load balancer the change applies to.
+  """
+  loadBalancerID: ID!
+  """
+  The load balancer after the change, empty once it has been deleted.
+  """
+  loadBalancer: LoadBalancer
+  """
+  The time the change was made.
+  """
+  timestamp: Time!
 }
 
 """
@@ -3114,35 +4010,135 @@ type LoadBalancerUpdatePayload {
   """
   loadBalancer: LoadBalancer!
 }
-`, BuiltIn: false},
-	{Name: "../../schema/location.graphql", Input: `directive @prefixedID(prefix: String!) on OBJECT
-
-type Location @key(fields: "id") {
-  id: ID!
-  loadBalancers(
-    """
-    Returns the elements in the list that come after the specified cursor.
-    """
-    after: Cursor
-
-    """
-    Returns the first _n_ elements from the list.
-    """
-    first: Int
-
-    """
-    Returns the elements in the list that come before the specified cursor.
-    """
-    before: Cursor
 
-    """
-    Returns the last _n_ elements from the list.
-    """
-    last: Int
-
-    """
-    Ordering options for LoadBalancers returned from the connection.
-    """
+"""
+Input information to create a load balancer along with its ports, pools and origins.
+"""
+input CreateLoadBalancerWithChildrenInput {
+  """
+  The name of the load balancer.
+  """
+  name: String!
+  """
+  The ID for the owner for this load balancer and its pools.
+  """
+  ownerID: ID!
+  """
+  The ID for the location of this load balancer.
+  """
+  locationID: ID!
+  """
+  The ID for the provider of this load balancer.
+  """
+  providerID: ID!
+  """
+  The ports to create on the load balancer.
+  """
+  ports: [CreateLoadBalancerChildPortInput!]
+  """
+  A client provided key that makes the create safe to retry. Repeating the request with the same key returns the originally created resource.
+  """
+  idempotencyKey: String
+}
+
+"""
+Input information to create a port as part of loadBalancerCreateWithChildren.
+"""
+input CreateLoadBalancerChildPortInput {
+  """
+  The port number.
+  """
+  number: Int!
+  """
+  The name of the port.
+  """
+  name: String
+  """
+  The pools to create and assign to the port.
+  """
+  pools: [CreateLoadBalancerChildPoolInput!]
+}
+
+"""
+Input information to create a pool as part of loadBalancerCreateWithChildren.
+"""
+input CreateLoadBalancerChildPoolInput {
+  """
+  The name of the pool.
+  """
+  name: String!
+  """
+  The protocol for the pool.
+  """
+  protocol: LoadBalancerPoolProtocol!
+  """
+  The origins to create in the pool.
+  """
+  origins: [CreateLoadBalancerChildOriginInput!]
+}
+
+"""
+Input information to create an origin as part of loadBalancerCreateWithChildren.
+"""
+input CreateLoadBalancerChildOriginInput {
+  """
+  The name of the origin.
+  """
+  name: String!
+  """
+  The weight of the origin.
+  """
+  weight: Int
+  """
+  The target of the origin.
+  """
+  target: String!
+  """
+  The port number of the origin.
+  """
+  portNumber: Int!
+  """
+  Whether the origin is active.
+  """
+  active: Boolean
+}
+
+extend input CreateLoadBalancerInput {
+  """
+  A client provided key that makes the create safe to retry. Repeating the request with the same key returns the originally created resource.
+  """
+  idempotencyKey: String
+}
+`, BuiltIn: false},
+	{Name: "../../schema/location.graphql", Input: `directive @prefixedID(prefix: String!) on OBJECT
+directive @entityResolver(multi: Boolean) on OBJECT
+
+type Location @key(fields: "id") {
+  id: ID!
+  loadBalancers(
+    """
+    Returns the elements in the list that come after the specified cursor.
+    """
+    after: Cursor
+
+    """
+    Returns the first _n_ elements from the list.
+    """
+    first: Int
+
+    """
+    Returns the elements in the list that come before the specified cursor.
+    """
+    before: Cursor
+
+    """
+    Returns the last _n_ elements from the list.
+    """
+    last: Int
+
+    """
+    Ordering options for LoadBalancers returned from the connection.
+    """
     orderBy: LoadBalancerOrder
 
     """
@@ -3163,7 +4159,24 @@ extend type LoadBalancer {
   id: ID!
 }
 `, BuiltIn: false},
-	{Name: "../../schema/origin.graphql", Input: `type Mutation {
+	{Name: "../../schema/origin.graphql", Input: `extend type Query {
+  """
+  Lookup a pool origin by ID.
+  """
+  loadBalancerOrigin(
+    """The pool origin ID."""
+    id: ID!
+  ): LoadBalancerOrigin!
+  """
+  Lookup a pool origin by ID, including soft deleted origins.
+  """
+  loadBalancerOriginHistory(
+    """The pool origin ID."""
+    id: ID!
+  ): LoadBalancerOrigin!
+}
+
+type Mutation {
   """
   Create a loadbalancer pool origin
   """
@@ -3177,12 +4190,22 @@ extend type LoadBalancer {
   loadBalancerOriginUpdate(
     id: ID!
     input: UpdateLoadBalancerOriginInput!
+    """
+    The version of the resource the update is based on. When it does not match the current version the update fails with a CONFLICT error.
+    """
+    expectedVersion: Int
   ): LoadBalancerOriginUpdatePayload!
 
   """
   Delete a loadbalancer pool origin
   """
-  loadBalancerOriginDelete(id: ID!): LoadBalancerOriginDeletePayload!
+  loadBalancerOriginDelete(
+    id: ID!
+    """
+    The version of the resource the delete is based on. When it does not match the current version the delete fails with a CONFLICT error.
+    """
+    expectedVersion: Int
+  ): LoadBalancerOriginDeletePayload!
 }
 
 """
@@ -3214,6 +4237,13 @@ type LoadBalancerOriginDeletePayload {
   """
   deletedID: ID!
 }
+
+extend input CreateLoadBalancerOriginInput {
+  """
+  A client provided key that makes the create safe to retry. Repeating the request with the same key returns the originally created resource.
+  """
+  idempotencyKey: String
+}
 `, BuiltIn: false},
 	{Name: "../../schema/owner.graphql", Input: `type ResourceOwner @interfaceObject @key(fields: "id") {
   id: ID!
@@ -3247,6 +4277,11 @@ type LoadBalancerOriginDeletePayload {
     Filtering options for LoadBalancers returned from the connection.
     """
     where: LoadBalancerWhereInput
+
+    """
+    Include soft deleted elements in the list.
+    """
+    includeDeleted: Boolean = false
   ): LoadBalancerConnection! @goField(forceResolver: true)
   loadBalancerPools(
     """
@@ -3303,7 +4338,7 @@ type LoadBalancerOriginDeletePayload {
     """
     Ordering options for LoadBalancerProviders returned from the connection.
     """
-    orderBy: LoadBalancerOrder
+    orderBy: LoadBalancerProviderOrder
 
     """
     Filtering options for LoadBalancerProviders returned from the connection.
@@ -3341,6 +4376,13 @@ extend type LoadBalancerProvider {
     """The pool ID."""
     id: ID!
   ): LoadBalancerPool!
+  """
+  Lookup a pool by ID, including soft deleted pools.
+  """
+  loadBalancerPoolHistory(
+    """The pool ID."""
+    id: ID!
+  ): LoadBalancerPool!
 }
 
 extend type Mutation {
@@ -3351,11 +4393,24 @@ extend type Mutation {
   """
   Update a pool.
   """
-  loadBalancerPoolUpdate(id: ID!, input: UpdateLoadBalancerPoolInput!): LoadBalancerPoolUpdatePayload!
+  loadBalancerPoolUpdate(
+    id: ID!
+    input: UpdateLoadBalancerPoolInput!
+    """
+    The version of the resource the update is based on. When it does not match the current version the update fails with a CONFLICT error.
+    """
+    expectedVersion: Int
+  ): LoadBalancerPoolUpdatePayload!
   """
   Delete a pool.
   """
-  loadBalancerPoolDelete(id: ID!): LoadBalancerPoolDeletePayload!
+  loadBalancerPoolDelete(
+    id: ID!
+    """
+    The version of the resource the delete is based on. When it does not match the current version the delete fails with a CONFLICT error.
+    """
+    expectedVersion: Int
+  ): LoadBalancerPoolDeletePayload!
 }
 
 """
@@ -3387,6 +4442,13 @@ type LoadBalancerPoolDeletePayload {
   """
   deletedID: ID
 }
+
+extend input CreateLoadBalancerPoolInput {
+  """
+  A client provided key that makes the create safe to retry. Repeating the request with the same key returns the originally created resource.
+  """
+  idempotencyKey: String
+}
 `, BuiltIn: false},
 	{Name: "../../schema/port.graphql", Input: `extend type Query {
   """
@@ -3396,6 +4458,13 @@ type LoadBalancerPoolDeletePayload {
     """The port ID."""
     id: ID!
   ): LoadBalancerPort!
+  """
+  Lookup a port by ID, including soft deleted ports.
+  """
+  loadBalancerPortHistory(
+    """The port ID."""
+    id: ID!
+  ): LoadBalancerPort!
 }
 
 extend type Mutation {
@@ -3412,12 +4481,22 @@ extend type Mutation {
   loadBalancerPortUpdate(
     id: ID!
     input: UpdateLoadBalancerPortInput!
+    """
+    The version of the resource the update is based on. When it does not match the current version the update fails with a CONFLICT error.
+    """
+    expectedVersion: Int
   ): LoadBalancerPortUpdatePayload!
 
   """
   Delete a load balancer port
   """
-  loadBalancerPortDelete(id: ID!): LoadBalancerPortDeletePayload!
+  loadBalancerPortDelete(
+    id: ID!
+    """
+    The version of the resource the delete is based on. When it does not match the current version the delete fails with a CONFLICT error.
+    """
+    expectedVersion: Int
+  ): LoadBalancerPortDeletePayload!
 }
 
 """
@@ -3449,6 +4528,13 @@ type LoadBalancerPortDeletePayload {
   """
   deletedID: ID!
 }
+
+extend input CreateLoadBalancerPortInput {
+  """
+  A client provided key that makes the create safe to retry. Repeating the request with the same key returns the originally created resource.
+  """
+  idempotencyKey: String
+}
 `, BuiltIn: false},
 	{Name: "../../schema/provider.graphql", Input: `extend type Query {
   """
@@ -3460,6 +4546,15 @@ type LoadBalancerPortDeletePayload {
     """
     id: ID!
   ): LoadBalancerProvider!
+  """
+  Lookup a load balancer provider by ID, including soft deleted providers.
+  """
+  loadBalancerProviderHistory(
+    """
+    The load balancer provider ID.
+    """
+    id: ID!
+  ): LoadBalancerProvider!
 }
 
 extend type Mutation {
@@ -3475,11 +4570,21 @@ extend type Mutation {
   loadBalancerProviderUpdate(
     id: ID!
     input: UpdateLoadBalancerProviderInput!
+    """
+    The version of the resource the update is based on. When it does not match the current version the update fails with a CONFLICT error.
+    """
+    expectedVersion: Int
   ): LoadBalancerProviderUpdatePayload!
   """
   Delete a load balancer provider.
   """
-  loadBalancerProviderDelete(id: ID!): LoadBalancerProviderDeletePayload!
+  loadBalancerProviderDelete(
+    id: ID!
+    """
+    The version of the resource the delete is based on. When it does not match the current version the delete fails with a CONFLICT error.
+    """
+    expectedVersion: Int
+  ): LoadBalancerProviderDeletePayload!
 }
 
 """
@@ -3511,6 +4616,67 @@ type LoadBalancerProviderUpdatePayload {
   """
   loadBalancerProvider: LoadBalancerProvider!
 }
+
+extend input CreateLoadBalancerProviderInput {
+  """
+  A client provided key that makes the create safe to retry. Repeating the request with the same key returns the originally created resource.
+  """
+  idempotencyKey: String
+}
+`, BuiltIn: false},
+	{Name: "../../schema/resync.graphql", Input: `extend type Mutation {
+  """
+  Republish a change message for every live load balancer, port, pool and origin matching the filter, so
+  consumers can rebuild their state. The messages use the resync event type. At least one filter is required,
+  a full resync is only available through the resync command.
+  """
+  loadBalancerResync(
+    """
+    The resources to republish and where to continue from.
+    """
+    input: LoadBalancerResyncInput!
+  ): LoadBalancerResyncPayload!
+}
+
+"""
+Input information to resync load balancer resources.
+"""
+input LoadBalancerResyncInput {
+  """
+  Only resync the resources of this owner.
+  """
+  ownerID: ID
+  """
+  Only resync the resources in this location.
+  """
+  locationID: ID
+  """
+  Only resync the resources of this provider.
+  """
+  providerID: ID
+  """
+  The cursor returned by a previous resync to continue after.
+  """
+  after: ID
+  """
+  The maximum number of messages to publish. Defaults to 100 and is capped at 1000.
+  """
+  limit: Int
+}
+
+"""
+Return response from loadBalancerResync
+"""
+type LoadBalancerResyncPayload {
+  """
+  The number of messages published.
+  """
+  published: Int!
+  """
+  The cursor to continue the resync from. Not set once all resources have been published.
+  """
+  cursor: ID
+}
 `, BuiltIn: false},
 	{Name: "../../federation/directives.graphql", Input: `
 	directive @composeDirective(name: String!) repeatable on SCHEMA
@@ -3552,13 +4718,33 @@ type LoadBalancerProviderUpdatePayload {
 # a union of all types that use the @key directive
 union _Entity = LoadBalancer | LoadBalancerOrigin | LoadBalancerPool | LoadBalancerPort | LoadBalancerProvider | Location | ResourceOwner
 
+input LoadBalancerByIDsInput {
+	ID: ID!
+}
+
+input LoadBalancerOriginByIDsInput {
+	ID: ID!
+}
+
+input LoadBalancerPoolByIDsInput {
+	ID: ID!
+}
+
+input LoadBalancerPortByIDsInput {
+	ID: ID!
+}
+
+input LoadBalancerProviderByIDsInput {
+	ID: ID!
+}
+
 # fake type to build resolver interfaces for users to implement
 type Entity {
-		findLoadBalancerByID(id: ID!,): LoadBalancer!
-	findLoadBalancerOriginByID(id: ID!,): LoadBalancerOrigin!
-	findLoadBalancerPoolByID(id: ID!,): LoadBalancerPool!
-	findLoadBalancerPortByID(id: ID!,): LoadBalancerPort!
-	findLoadBalancerProviderByID(id: ID!,): LoadBalancerProvider!
+		findManyLoadBalancerByIDs(reps: [LoadBalancerByIDsInput!]!): [LoadBalancer]
+	findManyLoadBalancerOriginByIDs(reps: [LoadBalancerOriginByIDsInput!]!): [LoadBalancerOrigin]
+	findManyLoadBalancerPoolByIDs(reps: [LoadBalancerPoolByIDsInput!]!): [LoadBalancerPool]
+	findManyLoadBalancerPortByIDs(reps: [LoadBalancerPortByIDsInput!]!): [LoadBalancerPort]
+	findManyLoadBalancerProviderByIDs(reps: [LoadBalancerProviderByIDsInput!]!): [LoadBalancerProvider]
 	findLocationByID(id: ID!,): Location!
 	findResourceOwnerByID(id: ID!,): ResourceOwner!
 
@@ -3595,7 +4781,7 @@ func (ec *executionContext) dir_composeDirective_args(ctx context.Context, rawAr
 	return args, nil
 }
 
-func (ec *executionContext) field_Entity_findLoadBalancerByID_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Entity_findLocationByID_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 gidx.PrefixedID
@@ -3610,78 +4796,78 @@ func (ec *executionContext) field_Entity_findLoadBalancerByID_args(ctx context.C
 	return args, nil
 }
 
-func (ec *executionContext) field_Entity_findLoadBalancerOriginByID_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Entity_findManyLoadBalancerByIDs_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 gidx.PrefixedID
-	if tmp, ok := rawArgs["id"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-		arg0, err = ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, tmp)
+	var arg0 []*LoadBalancerByIDsInput
+	if tmp, ok := rawArgs["reps"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reps"))
+		arg0, err = ec.unmarshalNLoadBalancerByIDsInput2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerByIDsInputᚄ(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["id"] = arg0
+	args["reps"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Entity_findLoadBalancerPoolByID_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Entity_findManyLoadBalancerOriginByIDs_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 gidx.PrefixedID
-	if tmp, ok := rawArgs["id"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-		arg0, err = ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, tmp)
+	var arg0 []*LoadBalancerOriginByIDsInput
+	if tmp, ok := rawArgs["reps"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reps"))
+		arg0, err = ec.unmarshalNLoadBalancerOriginByIDsInput2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerOriginByIDsInputᚄ(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["id"] = arg0
+	args["reps"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Entity_findLoadBalancerPortByID_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Entity_findManyLoadBalancerPoolByIDs_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 gidx.PrefixedID
-	if tmp, ok := rawArgs["id"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-		arg0, err = ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, tmp)
+	var arg0 []*LoadBalancerPoolByIDsInput
+	if tmp, ok := rawArgs["reps"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reps"))
+		arg0, err = ec.unmarshalNLoadBalancerPoolByIDsInput2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerPoolByIDsInputᚄ(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["id"] = arg0
+	args["reps"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Entity_findLoadBalancerProviderByID_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Entity_findManyLoadBalancerPortByIDs_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 gidx.PrefixedID
-	if tmp, ok := rawArgs["id"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-		arg0, err = ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, tmp)
+	var arg0 []*LoadBalancerPortByIDsInput
+	if tmp, ok := rawArgs["reps"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reps"))
+		arg0, err = ec.unmarshalNLoadBalancerPortByIDsInput2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerPortByIDsInputᚄ(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["id"] = arg0
+	args["reps"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Entity_findLocationByID_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Entity_findManyLoadBalancerProviderByIDs_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 gidx.PrefixedID
-	if tmp, ok := rawArgs["id"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-		arg0, err = ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, tmp)
+	var arg0 []*LoadBalancerProviderByIDsInput
+	if tmp, ok := rawArgs["reps"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reps"))
+		arg0, err = ec.unmarshalNLoadBalancerProviderByIDsInput2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerProviderByIDsInputᚄ(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["id"] = arg0
+	args["reps"] = arg0
 	return args, nil
 }
 
@@ -3700,7 +4886,7 @@ func (ec *executionContext) field_Entity_findResourceOwnerByID_args(ctx context.
 	return args, nil
 }
 
-func (ec *executionContext) field_LoadBalancerPool_origins_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_LoadBalancerPool_auditEvents_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 *entgql.Cursor[gidx.PrefixedID]
@@ -3739,28 +4925,28 @@ func (ec *executionContext) field_LoadBalancerPool_origins_args(ctx context.Cont
 		}
 	}
 	args["last"] = arg3
-	var arg4 *generated.LoadBalancerOriginOrder
+	var arg4 *generated.AuditEventOrder
 	if tmp, ok := rawArgs["orderBy"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("orderBy"))
-		arg4, err = ec.unmarshalOLoadBalancerOriginOrder2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerOriginOrder(ctx, tmp)
+		arg4, err = ec.unmarshalOAuditEventOrder2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐAuditEventOrder(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
 	args["orderBy"] = arg4
-	var arg5 *generated.LoadBalancerOriginWhereInput
-	if tmp, ok := rawArgs["where"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("where"))
-		arg5, err = ec.unmarshalOLoadBalancerOriginWhereInput2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerOriginWhereInput(ctx, tmp)
+	var arg5 *AuditEventFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+		arg5, err = ec.unmarshalOAuditEventFilter2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐAuditEventFilter(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["where"] = arg5
+	args["filter"] = arg5
 	return args, nil
 }
 
-func (ec *executionContext) field_LoadBalancerProvider_loadBalancers_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_LoadBalancerPool_origins_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 *entgql.Cursor[gidx.PrefixedID]
@@ -3799,28 +4985,37 @@ func (ec *executionContext) field_LoadBalancerProvider_loadBalancers_args(ctx co
 		}
 	}
 	args["last"] = arg3
-	var arg4 *generated.LoadBalancerOrder
+	var arg4 *generated.LoadBalancerOriginOrder
 	if tmp, ok := rawArgs["orderBy"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("orderBy"))
-		arg4, err = ec.unmarshalOLoadBalancerOrder2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerOrder(ctx, tmp)
+		arg4, err = ec.unmarshalOLoadBalancerOriginOrder2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerOriginOrder(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
 	args["orderBy"] = arg4
-	var arg5 *generated.LoadBalancerWhereInput
+	var arg5 *generated.LoadBalancerOriginWhereInput
 	if tmp, ok := rawArgs["where"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("where"))
-		arg5, err = ec.unmarshalOLoadBalancerWhereInput2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerWhereInput(ctx, tmp)
+		arg5, err = ec.unmarshalOLoadBalancerOriginWhereInput2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerOriginWhereInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
 	args["where"] = arg5
+	var arg6 *bool
+	if tmp, ok := rawArgs["includeDeleted"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeleted"))
+		arg6, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeleted"] = arg6
 	return args, nil
 }
 
-func (ec *executionContext) field_LoadBalancer_ports_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_LoadBalancerProvider_auditEvents_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 *entgql.Cursor[gidx.PrefixedID]
@@ -3859,24 +5054,213 @@ func (ec *executionContext) field_LoadBalancer_ports_args(ctx context.Context, r
 		}
 	}
 	args["last"] = arg3
-	var arg4 *generated.LoadBalancerPortOrder
+	var arg4 *generated.AuditEventOrder
 	if tmp, ok := rawArgs["orderBy"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("orderBy"))
-		arg4, err = ec.unmarshalOLoadBalancerPortOrder2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerPortOrder(ctx, tmp)
+		arg4, err = ec.unmarshalOAuditEventOrder2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐAuditEventOrder(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
 	args["orderBy"] = arg4
-	var arg5 *generated.LoadBalancerPortWhereInput
-	if tmp, ok := rawArgs["where"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("where"))
+	var arg5 *AuditEventFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+		arg5, err = ec.unmarshalOAuditEventFilter2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐAuditEventFilter(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filter"] = arg5
+	return args, nil
+}
+
+func (ec *executionContext) field_LoadBalancerProvider_loadBalancers_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *entgql.Cursor[gidx.PrefixedID]
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg0, err = ec.unmarshalOCursor2ᚖentgoᚗioᚋcontribᚋentgqlᚐCursor(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["first"] = arg1
+	var arg2 *entgql.Cursor[gidx.PrefixedID]
+	if tmp, ok := rawArgs["before"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("before"))
+		arg2, err = ec.unmarshalOCursor2ᚖentgoᚗioᚋcontribᚋentgqlᚐCursor(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["before"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["last"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("last"))
+		arg3, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["last"] = arg3
+	var arg4 *generated.LoadBalancerOrder
+	if tmp, ok := rawArgs["orderBy"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("orderBy"))
+		arg4, err = ec.unmarshalOLoadBalancerOrder2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerOrder(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["orderBy"] = arg4
+	var arg5 *generated.LoadBalancerWhereInput
+	if tmp, ok := rawArgs["where"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("where"))
+		arg5, err = ec.unmarshalOLoadBalancerWhereInput2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerWhereInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["where"] = arg5
+	return args, nil
+}
+
+func (ec *executionContext) field_LoadBalancer_auditEvents_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *entgql.Cursor[gidx.PrefixedID]
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg0, err = ec.unmarshalOCursor2ᚖentgoᚗioᚋcontribᚋentgqlᚐCursor(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["first"] = arg1
+	var arg2 *entgql.Cursor[gidx.PrefixedID]
+	if tmp, ok := rawArgs["before"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("before"))
+		arg2, err = ec.unmarshalOCursor2ᚖentgoᚗioᚋcontribᚋentgqlᚐCursor(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["before"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["last"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("last"))
+		arg3, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["last"] = arg3
+	var arg4 *generated.AuditEventOrder
+	if tmp, ok := rawArgs["orderBy"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("orderBy"))
+		arg4, err = ec.unmarshalOAuditEventOrder2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐAuditEventOrder(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["orderBy"] = arg4
+	var arg5 *AuditEventFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+		arg5, err = ec.unmarshalOAuditEventFilter2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐAuditEventFilter(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filter"] = arg5
+	return args, nil
+}
+
+func (ec *executionContext) field_LoadBalancer_ports_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *entgql.Cursor[gidx.PrefixedID]
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg0, err = ec.unmarshalOCursor2ᚖentgoᚗioᚋcontribᚋentgqlᚐCursor(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["first"] = arg1
+	var arg2 *entgql.Cursor[gidx.PrefixedID]
+	if tmp, ok := rawArgs["before"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("before"))
+		arg2, err = ec.unmarshalOCursor2ᚖentgoᚗioᚋcontribᚋentgqlᚐCursor(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["before"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["last"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("last"))
+		arg3, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["last"] = arg3
+	var arg4 *generated.LoadBalancerPortOrder
+	if tmp, ok := rawArgs["orderBy"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("orderBy"))
+		arg4, err = ec.unmarshalOLoadBalancerPortOrder2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerPortOrder(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["orderBy"] = arg4
+	var arg5 *generated.LoadBalancerPortWhereInput
+	if tmp, ok := rawArgs["where"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("where"))
 		arg5, err = ec.unmarshalOLoadBalancerPortWhereInput2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerPortWhereInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
 	args["where"] = arg5
+	var arg6 *bool
+	if tmp, ok := rawArgs["includeDeleted"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeleted"))
+		arg6, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeleted"] = arg6
 	return args, nil
 }
 
@@ -3940,13 +5324,52 @@ func (ec *executionContext) field_Location_loadBalancers_args(ctx context.Contex
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_loadBalancerApply_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 json.RawMessage
+	if tmp, ok := rawArgs["spec"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("spec"))
+		arg0, err = ec.unmarshalNJSON2encodingᚋjsonᚐRawMessage(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["spec"] = arg0
+	var arg1 *bool
+	if tmp, ok := rawArgs["dryRun"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dryRun"))
+		arg1, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["dryRun"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_loadBalancerCreateWithChildren_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 CreateLoadBalancerWithChildrenInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNCreateLoadBalancerWithChildrenInput2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐCreateLoadBalancerWithChildrenInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_loadBalancerCreate_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 generated.CreateLoadBalancerInput
+	var arg0 CreateLoadBalancerInput
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalNCreateLoadBalancerInput2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐCreateLoadBalancerInput(ctx, tmp)
+		arg0, err = ec.unmarshalNCreateLoadBalancerInput2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐCreateLoadBalancerInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -3967,16 +5390,25 @@ func (ec *executionContext) field_Mutation_loadBalancerDelete_args(ctx context.C
 		}
 	}
 	args["id"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["expectedVersion"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("expectedVersion"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["expectedVersion"] = arg1
 	return args, nil
 }
 
 func (ec *executionContext) field_Mutation_loadBalancerOriginCreate_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 generated.CreateLoadBalancerOriginInput
+	var arg0 CreateLoadBalancerOriginInput
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalNCreateLoadBalancerOriginInput2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐCreateLoadBalancerOriginInput(ctx, tmp)
+		arg0, err = ec.unmarshalNCreateLoadBalancerOriginInput2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐCreateLoadBalancerOriginInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -3997,6 +5429,15 @@ func (ec *executionContext) field_Mutation_loadBalancerOriginDelete_args(ctx con
 		}
 	}
 	args["id"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["expectedVersion"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("expectedVersion"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["expectedVersion"] = arg1
 	return args, nil
 }
 
@@ -4021,16 +5462,25 @@ func (ec *executionContext) field_Mutation_loadBalancerOriginUpdate_args(ctx con
 		}
 	}
 	args["input"] = arg1
+	var arg2 *int
+	if tmp, ok := rawArgs["expectedVersion"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("expectedVersion"))
+		arg2, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["expectedVersion"] = arg2
 	return args, nil
 }
 
 func (ec *executionContext) field_Mutation_loadBalancerPoolCreate_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 generated.CreateLoadBalancerPoolInput
+	var arg0 CreateLoadBalancerPoolInput
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalNCreateLoadBalancerPoolInput2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐCreateLoadBalancerPoolInput(ctx, tmp)
+		arg0, err = ec.unmarshalNCreateLoadBalancerPoolInput2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐCreateLoadBalancerPoolInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -4051,6 +5501,15 @@ func (ec *executionContext) field_Mutation_loadBalancerPoolDelete_args(ctx conte
 		}
 	}
 	args["id"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["expectedVersion"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("expectedVersion"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["expectedVersion"] = arg1
 	return args, nil
 }
 
@@ -4075,16 +5534,25 @@ func (ec *executionContext) field_Mutation_loadBalancerPoolUpdate_args(ctx conte
 		}
 	}
 	args["input"] = arg1
+	var arg2 *int
+	if tmp, ok := rawArgs["expectedVersion"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("expectedVersion"))
+		arg2, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["expectedVersion"] = arg2
 	return args, nil
 }
 
 func (ec *executionContext) field_Mutation_loadBalancerPortCreate_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 generated.CreateLoadBalancerPortInput
+	var arg0 CreateLoadBalancerPortInput
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalNCreateLoadBalancerPortInput2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐCreateLoadBalancerPortInput(ctx, tmp)
+		arg0, err = ec.unmarshalNCreateLoadBalancerPortInput2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐCreateLoadBalancerPortInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -4105,6 +5573,15 @@ func (ec *executionContext) field_Mutation_loadBalancerPortDelete_args(ctx conte
 		}
 	}
 	args["id"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["expectedVersion"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("expectedVersion"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["expectedVersion"] = arg1
 	return args, nil
 }
 
@@ -4129,16 +5606,25 @@ func (ec *executionContext) field_Mutation_loadBalancerPortUpdate_args(ctx conte
 		}
 	}
 	args["input"] = arg1
+	var arg2 *int
+	if tmp, ok := rawArgs["expectedVersion"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("expectedVersion"))
+		arg2, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["expectedVersion"] = arg2
 	return args, nil
 }
 
 func (ec *executionContext) field_Mutation_loadBalancerProviderCreate_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 generated.CreateLoadBalancerProviderInput
+	var arg0 CreateLoadBalancerProviderInput
 	if tmp, ok := rawArgs["input"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-		arg0, err = ec.unmarshalNCreateLoadBalancerProviderInput2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐCreateLoadBalancerProviderInput(ctx, tmp)
+		arg0, err = ec.unmarshalNCreateLoadBalancerProviderInput2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐCreateLoadBalancerProviderInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -4159,6 +5645,15 @@ func (ec *executionContext) field_Mutation_loadBalancerProviderDelete_args(ctx c
 		}
 	}
 	args["id"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["expectedVersion"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("expectedVersion"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["expectedVersion"] = arg1
 	return args, nil
 }
 
@@ -4183,30 +5678,63 @@ func (ec *executionContext) field_Mutation_loadBalancerProviderUpdate_args(ctx c
 		}
 	}
 	args["input"] = arg1
+	var arg2 *int
+	if tmp, ok := rawArgs["expectedVersion"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("expectedVersion"))
+		arg2, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["expectedVersion"] = arg2
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_loadBalancerUpdate_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_loadBalancerResync_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 gidx.PrefixedID
-	if tmp, ok := rawArgs["id"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-		arg0, err = ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, tmp)
+	var arg0 LoadBalancerResyncInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNLoadBalancerResyncInput2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerResyncInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["id"] = arg0
-	var arg1 generated.UpdateLoadBalancerInput
-	if tmp, ok := rawArgs["input"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_loadBalancerUpdate_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 gidx.PrefixedID
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	var arg1 generated.UpdateLoadBalancerInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
 		arg1, err = ec.unmarshalNUpdateLoadBalancerInput2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐUpdateLoadBalancerInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
 	args["input"] = arg1
+	var arg2 *int
+	if tmp, ok := rawArgs["expectedVersion"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("expectedVersion"))
+		arg2, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["expectedVersion"] = arg2
 	return args, nil
 }
 
@@ -4255,6 +5783,51 @@ func (ec *executionContext) field_Query_loadBalancerHistory_args(ctx context.Con
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_loadBalancerOriginHistory_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 gidx.PrefixedID
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_loadBalancerOrigin_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 gidx.PrefixedID
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_loadBalancerPoolHistory_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 gidx.PrefixedID
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_loadBalancerPool_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -4270,6 +5843,21 @@ func (ec *executionContext) field_Query_loadBalancerPool_args(ctx context.Contex
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_loadBalancerPortHistory_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 gidx.PrefixedID
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_loadBalancerPort_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -4285,6 +5873,21 @@ func (ec *executionContext) field_Query_loadBalancerPort_args(ctx context.Contex
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_loadBalancerProviderHistory_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 gidx.PrefixedID
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_loadBalancerProvider_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -4414,10 +6017,10 @@ func (ec *executionContext) field_ResourceOwner_loadBalancersProviders_args(ctx
 		}
 	}
 	args["last"] = arg3
-	var arg4 *generated.LoadBalancerOrder
+	var arg4 *generated.LoadBalancerProviderOrder
 	if tmp, ok := rawArgs["orderBy"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("orderBy"))
-		arg4, err = ec.unmarshalOLoadBalancerOrder2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerOrder(ctx, tmp)
+		arg4, err = ec.unmarshalOLoadBalancerProviderOrder2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerProviderOrder(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -4492,6 +6095,45 @@ func (ec *executionContext) field_ResourceOwner_loadBalancers_args(ctx context.C
 		}
 	}
 	args["where"] = arg5
+	var arg6 *bool
+	if tmp, ok := rawArgs["includeDeleted"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeleted"))
+		arg6, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeleted"] = arg6
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_loadBalancerChanged_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 gidx.PrefixedID
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_ownerLoadBalancersChanged_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 gidx.PrefixedID
+	if tmp, ok := rawArgs["ownerID"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ownerID"))
+		arg0, err = ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["ownerID"] = arg0
 	return args, nil
 }
 
@@ -4533,8 +6175,8 @@ func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArg
 
 // region    **************************** field.gotpl *****************************
 
-func (ec *executionContext) _Entity_findLoadBalancerByID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Entity_findLoadBalancerByID(ctx, field)
+func (ec *executionContext) _AuditEvent_id(ctx context.Context, field graphql.CollectedField, obj *generated.AuditEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditEvent_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4547,7 +6189,7 @@ func (ec *executionContext) _Entity_findLoadBalancerByID(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Entity().FindLoadBalancerByID(rctx, fc.Args["id"].(gidx.PrefixedID))
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4559,63 +6201,26 @@ func (ec *executionContext) _Entity_findLoadBalancerByID(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.LoadBalancer)
+	res := resTmp.(gidx.PrefixedID)
 	fc.Result = res
-	return ec.marshalNLoadBalancer2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancer(ctx, field.Selections, res)
+	return ec.marshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Entity_findLoadBalancerByID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditEvent_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Entity",
+		Object:     "AuditEvent",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_LoadBalancer_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_LoadBalancer_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_LoadBalancer_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_LoadBalancer_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_LoadBalancer_updatedBy(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_LoadBalancer_deletedAt(ctx, field)
-			case "deletedBy":
-				return ec.fieldContext_LoadBalancer_deletedBy(ctx, field)
-			case "name":
-				return ec.fieldContext_LoadBalancer_name(ctx, field)
-			case "ports":
-				return ec.fieldContext_LoadBalancer_ports(ctx, field)
-			case "loadBalancerProvider":
-				return ec.fieldContext_LoadBalancer_loadBalancerProvider(ctx, field)
-			case "location":
-				return ec.fieldContext_LoadBalancer_location(ctx, field)
-			case "owner":
-				return ec.fieldContext_LoadBalancer_owner(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancer", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Entity_findLoadBalancerByID_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Entity_findLoadBalancerOriginByID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Entity_findLoadBalancerOriginByID(ctx, field)
+func (ec *executionContext) _AuditEvent_createdAt(ctx context.Context, field graphql.CollectedField, obj *generated.AuditEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditEvent_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4628,7 +6233,7 @@ func (ec *executionContext) _Entity_findLoadBalancerOriginByID(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Entity().FindLoadBalancerOriginByID(rctx, fc.Args["id"].(gidx.PrefixedID))
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4640,67 +6245,70 @@ func (ec *executionContext) _Entity_findLoadBalancerOriginByID(ctx context.Conte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.Origin)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNLoadBalancerOrigin2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐOrigin(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Entity_findLoadBalancerOriginByID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditEvent_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Entity",
+		Object:     "AuditEvent",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_LoadBalancerOrigin_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_LoadBalancerOrigin_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_LoadBalancerOrigin_updatedAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_LoadBalancerOrigin_deletedAt(ctx, field)
-			case "deletedBy":
-				return ec.fieldContext_LoadBalancerOrigin_deletedBy(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_LoadBalancerOrigin_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_LoadBalancerOrigin_updatedBy(ctx, field)
-			case "name":
-				return ec.fieldContext_LoadBalancerOrigin_name(ctx, field)
-			case "weight":
-				return ec.fieldContext_LoadBalancerOrigin_weight(ctx, field)
-			case "target":
-				return ec.fieldContext_LoadBalancerOrigin_target(ctx, field)
-			case "portNumber":
-				return ec.fieldContext_LoadBalancerOrigin_portNumber(ctx, field)
-			case "active":
-				return ec.fieldContext_LoadBalancerOrigin_active(ctx, field)
-			case "poolID":
-				return ec.fieldContext_LoadBalancerOrigin_poolID(ctx, field)
-			case "pool":
-				return ec.fieldContext_LoadBalancerOrigin_pool(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerOrigin", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuditEvent_actor(ctx context.Context, field graphql.CollectedField, obj *generated.AuditEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditEvent_actor(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
 	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Entity_findLoadBalancerOriginByID_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Actor, nil
+	})
+	if err != nil {
 		ec.Error(ctx, err)
-		return fc, err
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AuditEvent_actor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuditEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Entity_findLoadBalancerPoolByID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Entity_findLoadBalancerPoolByID(ctx, field)
+func (ec *executionContext) _AuditEvent_operation(ctx context.Context, field graphql.CollectedField, obj *generated.AuditEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditEvent_operation(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4713,7 +6321,7 @@ func (ec *executionContext) _Entity_findLoadBalancerPoolByID(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Entity().FindLoadBalancerPoolByID(rctx, fc.Args["id"].(gidx.PrefixedID))
+		return obj.Operation, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4725,65 +6333,26 @@ func (ec *executionContext) _Entity_findLoadBalancerPoolByID(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.Pool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNLoadBalancerPool2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Entity_findLoadBalancerPoolByID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditEvent_operation(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Entity",
+		Object:     "AuditEvent",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_LoadBalancerPool_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_LoadBalancerPool_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_LoadBalancerPool_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_LoadBalancerPool_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_LoadBalancerPool_updatedBy(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_LoadBalancerPool_deletedAt(ctx, field)
-			case "deletedBy":
-				return ec.fieldContext_LoadBalancerPool_deletedBy(ctx, field)
-			case "name":
-				return ec.fieldContext_LoadBalancerPool_name(ctx, field)
-			case "protocol":
-				return ec.fieldContext_LoadBalancerPool_protocol(ctx, field)
-			case "ownerID":
-				return ec.fieldContext_LoadBalancerPool_ownerID(ctx, field)
-			case "ports":
-				return ec.fieldContext_LoadBalancerPool_ports(ctx, field)
-			case "origins":
-				return ec.fieldContext_LoadBalancerPool_origins(ctx, field)
-			case "owner":
-				return ec.fieldContext_LoadBalancerPool_owner(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPool", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Entity_findLoadBalancerPoolByID_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Entity_findLoadBalancerPortByID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Entity_findLoadBalancerPortByID(ctx, field)
+func (ec *executionContext) _AuditEvent_subjectID(ctx context.Context, field graphql.CollectedField, obj *generated.AuditEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditEvent_subjectID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4796,7 +6365,7 @@ func (ec *executionContext) _Entity_findLoadBalancerPortByID(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Entity().FindLoadBalancerPortByID(rctx, fc.Args["id"].(gidx.PrefixedID))
+		return obj.SubjectID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4808,63 +6377,26 @@ func (ec *executionContext) _Entity_findLoadBalancerPortByID(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.Port)
+	res := resTmp.(gidx.PrefixedID)
 	fc.Result = res
-	return ec.marshalNLoadBalancerPort2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPort(ctx, field.Selections, res)
+	return ec.marshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Entity_findLoadBalancerPortByID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditEvent_subjectID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Entity",
+		Object:     "AuditEvent",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_LoadBalancerPort_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_LoadBalancerPort_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_LoadBalancerPort_updatedAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_LoadBalancerPort_deletedAt(ctx, field)
-			case "deletedBy":
-				return ec.fieldContext_LoadBalancerPort_deletedBy(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_LoadBalancerPort_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_LoadBalancerPort_updatedBy(ctx, field)
-			case "number":
-				return ec.fieldContext_LoadBalancerPort_number(ctx, field)
-			case "name":
-				return ec.fieldContext_LoadBalancerPort_name(ctx, field)
-			case "loadBalancerID":
-				return ec.fieldContext_LoadBalancerPort_loadBalancerID(ctx, field)
-			case "pools":
-				return ec.fieldContext_LoadBalancerPort_pools(ctx, field)
-			case "loadBalancer":
-				return ec.fieldContext_LoadBalancerPort_loadBalancer(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPort", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Entity_findLoadBalancerPortByID_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Entity_findLoadBalancerProviderByID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Entity_findLoadBalancerProviderByID(ctx, field)
+func (ec *executionContext) _AuditEvent_changeset(ctx context.Context, field graphql.CollectedField, obj *generated.AuditEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditEvent_changeset(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4877,7 +6409,7 @@ func (ec *executionContext) _Entity_findLoadBalancerProviderByID(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Entity().FindLoadBalancerProviderByID(rctx, fc.Args["id"].(gidx.PrefixedID))
+		return obj.Changeset, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4889,59 +6421,34 @@ func (ec *executionContext) _Entity_findLoadBalancerProviderByID(ctx context.Con
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.Provider)
+	res := resTmp.([]events.FieldChange)
 	fc.Result = res
-	return ec.marshalNLoadBalancerProvider2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐProvider(ctx, field.Selections, res)
+	return ec.marshalNAuditFieldChange2ᚕgoᚗinfratographerᚗcomᚋxᚋeventsᚐFieldChangeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Entity_findLoadBalancerProviderByID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditEvent_changeset(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Entity",
+		Object:     "AuditEvent",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_LoadBalancerProvider_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_LoadBalancerProvider_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_LoadBalancerProvider_updatedAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_LoadBalancerProvider_deletedAt(ctx, field)
-			case "deletedBy":
-				return ec.fieldContext_LoadBalancerProvider_deletedBy(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_LoadBalancerProvider_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_LoadBalancerProvider_updatedBy(ctx, field)
-			case "name":
-				return ec.fieldContext_LoadBalancerProvider_name(ctx, field)
-			case "loadBalancers":
-				return ec.fieldContext_LoadBalancerProvider_loadBalancers(ctx, field)
-			case "owner":
-				return ec.fieldContext_LoadBalancerProvider_owner(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerProvider", field.Name)
+			case "field":
+				return ec.fieldContext_AuditFieldChange_field(ctx, field)
+			case "previousValue":
+				return ec.fieldContext_AuditFieldChange_previousValue(ctx, field)
+			case "currentValue":
+				return ec.fieldContext_AuditFieldChange_currentValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuditFieldChange", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Entity_findLoadBalancerProviderByID_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Entity_findLocationByID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Entity_findLocationByID(ctx, field)
+func (ec *executionContext) _AuditEventConnection_edges(ctx context.Context, field graphql.CollectedField, obj *generated.AuditEventConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditEventConnection_edges(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4954,55 +6461,41 @@ func (ec *executionContext) _Entity_findLocationByID(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Entity().FindLocationByID(rctx, fc.Args["id"].(gidx.PrefixedID))
+		return obj.Edges, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*Location)
+	res := resTmp.([]*generated.AuditEventEdge)
 	fc.Result = res
-	return ec.marshalNLocation2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLocation(ctx, field.Selections, res)
+	return ec.marshalOAuditEventEdge2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐAuditEventEdge(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Entity_findLocationByID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditEventConnection_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Entity",
+		Object:     "AuditEventConnection",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Location_id(ctx, field)
-			case "loadBalancers":
-				return ec.fieldContext_Location_loadBalancers(ctx, field)
+			case "node":
+				return ec.fieldContext_AuditEventEdge_node(ctx, field)
+			case "cursor":
+				return ec.fieldContext_AuditEventEdge_cursor(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Location", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type AuditEventEdge", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Entity_findLocationByID_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Entity_findResourceOwnerByID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Entity_findResourceOwnerByID(ctx, field)
+func (ec *executionContext) _AuditEventConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *generated.AuditEventConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditEventConnection_pageInfo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5015,7 +6508,7 @@ func (ec *executionContext) _Entity_findResourceOwnerByID(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Entity().FindResourceOwnerByID(rctx, fc.Args["id"].(gidx.PrefixedID))
+		return obj.PageInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5027,47 +6520,36 @@ func (ec *executionContext) _Entity_findResourceOwnerByID(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*ResourceOwner)
+	res := resTmp.(entgql.PageInfo[gidx.PrefixedID])
 	fc.Result = res
-	return ec.marshalNResourceOwner2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐResourceOwner(ctx, field.Selections, res)
+	return ec.marshalNPageInfo2entgoᚗioᚋcontribᚋentgqlᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Entity_findResourceOwnerByID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditEventConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Entity",
+		Object:     "AuditEventConnection",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_ResourceOwner_id(ctx, field)
-			case "loadBalancers":
-				return ec.fieldContext_ResourceOwner_loadBalancers(ctx, field)
-			case "loadBalancerPools":
-				return ec.fieldContext_ResourceOwner_loadBalancerPools(ctx, field)
-			case "loadBalancersProviders":
-				return ec.fieldContext_ResourceOwner_loadBalancersProviders(ctx, field)
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "startCursor":
+				return ec.fieldContext_PageInfo_startCursor(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ResourceOwner", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Entity_findResourceOwnerByID_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancer_id(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancer) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancer_id(ctx, field)
+func (ec *executionContext) _AuditEventConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *generated.AuditEventConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditEventConnection_totalCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5080,7 +6562,7 @@ func (ec *executionContext) _LoadBalancer_id(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.TotalCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5092,26 +6574,26 @@ func (ec *executionContext) _LoadBalancer_id(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(gidx.PrefixedID)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancer_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditEventConnection_totalCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancer",
+		Object:     "AuditEventConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancer_createdAt(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancer) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancer_createdAt(ctx, field)
+func (ec *executionContext) _AuditEventEdge_node(ctx context.Context, field graphql.CollectedField, obj *generated.AuditEventEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditEventEdge_node(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5124,38 +6606,49 @@ func (ec *executionContext) _LoadBalancer_createdAt(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.Node, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*generated.AuditEvent)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOAuditEvent2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐAuditEvent(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancer_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditEventEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancer",
+		Object:     "AuditEventEdge",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AuditEvent_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_AuditEvent_createdAt(ctx, field)
+			case "actor":
+				return ec.fieldContext_AuditEvent_actor(ctx, field)
+			case "operation":
+				return ec.fieldContext_AuditEvent_operation(ctx, field)
+			case "subjectID":
+				return ec.fieldContext_AuditEvent_subjectID(ctx, field)
+			case "changeset":
+				return ec.fieldContext_AuditEvent_changeset(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuditEvent", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancer_updatedAt(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancer) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancer_updatedAt(ctx, field)
+func (ec *executionContext) _AuditEventEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *generated.AuditEventEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditEventEdge_cursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5168,7 +6661,7 @@ func (ec *executionContext) _LoadBalancer_updatedAt(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.Cursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5180,26 +6673,26 @@ func (ec *executionContext) _LoadBalancer_updatedAt(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(entgql.Cursor[gidx.PrefixedID])
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNCursor2entgoᚗioᚋcontribᚋentgqlᚐCursor(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancer_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditEventEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancer",
+		Object:     "AuditEventEdge",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Cursor does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancer_createdBy(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancer) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancer_createdBy(ctx, field)
+func (ec *executionContext) _AuditFieldChange_field(ctx context.Context, field graphql.CollectedField, obj *events.FieldChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditFieldChange_field(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5212,23 +6705,26 @@ func (ec *executionContext) _LoadBalancer_createdBy(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedBy, nil
+		return obj.Field, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancer_createdBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditFieldChange_field(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancer",
+		Object:     "AuditFieldChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5239,8 +6735,8 @@ func (ec *executionContext) fieldContext_LoadBalancer_createdBy(ctx context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancer_updatedBy(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancer) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancer_updatedBy(ctx, field)
+func (ec *executionContext) _AuditFieldChange_previousValue(ctx context.Context, field graphql.CollectedField, obj *events.FieldChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditFieldChange_previousValue(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5253,23 +6749,26 @@ func (ec *executionContext) _LoadBalancer_updatedBy(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedBy, nil
+		return obj.PreviousValue, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancer_updatedBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditFieldChange_previousValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancer",
+		Object:     "AuditFieldChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5280,8 +6779,8 @@ func (ec *executionContext) fieldContext_LoadBalancer_updatedBy(ctx context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancer_deletedAt(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancer) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancer_deletedAt(ctx, field)
+func (ec *executionContext) _AuditFieldChange_currentValue(ctx context.Context, field graphql.CollectedField, obj *events.FieldChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditFieldChange_currentValue(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5294,35 +6793,38 @@ func (ec *executionContext) _LoadBalancer_deletedAt(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeletedAt, nil
+		return obj.CurrentValue, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancer_deletedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditFieldChange_currentValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancer",
+		Object:     "AuditFieldChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancer_deletedBy(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancer) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancer_deletedBy(ctx, field)
+func (ec *executionContext) _Entity_findManyLoadBalancerByIDs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Entity_findManyLoadBalancerByIDs(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5335,7 +6837,7 @@ func (ec *executionContext) _LoadBalancer_deletedBy(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeletedBy, nil
+		return ec.resolvers.Entity().FindManyLoadBalancerByIDs(rctx, fc.Args["reps"].([]*LoadBalancerByIDsInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5344,26 +6846,67 @@ func (ec *executionContext) _LoadBalancer_deletedBy(ctx context.Context, field g
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*generated.LoadBalancer)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalOLoadBalancer2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancer(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancer_deletedBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Entity_findManyLoadBalancerByIDs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancer",
+		Object:     "Entity",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_LoadBalancer_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancer_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancer_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancer_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancer_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancer_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancer_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancer_deletedBy(ctx, field)
+			case "name":
+				return ec.fieldContext_LoadBalancer_name(ctx, field)
+			case "ports":
+				return ec.fieldContext_LoadBalancer_ports(ctx, field)
+			case "loadBalancerProvider":
+				return ec.fieldContext_LoadBalancer_loadBalancerProvider(ctx, field)
+			case "auditEvents":
+				return ec.fieldContext_LoadBalancer_auditEvents(ctx, field)
+			case "location":
+				return ec.fieldContext_LoadBalancer_location(ctx, field)
+			case "owner":
+				return ec.fieldContext_LoadBalancer_owner(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancer", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Entity_findManyLoadBalancerByIDs_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancer_name(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancer) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancer_name(ctx, field)
+func (ec *executionContext) _Entity_findManyLoadBalancerOriginByIDs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Entity_findManyLoadBalancerOriginByIDs(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5376,38 +6919,78 @@ func (ec *executionContext) _LoadBalancer_name(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return ec.resolvers.Entity().FindManyLoadBalancerOriginByIDs(rctx, fc.Args["reps"].([]*LoadBalancerOriginByIDsInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*generated.Origin)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOLoadBalancerOrigin2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐOrigin(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancer_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Entity_findManyLoadBalancerOriginByIDs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancer",
+		Object:     "Entity",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_LoadBalancerOrigin_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancerOrigin_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancerOrigin_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancerOrigin_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancerOrigin_deletedBy(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancerOrigin_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancerOrigin_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancerOrigin_version(ctx, field)
+			case "name":
+				return ec.fieldContext_LoadBalancerOrigin_name(ctx, field)
+			case "weight":
+				return ec.fieldContext_LoadBalancerOrigin_weight(ctx, field)
+			case "target":
+				return ec.fieldContext_LoadBalancerOrigin_target(ctx, field)
+			case "portNumber":
+				return ec.fieldContext_LoadBalancerOrigin_portNumber(ctx, field)
+			case "active":
+				return ec.fieldContext_LoadBalancerOrigin_active(ctx, field)
+			case "poolID":
+				return ec.fieldContext_LoadBalancerOrigin_poolID(ctx, field)
+			case "pool":
+				return ec.fieldContext_LoadBalancerOrigin_pool(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerOrigin", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Entity_findManyLoadBalancerOriginByIDs_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancer_ports(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancer) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancer_ports(ctx, field)
+func (ec *executionContext) _Entity_findManyLoadBalancerPoolByIDs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Entity_findManyLoadBalancerPoolByIDs(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5420,39 +7003,60 @@ func (ec *executionContext) _LoadBalancer_ports(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Ports(ctx, fc.Args["after"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["first"].(*int), fc.Args["before"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["last"].(*int), fc.Args["orderBy"].(*generated.LoadBalancerPortOrder), fc.Args["where"].(*generated.LoadBalancerPortWhereInput))
+		return ec.resolvers.Entity().FindManyLoadBalancerPoolByIDs(rctx, fc.Args["reps"].([]*LoadBalancerPoolByIDsInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.LoadBalancerPortConnection)
+	res := resTmp.([]*generated.Pool)
 	fc.Result = res
-	return ec.marshalNLoadBalancerPortConnection2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerPortConnection(ctx, field.Selections, res)
+	return ec.marshalOLoadBalancerPool2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancer_ports(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Entity_findManyLoadBalancerPoolByIDs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancer",
+		Object:     "Entity",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "edges":
-				return ec.fieldContext_LoadBalancerPortConnection_edges(ctx, field)
-			case "pageInfo":
-				return ec.fieldContext_LoadBalancerPortConnection_pageInfo(ctx, field)
-			case "totalCount":
-				return ec.fieldContext_LoadBalancerPortConnection_totalCount(ctx, field)
+			case "id":
+				return ec.fieldContext_LoadBalancerPool_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancerPool_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancerPool_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancerPool_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancerPool_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancerPool_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancerPool_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancerPool_deletedBy(ctx, field)
+			case "name":
+				return ec.fieldContext_LoadBalancerPool_name(ctx, field)
+			case "protocol":
+				return ec.fieldContext_LoadBalancerPool_protocol(ctx, field)
+			case "ownerID":
+				return ec.fieldContext_LoadBalancerPool_ownerID(ctx, field)
+			case "ports":
+				return ec.fieldContext_LoadBalancerPool_ports(ctx, field)
+			case "origins":
+				return ec.fieldContext_LoadBalancerPool_origins(ctx, field)
+			case "auditEvents":
+				return ec.fieldContext_LoadBalancerPool_auditEvents(ctx, field)
+			case "owner":
+				return ec.fieldContext_LoadBalancerPool_owner(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPortConnection", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPool", field.Name)
 		},
 	}
 	defer func() {
@@ -5462,15 +7066,15 @@ func (ec *executionContext) fieldContext_LoadBalancer_ports(ctx context.Context,
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_LoadBalancer_ports_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Entity_findManyLoadBalancerPoolByIDs_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancer_loadBalancerProvider(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancer) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancer_loadBalancerProvider(ctx, field)
+func (ec *executionContext) _Entity_findManyLoadBalancerPortByIDs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Entity_findManyLoadBalancerPortByIDs(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5483,60 +7087,74 @@ func (ec *executionContext) _LoadBalancer_loadBalancerProvider(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Provider(ctx)
+		return ec.resolvers.Entity().FindManyLoadBalancerPortByIDs(rctx, fc.Args["reps"].([]*LoadBalancerPortByIDsInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.Provider)
+	res := resTmp.([]*generated.Port)
 	fc.Result = res
-	return ec.marshalNLoadBalancerProvider2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐProvider(ctx, field.Selections, res)
+	return ec.marshalOLoadBalancerPort2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPort(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancer_loadBalancerProvider(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Entity_findManyLoadBalancerPortByIDs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancer",
+		Object:     "Entity",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_LoadBalancerProvider_id(ctx, field)
+				return ec.fieldContext_LoadBalancerPort_id(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_LoadBalancerProvider_createdAt(ctx, field)
+				return ec.fieldContext_LoadBalancerPort_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_LoadBalancerProvider_updatedAt(ctx, field)
+				return ec.fieldContext_LoadBalancerPort_updatedAt(ctx, field)
 			case "deletedAt":
-				return ec.fieldContext_LoadBalancerProvider_deletedAt(ctx, field)
+				return ec.fieldContext_LoadBalancerPort_deletedAt(ctx, field)
 			case "deletedBy":
-				return ec.fieldContext_LoadBalancerProvider_deletedBy(ctx, field)
+				return ec.fieldContext_LoadBalancerPort_deletedBy(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_LoadBalancerProvider_createdBy(ctx, field)
+				return ec.fieldContext_LoadBalancerPort_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_LoadBalancerProvider_updatedBy(ctx, field)
+				return ec.fieldContext_LoadBalancerPort_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancerPort_version(ctx, field)
+			case "number":
+				return ec.fieldContext_LoadBalancerPort_number(ctx, field)
 			case "name":
-				return ec.fieldContext_LoadBalancerProvider_name(ctx, field)
-			case "loadBalancers":
-				return ec.fieldContext_LoadBalancerProvider_loadBalancers(ctx, field)
-			case "owner":
-				return ec.fieldContext_LoadBalancerProvider_owner(ctx, field)
+				return ec.fieldContext_LoadBalancerPort_name(ctx, field)
+			case "loadBalancerID":
+				return ec.fieldContext_LoadBalancerPort_loadBalancerID(ctx, field)
+			case "pools":
+				return ec.fieldContext_LoadBalancerPort_pools(ctx, field)
+			case "loadBalancer":
+				return ec.fieldContext_LoadBalancerPort_loadBalancer(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerProvider", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPort", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Entity_findManyLoadBalancerPortByIDs_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancer_location(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancer) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancer_location(ctx, field)
+func (ec *executionContext) _Entity_findManyLoadBalancerProviderByIDs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Entity_findManyLoadBalancerProviderByIDs(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5549,45 +7167,73 @@ func (ec *executionContext) _LoadBalancer_location(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.LoadBalancer().Location(rctx, obj)
+		return ec.resolvers.Entity().FindManyLoadBalancerProviderByIDs(rctx, fc.Args["reps"].([]*LoadBalancerProviderByIDsInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*Location)
+	res := resTmp.([]*generated.Provider)
 	fc.Result = res
-	return ec.marshalNLocation2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLocation(ctx, field.Selections, res)
+	return ec.marshalOLoadBalancerProvider2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐProvider(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancer_location(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Entity_findManyLoadBalancerProviderByIDs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancer",
+		Object:     "Entity",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Location_id(ctx, field)
-			case "loadBalancers":
-				return ec.fieldContext_Location_loadBalancers(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Location", field.Name)
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _LoadBalancer_owner(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancer) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancer_owner(ctx, field)
-	if err != nil {
+				return ec.fieldContext_LoadBalancerProvider_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancerProvider_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancerProvider_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancerProvider_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancerProvider_deletedBy(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancerProvider_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancerProvider_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancerProvider_version(ctx, field)
+			case "name":
+				return ec.fieldContext_LoadBalancerProvider_name(ctx, field)
+			case "loadBalancers":
+				return ec.fieldContext_LoadBalancerProvider_loadBalancers(ctx, field)
+			case "auditEvents":
+				return ec.fieldContext_LoadBalancerProvider_auditEvents(ctx, field)
+			case "owner":
+				return ec.fieldContext_LoadBalancerProvider_owner(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerProvider", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Entity_findManyLoadBalancerProviderByIDs_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Entity_findLocationByID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Entity_findLocationByID(ctx, field)
+	if err != nil {
 		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
@@ -5599,7 +7245,7 @@ func (ec *executionContext) _LoadBalancer_owner(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.LoadBalancer().Owner(rctx, obj)
+		return ec.resolvers.Entity().FindLocationByID(rctx, fc.Args["id"].(gidx.PrefixedID))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5611,36 +7257,43 @@ func (ec *executionContext) _LoadBalancer_owner(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*ResourceOwner)
+	res := resTmp.(*Location)
 	fc.Result = res
-	return ec.marshalNResourceOwner2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐResourceOwner(ctx, field.Selections, res)
+	return ec.marshalNLocation2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLocation(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancer_owner(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Entity_findLocationByID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancer",
+		Object:     "Entity",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_ResourceOwner_id(ctx, field)
+				return ec.fieldContext_Location_id(ctx, field)
 			case "loadBalancers":
-				return ec.fieldContext_ResourceOwner_loadBalancers(ctx, field)
-			case "loadBalancerPools":
-				return ec.fieldContext_ResourceOwner_loadBalancerPools(ctx, field)
-			case "loadBalancersProviders":
-				return ec.fieldContext_ResourceOwner_loadBalancersProviders(ctx, field)
+				return ec.fieldContext_Location_loadBalancers(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ResourceOwner", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Location", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Entity_findLocationByID_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerConnection_edges(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerConnection_edges(ctx, field)
+func (ec *executionContext) _Entity_findResourceOwnerByID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Entity_findResourceOwnerByID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5653,41 +7306,59 @@ func (ec *executionContext) _LoadBalancerConnection_edges(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Edges, nil
+		return ec.resolvers.Entity().FindResourceOwnerByID(rctx, fc.Args["id"].(gidx.PrefixedID))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*generated.LoadBalancerEdge)
+	res := resTmp.(*ResourceOwner)
 	fc.Result = res
-	return ec.marshalOLoadBalancerEdge2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerEdge(ctx, field.Selections, res)
+	return ec.marshalNResourceOwner2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐResourceOwner(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerConnection_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Entity_findResourceOwnerByID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerConnection",
+		Object:     "Entity",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "node":
-				return ec.fieldContext_LoadBalancerEdge_node(ctx, field)
-			case "cursor":
-				return ec.fieldContext_LoadBalancerEdge_cursor(ctx, field)
+			case "id":
+				return ec.fieldContext_ResourceOwner_id(ctx, field)
+			case "loadBalancers":
+				return ec.fieldContext_ResourceOwner_loadBalancers(ctx, field)
+			case "loadBalancerPools":
+				return ec.fieldContext_ResourceOwner_loadBalancerPools(ctx, field)
+			case "loadBalancersProviders":
+				return ec.fieldContext_ResourceOwner_loadBalancersProviders(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerEdge", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ResourceOwner", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Entity_findResourceOwnerByID_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerConnection_pageInfo(ctx, field)
+func (ec *executionContext) _LoadBalancer_id(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancer_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5700,7 +7371,7 @@ func (ec *executionContext) _LoadBalancerConnection_pageInfo(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PageInfo, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5712,36 +7383,26 @@ func (ec *executionContext) _LoadBalancerConnection_pageInfo(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(entgql.PageInfo[gidx.PrefixedID])
+	res := resTmp.(gidx.PrefixedID)
 	fc.Result = res
-	return ec.marshalNPageInfo2entgoᚗioᚋcontribᚋentgqlᚐPageInfo(ctx, field.Selections, res)
+	return ec.marshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancer_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerConnection",
+		Object:     "LoadBalancer",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "hasNextPage":
-				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
-			case "hasPreviousPage":
-				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
-			case "startCursor":
-				return ec.fieldContext_PageInfo_startCursor(ctx, field)
-			case "endCursor":
-				return ec.fieldContext_PageInfo_endCursor(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerConnection_totalCount(ctx, field)
+func (ec *executionContext) _LoadBalancer_createdAt(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancer_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5754,7 +7415,7 @@ func (ec *executionContext) _LoadBalancerConnection_totalCount(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalCount, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5766,26 +7427,26 @@ func (ec *executionContext) _LoadBalancerConnection_totalCount(ctx context.Conte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerConnection_totalCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancer_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerConnection",
+		Object:     "LoadBalancer",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerCreatePayload_loadBalancer(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerCreatePayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerCreatePayload_loadBalancer(ctx, field)
+func (ec *executionContext) _LoadBalancer_updatedAt(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancer_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5798,7 +7459,7 @@ func (ec *executionContext) _LoadBalancerCreatePayload_loadBalancer(ctx context.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LoadBalancer, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5810,52 +7471,26 @@ func (ec *executionContext) _LoadBalancerCreatePayload_loadBalancer(ctx context.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.LoadBalancer)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNLoadBalancer2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancer(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerCreatePayload_loadBalancer(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancer_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerCreatePayload",
+		Object:     "LoadBalancer",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_LoadBalancer_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_LoadBalancer_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_LoadBalancer_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_LoadBalancer_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_LoadBalancer_updatedBy(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_LoadBalancer_deletedAt(ctx, field)
-			case "deletedBy":
-				return ec.fieldContext_LoadBalancer_deletedBy(ctx, field)
-			case "name":
-				return ec.fieldContext_LoadBalancer_name(ctx, field)
-			case "ports":
-				return ec.fieldContext_LoadBalancer_ports(ctx, field)
-			case "loadBalancerProvider":
-				return ec.fieldContext_LoadBalancer_loadBalancerProvider(ctx, field)
-			case "location":
-				return ec.fieldContext_LoadBalancer_location(ctx, field)
-			case "owner":
-				return ec.fieldContext_LoadBalancer_owner(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancer", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerDeletePayload_deletedID(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerDeletePayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerDeletePayload_deletedID(ctx, field)
+func (ec *executionContext) _LoadBalancer_createdBy(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancer_createdBy(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5868,38 +7503,35 @@ func (ec *executionContext) _LoadBalancerDeletePayload_deletedID(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeletedID, nil
+		return obj.CreatedBy, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(gidx.PrefixedID)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerDeletePayload_deletedID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancer_createdBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerDeletePayload",
+		Object:     "LoadBalancer",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerEdge_node(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerEdge) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerEdge_node(ctx, field)
+func (ec *executionContext) _LoadBalancer_updatedBy(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancer_updatedBy(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5912,7 +7544,7 @@ func (ec *executionContext) _LoadBalancerEdge_node(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Node, nil
+		return obj.UpdatedBy, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5921,52 +7553,26 @@ func (ec *executionContext) _LoadBalancerEdge_node(ctx context.Context, field gr
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*generated.LoadBalancer)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOLoadBalancer2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancer(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancer_updatedBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerEdge",
+		Object:     "LoadBalancer",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_LoadBalancer_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_LoadBalancer_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_LoadBalancer_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_LoadBalancer_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_LoadBalancer_updatedBy(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_LoadBalancer_deletedAt(ctx, field)
-			case "deletedBy":
-				return ec.fieldContext_LoadBalancer_deletedBy(ctx, field)
-			case "name":
-				return ec.fieldContext_LoadBalancer_name(ctx, field)
-			case "ports":
-				return ec.fieldContext_LoadBalancer_ports(ctx, field)
-			case "loadBalancerProvider":
-				return ec.fieldContext_LoadBalancer_loadBalancerProvider(ctx, field)
-			case "location":
-				return ec.fieldContext_LoadBalancer_location(ctx, field)
-			case "owner":
-				return ec.fieldContext_LoadBalancer_owner(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancer", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerEdge) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerEdge_cursor(ctx, field)
+func (ec *executionContext) _LoadBalancer_version(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancer_version(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5979,7 +7585,7 @@ func (ec *executionContext) _LoadBalancerEdge_cursor(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Cursor, nil
+		return obj.Version, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5991,26 +7597,26 @@ func (ec *executionContext) _LoadBalancerEdge_cursor(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(entgql.Cursor[gidx.PrefixedID])
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNCursor2entgoᚗioᚋcontribᚋentgqlᚐCursor(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancer_version(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerEdge",
+		Object:     "LoadBalancer",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Cursor does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerOrigin_id(ctx context.Context, field graphql.CollectedField, obj *generated.Origin) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerOrigin_id(ctx, field)
+func (ec *executionContext) _LoadBalancer_deletedAt(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancer_deletedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6023,38 +7629,35 @@ func (ec *executionContext) _LoadBalancerOrigin_id(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.DeletedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(gidx.PrefixedID)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
+	return ec.marshalOTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerOrigin_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancer_deletedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerOrigin",
+		Object:     "LoadBalancer",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerOrigin_createdAt(ctx context.Context, field graphql.CollectedField, obj *generated.Origin) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerOrigin_createdAt(ctx, field)
+func (ec *executionContext) _LoadBalancer_deletedBy(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancer_deletedBy(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6067,38 +7670,35 @@ func (ec *executionContext) _LoadBalancerOrigin_createdAt(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.DeletedBy, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerOrigin_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancer_deletedBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerOrigin",
+		Object:     "LoadBalancer",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerOrigin_updatedAt(ctx context.Context, field graphql.CollectedField, obj *generated.Origin) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerOrigin_updatedAt(ctx, field)
+func (ec *executionContext) _LoadBalancer_name(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancer_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6111,7 +7711,7 @@ func (ec *executionContext) _LoadBalancerOrigin_updatedAt(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6123,26 +7723,26 @@ func (ec *executionContext) _LoadBalancerOrigin_updatedAt(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerOrigin_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancer_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerOrigin",
+		Object:     "LoadBalancer",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerOrigin_deletedAt(ctx context.Context, field graphql.CollectedField, obj *generated.Origin) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerOrigin_deletedAt(ctx, field)
+func (ec *executionContext) _LoadBalancer_ports(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancer_ports(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6155,35 +7755,57 @@ func (ec *executionContext) _LoadBalancerOrigin_deletedAt(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeletedAt, nil
+		return ec.resolvers.LoadBalancer().Ports(rctx, obj, fc.Args["after"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["first"].(*int), fc.Args["before"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["last"].(*int), fc.Args["orderBy"].(*generated.LoadBalancerPortOrder), fc.Args["where"].(*generated.LoadBalancerPortWhereInput), fc.Args["includeDeleted"].(*bool))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*generated.LoadBalancerPortConnection)
 	fc.Result = res
-	return ec.marshalOTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerPortConnection2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerPortConnection(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerOrigin_deletedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancer_ports(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerOrigin",
+		Object:     "LoadBalancer",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_LoadBalancerPortConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_LoadBalancerPortConnection_pageInfo(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_LoadBalancerPortConnection_totalCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPortConnection", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_LoadBalancer_ports_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerOrigin_deletedBy(ctx context.Context, field graphql.CollectedField, obj *generated.Origin) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerOrigin_deletedBy(ctx, field)
+func (ec *executionContext) _LoadBalancer_loadBalancerProvider(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancer_loadBalancerProvider(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6196,35 +7818,64 @@ func (ec *executionContext) _LoadBalancerOrigin_deletedBy(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeletedBy, nil
+		return obj.Provider(ctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*generated.Provider)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerProvider2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐProvider(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerOrigin_deletedBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancer_loadBalancerProvider(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerOrigin",
+		Object:     "LoadBalancer",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_LoadBalancerProvider_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancerProvider_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancerProvider_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancerProvider_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancerProvider_deletedBy(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancerProvider_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancerProvider_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancerProvider_version(ctx, field)
+			case "name":
+				return ec.fieldContext_LoadBalancerProvider_name(ctx, field)
+			case "loadBalancers":
+				return ec.fieldContext_LoadBalancerProvider_loadBalancers(ctx, field)
+			case "auditEvents":
+				return ec.fieldContext_LoadBalancerProvider_auditEvents(ctx, field)
+			case "owner":
+				return ec.fieldContext_LoadBalancerProvider_owner(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerProvider", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerOrigin_createdBy(ctx context.Context, field graphql.CollectedField, obj *generated.Origin) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerOrigin_createdBy(ctx, field)
+func (ec *executionContext) _LoadBalancer_auditEvents(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancer_auditEvents(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6237,35 +7888,57 @@ func (ec *executionContext) _LoadBalancerOrigin_createdBy(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedBy, nil
+		return ec.resolvers.LoadBalancer().AuditEvents(rctx, obj, fc.Args["after"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["first"].(*int), fc.Args["before"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["last"].(*int), fc.Args["orderBy"].(*generated.AuditEventOrder), fc.Args["filter"].(*AuditEventFilter))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*generated.AuditEventConnection)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNAuditEventConnection2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐAuditEventConnection(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerOrigin_createdBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancer_auditEvents(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerOrigin",
+		Object:     "LoadBalancer",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_AuditEventConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_AuditEventConnection_pageInfo(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_AuditEventConnection_totalCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuditEventConnection", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_LoadBalancer_auditEvents_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerOrigin_updatedBy(ctx context.Context, field graphql.CollectedField, obj *generated.Origin) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerOrigin_updatedBy(ctx, field)
+func (ec *executionContext) _LoadBalancer_location(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancer_location(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6278,35 +7951,44 @@ func (ec *executionContext) _LoadBalancerOrigin_updatedBy(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedBy, nil
+		return ec.resolvers.LoadBalancer().Location(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*Location)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNLocation2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLocation(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerOrigin_updatedBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancer_location(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerOrigin",
+		Object:     "LoadBalancer",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Location_id(ctx, field)
+			case "loadBalancers":
+				return ec.fieldContext_Location_loadBalancers(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Location", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerOrigin_name(ctx context.Context, field graphql.CollectedField, obj *generated.Origin) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerOrigin_name(ctx, field)
+func (ec *executionContext) _LoadBalancer_owner(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancer_owner(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6319,7 +8001,7 @@ func (ec *executionContext) _LoadBalancerOrigin_name(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return ec.resolvers.LoadBalancer().Owner(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6331,26 +8013,36 @@ func (ec *executionContext) _LoadBalancerOrigin_name(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*ResourceOwner)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNResourceOwner2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐResourceOwner(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerOrigin_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancer_owner(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerOrigin",
+		Object:     "LoadBalancer",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ResourceOwner_id(ctx, field)
+			case "loadBalancers":
+				return ec.fieldContext_ResourceOwner_loadBalancers(ctx, field)
+			case "loadBalancerPools":
+				return ec.fieldContext_ResourceOwner_loadBalancerPools(ctx, field)
+			case "loadBalancersProviders":
+				return ec.fieldContext_ResourceOwner_loadBalancersProviders(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResourceOwner", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerOrigin_weight(ctx context.Context, field graphql.CollectedField, obj *generated.Origin) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerOrigin_weight(ctx, field)
+func (ec *executionContext) _LoadBalancerApplyChange_action(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerApplyChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerApplyChange_action(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6363,7 +8055,7 @@ func (ec *executionContext) _LoadBalancerOrigin_weight(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Weight, nil
+		return obj.Action, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6375,26 +8067,26 @@ func (ec *executionContext) _LoadBalancerOrigin_weight(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int32)
+	res := resTmp.(LoadBalancerApplyAction)
 	fc.Result = res
-	return ec.marshalNInt2int32(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerApplyAction2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerApplyAction(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerOrigin_weight(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerApplyChange_action(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerOrigin",
+		Object:     "LoadBalancerApplyChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type LoadBalancerApplyAction does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerOrigin_target(ctx context.Context, field graphql.CollectedField, obj *generated.Origin) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerOrigin_target(ctx, field)
+func (ec *executionContext) _LoadBalancerApplyChange_resourceType(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerApplyChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerApplyChange_resourceType(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6407,7 +8099,7 @@ func (ec *executionContext) _LoadBalancerOrigin_target(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Target, nil
+		return obj.ResourceType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6424,9 +8116,9 @@ func (ec *executionContext) _LoadBalancerOrigin_target(ctx context.Context, fiel
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerOrigin_target(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerApplyChange_resourceType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerOrigin",
+		Object:     "LoadBalancerApplyChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -6437,8 +8129,8 @@ func (ec *executionContext) fieldContext_LoadBalancerOrigin_target(ctx context.C
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerOrigin_portNumber(ctx context.Context, field graphql.CollectedField, obj *generated.Origin) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerOrigin_portNumber(ctx, field)
+func (ec *executionContext) _LoadBalancerApplyChange_id(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerApplyChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerApplyChange_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6451,38 +8143,35 @@ func (ec *executionContext) _LoadBalancerOrigin_portNumber(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PortNumber, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*gidx.PrefixedID)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOID2ᚖgoᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerOrigin_portNumber(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerApplyChange_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerOrigin",
+		Object:     "LoadBalancerApplyChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerOrigin_active(ctx context.Context, field graphql.CollectedField, obj *generated.Origin) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerOrigin_active(ctx, field)
+func (ec *executionContext) _LoadBalancerApplyChange_key(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerApplyChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerApplyChange_key(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6495,7 +8184,7 @@ func (ec *executionContext) _LoadBalancerOrigin_active(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Active, nil
+		return obj.Key, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6507,26 +8196,26 @@ func (ec *executionContext) _LoadBalancerOrigin_active(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerOrigin_active(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerApplyChange_key(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerOrigin",
+		Object:     "LoadBalancerApplyChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerOrigin_poolID(ctx context.Context, field graphql.CollectedField, obj *generated.Origin) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerOrigin_poolID(ctx, field)
+func (ec *executionContext) _LoadBalancerApplyChange_fields(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerApplyChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerApplyChange_fields(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6539,7 +8228,7 @@ func (ec *executionContext) _LoadBalancerOrigin_poolID(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PoolID, nil
+		return obj.Fields, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6551,26 +8240,34 @@ func (ec *executionContext) _LoadBalancerOrigin_poolID(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(gidx.PrefixedID)
+	res := resTmp.([]*events.FieldChange)
 	fc.Result = res
-	return ec.marshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
+	return ec.marshalNAuditFieldChange2ᚕᚖgoᚗinfratographerᚗcomᚋxᚋeventsᚐFieldChangeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerOrigin_poolID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerApplyChange_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerOrigin",
+		Object:     "LoadBalancerApplyChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_AuditFieldChange_field(ctx, field)
+			case "previousValue":
+				return ec.fieldContext_AuditFieldChange_previousValue(ctx, field)
+			case "currentValue":
+				return ec.fieldContext_AuditFieldChange_currentValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuditFieldChange", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerOrigin_pool(ctx context.Context, field graphql.CollectedField, obj *generated.Origin) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerOrigin_pool(ctx, field)
+func (ec *executionContext) _LoadBalancerApplyPayload_loadBalancer(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerApplyPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerApplyPayload_loadBalancer(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6583,66 +8280,65 @@ func (ec *executionContext) _LoadBalancerOrigin_pool(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Pool(ctx)
+		return obj.LoadBalancer, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.Pool)
+	res := resTmp.(*generated.LoadBalancer)
 	fc.Result = res
-	return ec.marshalNLoadBalancerPool2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPool(ctx, field.Selections, res)
+	return ec.marshalOLoadBalancer2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancer(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerOrigin_pool(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerApplyPayload_loadBalancer(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerOrigin",
+		Object:     "LoadBalancerApplyPayload",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_LoadBalancerPool_id(ctx, field)
+				return ec.fieldContext_LoadBalancer_id(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_LoadBalancerPool_createdAt(ctx, field)
+				return ec.fieldContext_LoadBalancer_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_LoadBalancerPool_updatedAt(ctx, field)
+				return ec.fieldContext_LoadBalancer_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_LoadBalancerPool_createdBy(ctx, field)
+				return ec.fieldContext_LoadBalancer_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_LoadBalancerPool_updatedBy(ctx, field)
+				return ec.fieldContext_LoadBalancer_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancer_version(ctx, field)
 			case "deletedAt":
-				return ec.fieldContext_LoadBalancerPool_deletedAt(ctx, field)
+				return ec.fieldContext_LoadBalancer_deletedAt(ctx, field)
 			case "deletedBy":
-				return ec.fieldContext_LoadBalancerPool_deletedBy(ctx, field)
+				return ec.fieldContext_LoadBalancer_deletedBy(ctx, field)
 			case "name":
-				return ec.fieldContext_LoadBalancerPool_name(ctx, field)
-			case "protocol":
-				return ec.fieldContext_LoadBalancerPool_protocol(ctx, field)
-			case "ownerID":
-				return ec.fieldContext_LoadBalancerPool_ownerID(ctx, field)
+				return ec.fieldContext_LoadBalancer_name(ctx, field)
 			case "ports":
-				return ec.fieldContext_LoadBalancerPool_ports(ctx, field)
-			case "origins":
-				return ec.fieldContext_LoadBalancerPool_origins(ctx, field)
+				return ec.fieldContext_LoadBalancer_ports(ctx, field)
+			case "loadBalancerProvider":
+				return ec.fieldContext_LoadBalancer_loadBalancerProvider(ctx, field)
+			case "auditEvents":
+				return ec.fieldContext_LoadBalancer_auditEvents(ctx, field)
+			case "location":
+				return ec.fieldContext_LoadBalancer_location(ctx, field)
 			case "owner":
-				return ec.fieldContext_LoadBalancerPool_owner(ctx, field)
+				return ec.fieldContext_LoadBalancer_owner(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPool", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancer", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerOriginConnection_edges(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerOriginConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerOriginConnection_edges(ctx, field)
+func (ec *executionContext) _LoadBalancerApplyPayload_dryRun(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerApplyPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerApplyPayload_dryRun(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6655,41 +8351,38 @@ func (ec *executionContext) _LoadBalancerOriginConnection_edges(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Edges, nil
+		return obj.DryRun, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*generated.LoadBalancerOriginEdge)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOLoadBalancerOriginEdge2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerOriginEdge(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerOriginConnection_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerApplyPayload_dryRun(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerOriginConnection",
+		Object:     "LoadBalancerApplyPayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "node":
-				return ec.fieldContext_LoadBalancerOriginEdge_node(ctx, field)
-			case "cursor":
-				return ec.fieldContext_LoadBalancerOriginEdge_cursor(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerOriginEdge", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerOriginConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerOriginConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerOriginConnection_pageInfo(ctx, field)
+func (ec *executionContext) _LoadBalancerApplyPayload_changes(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerApplyPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerApplyPayload_changes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6702,7 +8395,7 @@ func (ec *executionContext) _LoadBalancerOriginConnection_pageInfo(ctx context.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PageInfo, nil
+		return obj.Changes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6714,36 +8407,38 @@ func (ec *executionContext) _LoadBalancerOriginConnection_pageInfo(ctx context.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(entgql.PageInfo[gidx.PrefixedID])
+	res := resTmp.([]*LoadBalancerApplyChange)
 	fc.Result = res
-	return ec.marshalNPageInfo2entgoᚗioᚋcontribᚋentgqlᚐPageInfo(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerApplyChange2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerApplyChangeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerOriginConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerApplyPayload_changes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerOriginConnection",
+		Object:     "LoadBalancerApplyPayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "hasNextPage":
-				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
-			case "hasPreviousPage":
-				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
-			case "startCursor":
-				return ec.fieldContext_PageInfo_startCursor(ctx, field)
-			case "endCursor":
-				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			case "action":
+				return ec.fieldContext_LoadBalancerApplyChange_action(ctx, field)
+			case "resourceType":
+				return ec.fieldContext_LoadBalancerApplyChange_resourceType(ctx, field)
+			case "id":
+				return ec.fieldContext_LoadBalancerApplyChange_id(ctx, field)
+			case "key":
+				return ec.fieldContext_LoadBalancerApplyChange_key(ctx, field)
+			case "fields":
+				return ec.fieldContext_LoadBalancerApplyChange_fields(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerApplyChange", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerOriginConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerOriginConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerOriginConnection_totalCount(ctx, field)
+func (ec *executionContext) _LoadBalancerChange_eventType(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerChange_eventType(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6756,7 +8451,7 @@ func (ec *executionContext) _LoadBalancerOriginConnection_totalCount(ctx context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalCount, nil
+		return obj.EventType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6768,26 +8463,26 @@ func (ec *executionContext) _LoadBalancerOriginConnection_totalCount(ctx context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerOriginConnection_totalCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerChange_eventType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerOriginConnection",
+		Object:     "LoadBalancerChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerOriginCreatePayload_loadBalancerOrigin(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerOriginCreatePayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerOriginCreatePayload_loadBalancerOrigin(ctx, field)
+func (ec *executionContext) _LoadBalancerChange_subjectID(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerChange_subjectID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6800,7 +8495,7 @@ func (ec *executionContext) _LoadBalancerOriginCreatePayload_loadBalancerOrigin(
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LoadBalancerOrigin, nil
+		return obj.SubjectID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6812,56 +8507,26 @@ func (ec *executionContext) _LoadBalancerOriginCreatePayload_loadBalancerOrigin(
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.Origin)
+	res := resTmp.(gidx.PrefixedID)
 	fc.Result = res
-	return ec.marshalNLoadBalancerOrigin2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐOrigin(ctx, field.Selections, res)
+	return ec.marshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerOriginCreatePayload_loadBalancerOrigin(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerChange_subjectID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerOriginCreatePayload",
+		Object:     "LoadBalancerChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_LoadBalancerOrigin_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_LoadBalancerOrigin_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_LoadBalancerOrigin_updatedAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_LoadBalancerOrigin_deletedAt(ctx, field)
-			case "deletedBy":
-				return ec.fieldContext_LoadBalancerOrigin_deletedBy(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_LoadBalancerOrigin_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_LoadBalancerOrigin_updatedBy(ctx, field)
-			case "name":
-				return ec.fieldContext_LoadBalancerOrigin_name(ctx, field)
-			case "weight":
-				return ec.fieldContext_LoadBalancerOrigin_weight(ctx, field)
-			case "target":
-				return ec.fieldContext_LoadBalancerOrigin_target(ctx, field)
-			case "portNumber":
-				return ec.fieldContext_LoadBalancerOrigin_portNumber(ctx, field)
-			case "active":
-				return ec.fieldContext_LoadBalancerOrigin_active(ctx, field)
-			case "poolID":
-				return ec.fieldContext_LoadBalancerOrigin_poolID(ctx, field)
-			case "pool":
-				return ec.fieldContext_LoadBalancerOrigin_pool(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerOrigin", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerOriginDeletePayload_deletedID(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerOriginDeletePayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerOriginDeletePayload_deletedID(ctx, field)
+func (ec *executionContext) _LoadBalancerChange_loadBalancerID(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerChange_loadBalancerID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6874,7 +8539,7 @@ func (ec *executionContext) _LoadBalancerOriginDeletePayload_deletedID(ctx conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeletedID, nil
+		return obj.LoadBalancerID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6891,9 +8556,9 @@ func (ec *executionContext) _LoadBalancerOriginDeletePayload_deletedID(ctx conte
 	return ec.marshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerOriginDeletePayload_deletedID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerChange_loadBalancerID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerOriginDeletePayload",
+		Object:     "LoadBalancerChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -6904,8 +8569,8 @@ func (ec *executionContext) fieldContext_LoadBalancerOriginDeletePayload_deleted
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerOriginEdge_node(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerOriginEdge) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerOriginEdge_node(ctx, field)
+func (ec *executionContext) _LoadBalancerChange_loadBalancer(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerChange_loadBalancer(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6918,7 +8583,7 @@ func (ec *executionContext) _LoadBalancerOriginEdge_node(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Node, nil
+		return obj.LoadBalancer, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6927,56 +8592,56 @@ func (ec *executionContext) _LoadBalancerOriginEdge_node(ctx context.Context, fi
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*generated.Origin)
+	res := resTmp.(*generated.LoadBalancer)
 	fc.Result = res
-	return ec.marshalOLoadBalancerOrigin2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐOrigin(ctx, field.Selections, res)
+	return ec.marshalOLoadBalancer2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancer(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerOriginEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerChange_loadBalancer(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerOriginEdge",
+		Object:     "LoadBalancerChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_LoadBalancerOrigin_id(ctx, field)
+				return ec.fieldContext_LoadBalancer_id(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_LoadBalancerOrigin_createdAt(ctx, field)
+				return ec.fieldContext_LoadBalancer_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_LoadBalancerOrigin_updatedAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_LoadBalancerOrigin_deletedAt(ctx, field)
-			case "deletedBy":
-				return ec.fieldContext_LoadBalancerOrigin_deletedBy(ctx, field)
+				return ec.fieldContext_LoadBalancer_updatedAt(ctx, field)
 			case "createdBy":
-				return ec.fieldContext_LoadBalancerOrigin_createdBy(ctx, field)
+				return ec.fieldContext_LoadBalancer_createdBy(ctx, field)
 			case "updatedBy":
-				return ec.fieldContext_LoadBalancerOrigin_updatedBy(ctx, field)
+				return ec.fieldContext_LoadBalancer_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancer_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancer_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancer_deletedBy(ctx, field)
 			case "name":
-				return ec.fieldContext_LoadBalancerOrigin_name(ctx, field)
-			case "weight":
-				return ec.fieldContext_LoadBalancerOrigin_weight(ctx, field)
-			case "target":
-				return ec.fieldContext_LoadBalancerOrigin_target(ctx, field)
-			case "portNumber":
-				return ec.fieldContext_LoadBalancerOrigin_portNumber(ctx, field)
-			case "active":
-				return ec.fieldContext_LoadBalancerOrigin_active(ctx, field)
-			case "poolID":
-				return ec.fieldContext_LoadBalancerOrigin_poolID(ctx, field)
-			case "pool":
-				return ec.fieldContext_LoadBalancerOrigin_pool(ctx, field)
+				return ec.fieldContext_LoadBalancer_name(ctx, field)
+			case "ports":
+				return ec.fieldContext_LoadBalancer_ports(ctx, field)
+			case "loadBalancerProvider":
+				return ec.fieldContext_LoadBalancer_loadBalancerProvider(ctx, field)
+			case "auditEvents":
+				return ec.fieldContext_LoadBalancer_auditEvents(ctx, field)
+			case "location":
+				return ec.fieldContext_LoadBalancer_location(ctx, field)
+			case "owner":
+				return ec.fieldContext_LoadBalancer_owner(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerOrigin", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancer", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerOriginEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerOriginEdge) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerOriginEdge_cursor(ctx, field)
+func (ec *executionContext) _LoadBalancerChange_timestamp(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerChange_timestamp(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6989,7 +8654,7 @@ func (ec *executionContext) _LoadBalancerOriginEdge_cursor(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Cursor, nil
+		return obj.Timestamp, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7001,26 +8666,26 @@ func (ec *executionContext) _LoadBalancerOriginEdge_cursor(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(entgql.Cursor[gidx.PrefixedID])
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNCursor2entgoᚗioᚋcontribᚋentgqlᚐCursor(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerOriginEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerChange_timestamp(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerOriginEdge",
+		Object:     "LoadBalancerChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Cursor does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerOriginUpdatePayload_loadBalancerOrigin(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerOriginUpdatePayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerOriginUpdatePayload_loadBalancerOrigin(ctx, field)
+func (ec *executionContext) _LoadBalancerConnection_edges(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerConnection_edges(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7033,68 +8698,41 @@ func (ec *executionContext) _LoadBalancerOriginUpdatePayload_loadBalancerOrigin(
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LoadBalancerOrigin, nil
+		return obj.Edges, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.Origin)
+	res := resTmp.([]*generated.LoadBalancerEdge)
 	fc.Result = res
-	return ec.marshalNLoadBalancerOrigin2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐOrigin(ctx, field.Selections, res)
+	return ec.marshalOLoadBalancerEdge2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerEdge(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerOriginUpdatePayload_loadBalancerOrigin(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerConnection_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerOriginUpdatePayload",
+		Object:     "LoadBalancerConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_LoadBalancerOrigin_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_LoadBalancerOrigin_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_LoadBalancerOrigin_updatedAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_LoadBalancerOrigin_deletedAt(ctx, field)
-			case "deletedBy":
-				return ec.fieldContext_LoadBalancerOrigin_deletedBy(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_LoadBalancerOrigin_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_LoadBalancerOrigin_updatedBy(ctx, field)
-			case "name":
-				return ec.fieldContext_LoadBalancerOrigin_name(ctx, field)
-			case "weight":
-				return ec.fieldContext_LoadBalancerOrigin_weight(ctx, field)
-			case "target":
-				return ec.fieldContext_LoadBalancerOrigin_target(ctx, field)
-			case "portNumber":
-				return ec.fieldContext_LoadBalancerOrigin_portNumber(ctx, field)
-			case "active":
-				return ec.fieldContext_LoadBalancerOrigin_active(ctx, field)
-			case "poolID":
-				return ec.fieldContext_LoadBalancerOrigin_poolID(ctx, field)
-			case "pool":
-				return ec.fieldContext_LoadBalancerOrigin_pool(ctx, field)
+			case "node":
+				return ec.fieldContext_LoadBalancerEdge_node(ctx, field)
+			case "cursor":
+				return ec.fieldContext_LoadBalancerEdge_cursor(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerOrigin", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerEdge", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPool_id(ctx context.Context, field graphql.CollectedField, obj *generated.Pool) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPool_id(ctx, field)
+func (ec *executionContext) _LoadBalancerConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerConnection_pageInfo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7107,7 +8745,7 @@ func (ec *executionContext) _LoadBalancerPool_id(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.PageInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7119,26 +8757,36 @@ func (ec *executionContext) _LoadBalancerPool_id(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(gidx.PrefixedID)
+	res := resTmp.(entgql.PageInfo[gidx.PrefixedID])
 	fc.Result = res
-	return ec.marshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
+	return ec.marshalNPageInfo2entgoᚗioᚋcontribᚋentgqlᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPool_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPool",
+		Object:     "LoadBalancerConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "startCursor":
+				return ec.fieldContext_PageInfo_startCursor(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPool_createdAt(ctx context.Context, field graphql.CollectedField, obj *generated.Pool) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPool_createdAt(ctx, field)
+func (ec *executionContext) _LoadBalancerConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerConnection_totalCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7151,7 +8799,7 @@ func (ec *executionContext) _LoadBalancerPool_createdAt(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.TotalCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7163,26 +8811,26 @@ func (ec *executionContext) _LoadBalancerPool_createdAt(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPool_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerConnection_totalCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPool",
+		Object:     "LoadBalancerConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPool_updatedAt(ctx context.Context, field graphql.CollectedField, obj *generated.Pool) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPool_updatedAt(ctx, field)
+func (ec *executionContext) _LoadBalancerCreatePayload_loadBalancer(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerCreatePayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerCreatePayload_loadBalancer(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7195,7 +8843,7 @@ func (ec *executionContext) _LoadBalancerPool_updatedAt(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.LoadBalancer, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7207,26 +8855,56 @@ func (ec *executionContext) _LoadBalancerPool_updatedAt(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*generated.LoadBalancer)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancer2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancer(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPool_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerCreatePayload_loadBalancer(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPool",
+		Object:     "LoadBalancerCreatePayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_LoadBalancer_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancer_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancer_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancer_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancer_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancer_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancer_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancer_deletedBy(ctx, field)
+			case "name":
+				return ec.fieldContext_LoadBalancer_name(ctx, field)
+			case "ports":
+				return ec.fieldContext_LoadBalancer_ports(ctx, field)
+			case "loadBalancerProvider":
+				return ec.fieldContext_LoadBalancer_loadBalancerProvider(ctx, field)
+			case "auditEvents":
+				return ec.fieldContext_LoadBalancer_auditEvents(ctx, field)
+			case "location":
+				return ec.fieldContext_LoadBalancer_location(ctx, field)
+			case "owner":
+				return ec.fieldContext_LoadBalancer_owner(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancer", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPool_createdBy(ctx context.Context, field graphql.CollectedField, obj *generated.Pool) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPool_createdBy(ctx, field)
+func (ec *executionContext) _LoadBalancerDeletePayload_deletedID(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerDeletePayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerDeletePayload_deletedID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7239,35 +8917,38 @@ func (ec *executionContext) _LoadBalancerPool_createdBy(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedBy, nil
+		return obj.DeletedID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(gidx.PrefixedID)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPool_createdBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerDeletePayload_deletedID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPool",
+		Object:     "LoadBalancerDeletePayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPool_updatedBy(ctx context.Context, field graphql.CollectedField, obj *generated.Pool) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPool_updatedBy(ctx, field)
+func (ec *executionContext) _LoadBalancerEdge_node(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerEdge_node(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7280,7 +8961,7 @@ func (ec *executionContext) _LoadBalancerPool_updatedBy(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedBy, nil
+		return obj.Node, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7289,26 +8970,56 @@ func (ec *executionContext) _LoadBalancerPool_updatedBy(ctx context.Context, fie
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*generated.LoadBalancer)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalOLoadBalancer2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancer(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPool_updatedBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPool",
+		Object:     "LoadBalancerEdge",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_LoadBalancer_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancer_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancer_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancer_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancer_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancer_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancer_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancer_deletedBy(ctx, field)
+			case "name":
+				return ec.fieldContext_LoadBalancer_name(ctx, field)
+			case "ports":
+				return ec.fieldContext_LoadBalancer_ports(ctx, field)
+			case "loadBalancerProvider":
+				return ec.fieldContext_LoadBalancer_loadBalancerProvider(ctx, field)
+			case "auditEvents":
+				return ec.fieldContext_LoadBalancer_auditEvents(ctx, field)
+			case "location":
+				return ec.fieldContext_LoadBalancer_location(ctx, field)
+			case "owner":
+				return ec.fieldContext_LoadBalancer_owner(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancer", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPool_deletedAt(ctx context.Context, field graphql.CollectedField, obj *generated.Pool) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPool_deletedAt(ctx, field)
+func (ec *executionContext) _LoadBalancerEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerEdge_cursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7321,35 +9032,38 @@ func (ec *executionContext) _LoadBalancerPool_deletedAt(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeletedAt, nil
+		return obj.Cursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(entgql.Cursor[gidx.PrefixedID])
 	fc.Result = res
-	return ec.marshalOTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNCursor2entgoᚗioᚋcontribᚋentgqlᚐCursor(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPool_deletedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPool",
+		Object:     "LoadBalancerEdge",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Cursor does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPool_deletedBy(ctx context.Context, field graphql.CollectedField, obj *generated.Pool) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPool_deletedBy(ctx, field)
+func (ec *executionContext) _LoadBalancerOrigin_id(ctx context.Context, field graphql.CollectedField, obj *generated.Origin) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerOrigin_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7362,35 +9076,38 @@ func (ec *executionContext) _LoadBalancerPool_deletedBy(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeletedBy, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(gidx.PrefixedID)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPool_deletedBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerOrigin_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPool",
+		Object:     "LoadBalancerOrigin",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPool_name(ctx context.Context, field graphql.CollectedField, obj *generated.Pool) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPool_name(ctx, field)
+func (ec *executionContext) _LoadBalancerOrigin_createdAt(ctx context.Context, field graphql.CollectedField, obj *generated.Origin) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerOrigin_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7403,7 +9120,7 @@ func (ec *executionContext) _LoadBalancerPool_name(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7415,26 +9132,26 @@ func (ec *executionContext) _LoadBalancerPool_name(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPool_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerOrigin_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPool",
+		Object:     "LoadBalancerOrigin",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPool_protocol(ctx context.Context, field graphql.CollectedField, obj *generated.Pool) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPool_protocol(ctx, field)
+func (ec *executionContext) _LoadBalancerOrigin_updatedAt(ctx context.Context, field graphql.CollectedField, obj *generated.Origin) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerOrigin_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7447,7 +9164,7 @@ func (ec *executionContext) _LoadBalancerPool_protocol(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Protocol, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7459,27 +9176,27 @@ func (ec *executionContext) _LoadBalancerPool_protocol(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(pool.Protocol)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNLoadBalancerPoolProtocol2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚋpoolᚐProtocol(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPool_protocol(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerOrigin_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPool",
+		Object:     "LoadBalancerOrigin",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type LoadBalancerPoolProtocol does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPool_ownerID(ctx context.Context, field graphql.CollectedField, obj *generated.Pool) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPool_ownerID(ctx, field)
-	if err != nil {
+func (ec *executionContext) _LoadBalancerOrigin_deletedAt(ctx context.Context, field graphql.CollectedField, obj *generated.Origin) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerOrigin_deletedAt(ctx, field)
+	if err != nil {
 		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
@@ -7491,38 +9208,35 @@ func (ec *executionContext) _LoadBalancerPool_ownerID(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OwnerID, nil
+		return obj.DeletedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(gidx.PrefixedID)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
+	return ec.marshalOTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPool_ownerID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerOrigin_deletedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPool",
+		Object:     "LoadBalancerOrigin",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPool_ports(ctx context.Context, field graphql.CollectedField, obj *generated.Pool) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPool_ports(ctx, field)
+func (ec *executionContext) _LoadBalancerOrigin_deletedBy(ctx context.Context, field graphql.CollectedField, obj *generated.Origin) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerOrigin_deletedBy(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7535,7 +9249,7 @@ func (ec *executionContext) _LoadBalancerPool_ports(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Ports(ctx)
+		return obj.DeletedBy, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7544,52 +9258,26 @@ func (ec *executionContext) _LoadBalancerPool_ports(ctx context.Context, field g
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*generated.Port)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOLoadBalancerPort2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPortᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPool_ports(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerOrigin_deletedBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPool",
+		Object:     "LoadBalancerOrigin",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_LoadBalancerPort_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_LoadBalancerPort_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_LoadBalancerPort_updatedAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_LoadBalancerPort_deletedAt(ctx, field)
-			case "deletedBy":
-				return ec.fieldContext_LoadBalancerPort_deletedBy(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_LoadBalancerPort_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_LoadBalancerPort_updatedBy(ctx, field)
-			case "number":
-				return ec.fieldContext_LoadBalancerPort_number(ctx, field)
-			case "name":
-				return ec.fieldContext_LoadBalancerPort_name(ctx, field)
-			case "loadBalancerID":
-				return ec.fieldContext_LoadBalancerPort_loadBalancerID(ctx, field)
-			case "pools":
-				return ec.fieldContext_LoadBalancerPort_pools(ctx, field)
-			case "loadBalancer":
-				return ec.fieldContext_LoadBalancerPort_loadBalancer(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPort", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPool_origins(ctx context.Context, field graphql.CollectedField, obj *generated.Pool) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPool_origins(ctx, field)
+func (ec *executionContext) _LoadBalancerOrigin_createdBy(ctx context.Context, field graphql.CollectedField, obj *generated.Origin) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerOrigin_createdBy(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7602,57 +9290,35 @@ func (ec *executionContext) _LoadBalancerPool_origins(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Origins(ctx, fc.Args["after"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["first"].(*int), fc.Args["before"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["last"].(*int), fc.Args["orderBy"].(*generated.LoadBalancerOriginOrder), fc.Args["where"].(*generated.LoadBalancerOriginWhereInput))
+		return obj.CreatedBy, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.LoadBalancerOriginConnection)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNLoadBalancerOriginConnection2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerOriginConnection(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPool_origins(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerOrigin_createdBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPool",
+		Object:     "LoadBalancerOrigin",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "edges":
-				return ec.fieldContext_LoadBalancerOriginConnection_edges(ctx, field)
-			case "pageInfo":
-				return ec.fieldContext_LoadBalancerOriginConnection_pageInfo(ctx, field)
-			case "totalCount":
-				return ec.fieldContext_LoadBalancerOriginConnection_totalCount(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerOriginConnection", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_LoadBalancerPool_origins_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPool_owner(ctx context.Context, field graphql.CollectedField, obj *generated.Pool) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPool_owner(ctx, field)
+func (ec *executionContext) _LoadBalancerOrigin_updatedBy(ctx context.Context, field graphql.CollectedField, obj *generated.Origin) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerOrigin_updatedBy(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7665,48 +9331,35 @@ func (ec *executionContext) _LoadBalancerPool_owner(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.LoadBalancerPool().Owner(rctx, obj)
+		return obj.UpdatedBy, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*ResourceOwner)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNResourceOwner2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐResourceOwner(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPool_owner(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerOrigin_updatedBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPool",
+		Object:     "LoadBalancerOrigin",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_ResourceOwner_id(ctx, field)
-			case "loadBalancers":
-				return ec.fieldContext_ResourceOwner_loadBalancers(ctx, field)
-			case "loadBalancerPools":
-				return ec.fieldContext_ResourceOwner_loadBalancerPools(ctx, field)
-			case "loadBalancersProviders":
-				return ec.fieldContext_ResourceOwner_loadBalancersProviders(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ResourceOwner", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPoolConnection_edges(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerPoolConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPoolConnection_edges(ctx, field)
+func (ec *executionContext) _LoadBalancerOrigin_version(ctx context.Context, field graphql.CollectedField, obj *generated.Origin) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerOrigin_version(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7719,41 +9372,38 @@ func (ec *executionContext) _LoadBalancerPoolConnection_edges(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Edges, nil
+		return obj.Version, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*generated.LoadBalancerPoolEdge)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOLoadBalancerPoolEdge2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerPoolEdge(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPoolConnection_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerOrigin_version(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPoolConnection",
+		Object:     "LoadBalancerOrigin",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "node":
-				return ec.fieldContext_LoadBalancerPoolEdge_node(ctx, field)
-			case "cursor":
-				return ec.fieldContext_LoadBalancerPoolEdge_cursor(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPoolEdge", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPoolConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerPoolConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPoolConnection_pageInfo(ctx, field)
+func (ec *executionContext) _LoadBalancerOrigin_name(ctx context.Context, field graphql.CollectedField, obj *generated.Origin) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerOrigin_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7766,7 +9416,7 @@ func (ec *executionContext) _LoadBalancerPoolConnection_pageInfo(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PageInfo, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7778,36 +9428,26 @@ func (ec *executionContext) _LoadBalancerPoolConnection_pageInfo(ctx context.Con
 		}
 		return graphql.Null
 	}
-	res := resTmp.(entgql.PageInfo[gidx.PrefixedID])
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNPageInfo2entgoᚗioᚋcontribᚋentgqlᚐPageInfo(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPoolConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerOrigin_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPoolConnection",
+		Object:     "LoadBalancerOrigin",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "hasNextPage":
-				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
-			case "hasPreviousPage":
-				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
-			case "startCursor":
-				return ec.fieldContext_PageInfo_startCursor(ctx, field)
-			case "endCursor":
-				return ec.fieldContext_PageInfo_endCursor(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPoolConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerPoolConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPoolConnection_totalCount(ctx, field)
+func (ec *executionContext) _LoadBalancerOrigin_weight(ctx context.Context, field graphql.CollectedField, obj *generated.Origin) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerOrigin_weight(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7820,7 +9460,7 @@ func (ec *executionContext) _LoadBalancerPoolConnection_totalCount(ctx context.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalCount, nil
+		return obj.Weight, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7832,14 +9472,14 @@ func (ec *executionContext) _LoadBalancerPoolConnection_totalCount(ctx context.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(int32)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNInt2int32(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPoolConnection_totalCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerOrigin_weight(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPoolConnection",
+		Object:     "LoadBalancerOrigin",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7850,8 +9490,8 @@ func (ec *executionContext) fieldContext_LoadBalancerPoolConnection_totalCount(c
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPoolCreatePayload_loadBalancerPool(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerPoolCreatePayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPoolCreatePayload_loadBalancerPool(ctx, field)
+func (ec *executionContext) _LoadBalancerOrigin_target(ctx context.Context, field graphql.CollectedField, obj *generated.Origin) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerOrigin_target(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7864,7 +9504,7 @@ func (ec *executionContext) _LoadBalancerPoolCreatePayload_loadBalancerPool(ctx
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LoadBalancerPool, nil
+		return obj.Target, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7876,54 +9516,26 @@ func (ec *executionContext) _LoadBalancerPoolCreatePayload_loadBalancerPool(ctx
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.Pool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNLoadBalancerPool2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPoolCreatePayload_loadBalancerPool(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerOrigin_target(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPoolCreatePayload",
+		Object:     "LoadBalancerOrigin",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_LoadBalancerPool_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_LoadBalancerPool_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_LoadBalancerPool_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_LoadBalancerPool_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_LoadBalancerPool_updatedBy(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_LoadBalancerPool_deletedAt(ctx, field)
-			case "deletedBy":
-				return ec.fieldContext_LoadBalancerPool_deletedBy(ctx, field)
-			case "name":
-				return ec.fieldContext_LoadBalancerPool_name(ctx, field)
-			case "protocol":
-				return ec.fieldContext_LoadBalancerPool_protocol(ctx, field)
-			case "ownerID":
-				return ec.fieldContext_LoadBalancerPool_ownerID(ctx, field)
-			case "ports":
-				return ec.fieldContext_LoadBalancerPool_ports(ctx, field)
-			case "origins":
-				return ec.fieldContext_LoadBalancerPool_origins(ctx, field)
-			case "owner":
-				return ec.fieldContext_LoadBalancerPool_owner(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPool", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPoolDeletePayload_deletedID(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerPoolDeletePayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPoolDeletePayload_deletedID(ctx, field)
+func (ec *executionContext) _LoadBalancerOrigin_portNumber(ctx context.Context, field graphql.CollectedField, obj *generated.Origin) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerOrigin_portNumber(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7936,35 +9548,38 @@ func (ec *executionContext) _LoadBalancerPoolDeletePayload_deletedID(ctx context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeletedID, nil
+		return obj.PortNumber, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*gidx.PrefixedID)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOID2ᚖgoᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPoolDeletePayload_deletedID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerOrigin_portNumber(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPoolDeletePayload",
+		Object:     "LoadBalancerOrigin",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPoolEdge_node(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerPoolEdge) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPoolEdge_node(ctx, field)
+func (ec *executionContext) _LoadBalancerOrigin_active(ctx context.Context, field graphql.CollectedField, obj *generated.Origin) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerOrigin_active(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7977,63 +9592,38 @@ func (ec *executionContext) _LoadBalancerPoolEdge_node(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Node, nil
+		return obj.Active, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.Pool)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOLoadBalancerPool2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPool(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPoolEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerOrigin_active(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPoolEdge",
+		Object:     "LoadBalancerOrigin",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_LoadBalancerPool_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_LoadBalancerPool_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_LoadBalancerPool_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_LoadBalancerPool_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_LoadBalancerPool_updatedBy(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_LoadBalancerPool_deletedAt(ctx, field)
-			case "deletedBy":
-				return ec.fieldContext_LoadBalancerPool_deletedBy(ctx, field)
-			case "name":
-				return ec.fieldContext_LoadBalancerPool_name(ctx, field)
-			case "protocol":
-				return ec.fieldContext_LoadBalancerPool_protocol(ctx, field)
-			case "ownerID":
-				return ec.fieldContext_LoadBalancerPool_ownerID(ctx, field)
-			case "ports":
-				return ec.fieldContext_LoadBalancerPool_ports(ctx, field)
-			case "origins":
-				return ec.fieldContext_LoadBalancerPool_origins(ctx, field)
-			case "owner":
-				return ec.fieldContext_LoadBalancerPool_owner(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPool", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPoolEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerPoolEdge) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPoolEdge_cursor(ctx, field)
+func (ec *executionContext) _LoadBalancerOrigin_poolID(ctx context.Context, field graphql.CollectedField, obj *generated.Origin) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerOrigin_poolID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8046,7 +9636,7 @@ func (ec *executionContext) _LoadBalancerPoolEdge_cursor(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Cursor, nil
+		return obj.PoolID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8058,26 +9648,26 @@ func (ec *executionContext) _LoadBalancerPoolEdge_cursor(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(entgql.Cursor[gidx.PrefixedID])
+	res := resTmp.(gidx.PrefixedID)
 	fc.Result = res
-	return ec.marshalNCursor2entgoᚗioᚋcontribᚋentgqlᚐCursor(ctx, field.Selections, res)
+	return ec.marshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPoolEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerOrigin_poolID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPoolEdge",
+		Object:     "LoadBalancerOrigin",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Cursor does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPoolUpdatePayload_loadBalancerPool(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerPoolUpdatePayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPoolUpdatePayload_loadBalancerPool(ctx, field)
+func (ec *executionContext) _LoadBalancerOrigin_pool(ctx context.Context, field graphql.CollectedField, obj *generated.Origin) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerOrigin_pool(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8090,7 +9680,7 @@ func (ec *executionContext) _LoadBalancerPoolUpdatePayload_loadBalancerPool(ctx
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LoadBalancerPool, nil
+		return obj.Pool(ctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8107,11 +9697,11 @@ func (ec *executionContext) _LoadBalancerPoolUpdatePayload_loadBalancerPool(ctx
 	return ec.marshalNLoadBalancerPool2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPoolUpdatePayload_loadBalancerPool(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerOrigin_pool(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPoolUpdatePayload",
+		Object:     "LoadBalancerOrigin",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
@@ -8125,6 +9715,8 @@ func (ec *executionContext) fieldContext_LoadBalancerPoolUpdatePayload_loadBalan
 				return ec.fieldContext_LoadBalancerPool_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_LoadBalancerPool_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancerPool_version(ctx, field)
 			case "deletedAt":
 				return ec.fieldContext_LoadBalancerPool_deletedAt(ctx, field)
 			case "deletedBy":
@@ -8139,6 +9731,8 @@ func (ec *executionContext) fieldContext_LoadBalancerPoolUpdatePayload_loadBalan
 				return ec.fieldContext_LoadBalancerPool_ports(ctx, field)
 			case "origins":
 				return ec.fieldContext_LoadBalancerPool_origins(ctx, field)
+			case "auditEvents":
+				return ec.fieldContext_LoadBalancerPool_auditEvents(ctx, field)
 			case "owner":
 				return ec.fieldContext_LoadBalancerPool_owner(ctx, field)
 			}
@@ -8148,8 +9742,8 @@ func (ec *executionContext) fieldContext_LoadBalancerPoolUpdatePayload_loadBalan
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPort_id(ctx context.Context, field graphql.CollectedField, obj *generated.Port) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPort_id(ctx, field)
+func (ec *executionContext) _LoadBalancerOriginConnection_edges(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerOriginConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerOriginConnection_edges(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8162,38 +9756,41 @@ func (ec *executionContext) _LoadBalancerPort_id(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Edges, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(gidx.PrefixedID)
+	res := resTmp.([]*generated.LoadBalancerOriginEdge)
 	fc.Result = res
-	return ec.marshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
+	return ec.marshalOLoadBalancerOriginEdge2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerOriginEdge(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPort_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerOriginConnection_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPort",
+		Object:     "LoadBalancerOriginConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "node":
+				return ec.fieldContext_LoadBalancerOriginEdge_node(ctx, field)
+			case "cursor":
+				return ec.fieldContext_LoadBalancerOriginEdge_cursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerOriginEdge", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPort_createdAt(ctx context.Context, field graphql.CollectedField, obj *generated.Port) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPort_createdAt(ctx, field)
+func (ec *executionContext) _LoadBalancerOriginConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerOriginConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerOriginConnection_pageInfo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8206,7 +9803,7 @@ func (ec *executionContext) _LoadBalancerPort_createdAt(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.PageInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8218,26 +9815,36 @@ func (ec *executionContext) _LoadBalancerPort_createdAt(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(entgql.PageInfo[gidx.PrefixedID])
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNPageInfo2entgoᚗioᚋcontribᚋentgqlᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPort_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerOriginConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPort",
+		Object:     "LoadBalancerOriginConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			switch field.Name {
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "startCursor":
+				return ec.fieldContext_PageInfo_startCursor(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPort_updatedAt(ctx context.Context, field graphql.CollectedField, obj *generated.Port) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPort_updatedAt(ctx, field)
+func (ec *executionContext) _LoadBalancerOriginConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerOriginConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerOriginConnection_totalCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8250,7 +9857,7 @@ func (ec *executionContext) _LoadBalancerPort_updatedAt(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.TotalCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8262,26 +9869,26 @@ func (ec *executionContext) _LoadBalancerPort_updatedAt(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPort_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerOriginConnection_totalCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPort",
+		Object:     "LoadBalancerOriginConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPort_deletedAt(ctx context.Context, field graphql.CollectedField, obj *generated.Port) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPort_deletedAt(ctx, field)
+func (ec *executionContext) _LoadBalancerOriginCreatePayload_loadBalancerOrigin(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerOriginCreatePayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerOriginCreatePayload_loadBalancerOrigin(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8294,35 +9901,70 @@ func (ec *executionContext) _LoadBalancerPort_deletedAt(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeletedAt, nil
+		return obj.LoadBalancerOrigin, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*generated.Origin)
 	fc.Result = res
-	return ec.marshalOTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerOrigin2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐOrigin(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPort_deletedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerOriginCreatePayload_loadBalancerOrigin(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPort",
+		Object:     "LoadBalancerOriginCreatePayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_LoadBalancerOrigin_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancerOrigin_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancerOrigin_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancerOrigin_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancerOrigin_deletedBy(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancerOrigin_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancerOrigin_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancerOrigin_version(ctx, field)
+			case "name":
+				return ec.fieldContext_LoadBalancerOrigin_name(ctx, field)
+			case "weight":
+				return ec.fieldContext_LoadBalancerOrigin_weight(ctx, field)
+			case "target":
+				return ec.fieldContext_LoadBalancerOrigin_target(ctx, field)
+			case "portNumber":
+				return ec.fieldContext_LoadBalancerOrigin_portNumber(ctx, field)
+			case "active":
+				return ec.fieldContext_LoadBalancerOrigin_active(ctx, field)
+			case "poolID":
+				return ec.fieldContext_LoadBalancerOrigin_poolID(ctx, field)
+			case "pool":
+				return ec.fieldContext_LoadBalancerOrigin_pool(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerOrigin", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPort_deletedBy(ctx context.Context, field graphql.CollectedField, obj *generated.Port) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPort_deletedBy(ctx, field)
+func (ec *executionContext) _LoadBalancerOriginDeletePayload_deletedID(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerOriginDeletePayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerOriginDeletePayload_deletedID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8335,35 +9977,38 @@ func (ec *executionContext) _LoadBalancerPort_deletedBy(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeletedBy, nil
+		return obj.DeletedID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(gidx.PrefixedID)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPort_deletedBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerOriginDeletePayload_deletedID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPort",
+		Object:     "LoadBalancerOriginDeletePayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPort_createdBy(ctx context.Context, field graphql.CollectedField, obj *generated.Port) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPort_createdBy(ctx, field)
+func (ec *executionContext) _LoadBalancerOriginEdge_node(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerOriginEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerOriginEdge_node(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8376,7 +10021,7 @@ func (ec *executionContext) _LoadBalancerPort_createdBy(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedBy, nil
+		return obj.Node, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8385,67 +10030,58 @@ func (ec *executionContext) _LoadBalancerPort_createdBy(ctx context.Context, fie
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*generated.Origin)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalOLoadBalancerOrigin2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐOrigin(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPort_createdBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerOriginEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPort",
+		Object:     "LoadBalancerOriginEdge",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _LoadBalancerPort_updatedBy(ctx context.Context, field graphql.CollectedField, obj *generated.Port) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPort_updatedBy(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedBy, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_LoadBalancerPort_updatedBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPort",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_LoadBalancerOrigin_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancerOrigin_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancerOrigin_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancerOrigin_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancerOrigin_deletedBy(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancerOrigin_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancerOrigin_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancerOrigin_version(ctx, field)
+			case "name":
+				return ec.fieldContext_LoadBalancerOrigin_name(ctx, field)
+			case "weight":
+				return ec.fieldContext_LoadBalancerOrigin_weight(ctx, field)
+			case "target":
+				return ec.fieldContext_LoadBalancerOrigin_target(ctx, field)
+			case "portNumber":
+				return ec.fieldContext_LoadBalancerOrigin_portNumber(ctx, field)
+			case "active":
+				return ec.fieldContext_LoadBalancerOrigin_active(ctx, field)
+			case "poolID":
+				return ec.fieldContext_LoadBalancerOrigin_poolID(ctx, field)
+			case "pool":
+				return ec.fieldContext_LoadBalancerOrigin_pool(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerOrigin", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPort_number(ctx context.Context, field graphql.CollectedField, obj *generated.Port) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPort_number(ctx, field)
+func (ec *executionContext) _LoadBalancerOriginEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerOriginEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerOriginEdge_cursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8458,7 +10094,7 @@ func (ec *executionContext) _LoadBalancerPort_number(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Number, nil
+		return obj.Cursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8470,26 +10106,26 @@ func (ec *executionContext) _LoadBalancerPort_number(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(entgql.Cursor[gidx.PrefixedID])
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNCursor2entgoᚗioᚋcontribᚋentgqlᚐCursor(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPort_number(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerOriginEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPort",
+		Object:     "LoadBalancerOriginEdge",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Cursor does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPort_name(ctx context.Context, field graphql.CollectedField, obj *generated.Port) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPort_name(ctx, field)
+func (ec *executionContext) _LoadBalancerOriginUpdatePayload_loadBalancerOrigin(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerOriginUpdatePayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerOriginUpdatePayload_loadBalancerOrigin(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8502,35 +10138,70 @@ func (ec *executionContext) _LoadBalancerPort_name(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.LoadBalancerOrigin, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*generated.Origin)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerOrigin2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐOrigin(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPort_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerOriginUpdatePayload_loadBalancerOrigin(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPort",
+		Object:     "LoadBalancerOriginUpdatePayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_LoadBalancerOrigin_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancerOrigin_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancerOrigin_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancerOrigin_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancerOrigin_deletedBy(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancerOrigin_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancerOrigin_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancerOrigin_version(ctx, field)
+			case "name":
+				return ec.fieldContext_LoadBalancerOrigin_name(ctx, field)
+			case "weight":
+				return ec.fieldContext_LoadBalancerOrigin_weight(ctx, field)
+			case "target":
+				return ec.fieldContext_LoadBalancerOrigin_target(ctx, field)
+			case "portNumber":
+				return ec.fieldContext_LoadBalancerOrigin_portNumber(ctx, field)
+			case "active":
+				return ec.fieldContext_LoadBalancerOrigin_active(ctx, field)
+			case "poolID":
+				return ec.fieldContext_LoadBalancerOrigin_poolID(ctx, field)
+			case "pool":
+				return ec.fieldContext_LoadBalancerOrigin_pool(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerOrigin", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPort_loadBalancerID(ctx context.Context, field graphql.CollectedField, obj *generated.Port) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPort_loadBalancerID(ctx, field)
+func (ec *executionContext) _LoadBalancerPool_id(ctx context.Context, field graphql.CollectedField, obj *generated.Pool) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPool_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8543,7 +10214,7 @@ func (ec *executionContext) _LoadBalancerPort_loadBalancerID(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LoadBalancerID, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8560,9 +10231,9 @@ func (ec *executionContext) _LoadBalancerPort_loadBalancerID(ctx context.Context
 	return ec.marshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPort_loadBalancerID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPool_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPort",
+		Object:     "LoadBalancerPool",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8573,8 +10244,8 @@ func (ec *executionContext) fieldContext_LoadBalancerPort_loadBalancerID(ctx con
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPort_pools(ctx context.Context, field graphql.CollectedField, obj *generated.Port) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPort_pools(ctx, field)
+func (ec *executionContext) _LoadBalancerPool_createdAt(ctx context.Context, field graphql.CollectedField, obj *generated.Pool) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPool_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8587,63 +10258,38 @@ func (ec *executionContext) _LoadBalancerPort_pools(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Pools(ctx)
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*generated.Pool)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalOLoadBalancerPool2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPoolᚄ(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPort_pools(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPool_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPort",
+		Object:     "LoadBalancerPool",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_LoadBalancerPool_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_LoadBalancerPool_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_LoadBalancerPool_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_LoadBalancerPool_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_LoadBalancerPool_updatedBy(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_LoadBalancerPool_deletedAt(ctx, field)
-			case "deletedBy":
-				return ec.fieldContext_LoadBalancerPool_deletedBy(ctx, field)
-			case "name":
-				return ec.fieldContext_LoadBalancerPool_name(ctx, field)
-			case "protocol":
-				return ec.fieldContext_LoadBalancerPool_protocol(ctx, field)
-			case "ownerID":
-				return ec.fieldContext_LoadBalancerPool_ownerID(ctx, field)
-			case "ports":
-				return ec.fieldContext_LoadBalancerPool_ports(ctx, field)
-			case "origins":
-				return ec.fieldContext_LoadBalancerPool_origins(ctx, field)
-			case "owner":
-				return ec.fieldContext_LoadBalancerPool_owner(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPool", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPort_loadBalancer(ctx context.Context, field graphql.CollectedField, obj *generated.Port) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPort_loadBalancer(ctx, field)
+func (ec *executionContext) _LoadBalancerPool_updatedAt(ctx context.Context, field graphql.CollectedField, obj *generated.Pool) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPool_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8656,7 +10302,7 @@ func (ec *executionContext) _LoadBalancerPort_loadBalancer(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LoadBalancer(ctx)
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8668,52 +10314,26 @@ func (ec *executionContext) _LoadBalancerPort_loadBalancer(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.LoadBalancer)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNLoadBalancer2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancer(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPort_loadBalancer(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPool_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPort",
+		Object:     "LoadBalancerPool",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_LoadBalancer_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_LoadBalancer_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_LoadBalancer_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_LoadBalancer_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_LoadBalancer_updatedBy(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_LoadBalancer_deletedAt(ctx, field)
-			case "deletedBy":
-				return ec.fieldContext_LoadBalancer_deletedBy(ctx, field)
-			case "name":
-				return ec.fieldContext_LoadBalancer_name(ctx, field)
-			case "ports":
-				return ec.fieldContext_LoadBalancer_ports(ctx, field)
-			case "loadBalancerProvider":
-				return ec.fieldContext_LoadBalancer_loadBalancerProvider(ctx, field)
-			case "location":
-				return ec.fieldContext_LoadBalancer_location(ctx, field)
-			case "owner":
-				return ec.fieldContext_LoadBalancer_owner(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancer", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPortConnection_edges(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerPortConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPortConnection_edges(ctx, field)
+func (ec *executionContext) _LoadBalancerPool_createdBy(ctx context.Context, field graphql.CollectedField, obj *generated.Pool) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPool_createdBy(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8726,7 +10346,7 @@ func (ec *executionContext) _LoadBalancerPortConnection_edges(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Edges, nil
+		return obj.CreatedBy, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8735,32 +10355,26 @@ func (ec *executionContext) _LoadBalancerPortConnection_edges(ctx context.Contex
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*generated.LoadBalancerPortEdge)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOLoadBalancerPortEdge2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerPortEdge(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPortConnection_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPool_createdBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPortConnection",
+		Object:     "LoadBalancerPool",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "node":
-				return ec.fieldContext_LoadBalancerPortEdge_node(ctx, field)
-			case "cursor":
-				return ec.fieldContext_LoadBalancerPortEdge_cursor(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPortEdge", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPortConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerPortConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPortConnection_pageInfo(ctx, field)
+func (ec *executionContext) _LoadBalancerPool_updatedBy(ctx context.Context, field graphql.CollectedField, obj *generated.Pool) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPool_updatedBy(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8773,48 +10387,35 @@ func (ec *executionContext) _LoadBalancerPortConnection_pageInfo(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PageInfo, nil
+		return obj.UpdatedBy, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(entgql.PageInfo[gidx.PrefixedID])
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNPageInfo2entgoᚗioᚋcontribᚋentgqlᚐPageInfo(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPortConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPool_updatedBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPortConnection",
+		Object:     "LoadBalancerPool",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "hasNextPage":
-				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
-			case "hasPreviousPage":
-				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
-			case "startCursor":
-				return ec.fieldContext_PageInfo_startCursor(ctx, field)
-			case "endCursor":
-				return ec.fieldContext_PageInfo_endCursor(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPortConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerPortConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPortConnection_totalCount(ctx, field)
+func (ec *executionContext) _LoadBalancerPool_version(ctx context.Context, field graphql.CollectedField, obj *generated.Pool) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPool_version(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8827,7 +10428,7 @@ func (ec *executionContext) _LoadBalancerPortConnection_totalCount(ctx context.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalCount, nil
+		return obj.Version, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8844,9 +10445,9 @@ func (ec *executionContext) _LoadBalancerPortConnection_totalCount(ctx context.C
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPortConnection_totalCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPool_version(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPortConnection",
+		Object:     "LoadBalancerPool",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8857,8 +10458,8 @@ func (ec *executionContext) fieldContext_LoadBalancerPortConnection_totalCount(c
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPortCreatePayload_loadBalancerPort(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerPortCreatePayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPortCreatePayload_loadBalancerPort(ctx, field)
+func (ec *executionContext) _LoadBalancerPool_deletedAt(ctx context.Context, field graphql.CollectedField, obj *generated.Pool) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPool_deletedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8871,64 +10472,35 @@ func (ec *executionContext) _LoadBalancerPortCreatePayload_loadBalancerPort(ctx
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LoadBalancerPort, nil
+		return obj.DeletedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.Port)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNLoadBalancerPort2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPort(ctx, field.Selections, res)
+	return ec.marshalOTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPortCreatePayload_loadBalancerPort(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPool_deletedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPortCreatePayload",
+		Object:     "LoadBalancerPool",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_LoadBalancerPort_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_LoadBalancerPort_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_LoadBalancerPort_updatedAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_LoadBalancerPort_deletedAt(ctx, field)
-			case "deletedBy":
-				return ec.fieldContext_LoadBalancerPort_deletedBy(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_LoadBalancerPort_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_LoadBalancerPort_updatedBy(ctx, field)
-			case "number":
-				return ec.fieldContext_LoadBalancerPort_number(ctx, field)
-			case "name":
-				return ec.fieldContext_LoadBalancerPort_name(ctx, field)
-			case "loadBalancerID":
-				return ec.fieldContext_LoadBalancerPort_loadBalancerID(ctx, field)
-			case "pools":
-				return ec.fieldContext_LoadBalancerPort_pools(ctx, field)
-			case "loadBalancer":
-				return ec.fieldContext_LoadBalancerPort_loadBalancer(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPort", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPortDeletePayload_deletedID(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerPortDeletePayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPortDeletePayload_deletedID(ctx, field)
+func (ec *executionContext) _LoadBalancerPool_deletedBy(ctx context.Context, field graphql.CollectedField, obj *generated.Pool) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPool_deletedBy(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8941,38 +10513,35 @@ func (ec *executionContext) _LoadBalancerPortDeletePayload_deletedID(ctx context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeletedID, nil
+		return obj.DeletedBy, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(gidx.PrefixedID)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPortDeletePayload_deletedID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPool_deletedBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPortDeletePayload",
+		Object:     "LoadBalancerPool",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPortEdge_node(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerPortEdge) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPortEdge_node(ctx, field)
+func (ec *executionContext) _LoadBalancerPool_name(ctx context.Context, field graphql.CollectedField, obj *generated.Pool) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPool_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8985,61 +10554,38 @@ func (ec *executionContext) _LoadBalancerPortEdge_node(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Node, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.Port)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOLoadBalancerPort2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPort(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPortEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPool_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPortEdge",
+		Object:     "LoadBalancerPool",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_LoadBalancerPort_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_LoadBalancerPort_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_LoadBalancerPort_updatedAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_LoadBalancerPort_deletedAt(ctx, field)
-			case "deletedBy":
-				return ec.fieldContext_LoadBalancerPort_deletedBy(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_LoadBalancerPort_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_LoadBalancerPort_updatedBy(ctx, field)
-			case "number":
-				return ec.fieldContext_LoadBalancerPort_number(ctx, field)
-			case "name":
-				return ec.fieldContext_LoadBalancerPort_name(ctx, field)
-			case "loadBalancerID":
-				return ec.fieldContext_LoadBalancerPort_loadBalancerID(ctx, field)
-			case "pools":
-				return ec.fieldContext_LoadBalancerPort_pools(ctx, field)
-			case "loadBalancer":
-				return ec.fieldContext_LoadBalancerPort_loadBalancer(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPort", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPortEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerPortEdge) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPortEdge_cursor(ctx, field)
+func (ec *executionContext) _LoadBalancerPool_protocol(ctx context.Context, field graphql.CollectedField, obj *generated.Pool) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPool_protocol(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9052,7 +10598,7 @@ func (ec *executionContext) _LoadBalancerPortEdge_cursor(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Cursor, nil
+		return obj.Protocol, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9064,26 +10610,26 @@ func (ec *executionContext) _LoadBalancerPortEdge_cursor(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(entgql.Cursor[gidx.PrefixedID])
+	res := resTmp.(pool.Protocol)
 	fc.Result = res
-	return ec.marshalNCursor2entgoᚗioᚋcontribᚋentgqlᚐCursor(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerPoolProtocol2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚋpoolᚐProtocol(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPortEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPool_protocol(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPortEdge",
+		Object:     "LoadBalancerPool",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Cursor does not have child fields")
+			return nil, errors.New("field of type LoadBalancerPoolProtocol does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerPortUpdatePayload_loadBalancerPort(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerPortUpdatePayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerPortUpdatePayload_loadBalancerPort(ctx, field)
+func (ec *executionContext) _LoadBalancerPool_ownerID(ctx context.Context, field graphql.CollectedField, obj *generated.Pool) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPool_ownerID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9096,7 +10642,7 @@ func (ec *executionContext) _LoadBalancerPortUpdatePayload_loadBalancerPort(ctx
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LoadBalancerPort, nil
+		return obj.OwnerID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9108,17 +10654,58 @@ func (ec *executionContext) _LoadBalancerPortUpdatePayload_loadBalancerPort(ctx
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.Port)
+	res := resTmp.(gidx.PrefixedID)
 	fc.Result = res
-	return ec.marshalNLoadBalancerPort2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPort(ctx, field.Selections, res)
+	return ec.marshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerPortUpdatePayload_loadBalancerPort(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPool_ownerID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerPortUpdatePayload",
+		Object:     "LoadBalancerPool",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LoadBalancerPool_ports(ctx context.Context, field graphql.CollectedField, obj *generated.Pool) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPool_ports(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Ports(ctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*generated.Port)
+	fc.Result = res
+	return ec.marshalOLoadBalancerPort2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPortᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LoadBalancerPool_ports(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LoadBalancerPool",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
@@ -9135,6 +10722,8 @@ func (ec *executionContext) fieldContext_LoadBalancerPortUpdatePayload_loadBalan
 				return ec.fieldContext_LoadBalancerPort_createdBy(ctx, field)
 			case "updatedBy":
 				return ec.fieldContext_LoadBalancerPort_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancerPort_version(ctx, field)
 			case "number":
 				return ec.fieldContext_LoadBalancerPort_number(ctx, field)
 			case "name":
@@ -9152,8 +10741,8 @@ func (ec *executionContext) fieldContext_LoadBalancerPortUpdatePayload_loadBalan
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerProvider_id(ctx context.Context, field graphql.CollectedField, obj *generated.Provider) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerProvider_id(ctx, field)
+func (ec *executionContext) _LoadBalancerPool_origins(ctx context.Context, field graphql.CollectedField, obj *generated.Pool) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPool_origins(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9166,7 +10755,7 @@ func (ec *executionContext) _LoadBalancerProvider_id(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return ec.resolvers.LoadBalancerPool().Origins(rctx, obj, fc.Args["after"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["first"].(*int), fc.Args["before"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["last"].(*int), fc.Args["orderBy"].(*generated.LoadBalancerOriginOrder), fc.Args["where"].(*generated.LoadBalancerOriginWhereInput), fc.Args["includeDeleted"].(*bool))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9178,26 +10767,45 @@ func (ec *executionContext) _LoadBalancerProvider_id(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(gidx.PrefixedID)
+	res := resTmp.(*generated.LoadBalancerOriginConnection)
 	fc.Result = res
-	return ec.marshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerOriginConnection2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerOriginConnection(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerProvider_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPool_origins(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerProvider",
+		Object:     "LoadBalancerPool",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_LoadBalancerOriginConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_LoadBalancerOriginConnection_pageInfo(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_LoadBalancerOriginConnection_totalCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerOriginConnection", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_LoadBalancerPool_origins_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerProvider_createdAt(ctx context.Context, field graphql.CollectedField, obj *generated.Provider) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerProvider_createdAt(ctx, field)
+func (ec *executionContext) _LoadBalancerPool_auditEvents(ctx context.Context, field graphql.CollectedField, obj *generated.Pool) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPool_auditEvents(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9210,7 +10818,7 @@ func (ec *executionContext) _LoadBalancerProvider_createdAt(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return ec.resolvers.LoadBalancerPool().AuditEvents(rctx, obj, fc.Args["after"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["first"].(*int), fc.Args["before"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["last"].(*int), fc.Args["orderBy"].(*generated.AuditEventOrder), fc.Args["filter"].(*AuditEventFilter))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9222,29 +10830,48 @@ func (ec *executionContext) _LoadBalancerProvider_createdAt(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*generated.AuditEventConnection)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNAuditEventConnection2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐAuditEventConnection(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerProvider_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPool_auditEvents(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerProvider",
+		Object:     "LoadBalancerPool",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_AuditEventConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_AuditEventConnection_pageInfo(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_AuditEventConnection_totalCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuditEventConnection", field.Name)
 		},
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) _LoadBalancerProvider_updatedAt(ctx context.Context, field graphql.CollectedField, obj *generated.Provider) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerProvider_updatedAt(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_LoadBalancerPool_auditEvents_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LoadBalancerPool_owner(ctx context.Context, field graphql.CollectedField, obj *generated.Pool) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPool_owner(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
@@ -9254,7 +10881,7 @@ func (ec *executionContext) _LoadBalancerProvider_updatedAt(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return ec.resolvers.LoadBalancerPool().Owner(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9266,26 +10893,36 @@ func (ec *executionContext) _LoadBalancerProvider_updatedAt(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*ResourceOwner)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNResourceOwner2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐResourceOwner(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerProvider_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPool_owner(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerProvider",
+		Object:     "LoadBalancerPool",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ResourceOwner_id(ctx, field)
+			case "loadBalancers":
+				return ec.fieldContext_ResourceOwner_loadBalancers(ctx, field)
+			case "loadBalancerPools":
+				return ec.fieldContext_ResourceOwner_loadBalancerPools(ctx, field)
+			case "loadBalancersProviders":
+				return ec.fieldContext_ResourceOwner_loadBalancersProviders(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResourceOwner", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerProvider_deletedAt(ctx context.Context, field graphql.CollectedField, obj *generated.Provider) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerProvider_deletedAt(ctx, field)
+func (ec *executionContext) _LoadBalancerPoolConnection_edges(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerPoolConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPoolConnection_edges(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9298,7 +10935,7 @@ func (ec *executionContext) _LoadBalancerProvider_deletedAt(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeletedAt, nil
+		return obj.Edges, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9307,26 +10944,32 @@ func (ec *executionContext) _LoadBalancerProvider_deletedAt(ctx context.Context,
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.([]*generated.LoadBalancerPoolEdge)
 	fc.Result = res
-	return ec.marshalOTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOLoadBalancerPoolEdge2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerPoolEdge(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerProvider_deletedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPoolConnection_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerProvider",
+		Object:     "LoadBalancerPoolConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			switch field.Name {
+			case "node":
+				return ec.fieldContext_LoadBalancerPoolEdge_node(ctx, field)
+			case "cursor":
+				return ec.fieldContext_LoadBalancerPoolEdge_cursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPoolEdge", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerProvider_deletedBy(ctx context.Context, field graphql.CollectedField, obj *generated.Provider) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerProvider_deletedBy(ctx, field)
+func (ec *executionContext) _LoadBalancerPoolConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerPoolConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPoolConnection_pageInfo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9339,35 +10982,48 @@ func (ec *executionContext) _LoadBalancerProvider_deletedBy(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeletedBy, nil
+		return obj.PageInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(entgql.PageInfo[gidx.PrefixedID])
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNPageInfo2entgoᚗioᚋcontribᚋentgqlᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerProvider_deletedBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPoolConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerProvider",
+		Object:     "LoadBalancerPoolConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "startCursor":
+				return ec.fieldContext_PageInfo_startCursor(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerProvider_createdBy(ctx context.Context, field graphql.CollectedField, obj *generated.Provider) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerProvider_createdBy(ctx, field)
+func (ec *executionContext) _LoadBalancerPoolConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerPoolConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPoolConnection_totalCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9380,35 +11036,38 @@ func (ec *executionContext) _LoadBalancerProvider_createdBy(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedBy, nil
+		return obj.TotalCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerProvider_createdBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPoolConnection_totalCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerProvider",
+		Object:     "LoadBalancerPoolConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerProvider_updatedBy(ctx context.Context, field graphql.CollectedField, obj *generated.Provider) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerProvider_updatedBy(ctx, field)
+func (ec *executionContext) _LoadBalancerPoolCreatePayload_loadBalancerPool(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerPoolCreatePayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPoolCreatePayload_loadBalancerPool(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9421,35 +11080,70 @@ func (ec *executionContext) _LoadBalancerProvider_updatedBy(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedBy, nil
+		return obj.LoadBalancerPool, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*generated.Pool)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerPool2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerProvider_updatedBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPoolCreatePayload_loadBalancerPool(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerProvider",
+		Object:     "LoadBalancerPoolCreatePayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_LoadBalancerPool_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancerPool_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancerPool_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancerPool_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancerPool_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancerPool_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancerPool_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancerPool_deletedBy(ctx, field)
+			case "name":
+				return ec.fieldContext_LoadBalancerPool_name(ctx, field)
+			case "protocol":
+				return ec.fieldContext_LoadBalancerPool_protocol(ctx, field)
+			case "ownerID":
+				return ec.fieldContext_LoadBalancerPool_ownerID(ctx, field)
+			case "ports":
+				return ec.fieldContext_LoadBalancerPool_ports(ctx, field)
+			case "origins":
+				return ec.fieldContext_LoadBalancerPool_origins(ctx, field)
+			case "auditEvents":
+				return ec.fieldContext_LoadBalancerPool_auditEvents(ctx, field)
+			case "owner":
+				return ec.fieldContext_LoadBalancerPool_owner(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPool", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerProvider_name(ctx context.Context, field graphql.CollectedField, obj *generated.Provider) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerProvider_name(ctx, field)
+func (ec *executionContext) _LoadBalancerPoolDeletePayload_deletedID(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerPoolDeletePayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPoolDeletePayload_deletedID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9462,38 +11156,35 @@ func (ec *executionContext) _LoadBalancerProvider_name(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.DeletedID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*gidx.PrefixedID)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOID2ᚖgoᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerProvider_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPoolDeletePayload_deletedID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerProvider",
+		Object:     "LoadBalancerPoolDeletePayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerProvider_loadBalancers(ctx context.Context, field graphql.CollectedField, obj *generated.Provider) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerProvider_loadBalancers(ctx, field)
+func (ec *executionContext) _LoadBalancerPoolEdge_node(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerPoolEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPoolEdge_node(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9506,57 +11197,67 @@ func (ec *executionContext) _LoadBalancerProvider_loadBalancers(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LoadBalancers(ctx, fc.Args["after"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["first"].(*int), fc.Args["before"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["last"].(*int), fc.Args["orderBy"].(*generated.LoadBalancerOrder), fc.Args["where"].(*generated.LoadBalancerWhereInput))
+		return obj.Node, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.LoadBalancerConnection)
+	res := resTmp.(*generated.Pool)
 	fc.Result = res
-	return ec.marshalNLoadBalancerConnection2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerConnection(ctx, field.Selections, res)
+	return ec.marshalOLoadBalancerPool2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerProvider_loadBalancers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPoolEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerProvider",
+		Object:     "LoadBalancerPoolEdge",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "edges":
-				return ec.fieldContext_LoadBalancerConnection_edges(ctx, field)
-			case "pageInfo":
-				return ec.fieldContext_LoadBalancerConnection_pageInfo(ctx, field)
-			case "totalCount":
-				return ec.fieldContext_LoadBalancerConnection_totalCount(ctx, field)
+			case "id":
+				return ec.fieldContext_LoadBalancerPool_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancerPool_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancerPool_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancerPool_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancerPool_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancerPool_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancerPool_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancerPool_deletedBy(ctx, field)
+			case "name":
+				return ec.fieldContext_LoadBalancerPool_name(ctx, field)
+			case "protocol":
+				return ec.fieldContext_LoadBalancerPool_protocol(ctx, field)
+			case "ownerID":
+				return ec.fieldContext_LoadBalancerPool_ownerID(ctx, field)
+			case "ports":
+				return ec.fieldContext_LoadBalancerPool_ports(ctx, field)
+			case "origins":
+				return ec.fieldContext_LoadBalancerPool_origins(ctx, field)
+			case "auditEvents":
+				return ec.fieldContext_LoadBalancerPool_auditEvents(ctx, field)
+			case "owner":
+				return ec.fieldContext_LoadBalancerPool_owner(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerConnection", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPool", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_LoadBalancerProvider_loadBalancers_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerProvider_owner(ctx context.Context, field graphql.CollectedField, obj *generated.Provider) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerProvider_owner(ctx, field)
+func (ec *executionContext) _LoadBalancerPoolEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerPoolEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPoolEdge_cursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9569,7 +11270,7 @@ func (ec *executionContext) _LoadBalancerProvider_owner(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.LoadBalancerProvider().Owner(rctx, obj)
+		return obj.Cursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9581,36 +11282,26 @@ func (ec *executionContext) _LoadBalancerProvider_owner(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*ResourceOwner)
+	res := resTmp.(entgql.Cursor[gidx.PrefixedID])
 	fc.Result = res
-	return ec.marshalNResourceOwner2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐResourceOwner(ctx, field.Selections, res)
+	return ec.marshalNCursor2entgoᚗioᚋcontribᚋentgqlᚐCursor(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerProvider_owner(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPoolEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerProvider",
+		Object:     "LoadBalancerPoolEdge",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_ResourceOwner_id(ctx, field)
-			case "loadBalancers":
-				return ec.fieldContext_ResourceOwner_loadBalancers(ctx, field)
-			case "loadBalancerPools":
-				return ec.fieldContext_ResourceOwner_loadBalancerPools(ctx, field)
-			case "loadBalancersProviders":
-				return ec.fieldContext_ResourceOwner_loadBalancersProviders(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ResourceOwner", field.Name)
+			return nil, errors.New("field of type Cursor does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerProviderConnection_edges(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerProviderConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerProviderConnection_edges(ctx, field)
+func (ec *executionContext) _LoadBalancerPoolUpdatePayload_loadBalancerPool(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerPoolUpdatePayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPoolUpdatePayload_loadBalancerPool(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9623,41 +11314,70 @@ func (ec *executionContext) _LoadBalancerProviderConnection_edges(ctx context.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Edges, nil
+		return obj.LoadBalancerPool, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*generated.LoadBalancerProviderEdge)
+	res := resTmp.(*generated.Pool)
 	fc.Result = res
-	return ec.marshalOLoadBalancerProviderEdge2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerProviderEdge(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerPool2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerProviderConnection_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPoolUpdatePayload_loadBalancerPool(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerProviderConnection",
+		Object:     "LoadBalancerPoolUpdatePayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "node":
-				return ec.fieldContext_LoadBalancerProviderEdge_node(ctx, field)
-			case "cursor":
-				return ec.fieldContext_LoadBalancerProviderEdge_cursor(ctx, field)
+			case "id":
+				return ec.fieldContext_LoadBalancerPool_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancerPool_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancerPool_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancerPool_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancerPool_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancerPool_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancerPool_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancerPool_deletedBy(ctx, field)
+			case "name":
+				return ec.fieldContext_LoadBalancerPool_name(ctx, field)
+			case "protocol":
+				return ec.fieldContext_LoadBalancerPool_protocol(ctx, field)
+			case "ownerID":
+				return ec.fieldContext_LoadBalancerPool_ownerID(ctx, field)
+			case "ports":
+				return ec.fieldContext_LoadBalancerPool_ports(ctx, field)
+			case "origins":
+				return ec.fieldContext_LoadBalancerPool_origins(ctx, field)
+			case "auditEvents":
+				return ec.fieldContext_LoadBalancerPool_auditEvents(ctx, field)
+			case "owner":
+				return ec.fieldContext_LoadBalancerPool_owner(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerProviderEdge", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPool", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerProviderConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerProviderConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerProviderConnection_pageInfo(ctx, field)
+func (ec *executionContext) _LoadBalancerPort_id(ctx context.Context, field graphql.CollectedField, obj *generated.Port) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPort_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9670,7 +11390,7 @@ func (ec *executionContext) _LoadBalancerProviderConnection_pageInfo(ctx context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PageInfo, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9682,36 +11402,26 @@ func (ec *executionContext) _LoadBalancerProviderConnection_pageInfo(ctx context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(entgql.PageInfo[gidx.PrefixedID])
+	res := resTmp.(gidx.PrefixedID)
 	fc.Result = res
-	return ec.marshalNPageInfo2entgoᚗioᚋcontribᚋentgqlᚐPageInfo(ctx, field.Selections, res)
+	return ec.marshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerProviderConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPort_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerProviderConnection",
+		Object:     "LoadBalancerPort",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "hasNextPage":
-				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
-			case "hasPreviousPage":
-				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
-			case "startCursor":
-				return ec.fieldContext_PageInfo_startCursor(ctx, field)
-			case "endCursor":
-				return ec.fieldContext_PageInfo_endCursor(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerProviderConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerProviderConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerProviderConnection_totalCount(ctx, field)
+func (ec *executionContext) _LoadBalancerPort_createdAt(ctx context.Context, field graphql.CollectedField, obj *generated.Port) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPort_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9724,7 +11434,7 @@ func (ec *executionContext) _LoadBalancerProviderConnection_totalCount(ctx conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalCount, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9736,26 +11446,26 @@ func (ec *executionContext) _LoadBalancerProviderConnection_totalCount(ctx conte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerProviderConnection_totalCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPort_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerProviderConnection",
+		Object:     "LoadBalancerPort",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerProviderCreatePayload_loadBalancerProvider(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerProviderCreatePayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerProviderCreatePayload_loadBalancerProvider(ctx, field)
+func (ec *executionContext) _LoadBalancerPort_updatedAt(ctx context.Context, field graphql.CollectedField, obj *generated.Port) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPort_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9768,7 +11478,7 @@ func (ec *executionContext) _LoadBalancerProviderCreatePayload_loadBalancerProvi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LoadBalancerProvider, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9780,48 +11490,26 @@ func (ec *executionContext) _LoadBalancerProviderCreatePayload_loadBalancerProvi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.Provider)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNLoadBalancerProvider2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐProvider(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerProviderCreatePayload_loadBalancerProvider(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPort_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerProviderCreatePayload",
+		Object:     "LoadBalancerPort",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_LoadBalancerProvider_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_LoadBalancerProvider_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_LoadBalancerProvider_updatedAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_LoadBalancerProvider_deletedAt(ctx, field)
-			case "deletedBy":
-				return ec.fieldContext_LoadBalancerProvider_deletedBy(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_LoadBalancerProvider_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_LoadBalancerProvider_updatedBy(ctx, field)
-			case "name":
-				return ec.fieldContext_LoadBalancerProvider_name(ctx, field)
-			case "loadBalancers":
-				return ec.fieldContext_LoadBalancerProvider_loadBalancers(ctx, field)
-			case "owner":
-				return ec.fieldContext_LoadBalancerProvider_owner(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerProvider", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerProviderDeletePayload_deletedID(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerProviderDeletePayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerProviderDeletePayload_deletedID(ctx, field)
+func (ec *executionContext) _LoadBalancerPort_deletedAt(ctx context.Context, field graphql.CollectedField, obj *generated.Port) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPort_deletedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9834,38 +11522,35 @@ func (ec *executionContext) _LoadBalancerProviderDeletePayload_deletedID(ctx con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeletedID, nil
+		return obj.DeletedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(gidx.PrefixedID)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
+	return ec.marshalOTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerProviderDeletePayload_deletedID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPort_deletedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerProviderDeletePayload",
+		Object:     "LoadBalancerPort",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerProviderEdge_node(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerProviderEdge) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerProviderEdge_node(ctx, field)
+func (ec *executionContext) _LoadBalancerPort_deletedBy(ctx context.Context, field graphql.CollectedField, obj *generated.Port) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPort_deletedBy(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9878,7 +11563,7 @@ func (ec *executionContext) _LoadBalancerProviderEdge_node(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Node, nil
+		return obj.DeletedBy, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9887,48 +11572,26 @@ func (ec *executionContext) _LoadBalancerProviderEdge_node(ctx context.Context,
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*generated.Provider)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOLoadBalancerProvider2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐProvider(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerProviderEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPort_deletedBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerProviderEdge",
+		Object:     "LoadBalancerPort",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_LoadBalancerProvider_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_LoadBalancerProvider_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_LoadBalancerProvider_updatedAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_LoadBalancerProvider_deletedAt(ctx, field)
-			case "deletedBy":
-				return ec.fieldContext_LoadBalancerProvider_deletedBy(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_LoadBalancerProvider_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_LoadBalancerProvider_updatedBy(ctx, field)
-			case "name":
-				return ec.fieldContext_LoadBalancerProvider_name(ctx, field)
-			case "loadBalancers":
-				return ec.fieldContext_LoadBalancerProvider_loadBalancers(ctx, field)
-			case "owner":
-				return ec.fieldContext_LoadBalancerProvider_owner(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerProvider", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerProviderEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerProviderEdge) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerProviderEdge_cursor(ctx, field)
+func (ec *executionContext) _LoadBalancerPort_createdBy(ctx context.Context, field graphql.CollectedField, obj *generated.Port) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPort_createdBy(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9941,38 +11604,35 @@ func (ec *executionContext) _LoadBalancerProviderEdge_cursor(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Cursor, nil
+		return obj.CreatedBy, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(entgql.Cursor[gidx.PrefixedID])
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNCursor2entgoᚗioᚋcontribᚋentgqlᚐCursor(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerProviderEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPort_createdBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerProviderEdge",
+		Object:     "LoadBalancerPort",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Cursor does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerProviderUpdatePayload_loadBalancerProvider(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerProviderUpdatePayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerProviderUpdatePayload_loadBalancerProvider(ctx, field)
+func (ec *executionContext) _LoadBalancerPort_updatedBy(ctx context.Context, field graphql.CollectedField, obj *generated.Port) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPort_updatedBy(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9985,60 +11645,35 @@ func (ec *executionContext) _LoadBalancerProviderUpdatePayload_loadBalancerProvi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LoadBalancerProvider, nil
+		return obj.UpdatedBy, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.Provider)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNLoadBalancerProvider2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐProvider(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerProviderUpdatePayload_loadBalancerProvider(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPort_updatedBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerProviderUpdatePayload",
+		Object:     "LoadBalancerPort",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_LoadBalancerProvider_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_LoadBalancerProvider_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_LoadBalancerProvider_updatedAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_LoadBalancerProvider_deletedAt(ctx, field)
-			case "deletedBy":
-				return ec.fieldContext_LoadBalancerProvider_deletedBy(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_LoadBalancerProvider_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_LoadBalancerProvider_updatedBy(ctx, field)
-			case "name":
-				return ec.fieldContext_LoadBalancerProvider_name(ctx, field)
-			case "loadBalancers":
-				return ec.fieldContext_LoadBalancerProvider_loadBalancers(ctx, field)
-			case "owner":
-				return ec.fieldContext_LoadBalancerProvider_owner(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerProvider", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LoadBalancerUpdatePayload_loadBalancer(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerUpdatePayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LoadBalancerUpdatePayload_loadBalancer(ctx, field)
+func (ec *executionContext) _LoadBalancerPort_version(ctx context.Context, field graphql.CollectedField, obj *generated.Port) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPort_version(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10051,7 +11686,7 @@ func (ec *executionContext) _LoadBalancerUpdatePayload_loadBalancer(ctx context.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LoadBalancer, nil
+		return obj.Version, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10063,52 +11698,26 @@ func (ec *executionContext) _LoadBalancerUpdatePayload_loadBalancer(ctx context.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.LoadBalancer)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNLoadBalancer2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancer(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LoadBalancerUpdatePayload_loadBalancer(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPort_version(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LoadBalancerUpdatePayload",
+		Object:     "LoadBalancerPort",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_LoadBalancer_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_LoadBalancer_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_LoadBalancer_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_LoadBalancer_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_LoadBalancer_updatedBy(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_LoadBalancer_deletedAt(ctx, field)
-			case "deletedBy":
-				return ec.fieldContext_LoadBalancer_deletedBy(ctx, field)
-			case "name":
-				return ec.fieldContext_LoadBalancer_name(ctx, field)
-			case "ports":
-				return ec.fieldContext_LoadBalancer_ports(ctx, field)
-			case "loadBalancerProvider":
-				return ec.fieldContext_LoadBalancer_loadBalancerProvider(ctx, field)
-			case "location":
-				return ec.fieldContext_LoadBalancer_location(ctx, field)
-			case "owner":
-				return ec.fieldContext_LoadBalancer_owner(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancer", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Location_id(ctx context.Context, field graphql.CollectedField, obj *Location) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Location_id(ctx, field)
+func (ec *executionContext) _LoadBalancerPort_number(ctx context.Context, field graphql.CollectedField, obj *generated.Port) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPort_number(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10121,7 +11730,7 @@ func (ec *executionContext) _Location_id(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Number, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10133,26 +11742,26 @@ func (ec *executionContext) _Location_id(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(gidx.PrefixedID)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Location_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPort_number(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Location",
+		Object:     "LoadBalancerPort",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Location_loadBalancers(ctx context.Context, field graphql.CollectedField, obj *Location) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Location_loadBalancers(ctx, field)
+func (ec *executionContext) _LoadBalancerPort_name(ctx context.Context, field graphql.CollectedField, obj *generated.Port) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPort_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10165,57 +11774,35 @@ func (ec *executionContext) _Location_loadBalancers(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Location().LoadBalancers(rctx, obj, fc.Args["after"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["first"].(*int), fc.Args["before"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["last"].(*int), fc.Args["orderBy"].(*generated.LoadBalancerOrder), fc.Args["where"].(*generated.LoadBalancerWhereInput))
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.LoadBalancerConnection)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNLoadBalancerConnection2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerConnection(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Location_loadBalancers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPort_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Location",
+		Object:     "LoadBalancerPort",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "edges":
-				return ec.fieldContext_LoadBalancerConnection_edges(ctx, field)
-			case "pageInfo":
-				return ec.fieldContext_LoadBalancerConnection_pageInfo(ctx, field)
-			case "totalCount":
-				return ec.fieldContext_LoadBalancerConnection_totalCount(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerConnection", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Location_loadBalancers_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_loadBalancerOriginCreate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_loadBalancerOriginCreate(ctx, field)
+func (ec *executionContext) _LoadBalancerPort_loadBalancerID(ctx context.Context, field graphql.CollectedField, obj *generated.Port) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPort_loadBalancerID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10228,7 +11815,7 @@ func (ec *executionContext) _Mutation_loadBalancerOriginCreate(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().LoadBalancerOriginCreate(rctx, fc.Args["input"].(generated.CreateLoadBalancerOriginInput))
+		return obj.LoadBalancerID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10240,41 +11827,26 @@ func (ec *executionContext) _Mutation_loadBalancerOriginCreate(ctx context.Conte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*LoadBalancerOriginCreatePayload)
+	res := resTmp.(gidx.PrefixedID)
 	fc.Result = res
-	return ec.marshalNLoadBalancerOriginCreatePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerOriginCreatePayload(ctx, field.Selections, res)
+	return ec.marshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_loadBalancerOriginCreate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPort_loadBalancerID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "LoadBalancerPort",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "loadBalancerOrigin":
-				return ec.fieldContext_LoadBalancerOriginCreatePayload_loadBalancerOrigin(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerOriginCreatePayload", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_loadBalancerOriginCreate_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_loadBalancerOriginUpdate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_loadBalancerOriginUpdate(ctx, field)
+func (ec *executionContext) _LoadBalancerPort_pools(ctx context.Context, field graphql.CollectedField, obj *generated.Port) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPort_pools(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10287,53 +11859,67 @@ func (ec *executionContext) _Mutation_loadBalancerOriginUpdate(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().LoadBalancerOriginUpdate(rctx, fc.Args["id"].(gidx.PrefixedID), fc.Args["input"].(generated.UpdateLoadBalancerOriginInput))
+		return obj.Pools(ctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*LoadBalancerOriginUpdatePayload)
+	res := resTmp.([]*generated.Pool)
 	fc.Result = res
-	return ec.marshalNLoadBalancerOriginUpdatePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerOriginUpdatePayload(ctx, field.Selections, res)
+	return ec.marshalOLoadBalancerPool2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPoolᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_loadBalancerOriginUpdate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPort_pools(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "LoadBalancerPort",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: true,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "loadBalancerOrigin":
-				return ec.fieldContext_LoadBalancerOriginUpdatePayload_loadBalancerOrigin(ctx, field)
+			case "id":
+				return ec.fieldContext_LoadBalancerPool_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancerPool_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancerPool_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancerPool_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancerPool_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancerPool_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancerPool_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancerPool_deletedBy(ctx, field)
+			case "name":
+				return ec.fieldContext_LoadBalancerPool_name(ctx, field)
+			case "protocol":
+				return ec.fieldContext_LoadBalancerPool_protocol(ctx, field)
+			case "ownerID":
+				return ec.fieldContext_LoadBalancerPool_ownerID(ctx, field)
+			case "ports":
+				return ec.fieldContext_LoadBalancerPool_ports(ctx, field)
+			case "origins":
+				return ec.fieldContext_LoadBalancerPool_origins(ctx, field)
+			case "auditEvents":
+				return ec.fieldContext_LoadBalancerPool_auditEvents(ctx, field)
+			case "owner":
+				return ec.fieldContext_LoadBalancerPool_owner(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerOriginUpdatePayload", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPool", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_loadBalancerOriginUpdate_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_loadBalancerOriginDelete(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_loadBalancerOriginDelete(ctx, field)
+func (ec *executionContext) _LoadBalancerPort_loadBalancer(ctx context.Context, field graphql.CollectedField, obj *generated.Port) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPort_loadBalancer(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10346,7 +11932,7 @@ func (ec *executionContext) _Mutation_loadBalancerOriginDelete(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().LoadBalancerOriginDelete(rctx, fc.Args["id"].(gidx.PrefixedID))
+		return obj.LoadBalancer(ctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10358,41 +11944,56 @@ func (ec *executionContext) _Mutation_loadBalancerOriginDelete(ctx context.Conte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*LoadBalancerOriginDeletePayload)
+	res := resTmp.(*generated.LoadBalancer)
 	fc.Result = res
-	return ec.marshalNLoadBalancerOriginDeletePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerOriginDeletePayload(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancer2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancer(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_loadBalancerOriginDelete(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPort_loadBalancer(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "LoadBalancerPort",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: true,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "deletedID":
-				return ec.fieldContext_LoadBalancerOriginDeletePayload_deletedID(ctx, field)
+			case "id":
+				return ec.fieldContext_LoadBalancer_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancer_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancer_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancer_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancer_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancer_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancer_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancer_deletedBy(ctx, field)
+			case "name":
+				return ec.fieldContext_LoadBalancer_name(ctx, field)
+			case "ports":
+				return ec.fieldContext_LoadBalancer_ports(ctx, field)
+			case "loadBalancerProvider":
+				return ec.fieldContext_LoadBalancer_loadBalancerProvider(ctx, field)
+			case "auditEvents":
+				return ec.fieldContext_LoadBalancer_auditEvents(ctx, field)
+			case "location":
+				return ec.fieldContext_LoadBalancer_location(ctx, field)
+			case "owner":
+				return ec.fieldContext_LoadBalancer_owner(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerOriginDeletePayload", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancer", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_loadBalancerOriginDelete_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_loadBalancerCreate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_loadBalancerCreate(ctx, field)
+func (ec *executionContext) _LoadBalancerPortConnection_edges(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerPortConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPortConnection_edges(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10405,53 +12006,41 @@ func (ec *executionContext) _Mutation_loadBalancerCreate(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().LoadBalancerCreate(rctx, fc.Args["input"].(generated.CreateLoadBalancerInput))
+		return obj.Edges, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*LoadBalancerCreatePayload)
+	res := resTmp.([]*generated.LoadBalancerPortEdge)
 	fc.Result = res
-	return ec.marshalNLoadBalancerCreatePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerCreatePayload(ctx, field.Selections, res)
+	return ec.marshalOLoadBalancerPortEdge2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerPortEdge(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_loadBalancerCreate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPortConnection_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "LoadBalancerPortConnection",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "loadBalancer":
-				return ec.fieldContext_LoadBalancerCreatePayload_loadBalancer(ctx, field)
+			case "node":
+				return ec.fieldContext_LoadBalancerPortEdge_node(ctx, field)
+			case "cursor":
+				return ec.fieldContext_LoadBalancerPortEdge_cursor(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerCreatePayload", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPortEdge", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_loadBalancerCreate_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_loadBalancerUpdate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_loadBalancerUpdate(ctx, field)
+func (ec *executionContext) _LoadBalancerPortConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerPortConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPortConnection_pageInfo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10464,7 +12053,7 @@ func (ec *executionContext) _Mutation_loadBalancerUpdate(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().LoadBalancerUpdate(rctx, fc.Args["id"].(gidx.PrefixedID), fc.Args["input"].(generated.UpdateLoadBalancerInput))
+		return obj.PageInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10476,41 +12065,36 @@ func (ec *executionContext) _Mutation_loadBalancerUpdate(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*LoadBalancerUpdatePayload)
+	res := resTmp.(entgql.PageInfo[gidx.PrefixedID])
 	fc.Result = res
-	return ec.marshalNLoadBalancerUpdatePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerUpdatePayload(ctx, field.Selections, res)
+	return ec.marshalNPageInfo2entgoᚗioᚋcontribᚋentgqlᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_loadBalancerUpdate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPortConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "LoadBalancerPortConnection",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "loadBalancer":
-				return ec.fieldContext_LoadBalancerUpdatePayload_loadBalancer(ctx, field)
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "startCursor":
+				return ec.fieldContext_PageInfo_startCursor(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerUpdatePayload", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_loadBalancerUpdate_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_loadBalancerDelete(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_loadBalancerDelete(ctx, field)
+func (ec *executionContext) _LoadBalancerPortConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerPortConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPortConnection_totalCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10523,7 +12107,7 @@ func (ec *executionContext) _Mutation_loadBalancerDelete(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().LoadBalancerDelete(rctx, fc.Args["id"].(gidx.PrefixedID))
+		return obj.TotalCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10535,41 +12119,26 @@ func (ec *executionContext) _Mutation_loadBalancerDelete(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*LoadBalancerDeletePayload)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNLoadBalancerDeletePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerDeletePayload(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_loadBalancerDelete(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPortConnection_totalCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "LoadBalancerPortConnection",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "deletedID":
-				return ec.fieldContext_LoadBalancerDeletePayload_deletedID(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerDeletePayload", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_loadBalancerDelete_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_loadBalancerPoolCreate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_loadBalancerPoolCreate(ctx, field)
+func (ec *executionContext) _LoadBalancerPortCreatePayload_loadBalancerPort(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerPortCreatePayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPortCreatePayload_loadBalancerPort(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10582,7 +12151,7 @@ func (ec *executionContext) _Mutation_loadBalancerPoolCreate(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().LoadBalancerPoolCreate(rctx, fc.Args["input"].(generated.CreateLoadBalancerPoolInput))
+		return obj.LoadBalancerPort, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10594,41 +12163,54 @@ func (ec *executionContext) _Mutation_loadBalancerPoolCreate(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*LoadBalancerPoolCreatePayload)
+	res := resTmp.(*generated.Port)
 	fc.Result = res
-	return ec.marshalNLoadBalancerPoolCreatePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerPoolCreatePayload(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerPort2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPort(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_loadBalancerPoolCreate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPortCreatePayload_loadBalancerPort(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "LoadBalancerPortCreatePayload",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "loadBalancerPool":
-				return ec.fieldContext_LoadBalancerPoolCreatePayload_loadBalancerPool(ctx, field)
+			case "id":
+				return ec.fieldContext_LoadBalancerPort_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancerPort_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancerPort_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancerPort_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancerPort_deletedBy(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancerPort_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancerPort_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancerPort_version(ctx, field)
+			case "number":
+				return ec.fieldContext_LoadBalancerPort_number(ctx, field)
+			case "name":
+				return ec.fieldContext_LoadBalancerPort_name(ctx, field)
+			case "loadBalancerID":
+				return ec.fieldContext_LoadBalancerPort_loadBalancerID(ctx, field)
+			case "pools":
+				return ec.fieldContext_LoadBalancerPort_pools(ctx, field)
+			case "loadBalancer":
+				return ec.fieldContext_LoadBalancerPort_loadBalancer(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPoolCreatePayload", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPort", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_loadBalancerPoolCreate_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_loadBalancerPoolUpdate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_loadBalancerPoolUpdate(ctx, field)
+func (ec *executionContext) _LoadBalancerPortDeletePayload_deletedID(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerPortDeletePayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPortDeletePayload_deletedID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10641,7 +12223,7 @@ func (ec *executionContext) _Mutation_loadBalancerPoolUpdate(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().LoadBalancerPoolUpdate(rctx, fc.Args["id"].(gidx.PrefixedID), fc.Args["input"].(generated.UpdateLoadBalancerPoolInput))
+		return obj.DeletedID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10653,41 +12235,26 @@ func (ec *executionContext) _Mutation_loadBalancerPoolUpdate(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*LoadBalancerPoolUpdatePayload)
+	res := resTmp.(gidx.PrefixedID)
 	fc.Result = res
-	return ec.marshalNLoadBalancerPoolUpdatePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerPoolUpdatePayload(ctx, field.Selections, res)
+	return ec.marshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_loadBalancerPoolUpdate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPortDeletePayload_deletedID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "LoadBalancerPortDeletePayload",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "loadBalancerPool":
-				return ec.fieldContext_LoadBalancerPoolUpdatePayload_loadBalancerPool(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPoolUpdatePayload", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_loadBalancerPoolUpdate_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_loadBalancerPoolDelete(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_loadBalancerPoolDelete(ctx, field)
+func (ec *executionContext) _LoadBalancerPortEdge_node(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerPortEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPortEdge_node(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10700,57 +12267,67 @@ func (ec *executionContext) _Mutation_loadBalancerPoolDelete(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().LoadBalancerPoolDelete(rctx, fc.Args["id"].(gidx.PrefixedID))
+		return obj.Node, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*LoadBalancerPoolDeletePayload)
+	res := resTmp.(*generated.Port)
 	fc.Result = res
-	return ec.marshalNLoadBalancerPoolDeletePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerPoolDeletePayload(ctx, field.Selections, res)
+	return ec.marshalOLoadBalancerPort2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPort(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_loadBalancerPoolDelete(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPortEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "LoadBalancerPortEdge",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "deletedID":
-				return ec.fieldContext_LoadBalancerPoolDeletePayload_deletedID(ctx, field)
+			case "id":
+				return ec.fieldContext_LoadBalancerPort_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancerPort_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancerPort_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancerPort_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancerPort_deletedBy(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancerPort_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancerPort_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancerPort_version(ctx, field)
+			case "number":
+				return ec.fieldContext_LoadBalancerPort_number(ctx, field)
+			case "name":
+				return ec.fieldContext_LoadBalancerPort_name(ctx, field)
+			case "loadBalancerID":
+				return ec.fieldContext_LoadBalancerPort_loadBalancerID(ctx, field)
+			case "pools":
+				return ec.fieldContext_LoadBalancerPort_pools(ctx, field)
+			case "loadBalancer":
+				return ec.fieldContext_LoadBalancerPort_loadBalancer(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPoolDeletePayload", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPort", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_loadBalancerPoolDelete_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _Mutation_loadBalancerPortCreate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_loadBalancerPortCreate(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
+	return fc, nil
+}
+
+func (ec *executionContext) _LoadBalancerPortEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerPortEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPortEdge_cursor(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -10759,7 +12336,7 @@ func (ec *executionContext) _Mutation_loadBalancerPortCreate(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().LoadBalancerPortCreate(rctx, fc.Args["input"].(generated.CreateLoadBalancerPortInput))
+		return obj.Cursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10771,41 +12348,26 @@ func (ec *executionContext) _Mutation_loadBalancerPortCreate(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*LoadBalancerPortCreatePayload)
+	res := resTmp.(entgql.Cursor[gidx.PrefixedID])
 	fc.Result = res
-	return ec.marshalNLoadBalancerPortCreatePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerPortCreatePayload(ctx, field.Selections, res)
+	return ec.marshalNCursor2entgoᚗioᚋcontribᚋentgqlᚐCursor(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_loadBalancerPortCreate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPortEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "LoadBalancerPortEdge",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "loadBalancerPort":
-				return ec.fieldContext_LoadBalancerPortCreatePayload_loadBalancerPort(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPortCreatePayload", field.Name)
+			return nil, errors.New("field of type Cursor does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_loadBalancerPortCreate_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_loadBalancerPortUpdate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_loadBalancerPortUpdate(ctx, field)
+func (ec *executionContext) _LoadBalancerPortUpdatePayload_loadBalancerPort(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerPortUpdatePayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerPortUpdatePayload_loadBalancerPort(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10818,7 +12380,7 @@ func (ec *executionContext) _Mutation_loadBalancerPortUpdate(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().LoadBalancerPortUpdate(rctx, fc.Args["id"].(gidx.PrefixedID), fc.Args["input"].(generated.UpdateLoadBalancerPortInput))
+		return obj.LoadBalancerPort, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10830,41 +12392,54 @@ func (ec *executionContext) _Mutation_loadBalancerPortUpdate(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*LoadBalancerPortUpdatePayload)
+	res := resTmp.(*generated.Port)
 	fc.Result = res
-	return ec.marshalNLoadBalancerPortUpdatePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerPortUpdatePayload(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerPort2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPort(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_loadBalancerPortUpdate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerPortUpdatePayload_loadBalancerPort(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "LoadBalancerPortUpdatePayload",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "loadBalancerPort":
-				return ec.fieldContext_LoadBalancerPortUpdatePayload_loadBalancerPort(ctx, field)
+			case "id":
+				return ec.fieldContext_LoadBalancerPort_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancerPort_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancerPort_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancerPort_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancerPort_deletedBy(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancerPort_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancerPort_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancerPort_version(ctx, field)
+			case "number":
+				return ec.fieldContext_LoadBalancerPort_number(ctx, field)
+			case "name":
+				return ec.fieldContext_LoadBalancerPort_name(ctx, field)
+			case "loadBalancerID":
+				return ec.fieldContext_LoadBalancerPort_loadBalancerID(ctx, field)
+			case "pools":
+				return ec.fieldContext_LoadBalancerPort_pools(ctx, field)
+			case "loadBalancer":
+				return ec.fieldContext_LoadBalancerPort_loadBalancer(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPortUpdatePayload", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPort", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_loadBalancerPortUpdate_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_loadBalancerPortDelete(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_loadBalancerPortDelete(ctx, field)
+func (ec *executionContext) _LoadBalancerProvider_id(ctx context.Context, field graphql.CollectedField, obj *generated.Provider) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerProvider_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10877,7 +12452,7 @@ func (ec *executionContext) _Mutation_loadBalancerPortDelete(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().LoadBalancerPortDelete(rctx, fc.Args["id"].(gidx.PrefixedID))
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10889,41 +12464,26 @@ func (ec *executionContext) _Mutation_loadBalancerPortDelete(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*LoadBalancerPortDeletePayload)
+	res := resTmp.(gidx.PrefixedID)
 	fc.Result = res
-	return ec.marshalNLoadBalancerPortDeletePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerPortDeletePayload(ctx, field.Selections, res)
+	return ec.marshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_loadBalancerPortDelete(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerProvider_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "LoadBalancerProvider",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "deletedID":
-				return ec.fieldContext_LoadBalancerPortDeletePayload_deletedID(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPortDeletePayload", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_loadBalancerPortDelete_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_loadBalancerProviderCreate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_loadBalancerProviderCreate(ctx, field)
+func (ec *executionContext) _LoadBalancerProvider_createdAt(ctx context.Context, field graphql.CollectedField, obj *generated.Provider) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerProvider_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10936,7 +12496,7 @@ func (ec *executionContext) _Mutation_loadBalancerProviderCreate(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().LoadBalancerProviderCreate(rctx, fc.Args["input"].(generated.CreateLoadBalancerProviderInput))
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10948,41 +12508,26 @@ func (ec *executionContext) _Mutation_loadBalancerProviderCreate(ctx context.Con
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*LoadBalancerProviderCreatePayload)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNLoadBalancerProviderCreatePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerProviderCreatePayload(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_loadBalancerProviderCreate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerProvider_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "LoadBalancerProvider",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "loadBalancerProvider":
-				return ec.fieldContext_LoadBalancerProviderCreatePayload_loadBalancerProvider(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerProviderCreatePayload", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_loadBalancerProviderCreate_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_loadBalancerProviderUpdate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_loadBalancerProviderUpdate(ctx, field)
+func (ec *executionContext) _LoadBalancerProvider_updatedAt(ctx context.Context, field graphql.CollectedField, obj *generated.Provider) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerProvider_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10995,7 +12540,7 @@ func (ec *executionContext) _Mutation_loadBalancerProviderUpdate(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().LoadBalancerProviderUpdate(rctx, fc.Args["id"].(gidx.PrefixedID), fc.Args["input"].(generated.UpdateLoadBalancerProviderInput))
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11007,41 +12552,26 @@ func (ec *executionContext) _Mutation_loadBalancerProviderUpdate(ctx context.Con
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*LoadBalancerProviderUpdatePayload)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNLoadBalancerProviderUpdatePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerProviderUpdatePayload(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_loadBalancerProviderUpdate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerProvider_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "LoadBalancerProvider",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "loadBalancerProvider":
-				return ec.fieldContext_LoadBalancerProviderUpdatePayload_loadBalancerProvider(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerProviderUpdatePayload", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_loadBalancerProviderUpdate_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_loadBalancerProviderDelete(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_loadBalancerProviderDelete(ctx, field)
+func (ec *executionContext) _LoadBalancerProvider_deletedAt(ctx context.Context, field graphql.CollectedField, obj *generated.Provider) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerProvider_deletedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11054,53 +12584,35 @@ func (ec *executionContext) _Mutation_loadBalancerProviderDelete(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().LoadBalancerProviderDelete(rctx, fc.Args["id"].(gidx.PrefixedID))
+		return obj.DeletedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*LoadBalancerProviderDeletePayload)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNLoadBalancerProviderDeletePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerProviderDeletePayload(ctx, field.Selections, res)
+	return ec.marshalOTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_loadBalancerProviderDelete(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerProvider_deletedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "LoadBalancerProvider",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "deletedID":
-				return ec.fieldContext_LoadBalancerProviderDeletePayload_deletedID(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerProviderDeletePayload", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_loadBalancerProviderDelete_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *entgql.PageInfo[gidx.PrefixedID]) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+func (ec *executionContext) _LoadBalancerProvider_deletedBy(ctx context.Context, field graphql.CollectedField, obj *generated.Provider) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerProvider_deletedBy(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11113,38 +12625,35 @@ func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HasNextPage, nil
+		return obj.DeletedBy, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerProvider_deletedBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "LoadBalancerProvider",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_hasPreviousPage(ctx context.Context, field graphql.CollectedField, obj *entgql.PageInfo[gidx.PrefixedID]) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+func (ec *executionContext) _LoadBalancerProvider_createdBy(ctx context.Context, field graphql.CollectedField, obj *generated.Provider) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerProvider_createdBy(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11157,38 +12666,35 @@ func (ec *executionContext) _PageInfo_hasPreviousPage(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HasPreviousPage, nil
+		return obj.CreatedBy, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_hasPreviousPage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerProvider_createdBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "LoadBalancerProvider",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_startCursor(ctx context.Context, field graphql.CollectedField, obj *entgql.PageInfo[gidx.PrefixedID]) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_startCursor(ctx, field)
+func (ec *executionContext) _LoadBalancerProvider_updatedBy(ctx context.Context, field graphql.CollectedField, obj *generated.Provider) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerProvider_updatedBy(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11201,7 +12707,7 @@ func (ec *executionContext) _PageInfo_startCursor(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.StartCursor, nil
+		return obj.UpdatedBy, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11210,26 +12716,26 @@ func (ec *executionContext) _PageInfo_startCursor(ctx context.Context, field gra
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*entgql.Cursor[gidx.PrefixedID])
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOCursor2ᚖentgoᚗioᚋcontribᚋentgqlᚐCursor(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_startCursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerProvider_updatedBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "LoadBalancerProvider",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Cursor does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_endCursor(ctx context.Context, field graphql.CollectedField, obj *entgql.PageInfo[gidx.PrefixedID]) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_endCursor(ctx, field)
+func (ec *executionContext) _LoadBalancerProvider_version(ctx context.Context, field graphql.CollectedField, obj *generated.Provider) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerProvider_version(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11242,35 +12748,38 @@ func (ec *executionContext) _PageInfo_endCursor(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EndCursor, nil
+		return obj.Version, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*entgql.Cursor[gidx.PrefixedID])
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOCursor2ᚖentgoᚗioᚋcontribᚋentgqlᚐCursor(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_endCursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerProvider_version(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "LoadBalancerProvider",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Cursor does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_loadBalancer(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_loadBalancer(ctx, field)
+func (ec *executionContext) _LoadBalancerProvider_name(ctx context.Context, field graphql.CollectedField, obj *generated.Provider) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerProvider_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11283,7 +12792,7 @@ func (ec *executionContext) _Query_loadBalancer(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().LoadBalancer(rctx, fc.Args["id"].(gidx.PrefixedID))
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11295,63 +12804,26 @@ func (ec *executionContext) _Query_loadBalancer(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.LoadBalancer)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNLoadBalancer2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancer(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_loadBalancer(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerProvider_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "LoadBalancerProvider",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_LoadBalancer_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_LoadBalancer_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_LoadBalancer_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_LoadBalancer_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_LoadBalancer_updatedBy(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_LoadBalancer_deletedAt(ctx, field)
-			case "deletedBy":
-				return ec.fieldContext_LoadBalancer_deletedBy(ctx, field)
-			case "name":
-				return ec.fieldContext_LoadBalancer_name(ctx, field)
-			case "ports":
-				return ec.fieldContext_LoadBalancer_ports(ctx, field)
-			case "loadBalancerProvider":
-				return ec.fieldContext_LoadBalancer_loadBalancerProvider(ctx, field)
-			case "location":
-				return ec.fieldContext_LoadBalancer_location(ctx, field)
-			case "owner":
-				return ec.fieldContext_LoadBalancer_owner(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancer", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_loadBalancer_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_loadBalancerHistory(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_loadBalancerHistory(ctx, field)
+func (ec *executionContext) _LoadBalancerProvider_loadBalancers(ctx context.Context, field graphql.CollectedField, obj *generated.Provider) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerProvider_loadBalancers(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11364,7 +12836,7 @@ func (ec *executionContext) _Query_loadBalancerHistory(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().LoadBalancerHistory(rctx, fc.Args["id"].(gidx.PrefixedID))
+		return obj.LoadBalancers(ctx, fc.Args["after"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["first"].(*int), fc.Args["before"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["last"].(*int), fc.Args["orderBy"].(*generated.LoadBalancerOrder), fc.Args["where"].(*generated.LoadBalancerWhereInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11376,45 +12848,27 @@ func (ec *executionContext) _Query_loadBalancerHistory(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.LoadBalancer)
+	res := resTmp.(*generated.LoadBalancerConnection)
 	fc.Result = res
-	return ec.marshalNLoadBalancer2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancer(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerConnection2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerConnection(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_loadBalancerHistory(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerProvider_loadBalancers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "LoadBalancerProvider",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: true,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_LoadBalancer_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_LoadBalancer_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_LoadBalancer_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_LoadBalancer_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_LoadBalancer_updatedBy(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_LoadBalancer_deletedAt(ctx, field)
-			case "deletedBy":
-				return ec.fieldContext_LoadBalancer_deletedBy(ctx, field)
-			case "name":
-				return ec.fieldContext_LoadBalancer_name(ctx, field)
-			case "ports":
-				return ec.fieldContext_LoadBalancer_ports(ctx, field)
-			case "loadBalancerProvider":
-				return ec.fieldContext_LoadBalancer_loadBalancerProvider(ctx, field)
-			case "location":
-				return ec.fieldContext_LoadBalancer_location(ctx, field)
-			case "owner":
-				return ec.fieldContext_LoadBalancer_owner(ctx, field)
+			case "edges":
+				return ec.fieldContext_LoadBalancerConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_LoadBalancerConnection_pageInfo(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_LoadBalancerConnection_totalCount(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancer", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerConnection", field.Name)
 		},
 	}
 	defer func() {
@@ -11424,15 +12878,15 @@ func (ec *executionContext) fieldContext_Query_loadBalancerHistory(ctx context.C
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_loadBalancerHistory_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_LoadBalancerProvider_loadBalancers_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_loadBalancerPool(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_loadBalancerPool(ctx, field)
+func (ec *executionContext) _LoadBalancerProvider_auditEvents(ctx context.Context, field graphql.CollectedField, obj *generated.Provider) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerProvider_auditEvents(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11445,7 +12899,7 @@ func (ec *executionContext) _Query_loadBalancerPool(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().LoadBalancerPool(rctx, fc.Args["id"].(gidx.PrefixedID))
+		return ec.resolvers.LoadBalancerProvider().AuditEvents(rctx, obj, fc.Args["after"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["first"].(*int), fc.Args["before"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["last"].(*int), fc.Args["orderBy"].(*generated.AuditEventOrder), fc.Args["filter"].(*AuditEventFilter))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11457,47 +12911,27 @@ func (ec *executionContext) _Query_loadBalancerPool(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.Pool)
+	res := resTmp.(*generated.AuditEventConnection)
 	fc.Result = res
-	return ec.marshalNLoadBalancerPool2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPool(ctx, field.Selections, res)
+	return ec.marshalNAuditEventConnection2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐAuditEventConnection(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_loadBalancerPool(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerProvider_auditEvents(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "LoadBalancerProvider",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_LoadBalancerPool_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_LoadBalancerPool_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_LoadBalancerPool_updatedAt(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_LoadBalancerPool_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_LoadBalancerPool_updatedBy(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_LoadBalancerPool_deletedAt(ctx, field)
-			case "deletedBy":
-				return ec.fieldContext_LoadBalancerPool_deletedBy(ctx, field)
-			case "name":
-				return ec.fieldContext_LoadBalancerPool_name(ctx, field)
-			case "protocol":
-				return ec.fieldContext_LoadBalancerPool_protocol(ctx, field)
-			case "ownerID":
-				return ec.fieldContext_LoadBalancerPool_ownerID(ctx, field)
-			case "ports":
-				return ec.fieldContext_LoadBalancerPool_ports(ctx, field)
-			case "origins":
-				return ec.fieldContext_LoadBalancerPool_origins(ctx, field)
-			case "owner":
-				return ec.fieldContext_LoadBalancerPool_owner(ctx, field)
+			case "edges":
+				return ec.fieldContext_AuditEventConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_AuditEventConnection_pageInfo(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_AuditEventConnection_totalCount(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPool", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type AuditEventConnection", field.Name)
 		},
 	}
 	defer func() {
@@ -11507,15 +12941,15 @@ func (ec *executionContext) fieldContext_Query_loadBalancerPool(ctx context.Cont
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_loadBalancerPool_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_LoadBalancerProvider_auditEvents_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_loadBalancerPort(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_loadBalancerPort(ctx, field)
+func (ec *executionContext) _LoadBalancerProvider_owner(ctx context.Context, field graphql.CollectedField, obj *generated.Provider) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerProvider_owner(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11528,7 +12962,7 @@ func (ec *executionContext) _Query_loadBalancerPort(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().LoadBalancerPort(rctx, fc.Args["id"].(gidx.PrefixedID))
+		return ec.resolvers.LoadBalancerProvider().Owner(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11540,63 +12974,36 @@ func (ec *executionContext) _Query_loadBalancerPort(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.Port)
+	res := resTmp.(*ResourceOwner)
 	fc.Result = res
-	return ec.marshalNLoadBalancerPort2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPort(ctx, field.Selections, res)
+	return ec.marshalNResourceOwner2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐResourceOwner(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_loadBalancerPort(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerProvider_owner(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "LoadBalancerProvider",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_LoadBalancerPort_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_LoadBalancerPort_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_LoadBalancerPort_updatedAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_LoadBalancerPort_deletedAt(ctx, field)
-			case "deletedBy":
-				return ec.fieldContext_LoadBalancerPort_deletedBy(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_LoadBalancerPort_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_LoadBalancerPort_updatedBy(ctx, field)
-			case "number":
-				return ec.fieldContext_LoadBalancerPort_number(ctx, field)
-			case "name":
-				return ec.fieldContext_LoadBalancerPort_name(ctx, field)
-			case "loadBalancerID":
-				return ec.fieldContext_LoadBalancerPort_loadBalancerID(ctx, field)
-			case "pools":
-				return ec.fieldContext_LoadBalancerPort_pools(ctx, field)
-			case "loadBalancer":
-				return ec.fieldContext_LoadBalancerPort_loadBalancer(ctx, field)
+				return ec.fieldContext_ResourceOwner_id(ctx, field)
+			case "loadBalancers":
+				return ec.fieldContext_ResourceOwner_loadBalancers(ctx, field)
+			case "loadBalancerPools":
+				return ec.fieldContext_ResourceOwner_loadBalancerPools(ctx, field)
+			case "loadBalancersProviders":
+				return ec.fieldContext_ResourceOwner_loadBalancersProviders(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPort", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ResourceOwner", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_loadBalancerPort_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_loadBalancerProvider(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_loadBalancerProvider(ctx, field)
+func (ec *executionContext) _LoadBalancerProviderConnection_edges(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerProviderConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerProviderConnection_edges(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11609,71 +13016,41 @@ func (ec *executionContext) _Query_loadBalancerProvider(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().LoadBalancerProvider(rctx, fc.Args["id"].(gidx.PrefixedID))
+		return obj.Edges, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.Provider)
+	res := resTmp.([]*generated.LoadBalancerProviderEdge)
 	fc.Result = res
-	return ec.marshalNLoadBalancerProvider2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐProvider(ctx, field.Selections, res)
+	return ec.marshalOLoadBalancerProviderEdge2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerProviderEdge(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_loadBalancerProvider(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerProviderConnection_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "LoadBalancerProviderConnection",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_LoadBalancerProvider_id(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_LoadBalancerProvider_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_LoadBalancerProvider_updatedAt(ctx, field)
-			case "deletedAt":
-				return ec.fieldContext_LoadBalancerProvider_deletedAt(ctx, field)
-			case "deletedBy":
-				return ec.fieldContext_LoadBalancerProvider_deletedBy(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_LoadBalancerProvider_createdBy(ctx, field)
-			case "updatedBy":
-				return ec.fieldContext_LoadBalancerProvider_updatedBy(ctx, field)
-			case "name":
-				return ec.fieldContext_LoadBalancerProvider_name(ctx, field)
-			case "loadBalancers":
-				return ec.fieldContext_LoadBalancerProvider_loadBalancers(ctx, field)
-			case "owner":
-				return ec.fieldContext_LoadBalancerProvider_owner(ctx, field)
+			case "node":
+				return ec.fieldContext_LoadBalancerProviderEdge_node(ctx, field)
+			case "cursor":
+				return ec.fieldContext_LoadBalancerProviderEdge_cursor(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerProvider", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerProviderEdge", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_loadBalancerProvider_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query__entities(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query__entities(ctx, field)
+func (ec *executionContext) _LoadBalancerProviderConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerProviderConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerProviderConnection_pageInfo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11686,7 +13063,7 @@ func (ec *executionContext) _Query__entities(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.__resolve_entities(ctx, fc.Args["representations"].([]map[string]interface{})), nil
+		return obj.PageInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11698,37 +13075,36 @@ func (ec *executionContext) _Query__entities(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]fedruntime.Entity)
+	res := resTmp.(entgql.PageInfo[gidx.PrefixedID])
 	fc.Result = res
-	return ec.marshalN_Entity2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋpluginᚋfederationᚋfedruntimeᚐEntity(ctx, field.Selections, res)
+	return ec.marshalNPageInfo2entgoᚗioᚋcontribᚋentgqlᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query__entities(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerProviderConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "LoadBalancerProviderConnection",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type _Entity does not have child fields")
+			switch field.Name {
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "startCursor":
+				return ec.fieldContext_PageInfo_startCursor(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query__entities_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query__service(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query__service(ctx, field)
+func (ec *executionContext) _LoadBalancerProviderConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerProviderConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerProviderConnection_totalCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11741,7 +13117,7 @@ func (ec *executionContext) _Query__service(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.__resolve__service(ctx)
+		return obj.TotalCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11753,30 +13129,26 @@ func (ec *executionContext) _Query__service(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(fedruntime.Service)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalN_Service2githubᚗcomᚋ99designsᚋgqlgenᚋpluginᚋfederationᚋfedruntimeᚐService(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query__service(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerProviderConnection_totalCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "LoadBalancerProviderConnection",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "sdl":
-				return ec.fieldContext__Service_sdl(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type _Service", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___type(ctx, field)
+func (ec *executionContext) _LoadBalancerProviderCreatePayload_loadBalancerProvider(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerProviderCreatePayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerProviderCreatePayload_loadBalancerProvider(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11789,68 +13161,64 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(fc.Args["name"].(string))
+		return obj.LoadBalancerProvider, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*generated.Provider)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerProvider2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐProvider(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerProviderCreatePayload_loadBalancerProvider(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "LoadBalancerProviderCreatePayload",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
+			case "id":
+				return ec.fieldContext_LoadBalancerProvider_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancerProvider_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancerProvider_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancerProvider_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancerProvider_deletedBy(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancerProvider_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancerProvider_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancerProvider_version(ctx, field)
 			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+				return ec.fieldContext_LoadBalancerProvider_name(ctx, field)
+			case "loadBalancers":
+				return ec.fieldContext_LoadBalancerProvider_loadBalancers(ctx, field)
+			case "auditEvents":
+				return ec.fieldContext_LoadBalancerProvider_auditEvents(ctx, field)
+			case "owner":
+				return ec.fieldContext_LoadBalancerProvider_owner(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerProvider", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___schema(ctx, field)
+func (ec *executionContext) _LoadBalancerProviderDeletePayload_deletedID(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerProviderDeletePayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerProviderDeletePayload_deletedID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11863,49 +13231,38 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
+		return obj.DeletedID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Schema)
+	res := resTmp.(gidx.PrefixedID)
 	fc.Result = res
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+	return ec.marshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___schema(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerProviderDeletePayload_deletedID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "LoadBalancerProviderDeletePayload",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ResourceOwner_id(ctx context.Context, field graphql.CollectedField, obj *ResourceOwner) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ResourceOwner_id(ctx, field)
+func (ec *executionContext) _LoadBalancerProviderEdge_node(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerProviderEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerProviderEdge_node(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11918,41 +13275,64 @@ func (ec *executionContext) _ResourceOwner_id(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Node, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(gidx.PrefixedID)
+	res := resTmp.(*generated.Provider)
 	fc.Result = res
-	return ec.marshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
+	return ec.marshalOLoadBalancerProvider2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐProvider(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ResourceOwner_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerProviderEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ResourceOwner",
+		Object:     "LoadBalancerProviderEdge",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _ResourceOwner_loadBalancers(ctx context.Context, field graphql.CollectedField, obj *ResourceOwner) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ResourceOwner_loadBalancers(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_LoadBalancerProvider_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancerProvider_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancerProvider_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancerProvider_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancerProvider_deletedBy(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancerProvider_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancerProvider_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancerProvider_version(ctx, field)
+			case "name":
+				return ec.fieldContext_LoadBalancerProvider_name(ctx, field)
+			case "loadBalancers":
+				return ec.fieldContext_LoadBalancerProvider_loadBalancers(ctx, field)
+			case "auditEvents":
+				return ec.fieldContext_LoadBalancerProvider_auditEvents(ctx, field)
+			case "owner":
+				return ec.fieldContext_LoadBalancerProvider_owner(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerProvider", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LoadBalancerProviderEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *generated.LoadBalancerProviderEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerProviderEdge_cursor(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
@@ -11962,7 +13342,7 @@ func (ec *executionContext) _ResourceOwner_loadBalancers(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ResourceOwner().LoadBalancers(rctx, obj, fc.Args["after"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["first"].(*int), fc.Args["before"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["last"].(*int), fc.Args["orderBy"].(*generated.LoadBalancerOrder), fc.Args["where"].(*generated.LoadBalancerWhereInput))
+		return obj.Cursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11974,45 +13354,26 @@ func (ec *executionContext) _ResourceOwner_loadBalancers(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.LoadBalancerConnection)
+	res := resTmp.(entgql.Cursor[gidx.PrefixedID])
 	fc.Result = res
-	return ec.marshalNLoadBalancerConnection2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerConnection(ctx, field.Selections, res)
+	return ec.marshalNCursor2entgoᚗioᚋcontribᚋentgqlᚐCursor(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ResourceOwner_loadBalancers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerProviderEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ResourceOwner",
+		Object:     "LoadBalancerProviderEdge",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "edges":
-				return ec.fieldContext_LoadBalancerConnection_edges(ctx, field)
-			case "pageInfo":
-				return ec.fieldContext_LoadBalancerConnection_pageInfo(ctx, field)
-			case "totalCount":
-				return ec.fieldContext_LoadBalancerConnection_totalCount(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerConnection", field.Name)
+			return nil, errors.New("field of type Cursor does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_ResourceOwner_loadBalancers_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ResourceOwner_loadBalancerPools(ctx context.Context, field graphql.CollectedField, obj *ResourceOwner) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ResourceOwner_loadBalancerPools(ctx, field)
+func (ec *executionContext) _LoadBalancerProviderUpdatePayload_loadBalancerProvider(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerProviderUpdatePayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerProviderUpdatePayload_loadBalancerProvider(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12025,7 +13386,7 @@ func (ec *executionContext) _ResourceOwner_loadBalancerPools(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ResourceOwner().LoadBalancerPools(rctx, obj, fc.Args["after"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["first"].(*int), fc.Args["before"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["last"].(*int), fc.Args["orderBy"].(*generated.LoadBalancerPoolOrder), fc.Args["where"].(*generated.LoadBalancerPoolWhereInput))
+		return obj.LoadBalancerProvider, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12037,45 +13398,52 @@ func (ec *executionContext) _ResourceOwner_loadBalancerPools(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.LoadBalancerPoolConnection)
+	res := resTmp.(*generated.Provider)
 	fc.Result = res
-	return ec.marshalNLoadBalancerPoolConnection2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerPoolConnection(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerProvider2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐProvider(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ResourceOwner_loadBalancerPools(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerProviderUpdatePayload_loadBalancerProvider(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ResourceOwner",
+		Object:     "LoadBalancerProviderUpdatePayload",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "edges":
-				return ec.fieldContext_LoadBalancerPoolConnection_edges(ctx, field)
-			case "pageInfo":
-				return ec.fieldContext_LoadBalancerPoolConnection_pageInfo(ctx, field)
-			case "totalCount":
-				return ec.fieldContext_LoadBalancerPoolConnection_totalCount(ctx, field)
+			case "id":
+				return ec.fieldContext_LoadBalancerProvider_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancerProvider_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancerProvider_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancerProvider_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancerProvider_deletedBy(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancerProvider_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancerProvider_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancerProvider_version(ctx, field)
+			case "name":
+				return ec.fieldContext_LoadBalancerProvider_name(ctx, field)
+			case "loadBalancers":
+				return ec.fieldContext_LoadBalancerProvider_loadBalancers(ctx, field)
+			case "auditEvents":
+				return ec.fieldContext_LoadBalancerProvider_auditEvents(ctx, field)
+			case "owner":
+				return ec.fieldContext_LoadBalancerProvider_owner(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPoolConnection", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerProvider", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_ResourceOwner_loadBalancerPools_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ResourceOwner_loadBalancersProviders(ctx context.Context, field graphql.CollectedField, obj *ResourceOwner) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ResourceOwner_loadBalancersProviders(ctx, field)
+func (ec *executionContext) _LoadBalancerResyncPayload_published(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerResyncPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerResyncPayload_published(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12088,7 +13456,7 @@ func (ec *executionContext) _ResourceOwner_loadBalancersProviders(ctx context.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ResourceOwner().LoadBalancersProviders(rctx, obj, fc.Args["after"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["first"].(*int), fc.Args["before"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["last"].(*int), fc.Args["orderBy"].(*generated.LoadBalancerOrder), fc.Args["where"].(*generated.LoadBalancerProviderWhereInput))
+		return obj.Published, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12100,45 +13468,26 @@ func (ec *executionContext) _ResourceOwner_loadBalancersProviders(ctx context.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*generated.LoadBalancerProviderConnection)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNLoadBalancerProviderConnection2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerProviderConnection(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ResourceOwner_loadBalancersProviders(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerResyncPayload_published(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ResourceOwner",
+		Object:     "LoadBalancerResyncPayload",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "edges":
-				return ec.fieldContext_LoadBalancerProviderConnection_edges(ctx, field)
-			case "pageInfo":
-				return ec.fieldContext_LoadBalancerProviderConnection_pageInfo(ctx, field)
-			case "totalCount":
-				return ec.fieldContext_LoadBalancerProviderConnection_totalCount(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerProviderConnection", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_ResourceOwner_loadBalancersProviders_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) __Service_sdl(ctx context.Context, field graphql.CollectedField, obj *fedruntime.Service) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext__Service_sdl(ctx, field)
+func (ec *executionContext) _LoadBalancerResyncPayload_cursor(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerResyncPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerResyncPayload_cursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12151,7 +13500,7 @@ func (ec *executionContext) __Service_sdl(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SDL, nil
+		return obj.Cursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12160,26 +13509,26 @@ func (ec *executionContext) __Service_sdl(ctx context.Context, field graphql.Col
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*gidx.PrefixedID)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalOID2ᚖgoᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext__Service_sdl(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerResyncPayload_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "_Service",
+		Object:     "LoadBalancerResyncPayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_name(ctx, field)
+func (ec *executionContext) _LoadBalancerUpdatePayload_loadBalancer(ctx context.Context, field graphql.CollectedField, obj *LoadBalancerUpdatePayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadBalancerUpdatePayload_loadBalancer(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12192,7 +13541,7 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.LoadBalancer, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12204,26 +13553,56 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*generated.LoadBalancer)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancer2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancer(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadBalancerUpdatePayload_loadBalancer(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "LoadBalancerUpdatePayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_LoadBalancer_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancer_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancer_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancer_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancer_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancer_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancer_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancer_deletedBy(ctx, field)
+			case "name":
+				return ec.fieldContext_LoadBalancer_name(ctx, field)
+			case "ports":
+				return ec.fieldContext_LoadBalancer_ports(ctx, field)
+			case "loadBalancerProvider":
+				return ec.fieldContext_LoadBalancer_loadBalancerProvider(ctx, field)
+			case "auditEvents":
+				return ec.fieldContext_LoadBalancer_auditEvents(ctx, field)
+			case "location":
+				return ec.fieldContext_LoadBalancer_location(ctx, field)
+			case "owner":
+				return ec.fieldContext_LoadBalancer_owner(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancer", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_description(ctx, field)
+func (ec *executionContext) _Location_id(ctx context.Context, field graphql.CollectedField, obj *Location) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Location_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12236,35 +13615,38 @@ func (ec *executionContext) ___Directive_description(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(gidx.PrefixedID)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Location_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "Location",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_locations(ctx, field)
+func (ec *executionContext) _Location_loadBalancers(ctx context.Context, field graphql.CollectedField, obj *Location) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Location_loadBalancers(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12277,7 +13659,7 @@ func (ec *executionContext) ___Directive_locations(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Locations, nil
+		return ec.resolvers.Location().LoadBalancers(rctx, obj, fc.Args["after"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["first"].(*int), fc.Args["before"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["last"].(*int), fc.Args["orderBy"].(*generated.LoadBalancerOrder), fc.Args["where"].(*generated.LoadBalancerWhereInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12289,26 +13671,45 @@ func (ec *executionContext) ___Directive_locations(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(*generated.LoadBalancerConnection)
 	fc.Result = res
-	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerConnection2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerConnection(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_locations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Location_loadBalancers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "Location",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_args(ctx, field)
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_LoadBalancerConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_LoadBalancerConnection_pageInfo(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_LoadBalancerConnection_totalCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Location_loadBalancers_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_loadBalancerOriginCreate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_loadBalancerOriginCreate(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12321,7 +13722,7 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return ec.resolvers.Mutation().LoadBalancerOriginCreate(rctx, fc.Args["input"].(CreateLoadBalancerOriginInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12333,36 +13734,41 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(*LoadBalancerOriginCreatePayload)
 	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerOriginCreatePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerOriginCreatePayload(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_loadBalancerOriginCreate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "loadBalancerOrigin":
+				return ec.fieldContext_LoadBalancerOriginCreatePayload_loadBalancerOrigin(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerOriginCreatePayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_loadBalancerOriginCreate_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_isRepeatable(ctx, field)
+func (ec *executionContext) _Mutation_loadBalancerOriginUpdate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_loadBalancerOriginUpdate(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12375,7 +13781,7 @@ func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsRepeatable, nil
+		return ec.resolvers.Mutation().LoadBalancerOriginUpdate(rctx, fc.Args["id"].(gidx.PrefixedID), fc.Args["input"].(generated.UpdateLoadBalancerOriginInput), fc.Args["expectedVersion"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12387,26 +13793,41 @@ func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*LoadBalancerOriginUpdatePayload)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerOriginUpdatePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerOriginUpdatePayload(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_loadBalancerOriginUpdate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "loadBalancerOrigin":
+				return ec.fieldContext_LoadBalancerOriginUpdatePayload_loadBalancerOrigin(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerOriginUpdatePayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_loadBalancerOriginUpdate_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_name(ctx, field)
+func (ec *executionContext) _Mutation_loadBalancerOriginDelete(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_loadBalancerOriginDelete(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12419,7 +13840,7 @@ func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return ec.resolvers.Mutation().LoadBalancerOriginDelete(rctx, fc.Args["id"].(gidx.PrefixedID), fc.Args["expectedVersion"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12431,26 +13852,41 @@ func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*LoadBalancerOriginDeletePayload)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerOriginDeletePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerOriginDeletePayload(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_loadBalancerOriginDelete(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "deletedID":
+				return ec.fieldContext_LoadBalancerOriginDeletePayload_deletedID(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerOriginDeletePayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_loadBalancerOriginDelete_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_description(ctx, field)
+func (ec *executionContext) _Mutation_loadBalancerApply(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_loadBalancerApply(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12463,35 +13899,57 @@ func (ec *executionContext) ___EnumValue_description(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return ec.resolvers.Mutation().LoadBalancerApply(rctx, fc.Args["spec"].(json.RawMessage), fc.Args["dryRun"].(*bool))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*LoadBalancerApplyPayload)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerApplyPayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerApplyPayload(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_loadBalancerApply(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "loadBalancer":
+				return ec.fieldContext_LoadBalancerApplyPayload_loadBalancer(ctx, field)
+			case "dryRun":
+				return ec.fieldContext_LoadBalancerApplyPayload_dryRun(ctx, field)
+			case "changes":
+				return ec.fieldContext_LoadBalancerApplyPayload_changes(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerApplyPayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_loadBalancerApply_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+func (ec *executionContext) _Mutation_loadBalancerCreate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_loadBalancerCreate(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12504,7 +13962,7 @@ func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		return ec.resolvers.Mutation().LoadBalancerCreate(rctx, fc.Args["input"].(CreateLoadBalancerInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12516,26 +13974,41 @@ func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*LoadBalancerCreatePayload)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerCreatePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerCreatePayload(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_loadBalancerCreate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "loadBalancer":
+				return ec.fieldContext_LoadBalancerCreatePayload_loadBalancer(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerCreatePayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_loadBalancerCreate_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+func (ec *executionContext) _Mutation_loadBalancerCreateWithChildren(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_loadBalancerCreateWithChildren(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12548,35 +14021,53 @@ func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		return ec.resolvers.Mutation().LoadBalancerCreateWithChildren(rctx, fc.Args["input"].(CreateLoadBalancerWithChildrenInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*LoadBalancerCreatePayload)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerCreatePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerCreatePayload(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_loadBalancerCreateWithChildren(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "loadBalancer":
+				return ec.fieldContext_LoadBalancerCreatePayload_loadBalancer(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerCreatePayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_loadBalancerCreateWithChildren_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_name(ctx, field)
+func (ec *executionContext) _Mutation_loadBalancerUpdate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_loadBalancerUpdate(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12589,7 +14080,7 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return ec.resolvers.Mutation().LoadBalancerUpdate(rctx, fc.Args["id"].(gidx.PrefixedID), fc.Args["input"].(generated.UpdateLoadBalancerInput), fc.Args["expectedVersion"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12601,67 +14092,100 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*LoadBalancerUpdatePayload)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerUpdatePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerUpdatePayload(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_loadBalancerUpdate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "loadBalancer":
+				return ec.fieldContext_LoadBalancerUpdatePayload_loadBalancer(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerUpdatePayload", field.Name)
 		},
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_description(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_loadBalancerUpdate_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_loadBalancerDelete(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_loadBalancerDelete(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
 			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return ec.resolvers.Mutation().LoadBalancerDelete(rctx, fc.Args["id"].(gidx.PrefixedID), fc.Args["expectedVersion"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*LoadBalancerDeletePayload)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerDeletePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerDeletePayload(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_loadBalancerDelete(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "deletedID":
+				return ec.fieldContext_LoadBalancerDeletePayload_deletedID(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerDeletePayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_loadBalancerDelete_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_args(ctx, field)
+func (ec *executionContext) _Mutation_loadBalancerPoolCreate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_loadBalancerPoolCreate(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12674,7 +14198,7 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return ec.resolvers.Mutation().LoadBalancerPoolCreate(rctx, fc.Args["input"].(CreateLoadBalancerPoolInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12686,36 +14210,41 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(*LoadBalancerPoolCreatePayload)
 	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerPoolCreatePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerPoolCreatePayload(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_loadBalancerPoolCreate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "loadBalancerPool":
+				return ec.fieldContext_LoadBalancerPoolCreatePayload_loadBalancerPool(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPoolCreatePayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_loadBalancerPoolCreate_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_type(ctx, field)
+func (ec *executionContext) _Mutation_loadBalancerPoolUpdate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_loadBalancerPoolUpdate(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12728,7 +14257,7 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return ec.resolvers.Mutation().LoadBalancerPoolUpdate(rctx, fc.Args["id"].(gidx.PrefixedID), fc.Args["input"].(generated.UpdateLoadBalancerPoolInput), fc.Args["expectedVersion"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12740,48 +14269,41 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*LoadBalancerPoolUpdatePayload)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerPoolUpdatePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerPoolUpdatePayload(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_loadBalancerPoolUpdate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "loadBalancerPool":
+				return ec.fieldContext_LoadBalancerPoolUpdatePayload_loadBalancerPool(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPoolUpdatePayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_loadBalancerPoolUpdate_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_isDeprecated(ctx, field)
+func (ec *executionContext) _Mutation_loadBalancerPoolDelete(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_loadBalancerPoolDelete(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12794,7 +14316,7 @@ func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		return ec.resolvers.Mutation().LoadBalancerPoolDelete(rctx, fc.Args["id"].(gidx.PrefixedID), fc.Args["expectedVersion"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12806,26 +14328,41 @@ func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*LoadBalancerPoolDeletePayload)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerPoolDeletePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerPoolDeletePayload(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_isDeprecated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_loadBalancerPoolDelete(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "deletedID":
+				return ec.fieldContext_LoadBalancerPoolDeletePayload_deletedID(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPoolDeletePayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_loadBalancerPoolDelete_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_deprecationReason(ctx, field)
+func (ec *executionContext) _Mutation_loadBalancerPortCreate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_loadBalancerPortCreate(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12838,35 +14375,53 @@ func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		return ec.resolvers.Mutation().LoadBalancerPortCreate(rctx, fc.Args["input"].(CreateLoadBalancerPortInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*LoadBalancerPortCreatePayload)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerPortCreatePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerPortCreatePayload(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_deprecationReason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_loadBalancerPortCreate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "loadBalancerPort":
+				return ec.fieldContext_LoadBalancerPortCreatePayload_loadBalancerPort(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPortCreatePayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_loadBalancerPortCreate_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_name(ctx, field)
+func (ec *executionContext) _Mutation_loadBalancerPortUpdate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_loadBalancerPortUpdate(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12879,7 +14434,7 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return ec.resolvers.Mutation().LoadBalancerPortUpdate(rctx, fc.Args["id"].(gidx.PrefixedID), fc.Args["input"].(generated.UpdateLoadBalancerPortInput), fc.Args["expectedVersion"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12891,26 +14446,41 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*LoadBalancerPortUpdatePayload)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerPortUpdatePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerPortUpdatePayload(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_loadBalancerPortUpdate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "loadBalancerPort":
+				return ec.fieldContext_LoadBalancerPortUpdatePayload_loadBalancerPort(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPortUpdatePayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_loadBalancerPortUpdate_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_description(ctx, field)
+func (ec *executionContext) _Mutation_loadBalancerPortDelete(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_loadBalancerPortDelete(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12923,35 +14493,53 @@ func (ec *executionContext) ___InputValue_description(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return ec.resolvers.Mutation().LoadBalancerPortDelete(rctx, fc.Args["id"].(gidx.PrefixedID), fc.Args["expectedVersion"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*LoadBalancerPortDeletePayload)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerPortDeletePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerPortDeletePayload(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_loadBalancerPortDelete(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "deletedID":
+				return ec.fieldContext_LoadBalancerPortDeletePayload_deletedID(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPortDeletePayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_loadBalancerPortDelete_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_type(ctx, field)
+func (ec *executionContext) _Mutation_loadBalancerProviderCreate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_loadBalancerProviderCreate(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12964,7 +14552,7 @@ func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return ec.resolvers.Mutation().LoadBalancerProviderCreate(rctx, fc.Args["input"].(CreateLoadBalancerProviderInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12976,48 +14564,41 @@ func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*LoadBalancerProviderCreatePayload)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerProviderCreatePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerProviderCreatePayload(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_loadBalancerProviderCreate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "loadBalancerProvider":
+				return ec.fieldContext_LoadBalancerProviderCreatePayload_loadBalancerProvider(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerProviderCreatePayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_loadBalancerProviderCreate_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_defaultValue(ctx, field)
+func (ec *executionContext) _Mutation_loadBalancerProviderUpdate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_loadBalancerProviderUpdate(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13030,35 +14611,53 @@ func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DefaultValue, nil
+		return ec.resolvers.Mutation().LoadBalancerProviderUpdate(rctx, fc.Args["id"].(gidx.PrefixedID), fc.Args["input"].(generated.UpdateLoadBalancerProviderInput), fc.Args["expectedVersion"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*LoadBalancerProviderUpdatePayload)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerProviderUpdatePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerProviderUpdatePayload(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_loadBalancerProviderUpdate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "loadBalancerProvider":
+				return ec.fieldContext_LoadBalancerProviderUpdatePayload_loadBalancerProvider(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerProviderUpdatePayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_loadBalancerProviderUpdate_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_description(ctx, field)
+func (ec *executionContext) _Mutation_loadBalancerProviderDelete(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_loadBalancerProviderDelete(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13071,35 +14670,53 @@ func (ec *executionContext) ___Schema_description(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return ec.resolvers.Mutation().LoadBalancerProviderDelete(rctx, fc.Args["id"].(gidx.PrefixedID), fc.Args["expectedVersion"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*LoadBalancerProviderDeletePayload)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerProviderDeletePayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerProviderDeletePayload(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_loadBalancerProviderDelete(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "deletedID":
+				return ec.fieldContext_LoadBalancerProviderDeletePayload_deletedID(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerProviderDeletePayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_loadBalancerProviderDelete_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_types(ctx, field)
+func (ec *executionContext) _Mutation_loadBalancerResync(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_loadBalancerResync(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13112,7 +14729,7 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Types(), nil
+		return ec.resolvers.Mutation().LoadBalancerResync(rctx, fc.Args["input"].(LoadBalancerResyncInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13124,48 +14741,43 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(*LoadBalancerResyncPayload)
 	fc.Result = res
-	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerResyncPayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerResyncPayload(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_types(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_loadBalancerResync(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "published":
+				return ec.fieldContext_LoadBalancerResyncPayload_published(ctx, field)
+			case "cursor":
+				return ec.fieldContext_LoadBalancerResyncPayload_cursor(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerResyncPayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_loadBalancerResync_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_queryType(ctx, field)
+func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *entgql.PageInfo[gidx.PrefixedID]) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_hasNextPage(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13178,7 +14790,7 @@ func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.QueryType(), nil
+		return obj.HasNextPage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13190,48 +14802,26 @@ func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_queryType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "PageInfo",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_mutationType(ctx, field)
+func (ec *executionContext) _PageInfo_hasPreviousPage(ctx context.Context, field graphql.CollectedField, obj *entgql.PageInfo[gidx.PrefixedID]) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13244,57 +14834,38 @@ func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MutationType(), nil
+		return obj.HasPreviousPage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_mutationType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_hasPreviousPage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "PageInfo",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_subscriptionType(ctx, field)
+func (ec *executionContext) _PageInfo_startCursor(ctx context.Context, field graphql.CollectedField, obj *entgql.PageInfo[gidx.PrefixedID]) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_startCursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13307,7 +14878,7 @@ func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SubscriptionType(), nil
+		return obj.StartCursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13316,48 +14887,26 @@ func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, fiel
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*entgql.Cursor[gidx.PrefixedID])
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalOCursor2ᚖentgoᚗioᚋcontribᚋentgqlᚐCursor(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_startCursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "PageInfo",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Cursor does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_directives(ctx, field)
+func (ec *executionContext) _PageInfo_endCursor(ctx context.Context, field graphql.CollectedField, obj *entgql.PageInfo[gidx.PrefixedID]) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_endCursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13370,50 +14919,35 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Directives(), nil
+		return obj.EndCursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Directive)
+	res := resTmp.(*entgql.Cursor[gidx.PrefixedID])
 	fc.Result = res
-	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+	return ec.marshalOCursor2ᚖentgoᚗioᚋcontribᚋentgqlᚐCursor(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_directives(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_endCursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "PageInfo",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Directive_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Directive_description(ctx, field)
-			case "locations":
-				return ec.fieldContext___Directive_locations(ctx, field)
-			case "args":
-				return ec.fieldContext___Directive_args(ctx, field)
-			case "isRepeatable":
-				return ec.fieldContext___Directive_isRepeatable(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+			return nil, errors.New("field of type Cursor does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_kind(ctx, field)
+func (ec *executionContext) _Query_loadBalancer(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_loadBalancer(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13426,7 +14960,7 @@ func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Kind(), nil
+		return ec.resolvers.Query().LoadBalancer(rctx, fc.Args["id"].(gidx.PrefixedID))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13438,67 +14972,67 @@ func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*generated.LoadBalancer)
 	fc.Result = res
-	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancer2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancer(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_loadBalancer(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __TypeKind does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_LoadBalancer_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancer_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancer_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancer_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancer_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancer_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancer_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancer_deletedBy(ctx, field)
+			case "name":
+				return ec.fieldContext_LoadBalancer_name(ctx, field)
+			case "ports":
+				return ec.fieldContext_LoadBalancer_ports(ctx, field)
+			case "loadBalancerProvider":
+				return ec.fieldContext_LoadBalancer_loadBalancerProvider(ctx, field)
+			case "auditEvents":
+				return ec.fieldContext_LoadBalancer_auditEvents(ctx, field)
+			case "location":
+				return ec.fieldContext_LoadBalancer_location(ctx, field)
+			case "owner":
+				return ec.fieldContext_LoadBalancer_owner(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancer", field.Name)
 		},
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_name(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name(), nil
-	})
-	if err != nil {
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_loadBalancer_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext___Type_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_description(ctx, field)
+func (ec *executionContext) _Query_loadBalancerHistory(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_loadBalancerHistory(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13511,35 +15045,79 @@ func (ec *executionContext) ___Type_description(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return ec.resolvers.Query().LoadBalancerHistory(rctx, fc.Args["id"].(gidx.PrefixedID))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*generated.LoadBalancer)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancer2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancer(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_loadBalancerHistory(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_LoadBalancer_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancer_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancer_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancer_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancer_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancer_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancer_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancer_deletedBy(ctx, field)
+			case "name":
+				return ec.fieldContext_LoadBalancer_name(ctx, field)
+			case "ports":
+				return ec.fieldContext_LoadBalancer_ports(ctx, field)
+			case "loadBalancerProvider":
+				return ec.fieldContext_LoadBalancer_loadBalancerProvider(ctx, field)
+			case "auditEvents":
+				return ec.fieldContext_LoadBalancer_auditEvents(ctx, field)
+			case "location":
+				return ec.fieldContext_LoadBalancer_location(ctx, field)
+			case "owner":
+				return ec.fieldContext_LoadBalancer_owner(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancer", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_loadBalancerHistory_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_fields(ctx, field)
+func (ec *executionContext) _Query_loadBalancerOrigin(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_loadBalancerOrigin(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13552,42 +15130,63 @@ func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+		return ec.resolvers.Query().LoadBalancerOrigin(rctx, fc.Args["id"].(gidx.PrefixedID))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Field)
+	res := resTmp.(*generated.Origin)
 	fc.Result = res
-	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerOrigin2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐOrigin(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_loadBalancerOrigin(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext___Field_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Field_description(ctx, field)
-			case "args":
-				return ec.fieldContext___Field_args(ctx, field)
-			case "type":
-				return ec.fieldContext___Field_type(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___Field_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___Field_deprecationReason(ctx, field)
+			case "id":
+				return ec.fieldContext_LoadBalancerOrigin_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancerOrigin_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancerOrigin_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancerOrigin_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancerOrigin_deletedBy(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancerOrigin_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancerOrigin_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancerOrigin_version(ctx, field)
+			case "name":
+				return ec.fieldContext_LoadBalancerOrigin_name(ctx, field)
+			case "weight":
+				return ec.fieldContext_LoadBalancerOrigin_weight(ctx, field)
+			case "target":
+				return ec.fieldContext_LoadBalancerOrigin_target(ctx, field)
+			case "portNumber":
+				return ec.fieldContext_LoadBalancerOrigin_portNumber(ctx, field)
+			case "active":
+				return ec.fieldContext_LoadBalancerOrigin_active(ctx, field)
+			case "poolID":
+				return ec.fieldContext_LoadBalancerOrigin_poolID(ctx, field)
+			case "pool":
+				return ec.fieldContext_LoadBalancerOrigin_pool(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerOrigin", field.Name)
 		},
 	}
 	defer func() {
@@ -13597,15 +15196,15 @@ func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, fiel
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_loadBalancerOrigin_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_interfaces(ctx, field)
+func (ec *executionContext) _Query_loadBalancerOriginHistory(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_loadBalancerOriginHistory(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13618,57 +15217,81 @@ func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Interfaces(), nil
+		return ec.resolvers.Query().LoadBalancerOriginHistory(rctx, fc.Args["id"].(gidx.PrefixedID))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(*generated.Origin)
 	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerOrigin2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐOrigin(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_interfaces(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_loadBalancerOriginHistory(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
+			case "id":
+				return ec.fieldContext_LoadBalancerOrigin_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancerOrigin_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancerOrigin_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancerOrigin_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancerOrigin_deletedBy(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancerOrigin_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancerOrigin_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancerOrigin_version(ctx, field)
 			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+				return ec.fieldContext_LoadBalancerOrigin_name(ctx, field)
+			case "weight":
+				return ec.fieldContext_LoadBalancerOrigin_weight(ctx, field)
+			case "target":
+				return ec.fieldContext_LoadBalancerOrigin_target(ctx, field)
+			case "portNumber":
+				return ec.fieldContext_LoadBalancerOrigin_portNumber(ctx, field)
+			case "active":
+				return ec.fieldContext_LoadBalancerOrigin_active(ctx, field)
+			case "poolID":
+				return ec.fieldContext_LoadBalancerOrigin_poolID(ctx, field)
+			case "pool":
+				return ec.fieldContext_LoadBalancerOrigin_pool(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerOrigin", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_loadBalancerOriginHistory_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_possibleTypes(ctx, field)
+func (ec *executionContext) _Query_loadBalancerPool(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_loadBalancerPool(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13681,57 +15304,81 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PossibleTypes(), nil
+		return ec.resolvers.Query().LoadBalancerPool(rctx, fc.Args["id"].(gidx.PrefixedID))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(*generated.Pool)
 	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerPool2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_possibleTypes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_loadBalancerPool(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
+			case "id":
+				return ec.fieldContext_LoadBalancerPool_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancerPool_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancerPool_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancerPool_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancerPool_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancerPool_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancerPool_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancerPool_deletedBy(ctx, field)
 			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+				return ec.fieldContext_LoadBalancerPool_name(ctx, field)
+			case "protocol":
+				return ec.fieldContext_LoadBalancerPool_protocol(ctx, field)
+			case "ownerID":
+				return ec.fieldContext_LoadBalancerPool_ownerID(ctx, field)
+			case "ports":
+				return ec.fieldContext_LoadBalancerPool_ports(ctx, field)
+			case "origins":
+				return ec.fieldContext_LoadBalancerPool_origins(ctx, field)
+			case "auditEvents":
+				return ec.fieldContext_LoadBalancerPool_auditEvents(ctx, field)
+			case "owner":
+				return ec.fieldContext_LoadBalancerPool_owner(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPool", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_loadBalancerPool_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_enumValues(ctx, field)
+func (ec *executionContext) _Query_loadBalancerPoolHistory(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_loadBalancerPoolHistory(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13744,38 +15391,63 @@ func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+		return ec.resolvers.Query().LoadBalancerPoolHistory(rctx, fc.Args["id"].(gidx.PrefixedID))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.EnumValue)
+	res := resTmp.(*generated.Pool)
 	fc.Result = res
-	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerPool2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_loadBalancerPoolHistory(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "id":
+				return ec.fieldContext_LoadBalancerPool_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancerPool_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancerPool_updatedAt(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancerPool_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancerPool_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancerPool_version(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancerPool_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancerPool_deletedBy(ctx, field)
 			case "name":
-				return ec.fieldContext___EnumValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___EnumValue_description(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+				return ec.fieldContext_LoadBalancerPool_name(ctx, field)
+			case "protocol":
+				return ec.fieldContext_LoadBalancerPool_protocol(ctx, field)
+			case "ownerID":
+				return ec.fieldContext_LoadBalancerPool_ownerID(ctx, field)
+			case "ports":
+				return ec.fieldContext_LoadBalancerPool_ports(ctx, field)
+			case "origins":
+				return ec.fieldContext_LoadBalancerPool_origins(ctx, field)
+			case "auditEvents":
+				return ec.fieldContext_LoadBalancerPool_auditEvents(ctx, field)
+			case "owner":
+				return ec.fieldContext_LoadBalancerPool_owner(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPool", field.Name)
 		},
 	}
 	defer func() {
@@ -13785,15 +15457,15 @@ func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context,
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_loadBalancerPoolHistory_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_inputFields(ctx, field)
+func (ec *executionContext) _Query_loadBalancerPort(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_loadBalancerPort(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13806,32 +15478,2642 @@ func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.InputFields(), nil
+		return ec.resolvers.Query().LoadBalancerPort(rctx, fc.Args["id"].(gidx.PrefixedID))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(*generated.Port)
 	fc.Result = res
-	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNLoadBalancerPort2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPort(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_inputFields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_loadBalancerPort(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
+			case "id":
+				return ec.fieldContext_LoadBalancerPort_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancerPort_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancerPort_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancerPort_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancerPort_deletedBy(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancerPort_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancerPort_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancerPort_version(ctx, field)
+			case "number":
+				return ec.fieldContext_LoadBalancerPort_number(ctx, field)
+			case "name":
+				return ec.fieldContext_LoadBalancerPort_name(ctx, field)
+			case "loadBalancerID":
+				return ec.fieldContext_LoadBalancerPort_loadBalancerID(ctx, field)
+			case "pools":
+				return ec.fieldContext_LoadBalancerPort_pools(ctx, field)
+			case "loadBalancer":
+				return ec.fieldContext_LoadBalancerPort_loadBalancer(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPort", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_loadBalancerPort_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_loadBalancerPortHistory(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_loadBalancerPortHistory(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().LoadBalancerPortHistory(rctx, fc.Args["id"].(gidx.PrefixedID))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*generated.Port)
+	fc.Result = res
+	return ec.marshalNLoadBalancerPort2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPort(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_loadBalancerPortHistory(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_LoadBalancerPort_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancerPort_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancerPort_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancerPort_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancerPort_deletedBy(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancerPort_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancerPort_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancerPort_version(ctx, field)
+			case "number":
+				return ec.fieldContext_LoadBalancerPort_number(ctx, field)
+			case "name":
+				return ec.fieldContext_LoadBalancerPort_name(ctx, field)
+			case "loadBalancerID":
+				return ec.fieldContext_LoadBalancerPort_loadBalancerID(ctx, field)
+			case "pools":
+				return ec.fieldContext_LoadBalancerPort_pools(ctx, field)
+			case "loadBalancer":
+				return ec.fieldContext_LoadBalancerPort_loadBalancer(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPort", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_loadBalancerPortHistory_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_loadBalancerProvider(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_loadBalancerProvider(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().LoadBalancerProvider(rctx, fc.Args["id"].(gidx.PrefixedID))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*generated.Provider)
+	fc.Result = res
+	return ec.marshalNLoadBalancerProvider2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐProvider(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_loadBalancerProvider(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_LoadBalancerProvider_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancerProvider_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancerProvider_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancerProvider_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancerProvider_deletedBy(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancerProvider_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancerProvider_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancerProvider_version(ctx, field)
+			case "name":
+				return ec.fieldContext_LoadBalancerProvider_name(ctx, field)
+			case "loadBalancers":
+				return ec.fieldContext_LoadBalancerProvider_loadBalancers(ctx, field)
+			case "auditEvents":
+				return ec.fieldContext_LoadBalancerProvider_auditEvents(ctx, field)
+			case "owner":
+				return ec.fieldContext_LoadBalancerProvider_owner(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerProvider", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_loadBalancerProvider_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_loadBalancerProviderHistory(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_loadBalancerProviderHistory(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().LoadBalancerProviderHistory(rctx, fc.Args["id"].(gidx.PrefixedID))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*generated.Provider)
+	fc.Result = res
+	return ec.marshalNLoadBalancerProvider2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐProvider(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_loadBalancerProviderHistory(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_LoadBalancerProvider_id(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LoadBalancerProvider_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LoadBalancerProvider_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_LoadBalancerProvider_deletedAt(ctx, field)
+			case "deletedBy":
+				return ec.fieldContext_LoadBalancerProvider_deletedBy(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_LoadBalancerProvider_createdBy(ctx, field)
+			case "updatedBy":
+				return ec.fieldContext_LoadBalancerProvider_updatedBy(ctx, field)
+			case "version":
+				return ec.fieldContext_LoadBalancerProvider_version(ctx, field)
+			case "name":
+				return ec.fieldContext_LoadBalancerProvider_name(ctx, field)
+			case "loadBalancers":
+				return ec.fieldContext_LoadBalancerProvider_loadBalancers(ctx, field)
+			case "auditEvents":
+				return ec.fieldContext_LoadBalancerProvider_auditEvents(ctx, field)
+			case "owner":
+				return ec.fieldContext_LoadBalancerProvider_owner(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerProvider", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_loadBalancerProviderHistory_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query__entities(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query__entities(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.__resolve_entities(ctx, fc.Args["representations"].([]map[string]interface{})), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]fedruntime.Entity)
+	fc.Result = res
+	return ec.marshalN_Entity2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋpluginᚋfederationᚋfedruntimeᚐEntity(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query__entities(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type _Entity does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query__entities_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query__service(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query__service(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.__resolve__service(ctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(fedruntime.Service)
+	fc.Result = res
+	return ec.marshalN_Service2githubᚗcomᚋ99designsᚋgqlgenᚋpluginᚋfederationᚋfedruntimeᚐService(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query__service(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "sdl":
+				return ec.fieldContext__Service_sdl(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type _Service", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectType(fc.Args["name"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___schema(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectSchema()
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Schema)
+	fc.Result = res
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query___schema(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceOwner_id(ctx context.Context, field graphql.CollectedField, obj *ResourceOwner) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceOwner_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(gidx.PrefixedID)
+	fc.Result = res
+	return ec.marshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ResourceOwner_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceOwner",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceOwner_loadBalancers(ctx context.Context, field graphql.CollectedField, obj *ResourceOwner) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceOwner_loadBalancers(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ResourceOwner().LoadBalancers(rctx, obj, fc.Args["after"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["first"].(*int), fc.Args["before"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["last"].(*int), fc.Args["orderBy"].(*generated.LoadBalancerOrder), fc.Args["where"].(*generated.LoadBalancerWhereInput), fc.Args["includeDeleted"].(*bool))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*generated.LoadBalancerConnection)
+	fc.Result = res
+	return ec.marshalNLoadBalancerConnection2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ResourceOwner_loadBalancers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceOwner",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_LoadBalancerConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_LoadBalancerConnection_pageInfo(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_LoadBalancerConnection_totalCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_ResourceOwner_loadBalancers_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceOwner_loadBalancerPools(ctx context.Context, field graphql.CollectedField, obj *ResourceOwner) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceOwner_loadBalancerPools(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ResourceOwner().LoadBalancerPools(rctx, obj, fc.Args["after"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["first"].(*int), fc.Args["before"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["last"].(*int), fc.Args["orderBy"].(*generated.LoadBalancerPoolOrder), fc.Args["where"].(*generated.LoadBalancerPoolWhereInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*generated.LoadBalancerPoolConnection)
+	fc.Result = res
+	return ec.marshalNLoadBalancerPoolConnection2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerPoolConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ResourceOwner_loadBalancerPools(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceOwner",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_LoadBalancerPoolConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_LoadBalancerPoolConnection_pageInfo(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_LoadBalancerPoolConnection_totalCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerPoolConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_ResourceOwner_loadBalancerPools_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceOwner_loadBalancersProviders(ctx context.Context, field graphql.CollectedField, obj *ResourceOwner) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceOwner_loadBalancersProviders(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ResourceOwner().LoadBalancersProviders(rctx, obj, fc.Args["after"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["first"].(*int), fc.Args["before"].(*entgql.Cursor[gidx.PrefixedID]), fc.Args["last"].(*int), fc.Args["orderBy"].(*generated.LoadBalancerProviderOrder), fc.Args["where"].(*generated.LoadBalancerProviderWhereInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*generated.LoadBalancerProviderConnection)
+	fc.Result = res
+	return ec.marshalNLoadBalancerProviderConnection2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerProviderConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ResourceOwner_loadBalancersProviders(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceOwner",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_LoadBalancerProviderConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_LoadBalancerProviderConnection_pageInfo(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_LoadBalancerProviderConnection_totalCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerProviderConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_ResourceOwner_loadBalancersProviders_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_loadBalancerChanged(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_loadBalancerChanged(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().LoadBalancerChanged(rctx, fc.Args["id"].(gidx.PrefixedID))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *LoadBalancerChange):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNLoadBalancerChange2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerChange(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_loadBalancerChanged(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "eventType":
+				return ec.fieldContext_LoadBalancerChange_eventType(ctx, field)
+			case "subjectID":
+				return ec.fieldContext_LoadBalancerChange_subjectID(ctx, field)
+			case "loadBalancerID":
+				return ec.fieldContext_LoadBalancerChange_loadBalancerID(ctx, field)
+			case "loadBalancer":
+				return ec.fieldContext_LoadBalancerChange_loadBalancer(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_LoadBalancerChange_timestamp(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerChange", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_loadBalancerChanged_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_ownerLoadBalancersChanged(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_ownerLoadBalancersChanged(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().OwnerLoadBalancersChanged(rctx, fc.Args["ownerID"].(gidx.PrefixedID))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *LoadBalancerChange):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNLoadBalancerChange2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerChange(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_ownerLoadBalancersChanged(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "eventType":
+				return ec.fieldContext_LoadBalancerChange_eventType(ctx, field)
+			case "subjectID":
+				return ec.fieldContext_LoadBalancerChange_subjectID(ctx, field)
+			case "loadBalancerID":
+				return ec.fieldContext_LoadBalancerChange_loadBalancerID(ctx, field)
+			case "loadBalancer":
+				return ec.fieldContext_LoadBalancerChange_loadBalancer(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_LoadBalancerChange_timestamp(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadBalancerChange", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_ownerLoadBalancersChanged_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) __Service_sdl(ctx context.Context, field graphql.CollectedField, obj *fedruntime.Service) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext__Service_sdl(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SDL, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext__Service_sdl(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "_Service",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_locations(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Locations, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_locations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_args(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_isRepeatable(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsRepeatable, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_args(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_isDeprecated(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_isDeprecated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_deprecationReason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_deprecationReason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_defaultValue(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DefaultValue, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_types(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Types(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_types(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_queryType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.QueryType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_queryType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_mutationType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MutationType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_mutationType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_subscriptionType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SubscriptionType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_directives(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Directives(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Directive)
+	fc.Result = res
+	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_directives(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Directive_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Directive_description(ctx, field)
+			case "locations":
+				return ec.fieldContext___Directive_locations(ctx, field)
+			case "args":
+				return ec.fieldContext___Directive_args(ctx, field)
+			case "isRepeatable":
+				return ec.fieldContext___Directive_isRepeatable(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_kind(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __TypeKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_fields(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Field)
+	fc.Result = res
+	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Field_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Field_description(ctx, field)
+			case "args":
+				return ec.fieldContext___Field_args(ctx, field)
+			case "type":
+				return ec.fieldContext___Field_type(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___Field_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___Field_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_interfaces(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Interfaces(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_interfaces(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_possibleTypes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PossibleTypes(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_possibleTypes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_enumValues(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.EnumValue)
+	fc.Result = res
+	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___EnumValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___EnumValue_description(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_inputFields(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.InputFields(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_inputFields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
 			case "type":
 				return ec.fieldContext___InputValue_type(ctx, field)
 			case "defaultValue":
@@ -13900,65 +18182,322 @@ func (ec *executionContext) fieldContext___Type_ofType(ctx context.Context, fiel
 			case "specifiedByURL":
 				return ec.fieldContext___Type_specifiedByURL(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_specifiedByURL(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SpecifiedByURL(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputAuditEventFilter(ctx context.Context, obj interface{}) (AuditEventFilter, error) {
+	var it AuditEventFilter
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"actor", "field", "since", "until"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "actor":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("actor"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Actor = data
+		case "field":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("field"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Field = data
+		case "since":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("since"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Since = data
+		case "until":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("until"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Until = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputAuditEventOrder(ctx context.Context, obj interface{}) (generated.AuditEventOrder, error) {
+	var it generated.AuditEventOrder
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["direction"]; !present {
+		asMap["direction"] = "ASC"
+	}
+
+	fieldsInOrder := [...]string{"direction", "field"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "direction":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("direction"))
+			data, err := ec.unmarshalNOrderDirection2entgoᚗioᚋcontribᚋentgqlᚐOrderDirection(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Direction = data
+		case "field":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("field"))
+			data, err := ec.unmarshalNAuditEventOrderField2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐAuditEventOrderField(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Field = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCreateLoadBalancerChildOriginInput(ctx context.Context, obj interface{}) (CreateLoadBalancerChildOriginInput, error) {
+	var it CreateLoadBalancerChildOriginInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"name", "weight", "target", "portNumber", "active"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "weight":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("weight"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Weight = data
+		case "target":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("target"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Target = data
+		case "portNumber":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("portNumber"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PortNumber = data
+		case "active":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("active"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Active = data
+		}
 	}
-	return fc, nil
+
+	return it, nil
 }
 
-func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_specifiedByURL(ctx, field)
-	if err != nil {
-		return graphql.Null
+func (ec *executionContext) unmarshalInputCreateLoadBalancerChildPoolInput(ctx context.Context, obj interface{}) (CreateLoadBalancerChildPoolInput, error) {
+	var it CreateLoadBalancerChildPoolInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+
+	fieldsInOrder := [...]string{"name", "protocol", "origins"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "protocol":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("protocol"))
+			data, err := ec.unmarshalNLoadBalancerPoolProtocol2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚋpoolᚐProtocol(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Protocol = data
+		case "origins":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("origins"))
+			data, err := ec.unmarshalOCreateLoadBalancerChildOriginInput2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐCreateLoadBalancerChildOriginInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Origins = data
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.SpecifiedByURL(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
 	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+
+	return it, nil
 }
 
-func (ec *executionContext) fieldContext___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) unmarshalInputCreateLoadBalancerChildPortInput(ctx context.Context, obj interface{}) (CreateLoadBalancerChildPortInput, error) {
+	var it CreateLoadBalancerChildPortInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
 	}
-	return fc, nil
-}
 
-// endregion **************************** field.gotpl *****************************
+	fieldsInOrder := [...]string{"number", "name", "pools"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "number":
+			var err error
 
-// region    **************************** input.gotpl *****************************
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("number"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Number = data
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "pools":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pools"))
+			data, err := ec.unmarshalOCreateLoadBalancerChildPoolInput2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐCreateLoadBalancerChildPoolInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Pools = data
+		}
+	}
+
+	return it, nil
+}
 
-func (ec *executionContext) unmarshalInputCreateLoadBalancerInput(ctx context.Context, obj interface{}) (generated.CreateLoadBalancerInput, error) {
-	var it generated.CreateLoadBalancerInput
+func (ec *executionContext) unmarshalInputCreateLoadBalancerInput(ctx context.Context, obj interface{}) (CreateLoadBalancerInput, error) {
+	var it CreateLoadBalancerInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"name", "ownerID", "locationID", "portIDs", "providerID"}
+	fieldsInOrder := [...]string{"name", "ownerID", "locationID", "portIDs", "providerID", "idempotencyKey"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -14010,20 +18549,29 @@ func (ec *executionContext) unmarshalInputCreateLoadBalancerInput(ctx context.Co
 				return it, err
 			}
 			it.ProviderID = data
+		case "idempotencyKey":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("idempotencyKey"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IdempotencyKey = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputCreateLoadBalancerOriginInput(ctx context.Context, obj interface{}) (generated.CreateLoadBalancerOriginInput, error) {
-	var it generated.CreateLoadBalancerOriginInput
+func (ec *executionContext) unmarshalInputCreateLoadBalancerOriginInput(ctx context.Context, obj interface{}) (CreateLoadBalancerOriginInput, error) {
+	var it CreateLoadBalancerOriginInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"name", "weight", "target", "portNumber", "active", "poolID"}
+	fieldsInOrder := [...]string{"name", "weight", "target", "portNumber", "active", "poolID", "idempotencyKey"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -14060,44 +18608,192 @@ func (ec *executionContext) unmarshalInputCreateLoadBalancerOriginInput(ctx cont
 		case "portNumber":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("portNumber"))
-			data, err := ec.unmarshalNInt2int(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("portNumber"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PortNumber = data
+		case "active":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("active"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Active = data
+		case "poolID":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("poolID"))
+			data, err := ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PoolID = data
+		case "idempotencyKey":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("idempotencyKey"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IdempotencyKey = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCreateLoadBalancerPoolInput(ctx context.Context, obj interface{}) (CreateLoadBalancerPoolInput, error) {
+	var it CreateLoadBalancerPoolInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"name", "protocol", "ownerID", "portIDs", "originIDs", "idempotencyKey"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "protocol":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("protocol"))
+			data, err := ec.unmarshalNLoadBalancerPoolProtocol2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚋpoolᚐProtocol(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Protocol = data
+		case "ownerID":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ownerID"))
+			data, err := ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.OwnerID = data
+		case "portIDs":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("portIDs"))
+			data, err := ec.unmarshalOID2ᚕgoᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedIDᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PortIDs = data
+		case "originIDs":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("originIDs"))
+			data, err := ec.unmarshalOID2ᚕgoᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedIDᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.OriginIDs = data
+		case "idempotencyKey":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("idempotencyKey"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IdempotencyKey = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCreateLoadBalancerPortInput(ctx context.Context, obj interface{}) (CreateLoadBalancerPortInput, error) {
+	var it CreateLoadBalancerPortInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"number", "name", "poolIDs", "loadBalancerID", "idempotencyKey"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "number":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("number"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Number = data
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "poolIDs":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("poolIDs"))
+			data, err := ec.unmarshalOID2ᚕgoᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedIDᚄ(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.PortNumber = data
-		case "active":
+			it.PoolIDs = data
+		case "loadBalancerID":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("active"))
-			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("loadBalancerID"))
+			data, err := ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Active = data
-		case "poolID":
+			it.LoadBalancerID = data
+		case "idempotencyKey":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("poolID"))
-			data, err := ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("idempotencyKey"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.PoolID = data
+			it.IdempotencyKey = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputCreateLoadBalancerPoolInput(ctx context.Context, obj interface{}) (generated.CreateLoadBalancerPoolInput, error) {
-	var it generated.CreateLoadBalancerPoolInput
+func (ec *executionContext) unmarshalInputCreateLoadBalancerProviderInput(ctx context.Context, obj interface{}) (CreateLoadBalancerProviderInput, error) {
+	var it CreateLoadBalancerProviderInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"name", "protocol", "ownerID", "portIDs", "originIDs"}
+	fieldsInOrder := [...]string{"name", "ownerID", "idempotencyKey"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -14113,15 +18809,6 @@ func (ec *executionContext) unmarshalInputCreateLoadBalancerPoolInput(ctx contex
 				return it, err
 			}
 			it.Name = data
-		case "protocol":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("protocol"))
-			data, err := ec.unmarshalNLoadBalancerPoolProtocol2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚋpoolᚐProtocol(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Protocol = data
 		case "ownerID":
 			var err error
 
@@ -14131,118 +18818,118 @@ func (ec *executionContext) unmarshalInputCreateLoadBalancerPoolInput(ctx contex
 				return it, err
 			}
 			it.OwnerID = data
-		case "portIDs":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("portIDs"))
-			data, err := ec.unmarshalOID2ᚕgoᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedIDᚄ(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.PortIDs = data
-		case "originIDs":
+		case "idempotencyKey":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("originIDs"))
-			data, err := ec.unmarshalOID2ᚕgoᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedIDᚄ(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("idempotencyKey"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.OriginIDs = data
+			it.IdempotencyKey = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputCreateLoadBalancerPortInput(ctx context.Context, obj interface{}) (generated.CreateLoadBalancerPortInput, error) {
-	var it generated.CreateLoadBalancerPortInput
+func (ec *executionContext) unmarshalInputCreateLoadBalancerWithChildrenInput(ctx context.Context, obj interface{}) (CreateLoadBalancerWithChildrenInput, error) {
+	var it CreateLoadBalancerWithChildrenInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"number", "name", "poolIDs", "loadBalancerID"}
+	fieldsInOrder := [...]string{"name", "ownerID", "locationID", "providerID", "ports", "idempotencyKey"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "number":
+		case "name":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("number"))
-			data, err := ec.unmarshalNInt2int(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Number = data
-		case "name":
+			it.Name = data
+		case "ownerID":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ownerID"))
+			data, err := ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Name = data
-		case "poolIDs":
+			it.OwnerID = data
+		case "locationID":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("poolIDs"))
-			data, err := ec.unmarshalOID2ᚕgoᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedIDᚄ(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locationID"))
+			data, err := ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.PoolIDs = data
-		case "loadBalancerID":
+			it.LocationID = data
+		case "providerID":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("loadBalancerID"))
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("providerID"))
 			data, err := ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.LoadBalancerID = data
+			it.ProviderID = data
+		case "ports":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ports"))
+			data, err := ec.unmarshalOCreateLoadBalancerChildPortInput2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐCreateLoadBalancerChildPortInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Ports = data
+		case "idempotencyKey":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("idempotencyKey"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IdempotencyKey = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputCreateLoadBalancerProviderInput(ctx context.Context, obj interface{}) (generated.CreateLoadBalancerProviderInput, error) {
-	var it generated.CreateLoadBalancerProviderInput
+func (ec *executionContext) unmarshalInputLoadBalancerByIDsInput(ctx context.Context, obj interface{}) (LoadBalancerByIDsInput, error) {
+	var it LoadBalancerByIDsInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"name", "ownerID"}
+	fieldsInOrder := [...]string{"ID"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "name":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Name = data
-		case "ownerID":
+		case "ID":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ownerID"))
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ID"))
 			data, err := ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.OwnerID = data
+			it.ID = data
 		}
 	}
 
@@ -14291,6 +18978,35 @@ func (ec *executionContext) unmarshalInputLoadBalancerOrder(ctx context.Context,
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputLoadBalancerOriginByIDsInput(ctx context.Context, obj interface{}) (LoadBalancerOriginByIDsInput, error) {
+	var it LoadBalancerOriginByIDsInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"ID"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "ID":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ID"))
+			data, err := ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ID = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputLoadBalancerOriginOrder(ctx context.Context, obj interface{}) (generated.LoadBalancerOriginOrder, error) {
 	var it generated.LoadBalancerOriginOrder
 	asMap := map[string]interface{}{}
@@ -15505,6 +20221,35 @@ func (ec *executionContext) unmarshalInputLoadBalancerOriginWhereInput(ctx conte
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputLoadBalancerPoolByIDsInput(ctx context.Context, obj interface{}) (LoadBalancerPoolByIDsInput, error) {
+	var it LoadBalancerPoolByIDsInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"ID"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "ID":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ID"))
+			data, err := ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ID = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputLoadBalancerPoolOrder(ctx context.Context, obj interface{}) (generated.LoadBalancerPoolOrder, error) {
 	var it generated.LoadBalancerPoolOrder
 	asMap := map[string]interface{}{}
@@ -16494,6 +21239,35 @@ func (ec *executionContext) unmarshalInputLoadBalancerPoolWhereInput(ctx context
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputLoadBalancerPortByIDsInput(ctx context.Context, obj interface{}) (LoadBalancerPortByIDsInput, error) {
+	var it LoadBalancerPortByIDsInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"ID"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "ID":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ID"))
+			data, err := ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ID = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputLoadBalancerPortOrder(ctx context.Context, obj interface{}) (generated.LoadBalancerPortOrder, error) {
 	var it generated.LoadBalancerPortOrder
 	asMap := map[string]interface{}{}
@@ -17537,6 +22311,35 @@ func (ec *executionContext) unmarshalInputLoadBalancerPortWhereInput(ctx context
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputLoadBalancerProviderByIDsInput(ctx context.Context, obj interface{}) (LoadBalancerProviderByIDsInput, error) {
+	var it LoadBalancerProviderByIDsInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"ID"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "ID":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ID"))
+			data, err := ec.unmarshalNID2goᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ID = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputLoadBalancerProviderOrder(ctx context.Context, obj interface{}) (generated.LoadBalancerProviderOrder, error) {
 	var it generated.LoadBalancerProviderOrder
 	asMap := map[string]interface{}{}
@@ -18415,57 +23218,122 @@ func (ec *executionContext) unmarshalInputLoadBalancerProviderWhereInput(ctx con
 		case "nameHasPrefix":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("nameHasPrefix"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.NameHasPrefix = data
-		case "nameHasSuffix":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("nameHasPrefix"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.NameHasPrefix = data
+		case "nameHasSuffix":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("nameHasSuffix"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.NameHasSuffix = data
+		case "nameEqualFold":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("nameEqualFold"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.NameEqualFold = data
+		case "nameContainsFold":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("nameContainsFold"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.NameContainsFold = data
+		case "hasLoadBalancers":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hasLoadBalancers"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.HasLoadBalancers = data
+		case "hasLoadBalancersWith":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hasLoadBalancersWith"))
+			data, err := ec.unmarshalOLoadBalancerWhereInput2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerWhereInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.HasLoadBalancersWith = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputLoadBalancerResyncInput(ctx context.Context, obj interface{}) (LoadBalancerResyncInput, error) {
+	var it LoadBalancerResyncInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"ownerID", "locationID", "providerID", "after", "limit"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "ownerID":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("nameHasSuffix"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ownerID"))
+			data, err := ec.unmarshalOID2ᚖgoᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.NameHasSuffix = data
-		case "nameEqualFold":
+			it.OwnerID = data
+		case "locationID":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("nameEqualFold"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locationID"))
+			data, err := ec.unmarshalOID2ᚖgoᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.NameEqualFold = data
-		case "nameContainsFold":
+			it.LocationID = data
+		case "providerID":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("nameContainsFold"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("providerID"))
+			data, err := ec.unmarshalOID2ᚖgoᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.NameContainsFold = data
-		case "hasLoadBalancers":
+			it.ProviderID = data
+		case "after":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hasLoadBalancers"))
-			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+			data, err := ec.unmarshalOID2ᚖgoᚗinfratographerᚗcomᚋxᚋgidxᚐPrefixedID(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.HasLoadBalancers = data
-		case "hasLoadBalancersWith":
+			it.After = data
+		case "limit":
 			var err error
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hasLoadBalancersWith"))
-			data, err := ec.unmarshalOLoadBalancerWhereInput2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerWhereInputᚄ(ctx, v)
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.HasLoadBalancersWith = data
+			it.Limit = data
 		}
 	}
 
@@ -19739,6 +24607,11 @@ func (ec *executionContext) _Node(ctx context.Context, sel ast.SelectionSet, obj
 	switch obj := (obj).(type) {
 	case nil:
 		return graphql.Null
+	case *generated.AuditEvent:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._AuditEvent(ctx, sel, obj)
 	case *generated.LoadBalancer:
 		if obj == nil {
 			return graphql.Null
@@ -19831,6 +24704,206 @@ func (ec *executionContext) __Entity(ctx context.Context, sel ast.SelectionSet,
 
 // region    **************************** object.gotpl ****************************
 
+var auditEventImplementors = []string{"AuditEvent", "Node"}
+
+func (ec *executionContext) _AuditEvent(ctx context.Context, sel ast.SelectionSet, obj *generated.AuditEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, auditEventImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AuditEvent")
+		case "id":
+			out.Values[i] = ec._AuditEvent_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._AuditEvent_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "actor":
+			out.Values[i] = ec._AuditEvent_actor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "operation":
+			out.Values[i] = ec._AuditEvent_operation(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "subjectID":
+			out.Values[i] = ec._AuditEvent_subjectID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "changeset":
+			out.Values[i] = ec._AuditEvent_changeset(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var auditEventConnectionImplementors = []string{"AuditEventConnection"}
+
+func (ec *executionContext) _AuditEventConnection(ctx context.Context, sel ast.SelectionSet, obj *generated.AuditEventConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, auditEventConnectionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AuditEventConnection")
+		case "edges":
+			out.Values[i] = ec._AuditEventConnection_edges(ctx, field, obj)
+		case "pageInfo":
+			out.Values[i] = ec._AuditEventConnection_pageInfo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalCount":
+			out.Values[i] = ec._AuditEventConnection_totalCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var auditEventEdgeImplementors = []string{"AuditEventEdge"}
+
+func (ec *executionContext) _AuditEventEdge(ctx context.Context, sel ast.SelectionSet, obj *generated.AuditEventEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, auditEventEdgeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AuditEventEdge")
+		case "node":
+			out.Values[i] = ec._AuditEventEdge_node(ctx, field, obj)
+		case "cursor":
+			out.Values[i] = ec._AuditEventEdge_cursor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var auditFieldChangeImplementors = []string{"AuditFieldChange"}
+
+func (ec *executionContext) _AuditFieldChange(ctx context.Context, sel ast.SelectionSet, obj *events.FieldChange) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, auditFieldChangeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AuditFieldChange")
+		case "field":
+			out.Values[i] = ec._AuditFieldChange_field(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "previousValue":
+			out.Values[i] = ec._AuditFieldChange_previousValue(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "currentValue":
+			out.Values[i] = ec._AuditFieldChange_currentValue(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var entityImplementors = []string{"Entity"}
 
 func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
@@ -19850,7 +24923,7 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet) g
 		switch field.Name {
 		case "__typename":
 			out.Values[i] = graphql.MarshalString("Entity")
-		case "findLoadBalancerByID":
+		case "findManyLoadBalancerByIDs":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -19859,10 +24932,7 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet) g
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Entity_findLoadBalancerByID(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
+				res = ec._Entity_findManyLoadBalancerByIDs(ctx, field)
 				return res
 			}
 
@@ -19872,7 +24942,7 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet) g
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "findLoadBalancerOriginByID":
+		case "findManyLoadBalancerOriginByIDs":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -19881,10 +24951,7 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet) g
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Entity_findLoadBalancerOriginByID(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
+				res = ec._Entity_findManyLoadBalancerOriginByIDs(ctx, field)
 				return res
 			}
 
@@ -19894,7 +24961,7 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet) g
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "findLoadBalancerPoolByID":
+		case "findManyLoadBalancerPoolByIDs":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -19903,10 +24970,7 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet) g
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Entity_findLoadBalancerPoolByID(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
+				res = ec._Entity_findManyLoadBalancerPoolByIDs(ctx, field)
 				return res
 			}
 
@@ -19916,7 +24980,7 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet) g
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "findLoadBalancerPortByID":
+		case "findManyLoadBalancerPortByIDs":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -19925,10 +24989,7 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet) g
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Entity_findLoadBalancerPortByID(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
+				res = ec._Entity_findManyLoadBalancerPortByIDs(ctx, field)
 				return res
 			}
 
@@ -19938,7 +24999,7 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet) g
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "findLoadBalancerProviderByID":
+		case "findManyLoadBalancerProviderByIDs":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -19947,10 +25008,7 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet) g
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Entity_findLoadBalancerProviderByID(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
+				res = ec._Entity_findManyLoadBalancerProviderByIDs(ctx, field)
 				return res
 			}
 
@@ -20057,6 +25115,11 @@ func (ec *executionContext) _LoadBalancer(ctx context.Context, sel ast.Selection
 			out.Values[i] = ec._LoadBalancer_createdBy(ctx, field, obj)
 		case "updatedBy":
 			out.Values[i] = ec._LoadBalancer_updatedBy(ctx, field, obj)
+		case "version":
+			out.Values[i] = ec._LoadBalancer_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
 		case "deletedAt":
 			out.Values[i] = ec._LoadBalancer_deletedAt(ctx, field, obj)
 		case "deletedBy":
@@ -20066,7 +25129,43 @@ func (ec *executionContext) _LoadBalancer(ctx context.Context, sel ast.Selection
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "ports":
+		case "ports":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._LoadBalancer_ports(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "loadBalancerProvider":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -20075,7 +25174,7 @@ func (ec *executionContext) _LoadBalancer(ctx context.Context, sel ast.Selection
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._LoadBalancer_ports(ctx, field, obj)
+				res = ec._LoadBalancer_loadBalancerProvider(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -20102,7 +25201,7 @@ func (ec *executionContext) _LoadBalancer(ctx context.Context, sel ast.Selection
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "loadBalancerProvider":
+		case "auditEvents":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -20111,7 +25210,7 @@ func (ec *executionContext) _LoadBalancer(ctx context.Context, sel ast.Selection
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._LoadBalancer_loadBalancerProvider(ctx, field, obj)
+				res = ec._LoadBalancer_auditEvents(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -20233,6 +25332,164 @@ func (ec *executionContext) _LoadBalancer(ctx context.Context, sel ast.Selection
 	return out
 }
 
+var loadBalancerApplyChangeImplementors = []string{"LoadBalancerApplyChange"}
+
+func (ec *executionContext) _LoadBalancerApplyChange(ctx context.Context, sel ast.SelectionSet, obj *LoadBalancerApplyChange) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, loadBalancerApplyChangeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LoadBalancerApplyChange")
+		case "action":
+			out.Values[i] = ec._LoadBalancerApplyChange_action(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "resourceType":
+			out.Values[i] = ec._LoadBalancerApplyChange_resourceType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "id":
+			out.Values[i] = ec._LoadBalancerApplyChange_id(ctx, field, obj)
+		case "key":
+			out.Values[i] = ec._LoadBalancerApplyChange_key(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "fields":
+			out.Values[i] = ec._LoadBalancerApplyChange_fields(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var loadBalancerApplyPayloadImplementors = []string{"LoadBalancerApplyPayload"}
+
+func (ec *executionContext) _LoadBalancerApplyPayload(ctx context.Context, sel ast.SelectionSet, obj *LoadBalancerApplyPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, loadBalancerApplyPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LoadBalancerApplyPayload")
+		case "loadBalancer":
+			out.Values[i] = ec._LoadBalancerApplyPayload_loadBalancer(ctx, field, obj)
+		case "dryRun":
+			out.Values[i] = ec._LoadBalancerApplyPayload_dryRun(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "changes":
+			out.Values[i] = ec._LoadBalancerApplyPayload_changes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var loadBalancerChangeImplementors = []string{"LoadBalancerChange"}
+
+func (ec *executionContext) _LoadBalancerChange(ctx context.Context, sel ast.SelectionSet, obj *LoadBalancerChange) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, loadBalancerChangeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LoadBalancerChange")
+		case "eventType":
+			out.Values[i] = ec._LoadBalancerChange_eventType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "subjectID":
+			out.Values[i] = ec._LoadBalancerChange_subjectID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "loadBalancerID":
+			out.Values[i] = ec._LoadBalancerChange_loadBalancerID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "loadBalancer":
+			out.Values[i] = ec._LoadBalancerChange_loadBalancer(ctx, field, obj)
+		case "timestamp":
+			out.Values[i] = ec._LoadBalancerChange_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var loadBalancerConnectionImplementors = []string{"LoadBalancerConnection"}
 
 func (ec *executionContext) _LoadBalancerConnection(ctx context.Context, sel ast.SelectionSet, obj *generated.LoadBalancerConnection) graphql.Marshaler {
@@ -20432,6 +25689,11 @@ func (ec *executionContext) _LoadBalancerOrigin(ctx context.Context, sel ast.Sel
 			out.Values[i] = ec._LoadBalancerOrigin_createdBy(ctx, field, obj)
 		case "updatedBy":
 			out.Values[i] = ec._LoadBalancerOrigin_updatedBy(ctx, field, obj)
+		case "version":
+			out.Values[i] = ec._LoadBalancerOrigin_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
 		case "name":
 			out.Values[i] = ec._LoadBalancerOrigin_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -20755,6 +26017,11 @@ func (ec *executionContext) _LoadBalancerPool(ctx context.Context, sel ast.Selec
 			out.Values[i] = ec._LoadBalancerPool_createdBy(ctx, field, obj)
 		case "updatedBy":
 			out.Values[i] = ec._LoadBalancerPool_updatedBy(ctx, field, obj)
+		case "version":
+			out.Values[i] = ec._LoadBalancerPool_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
 		case "deletedAt":
 			out.Values[i] = ec._LoadBalancerPool_deletedAt(ctx, field, obj)
 		case "deletedBy":
@@ -20842,6 +26109,42 @@ func (ec *executionContext) _LoadBalancerPool(ctx context.Context, sel ast.Selec
 				continue
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "auditEvents":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._LoadBalancerPool_auditEvents(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		case "owner":
 			field := field
@@ -21137,6 +26440,11 @@ func (ec *executionContext) _LoadBalancerPort(ctx context.Context, sel ast.Selec
 			out.Values[i] = ec._LoadBalancerPort_createdBy(ctx, field, obj)
 		case "updatedBy":
 			out.Values[i] = ec._LoadBalancerPort_updatedBy(ctx, field, obj)
+		case "version":
+			out.Values[i] = ec._LoadBalancerPort_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
 		case "number":
 			out.Values[i] = ec._LoadBalancerPort_number(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -21479,6 +26787,11 @@ func (ec *executionContext) _LoadBalancerProvider(ctx context.Context, sel ast.S
 			out.Values[i] = ec._LoadBalancerProvider_createdBy(ctx, field, obj)
 		case "updatedBy":
 			out.Values[i] = ec._LoadBalancerProvider_updatedBy(ctx, field, obj)
+		case "version":
+			out.Values[i] = ec._LoadBalancerProvider_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
 		case "name":
 			out.Values[i] = ec._LoadBalancerProvider_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -21519,6 +26832,42 @@ func (ec *executionContext) _LoadBalancerProvider(ctx context.Context, sel ast.S
 				continue
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "auditEvents":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._LoadBalancerProvider_auditEvents(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		case "owner":
 			field := field
@@ -21783,6 +27132,47 @@ func (ec *executionContext) _LoadBalancerProviderUpdatePayload(ctx context.Conte
 	return out
 }
 
+var loadBalancerResyncPayloadImplementors = []string{"LoadBalancerResyncPayload"}
+
+func (ec *executionContext) _LoadBalancerResyncPayload(ctx context.Context, sel ast.SelectionSet, obj *LoadBalancerResyncPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, loadBalancerResyncPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LoadBalancerResyncPayload")
+		case "published":
+			out.Values[i] = ec._LoadBalancerResyncPayload_published(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "cursor":
+			out.Values[i] = ec._LoadBalancerResyncPayload_cursor(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var loadBalancerUpdatePayloadImplementors = []string{"LoadBalancerUpdatePayload"}
 
 func (ec *executionContext) _LoadBalancerUpdatePayload(ctx context.Context, sel ast.SelectionSet, obj *LoadBalancerUpdatePayload) graphql.Marshaler {
@@ -21937,6 +27327,13 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "loadBalancerApply":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_loadBalancerApply(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "loadBalancerCreate":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_loadBalancerCreate(ctx, field)
@@ -21944,6 +27341,13 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "loadBalancerCreateWithChildren":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_loadBalancerCreateWithChildren(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "loadBalancerUpdate":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_loadBalancerUpdate(ctx, field)
@@ -22021,6 +27425,13 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "loadBalancerResync":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_loadBalancerResync(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -22154,6 +27565,50 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "loadBalancerOrigin":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_loadBalancerOrigin(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "loadBalancerOriginHistory":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_loadBalancerOriginHistory(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "loadBalancerPool":
 			field := field
@@ -22176,6 +27631,28 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "loadBalancerPoolHistory":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_loadBalancerPoolHistory(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "loadBalancerPort":
 			field := field
@@ -22198,6 +27675,28 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "loadBalancerPortHistory":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_loadBalancerPortHistory(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "loadBalancerProvider":
 			field := field
@@ -22220,6 +27719,28 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "loadBalancerProviderHistory":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_loadBalancerProviderHistory(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "_entities":
 			field := field
@@ -22443,6 +27964,28 @@ func (ec *executionContext) _ResourceOwner(ctx context.Context, sel ast.Selectio
 	return out
 }
 
+var subscriptionImplementors = []string{"Subscription"}
+
+func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func(ctx context.Context) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, subscriptionImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Subscription",
+	})
+	if len(fields) != 1 {
+		ec.Errorf(ctx, "must subscribe to exactly one stream")
+		return nil
+	}
+
+	switch fields[0].Name {
+	case "loadBalancerChanged":
+		return ec._Subscription_loadBalancerChanged(ctx, fields[0])
+	case "ownerLoadBalancersChanged":
+		return ec._Subscription_ownerLoadBalancersChanged(ctx, fields[0])
+	default:
+		panic("unknown field " + strconv.Quote(fields[0].Name))
+	}
+}
+
 var _ServiceImplementors = []string{"_Service"}
 
 func (ec *executionContext) __Service(ctx context.Context, sel ast.SelectionSet, obj *fedruntime.Service) graphql.Marshaler {
@@ -22805,6 +28348,138 @@ func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, o
 
 // region    ***************************** type.gotpl *****************************
 
+func (ec *executionContext) marshalNAuditEventConnection2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐAuditEventConnection(ctx context.Context, sel ast.SelectionSet, v generated.AuditEventConnection) graphql.Marshaler {
+	return ec._AuditEventConnection(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNAuditEventConnection2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐAuditEventConnection(ctx context.Context, sel ast.SelectionSet, v *generated.AuditEventConnection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AuditEventConnection(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNAuditEventOrderField2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐAuditEventOrderField(ctx context.Context, v interface{}) (*generated.AuditEventOrderField, error) {
+	var res = new(generated.AuditEventOrderField)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNAuditEventOrderField2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐAuditEventOrderField(ctx context.Context, sel ast.SelectionSet, v *generated.AuditEventOrderField) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalNAuditFieldChange2goᚗinfratographerᚗcomᚋxᚋeventsᚐFieldChange(ctx context.Context, sel ast.SelectionSet, v events.FieldChange) graphql.Marshaler {
+	return ec._AuditFieldChange(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNAuditFieldChange2ᚕgoᚗinfratographerᚗcomᚋxᚋeventsᚐFieldChangeᚄ(ctx context.Context, sel ast.SelectionSet, v []events.FieldChange) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAuditFieldChange2goᚗinfratographerᚗcomᚋxᚋeventsᚐFieldChange(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNAuditFieldChange2ᚕᚖgoᚗinfratographerᚗcomᚋxᚋeventsᚐFieldChangeᚄ(ctx context.Context, sel ast.SelectionSet, v []*events.FieldChange) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAuditFieldChange2ᚖgoᚗinfratographerᚗcomᚋxᚋeventsᚐFieldChange(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNAuditFieldChange2ᚖgoᚗinfratographerᚗcomᚋxᚋeventsᚐFieldChange(ctx context.Context, sel ast.SelectionSet, v *events.FieldChange) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AuditFieldChange(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
 	res, err := graphql.UnmarshalBoolean(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -22820,28 +28495,48 @@ func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.Se
 	return res
 }
 
-func (ec *executionContext) unmarshalNCreateLoadBalancerInput2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐCreateLoadBalancerInput(ctx context.Context, v interface{}) (generated.CreateLoadBalancerInput, error) {
+func (ec *executionContext) unmarshalNCreateLoadBalancerChildOriginInput2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐCreateLoadBalancerChildOriginInput(ctx context.Context, v interface{}) (*CreateLoadBalancerChildOriginInput, error) {
+	res, err := ec.unmarshalInputCreateLoadBalancerChildOriginInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNCreateLoadBalancerChildPoolInput2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐCreateLoadBalancerChildPoolInput(ctx context.Context, v interface{}) (*CreateLoadBalancerChildPoolInput, error) {
+	res, err := ec.unmarshalInputCreateLoadBalancerChildPoolInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNCreateLoadBalancerChildPortInput2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐCreateLoadBalancerChildPortInput(ctx context.Context, v interface{}) (*CreateLoadBalancerChildPortInput, error) {
+	res, err := ec.unmarshalInputCreateLoadBalancerChildPortInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNCreateLoadBalancerInput2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐCreateLoadBalancerInput(ctx context.Context, v interface{}) (CreateLoadBalancerInput, error) {
 	res, err := ec.unmarshalInputCreateLoadBalancerInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) unmarshalNCreateLoadBalancerOriginInput2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐCreateLoadBalancerOriginInput(ctx context.Context, v interface{}) (generated.CreateLoadBalancerOriginInput, error) {
+func (ec *executionContext) unmarshalNCreateLoadBalancerOriginInput2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐCreateLoadBalancerOriginInput(ctx context.Context, v interface{}) (CreateLoadBalancerOriginInput, error) {
 	res, err := ec.unmarshalInputCreateLoadBalancerOriginInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) unmarshalNCreateLoadBalancerPoolInput2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐCreateLoadBalancerPoolInput(ctx context.Context, v interface{}) (generated.CreateLoadBalancerPoolInput, error) {
+func (ec *executionContext) unmarshalNCreateLoadBalancerPoolInput2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐCreateLoadBalancerPoolInput(ctx context.Context, v interface{}) (CreateLoadBalancerPoolInput, error) {
 	res, err := ec.unmarshalInputCreateLoadBalancerPoolInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) unmarshalNCreateLoadBalancerPortInput2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐCreateLoadBalancerPortInput(ctx context.Context, v interface{}) (generated.CreateLoadBalancerPortInput, error) {
+func (ec *executionContext) unmarshalNCreateLoadBalancerPortInput2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐCreateLoadBalancerPortInput(ctx context.Context, v interface{}) (CreateLoadBalancerPortInput, error) {
 	res, err := ec.unmarshalInputCreateLoadBalancerPortInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) unmarshalNCreateLoadBalancerProviderInput2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐCreateLoadBalancerProviderInput(ctx context.Context, v interface{}) (generated.CreateLoadBalancerProviderInput, error) {
-	res, err := ec.unmarshalInputCreateLoadBalancerProviderInput(ctx, v)
+func (ec *executionContext) unmarshalNCreateLoadBalancerProviderInput2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐCreateLoadBalancerProviderInput(ctx context.Context, v interface{}) (CreateLoadBalancerProviderInput, error) {
+	res, err := ec.unmarshalInputCreateLoadBalancerProviderInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNCreateLoadBalancerWithChildrenInput2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐCreateLoadBalancerWithChildrenInput(ctx context.Context, v interface{}) (CreateLoadBalancerWithChildrenInput, error) {
+	res, err := ec.unmarshalInputCreateLoadBalancerWithChildrenInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
@@ -22910,6 +28605,27 @@ func (ec *executionContext) marshalNInt2int32(ctx context.Context, sel ast.Selec
 	return res
 }
 
+func (ec *executionContext) unmarshalNJSON2encodingᚋjsonᚐRawMessage(ctx context.Context, v interface{}) (json.RawMessage, error) {
+	res, err := entx.UnmarshalRawMessage(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNJSON2encodingᚋjsonᚐRawMessage(ctx context.Context, sel ast.SelectionSet, v json.RawMessage) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	res := entx.MarshalRawMessage(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
 func (ec *executionContext) marshalNLoadBalancer2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancer(ctx context.Context, sel ast.SelectionSet, v generated.LoadBalancer) graphql.Marshaler {
 	return ec._LoadBalancer(ctx, sel, &v)
 }
@@ -22924,6 +28640,120 @@ func (ec *executionContext) marshalNLoadBalancer2ᚖgoᚗinfratographerᚗcomᚋ
 	return ec._LoadBalancer(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNLoadBalancerApplyAction2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerApplyAction(ctx context.Context, v interface{}) (LoadBalancerApplyAction, error) {
+	var res LoadBalancerApplyAction
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNLoadBalancerApplyAction2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerApplyAction(ctx context.Context, sel ast.SelectionSet, v LoadBalancerApplyAction) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNLoadBalancerApplyChange2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerApplyChangeᚄ(ctx context.Context, sel ast.SelectionSet, v []*LoadBalancerApplyChange) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNLoadBalancerApplyChange2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerApplyChange(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNLoadBalancerApplyChange2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerApplyChange(ctx context.Context, sel ast.SelectionSet, v *LoadBalancerApplyChange) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._LoadBalancerApplyChange(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNLoadBalancerApplyPayload2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerApplyPayload(ctx context.Context, sel ast.SelectionSet, v LoadBalancerApplyPayload) graphql.Marshaler {
+	return ec._LoadBalancerApplyPayload(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNLoadBalancerApplyPayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerApplyPayload(ctx context.Context, sel ast.SelectionSet, v *LoadBalancerApplyPayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._LoadBalancerApplyPayload(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNLoadBalancerByIDsInput2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerByIDsInputᚄ(ctx context.Context, v interface{}) ([]*LoadBalancerByIDsInput, error) {
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*LoadBalancerByIDsInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNLoadBalancerByIDsInput2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerByIDsInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalNLoadBalancerByIDsInput2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerByIDsInput(ctx context.Context, v interface{}) (*LoadBalancerByIDsInput, error) {
+	res, err := ec.unmarshalInputLoadBalancerByIDsInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNLoadBalancerChange2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerChange(ctx context.Context, sel ast.SelectionSet, v LoadBalancerChange) graphql.Marshaler {
+	return ec._LoadBalancerChange(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNLoadBalancerChange2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerChange(ctx context.Context, sel ast.SelectionSet, v *LoadBalancerChange) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._LoadBalancerChange(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNLoadBalancerConnection2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerConnection(ctx context.Context, sel ast.SelectionSet, v generated.LoadBalancerConnection) graphql.Marshaler {
 	return ec._LoadBalancerConnection(ctx, sel, &v)
 }
@@ -22996,6 +28826,32 @@ func (ec *executionContext) marshalNLoadBalancerOrigin2ᚖgoᚗinfratographerᚗ
 	return ec._LoadBalancerOrigin(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNLoadBalancerOriginByIDsInput2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerOriginByIDsInputᚄ(ctx context.Context, v interface{}) ([]*LoadBalancerOriginByIDsInput, error) {
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*LoadBalancerOriginByIDsInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNLoadBalancerOriginByIDsInput2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerOriginByIDsInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalNLoadBalancerOriginByIDsInput2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerOriginByIDsInput(ctx context.Context, v interface{}) (*LoadBalancerOriginByIDsInput, error) {
+	res, err := ec.unmarshalInputLoadBalancerOriginByIDsInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNLoadBalancerOriginConnection2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerOriginConnection(ctx context.Context, sel ast.SelectionSet, v generated.LoadBalancerOriginConnection) graphql.Marshaler {
+	return ec._LoadBalancerOriginConnection(ctx, sel, &v)
+}
+
 func (ec *executionContext) marshalNLoadBalancerOriginConnection2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerOriginConnection(ctx context.Context, sel ast.SelectionSet, v *generated.LoadBalancerOriginConnection) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -23083,6 +28939,28 @@ func (ec *executionContext) marshalNLoadBalancerPool2ᚖgoᚗinfratographerᚗco
 	return ec._LoadBalancerPool(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNLoadBalancerPoolByIDsInput2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerPoolByIDsInputᚄ(ctx context.Context, v interface{}) ([]*LoadBalancerPoolByIDsInput, error) {
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*LoadBalancerPoolByIDsInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNLoadBalancerPoolByIDsInput2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerPoolByIDsInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalNLoadBalancerPoolByIDsInput2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerPoolByIDsInput(ctx context.Context, v interface{}) (*LoadBalancerPoolByIDsInput, error) {
+	res, err := ec.unmarshalInputLoadBalancerPoolByIDsInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalNLoadBalancerPoolConnection2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerPoolConnection(ctx context.Context, sel ast.SelectionSet, v generated.LoadBalancerPoolConnection) graphql.Marshaler {
 	return ec._LoadBalancerPoolConnection(ctx, sel, &v)
 }
@@ -23184,6 +29062,32 @@ func (ec *executionContext) marshalNLoadBalancerPort2ᚖgoᚗinfratographerᚗco
 	return ec._LoadBalancerPort(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNLoadBalancerPortByIDsInput2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerPortByIDsInputᚄ(ctx context.Context, v interface{}) ([]*LoadBalancerPortByIDsInput, error) {
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*LoadBalancerPortByIDsInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNLoadBalancerPortByIDsInput2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerPortByIDsInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalNLoadBalancerPortByIDsInput2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerPortByIDsInput(ctx context.Context, v interface{}) (*LoadBalancerPortByIDsInput, error) {
+	res, err := ec.unmarshalInputLoadBalancerPortByIDsInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNLoadBalancerPortConnection2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerPortConnection(ctx context.Context, sel ast.SelectionSet, v generated.LoadBalancerPortConnection) graphql.Marshaler {
+	return ec._LoadBalancerPortConnection(ctx, sel, &v)
+}
+
 func (ec *executionContext) marshalNLoadBalancerPortConnection2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerPortConnection(ctx context.Context, sel ast.SelectionSet, v *generated.LoadBalancerPortConnection) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -23271,6 +29175,28 @@ func (ec *executionContext) marshalNLoadBalancerProvider2ᚖgoᚗinfratographer
 	return ec._LoadBalancerProvider(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNLoadBalancerProviderByIDsInput2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerProviderByIDsInputᚄ(ctx context.Context, v interface{}) ([]*LoadBalancerProviderByIDsInput, error) {
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*LoadBalancerProviderByIDsInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNLoadBalancerProviderByIDsInput2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerProviderByIDsInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalNLoadBalancerProviderByIDsInput2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerProviderByIDsInput(ctx context.Context, v interface{}) (*LoadBalancerProviderByIDsInput, error) {
+	res, err := ec.unmarshalInputLoadBalancerProviderByIDsInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalNLoadBalancerProviderConnection2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerProviderConnection(ctx context.Context, sel ast.SelectionSet, v generated.LoadBalancerProviderConnection) graphql.Marshaler {
 	return ec._LoadBalancerProviderConnection(ctx, sel, &v)
 }
@@ -23348,6 +29274,25 @@ func (ec *executionContext) unmarshalNLoadBalancerProviderWhereInput2ᚖgoᚗinf
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalNLoadBalancerResyncInput2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerResyncInput(ctx context.Context, v interface{}) (LoadBalancerResyncInput, error) {
+	res, err := ec.unmarshalInputLoadBalancerResyncInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNLoadBalancerResyncPayload2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerResyncPayload(ctx context.Context, sel ast.SelectionSet, v LoadBalancerResyncPayload) graphql.Marshaler {
+	return ec._LoadBalancerResyncPayload(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNLoadBalancerResyncPayload2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerResyncPayload(ctx context.Context, sel ast.SelectionSet, v *LoadBalancerResyncPayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._LoadBalancerResyncPayload(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNLoadBalancerUpdatePayload2goᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐLoadBalancerUpdatePayload(ctx context.Context, sel ast.SelectionSet, v LoadBalancerUpdatePayload) graphql.Marshaler {
 	return ec._LoadBalancerUpdatePayload(ctx, sel, &v)
 }
@@ -23809,7 +29754,78 @@ func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel a
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 	}
-	return res
+	return res
+}
+
+func (ec *executionContext) marshalOAuditEvent2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐAuditEvent(ctx context.Context, sel ast.SelectionSet, v *generated.AuditEvent) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._AuditEvent(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOAuditEventEdge2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐAuditEventEdge(ctx context.Context, sel ast.SelectionSet, v []*generated.AuditEventEdge) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOAuditEventEdge2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐAuditEventEdge(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	return ret
+}
+
+func (ec *executionContext) marshalOAuditEventEdge2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐAuditEventEdge(ctx context.Context, sel ast.SelectionSet, v *generated.AuditEventEdge) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._AuditEventEdge(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOAuditEventFilter2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐAuditEventFilter(ctx context.Context, v interface{}) (*AuditEventFilter, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputAuditEventFilter(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalOAuditEventOrder2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐAuditEventOrder(ctx context.Context, v interface{}) (*generated.AuditEventOrder, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputAuditEventOrder(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
 func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
@@ -23838,6 +29854,66 @@ func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast
 	return res
 }
 
+func (ec *executionContext) unmarshalOCreateLoadBalancerChildOriginInput2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐCreateLoadBalancerChildOriginInputᚄ(ctx context.Context, v interface{}) ([]*CreateLoadBalancerChildOriginInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*CreateLoadBalancerChildOriginInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNCreateLoadBalancerChildOriginInput2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐCreateLoadBalancerChildOriginInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalOCreateLoadBalancerChildPoolInput2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐCreateLoadBalancerChildPoolInputᚄ(ctx context.Context, v interface{}) ([]*CreateLoadBalancerChildPoolInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*CreateLoadBalancerChildPoolInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNCreateLoadBalancerChildPoolInput2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐCreateLoadBalancerChildPoolInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalOCreateLoadBalancerChildPortInput2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐCreateLoadBalancerChildPortInputᚄ(ctx context.Context, v interface{}) ([]*CreateLoadBalancerChildPortInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*CreateLoadBalancerChildPortInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNCreateLoadBalancerChildPortInput2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋgraphapiᚐCreateLoadBalancerChildPortInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 func (ec *executionContext) unmarshalOCursor2ᚖentgoᚗioᚋcontribᚋentgqlᚐCursor(ctx context.Context, v interface{}) (*entgql.Cursor[gidx.PrefixedID], error) {
 	if v == nil {
 		return nil, nil
@@ -24016,6 +30092,47 @@ func (ec *executionContext) marshalOInt2ᚖint32(ctx context.Context, sel ast.Se
 	return res
 }
 
+func (ec *executionContext) marshalOLoadBalancer2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancer(ctx context.Context, sel ast.SelectionSet, v []*generated.LoadBalancer) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOLoadBalancer2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancer(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	return ret
+}
+
 func (ec *executionContext) marshalOLoadBalancer2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancer(ctx context.Context, sel ast.SelectionSet, v *generated.LoadBalancer) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -24079,6 +30196,47 @@ func (ec *executionContext) unmarshalOLoadBalancerOrder2ᚖgoᚗinfratographer
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalOLoadBalancerOrigin2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐOrigin(ctx context.Context, sel ast.SelectionSet, v []*generated.Origin) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOLoadBalancerOrigin2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐOrigin(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	return ret
+}
+
 func (ec *executionContext) marshalOLoadBalancerOrigin2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐOrigin(ctx context.Context, sel ast.SelectionSet, v *generated.Origin) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -24170,6 +30328,47 @@ func (ec *executionContext) unmarshalOLoadBalancerOriginWhereInput2ᚖgoᚗinfra
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalOLoadBalancerPool2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPool(ctx context.Context, sel ast.SelectionSet, v []*generated.Pool) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOLoadBalancerPool2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPool(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	return ret
+}
+
 func (ec *executionContext) marshalOLoadBalancerPool2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPoolᚄ(ctx context.Context, sel ast.SelectionSet, v []*generated.Pool) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -24391,6 +30590,47 @@ func (ec *executionContext) unmarshalOLoadBalancerPoolWhereInput2ᚖgoᚗinfrato
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalOLoadBalancerPort2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPort(ctx context.Context, sel ast.SelectionSet, v []*generated.Port) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOLoadBalancerPort2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPort(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	return ret
+}
+
 func (ec *executionContext) marshalOLoadBalancerPort2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐPortᚄ(ctx context.Context, sel ast.SelectionSet, v []*generated.Port) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -24529,6 +30769,47 @@ func (ec *executionContext) unmarshalOLoadBalancerPortWhereInput2ᚖgoᚗinfrato
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalOLoadBalancerProvider2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐProvider(ctx context.Context, sel ast.SelectionSet, v []*generated.Provider) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOLoadBalancerProvider2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐProvider(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	return ret
+}
+
 func (ec *executionContext) marshalOLoadBalancerProvider2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐProvider(ctx context.Context, sel ast.SelectionSet, v *generated.Provider) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -24584,6 +30865,14 @@ func (ec *executionContext) marshalOLoadBalancerProviderEdge2ᚖgoᚗinfratograp
 	return ec._LoadBalancerProviderEdge(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalOLoadBalancerProviderOrder2ᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerProviderOrder(ctx context.Context, v interface{}) (*generated.LoadBalancerProviderOrder, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputLoadBalancerProviderOrder(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalOLoadBalancerProviderWhereInput2ᚕᚖgoᚗinfratographerᚗcomᚋloadᚑbalancerᚑapiᚋinternalᚋentᚋgeneratedᚐLoadBalancerProviderWhereInputᚄ(ctx context.Context, v interface{}) ([]*generated.LoadBalancerProviderWhereInput, error) {
 	if v == nil {
 		return nil, nil
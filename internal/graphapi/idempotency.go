@@ -0,0 +1,232 @@
+package graphapi
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"go.infratographer.com/x/gidx"
+	"go.uber.org/zap"
+
+	"go.infratographer.com/load-balancer-api/internal/config"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/idempotencykey"
+)
+
+const (
+	// DefaultIdempotencyKeyTTL is how long an idempotency key is remembered when no TTL is configured
+	DefaultIdempotencyKeyTTL = 24 * time.Hour
+	// DefaultIdempotencyKeyLease is how long a request holds its idempotency key while it is in
+	// progress when no lease is configured. A key still in progress after its lease belongs to a
+	// request that stopped, and is claimed again by the next request using it.
+	DefaultIdempotencyKeyLease = time.Minute
+)
+
+// idempotencyClaim is a claimed idempotency key for a create mutation. A nil claim is used when
+// the request has no idempotency key, all methods are no-ops in that case.
+type idempotencyClaim struct {
+	client    *generated.Client
+	logger    *zap.SugaredLogger
+	record    *generated.IdempotencyKey
+	replay    bool
+	completed bool
+}
+
+// claimIdempotencyKey claims the key for the owner before a create is run. When the key was
+// already used with the same request the claim is a replay of the created resource. The same key
+// with a different request, or a request that is still in progress, is a conflict. A key whose
+// request stopped without completing or releasing it is claimed again once its lease expired.
+func (r *Resolver) claimIdempotencyKey(ctx context.Context, key *string, ownerID gidx.PrefixedID, operation string, input any) (*idempotencyClaim, error) {
+	if key == nil {
+		return nil, nil
+	}
+
+	if strings.TrimSpace(*key) == "" {
+		return nil, newInvalidFieldError("idempotencyKey", ErrFieldEmpty)
+	}
+
+	logger := r.logger.With("ownerID", ownerID, "operation", operation)
+
+	hash, err := requestHash(operation, input)
+	if err != nil {
+		logger.Errorw("failed to hash request", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	ttl := config.AppConfig.IdempotencyKeyTTL
+	if ttl <= 0 {
+		ttl = DefaultIdempotencyKeyTTL
+	}
+
+	lease := config.AppConfig.IdempotencyKeyLease
+	if lease <= 0 {
+		lease = DefaultIdempotencyKeyLease
+	}
+
+	// a second attempt is made when the existing key expired, was abandoned or was released in the meantime
+	for attempt := 0; attempt < 2; attempt++ {
+		now := time.Now()
+
+		rec, err := r.client.IdempotencyKey.Create().
+			SetKey(*key).
+			SetOwnerID(ownerID).
+			SetRequestHash(hash).
+			SetExpiresAt(now.Add(ttl)).
+			SetLeaseExpiresAt(now.Add(lease)).
+			Save(ctx)
+		if err == nil {
+			return &idempotencyClaim{client: r.client, logger: logger, record: rec}, nil
+		}
+
+		switch {
+		case generated.IsValidationError(err):
+			return nil, err
+		case !generated.IsConstraintError(err):
+			logger.Errorw("failed to create idempotency key", "error", err)
+			return nil, ErrInternalServerError
+		}
+
+		existing, err := r.client.IdempotencyKey.Query().
+			Where(idempotencykey.OwnerIDEQ(ownerID), idempotencykey.KeyEQ(*key)).
+			Only(ctx)
+		if err != nil {
+			if generated.IsNotFound(err) {
+				continue
+			}
+
+			logger.Errorw("failed to get idempotency key", "error", err)
+
+			return nil, ErrInternalServerError
+		}
+
+		if existing.ExpiresAt.Before(time.Now()) {
+			if err := r.client.IdempotencyKey.DeleteOne(existing).Exec(ctx); err != nil && !generated.IsNotFound(err) {
+				logger.Errorw("failed to delete expired idempotency key", "error", err)
+				return nil, ErrInternalServerError
+			}
+
+			continue
+		}
+
+		if existing.ResourceID == "" && (existing.LeaseExpiresAt == nil || existing.LeaseExpiresAt.Before(time.Now())) {
+			// the abandoned key is only removed while it is still in progress, so a key completed
+			// by a slow request in the meantime is kept. The slow request can no longer complete a
+			// removed key, which rolls back its create.
+			if _, err := r.client.IdempotencyKey.Delete().
+				Where(
+					idempotencykey.IDEQ(existing.ID),
+					idempotencykey.Or(idempotencykey.ResourceIDIsNil(), idempotencykey.ResourceIDEQ("")),
+				).
+				Exec(ctx); err != nil {
+				logger.Errorw("failed to delete abandoned idempotency key", "error", err)
+				return nil, ErrInternalServerError
+			}
+
+			logger.Warnw("claiming abandoned idempotency key", "idempotencyKeyID", existing.ID)
+
+			continue
+		}
+
+		switch {
+		case existing.RequestHash != hash:
+			return nil, ErrIdempotencyKeyReused
+		case existing.ResourceID == "":
+			return nil, ErrIdempotencyKeyInProgress
+		default:
+			return &idempotencyClaim{record: existing, replay: true, completed: true}, nil
+		}
+	}
+
+	return nil, ErrIdempotencyKeyInProgress
+}
+
+// replayID returns the ID of the resource created by the original request when the claim is a replay
+func (c *idempotencyClaim) replayID() (gidx.PrefixedID, bool) {
+	if c == nil || !c.replay {
+		return "", false
+	}
+
+	return c.record.ResourceID, true
+}
+
+// complete records the created resource on the key within the transaction creating it, so the
+// key is completed exactly when the resource is committed and repeats of the request return it
+func (c *idempotencyClaim) complete(ctx context.Context, tx *generated.Tx, id gidx.PrefixedID) error {
+	if c == nil || c.completed {
+		return nil
+	}
+
+	if err := tx.IdempotencyKey.UpdateOne(c.record).SetResourceID(id).ClearLeaseExpiresAt().Exec(ctx); err != nil {
+		return err
+	}
+
+	tx.OnCommit(func(next generated.Committer) generated.Committer {
+		return generated.CommitFunc(func(ctx context.Context, tx *generated.Tx) error {
+			if err := next.Commit(ctx, tx); err != nil {
+				return err
+			}
+
+			c.completed = true
+
+			return nil
+		})
+	})
+
+	return nil
+}
+
+// release removes the claim when the create was not committed so the request can be retried.
+// Once the create is committed the key is kept, even when work after the commit failed, as
+// releasing it would allow a duplicate.
+func (c *idempotencyClaim) release(ctx context.Context) {
+	if c == nil || c.completed {
+		return
+	}
+
+	if err := c.client.IdempotencyKey.DeleteOne(c.record).Exec(context.WithoutCancel(ctx)); err != nil {
+		c.logger.Errorw("failed to release idempotency key", "error", err)
+	}
+}
+
+// createInTx runs create in a transaction and completes the claim with the ID it returns in the
+// same transaction. Nothing is stored when either fails, so the claim can be released.
+func (r *Resolver) createInTx(ctx context.Context, claim *idempotencyClaim, create func(tx *generated.Tx) (gidx.PrefixedID, error)) error {
+	tx, err := r.client.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	id, err := create(tx)
+	if err == nil {
+		err = claim.complete(ctx, tx, id)
+	}
+
+	if err != nil {
+		if err := tx.Rollback(); err != nil {
+			r.logger.Errorw("failed to rollback transaction", "error", err)
+		}
+
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// requestHash returns the hash identifying an operation and its input
+func requestHash(operation string, input any) (string, error) {
+	b, err := json.Marshal(input)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	h.Write([]byte(operation))
+	h.Write([]byte{0})
+	h.Write(b)
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,334 @@
+package graphapi_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/permissions-api/pkg/permissions/mockpermissions"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/load-balancer-api/internal/config"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/hook"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/idempotencykey"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/loadbalancer"
+	"go.infratographer.com/load-balancer-api/internal/graphclient"
+	"go.infratographer.com/load-balancer-api/internal/testutils"
+)
+
+func TestLoadBalancerCreateIdempotency(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	prov := (&testutils.ProviderBuilder{}).MustNew(ctx)
+	ownerID := gidx.MustNewID(ownerPrefix)
+	locationID := gidx.MustNewID(locationPrefix)
+
+	input := func(name string, key string) graphclient.CreateLoadBalancerInput {
+		return graphclient.CreateLoadBalancerInput{
+			Name:           name,
+			OwnerID:        ownerID,
+			LocationID:     locationID,
+			ProviderID:     prov.ID,
+			IdempotencyKey: &key,
+		}
+	}
+
+	count := func() int {
+		return EntClient.LoadBalancer.Query().Where(loadbalancer.OwnerIDEQ(ownerID)).CountX(ctx)
+	}
+
+	t.Run("repeat returns the original load balancer", func(t *testing.T) {
+		first, err := graphTestClient().LoadBalancerCreate(ctx, input("lb-a", "key-a"))
+		require.NoError(t, err)
+
+		// a repeat must not be refused by the limit the original request counts towards
+		config.AppConfig.LoadBalancerLimit = count()
+		defer func() { config.AppConfig.LoadBalancerLimit = 0 }()
+
+		second, err := graphTestClient().LoadBalancerCreate(ctx, input("lb-a", "key-a"))
+		require.NoError(t, err)
+
+		assert.Equal(t, first.LoadBalancerCreate.LoadBalancer.ID, second.LoadBalancerCreate.LoadBalancer.ID)
+		assert.Equal(t, 1, EntClient.LoadBalancer.Query().Where(loadbalancer.NameEQ("lb-a"), loadbalancer.OwnerIDEQ(ownerID)).CountX(ctx))
+	})
+
+	t.Run("same key with a different request conflicts", func(t *testing.T) {
+		_, err := graphTestClient().LoadBalancerCreate(ctx, input("lb-b", "key-b"))
+		require.NoError(t, err)
+
+		before := count()
+
+		_, err = graphTestClient().LoadBalancerCreate(ctx, input("lb-b-renamed", "key-b"))
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "idempotency key already used with a different request")
+		assert.Equal(t, before, count())
+	})
+
+	t.Run("key is scoped to the owner", func(t *testing.T) {
+		first, err := graphTestClient().LoadBalancerCreate(ctx, input("lb-c", "key-c"))
+		require.NoError(t, err)
+
+		other := input("lb-c", "key-c")
+		other.OwnerID = gidx.MustNewID(ownerPrefix)
+
+		second, err := graphTestClient().LoadBalancerCreate(ctx, other)
+		require.NoError(t, err)
+
+		assert.NotEqual(t, first.LoadBalancerCreate.LoadBalancer.ID, second.LoadBalancerCreate.LoadBalancer.ID)
+	})
+
+	t.Run("failed request releases the key", func(t *testing.T) {
+		config.AppConfig.LoadBalancerLimit = count()
+
+		_, err := graphTestClient().LoadBalancerCreate(ctx, input("lb-d", "key-d"))
+
+		config.AppConfig.LoadBalancerLimit = 0
+
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "load balancer limit reached")
+
+		resp, err := graphTestClient().LoadBalancerCreate(ctx, input("lb-d", "key-d"))
+		require.NoError(t, err)
+		assert.Equal(t, "lb-d", resp.LoadBalancerCreate.LoadBalancer.Name)
+	})
+
+	t.Run("failed relationships roll back the create and release the key", func(t *testing.T) {
+		failingPerms := new(mockpermissions.MockPermissions)
+		failingPerms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("permissions unavailable"))
+
+		failingCtx := failingPerms.ContextWithHandler(context.Background())
+		failingCtx = context.WithValue(failingCtx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+		_, err := graphTestClient().LoadBalancerCreate(failingCtx, input("lb-h", "key-h"))
+		require.Error(t, err)
+		assert.Equal(t, 0, EntClient.LoadBalancer.Query().Where(loadbalancer.NameEQ("lb-h"), loadbalancer.OwnerIDEQ(ownerID)).CountX(ctx))
+		assert.False(t, EntClient.IdempotencyKey.Query().Where(idempotencykey.OwnerIDEQ(ownerID), idempotencykey.KeyEQ("key-h")).ExistX(ctx))
+
+		resp, err := graphTestClient().LoadBalancerCreate(ctx, input("lb-h", "key-h"))
+		require.NoError(t, err)
+		assert.Equal(t, "lb-h", resp.LoadBalancerCreate.LoadBalancer.Name)
+		assert.Equal(t, 1, EntClient.LoadBalancer.Query().Where(loadbalancer.NameEQ("lb-h"), loadbalancer.OwnerIDEQ(ownerID)).CountX(ctx))
+	})
+
+	t.Run("failed completion rolls back the create and releases the key", func(t *testing.T) {
+		var failComplete atomic.Bool
+
+		EntClient.IdempotencyKey.Use(func(next generated.Mutator) generated.Mutator {
+			return hook.IdempotencyKeyFunc(func(ctx context.Context, m *generated.IdempotencyKeyMutation) (generated.Value, error) {
+				if failComplete.Load() && m.Op().Is(generated.OpUpdateOne) {
+					return nil, errors.New("completion failed")
+				}
+
+				return next.Mutate(ctx, m)
+			})
+		})
+
+		failComplete.Store(true)
+
+		_, err := graphTestClient().LoadBalancerCreate(ctx, input("lb-i", "key-i"))
+
+		failComplete.Store(false)
+
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "internal server error")
+		assert.Equal(t, 0, EntClient.LoadBalancer.Query().Where(loadbalancer.NameEQ("lb-i"), loadbalancer.OwnerIDEQ(ownerID)).CountX(ctx))
+		assert.False(t, EntClient.IdempotencyKey.Query().Where(idempotencykey.OwnerIDEQ(ownerID), idempotencykey.KeyEQ("key-i")).ExistX(ctx))
+
+		resp, err := graphTestClient().LoadBalancerCreate(ctx, input("lb-i", "key-i"))
+		require.NoError(t, err)
+		assert.Equal(t, "lb-i", resp.LoadBalancerCreate.LoadBalancer.Name)
+	})
+
+	t.Run("request in progress conflicts", func(t *testing.T) {
+		first, err := graphTestClient().LoadBalancerCreate(ctx, input("lb-e", "key-e"))
+		require.NoError(t, err)
+
+		EntClient.IdempotencyKey.Update().
+			Where(idempotencykey.OwnerIDEQ(ownerID), idempotencykey.KeyEQ("key-e")).
+			ClearResourceID().
+			SetLeaseExpiresAt(time.Now().Add(time.Minute)).
+			ExecX(ctx)
+
+		_, err = graphTestClient().LoadBalancerCreate(ctx, input("lb-e", "key-e"))
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "request with the same idempotency key is in progress")
+
+		assert.NotEmpty(t, first.LoadBalancerCreate.LoadBalancer.ID)
+	})
+
+	t.Run("abandoned claim is claimed again once its lease expired", func(t *testing.T) {
+		// left behind by a request that stopped between claiming the key and creating the load balancer
+		EntClient.IdempotencyKey.Create().
+			SetKey("key-j").
+			SetOwnerID(ownerID).
+			SetRequestHash("stale").
+			SetExpiresAt(time.Now().Add(time.Hour)).
+			SetLeaseExpiresAt(time.Now().Add(-time.Second)).
+			ExecX(ctx)
+
+		resp, err := graphTestClient().LoadBalancerCreate(ctx, input("lb-j", "key-j"))
+		require.NoError(t, err)
+
+		id := resp.LoadBalancerCreate.LoadBalancer.ID
+
+		assert.True(t, EntClient.IdempotencyKey.Query().Where(idempotencykey.OwnerIDEQ(ownerID), idempotencykey.KeyEQ("key-j"), idempotencykey.ResourceIDEQ(id)).ExistX(ctx))
+
+		// the retry completed the key, so repeating it returns the same load balancer
+		repeat, err := graphTestClient().LoadBalancerCreate(ctx, input("lb-j", "key-j"))
+		require.NoError(t, err)
+		assert.Equal(t, id, repeat.LoadBalancerCreate.LoadBalancer.ID)
+	})
+
+	t.Run("expired key can be reused", func(t *testing.T) {
+		config.AppConfig.IdempotencyKeyTTL = time.Millisecond
+		defer func() { config.AppConfig.IdempotencyKeyTTL = 0 }()
+
+		first, err := graphTestClient().LoadBalancerCreate(ctx, input("lb-f", "key-f"))
+		require.NoError(t, err)
+
+		time.Sleep(5 * time.Millisecond)
+
+		second, err := graphTestClient().LoadBalancerCreate(ctx, input("lb-f", "key-f"))
+		require.NoError(t, err)
+
+		assert.NotEqual(t, first.LoadBalancerCreate.LoadBalancer.ID, second.LoadBalancerCreate.LoadBalancer.ID)
+	})
+
+	t.Run("empty key", func(t *testing.T) {
+		_, err := graphTestClient().LoadBalancerCreate(ctx, input("lb-g", " "))
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "idempotencyKey: must not be empty")
+	})
+}
+
+func TestCreateIdempotency(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	lb := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+	pool := (&testutils.PoolBuilder{OwnerID: lb.OwnerID}).MustNew(ctx)
+	providerOwnerID := gidx.MustNewID(ownerPrefix)
+
+	testCases := []struct {
+		TestName string
+		Create   func(ctx context.Context, key *string) (gidx.PrefixedID, error)
+	}{
+		{
+			TestName: "loadBalancerCreateWithChildren",
+			Create: func(ctx context.Context, key *string) (gidx.PrefixedID, error) {
+				resp, err := graphTestClient().LoadBalancerCreateWithChildren(ctx, graphclient.CreateLoadBalancerWithChildrenInput{
+					Name:           "lb",
+					OwnerID:        lb.OwnerID,
+					LocationID:     lb.LocationID,
+					ProviderID:     lb.ProviderID,
+					Ports:          []*graphclient.CreateLoadBalancerChildPortInput{{Number: 80}},
+					IdempotencyKey: key,
+				})
+				if err != nil {
+					return "", err
+				}
+
+				return resp.LoadBalancerCreateWithChildren.LoadBalancer.ID, nil
+			},
+		},
+		{
+			TestName: "loadBalancerPortCreate",
+			Create: func(ctx context.Context, key *string) (gidx.PrefixedID, error) {
+				resp, err := graphTestClient().LoadBalancerPortCreate(ctx, graphclient.CreateLoadBalancerPortInput{
+					Number:         8080,
+					LoadBalancerID: lb.ID,
+					IdempotencyKey: key,
+				})
+				if err != nil {
+					return "", err
+				}
+
+				return resp.LoadBalancerPortCreate.LoadBalancerPort.ID, nil
+			},
+		},
+		{
+			TestName: "loadBalancerPoolCreate",
+			Create: func(ctx context.Context, key *string) (gidx.PrefixedID, error) {
+				resp, err := graphTestClient().LoadBalancerPoolCreate(ctx, graphclient.CreateLoadBalancerPoolInput{
+					Name:           "pool",
+					Protocol:       graphclient.LoadBalancerPoolProtocolTCP,
+					OwnerID:        lb.OwnerID,
+					IdempotencyKey: key,
+				})
+				if err != nil {
+					return "", err
+				}
+
+				return resp.LoadBalancerPoolCreate.LoadBalancerPool.ID, nil
+			},
+		},
+		{
+			TestName: "loadBalancerOriginCreate",
+			Create: func(ctx context.Context, key *string) (gidx.PrefixedID, error) {
+				resp, err := graphTestClient().LoadBalancerOriginCreate(ctx, graphclient.CreateLoadBalancerOriginInput{
+					Name:           "origin",
+					Target:         "1.2.3.4",
+					PortNumber:     80,
+					PoolID:         pool.ID,
+					IdempotencyKey: key,
+				})
+				if err != nil {
+					return "", err
+				}
+
+				return resp.LoadBalancerOriginCreate.LoadBalancerOrigin.ID, nil
+			},
+		},
+		{
+			TestName: "loadBalancerProviderCreate",
+			Create: func(ctx context.Context, key *string) (gidx.PrefixedID, error) {
+				resp, err := graphTestClient().LoadBalancerProviderCreate(ctx, graphclient.CreateLoadBalancerProviderInput{
+					Name:           "provider",
+					OwnerID:        providerOwnerID,
+					IdempotencyKey: key,
+				})
+				if err != nil {
+					return "", err
+				}
+
+				return resp.LoadBalancerProviderCreate.LoadBalancerProvider.ID, nil
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			key := tt.TestName
+
+			first, err := tt.Create(ctx, &key)
+			require.NoError(t, err)
+
+			second, err := tt.Create(ctx, &key)
+			require.NoError(t, err)
+
+			assert.Equal(t, first, second)
+
+			assert.True(t, EntClient.IdempotencyKey.Query().Where(idempotencykey.KeyEQ(key), idempotencykey.ResourceIDEQ(first)).ExistX(ctx))
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package graphapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	// DefaultMaxQueryDepth is the deepest selection an operation may have when no limit is configured
+	DefaultMaxQueryDepth = 15
+	// DefaultMaxQueryComplexity is the highest complexity an operation may have when no limit is configured.
+	// It allows a page of load balancers, or a load balancer with a page of ports and their pools and
+	// origins, but not all ports, pools and origins of a load balancer without first or last.
+	DefaultMaxQueryComplexity = 1000000
+	// DefaultMaxPageSize is the largest first or last a connection may ask for when no limit is configured
+	DefaultMaxPageSize = 1000
+
+	// maxQueryCost caps the cost of a selection so deeply nested connections can't overflow it
+	maxQueryCost = math.MaxInt32
+)
+
+const (
+	limitDepth      = "depth"
+	limitComplexity = "complexity"
+	limitPageSize   = "page_size"
+)
+
+var rejectedQueries = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "loadbalancerapi",
+	Subsystem: "graphql",
+	Name:      "rejected_operations_total",
+	Help:      "Operations rejected for being over a query limit, by the limit they exceeded",
+}, []string{"limit"})
+
+// QueryLimits bounds the operations the graph api resolves. A zero value disables the limit.
+//
+// Every selected field costs one. A connection costs one plus the cost of its selections times
+// the number of nodes it may return: its first or last argument, or the maximum page size when
+// neither is given. Connections without first or last return the first page of the maximum size.
+type QueryLimits struct {
+	MaxDepth      int
+	MaxComplexity int
+	MaxPageSize   int
+}
+
+// WithQueryLimits sets the limits operations are checked against before they are resolved
+func WithQueryLimits(l QueryLimits) func(*Resolver) {
+	return func(r *Resolver) {
+		r.limits = l
+	}
+}
+
+// queryLimiter rejects operations that are over the query limits before they are resolved
+type queryLimiter struct {
+	limits QueryLimits
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+	graphql.FieldInterceptor
+} = queryLimiter{}
+
+// ExtensionName implements graphql.HandlerExtension
+func (queryLimiter) ExtensionName() string {
+	return "QueryLimits"
+}
+
+// Validate implements graphql.HandlerExtension
+func (queryLimiter) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+// MutateOperationContext implements graphql.OperationContextMutator
+func (l queryLimiter) MutateOperationContext(_ context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	if rc.Operation == nil {
+		return nil
+	}
+
+	depth, complexity, err := l.measure(rc, rc.Operation.SelectionSet)
+
+	switch {
+	case err != nil:
+		return l.reject(limitPageSize, err)
+	case l.limits.MaxDepth > 0 && depth > l.limits.MaxDepth:
+		return l.reject(limitDepth, fmt.Errorf("%w: depth %d exceeds the limit of %d", ErrQueryTooDeep, depth, l.limits.MaxDepth))
+	case l.limits.MaxComplexity > 0 && complexity > l.limits.MaxComplexity:
+		return l.reject(limitComplexity, fmt.Errorf("%w: complexity %d exceeds the limit of %d", ErrQueryTooComplex, complexity, l.limits.MaxComplexity))
+	}
+
+	return nil
+}
+
+// InterceptField implements graphql.FieldInterceptor, connections without first or last are
+// resolved with first set to the maximum page size
+func (l queryLimiter) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+
+	if l.limits.MaxPageSize > 0 && fc != nil && isConnection(fc.Field.Definition) {
+		first, _ := fc.Args["first"].(*int)
+		last, _ := fc.Args["last"].(*int)
+
+		if first == nil && last == nil {
+			size := l.limits.MaxPageSize
+			fc.Args["first"] = &size
+		}
+	}
+
+	return next(ctx)
+}
+
+func (queryLimiter) reject(limit string, err error) *gqlerror.Error {
+	rejectedQueries.WithLabelValues(limit).Inc()
+
+	return &gqlerror.Error{Message: err.Error(), Err: err}
+}
+
+// measure returns the depth and complexity of the selections. It fails when a connection asks for
+// more nodes than the maximum page size.
+func (l queryLimiter) measure(rc *graphql.OperationContext, sel ast.SelectionSet) (int, int, error) {
+	depth, complexity := 0, 0
+
+	for _, f := range graphql.CollectFields(rc, sel, nil) {
+		// introspection and __typename are free
+		if strings.HasPrefix(f.Name, "__") {
+			continue
+		}
+
+		childDepth, childComplexity, err := l.measure(rc, f.Selections)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		depth = max(depth, childDepth+1)
+
+		if isConnection(f.Definition) {
+			size, err := l.pageSize(f, rc.Variables)
+			if err != nil {
+				return 0, 0, err
+			}
+
+			childComplexity = min(childComplexity*size, maxQueryCost)
+		}
+
+		complexity = min(complexity+childComplexity+1, maxQueryCost)
+	}
+
+	return depth, complexity, nil
+}
+
+// isConnection returns true when the field returns a connection
+func isConnection(def *ast.FieldDefinition) bool {
+	return def != nil && strings.HasSuffix(def.Type.Name(), "Connection")
+}
+
+// pageSize returns the number of nodes the connection may return
+func (l queryLimiter) pageSize(f graphql.CollectedField, vars map[string]interface{}) (int, error) {
+	args := f.ArgumentMap(vars)
+	size := -1
+
+	for _, name := range []string{"first", "last"} {
+		n, ok := intArgument(args[name])
+		if !ok {
+			continue
+		}
+
+		if l.limits.MaxPageSize > 0 && n > l.limits.MaxPageSize {
+			return 0, fmt.Errorf("%w: %s of %s is %d, the limit is %d", ErrPageSizeTooLarge, name, f.Alias, n, l.limits.MaxPageSize)
+		}
+
+		size = max(size, n)
+	}
+
+	switch {
+	case size >= 0:
+		return size, nil
+	case l.limits.MaxPageSize > 0:
+		return l.limits.MaxPageSize, nil
+	default:
+		return 1, nil
+	}
+}
+
+// intArgument returns the value of an Int argument, literals are parsed as int64 and variables
+// are decoded from the request as json numbers
+func intArgument(v interface{}) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case int64:
+		return int(min(n, maxQueryCost)), true
+	case json.Number:
+		i, err := n.Int64()
+		if err != nil {
+			return 0, false
+		}
+
+		return int(min(i, maxQueryCost)), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,301 @@
+package graphapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	gqlclient "github.com/99designs/gqlgen/client"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/permissions-api/pkg/permissions/mockpermissions"
+	"go.uber.org/zap"
+
+	"go.infratographer.com/load-balancer-api/internal/graphapi"
+	"go.infratographer.com/load-balancer-api/internal/testutils"
+)
+
+// rejectedOperations returns the number of operations rejected for exceeding the given limit
+func rejectedOperations(t *testing.T, limit string) float64 {
+	t.Helper()
+
+	families, err := prometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+
+	for _, mf := range families {
+		if mf.GetName() != "loadbalancerapi_graphql_rejected_operations_total" {
+			continue
+		}
+
+		for _, m := range mf.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "limit" && l.GetValue() == limit {
+					return m.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+
+	return 0
+}
+
+func TestQueryLimits(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	lb := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+
+	h := graphapi.NewResolver(EntClient, zap.NewNop().Sugar(), graphapi.WithQueryLimits(graphapi.QueryLimits{
+		MaxDepth:      5,
+		MaxComplexity: 20,
+		MaxPageSize:   10,
+	})).Handler(false).Handler()
+
+	// the permissions middleware is not part of the handler, so the checker is set on the request
+	withChecker := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), permissions.CheckerCtxKey, permissions.DefaultAllowChecker)))
+	})
+
+	testCases := []struct {
+		TestName  string
+		Query     string
+		Variables map[string]interface{}
+		Limit     string
+		errorMsg  string
+	}{
+		{
+			TestName: "within limits",
+			Query:    `query($id: ID!) { loadBalancer(id: $id) { id ports(first: 5) { edges { node { id } } } } }`,
+		},
+		{
+			TestName: "introspection is not counted",
+			Query:    `query($id: ID!) { __typename loadBalancer(id: $id) { __typename id } }`,
+		},
+		{
+			TestName: "too deep",
+			Query:    `query($id: ID!) { loadBalancer(id: $id) { ports(first: 1) { edges { node { pools { id } } } } } }`,
+			Limit:    "depth",
+			errorMsg: graphapi.ErrQueryTooDeep.Error() + ": depth 6 exceeds the limit of 5",
+		},
+		{
+			TestName: "too complex",
+			Query:    `query($id: ID!) { loadBalancer(id: $id) { id ports(first: 10) { edges { node { id } } } } }`,
+			Limit:    "complexity",
+			errorMsg: graphapi.ErrQueryTooComplex.Error() + ": complexity 33 exceeds the limit of 20",
+		},
+		{
+			TestName: "connections without first or last cost a full page",
+			Query:    `query($id: ID!) { loadBalancer(id: $id) { ports { edges { node { id } } } } }`,
+			Limit:    "complexity",
+			errorMsg: graphapi.ErrQueryTooComplex.Error(),
+		},
+		{
+			TestName: "page size too large",
+			Query:    `query($id: ID!) { loadBalancer(id: $id) { ports(first: 11) { edges { node { id } } } } }`,
+			Limit:    "page_size",
+			errorMsg: graphapi.ErrPageSizeTooLarge.Error() + ": first of ports is 11, the limit is 10",
+		},
+		{
+			TestName:  "page size too large from a variable",
+			Query:     `query($id: ID!, $last: Int) { loadBalancer(id: $id) { ports(last: $last) { edges { node { id } } } } }`,
+			Variables: map[string]interface{}{"last": 100},
+			Limit:     "page_size",
+			errorMsg:  graphapi.ErrPageSizeTooLarge.Error() + ": last of ports is 100, the limit is 10",
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+
+		t.Run(tt.TestName, func(t *testing.T) {
+			rejected := rejectedOperations(t, tt.Limit)
+
+			opts := []gqlclient.Option{gqlclient.Var("id", lb.ID)}
+			for name, value := range tt.Variables {
+				opts = append(opts, gqlclient.Var(name, value))
+			}
+
+			resp, err := gqlclient.New(withChecker).RawPost(tt.Query, opts...)
+			require.NoError(t, err)
+
+			if tt.errorMsg == "" {
+				assert.Empty(t, string(resp.Errors))
+				assert.NotNil(t, resp.Data)
+
+				return
+			}
+
+			var errs []struct {
+				Message    string
+				Extensions map[string]interface{}
+			}
+
+			require.NoError(t, json.Unmarshal(resp.Errors, &errs))
+			require.Len(t, errs, 1)
+
+			assert.Contains(t, errs[0].Message, tt.errorMsg)
+			assert.Equal(t, graphapi.ErrCodeQueryLimitExceeded, errs[0].Extensions["code"])
+			assert.Nil(t, resp.Data)
+
+			assert.Equal(t, rejected+1, rejectedOperations(t, tt.Limit))
+		})
+	}
+}
+
+func TestQueryLimits_Defaults(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	lb := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+
+	h := graphapi.NewResolver(EntClient, zap.NewNop().Sugar(), graphapi.WithQueryLimits(graphapi.QueryLimits{
+		MaxDepth:      graphapi.DefaultMaxQueryDepth,
+		MaxComplexity: graphapi.DefaultMaxQueryComplexity,
+		MaxPageSize:   graphapi.DefaultMaxPageSize,
+	})).Handler(false).Handler()
+
+	// the permissions middleware is not part of the handler, so the checker is set on the request
+	withChecker := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), permissions.CheckerCtxKey, permissions.DefaultAllowChecker)))
+	})
+
+	testCases := []struct {
+		TestName string
+		Query    string
+		errorMsg string
+	}{
+		{
+			TestName: "all ports, pools and origins of a load balancer",
+			Query: `query($id: ID!) { loadBalancer(id: $id) { ports { edges { node { id pools { id origins {
+				edges { node { id target portNumber } } } } } } } } }`,
+			errorMsg: graphapi.ErrQueryTooComplex.Error(),
+		},
+		{
+			TestName: "a page of ports, pools and origins of a load balancer",
+			Query: `query($id: ID!) { loadBalancer(id: $id) { ports(first: 100) { edges { node { id pools { id origins(first: 100) {
+				edges { node { id target portNumber } } } } } } } } }`,
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+
+		t.Run(tt.TestName, func(t *testing.T) {
+			resp, err := gqlclient.New(withChecker).RawPost(tt.Query, gqlclient.Var("id", lb.ID))
+			require.NoError(t, err)
+
+			if tt.errorMsg == "" {
+				assert.Empty(t, string(resp.Errors))
+				assert.NotNil(t, resp.Data)
+
+				return
+			}
+
+			var errs []struct {
+				Message    string
+				Extensions map[string]interface{}
+			}
+
+			require.NoError(t, json.Unmarshal(resp.Errors, &errs))
+			require.Len(t, errs, 1)
+
+			assert.Contains(t, errs[0].Message, tt.errorMsg)
+			assert.Equal(t, graphapi.ErrCodeQueryLimitExceeded, errs[0].Extensions["code"])
+		})
+	}
+}
+
+func TestQueryLimits_DefaultPageSize(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	lb := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+
+	for i := 0; i < 3; i++ {
+		(&testutils.PortBuilder{LoadBalancerID: lb.ID}).MustNew(ctx)
+	}
+
+	h := graphapi.NewResolver(EntClient, zap.NewNop().Sugar(), graphapi.WithQueryLimits(graphapi.QueryLimits{
+		MaxPageSize: 2,
+	})).Handler(false).Handler()
+
+	// the permissions middleware is not part of the handler, so the checker is set on the request
+	withChecker := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), permissions.CheckerCtxKey, permissions.DefaultAllowChecker)))
+	})
+
+	testCases := []struct {
+		TestName    string
+		Query       string
+		edges       int
+		hasNextPage bool
+	}{
+		{
+			TestName:    "connections without first or last return a page of the maximum size",
+			Query:       `query($id: ID!) { loadBalancer(id: $id) { ports { totalCount pageInfo { hasNextPage } edges { node { id } } } } }`,
+			edges:       2,
+			hasNextPage: true,
+		},
+		{
+			TestName:    "history connections without first or last return a page of the maximum size",
+			Query:       `query($id: ID!) { loadBalancer(id: $id) { ports(includeDeleted: true) { totalCount pageInfo { hasNextPage } edges { node { id } } } } }`,
+			edges:       2,
+			hasNextPage: true,
+		},
+		{
+			TestName: "last is kept",
+			Query:    `query($id: ID!) { loadBalancer(id: $id) { ports(last: 1) { totalCount pageInfo { hasNextPage } edges { node { id } } } } }`,
+			edges:    1,
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+
+		t.Run(tt.TestName, func(t *testing.T) {
+			var resp struct {
+				LoadBalancer struct {
+					Ports struct {
+						TotalCount int
+						PageInfo   struct {
+							HasNextPage bool
+						}
+						Edges []struct {
+							Node struct {
+								ID string
+							}
+						}
+					}
+				}
+			}
+
+			require.NoError(t, gqlclient.New(withChecker).Post(tt.Query, &resp, gqlclient.Var("id", lb.ID)))
+
+			assert.Len(t, resp.LoadBalancer.Ports.Edges, tt.edges)
+			assert.Equal(t, tt.hasNextPage, resp.LoadBalancer.Ports.PageInfo.HasNextPage)
+			assert.Equal(t, 3, resp.LoadBalancer.Ports.TotalCount)
+		})
+	}
+}
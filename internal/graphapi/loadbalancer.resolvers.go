@@ -7,6 +7,8 @@ package graphapi
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"strings"
 
 	"go.infratographer.com/load-balancer-api/internal/config"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated"
@@ -22,8 +24,8 @@ import (
 )
 
 // LoadBalancerCreate is the resolver for the loadBalancerCreate field.
-func (r *mutationResolver) LoadBalancerCreate(ctx context.Context, input generated.CreateLoadBalancerInput) (*LoadBalancerCreatePayload, error) {
-	if err := permissions.CheckAccess(ctx, input.OwnerID, actionLoadBalancerCreate); err != nil {
+func (r *mutationResolver) LoadBalancerCreate(ctx context.Context, input CreateLoadBalancerInput) (*LoadBalancerCreatePayload, error) {
+	if err := checkAccess(ctx, input.OwnerID, actionLoadBalancerCreate); err != nil {
 		return nil, err
 	}
 
@@ -41,6 +43,22 @@ func (r *mutationResolver) LoadBalancerCreate(ctx context.Context, input generat
 		return nil, newInvalidFieldError("locationID", err)
 	}
 
+	claim, err := r.claimIdempotencyKey(ctx, input.IdempotencyKey, input.OwnerID, "loadBalancerCreate", input)
+	if err != nil {
+		return nil, err
+	}
+
+	if id, ok := claim.replayID(); ok {
+		lb, err := r.client.LoadBalancer.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		return &LoadBalancerCreatePayload{LoadBalancer: lb}, nil
+	}
+
+	defer claim.release(ctx)
+
 	if config.AppConfig.LoadBalancerLimit > 0 {
 		count, err := r.client.LoadBalancer.Query().Where(loadbalancer.OwnerIDEQ(input.OwnerID)).Count(ctx)
 		if err != nil {
@@ -52,7 +70,18 @@ func (r *mutationResolver) LoadBalancerCreate(ctx context.Context, input generat
 		}
 	}
 
-	lb, err := r.client.LoadBalancer.Create().SetInput(input).Save(ctx)
+	var lb *generated.LoadBalancer
+
+	err = r.createInTx(ctx, claim, func(tx *generated.Tx) (gidx.PrefixedID, error) {
+		var err error
+
+		lb, err = tx.LoadBalancer.Create().SetInput(input.CreateLoadBalancerInput).Save(ctx)
+		if err != nil {
+			return "", err
+		}
+
+		return lb.ID, nil
+	})
 	if err != nil {
 		if generated.IsValidationError(err) {
 			return nil, err
@@ -62,6 +91,9 @@ func (r *mutationResolver) LoadBalancerCreate(ctx context.Context, input generat
 		return nil, ErrInternalServerError
 	}
 
+	// detach the load balancer from the committed transaction so edges can still be resolved
+	lb = lb.Unwrap()
+
 	status := &metadata.LoadBalancerStatus{State: metadata.LoadBalancerStateCreating}
 	if err := r.LoadBalancerStatusUpdate(ctx, lb.ID, status); err != nil {
 		r.logger.Errorw("failed to update loadbalancer metadata status", "error", err)
@@ -70,8 +102,122 @@ func (r *mutationResolver) LoadBalancerCreate(ctx context.Context, input generat
 	return &LoadBalancerCreatePayload{LoadBalancer: lb}, nil
 }
 
+// LoadBalancerCreateWithChildren is the resolver for the loadBalancerCreateWithChildren field.
+func (r *mutationResolver) LoadBalancerCreateWithChildren(ctx context.Context, input CreateLoadBalancerWithChildrenInput) (*LoadBalancerCreatePayload, error) {
+	logger := r.logger.With("ownerID", input.OwnerID)
+
+	input.Name = sanitizeField(input.Name)
+
+	if err := validateGidx(input.OwnerID); err != nil {
+		return nil, newInvalidFieldError("ownerID", err)
+	}
+
+	if err := validateGidx(input.ProviderID); err != nil {
+		return nil, newInvalidFieldError("providerID", err)
+	}
+
+	if err := validateGidx(input.LocationID); err != nil {
+		return nil, newInvalidFieldError("locationID", err)
+	}
+
+	if err := checkAccess(ctx, input.OwnerID, actionLoadBalancerCreate); err != nil {
+		return nil, err
+	}
+
+	// pools are checked on the owner like the load balancer. Ports and origins are checked on
+	// their load balancer and pool, which are created here too, so they are covered by the
+	// create actions of their parents.
+	for _, p := range input.Ports {
+		if len(p.Pools) == 0 {
+			continue
+		}
+
+		if err := checkAccess(ctx, input.OwnerID, actionLoadBalancerPoolCreate); err != nil {
+			return nil, err
+		}
+
+		break
+	}
+
+	claim, err := r.claimIdempotencyKey(ctx, input.IdempotencyKey, input.OwnerID, "loadBalancerCreateWithChildren", input)
+	if err != nil {
+		return nil, err
+	}
+
+	if id, ok := claim.replayID(); ok {
+		lb, err := r.client.LoadBalancer.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		return &LoadBalancerCreatePayload{LoadBalancer: lb}, nil
+	}
+
+	defer claim.release(ctx)
+
+	if config.AppConfig.LoadBalancerLimit > 0 {
+		count, err := r.client.LoadBalancer.Query().Where(loadbalancer.OwnerIDEQ(input.OwnerID)).Count(ctx)
+		if err != nil {
+			logger.Errorw("failed to query loadbalancer count", "error", err)
+		}
+
+		if count >= config.AppConfig.LoadBalancerLimit {
+			return nil, ErrLoadBalancerLimitReached
+		}
+	}
+
+	tx, err := r.client.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		logger.Errorw("failed to begin transaction", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	lb, err := createLoadBalancerWithChildren(ctx, tx, input)
+	if err == nil {
+		err = claim.complete(ctx, tx, lb.ID)
+	}
+
+	if err != nil {
+		logger.Debugw("rolling back transaction")
+
+		if err := tx.Rollback(); err != nil {
+			logger.Errorw("failed to rollback transaction", "error", err)
+		}
+
+		var fieldErr *ErrInvalidField
+
+		switch {
+		case generated.IsConstraintError(err) && strings.Contains(err.Error(), "number"):
+			return nil, ErrPortNumberInUse
+		case generated.IsValidationError(err), errors.As(err, &fieldErr):
+			return nil, err
+		default:
+			logger.Errorw("failed to create loadbalancer with children", "error", err)
+			return nil, ErrInternalServerError
+		}
+	}
+
+	// events for the created entities are sent once the transaction is committed
+	logger.Debugw("committing transaction")
+
+	if err := tx.Commit(); err != nil {
+		logger.Errorw("failed to commit transaction", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	// detach the load balancer from the committed transaction so edges can still be resolved
+	lb = lb.Unwrap()
+
+	status := &metadata.LoadBalancerStatus{State: metadata.LoadBalancerStateCreating}
+	if err := r.LoadBalancerStatusUpdate(ctx, lb.ID, status); err != nil {
+		logger.Errorw("failed to update loadbalancer metadata status", "error", err, "loadbalancerID", lb.ID)
+	}
+
+	return &LoadBalancerCreatePayload{LoadBalancer: lb}, nil
+}
+
 // LoadBalancerUpdate is the resolver for the loadBalancerUpdate field.
-func (r *mutationResolver) LoadBalancerUpdate(ctx context.Context, id gidx.PrefixedID, input generated.UpdateLoadBalancerInput) (*LoadBalancerUpdatePayload, error) {
+func (r *mutationResolver) LoadBalancerUpdate(ctx context.Context, id gidx.PrefixedID, input generated.UpdateLoadBalancerInput, expectedVersion *int) (*LoadBalancerUpdatePayload, error) {
 	logger := r.logger.With("loadbalancerID", id.String())
 
 	if err := validateGidx(id); err != nil {
@@ -104,18 +250,30 @@ func (r *mutationResolver) LoadBalancerUpdate(ctx context.Context, id gidx.Prefi
 		return nil, ErrInternalServerError
 	}
 
-	if err := permissions.CheckAccess(ctx, lb.OwnerID, actionLoadBalancerUpdate); err != nil {
+	if err := checkAccess(ctx, lb.OwnerID, actionLoadBalancerUpdate); err != nil {
 		return nil, err
 	}
 
-	lb, err = lb.Update().SetInput(input).Save(ctx)
+	if err := validateVersion(expectedVersion, lb.Version); err != nil {
+		return nil, err
+	}
+
+	update := lb.Update().SetInput(input)
+	if expectedVersion != nil {
+		update.Where(loadbalancer.VersionEQ(*expectedVersion))
+	}
+
+	lb, err = update.Save(ctx)
 	if err != nil {
-		if generated.IsValidationError(err) {
+		switch {
+		case generated.IsValidationError(err):
 			return nil, err
+		case generated.IsNotFound(err):
+			return nil, ErrVersionMismatch
+		default:
+			logger.Errorw("failed to update loadbalancer", "error", err)
+			return nil, ErrInternalServerError
 		}
-
-		logger.Errorw("failed to update loadbalancer", "error", err)
-		return nil, ErrInternalServerError
 	}
 
 	status := &metadata.LoadBalancerStatus{State: metadata.LoadBalancerStateUpdating}
@@ -127,7 +285,7 @@ func (r *mutationResolver) LoadBalancerUpdate(ctx context.Context, id gidx.Prefi
 }
 
 // LoadBalancerDelete is the resolver for the loadBalancerDelete field.
-func (r *mutationResolver) LoadBalancerDelete(ctx context.Context, id gidx.PrefixedID) (*LoadBalancerDeletePayload, error) {
+func (r *mutationResolver) LoadBalancerDelete(ctx context.Context, id gidx.PrefixedID, expectedVersion *int) (*LoadBalancerDeletePayload, error) {
 	logger := r.logger.With("loadbalancerID", id.String())
 
 	if err := validateGidx(id); err != nil {
@@ -144,7 +302,11 @@ func (r *mutationResolver) LoadBalancerDelete(ctx context.Context, id gidx.Prefi
 		return nil, ErrInternalServerError
 	}
 
-	if err := permissions.CheckAccess(ctx, lb.OwnerID, actionLoadBalancerDelete); err != nil {
+	if err := checkAccess(ctx, lb.OwnerID, actionLoadBalancerDelete); err != nil {
+		return nil, err
+	}
+
+	if err := validateVersion(expectedVersion, lb.Version); err != nil {
 		return nil, err
 	}
 
@@ -154,21 +316,7 @@ func (r *mutationResolver) LoadBalancerDelete(ctx context.Context, id gidx.Prefi
 		return nil, ErrInternalServerError
 	}
 
-	defer func() {
-		if err != nil {
-			logger.Debugw("rolling back transaction")
-			if err := tx.Rollback(); err != nil {
-				logger.Errorw("failed to rollback transaction", "error", err)
-			}
-
-			return
-		}
-
-		logger.Debugw("committing transaction")
-		if err := tx.Commit(); err != nil {
-			logger.Errorw("failed to commit transaction", "error", err)
-		}
-	}()
+	defer tx.Rollback()
 
 	// cleanup ports associated with loadbalancer
 	ports, err := tx.Port.Query().Where(predicate.Port(port.LoadBalancerIDEQ(id))).All(ctx)
@@ -185,11 +333,26 @@ func (r *mutationResolver) LoadBalancerDelete(ctx context.Context, id gidx.Prefi
 	}
 
 	// delete loadbalancer
-	if err = tx.LoadBalancer.DeleteOneID(id).Exec(ctx); err != nil {
+	del := tx.LoadBalancer.DeleteOneID(id)
+	if expectedVersion != nil {
+		del.Where(loadbalancer.VersionEQ(*expectedVersion))
+	}
+
+	if err = del.Exec(ctx); err != nil {
+		if generated.IsNotFound(err) {
+			return nil, ErrVersionMismatch
+		}
+
 		logger.Errorw("failed to delete loadbalancer", "error", err)
 		return nil, ErrInternalServerError
 	}
 
+	logger.Debugw("committing transaction")
+	if err := tx.Commit(); err != nil {
+		logger.Errorw("failed to commit transaction", "error", err)
+		return nil, ErrInternalServerError
+	}
+
 	status := &metadata.LoadBalancerStatus{State: metadata.LoadBalancerStateTerminating}
 	if err := r.LoadBalancerStatusUpdate(ctx, id, status); err != nil {
 		logger.Errorw("failed to update loadbalancer metadata status", "error", err)
@@ -229,7 +392,7 @@ func (r *queryResolver) LoadBalancer(ctx context.Context, id gidx.PrefixedID) (*
 		return nil, ErrInternalServerError
 	}
 
-	if err := permissions.CheckAccess(ctx, lb.OwnerID, actionLoadBalancerGet); err != nil {
+	if err := checkAccess(ctx, lb.OwnerID, actionLoadBalancerGet); err != nil {
 		return nil, err
 	}
 
@@ -256,9 +419,58 @@ func (r *queryResolver) LoadBalancerHistory(ctx context.Context, id gidx.Prefixe
 		return nil, ErrInternalServerError
 	}
 
-	if err := permissions.CheckAccess(ctx, lb.OwnerID, actionLoadBalancerGetHistory); err != nil {
+	if err := checkAccess(ctx, lb.OwnerID, actionLoadBalancerGetHistory); err != nil {
 		return nil, err
 	}
 
 	return lb, nil
 }
+
+// LoadBalancerChanged is the resolver for the loadBalancerChanged field.
+func (r *subscriptionResolver) LoadBalancerChanged(ctx context.Context, id gidx.PrefixedID) (<-chan *LoadBalancerChange, error) {
+	logger := r.logger.With("loadbalancerID", id.String())
+
+	// check gidx format
+	if err := validateGidx(id); err != nil {
+		return nil, newInvalidFieldError("id", err)
+	}
+
+	lb, err := r.client.LoadBalancer.Get(ctx, id)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		logger.Errorw("failed to get loadbalancer", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	if err := checkAccess(ctx, lb.OwnerID, actionLoadBalancerGet); err != nil {
+		return nil, err
+	}
+
+	return r.watchLoadBalancers(ctx, func(lb *generated.LoadBalancer) bool {
+		return lb.ID == id
+	})
+}
+
+// OwnerLoadBalancersChanged is the resolver for the ownerLoadBalancersChanged field.
+func (r *subscriptionResolver) OwnerLoadBalancersChanged(ctx context.Context, ownerID gidx.PrefixedID) (<-chan *LoadBalancerChange, error) {
+	// check gidx format
+	if err := validateGidx(ownerID); err != nil {
+		return nil, newInvalidFieldError("ownerID", err)
+	}
+
+	if err := checkAccess(ctx, ownerID, actionLoadBalancerGet); err != nil {
+		return nil, err
+	}
+
+	return r.watchLoadBalancers(ctx, func(lb *generated.LoadBalancer) bool {
+		return lb.OwnerID == ownerID
+	})
+}
+
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
+
+type subscriptionResolver struct{ *Resolver }
@@ -14,6 +14,8 @@ import (
 
 	"go.infratographer.com/load-balancer-api/internal/config"
 	ent "go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/loadbalancer"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/pool"
 	"go.infratographer.com/load-balancer-api/internal/graphclient"
 	"go.infratographer.com/load-balancer-api/internal/testutils"
 )
@@ -238,6 +240,197 @@ func TestCreate_loadBalancer_limit(t *testing.T) {
 	}
 }
 
+func TestCreate_loadBalancerWithChildren(t *testing.T) {
+	config.AppConfig.RestrictedPorts = []int{1234}
+
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	prov := (&testutils.ProviderBuilder{}).MustNew(ctx)
+	locationID := gidx.MustNewID(locationPrefix)
+	name := gofakeit.DomainName()
+
+	ports := []*graphclient.CreateLoadBalancerChildPortInput{
+		{
+			Number: 443,
+			Name:   newString("https"),
+			Pools: []*graphclient.CreateLoadBalancerChildPoolInput{
+				{
+					Name:     "pool-https",
+					Protocol: graphclient.LoadBalancerPoolProtocolTCP,
+					Origins: []*graphclient.CreateLoadBalancerChildOriginInput{
+						{Name: "origin-a", Target: "1.2.3.4", PortNumber: 8443, Weight: newInt64(50)},
+						{Name: "origin-b", Target: "1.2.3.5", PortNumber: 8443},
+					},
+				},
+			},
+		},
+		{
+			Number: 80,
+			Name:   newString("http"),
+		},
+	}
+
+	testCases := []struct {
+		TestName string
+		Input    graphclient.CreateLoadBalancerWithChildrenInput
+		Checker  permissions.Checker
+		errorMsg string
+	}{
+		{
+			TestName: "creates loadbalancer with ports, pools and origins",
+			Input:    graphclient.CreateLoadBalancerWithChildrenInput{Name: name, ProviderID: prov.ID, OwnerID: gidx.MustNewID(ownerPrefix), LocationID: locationID, Ports: ports},
+		},
+		{
+			TestName: "fails to create loadbalancer with duplicate port numbers",
+			Input: graphclient.CreateLoadBalancerWithChildrenInput{
+				Name:       name,
+				ProviderID: prov.ID,
+				OwnerID:    gidx.MustNewID(ownerPrefix),
+				LocationID: locationID,
+				Ports: []*graphclient.CreateLoadBalancerChildPortInput{
+					{Number: 22},
+					{Number: 22},
+				},
+			},
+			errorMsg: "port number already in use",
+		},
+		{
+			TestName: "fails to create loadbalancer with restricted port number",
+			Input: graphclient.CreateLoadBalancerWithChildrenInput{
+				Name:       name,
+				ProviderID: prov.ID,
+				OwnerID:    gidx.MustNewID(ownerPrefix),
+				LocationID: locationID,
+				Ports:      []*graphclient.CreateLoadBalancerChildPortInput{{Number: 1234}},
+			},
+			errorMsg: "port number restricted",
+		},
+		{
+			TestName: "fails to create loadbalancer with invalid origin",
+			Input: graphclient.CreateLoadBalancerWithChildrenInput{
+				Name:       name,
+				ProviderID: prov.ID,
+				OwnerID:    gidx.MustNewID(ownerPrefix),
+				LocationID: locationID,
+				Ports: []*graphclient.CreateLoadBalancerChildPortInput{
+					{
+						Number: 443,
+						Pools: []*graphclient.CreateLoadBalancerChildPoolInput{
+							{
+								Name:     "pool",
+								Protocol: graphclient.LoadBalancerPoolProtocolTCP,
+								Origins: []*graphclient.CreateLoadBalancerChildOriginInput{
+									{Name: "origin", Target: "not-an-ip", PortNumber: 8443},
+								},
+							},
+						},
+					},
+				},
+			},
+			errorMsg: "invalid ip address",
+		},
+		{
+			TestName: "fails to create loadbalancer with denied origin target",
+			Input: graphclient.CreateLoadBalancerWithChildrenInput{
+				Name:       name,
+				ProviderID: prov.ID,
+				OwnerID:    gidx.MustNewID(ownerPrefix),
+				LocationID: locationID,
+				Ports: []*graphclient.CreateLoadBalancerChildPortInput{
+					{
+						Number: 443,
+						Pools: []*graphclient.CreateLoadBalancerChildPoolInput{
+							{
+								Name:     "pool",
+								Protocol: graphclient.LoadBalancerPoolProtocolTCP,
+								Origins: []*graphclient.CreateLoadBalancerChildOriginInput{
+									{Name: "origin", Target: "127.0.0.1", PortNumber: 8443},
+								},
+							},
+						},
+					},
+				},
+			},
+			errorMsg: "127.0.0.1 is within 127.0.0.0/8",
+		},
+		{
+			TestName: "fails to create loadbalancer with empty ownerID",
+			Input:    graphclient.CreateLoadBalancerWithChildrenInput{Name: name, ProviderID: prov.ID, OwnerID: "", LocationID: locationID},
+			errorMsg: "must not be empty",
+		},
+		{
+			TestName: "fails to create loadbalancer without pool create permission",
+			Input:    graphclient.CreateLoadBalancerWithChildrenInput{Name: name, ProviderID: prov.ID, OwnerID: gidx.MustNewID(ownerPrefix), LocationID: locationID, Ports: ports},
+			Checker:  denyActionChecker("loadbalancerpool_create"),
+			errorMsg: "subject doesn't have access",
+		},
+		{
+			TestName: "creates loadbalancer with children using the documented policy",
+			Input:    graphclient.CreateLoadBalancerWithChildrenInput{Name: name, ProviderID: prov.ID, OwnerID: gidx.MustNewID(ownerPrefix), LocationID: locationID, Ports: ports},
+			Checker:  documentedPolicyChecker(),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			tt := tt
+
+			t.Parallel()
+
+			reqCtx := ctx
+			if tt.Checker != nil {
+				reqCtx = context.WithValue(ctx, permissions.CheckerCtxKey, tt.Checker)
+			}
+
+			resp, err := graphTestClient().LoadBalancerCreateWithChildren(reqCtx, tt.Input)
+
+			if tt.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.errorMsg)
+				assert.Nil(t, resp)
+
+				if tt.Input.OwnerID != "" {
+					// nothing should be left behind when the creation fails
+					count := testutils.EntClient.LoadBalancer.Query().Where(loadbalancer.OwnerID(tt.Input.OwnerID)).CountX(ctx)
+					assert.Zero(t, count)
+				}
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+
+			createdLB := resp.LoadBalancerCreateWithChildren.LoadBalancer
+			assert.Equal(t, name, createdLB.Name)
+			assert.Equal(t, tt.Input.OwnerID, createdLB.Owner.ID)
+			require.Len(t, createdLB.Ports.Edges, 2)
+
+			for _, edge := range createdLB.Ports.Edges {
+				if edge.Node.Number != 443 {
+					assert.Empty(t, edge.Node.Pools)
+
+					continue
+				}
+
+				require.Len(t, edge.Node.Pools, 1)
+				assert.Equal(t, "pool-https", edge.Node.Pools[0].Name)
+				assert.Len(t, edge.Node.Pools[0].Origins.Edges, 2)
+			}
+
+			pools := testutils.EntClient.Pool.Query().Where(pool.OwnerID(tt.Input.OwnerID)).AllX(ctx)
+			assert.Len(t, pools, 1)
+		})
+	}
+}
+
 func TestUpdate_loadBalancer(t *testing.T) {
 	ctx := context.Background()
 	perms := new(mockpermissions.MockPermissions)
@@ -303,7 +496,7 @@ func TestUpdate_loadBalancer(t *testing.T) {
 
 	for _, tt := range testCases {
 		t.Run(tt.TestName, func(t *testing.T) {
-			resp, err := graphTestClient().LoadBalancerUpdate(ctx, tt.ID, tt.Input)
+			resp, err := graphTestClient().LoadBalancerUpdate(ctx, tt.ID, tt.Input, nil)
 
 			if tt.errorMsg != "" {
 				require.Error(t, err)
@@ -372,7 +565,7 @@ func TestDelete_loadBalancer(t *testing.T) {
 
 	for _, tt := range testCases {
 		t.Run(tt.TestName, func(t *testing.T) {
-			resp, err := graphTestClient().LoadBalancerDelete(ctx, tt.Input)
+			resp, err := graphTestClient().LoadBalancerDelete(ctx, tt.Input, nil)
 
 			if tt.errorMsg != "" {
 				require.Error(t, err)
@@ -440,7 +633,7 @@ func TestFullLoadBalancerLifecycle(t *testing.T) {
 
 	// Update the LB
 	newName := gofakeit.DomainName()
-	updatedLBResp, err := graphTestClient().LoadBalancerUpdate(ctx, createdLB.ID, graphclient.UpdateLoadBalancerInput{Name: &newName})
+	updatedLBResp, err := graphTestClient().LoadBalancerUpdate(ctx, createdLB.ID, graphclient.UpdateLoadBalancerInput{Name: &newName}, nil)
 
 	require.NoError(t, err)
 	require.NotNil(t, updatedLBResp)
@@ -458,7 +651,7 @@ func TestFullLoadBalancerLifecycle(t *testing.T) {
 	require.Equal(t, newName, queryLB.LoadBalancer.Name)
 
 	// Delete the LB
-	deletedResp, err := graphTestClient().LoadBalancerDelete(ctx, createdLB.ID)
+	deletedResp, err := graphTestClient().LoadBalancerDelete(ctx, createdLB.ID, nil)
 	require.NoError(t, err)
 	require.NotNil(t, deletedResp)
 	require.NotNil(t, deletedResp.LoadBalancerDelete)
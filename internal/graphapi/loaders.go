@@ -0,0 +1,284 @@
+package graphapi
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/origin"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/port"
+)
+
+const (
+	// loaderWait is how long a loader collects keys before fetching them
+	loaderWait = time.Millisecond
+	// loaderMaxBatch is the most keys a loader fetches at once
+	loaderMaxBatch = 100
+)
+
+type loadersCtxKey struct{}
+
+// loaders holds the loaders of an operation, there is one for every field of the query that
+// loads through a loader so the arguments of the field are shared by the whole batch
+type loaders struct {
+	mu      sync.Mutex
+	byField map[*ast.Field]any
+}
+
+func withLoaders(ctx context.Context) context.Context {
+	return context.WithValue(ctx, loadersCtxKey{}, &loaders{byField: map[*ast.Field]any{}})
+}
+
+// loaderFor returns the loader of the field being resolved, creating it with the given fetch
+// function on first use. It returns nil when the operation doesn't use loaders.
+func loaderFor[K comparable, V any](ctx context.Context, fetch func(context.Context, []K) (map[K]V, error)) *loader[K, V] {
+	ls, ok := ctx.Value(loadersCtxKey{}).(*loaders)
+	if !ok {
+		return nil
+	}
+
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return nil
+	}
+
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+
+	if l, ok := ls.byField[fc.Field.Field].(*loader[K, V]); ok {
+		return l
+	}
+
+	l := &loader[K, V]{fetch: fetch}
+	ls.byField[fc.Field.Field] = l
+
+	return l
+}
+
+// loader batches the keys requested by resolvers running at the same time into a single fetch
+type loader[K comparable, V any] struct {
+	fetch func(context.Context, []K) (map[K]V, error)
+
+	mu    sync.Mutex
+	batch *loaderBatch[K, V]
+}
+
+type loaderBatch[K comparable, V any] struct {
+	keys    []K
+	full    chan struct{}
+	done    chan struct{}
+	results map[K]V
+	err     error
+}
+
+// load returns the value of the key once the batch it was added to is fetched
+func (l *loader[K, V]) load(ctx context.Context, key K) (V, error) {
+	l.mu.Lock()
+
+	b := l.batch
+	if b == nil {
+		b = &loaderBatch[K, V]{
+			full: make(chan struct{}),
+			done: make(chan struct{}),
+		}
+		l.batch = b
+
+		go l.run(ctx, b)
+	}
+
+	b.keys = append(b.keys, key)
+
+	if len(b.keys) == loaderMaxBatch {
+		l.batch = nil
+		close(b.full)
+	}
+
+	l.mu.Unlock()
+
+	select {
+	case <-b.done:
+		return b.results[key], b.err
+	case <-ctx.Done():
+		var v V
+		return v, ctx.Err()
+	}
+}
+
+func (l *loader[K, V]) run(ctx context.Context, b *loaderBatch[K, V]) {
+	select {
+	case <-time.After(loaderWait):
+		l.mu.Lock()
+		if l.batch == b {
+			l.batch = nil
+		}
+		l.mu.Unlock()
+	case <-b.full:
+	}
+
+	defer close(b.done)
+
+	defer func() {
+		if r := recover(); r != nil {
+			b.err = fmt.Errorf("%w: loader panic: %v", ErrInternalServerError, r)
+		}
+	}()
+
+	b.results, b.err = l.fetch(ctx, b.keys)
+}
+
+// collectsEdges returns true when the edges of the connection being resolved are selected
+func collectsEdges(ctx context.Context) bool {
+	for _, f := range graphql.CollectFieldsCtx(ctx, nil) {
+		if f.Name == "edges" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// groupEdges returns the edges of each of the parents, every parent has an entry even when it
+// has no edges
+func groupEdges[E any](ids []gidx.PrefixedID, edges []E, parent func(E) gidx.PrefixedID) map[gidx.PrefixedID][]E {
+	groups := make(map[gidx.PrefixedID][]E, len(ids))
+	for _, id := range ids {
+		groups[id] = []E{}
+	}
+
+	for _, e := range edges {
+		p := parent(e)
+		groups[p] = append(groups[p], e)
+	}
+
+	return groups
+}
+
+// loadsBatched returns true when a connection with the given pagination arguments can be loaded
+// together with the connections of the other parents. Only the first page is, as a batch is
+// fetched in a single query and every parent gets the first edges of its group.
+func loadsBatched[C any](after *C, first *int, before *C, last *int) bool {
+	return after == nil && before == nil && last == nil && (first == nil || *first >= 0)
+}
+
+// firstEdges returns at most first of the edges, and whether there were more
+func firstEdges[E any](edges []E, first *int) ([]E, bool) {
+	if first == nil || len(edges) <= *first {
+		return edges, false
+	}
+
+	return edges[:*first], true
+}
+
+// loadPorts returns the first ports of each of the load balancers, the ports are fetched in a
+// single query for the whole batch
+func (r *Resolver) loadPorts(first *int, orderBy *generated.LoadBalancerPortOrder, where *generated.LoadBalancerPortWhereInput) func(context.Context, []gidx.PrefixedID) (map[gidx.PrefixedID]*generated.LoadBalancerPortConnection, error) {
+	return func(ctx context.Context, ids []gidx.PrefixedID) (map[gidx.PrefixedID]*generated.LoadBalancerPortConnection, error) {
+		conn, err := r.client.Port.Query().
+			Where(port.LoadBalancerIDIn(ids...)).
+			Paginate(ctx, nil, nil, nil, nil, generated.WithLoadBalancerPortOrder(orderBy), generated.WithLoadBalancerPortFilter(where.Filter))
+		if err != nil {
+			return nil, err
+		}
+
+		parents := make(map[gidx.PrefixedID]gidx.PrefixedID, len(conn.Edges))
+		unknown := []gidx.PrefixedID{}
+
+		for _, e := range conn.Edges {
+			if e.Node.LoadBalancerID == "" {
+				unknown = append(unknown, e.Node.ID)
+				continue
+			}
+
+			parents[e.Node.ID] = e.Node.LoadBalancerID
+		}
+
+		// the load balancer id is only selected when the query asks for it
+		if len(unknown) != 0 {
+			ports, err := r.client.Port.Query().Where(port.IDIn(unknown...)).Select(port.FieldID, port.FieldLoadBalancerID).All(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, p := range ports {
+				parents[p.ID] = p.LoadBalancerID
+			}
+		}
+
+		groups := groupEdges(ids, conn.Edges, func(e *generated.LoadBalancerPortEdge) gidx.PrefixedID { return parents[e.Node.ID] })
+		conns := make(map[gidx.PrefixedID]*generated.LoadBalancerPortConnection, len(groups))
+
+		for id, edges := range groups {
+			c := &generated.LoadBalancerPortConnection{TotalCount: len(edges)}
+			c.Edges, c.PageInfo.HasNextPage = firstEdges(edges, first)
+
+			if l := len(c.Edges); l > 0 {
+				c.PageInfo.StartCursor = &c.Edges[0].Cursor
+				c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+			}
+
+			conns[id] = c
+		}
+
+		return conns, nil
+	}
+}
+
+// loadOrigins returns the first origins of each of the pools, the origins are fetched in a
+// single query for the whole batch
+func (r *Resolver) loadOrigins(first *int, orderBy *generated.LoadBalancerOriginOrder, where *generated.LoadBalancerOriginWhereInput) func(context.Context, []gidx.PrefixedID) (map[gidx.PrefixedID]*generated.LoadBalancerOriginConnection, error) {
+	return func(ctx context.Context, ids []gidx.PrefixedID) (map[gidx.PrefixedID]*generated.LoadBalancerOriginConnection, error) {
+		conn, err := r.client.Origin.Query().
+			Where(origin.PoolIDIn(ids...)).
+			Paginate(ctx, nil, nil, nil, nil, generated.WithLoadBalancerOriginOrder(orderBy), generated.WithLoadBalancerOriginFilter(where.Filter))
+		if err != nil {
+			return nil, err
+		}
+
+		parents := make(map[gidx.PrefixedID]gidx.PrefixedID, len(conn.Edges))
+		unknown := []gidx.PrefixedID{}
+
+		for _, e := range conn.Edges {
+			if e.Node.PoolID == "" {
+				unknown = append(unknown, e.Node.ID)
+				continue
+			}
+
+			parents[e.Node.ID] = e.Node.PoolID
+		}
+
+		// the pool id is only selected when the query asks for it
+		if len(unknown) != 0 {
+			origins, err := r.client.Origin.Query().Where(origin.IDIn(unknown...)).Select(origin.FieldID, origin.FieldPoolID).All(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, o := range origins {
+				parents[o.ID] = o.PoolID
+			}
+		}
+
+		groups := groupEdges(ids, conn.Edges, func(e *generated.LoadBalancerOriginEdge) gidx.PrefixedID { return parents[e.Node.ID] })
+		conns := make(map[gidx.PrefixedID]*generated.LoadBalancerOriginConnection, len(groups))
+
+		for id, edges := range groups {
+			c := &generated.LoadBalancerOriginConnection{TotalCount: len(edges)}
+			c.Edges, c.PageInfo.HasNextPage = firstEdges(edges, first)
+
+			if l := len(c.Edges); l > 0 {
+				c.PageInfo.StartCursor = &c.Edges[0].Cursor
+				c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+			}
+
+			conns[id] = c
+		}
+
+		return conns, nil
+	}
+}
@@ -0,0 +1,387 @@
+package graphapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	gqlclient "github.com/99designs/gqlgen/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/permissions-api/pkg/permissions/mockpermissions"
+	"go.infratographer.com/x/gidx"
+	"go.uber.org/zap"
+
+	ent "go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/graphapi"
+	"go.infratographer.com/load-balancer-api/internal/testutils"
+)
+
+const ownerLoadBalancersQuery = `query($id: ID!) {
+  _entities(representations: [{__typename: "ResourceOwner", id: $id}]) {
+    ... on ResourceOwner {
+      loadBalancers(first: 50) {
+        edges {
+          node {
+            id
+            ports {
+              edges {
+                node {
+                  id
+                  pools {
+                    id
+                    origins {
+                      edges { node { id } }
+                    }
+                  }
+                }
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+const ownerLoadBalancersIncludeDeletedQuery = `query($id: ID!) {
+  _entities(representations: [{__typename: "ResourceOwner", id: $id}]) {
+    ... on ResourceOwner {
+      loadBalancers(first: 50) {
+        edges {
+          node {
+            id
+            ports(includeDeleted: true) {
+              edges {
+                node {
+                  id
+                  pools {
+                    id
+                    origins(includeDeleted: true) {
+                      edges { node { id } }
+                    }
+                  }
+                }
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+const ownerLoadBalancersIncludeDeletedFirstQuery = `query($id: ID!) {
+  _entities(representations: [{__typename: "ResourceOwner", id: $id}]) {
+    ... on ResourceOwner {
+      loadBalancers(first: 50) {
+        edges {
+          node {
+            id
+            ports(includeDeleted: true, first: 1) {
+              totalCount
+              pageInfo { hasNextPage }
+              edges {
+                node {
+                  id
+                  pools {
+                    id
+                    origins(includeDeleted: true, first: 1) {
+                      edges { node { id } }
+                    }
+                  }
+                }
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+// countingHandler serves the graph with a client and permissions checker that count the database
+// queries and permission checks made
+type countingHandler struct {
+	handler http.Handler
+	queries atomic.Int64
+	checks  atomic.Int64
+}
+
+func newCountingHandler(t testing.TB) *countingHandler {
+	t.Helper()
+
+	dia, uri, _ := testutils.ParseDBURI(context.Background())
+
+	drv, err := entsql.Open(dia, uri)
+	require.NoError(t, err)
+
+	c := &countingHandler{}
+
+	client := ent.NewClient(ent.Driver(dialect.DebugWithContext(drv, func(context.Context, ...any) {
+		c.queries.Add(1)
+	})))
+
+	t.Cleanup(func() { client.Close() })
+
+	checker := func(context.Context, ...permissions.AccessRequest) error {
+		c.checks.Add(1)
+		return nil
+	}
+
+	h := graphapi.NewResolver(client, zap.NewNop().Sugar()).Handler(false).Handler()
+
+	// the permissions middleware is not part of the handler, so the checker is set on the request
+	c.handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), permissions.CheckerCtxKey, permissions.Checker(checker))))
+	})
+
+	return c
+}
+
+func (c *countingHandler) query(t testing.TB, query string, ownerID gidx.PrefixedID) json.RawMessage {
+	t.Helper()
+
+	resp, err := gqlclient.New(c.handler).RawPost(query, gqlclient.Var("id", ownerID))
+	require.NoError(t, err)
+	require.Empty(t, string(resp.Errors))
+
+	raw, err := json.Marshal(resp.Data)
+	require.NoError(t, err)
+
+	return raw
+}
+
+// newOwnerWithLoadBalancers creates an owner with the given number of load balancers, each with
+// a port in a pool with an origin
+func newOwnerWithLoadBalancers(ctx context.Context, count int) gidx.PrefixedID {
+	ownerID := gidx.MustNewID(ownerPrefix)
+
+	for i := 0; i < count; i++ {
+		lb := (&testutils.LoadBalancerBuilder{OwnerID: ownerID}).MustNew(ctx)
+		pool := (&testutils.PoolBuilder{OwnerID: ownerID}).MustNew(ctx)
+		(&testutils.PortBuilder{LoadBalancerID: lb.ID, PoolIDs: []gidx.PrefixedID{pool.ID}}).MustNew(ctx)
+		(&testutils.OriginBuilder{PoolID: pool.ID}).MustNew(ctx)
+	}
+
+	return ownerID
+}
+
+func TestOwnerLoadBalancers_IncludeDeletedLoaders(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	ownerID := gidx.MustNewID(ownerPrefix)
+	expected := map[gidx.PrefixedID][]gidx.PrefixedID{}
+
+	for i := 0; i < 3; i++ {
+		lb := (&testutils.LoadBalancerBuilder{OwnerID: ownerID}).MustNew(ctx)
+		port1 := (&testutils.PortBuilder{LoadBalancerID: lb.ID}).MustNew(ctx)
+		port2 := (&testutils.PortBuilder{LoadBalancerID: lb.ID}).MustNew(ctx)
+
+		expected[lb.ID] = []gidx.PrefixedID{port1.ID, port2.ID}
+	}
+
+	lb := (&testutils.LoadBalancerBuilder{OwnerID: ownerID}).MustNew(ctx)
+	deleted := (&testutils.PortBuilder{LoadBalancerID: lb.ID}).MustNew(ctx)
+
+	EntClient.Port.DeleteOneID(deleted.ID).ExecX(ctx)
+
+	expected[lb.ID] = []gidx.PrefixedID{deleted.ID}
+
+	c := newCountingHandler(t)
+
+	var data struct {
+		Entities []struct {
+			LoadBalancers struct {
+				Edges []struct {
+					Node struct {
+						ID    gidx.PrefixedID
+						Ports struct {
+							Edges []struct {
+								Node struct {
+									ID gidx.PrefixedID
+								}
+							}
+						}
+					}
+				}
+			}
+		} `json:"_entities"`
+	}
+
+	require.NoError(t, json.Unmarshal(c.query(t, ownerLoadBalancersIncludeDeletedQuery, ownerID), &data))
+	require.Len(t, data.Entities, 1)
+
+	ports := map[gidx.PrefixedID][]gidx.PrefixedID{}
+
+	for _, lbEdge := range data.Entities[0].LoadBalancers.Edges {
+		ports[lbEdge.Node.ID] = []gidx.PrefixedID{}
+
+		for _, portEdge := range lbEdge.Node.Ports.Edges {
+			ports[lbEdge.Node.ID] = append(ports[lbEdge.Node.ID], portEdge.Node.ID)
+		}
+	}
+
+	require.Len(t, ports, len(expected))
+
+	for id, expectedPorts := range expected {
+		assert.ElementsMatch(t, expectedPorts, ports[id])
+	}
+
+	// loadbalancer_get and loadbalancer_get_history are each checked once for the owner
+	assert.Equal(t, int64(2), c.checks.Load())
+
+	t.Run("first page", func(t *testing.T) {
+		var data struct {
+			Entities []struct {
+				LoadBalancers struct {
+					Edges []struct {
+						Node struct {
+							ID    gidx.PrefixedID
+							Ports struct {
+								TotalCount int
+								PageInfo   struct {
+									HasNextPage bool
+								}
+								Edges []struct {
+									Node struct {
+										ID gidx.PrefixedID
+									}
+								}
+							}
+						}
+					}
+				}
+			} `json:"_entities"`
+		}
+
+		require.NoError(t, json.Unmarshal(c.query(t, ownerLoadBalancersIncludeDeletedFirstQuery, ownerID), &data))
+		require.Len(t, data.Entities, 1)
+		require.Len(t, data.Entities[0].LoadBalancers.Edges, len(expected))
+
+		for _, lbEdge := range data.Entities[0].LoadBalancers.Edges {
+			ports := lbEdge.Node.Ports
+
+			require.Len(t, ports.Edges, 1)
+			assert.Contains(t, expected[lbEdge.Node.ID], ports.Edges[0].Node.ID)
+			assert.Equal(t, len(expected[lbEdge.Node.ID]), ports.TotalCount)
+			assert.Equal(t, len(expected[lbEdge.Node.ID]) > 1, ports.PageInfo.HasNextPage)
+		}
+	})
+}
+
+// TestOwnerLoadBalancers_LoaderQueries checks the number of database queries made to resolve the
+// nested connections of an owner with 50 load balancers. Loading the connections one by one takes
+// at least two queries for each load balancer. Loaders collect their batches for loaderWait, so
+// how many batches are fetched depends on scheduling and only an upper bound is checked for them.
+func TestOwnerLoadBalancers_LoaderQueries(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	const lbCount = 50
+
+	ownerID := newOwnerWithLoadBalancers(ctx, lbCount)
+
+	testCases := []struct {
+		name       string
+		query      string
+		maxQueries int64
+	}{
+		{
+			// live connections are eager loaded with the load balancers
+			name:       "nested connections",
+			query:      ownerLoadBalancersQuery,
+			maxQueries: 4,
+		},
+		{
+			name:       "nested connections including deleted",
+			query:      ownerLoadBalancersIncludeDeletedQuery,
+			maxQueries: lbCount,
+		},
+		{
+			name:       "first page of nested connections including deleted",
+			query:      ownerLoadBalancersIncludeDeletedFirstQuery,
+			maxQueries: lbCount,
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			c := newCountingHandler(t)
+
+			c.query(t, tt.query, ownerID)
+
+			assert.LessOrEqual(t, c.queries.Load(), tt.maxQueries)
+		})
+	}
+}
+
+// BenchmarkOwnerLoadBalancers reports the database queries and permission checks made to
+// resolve the nested connections of an owner with 50 load balancers
+func BenchmarkOwnerLoadBalancers(b *testing.B) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	ownerID := newOwnerWithLoadBalancers(ctx, 50)
+
+	benchmarks := []struct {
+		name  string
+		query string
+	}{
+		{
+			name:  "nested connections",
+			query: ownerLoadBalancersQuery,
+		},
+		{
+			name:  "nested connections including deleted",
+			query: ownerLoadBalancersIncludeDeletedQuery,
+		},
+	}
+
+	for _, bb := range benchmarks {
+		bb := bb
+
+		b.Run(bb.name, func(b *testing.B) {
+			c := newCountingHandler(b)
+
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				c.query(b, bb.query, ownerID)
+			}
+
+			b.StopTimer()
+
+			b.ReportMetric(float64(c.queries.Load())/float64(b.N), "queries/op")
+			b.ReportMetric(float64(c.checks.Load())/float64(b.N), "checks/op")
+		})
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"context"
 
 	"entgo.io/contrib/entgql"
-	"go.infratographer.com/permissions-api/pkg/permissions"
 	"go.infratographer.com/x/gidx"
 
 	"go.infratographer.com/load-balancer-api/internal/ent/generated"
@@ -23,7 +22,7 @@ func (r *loadBalancerResolver) Location(ctx context.Context, obj *generated.Load
 
 // LoadBalancers is the resolver for the loadBalancers field.
 func (r *locationResolver) LoadBalancers(ctx context.Context, obj *Location, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.LoadBalancerOrder, where *generated.LoadBalancerWhereInput) (*generated.LoadBalancerConnection, error) {
-	if err := permissions.CheckAccess(ctx, obj.ID, actionLocationGet); err != nil {
+	if err := checkAccess(ctx, obj.ID, actionLocationGet); err != nil {
 		return nil, err
 	}
 
@@ -25,3 +25,33 @@ type Owner struct {
 
 // IsEntity ensures the entity interface is met
 func (Owner) IsEntity() {}
+
+// CreateLoadBalancerInput is the input of loadBalancerCreate
+type CreateLoadBalancerInput struct {
+	generated.CreateLoadBalancerInput
+	IdempotencyKey *string `json:"idempotencyKey,omitempty"`
+}
+
+// CreateLoadBalancerPortInput is the input of loadBalancerPortCreate
+type CreateLoadBalancerPortInput struct {
+	generated.CreateLoadBalancerPortInput
+	IdempotencyKey *string `json:"idempotencyKey,omitempty"`
+}
+
+// CreateLoadBalancerPoolInput is the input of loadBalancerPoolCreate
+type CreateLoadBalancerPoolInput struct {
+	generated.CreateLoadBalancerPoolInput
+	IdempotencyKey *string `json:"idempotencyKey,omitempty"`
+}
+
+// CreateLoadBalancerOriginInput is the input of loadBalancerOriginCreate
+type CreateLoadBalancerOriginInput struct {
+	generated.CreateLoadBalancerOriginInput
+	IdempotencyKey *string `json:"idempotencyKey,omitempty"`
+}
+
+// CreateLoadBalancerProviderInput is the input of loadBalancerProviderCreate
+type CreateLoadBalancerProviderInput struct {
+	generated.CreateLoadBalancerProviderInput
+	IdempotencyKey *string `json:"idempotencyKey,omitempty"`
+}
@@ -6,19 +6,20 @@ package graphapi
 
 import (
 	"context"
+	"errors"
 
 	"go.infratographer.com/load-balancer-api/internal/ent/generated"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/origin"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/pool"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/port"
 	_ "go.infratographer.com/load-balancer-api/internal/ent/generated/runtime"
+	"go.infratographer.com/load-balancer-api/internal/ent/schema/softdelete"
 	"go.infratographer.com/load-balancer-api/pkg/metadata"
-	"go.infratographer.com/permissions-api/pkg/permissions"
 	"go.infratographer.com/x/gidx"
 )
 
 // LoadBalancerOriginCreate is the resolver for the loadBalancerOriginCreate field.
-func (r *mutationResolver) LoadBalancerOriginCreate(ctx context.Context, input generated.CreateLoadBalancerOriginInput) (*LoadBalancerOriginCreatePayload, error) {
+func (r *mutationResolver) LoadBalancerOriginCreate(ctx context.Context, input CreateLoadBalancerOriginInput) (*LoadBalancerOriginCreatePayload, error) {
 	logger := r.logger.With("poolID", input.PoolID)
 
 	// check gidx format
@@ -26,12 +27,12 @@ func (r *mutationResolver) LoadBalancerOriginCreate(ctx context.Context, input g
 		return nil, err
 	}
 
-	if err := permissions.CheckAccess(ctx, input.PoolID, actionLoadBalancerPoolUpdate); err != nil {
+	if err := checkAccess(ctx, input.PoolID, actionLoadBalancerOriginCreate); err != nil {
 		return nil, err
 	}
 
 	// check if pool exists
-	_, err := r.client.Pool.Get(ctx, input.PoolID)
+	pl, err := r.client.Pool.Get(ctx, input.PoolID)
 	if err != nil {
 		if generated.IsNotFound(err) {
 			return nil, err
@@ -41,7 +42,44 @@ func (r *mutationResolver) LoadBalancerOriginCreate(ctx context.Context, input g
 		return nil, ErrInternalServerError
 	}
 
-	ogn, err := r.client.Origin.Create().SetInput(input).Save(ctx)
+	if err := r.validateOriginTarget(ctx, input.PoolID, input.Target); err != nil {
+		var fieldErr *ErrInvalidField
+		if errors.As(err, &fieldErr) {
+			return nil, err
+		}
+
+		logger.Errorw("failed to validate origin target", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	claim, err := r.claimIdempotencyKey(ctx, input.IdempotencyKey, pl.OwnerID, "loadBalancerOriginCreate", input)
+	if err != nil {
+		return nil, err
+	}
+
+	if id, ok := claim.replayID(); ok {
+		ogn, err := r.client.Origin.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		return &LoadBalancerOriginCreatePayload{LoadBalancerOrigin: ogn}, nil
+	}
+
+	defer claim.release(ctx)
+
+	var ogn *generated.Origin
+
+	err = r.createInTx(ctx, claim, func(tx *generated.Tx) (gidx.PrefixedID, error) {
+		var err error
+
+		ogn, err = tx.Origin.Create().SetInput(input.CreateLoadBalancerOriginInput).Save(ctx)
+		if err != nil {
+			return "", err
+		}
+
+		return ogn.ID, nil
+	})
 	if err != nil {
 		if generated.IsValidationError(err) {
 			return nil, err
@@ -51,6 +89,9 @@ func (r *mutationResolver) LoadBalancerOriginCreate(ctx context.Context, input g
 		return nil, ErrInternalServerError
 	}
 
+	// detach the origin from the committed transaction so edges can still be resolved
+	ogn = ogn.Unwrap()
+
 	// find loadbalancers associated with this origin to update loadbalancer metadata status
 	ports, err := r.client.Port.Query().WithPools().WithLoadBalancer().Where(port.HasPoolsWith(pool.IDEQ(ogn.PoolID))).All(ctx)
 	if err == nil {
@@ -66,7 +107,7 @@ func (r *mutationResolver) LoadBalancerOriginCreate(ctx context.Context, input g
 }
 
 // LoadBalancerOriginUpdate is the resolver for the loadBalancerOriginUpdate field.
-func (r *mutationResolver) LoadBalancerOriginUpdate(ctx context.Context, id gidx.PrefixedID, input generated.UpdateLoadBalancerOriginInput) (*LoadBalancerOriginUpdatePayload, error) {
+func (r *mutationResolver) LoadBalancerOriginUpdate(ctx context.Context, id gidx.PrefixedID, input generated.UpdateLoadBalancerOriginInput, expectedVersion *int) (*LoadBalancerOriginUpdatePayload, error) {
 	logger := r.logger.With("originID", id.String())
 
 	if err := validateGidx(id); err != nil {
@@ -83,18 +124,42 @@ func (r *mutationResolver) LoadBalancerOriginUpdate(ctx context.Context, id gidx
 		return nil, ErrInternalServerError
 	}
 
-	if err := permissions.CheckAccess(ctx, ogn.Edges.Pool.OwnerID, actionLoadBalancerPoolUpdate); err != nil {
+	if err := checkAccess(ctx, ogn.PoolID, actionLoadBalancerOriginUpdate); err != nil {
 		return nil, err
 	}
 
-	ogn, err = ogn.Update().SetInput(input).Save(ctx)
+	if err := validateVersion(expectedVersion, ogn.Version); err != nil {
+		return nil, err
+	}
+
+	if input.Target != nil {
+		if err := r.validateOriginTarget(ctx, ogn.PoolID, *input.Target); err != nil {
+			var fieldErr *ErrInvalidField
+			if errors.As(err, &fieldErr) {
+				return nil, err
+			}
+
+			logger.Errorw("failed to validate origin target", "error", err)
+			return nil, ErrInternalServerError
+		}
+	}
+
+	update := ogn.Update().SetInput(input)
+	if expectedVersion != nil {
+		update.Where(origin.VersionEQ(*expectedVersion))
+	}
+
+	ogn, err = update.Save(ctx)
 	if err != nil {
-		if generated.IsValidationError(err) {
+		switch {
+		case generated.IsValidationError(err):
 			return nil, err
+		case generated.IsNotFound(err):
+			return nil, ErrVersionMismatch
+		default:
+			logger.Errorw("failed to update origin", "error", err)
+			return nil, ErrInternalServerError
 		}
-
-		logger.Errorw("failed to update origin", "error", err)
-		return nil, ErrInternalServerError
 	}
 
 	// find loadbalancers associated with this origin to update loadbalancer metadata status
@@ -112,7 +177,7 @@ func (r *mutationResolver) LoadBalancerOriginUpdate(ctx context.Context, id gidx
 }
 
 // LoadBalancerOriginDelete is the resolver for the loadBalancerOriginDelete field.
-func (r *mutationResolver) LoadBalancerOriginDelete(ctx context.Context, id gidx.PrefixedID) (*LoadBalancerOriginDeletePayload, error) {
+func (r *mutationResolver) LoadBalancerOriginDelete(ctx context.Context, id gidx.PrefixedID, expectedVersion *int) (*LoadBalancerOriginDeletePayload, error) {
 	logger := r.logger.With("originID", id.String())
 
 	if err := validateGidx(id); err != nil {
@@ -128,11 +193,24 @@ func (r *mutationResolver) LoadBalancerOriginDelete(ctx context.Context, id gidx
 		return nil, ErrInternalServerError
 	}
 
-	if err := permissions.CheckAccess(ctx, ogn.Edges.Pool.OwnerID, actionLoadBalancerPoolUpdate); err != nil {
+	if err := checkAccess(ctx, ogn.PoolID, actionLoadBalancerOriginDelete); err != nil {
+		return nil, err
+	}
+
+	if err := validateVersion(expectedVersion, ogn.Version); err != nil {
 		return nil, err
 	}
 
-	if err := r.client.Origin.DeleteOneID(id).Exec(ctx); err != nil {
+	del := r.client.Origin.DeleteOneID(id)
+	if expectedVersion != nil {
+		del.Where(origin.VersionEQ(*expectedVersion))
+	}
+
+	if err := del.Exec(ctx); err != nil {
+		if generated.IsNotFound(err) {
+			return nil, ErrVersionMismatch
+		}
+
 		logger.Errorw("failed to delete origin", "error", err)
 		return nil, ErrInternalServerError
 	}
@@ -151,6 +229,60 @@ func (r *mutationResolver) LoadBalancerOriginDelete(ctx context.Context, id gidx
 	return &LoadBalancerOriginDeletePayload{DeletedID: id}, nil
 }
 
+// LoadBalancerOrigin is the resolver for the loadBalancerOrigin field.
+func (r *queryResolver) LoadBalancerOrigin(ctx context.Context, id gidx.PrefixedID) (*generated.Origin, error) {
+	logger := r.logger.With("loadbalancerOriginID", id.String())
+
+	// check gidx format
+	if err := validateGidx(id); err != nil {
+		return nil, newInvalidFieldError("id", err)
+	}
+
+	o, err := r.client.Origin.Get(ctx, id)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		logger.Errorw("failed to get loadbalancer origin", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	if err := checkAccess(ctx, o.PoolID, actionLoadBalancerOriginGet); err != nil {
+		return nil, err
+	}
+
+	return o, nil
+}
+
+// LoadBalancerOriginHistory is the resolver for the loadBalancerOriginHistory field.
+func (r *queryResolver) LoadBalancerOriginHistory(ctx context.Context, id gidx.PrefixedID) (*generated.Origin, error) {
+	ctx = softdelete.SkipSoftDelete(ctx)
+
+	logger := r.logger.With("loadbalancerOriginID", id.String())
+
+	// check gidx format
+	if err := validateGidx(id); err != nil {
+		return nil, newInvalidFieldError("id", err)
+	}
+
+	o, err := r.client.Origin.Query().WithPool().Where(origin.IDEQ(id)).Only(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		logger.Errorw("failed to get loadbalancer origin", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	if err := checkAccess(ctx, o.Edges.Pool.OwnerID, actionLoadBalancerPoolGetHistory); err != nil {
+		return nil, err
+	}
+
+	return o, nil
+}
+
 // Mutation returns MutationResolver implementation.
 func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 
@@ -12,6 +12,7 @@ import (
 	"go.infratographer.com/permissions-api/pkg/permissions/mockpermissions"
 	"go.infratographer.com/x/gidx"
 
+	"go.infratographer.com/load-balancer-api/internal/config"
 	ent "go.infratographer.com/load-balancer-api/internal/ent/generated"
 	"go.infratographer.com/load-balancer-api/internal/graphclient"
 	"go.infratographer.com/load-balancer-api/internal/testutils"
@@ -82,6 +83,79 @@ func TestQueryPoolOrigin(t *testing.T) {
 	}
 }
 
+func TestQueryOrigin(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	pool1 := (&testutils.PoolBuilder{}).MustNew(ctx)
+	origin1 := (&testutils.OriginBuilder{PoolID: pool1.ID}).MustNew(ctx)
+
+	testCases := []struct {
+		TestName string
+		QueryID  gidx.PrefixedID
+		Checker  permissions.Checker
+		errorMsg string
+	}{
+		{
+			TestName: "get origin",
+			QueryID:  origin1.ID,
+		},
+		{
+			TestName: "get origin using the documented policy",
+			QueryID:  origin1.ID,
+			Checker:  documentedPolicyChecker(),
+		},
+		{
+			TestName: "origin not found",
+			QueryID:  gidx.MustNewID("loadogn"),
+			errorMsg: "not found",
+		},
+		{
+			TestName: "invalid origin query ID",
+			QueryID:  "an invalid origin id",
+			errorMsg: "invalid id",
+		},
+		{
+			TestName: "fails without origin get permission on the pool",
+			QueryID:  origin1.ID,
+			Checker:  denyResourceActionChecker(pool1.ID, "loadbalancerorigin_get"),
+			errorMsg: "subject doesn't have access",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			reqCtx := ctx
+			if tt.Checker != nil {
+				reqCtx = context.WithValue(ctx, permissions.CheckerCtxKey, tt.Checker)
+			}
+
+			resp, err := graphTestClient().GetLoadBalancerOrigin(reqCtx, tt.QueryID)
+			if tt.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.errorMsg)
+				assert.Nil(t, resp)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+
+			assert.Equal(t, origin1.ID, resp.LoadBalancerOrigin.ID)
+			assert.Equal(t, origin1.Name, resp.LoadBalancerOrigin.Name)
+			assert.Equal(t, origin1.Target, resp.LoadBalancerOrigin.Target)
+			assert.Equal(t, pool1.ID, resp.LoadBalancerOrigin.PoolID)
+		})
+	}
+}
+
 func TestMutate_OriginCreate(t *testing.T) {
 	ctx := context.Background()
 	perms := new(mockpermissions.MockPermissions)
@@ -301,7 +375,7 @@ func TestMutate_OriginUpdate(t *testing.T) {
 		tt := tt
 
 		t.Run(tt.TestName, func(t *testing.T) {
-			updatedOriginResp, err := graphTestClient().LoadBalancerOriginUpdate(ctx, tt.OriginID, tt.Input)
+			updatedOriginResp, err := graphTestClient().LoadBalancerOriginUpdate(ctx, tt.OriginID, tt.Input, nil)
 
 			if tt.errorMsg != "" {
 				require.Error(t, err)
@@ -325,6 +399,216 @@ func TestMutate_OriginUpdate(t *testing.T) {
 	}
 }
 
+func TestMutate_OriginTargetPolicy(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	prov := (&testutils.ProviderBuilder{}).MustNew(ctx)
+	lb := (&testutils.LoadBalancerBuilder{Provider: prov}).MustNew(ctx)
+	pool1 := (&testutils.PoolBuilder{}).MustNew(ctx)
+	_ = (&testutils.PortBuilder{LoadBalancerID: lb.ID, PoolIDs: []gidx.PrefixedID{pool1.ID}}).MustNew(ctx)
+
+	pool2 := (&testutils.PoolBuilder{}).MustNew(ctx)
+	origin1 := (&testutils.OriginBuilder{PoolID: pool2.ID, Target: "1.2.3.4"}).MustNew(ctx)
+
+	testCases := []struct {
+		TestName string
+		Policy   config.OriginTargetPolicyConfig
+		PoolID   gidx.PrefixedID
+		Target   string
+		errorMsg string
+	}{
+		{
+			TestName: "default denies loopback",
+			PoolID:   pool2.ID,
+			Target:   "127.0.0.1",
+			errorMsg: "127.0.0.1 is within 127.0.0.0/8",
+		},
+		{
+			TestName: "default denies cloud metadata",
+			PoolID:   pool2.ID,
+			Target:   "169.254.169.254",
+			errorMsg: "169.254.169.254 is within 169.254.0.0/16",
+		},
+		{
+			TestName: "default denies ipv4 mapped ipv6 loopback",
+			PoolID:   pool2.ID,
+			Target:   "::ffff:127.0.0.1",
+			errorMsg: "within 127.0.0.0/8",
+		},
+		{
+			TestName: "default denies ipv6 multicast",
+			PoolID:   pool2.ID,
+			Target:   "ff02::1",
+			errorMsg: "within ff00::/8",
+		},
+		{
+			TestName: "configured ranges replace defaults",
+			Policy:   config.OriginTargetPolicyConfig{DeniedCIDRs: []string{"10.0.0.0/8"}},
+			PoolID:   pool2.ID,
+			Target:   "10.1.2.3",
+			errorMsg: "10.1.2.3 is within 10.0.0.0/8",
+		},
+		{
+			TestName: "provider override keeps default ranges",
+			Policy: config.OriginTargetPolicyConfig{
+				Providers: []config.ProviderTargetPolicyConfig{{ID: prov.ID, DeniedCIDRs: []string{"192.168.0.0/16"}}},
+			},
+			PoolID:   pool1.ID,
+			Target:   "169.254.169.254",
+			errorMsg: "169.254.169.254 is within 169.254.0.0/16",
+		},
+		{
+			TestName: "provider override only applies to its provider",
+			Policy: config.OriginTargetPolicyConfig{
+				Providers: []config.ProviderTargetPolicyConfig{{ID: prov.ID, DeniedCIDRs: []string{"192.168.0.0/16"}}},
+			},
+			PoolID: pool2.ID,
+			Target: "192.168.1.1",
+		},
+		{
+			TestName: "provider override denies range",
+			Policy: config.OriginTargetPolicyConfig{
+				DeniedCIDRs: []string{},
+				Providers:   []config.ProviderTargetPolicyConfig{{ID: prov.ID, DeniedCIDRs: []string{"192.168.0.0/16"}}},
+			},
+			PoolID:   pool1.ID,
+			Target:   "192.168.1.1",
+			errorMsg: "192.168.1.1 is within 192.168.0.0/16",
+		},
+		{
+			TestName: "allowed target",
+			PoolID:   pool2.ID,
+			Target:   "1.2.3.4",
+		},
+	}
+
+	for _, tt := range testCases {
+		// lint
+		tt := tt
+
+		t.Run(tt.TestName, func(t *testing.T) {
+			config.AppConfig.OriginTargetPolicy = tt.Policy
+			defer func() { config.AppConfig.OriginTargetPolicy = config.OriginTargetPolicyConfig{} }()
+
+			createResp, err := graphTestClient().LoadBalancerOriginCreate(ctx, graphclient.CreateLoadBalancerOriginInput{
+				Name:       "origin",
+				Target:     tt.Target,
+				PortNumber: 443,
+				PoolID:     tt.PoolID,
+			})
+
+			if tt.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.errorMsg)
+				assert.Nil(t, createResp)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.Target, createResp.LoadBalancerOriginCreate.LoadBalancerOrigin.Target)
+			}
+
+			if tt.PoolID != pool2.ID {
+				return
+			}
+
+			updateResp, err := graphTestClient().LoadBalancerOriginUpdate(ctx, origin1.ID, graphclient.UpdateLoadBalancerOriginInput{
+				Target: newString(tt.Target),
+			}, nil)
+
+			if tt.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.errorMsg)
+				assert.Nil(t, updateResp)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.Target, updateResp.LoadBalancerOriginUpdate.LoadBalancerOrigin.Target)
+		})
+	}
+}
+
+func TestMutate_OriginTargetPolicyOnAttach(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	prov := (&testutils.ProviderBuilder{}).MustNew(ctx)
+	lb := (&testutils.LoadBalancerBuilder{Provider: prov}).MustNew(ctx)
+	lbPort := (&testutils.PortBuilder{LoadBalancerID: lb.ID, Number: 80}).MustNew(ctx)
+
+	// the origins are created while their pools are not reachable through the provider
+	deniedPool := (&testutils.PoolBuilder{OwnerID: lb.OwnerID}).MustNew(ctx)
+	deniedOrigin := (&testutils.OriginBuilder{PoolID: deniedPool.ID, Target: "10.1.2.3"}).MustNew(ctx)
+	allowedPool := (&testutils.PoolBuilder{OwnerID: lb.OwnerID}).MustNew(ctx)
+	(&testutils.OriginBuilder{PoolID: allowedPool.ID, Target: "1.2.3.4"}).MustNew(ctx)
+
+	config.AppConfig.OriginTargetPolicy = config.OriginTargetPolicyConfig{
+		Providers: []config.ProviderTargetPolicyConfig{{ID: prov.ID, DeniedCIDRs: []string{"10.0.0.0/8"}}},
+	}
+	defer func() { config.AppConfig.OriginTargetPolicy = config.OriginTargetPolicyConfig{} }()
+
+	errorMsg := "10.1.2.3 is within 10.0.0.0/8"
+
+	t.Run("port create with pools", func(t *testing.T) {
+		_, err := graphTestClient().LoadBalancerPortCreate(ctx, graphclient.CreateLoadBalancerPortInput{
+			Number:         8080,
+			LoadBalancerID: lb.ID,
+			PoolIDs:        []gidx.PrefixedID{deniedPool.ID},
+		})
+		require.Error(t, err)
+		assert.ErrorContains(t, err, errorMsg)
+	})
+
+	t.Run("port update adding pools", func(t *testing.T) {
+		_, err := graphTestClient().LoadBalancerPortUpdate(ctx, lbPort.ID, graphclient.UpdateLoadBalancerPortInput{
+			AddPoolIDs: []gidx.PrefixedID{deniedPool.ID},
+		}, nil)
+		require.Error(t, err)
+		assert.ErrorContains(t, err, errorMsg)
+	})
+
+	t.Run("pool update adding ports", func(t *testing.T) {
+		_, err := graphTestClient().LoadBalancerPoolUpdate(ctx, deniedPool.ID, graphclient.UpdateLoadBalancerPoolInput{
+			AddPortIDs: []gidx.PrefixedID{lbPort.ID},
+		}, nil)
+		require.Error(t, err)
+		assert.ErrorContains(t, err, errorMsg)
+	})
+
+	t.Run("pool create with ports and origins", func(t *testing.T) {
+		_, err := graphTestClient().LoadBalancerPoolCreate(ctx, graphclient.CreateLoadBalancerPoolInput{
+			Name:      "pool",
+			Protocol:  graphclient.LoadBalancerPoolProtocolTCP,
+			OwnerID:   lb.OwnerID,
+			PortIDs:   []gidx.PrefixedID{lbPort.ID},
+			OriginIDs: []gidx.PrefixedID{deniedOrigin.ID},
+		})
+		require.Error(t, err)
+		assert.ErrorContains(t, err, errorMsg)
+	})
+
+	t.Run("allowed origins", func(t *testing.T) {
+		resp, err := graphTestClient().LoadBalancerPortUpdate(ctx, lbPort.ID, graphclient.UpdateLoadBalancerPortInput{
+			AddPoolIDs: []gidx.PrefixedID{allowedPool.ID},
+		}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, lbPort.ID, resp.LoadBalancerPortUpdate.LoadBalancerPort.ID)
+	})
+}
+
 func TestMutate_OriginDelete(t *testing.T) {
 	ctx := context.Background()
 	perms := new(mockpermissions.MockPermissions)
@@ -365,7 +649,7 @@ func TestMutate_OriginDelete(t *testing.T) {
 		tt := tt
 
 		t.Run(tt.TestName, func(t *testing.T) {
-			deletedOriginResp, err := graphTestClient().LoadBalancerOriginDelete(ctx, tt.OriginID)
+			deletedOriginResp, err := graphTestClient().LoadBalancerOriginDelete(ctx, tt.OriginID, nil)
 
 			if tt.errorMsg != "" {
 				require.Error(t, err)
@@ -383,3 +667,179 @@ func TestMutate_OriginDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryOriginHistory(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	pool := (&testutils.PoolBuilder{}).MustNew(ctx)
+	origin1 := (&testutils.OriginBuilder{PoolID: pool.ID}).MustNew(ctx)
+	origin2 := (&testutils.OriginBuilder{PoolID: pool.ID}).MustNew(ctx)
+
+	EntClient.Origin.DeleteOneID(origin2.ID).ExecX(ctx)
+
+	testCases := []struct {
+		TestName       string
+		QueryID        gidx.PrefixedID
+		Checker        permissions.Checker
+		ExpectedOrigin *ent.Origin
+		Deleted        bool
+		errorMsg       string
+	}{
+		{
+			TestName:       "get origin",
+			QueryID:        origin1.ID,
+			ExpectedOrigin: origin1,
+		},
+		{
+			TestName:       "get deleted origin",
+			QueryID:        origin2.ID,
+			ExpectedOrigin: origin2,
+			Deleted:        true,
+		},
+		{
+			TestName: "origin not found",
+			QueryID:  gidx.MustNewID("loadogn"),
+			errorMsg: "not found",
+		},
+		{
+			TestName: "invalid origin ID",
+			QueryID:  "an invalid origin id",
+			errorMsg: "invalid id",
+		},
+		{
+			TestName: "permission denied",
+			QueryID:  origin2.ID,
+			Checker:  denyActionChecker("loadbalancerpool_get_history"),
+			errorMsg: permissions.ErrPermissionDenied.Error(),
+		},
+	}
+
+	for _, tt := range testCases {
+		// lint
+		tt := tt
+
+		t.Run(tt.TestName, func(t *testing.T) {
+			ctx := ctx
+			if tt.Checker != nil {
+				ctx = context.WithValue(ctx, permissions.CheckerCtxKey, tt.Checker)
+			}
+
+			resp, err := graphTestClient().GetLoadBalancerOriginHistory(ctx, tt.QueryID)
+			if tt.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.errorMsg)
+				assert.Nil(t, resp)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			assert.Equal(t, tt.ExpectedOrigin.ID, resp.LoadBalancerOriginHistory.ID)
+			assert.Equal(t, tt.ExpectedOrigin.Target, resp.LoadBalancerOriginHistory.Target)
+			assert.Equal(t, tt.Deleted, resp.LoadBalancerOriginHistory.DeletedAt != nil)
+		})
+	}
+}
+
+func TestMutate_OriginPermissions(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	pool := (&testutils.PoolBuilder{}).MustNew(ctx)
+	origin := (&testutils.OriginBuilder{PoolID: pool.ID}).MustNew(ctx)
+
+	testCases := []struct {
+		TestName string
+		Checker  permissions.Checker
+		Run      func(ctx context.Context) error
+		errorMsg string
+	}{
+		{
+			TestName: "create is denied without origin create",
+			Checker:  denyActionChecker("loadbalancerorigin_create"),
+			Run: func(ctx context.Context) error {
+				_, err := graphTestClient().LoadBalancerOriginCreate(ctx, graphclient.CreateLoadBalancerOriginInput{
+					Name:       "denied",
+					Target:     "1.2.3.4",
+					PortNumber: 80,
+					PoolID:     pool.ID,
+				})
+				return err
+			},
+			errorMsg: permissions.ErrPermissionDenied.Error(),
+		},
+		{
+			TestName: "create is allowed without pool update",
+			Checker:  denyActionChecker("loadbalancerpool_update"),
+			Run: func(ctx context.Context) error {
+				_, err := graphTestClient().LoadBalancerOriginCreate(ctx, graphclient.CreateLoadBalancerOriginInput{
+					Name:       "allowed",
+					Target:     "1.2.3.4",
+					PortNumber: 80,
+					PoolID:     pool.ID,
+				})
+				return err
+			},
+		},
+		{
+			TestName: "update is denied without origin update on the pool",
+			Checker:  denyResourceActionChecker(pool.ID, "loadbalancerorigin_update"),
+			Run: func(ctx context.Context) error {
+				_, err := graphTestClient().LoadBalancerOriginUpdate(ctx, origin.ID, graphclient.UpdateLoadBalancerOriginInput{Name: newString("updated")}, nil)
+				return err
+			},
+			errorMsg: permissions.ErrPermissionDenied.Error(),
+		},
+		{
+			// existing origins have no relationships, so origin actions are checked on the pool
+			TestName: "update is not checked on the origin",
+			Checker:  denyResourceActionChecker(origin.ID, "loadbalancerorigin_update"),
+			Run: func(ctx context.Context) error {
+				_, err := graphTestClient().LoadBalancerOriginUpdate(ctx, origin.ID, graphclient.UpdateLoadBalancerOriginInput{Name: newString("allowed")}, nil)
+				return err
+			},
+		},
+		{
+			TestName: "delete is denied without origin delete on the pool",
+			Checker:  denyResourceActionChecker(pool.ID, "loadbalancerorigin_delete"),
+			Run: func(ctx context.Context) error {
+				_, err := graphTestClient().LoadBalancerOriginDelete(ctx, origin.ID, nil)
+				return err
+			},
+			errorMsg: permissions.ErrPermissionDenied.Error(),
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+
+		t.Run(tt.TestName, func(t *testing.T) {
+			err := tt.Run(context.WithValue(ctx, permissions.CheckerCtxKey, tt.Checker))
+
+			if tt.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.errorMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -6,14 +6,14 @@ package graphapi
 
 import (
 	"context"
-	"fmt"
 
 	"entgo.io/contrib/entgql"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/loadbalancer"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/pool"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/provider"
 	_ "go.infratographer.com/load-balancer-api/internal/ent/generated/runtime"
-	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/load-balancer-api/internal/ent/schema/softdelete"
 	"go.infratographer.com/x/gidx"
 )
 
@@ -33,17 +33,25 @@ func (r *loadBalancerProviderResolver) Owner(ctx context.Context, obj *generated
 }
 
 // LoadBalancers is the resolver for the loadBalancers field.
-func (r *resourceOwnerResolver) LoadBalancers(ctx context.Context, obj *ResourceOwner, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.LoadBalancerOrder, where *generated.LoadBalancerWhereInput) (*generated.LoadBalancerConnection, error) {
-	if err := permissions.CheckAccess(ctx, obj.ID, actionLoadBalancerGet); err != nil {
+func (r *resourceOwnerResolver) LoadBalancers(ctx context.Context, obj *ResourceOwner, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.LoadBalancerOrder, where *generated.LoadBalancerWhereInput, includeDeleted *bool) (*generated.LoadBalancerConnection, error) {
+	if err := checkAccess(ctx, obj.ID, actionLoadBalancerGet); err != nil {
 		return nil, err
 	}
 
+	if includeDeleted != nil && *includeDeleted {
+		if err := checkAccess(ctx, obj.ID, actionLoadBalancerGetHistory); err != nil {
+			return nil, err
+		}
+
+		ctx = softdelete.SkipSoftDelete(ctx)
+	}
+
 	return r.client.LoadBalancer.Query().Where(loadbalancer.OwnerID(obj.ID)).Paginate(ctx, after, first, before, last, generated.WithLoadBalancerOrder(orderBy), generated.WithLoadBalancerFilter(where.Filter))
 }
 
 // LoadBalancerPools is the resolver for the loadBalancerPools field.
 func (r *resourceOwnerResolver) LoadBalancerPools(ctx context.Context, obj *ResourceOwner, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.LoadBalancerPoolOrder, where *generated.LoadBalancerPoolWhereInput) (*generated.LoadBalancerPoolConnection, error) {
-	if err := permissions.CheckAccess(ctx, obj.ID, actionLoadBalancerPoolGet); err != nil {
+	if err := checkAccess(ctx, obj.ID, actionLoadBalancerPoolGet); err != nil {
 		return nil, err
 	}
 
@@ -51,8 +59,12 @@ func (r *resourceOwnerResolver) LoadBalancerPools(ctx context.Context, obj *Reso
 }
 
 // LoadBalancersProviders is the resolver for the loadBalancersProviders field.
-func (r *resourceOwnerResolver) LoadBalancersProviders(ctx context.Context, obj *ResourceOwner, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.LoadBalancerOrder, where *generated.LoadBalancerProviderWhereInput) (*generated.LoadBalancerProviderConnection, error) {
-	panic(fmt.Errorf("not implemented: LoadBalancersProviders - loadBalancersProviders"))
+func (r *resourceOwnerResolver) LoadBalancersProviders(ctx context.Context, obj *ResourceOwner, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.LoadBalancerProviderOrder, where *generated.LoadBalancerProviderWhereInput) (*generated.LoadBalancerProviderConnection, error) {
+	if err := checkAccess(ctx, obj.ID, actionLoadBalancerProviderGet); err != nil {
+		return nil, err
+	}
+
+	return r.client.Provider.Query().Where(provider.OwnerID(obj.ID)).Paginate(ctx, after, first, before, last, generated.WithLoadBalancerProviderOrder(orderBy), generated.WithLoadBalancerProviderFilter(where.Filter))
 }
 
 // ResourceOwner returns ResourceOwnerResolver implementation.
@@ -20,6 +20,7 @@ func TestOwnerLoadBalancersResolver(t *testing.T) {
 	ctx := context.Background()
 	perms := new(mockpermissions.MockPermissions)
 	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	ctx = perms.ContextWithHandler(ctx)
 
@@ -30,16 +31,22 @@ func TestOwnerLoadBalancersResolver(t *testing.T) {
 	lb1 := (&testutils.LoadBalancerBuilder{OwnerID: ownerID, LocationID: "testloc-CCCafdsaf", Name: "lb-a"}).MustNew(ctx)
 	lb2 := (&testutils.LoadBalancerBuilder{OwnerID: ownerID, LocationID: "testloc-AAAfasdf", Name: "lb-c"}).MustNew(ctx)
 	lb3 := (&testutils.LoadBalancerBuilder{OwnerID: ownerID, LocationID: "testloc-BBBasdfa", Name: "lb-1"}).MustNew(ctx)
+	lb4 := (&testutils.LoadBalancerBuilder{OwnerID: ownerID, LocationID: "testloc-DDDfdsaf", Name: "lb-b"}).MustNew(ctx)
 	(&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+
+	EntClient.LoadBalancer.DeleteOneID(lb4.ID).ExecX(ctx)
+
 	// Update LB1 so it's updated at is most recent
 	lb1.Update().SaveX(ctx)
 
 	testCases := []struct {
-		TestName      string
-		OrderBy       *graphclient.LoadBalancerOrder
-		OwnerID       gidx.PrefixedID
-		ResponseOrder []*ent.LoadBalancer
-		errorMsg      string
+		TestName       string
+		OrderBy        *graphclient.LoadBalancerOrder
+		OwnerID        gidx.PrefixedID
+		IncludeDeleted *bool
+		Checker        permissions.Checker
+		ResponseOrder  []*ent.LoadBalancer
+		errorMsg       string
 	}{
 		{
 			TestName:      "Get Owner LoadBalancers - Ordered By NAME ASC",
@@ -77,6 +84,20 @@ func TestOwnerLoadBalancersResolver(t *testing.T) {
 			OwnerID:       ownerID,
 			ResponseOrder: []*ent.LoadBalancer{lb1, lb3, lb2},
 		},
+		{
+			TestName:       "Get Owner LoadBalancers - Include Deleted",
+			OrderBy:        &graphclient.LoadBalancerOrder{Field: "NAME", Direction: "ASC"},
+			OwnerID:        ownerID,
+			IncludeDeleted: newBool(true),
+			ResponseOrder:  []*ent.LoadBalancer{lb3, lb1, lb4, lb2},
+		},
+		{
+			TestName:       "Get Owner LoadBalancers - Include Deleted Permission Denied",
+			OwnerID:        ownerID,
+			IncludeDeleted: newBool(true),
+			Checker:        denyActionChecker("loadbalancer_get_history"),
+			errorMsg:       permissions.ErrPermissionDenied.Error(),
+		},
 		{
 			TestName:      "Get Owner LoadBalancers - No LBs for Owner",
 			OwnerID:       gidx.MustNewID(ownerPrefix),
@@ -86,7 +107,12 @@ func TestOwnerLoadBalancersResolver(t *testing.T) {
 
 	for _, tt := range testCases {
 		t.Run(tt.TestName, func(t *testing.T) {
-			resp, err := graphTestClient().GetOwnerLoadBalancers(ctx, tt.OwnerID, tt.OrderBy)
+			ctx := ctx
+			if tt.Checker != nil {
+				ctx = context.WithValue(ctx, permissions.CheckerCtxKey, tt.Checker)
+			}
+
+			resp, err := graphTestClient().GetOwnerLoadBalancers(ctx, tt.OwnerID, tt.OrderBy, tt.IncludeDeleted, nil)
 
 			if tt.errorMsg != "" {
 				assert.Error(t, err)
@@ -105,3 +131,79 @@ func TestOwnerLoadBalancersResolver(t *testing.T) {
 		})
 	}
 }
+
+func TestOwnerLoadBalancerProvidersResolver(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	ownerID := gidx.MustNewID(ownerPrefix)
+	prov1 := (&testutils.ProviderBuilder{OwnerID: ownerID, Name: "provider-b"}).MustNew(ctx)
+	prov2 := (&testutils.ProviderBuilder{OwnerID: ownerID, Name: "provider-a"}).MustNew(ctx)
+	(&testutils.ProviderBuilder{}).MustNew(ctx)
+
+	testCases := []struct {
+		TestName      string
+		OrderBy       *graphclient.LoadBalancerProviderOrder
+		OwnerID       gidx.PrefixedID
+		Checker       permissions.Checker
+		ResponseOrder []*ent.Provider
+		errorMsg      string
+	}{
+		{
+			TestName:      "Get Owner LoadBalancerProviders - Ordered By NAME ASC",
+			OrderBy:       &graphclient.LoadBalancerProviderOrder{Field: "NAME", Direction: "ASC"},
+			OwnerID:       ownerID,
+			ResponseOrder: []*ent.Provider{prov2, prov1},
+		},
+		{
+			TestName:      "Get Owner LoadBalancerProviders - Ordered By CREATED_AT ASC",
+			OrderBy:       &graphclient.LoadBalancerProviderOrder{Field: "CREATED_AT", Direction: "ASC"},
+			OwnerID:       ownerID,
+			ResponseOrder: []*ent.Provider{prov1, prov2},
+		},
+		{
+			TestName: "Get Owner LoadBalancerProviders - Permission Denied",
+			OwnerID:  ownerID,
+			Checker:  denyActionChecker("loadbalancerprovider_get"),
+			errorMsg: permissions.ErrPermissionDenied.Error(),
+		},
+		{
+			TestName:      "Get Owner LoadBalancerProviders - No Providers for Owner",
+			OwnerID:       gidx.MustNewID(ownerPrefix),
+			ResponseOrder: []*ent.Provider{},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			ctx := ctx
+			if tt.Checker != nil {
+				ctx = context.WithValue(ctx, permissions.CheckerCtxKey, tt.Checker)
+			}
+
+			resp, err := graphTestClient().GetOwnerLoadBalancerProviders(ctx, tt.OwnerID, tt.OrderBy, nil)
+
+			if tt.errorMsg != "" {
+				assert.Error(t, err)
+				assert.ErrorContains(t, err, tt.errorMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, resp.Entities[0].LoadBalancersProviders.Edges, len(tt.ResponseOrder))
+
+			for i, prov := range tt.ResponseOrder {
+				respProv := resp.Entities[0].LoadBalancersProviders.Edges[i].Node
+				require.Equal(t, prov.ID, respProv.ID)
+				require.Equal(t, prov.Name, respProv.Name)
+			}
+		})
+	}
+}
@@ -1,11 +1,23 @@
 package graphapi
 
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/load-balancer-api/internal/ent/generated"
+)
+
 const (
 	actionLoadBalancerCreate     = "loadbalancer_create"
 	actionLoadBalancerUpdate     = "loadbalancer_update"
 	actionLoadBalancerDelete     = "loadbalancer_delete"
 	actionLoadBalancerGet        = "loadbalancer_get"
 	actionLoadBalancerGetHistory = "loadbalancer_get_history"
+	actionLoadBalancerResync     = "loadbalancer_resync"
 
 	actionLoadBalancerPoolCreate     = "loadbalancerpool_create"
 	actionLoadBalancerPoolUpdate     = "loadbalancerpool_update"
@@ -13,6 +25,16 @@ const (
 	actionLoadBalancerPoolGet        = "loadbalancerpool_get"
 	actionLoadBalancerPoolGetHistory = "loadbalancerpool_get_history"
 
+	actionLoadBalancerPortCreate = "loadbalancerport_create"
+	actionLoadBalancerPortUpdate = "loadbalancerport_update"
+	actionLoadBalancerPortDelete = "loadbalancerport_delete"
+	actionLoadBalancerPortGet    = "loadbalancerport_get"
+
+	actionLoadBalancerOriginCreate = "loadbalancerorigin_create"
+	actionLoadBalancerOriginUpdate = "loadbalancerorigin_update"
+	actionLoadBalancerOriginDelete = "loadbalancerorigin_delete"
+	actionLoadBalancerOriginGet    = "loadbalancerorigin_get"
+
 	actionLoadBalancerProviderCreate     = "loadbalancerprovider_create"
 	actionLoadBalancerProviderUpdate     = "loadbalancerprovider_update"
 	actionLoadBalancerProviderDelete     = "loadbalancerprovider_delete"
@@ -21,3 +43,126 @@ const (
 
 	actionLocationGet = "location_get"
 )
+
+// maxConcurrentAccessChecks bounds the permission checks of a batch that run at the same time
+const maxConcurrentAccessChecks = 10
+
+type accessCacheCtxKey struct{}
+
+// accessCache remembers the permission decisions made while resolving an operation, so each
+// resource and action pair is checked at most once per request
+type accessCache struct {
+	mu        sync.Mutex
+	decisions map[permissions.AccessRequest]*accessDecision
+}
+
+type accessDecision struct {
+	done chan struct{}
+	err  error
+}
+
+func withAccessCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, accessCacheCtxKey{}, &accessCache{
+		decisions: map[permissions.AccessRequest]*accessDecision{},
+	})
+}
+
+func (c *accessCache) check(ctx context.Context, req permissions.AccessRequest) error {
+	c.mu.Lock()
+
+	if d, ok := c.decisions[req]; ok {
+		c.mu.Unlock()
+		<-d.done
+
+		return d.err
+	}
+
+	d := &accessDecision{done: make(chan struct{})}
+	c.decisions[req] = d
+
+	c.mu.Unlock()
+
+	d.err = permissions.CheckAccess(ctx, req.ResourceID, req.Action)
+
+	// only decisions are kept, failed checks are tried again by the next caller
+	if d.err != nil && !errors.Is(d.err, permissions.ErrPermissionDenied) {
+		c.mu.Lock()
+		delete(c.decisions, req)
+		c.mu.Unlock()
+	}
+
+	close(d.done)
+
+	return d.err
+}
+
+// checkAccess checks if the subject may perform the action on the resource, using the decisions
+// already made for the request when there are any
+func checkAccess(ctx context.Context, resource gidx.PrefixedID, action string) error {
+	cache, ok := ctx.Value(accessCacheCtxKey{}).(*accessCache)
+	if !ok {
+		return permissions.CheckAccess(ctx, resource, action)
+	}
+
+	return cache.check(ctx, permissions.AccessRequest{ResourceID: resource, Action: action})
+}
+
+// accessRequests returns a request for the action on each of the resources
+func accessRequests(resources []gidx.PrefixedID, action string) []permissions.AccessRequest {
+	requests := make([]permissions.AccessRequest, len(resources))
+	for i, id := range resources {
+		requests[i] = permissions.AccessRequest{ResourceID: id, Action: action}
+	}
+
+	return requests
+}
+
+// portAccessRequests returns a request for the action on the load balancer of each port. Port
+// actions are checked on the load balancer and origin actions on the pool, because ports and
+// origins created before they had relationships of their own can't be resolved through them.
+func portAccessRequests(ports []*generated.Port, action string) []permissions.AccessRequest {
+	requests := make([]permissions.AccessRequest, len(ports))
+	for i, p := range ports {
+		requests[i] = permissions.AccessRequest{ResourceID: p.LoadBalancerID, Action: action}
+	}
+
+	return requests
+}
+
+// checkEach checks the requests concurrently and returns the result of every request in order
+func checkEach(ctx context.Context, requests ...permissions.AccessRequest) []error {
+	errs := make([]error, len(requests))
+	sem := make(chan struct{}, maxConcurrentAccessChecks)
+
+	var wg sync.WaitGroup
+
+	for i, req := range requests {
+		wg.Add(1)
+
+		sem <- struct{}{}
+
+		go func(i int, req permissions.AccessRequest) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			errs[i] = checkAccess(ctx, req.ResourceID, req.Action)
+		}(i, req)
+	}
+
+	wg.Wait()
+
+	return errs
+}
+
+// checkAll checks the requests concurrently and returns the error of the first request that failed
+func checkAll(ctx context.Context, requests ...permissions.AccessRequest) error {
+	for _, err := range checkEach(ctx, requests...) {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,213 @@
+package graphapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// DefaultPersistedQueryCacheSize is the number of automatic persisted queries kept in memory when no
+// cache is configured
+const DefaultPersistedQueryCacheSize = 100
+
+// federationFields are the root fields the federation gateway queries the subgraph with, queries
+// selecting only these are accepted by the allowlist
+var federationFields = map[string]bool{
+	"_entities":  true,
+	"_service":   true,
+	"__typename": true,
+}
+
+// PersistedQueryAllowlist holds the only queries accepted when the allowlist is enabled, keyed by
+// the sha256 hash of their text. Federation queries are accepted as well. It is also the persisted query cache in that case, so clients can
+// send just the hash of an allowed query.
+type PersistedQueryAllowlist struct {
+	queries map[string]string
+}
+
+var _ interface {
+	graphql.Cache
+	graphql.HandlerExtension
+	graphql.OperationParameterMutator
+} = (*PersistedQueryAllowlist)(nil)
+
+// LoadPersistedQueryAllowlist allows the operations of the .graphql files in dir. Each operation is
+// allowed as the document gqlgenc generates for it: the operation and the fragments it uses,
+// printed by the gqlparser formatter.
+func LoadPersistedQueryAllowlist(dir string) (*PersistedQueryAllowlist, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.graphql"))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNoPersistedQueries, dir)
+	}
+
+	a := &PersistedQueryAllowlist{queries: map[string]string{}}
+
+	for _, file := range files {
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		doc, gerr := parser.ParseQuery(&ast.Source{Name: file, Input: string(b)})
+		if gerr != nil {
+			return nil, fmt.Errorf("parsing %s: %w", file, gerr)
+		}
+
+		for _, op := range doc.Operations {
+			a.add(operationDocument(doc, op))
+		}
+	}
+
+	return a, nil
+}
+
+// Len returns the number of allowed queries
+func (a *PersistedQueryAllowlist) Len() int {
+	return len(a.queries)
+}
+
+// Get implements graphql.Cache
+func (a *PersistedQueryAllowlist) Get(_ context.Context, hash string) (interface{}, bool) {
+	query, ok := a.queries[hash]
+
+	return query, ok
+}
+
+// Add implements graphql.Cache. Queries sent by clients are never added to the allowlist.
+func (a *PersistedQueryAllowlist) Add(context.Context, string, interface{}) {}
+
+func (a *PersistedQueryAllowlist) add(query string) {
+	a.queries[queryHash(query)] = query
+}
+
+func (a *PersistedQueryAllowlist) allows(query string) bool {
+	if _, ok := a.queries[queryHash(query)]; ok {
+		return true
+	}
+
+	return isFederationQuery(query)
+}
+
+// ExtensionName implements graphql.HandlerExtension
+func (a *PersistedQueryAllowlist) ExtensionName() string {
+	return "PersistedQueryAllowlist"
+}
+
+// Validate implements graphql.HandlerExtension
+func (a *PersistedQueryAllowlist) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+// MutateOperationParameters implements graphql.OperationParameterMutator. It runs after the
+// persisted query extension, so queries sent by their hash are already resolved.
+func (a *PersistedQueryAllowlist) MutateOperationParameters(_ context.Context, rawParams *graphql.RawParams) *gqlerror.Error {
+	if a.allows(rawParams.Query) {
+		return nil
+	}
+
+	return &gqlerror.Error{Message: ErrQueryNotAllowed.Error(), Err: ErrQueryNotAllowed}
+}
+
+// WithPersistedQueryCache sets the cache automatic persisted queries are stored in, an in-memory
+// LRU cache is used by default
+func WithPersistedQueryCache(c graphql.Cache) func(*Resolver) {
+	return func(r *Resolver) {
+		r.persistedQueries = c
+	}
+}
+
+// WithPersistedQueryAllowlist only accepts the queries in the allowlist, replacing the persisted
+// query cache
+func WithPersistedQueryAllowlist(a *PersistedQueryAllowlist) func(*Resolver) {
+	return func(r *Resolver) {
+		r.allowlist = a
+	}
+}
+
+// operationDocument returns the text of a document holding the operation and the fragments it uses
+func operationDocument(doc *ast.QueryDocument, op *ast.OperationDefinition) string {
+	fragments := ast.FragmentDefinitionList{}
+	seen := map[string]bool{}
+
+	var walk func(ast.SelectionSet)
+
+	walk = func(set ast.SelectionSet) {
+		for _, sel := range set {
+			switch s := sel.(type) {
+			case *ast.Field:
+				walk(s.SelectionSet)
+			case *ast.InlineFragment:
+				walk(s.SelectionSet)
+			case *ast.FragmentSpread:
+				f := doc.Fragments.ForName(s.Name)
+				if f == nil || seen[f.Name] {
+					continue
+				}
+
+				seen[f.Name] = true
+				fragments = append(fragments, f)
+
+				walk(f.SelectionSet)
+			}
+		}
+	}
+
+	walk(op.SelectionSet)
+
+	sort.Slice(fragments, func(i, j int) bool { return fragments[i].Name < fragments[j].Name })
+
+	var buf bytes.Buffer
+
+	formatter.NewFormatter(&buf).FormatQueryDocument(&ast.QueryDocument{
+		Operations: ast.OperationList{op},
+		Fragments:  fragments,
+	})
+
+	return buf.String()
+}
+
+// isFederationQuery returns true when every operation of the query is a query selecting only
+// federation fields
+func isFederationQuery(query string) bool {
+	doc, err := parser.ParseQuery(&ast.Source{Input: query})
+	if err != nil || len(doc.Operations) == 0 {
+		return false
+	}
+
+	for _, op := range doc.Operations {
+		if op.Operation != ast.Query {
+			return false
+		}
+
+		for _, sel := range op.SelectionSet {
+			f, ok := sel.(*ast.Field)
+			if !ok || !federationFields[f.Name] {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// queryHash returns the hash automatic persisted queries use for the query
+func queryHash(query string) string {
+	b := sha256.Sum256([]byte(query))
+
+	return hex.EncodeToString(b[:])
+}
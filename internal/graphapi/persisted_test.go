@@ -0,0 +1,202 @@
+package graphapi_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	gqlclient "github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/permissions-api/pkg/permissions/mockpermissions"
+	"go.uber.org/zap"
+
+	"go.infratographer.com/load-balancer-api/internal/graphapi"
+	"go.infratographer.com/load-balancer-api/internal/graphclient"
+	"go.infratographer.com/load-balancer-api/internal/testutils"
+)
+
+const persistedQuery = `query($id: ID!) { loadBalancer(id: $id) { id } }`
+
+func persistedQueryHash(query string) string {
+	hash := sha256.Sum256([]byte(query))
+
+	return hex.EncodeToString(hash[:])
+}
+
+func persistedQueryExtension(query string) map[string]interface{} {
+	return map[string]interface{}{
+		"persistedQuery": map[string]interface{}{
+			"version":    1,
+			"sha256Hash": persistedQueryHash(query),
+		},
+	}
+}
+
+// persistedQueryErrors returns the message and code of each error of the response
+func persistedQueryErrors(t *testing.T, resp *gqlclient.Response) [][2]interface{} {
+	t.Helper()
+
+	if len(resp.Errors) == 0 {
+		return nil
+	}
+
+	var errs []struct {
+		Message    string
+		Extensions map[string]interface{}
+	}
+
+	require.NoError(t, json.Unmarshal(resp.Errors, &errs))
+
+	out := [][2]interface{}{}
+	for _, e := range errs {
+		out = append(out, [2]interface{}{e.Message, e.Extensions["code"]})
+	}
+
+	return out
+}
+
+func TestPersistedQueryCache(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	lb := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+
+	cache := graphql.MapCache{}
+
+	h := graphapi.NewResolver(EntClient, zap.NewNop().Sugar(), graphapi.WithPersistedQueryCache(cache)).Handler(false).Handler()
+
+	// the permissions middleware is not part of the handler, so the checker is set on the request
+	withChecker := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), permissions.CheckerCtxKey, permissions.DefaultAllowChecker)))
+	})
+
+	c := gqlclient.New(withChecker)
+
+	// the hash is unknown until the query has been sent with it
+	resp, err := c.RawPost("", gqlclient.Var("id", lb.ID), gqlclient.Extensions(persistedQueryExtension(persistedQuery)))
+	require.NoError(t, err)
+	assert.Equal(t, [][2]interface{}{{"PersistedQueryNotFound", "PERSISTED_QUERY_NOT_FOUND"}}, persistedQueryErrors(t, resp))
+
+	resp, err = c.RawPost(persistedQuery, gqlclient.Var("id", lb.ID), gqlclient.Extensions(persistedQueryExtension(persistedQuery)))
+	require.NoError(t, err)
+	assert.Empty(t, persistedQueryErrors(t, resp))
+	assert.Len(t, cache, 1)
+
+	resp, err = c.RawPost("", gqlclient.Var("id", lb.ID), gqlclient.Extensions(persistedQueryExtension(persistedQuery)))
+	require.NoError(t, err)
+	assert.Empty(t, persistedQueryErrors(t, resp))
+	assert.Equal(t, map[string]interface{}{"loadBalancer": map[string]interface{}{"id": lb.ID.String()}}, resp.Data)
+}
+
+func TestPersistedQueryAllowlist(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	lb := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+
+	allowlist, err := graphapi.LoadPersistedQueryAllowlist("../graphclient")
+	require.NoError(t, err)
+
+	h := graphapi.NewResolver(EntClient, zap.NewNop().Sugar(), graphapi.WithPersistedQueryAllowlist(allowlist)).Handler(false).Handler()
+
+	t.Run("allows the generated client", func(t *testing.T) {
+		cli := graphclient.NewClient(&http.Client{Transport: localRoundTripper{handler: h}}, "graph")
+
+		resp, err := cli.GetLoadBalancer(ctx, lb.ID)
+		require.NoError(t, err)
+		assert.Equal(t, lb.ID, resp.LoadBalancer.ID)
+	})
+
+	// the permissions middleware is not part of the handler, so the checker is set on the request
+	withChecker := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), permissions.CheckerCtxKey, permissions.DefaultAllowChecker)))
+	})
+
+	c := gqlclient.New(withChecker)
+
+	t.Run("allows an allowed query sent by its hash", func(t *testing.T) {
+		resp, err := c.RawPost("", gqlclient.Var("id", lb.ID), gqlclient.Extensions(persistedQueryExtension(graphclient.GetLoadBalancerDocument)))
+		require.NoError(t, err)
+		assert.Empty(t, persistedQueryErrors(t, resp))
+		assert.NotNil(t, resp.Data)
+	})
+
+	t.Run("allows federation queries", func(t *testing.T) {
+		resp, err := c.RawPost(`query { _service { sdl } }`)
+		require.NoError(t, err)
+		assert.Empty(t, persistedQueryErrors(t, resp))
+		assert.NotNil(t, resp.Data)
+
+		resp, err = c.RawPost(`query($representations: [_Any!]!) { _entities(representations: $representations) { ... on LoadBalancer { id } } }`,
+			gqlclient.Var("representations", []map[string]interface{}{{"__typename": "LoadBalancer", "id": lb.ID}}))
+		require.NoError(t, err)
+		assert.Empty(t, persistedQueryErrors(t, resp))
+		assert.Equal(t, map[string]interface{}{"_entities": []interface{}{map[string]interface{}{"id": lb.ID.String()}}}, resp.Data)
+	})
+
+	t.Run("rejects queries mixing federation and other fields", func(t *testing.T) {
+		resp, err := c.RawPost(`query($id: ID!) { _service { sdl } loadBalancer(id: $id) { id } }`, gqlclient.Var("id", lb.ID))
+		require.NoError(t, err)
+		assert.Equal(t, [][2]interface{}{{graphapi.ErrQueryNotAllowed.Error(), graphapi.ErrCodeQueryNotAllowed}}, persistedQueryErrors(t, resp))
+	})
+
+	t.Run("rejects other queries", func(t *testing.T) {
+		resp, err := c.RawPost(persistedQuery, gqlclient.Var("id", lb.ID))
+		require.NoError(t, err)
+		assert.Equal(t, [][2]interface{}{{graphapi.ErrQueryNotAllowed.Error(), graphapi.ErrCodeQueryNotAllowed}}, persistedQueryErrors(t, resp))
+		assert.Nil(t, resp.Data)
+	})
+
+	t.Run("does not learn queries sent with their hash", func(t *testing.T) {
+		resp, err := c.RawPost(persistedQuery, gqlclient.Var("id", lb.ID), gqlclient.Extensions(persistedQueryExtension(persistedQuery)))
+		require.NoError(t, err)
+		assert.Equal(t, [][2]interface{}{{graphapi.ErrQueryNotAllowed.Error(), graphapi.ErrCodeQueryNotAllowed}}, persistedQueryErrors(t, resp))
+
+		resp, err = c.RawPost("", gqlclient.Var("id", lb.ID), gqlclient.Extensions(persistedQueryExtension(persistedQuery)))
+		require.NoError(t, err)
+		assert.Equal(t, [][2]interface{}{{"PersistedQueryNotFound", "PERSISTED_QUERY_NOT_FOUND"}}, persistedQueryErrors(t, resp))
+	})
+}
+
+func TestLoadPersistedQueryAllowlist(t *testing.T) {
+	allowlist, err := graphapi.LoadPersistedQueryAllowlist("../graphclient")
+	require.NoError(t, err)
+
+	// every operation of the generated client is allowed
+	for _, doc := range []string{
+		graphclient.GetLoadBalancerDocument,
+		graphclient.GetLoadBalancerPortsDocument,
+		graphclient.GetOwnerLoadBalancersDocument,
+		graphclient.LoadBalancerCreateDocument,
+		graphclient.LoadBalancerApplyDocument,
+		graphclient.LoadBalancerPoolCreateDocument,
+		graphclient.LoadBalancerOriginCreateDocument,
+		graphclient.LoadBalancerProviderCreateDocument,
+	} {
+		query, ok := allowlist.Get(context.Background(), persistedQueryHash(doc))
+		require.True(t, ok, doc)
+		assert.Equal(t, doc, query)
+	}
+
+	_, err = graphapi.LoadPersistedQueryAllowlist(t.TempDir())
+	assert.ErrorIs(t, err, graphapi.ErrNoPersistedQueries)
+}
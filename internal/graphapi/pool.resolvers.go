@@ -7,6 +7,7 @@ package graphapi
 import (
 	"context"
 	"database/sql"
+	"errors"
 
 	"go.infratographer.com/load-balancer-api/internal/ent/generated"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/loadbalancer"
@@ -15,14 +16,14 @@ import (
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/port"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/predicate"
 	_ "go.infratographer.com/load-balancer-api/internal/ent/generated/runtime"
+	"go.infratographer.com/load-balancer-api/internal/ent/schema/softdelete"
 	"go.infratographer.com/load-balancer-api/pkg/metadata"
-	"go.infratographer.com/permissions-api/pkg/permissions"
 	"go.infratographer.com/x/gidx"
 	"golang.org/x/exp/slices"
 )
 
 // LoadBalancerPoolCreate is the resolver for the LoadBalancerPoolCreate field.
-func (r *mutationResolver) LoadBalancerPoolCreate(ctx context.Context, input generated.CreateLoadBalancerPoolInput) (*LoadBalancerPoolCreatePayload, error) {
+func (r *mutationResolver) LoadBalancerPoolCreate(ctx context.Context, input CreateLoadBalancerPoolInput) (*LoadBalancerPoolCreatePayload, error) {
 	logger := r.logger.With("ownerID", input.OwnerID)
 
 	// check gidx owner format
@@ -30,7 +31,7 @@ func (r *mutationResolver) LoadBalancerPoolCreate(ctx context.Context, input gen
 		return nil, err
 	}
 
-	if err := permissions.CheckAccess(ctx, input.OwnerID, actionLoadBalancerPoolCreate); err != nil {
+	if err := checkAccess(ctx, input.OwnerID, actionLoadBalancerPoolCreate); err != nil {
 		return nil, err
 	}
 
@@ -44,14 +45,51 @@ func (r *mutationResolver) LoadBalancerPoolCreate(ctx context.Context, input gen
 		return nil, ErrPortNotFound
 	}
 
-	for _, portId := range input.PortIDs {
-		if err := permissions.CheckAccess(ctx, portId, actionLoadBalancerGet); err != nil {
-			logger.Errorw("failed to check access", "error", err, "loadbalancerPortID", portId)
+	for i, err := range checkEach(ctx, portAccessRequests(ports, actionLoadBalancerPortGet)...) {
+		if err != nil {
+			logger.Errorw("failed to check access", "error", err, "loadbalancerPortID", ports[i].ID)
 			return nil, err
 		}
 	}
 
-	pool, err := r.client.Pool.Create().SetInput(input).Save(ctx)
+	if err := r.validateOriginTargets(ctx, "portIDs", origin.IDIn(input.OriginIDs...), loadbalancer.HasPortsWith(port.IDIn(input.PortIDs...))); err != nil {
+		var fieldErr *ErrInvalidField
+		if errors.As(err, &fieldErr) {
+			return nil, err
+		}
+
+		logger.Errorw("failed to validate origin targets", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	claim, err := r.claimIdempotencyKey(ctx, input.IdempotencyKey, input.OwnerID, "loadBalancerPoolCreate", input)
+	if err != nil {
+		return nil, err
+	}
+
+	if id, ok := claim.replayID(); ok {
+		pl, err := r.client.Pool.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		return &LoadBalancerPoolCreatePayload{LoadBalancerPool: pl}, nil
+	}
+
+	defer claim.release(ctx)
+
+	var pool *generated.Pool
+
+	err = r.createInTx(ctx, claim, func(tx *generated.Tx) (gidx.PrefixedID, error) {
+		var err error
+
+		pool, err = tx.Pool.Create().SetInput(input.CreateLoadBalancerPoolInput).Save(ctx)
+		if err != nil {
+			return "", err
+		}
+
+		return pool.ID, nil
+	})
 	if err != nil {
 		if generated.IsValidationError(err) {
 			return nil, err
@@ -61,6 +99,9 @@ func (r *mutationResolver) LoadBalancerPoolCreate(ctx context.Context, input gen
 		return nil, ErrInternalServerError
 	}
 
+	// detach the pool from the committed transaction so edges can still be resolved
+	pool = pool.Unwrap()
+
 	// if there are multiple loadbalancer ports with the same loadbalancer id, ensure the slice unique
 	p := slices.CompactFunc(ports, func(x, y *generated.Port) bool {
 		return x.LoadBalancerID == y.LoadBalancerID
@@ -78,7 +119,7 @@ func (r *mutationResolver) LoadBalancerPoolCreate(ctx context.Context, input gen
 }
 
 // LoadBalancerPoolUpdate is the resolver for the LoadBalancerPoolUpdate field.
-func (r *mutationResolver) LoadBalancerPoolUpdate(ctx context.Context, id gidx.PrefixedID, input generated.UpdateLoadBalancerPoolInput) (*LoadBalancerPoolUpdatePayload, error) {
+func (r *mutationResolver) LoadBalancerPoolUpdate(ctx context.Context, id gidx.PrefixedID, input generated.UpdateLoadBalancerPoolInput, expectedVersion *int) (*LoadBalancerPoolUpdatePayload, error) {
 	logger := r.logger.With("loadbalancerPoolID", id)
 
 	// check gidx format
@@ -86,7 +127,7 @@ func (r *mutationResolver) LoadBalancerPoolUpdate(ctx context.Context, id gidx.P
 		return nil, newInvalidFieldError("id", err)
 	}
 
-	pool, err := r.client.Pool.Get(ctx, id)
+	p, err := r.client.Pool.Get(ctx, id)
 	if err != nil {
 		if generated.IsNotFound(err) {
 			return nil, err
@@ -96,11 +137,15 @@ func (r *mutationResolver) LoadBalancerPoolUpdate(ctx context.Context, id gidx.P
 		return nil, ErrInternalServerError
 	}
 
-	if err := permissions.CheckAccess(ctx, pool.OwnerID, actionLoadBalancerPoolUpdate); err != nil {
+	if err := checkAccess(ctx, p.OwnerID, actionLoadBalancerPoolUpdate); err != nil {
 		return nil, err
 	}
 
-	ports, err := r.client.Port.Query().Where(port.HasLoadBalancerWith(loadbalancer.OwnerIDEQ(pool.OwnerID))).Where(port.IDIn(input.AddPortIDs...)).All(ctx)
+	if err := validateVersion(expectedVersion, p.Version); err != nil {
+		return nil, err
+	}
+
+	ports, err := r.client.Port.Query().Where(port.HasLoadBalancerWith(loadbalancer.OwnerIDEQ(p.OwnerID))).Where(port.IDIn(input.AddPortIDs...)).All(ctx)
 	if err != nil {
 		logger.Errorw("failed to query input ports", "error", err)
 		return nil, ErrInternalServerError
@@ -110,41 +155,67 @@ func (r *mutationResolver) LoadBalancerPoolUpdate(ctx context.Context, id gidx.P
 		return nil, ErrPortNotFound
 	}
 
-	for _, portId := range input.AddPortIDs {
-		if err := permissions.CheckAccess(ctx, portId, actionLoadBalancerGet); err != nil {
-			logger.Errorw("failed to check access", "error", err, "loadbalancerPortID", portId)
+	for i, err := range checkEach(ctx, portAccessRequests(ports, actionLoadBalancerPortGet)...) {
+		if err != nil {
+			logger.Errorw("failed to check access", "error", err, "loadbalancerPortID", ports[i].ID)
 			return nil, err
 		}
 	}
 
-	pool, err = pool.Update().SetInput(input).Save(ctx)
+	// origins of the pool become reachable through the added ports, and added origins
+	// through every port of the pool
+	if len(input.AddPortIDs) > 0 || len(input.AddOriginIDs) > 0 {
+		err := r.validateOriginTargets(ctx, "addPortIDs",
+			origin.Or(origin.PoolIDEQ(p.ID), origin.IDIn(input.AddOriginIDs...)),
+			loadbalancer.HasPortsWith(port.Or(port.IDIn(input.AddPortIDs...), port.HasPoolsWith(pool.IDEQ(p.ID)))),
+		)
+		if err != nil {
+			var fieldErr *ErrInvalidField
+			if errors.As(err, &fieldErr) {
+				return nil, err
+			}
+
+			logger.Errorw("failed to validate origin targets", "error", err)
+			return nil, ErrInternalServerError
+		}
+	}
+
+	update := p.Update().SetInput(input)
+	if expectedVersion != nil {
+		update.Where(pool.VersionEQ(*expectedVersion))
+	}
+
+	p, err = update.Save(ctx)
 	if err != nil {
-		if generated.IsValidationError(err) {
+		switch {
+		case generated.IsValidationError(err):
 			return nil, err
+		case generated.IsNotFound(err):
+			return nil, ErrVersionMismatch
+		default:
+			logger.Errorw("failed to update loadbalancer pool", "error", err)
+			return nil, ErrInternalServerError
 		}
-
-		logger.Errorw("failed to update loadbalancer pool", "error", err)
-		return nil, ErrInternalServerError
 	}
 
 	// if there are multiple loadbalancer ports with the same loadbalancer id, ensure the slice unique
-	p := slices.CompactFunc(ports, func(x, y *generated.Port) bool {
+	lbPorts := slices.CompactFunc(ports, func(x, y *generated.Port) bool {
 		return x.LoadBalancerID == y.LoadBalancerID
 	})
 
 	// update metadata status for the port loadbalancer
-	for _, port := range p {
+	for _, port := range lbPorts {
 		status := &metadata.LoadBalancerStatus{State: metadata.LoadBalancerStateUpdating}
 		if err := r.LoadBalancerStatusUpdate(ctx, port.LoadBalancerID, status); err != nil {
 			logger.Errorw("failed to update loadbalancer metadata status", "error", err, "loadbalancerID", port.LoadBalancerID)
 		}
 	}
 
-	return &LoadBalancerPoolUpdatePayload{LoadBalancerPool: pool}, nil
+	return &LoadBalancerPoolUpdatePayload{LoadBalancerPool: p}, nil
 }
 
 // LoadBalancerPoolDelete is the resolver for the loadBalancerPoolDelete field.
-func (r *mutationResolver) LoadBalancerPoolDelete(ctx context.Context, id gidx.PrefixedID) (*LoadBalancerPoolDeletePayload, error) {
+func (r *mutationResolver) LoadBalancerPoolDelete(ctx context.Context, id gidx.PrefixedID, expectedVersion *int) (*LoadBalancerPoolDeletePayload, error) {
 	logger := r.logger.With("loadbalancerPoolID", id)
 
 	// check gidx format
@@ -162,7 +233,11 @@ func (r *mutationResolver) LoadBalancerPoolDelete(ctx context.Context, id gidx.P
 		return nil, ErrInternalServerError
 	}
 
-	if err := permissions.CheckAccess(ctx, p.OwnerID, actionLoadBalancerPoolDelete); err != nil {
+	if err := checkAccess(ctx, p.OwnerID, actionLoadBalancerPoolDelete); err != nil {
+		return nil, err
+	}
+
+	if err := validateVersion(expectedVersion, p.Version); err != nil {
 		return nil, err
 	}
 
@@ -189,7 +264,16 @@ func (r *mutationResolver) LoadBalancerPoolDelete(ctx context.Context, id gidx.P
 	}
 
 	// delete pool
-	if err := tx.Pool.DeleteOneID(id).Exec(ctx); err != nil {
+	del := tx.Pool.DeleteOneID(id)
+	if expectedVersion != nil {
+		del.Where(pool.VersionEQ(*expectedVersion))
+	}
+
+	if err := del.Exec(ctx); err != nil {
+		if generated.IsNotFound(err) {
+			return nil, ErrVersionMismatch
+		}
+
 		logger.Errorw("failed to delete loadbalancer pool", "error", err)
 		return nil, ErrInternalServerError
 	}
@@ -233,7 +317,35 @@ func (r *queryResolver) LoadBalancerPool(ctx context.Context, id gidx.PrefixedID
 		return nil, ErrInternalServerError
 	}
 
-	if err := permissions.CheckAccess(ctx, pool.OwnerID, actionLoadBalancerPoolGet); err != nil {
+	if err := checkAccess(ctx, pool.OwnerID, actionLoadBalancerPoolGet); err != nil {
+		return nil, err
+	}
+
+	return pool, nil
+}
+
+// LoadBalancerPoolHistory is the resolver for the loadBalancerPoolHistory field.
+func (r *queryResolver) LoadBalancerPoolHistory(ctx context.Context, id gidx.PrefixedID) (*generated.Pool, error) {
+	ctx = softdelete.SkipSoftDelete(ctx)
+
+	logger := r.logger.With("loadbalancerPoolID", id.String())
+
+	// check gidx format
+	if err := validateGidx(id); err != nil {
+		return nil, newInvalidFieldError("id", err)
+	}
+
+	pool, err := r.client.Pool.Get(ctx, id)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		logger.Errorw("failed to get loadbalancer pool", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	if err := checkAccess(ctx, pool.OwnerID, actionLoadBalancerPoolGetHistory); err != nil {
 		return nil, err
 	}
 
@@ -277,7 +277,7 @@ func TestMutate_PoolUpdate(t *testing.T) {
 		tt := tt
 
 		t.Run(tt.TestName, func(t *testing.T) {
-			updatedPoolResp, err := graphTestClient().LoadBalancerPoolUpdate(ctx, tt.ID, tt.Input)
+			updatedPoolResp, err := graphTestClient().LoadBalancerPoolUpdate(ctx, tt.ID, tt.Input, nil)
 			if tt.errorMsg != "" {
 				require.Error(t, err)
 				assert.ErrorContains(t, err, tt.errorMsg)
@@ -358,7 +358,7 @@ func TestMutate_PoolDelete(t *testing.T) {
 		tt := tt
 
 		t.Run(tt.TestName, func(t *testing.T) {
-			poolDeleteResp, err := graphTestClient().LoadBalancerPoolDelete(ctx, tt.DeleteID)
+			poolDeleteResp, err := graphTestClient().LoadBalancerPoolDelete(ctx, tt.DeleteID, nil)
 			if tt.errorMsg != "" {
 				require.Error(t, err)
 				assert.Nil(t, poolDeleteResp)
@@ -372,3 +372,160 @@ func TestMutate_PoolDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryPoolHistory(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	pool1 := (&testutils.PoolBuilder{}).MustNew(ctx)
+	pool2 := (&testutils.PoolBuilder{}).MustNew(ctx)
+
+	EntClient.Pool.DeleteOneID(pool2.ID).ExecX(ctx)
+
+	testCases := []struct {
+		TestName     string
+		QueryID      gidx.PrefixedID
+		Checker      permissions.Checker
+		ExpectedPool *ent.Pool
+		Deleted      bool
+		errorMsg     string
+	}{
+		{
+			TestName:     "get pool",
+			QueryID:      pool1.ID,
+			ExpectedPool: pool1,
+		},
+		{
+			TestName:     "get deleted pool",
+			QueryID:      pool2.ID,
+			ExpectedPool: pool2,
+			Deleted:      true,
+		},
+		{
+			TestName: "pool not found",
+			QueryID:  gidx.MustNewID("loadpol"),
+			errorMsg: "not found",
+		},
+		{
+			TestName: "invalid pool ID",
+			QueryID:  "an invalid pool id",
+			errorMsg: "invalid id",
+		},
+		{
+			TestName: "permission denied",
+			QueryID:  pool2.ID,
+			Checker:  denyActionChecker("loadbalancerpool_get_history"),
+			errorMsg: permissions.ErrPermissionDenied.Error(),
+		},
+	}
+
+	for _, tt := range testCases {
+		// lint
+		tt := tt
+
+		t.Run(tt.TestName, func(t *testing.T) {
+			ctx := ctx
+			if tt.Checker != nil {
+				ctx = context.WithValue(ctx, permissions.CheckerCtxKey, tt.Checker)
+			}
+
+			resp, err := graphTestClient().GetLoadBalancerPoolHistory(ctx, tt.QueryID)
+			if tt.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.errorMsg)
+				assert.Nil(t, resp)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			assert.Equal(t, tt.ExpectedPool.ID, resp.LoadBalancerPoolHistory.ID)
+			assert.Equal(t, tt.Deleted, resp.LoadBalancerPoolHistory.DeletedAt != nil)
+		})
+	}
+}
+
+func TestQueryPoolOrigins_IncludeDeleted(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	pool1 := (&testutils.PoolBuilder{}).MustNew(ctx)
+	origin1 := (&testutils.OriginBuilder{PoolID: pool1.ID}).MustNew(ctx)
+	origin2 := (&testutils.OriginBuilder{PoolID: pool1.ID}).MustNew(ctx)
+
+	EntClient.Origin.DeleteOneID(origin2.ID).ExecX(ctx)
+
+	testCases := []struct {
+		TestName        string
+		IncludeDeleted  *bool
+		Checker         permissions.Checker
+		ExpectedOrigins []gidx.PrefixedID
+		errorMsg        string
+	}{
+		{
+			TestName:        "excludes deleted origins by default",
+			ExpectedOrigins: []gidx.PrefixedID{origin1.ID},
+		},
+		{
+			TestName:        "excludes deleted origins",
+			IncludeDeleted:  newBool(false),
+			ExpectedOrigins: []gidx.PrefixedID{origin1.ID},
+		},
+		{
+			TestName:        "includes deleted origins",
+			IncludeDeleted:  newBool(true),
+			ExpectedOrigins: []gidx.PrefixedID{origin1.ID, origin2.ID},
+		},
+		{
+			TestName:       "permission denied",
+			IncludeDeleted: newBool(true),
+			Checker:        denyActionChecker("loadbalancerpool_get_history"),
+			errorMsg:       permissions.ErrPermissionDenied.Error(),
+		},
+	}
+
+	for _, tt := range testCases {
+		// lint
+		tt := tt
+
+		t.Run(tt.TestName, func(t *testing.T) {
+			ctx := ctx
+			if tt.Checker != nil {
+				ctx = context.WithValue(ctx, permissions.CheckerCtxKey, tt.Checker)
+			}
+
+			resp, err := graphTestClient().GetLoadBalancerPoolOrigins(ctx, pool1.ID, tt.IncludeDeleted, nil)
+			if tt.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.errorMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+
+			ids := []gidx.PrefixedID{}
+			for _, edge := range resp.LoadBalancerPool.Origins.Edges {
+				ids = append(ids, edge.Node.ID)
+			}
+
+			assert.ElementsMatch(t, tt.ExpectedOrigins, ids)
+		})
+	}
+}
@@ -6,19 +6,22 @@ package graphapi
 
 import (
 	"context"
+	"errors"
 	"strings"
 
 	"go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/loadbalancer"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/origin"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/pool"
 	"go.infratographer.com/load-balancer-api/internal/ent/generated/port"
 	_ "go.infratographer.com/load-balancer-api/internal/ent/generated/runtime"
+	"go.infratographer.com/load-balancer-api/internal/ent/schema/softdelete"
 	"go.infratographer.com/load-balancer-api/pkg/metadata"
-	"go.infratographer.com/permissions-api/pkg/permissions"
 	"go.infratographer.com/x/gidx"
 )
 
 // LoadBalancerPortCreate is the resolver for the loadBalancerPortCreate field.
-func (r *mutationResolver) LoadBalancerPortCreate(ctx context.Context, input generated.CreateLoadBalancerPortInput) (*LoadBalancerPortCreatePayload, error) {
+func (r *mutationResolver) LoadBalancerPortCreate(ctx context.Context, input CreateLoadBalancerPortInput) (*LoadBalancerPortCreatePayload, error) {
 	logger := r.logger.With("loadbalancerID", input.LoadBalancerID, "loadbalancerPools", input.PoolIDs)
 
 	// check gidx lb id format
@@ -33,7 +36,7 @@ func (r *mutationResolver) LoadBalancerPortCreate(ctx context.Context, input gen
 		}
 	}
 
-	if err := permissions.CheckAccess(ctx, input.LoadBalancerID, actionLoadBalancerUpdate); err != nil {
+	if err := checkAccess(ctx, input.LoadBalancerID, actionLoadBalancerPortCreate); err != nil {
 		return nil, err
 	}
 
@@ -58,13 +61,50 @@ func (r *mutationResolver) LoadBalancerPortCreate(ctx context.Context, input gen
 	}
 
 	for _, poolId := range input.PoolIDs {
-		if err := permissions.CheckAccess(ctx, poolId, actionLoadBalancerPoolGet); err != nil {
+		if err := checkAccess(ctx, poolId, actionLoadBalancerPoolGet); err != nil {
 			logger.Errorw("failed to check access", "error", err, "loadbalancerPoolID", poolId)
 			return nil, err
 		}
 	}
 
-	p, err := r.client.Port.Create().SetInput(input).Save(ctx)
+	if err := r.validateOriginTargets(ctx, "poolIDs", origin.PoolIDIn(input.PoolIDs...), loadbalancer.IDEQ(lb.ID)); err != nil {
+		var fieldErr *ErrInvalidField
+		if errors.As(err, &fieldErr) {
+			return nil, err
+		}
+
+		logger.Errorw("failed to validate origin targets", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	claim, err := r.claimIdempotencyKey(ctx, input.IdempotencyKey, lb.OwnerID, "loadBalancerPortCreate", input)
+	if err != nil {
+		return nil, err
+	}
+
+	if id, ok := claim.replayID(); ok {
+		p, err := r.client.Port.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		return &LoadBalancerPortCreatePayload{LoadBalancerPort: p}, nil
+	}
+
+	defer claim.release(ctx)
+
+	var p *generated.Port
+
+	err = r.createInTx(ctx, claim, func(tx *generated.Tx) (gidx.PrefixedID, error) {
+		var err error
+
+		p, err = tx.Port.Create().SetInput(input.CreateLoadBalancerPortInput).Save(ctx)
+		if err != nil {
+			return "", err
+		}
+
+		return p.ID, nil
+	})
 	if err != nil {
 		switch {
 		case generated.IsConstraintError(err) && strings.Contains(err.Error(), "number"):
@@ -77,6 +117,9 @@ func (r *mutationResolver) LoadBalancerPortCreate(ctx context.Context, input gen
 		}
 	}
 
+	// detach the port from the committed transaction so edges can still be resolved
+	p = p.Unwrap()
+
 	status := &metadata.LoadBalancerStatus{State: metadata.LoadBalancerStateUpdating}
 	if err := r.LoadBalancerStatusUpdate(ctx, lb.ID, status); err != nil {
 		r.logger.Errorw("failed to update loadbalancer metadata status", "error", err, "loadbalancerID", lb.ID)
@@ -86,7 +129,7 @@ func (r *mutationResolver) LoadBalancerPortCreate(ctx context.Context, input gen
 }
 
 // LoadBalancerPortUpdate is the resolver for the loadBalancerPortUpdate field.
-func (r *mutationResolver) LoadBalancerPortUpdate(ctx context.Context, id gidx.PrefixedID, input generated.UpdateLoadBalancerPortInput) (*LoadBalancerPortUpdatePayload, error) {
+func (r *mutationResolver) LoadBalancerPortUpdate(ctx context.Context, id gidx.PrefixedID, input generated.UpdateLoadBalancerPortInput, expectedVersion *int) (*LoadBalancerPortUpdatePayload, error) {
 	logger := r.logger.With("loadbalancerPortID", id)
 
 	// check gidx format
@@ -104,7 +147,11 @@ func (r *mutationResolver) LoadBalancerPortUpdate(ctx context.Context, id gidx.P
 		return nil, ErrInternalServerError
 	}
 
-	if err := permissions.CheckAccess(ctx, p.Edges.LoadBalancer.OwnerID, actionLoadBalancerUpdate); err != nil {
+	if err := checkAccess(ctx, p.LoadBalancerID, actionLoadBalancerPortUpdate); err != nil {
+		return nil, err
+	}
+
+	if err := validateVersion(expectedVersion, p.Version); err != nil {
 		return nil, err
 	}
 
@@ -129,17 +176,34 @@ func (r *mutationResolver) LoadBalancerPortUpdate(ctx context.Context, id gidx.P
 	}
 
 	for _, poolId := range input.AddPoolIDs {
-		if err := permissions.CheckAccess(ctx, poolId, actionLoadBalancerPoolGet); err != nil {
+		if err := checkAccess(ctx, poolId, actionLoadBalancerPoolGet); err != nil {
 			logger.Errorw("failed to check access", "error", err, "loadbalancerPoolID", poolId)
 			return nil, err
 		}
 	}
 
-	p, err = p.Update().SetInput(input).Save(ctx)
+	if err := r.validateOriginTargets(ctx, "addPoolIDs", origin.PoolIDIn(input.AddPoolIDs...), loadbalancer.IDEQ(lb.ID)); err != nil {
+		var fieldErr *ErrInvalidField
+		if errors.As(err, &fieldErr) {
+			return nil, err
+		}
+
+		logger.Errorw("failed to validate origin targets", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	update := p.Update().SetInput(input)
+	if expectedVersion != nil {
+		update.Where(port.VersionEQ(*expectedVersion))
+	}
+
+	p, err = update.Save(ctx)
 	if err != nil {
 		switch {
 		case generated.IsValidationError(err):
 			return nil, err
+		case generated.IsNotFound(err):
+			return nil, ErrVersionMismatch
 		case generated.IsConstraintError(err) && strings.Contains(err.Error(), "number"):
 			return nil, ErrPortNumberInUse
 		default:
@@ -157,7 +221,7 @@ func (r *mutationResolver) LoadBalancerPortUpdate(ctx context.Context, id gidx.P
 }
 
 // LoadBalancerPortDelete is the resolver for the loadBalancerPortDelete field.
-func (r *mutationResolver) LoadBalancerPortDelete(ctx context.Context, id gidx.PrefixedID) (*LoadBalancerPortDeletePayload, error) {
+func (r *mutationResolver) LoadBalancerPortDelete(ctx context.Context, id gidx.PrefixedID, expectedVersion *int) (*LoadBalancerPortDeletePayload, error) {
 	logger := r.logger.With("loadbalancerPortID", id.String())
 
 	// check gidx format
@@ -175,11 +239,24 @@ func (r *mutationResolver) LoadBalancerPortDelete(ctx context.Context, id gidx.P
 		return nil, ErrInternalServerError
 	}
 
-	if err := permissions.CheckAccess(ctx, p.Edges.LoadBalancer.OwnerID, actionLoadBalancerUpdate); err != nil {
+	if err := checkAccess(ctx, p.LoadBalancerID, actionLoadBalancerPortDelete); err != nil {
 		return nil, err
 	}
 
-	if err := r.client.Port.DeleteOneID(id).Exec(ctx); err != nil {
+	if err := validateVersion(expectedVersion, p.Version); err != nil {
+		return nil, err
+	}
+
+	del := r.client.Port.DeleteOneID(id)
+	if expectedVersion != nil {
+		del.Where(port.VersionEQ(*expectedVersion))
+	}
+
+	if err := del.Exec(ctx); err != nil {
+		if generated.IsNotFound(err) {
+			return nil, ErrVersionMismatch
+		}
+
 		logger.Errorw("failed to delete loadbalancer port", "error", err)
 		return nil, ErrInternalServerError
 	}
@@ -211,7 +288,35 @@ func (r *queryResolver) LoadBalancerPort(ctx context.Context, id gidx.PrefixedID
 		return nil, ErrInternalServerError
 	}
 
-	if err := permissions.CheckAccess(ctx, p.Edges.LoadBalancer.OwnerID, actionLoadBalancerGet); err != nil {
+	if err := checkAccess(ctx, p.LoadBalancerID, actionLoadBalancerPortGet); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// LoadBalancerPortHistory is the resolver for the loadBalancerPortHistory field.
+func (r *queryResolver) LoadBalancerPortHistory(ctx context.Context, id gidx.PrefixedID) (*generated.Port, error) {
+	ctx = softdelete.SkipSoftDelete(ctx)
+
+	logger := r.logger.With("loadbalancerPortID", id.String())
+
+	// check gidx format
+	if err := validateGidx(id); err != nil {
+		return nil, newInvalidFieldError("id", err)
+	}
+
+	p, err := r.client.Port.Query().WithLoadBalancer().Where(port.IDEQ(id)).Only(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		logger.Errorw("failed to get loadbalancer port", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	if err := checkAccess(ctx, p.Edges.LoadBalancer.OwnerID, actionLoadBalancerGetHistory); err != nil {
 		return nil, err
 	}
 
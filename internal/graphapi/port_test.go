@@ -25,6 +25,7 @@ func TestCreate_LoadbalancerPort(t *testing.T) {
 	ctx := context.Background()
 	perms := new(mockpermissions.MockPermissions)
 	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	ctx = perms.ContextWithHandler(ctx)
 
@@ -34,6 +35,8 @@ func TestCreate_LoadbalancerPort(t *testing.T) {
 	lb := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
 	poolBad := (&testutils.PoolBuilder{}).MustNew(ctx)
 	_ = (&testutils.PortBuilder{Name: "port80", LoadBalancerID: lb.ID, Number: 80}).MustNew(ctx)
+	deletedPort := (&testutils.PortBuilder{Name: "port443", LoadBalancerID: lb.ID, Number: 443}).MustNew(ctx)
+	testutils.EntClient.Port.DeleteOneID(deletedPort.ID).ExecX(ctx)
 
 	testCases := []struct {
 		TestName string
@@ -113,6 +116,18 @@ func TestCreate_LoadbalancerPort(t *testing.T) {
 			},
 			errorMsg: "port number already in use",
 		},
+		{
+			TestName: "creates loadbalancer port with number of a deleted port",
+			Input: graphclient.CreateLoadBalancerPortInput{
+				Name:           newString("lb-port"),
+				LoadBalancerID: lb.ID,
+				Number:         443,
+			},
+			Expected: &graphclient.LoadBalancerPort{
+				Name:   newString("lb-port"),
+				Number: 443,
+			},
+		},
 		{
 			TestName: "fails to create loadbalancer port with restricted port number",
 			Input: graphclient.CreateLoadBalancerPortInput{
@@ -189,6 +204,7 @@ func TestUpdate_LoadbalancerPort(t *testing.T) {
 	ctx := context.Background()
 	perms := new(mockpermissions.MockPermissions)
 	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	ctx = perms.ContextWithHandler(ctx)
 
@@ -199,6 +215,8 @@ func TestUpdate_LoadbalancerPort(t *testing.T) {
 	port := (&testutils.PortBuilder{Name: "port80", LoadBalancerID: lb.ID, Number: 80}).MustNew(ctx)
 	poolBad := (&testutils.PoolBuilder{}).MustNew(ctx)
 	_ = (&testutils.PortBuilder{Name: "dupeport8080", LoadBalancerID: lb.ID, Number: 8080}).MustNew(ctx)
+	deletedPort := (&testutils.PortBuilder{Name: "port9090", LoadBalancerID: lb.ID, Number: 9090}).MustNew(ctx)
+	testutils.EntClient.Port.DeleteOneID(deletedPort.ID).ExecX(ctx)
 
 	testCases := []struct {
 		TestName string
@@ -245,6 +263,28 @@ func TestUpdate_LoadbalancerPort(t *testing.T) {
 				Number: 22,
 			},
 		},
+		{
+			TestName: "updates loadbalancer port number to number of a deleted port",
+			ID:       port.ID,
+			Input: graphclient.UpdateLoadBalancerPortInput{
+				Number: newInt64(9090),
+			},
+			Expected: &graphclient.LoadBalancerPort{
+				Name:   newString("lb-port"),
+				Number: 9090,
+			},
+		},
+		{
+			TestName: "updates loadbalancer port number back",
+			ID:       port.ID,
+			Input: graphclient.UpdateLoadBalancerPortInput{
+				Number: newInt64(22),
+			},
+			Expected: &graphclient.LoadBalancerPort{
+				Name:   newString("lb-port"),
+				Number: 22,
+			},
+		},
 		{
 			TestName: "succeeds in updating loadbalancer port name to empty",
 			ID:       port.ID,
@@ -302,7 +342,7 @@ func TestUpdate_LoadbalancerPort(t *testing.T) {
 
 	for _, tt := range testCases {
 		t.Run(tt.TestName, func(t *testing.T) {
-			resp, err := graphTestClient().LoadBalancerPortUpdate(ctx, tt.ID, tt.Input)
+			resp, err := graphTestClient().LoadBalancerPortUpdate(ctx, tt.ID, tt.Input, nil)
 
 			if tt.errorMsg != "" {
 				require.Error(t, err)
@@ -371,7 +411,7 @@ func TestDelete_LoadbalancerPort(t *testing.T) {
 
 			t.Parallel()
 
-			resp, err := graphTestClient().LoadBalancerPortDelete(ctx, tt.Input)
+			resp, err := graphTestClient().LoadBalancerPortDelete(ctx, tt.Input, nil)
 
 			if tt.errorMsg != "" {
 				require.Error(t, err)
@@ -495,7 +535,7 @@ func TestFullLoadBalancerPortLifecycle(t *testing.T) {
 
 	// Update the Port
 	newPort := int64(gofakeit.Number(1, 65535))
-	updatedPort, err := graphTestClient().LoadBalancerPortUpdate(ctx, createdPort.ID, graphclient.UpdateLoadBalancerPortInput{Number: &newPort})
+	updatedPort, err := graphTestClient().LoadBalancerPortUpdate(ctx, createdPort.ID, graphclient.UpdateLoadBalancerPortInput{Number: &newPort}, nil)
 
 	require.NoError(t, err)
 	require.NotNil(t, updatedPort)
@@ -510,7 +550,7 @@ func TestFullLoadBalancerPortLifecycle(t *testing.T) {
 	require.Equal(t, newPort, queryPort.LoadBalancer.Ports.Edges[0].Node.Number)
 
 	// Delete the Port
-	deletedResp, err := graphTestClient().LoadBalancerPortDelete(ctx, createdPort.ID)
+	deletedResp, err := graphTestClient().LoadBalancerPortDelete(ctx, createdPort.ID, nil)
 	require.NoError(t, err)
 	require.NotNil(t, deletedResp)
 	require.EqualValues(t, createdPort.ID, deletedResp.LoadBalancerPortDelete.DeletedID.String())
@@ -521,3 +561,296 @@ func TestFullLoadBalancerPortLifecycle(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, queryPort.LoadBalancer.Ports.Edges, 0)
 }
+
+func TestGet_LoadbalancerPortHistory(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	lb := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+	port1 := (&testutils.PortBuilder{LoadBalancerID: lb.ID, Number: 80}).MustNew(ctx)
+	port2 := (&testutils.PortBuilder{LoadBalancerID: lb.ID, Number: 443}).MustNew(ctx)
+
+	EntClient.Port.DeleteOneID(port2.ID).ExecX(ctx)
+
+	testCases := []struct {
+		TestName     string
+		QueryID      gidx.PrefixedID
+		Checker      permissions.Checker
+		ExpectedPort *ent.Port
+		Deleted      bool
+		errorMsg     string
+	}{
+		{
+			TestName:     "get port",
+			QueryID:      port1.ID,
+			ExpectedPort: port1,
+		},
+		{
+			TestName:     "get deleted port",
+			QueryID:      port2.ID,
+			ExpectedPort: port2,
+			Deleted:      true,
+		},
+		{
+			TestName: "port not found",
+			QueryID:  gidx.MustNewID("loadprt"),
+			errorMsg: "not found",
+		},
+		{
+			TestName: "invalid port ID",
+			QueryID:  "an invalid port id",
+			errorMsg: "invalid id",
+		},
+		{
+			TestName: "permission denied",
+			QueryID:  port2.ID,
+			Checker:  denyActionChecker("loadbalancer_get_history"),
+			errorMsg: permissions.ErrPermissionDenied.Error(),
+		},
+	}
+
+	for _, tt := range testCases {
+		// lint
+		tt := tt
+
+		t.Run(tt.TestName, func(t *testing.T) {
+			ctx := ctx
+			if tt.Checker != nil {
+				ctx = context.WithValue(ctx, permissions.CheckerCtxKey, tt.Checker)
+			}
+
+			resp, err := graphTestClient().GetLoadBalancerPortHistory(ctx, tt.QueryID)
+			if tt.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.errorMsg)
+				assert.Nil(t, resp)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+
+			assert.Equal(t, tt.ExpectedPort.ID, resp.LoadBalancerPortHistory.ID)
+			assert.EqualValues(t, tt.ExpectedPort.Number, resp.LoadBalancerPortHistory.Number)
+			assert.Equal(t, tt.Deleted, resp.LoadBalancerPortHistory.DeletedAt != nil)
+		})
+	}
+}
+
+func TestGet_LoadbalancerPorts_IncludeDeleted(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	lb := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+	port1 := (&testutils.PortBuilder{LoadBalancerID: lb.ID, Number: 80}).MustNew(ctx)
+	port2 := (&testutils.PortBuilder{LoadBalancerID: lb.ID, Number: 443}).MustNew(ctx)
+
+	EntClient.Port.DeleteOneID(port2.ID).ExecX(ctx)
+
+	testCases := []struct {
+		TestName       string
+		IncludeDeleted *bool
+		Checker        permissions.Checker
+		ExpectedPorts  []gidx.PrefixedID
+		errorMsg       string
+	}{
+		{
+			TestName:      "excludes deleted ports by default",
+			ExpectedPorts: []gidx.PrefixedID{port1.ID},
+		},
+		{
+			TestName:       "excludes deleted ports",
+			IncludeDeleted: newBool(false),
+			ExpectedPorts:  []gidx.PrefixedID{port1.ID},
+		},
+		{
+			TestName:       "includes deleted ports",
+			IncludeDeleted: newBool(true),
+			ExpectedPorts:  []gidx.PrefixedID{port1.ID, port2.ID},
+		},
+		{
+			TestName:       "permission denied",
+			IncludeDeleted: newBool(true),
+			Checker:        denyActionChecker("loadbalancer_get_history"),
+			errorMsg:       permissions.ErrPermissionDenied.Error(),
+		},
+	}
+
+	for _, tt := range testCases {
+		// lint
+		tt := tt
+
+		t.Run(tt.TestName, func(t *testing.T) {
+			ctx := ctx
+			if tt.Checker != nil {
+				ctx = context.WithValue(ctx, permissions.CheckerCtxKey, tt.Checker)
+			}
+
+			resp, err := graphTestClient().GetLoadBalancerPorts(ctx, lb.ID, tt.IncludeDeleted, nil)
+			if tt.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.errorMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+
+			ids := []gidx.PrefixedID{}
+			for _, edge := range resp.LoadBalancer.Ports.Edges {
+				ids = append(ids, edge.Node.ID)
+			}
+
+			assert.ElementsMatch(t, tt.ExpectedPorts, ids)
+		})
+	}
+}
+
+func TestLoadbalancerPort_Permissions(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	lb := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+	port := (&testutils.PortBuilder{LoadBalancerID: lb.ID, Number: 80}).MustNew(ctx)
+
+	testCases := []struct {
+		TestName string
+		Checker  permissions.Checker
+		Run      func(ctx context.Context) error
+		errorMsg string
+	}{
+		{
+			TestName: "create is denied without port create",
+			Checker:  denyActionChecker("loadbalancerport_create"),
+			Run: func(ctx context.Context) error {
+				_, err := graphTestClient().LoadBalancerPortCreate(ctx, graphclient.CreateLoadBalancerPortInput{LoadBalancerID: lb.ID, Number: 81})
+				return err
+			},
+			errorMsg: permissions.ErrPermissionDenied.Error(),
+		},
+		{
+			TestName: "create is allowed without loadbalancer update",
+			Checker:  denyActionChecker("loadbalancer_update"),
+			Run: func(ctx context.Context) error {
+				_, err := graphTestClient().LoadBalancerPortCreate(ctx, graphclient.CreateLoadBalancerPortInput{LoadBalancerID: lb.ID, Number: 82})
+				return err
+			},
+		},
+		{
+			TestName: "update is denied without port update on the load balancer",
+			Checker:  denyResourceActionChecker(lb.ID, "loadbalancerport_update"),
+			Run: func(ctx context.Context) error {
+				_, err := graphTestClient().LoadBalancerPortUpdate(ctx, port.ID, graphclient.UpdateLoadBalancerPortInput{Name: newString("updated")}, nil)
+				return err
+			},
+			errorMsg: permissions.ErrPermissionDenied.Error(),
+		},
+		{
+			TestName: "get is denied without port get on the load balancer",
+			Checker:  denyResourceActionChecker(lb.ID, "loadbalancerport_get"),
+			Run: func(ctx context.Context) error {
+				_, err := graphTestClient().GetLoadBalancerPort(ctx, port.ID)
+				return err
+			},
+			errorMsg: permissions.ErrPermissionDenied.Error(),
+		},
+		{
+			// existing ports have no relationships, so port actions are checked on the load balancer
+			TestName: "update is not checked on the port",
+			Checker:  denyResourceActionChecker(port.ID, "loadbalancerport_update"),
+			Run: func(ctx context.Context) error {
+				_, err := graphTestClient().LoadBalancerPortUpdate(ctx, port.ID, graphclient.UpdateLoadBalancerPortInput{Name: newString("allowed")}, nil)
+				return err
+			},
+		},
+		{
+			TestName: "delete is denied without port delete on the load balancer",
+			Checker:  denyResourceActionChecker(lb.ID, "loadbalancerport_delete"),
+			Run: func(ctx context.Context) error {
+				_, err := graphTestClient().LoadBalancerPortDelete(ctx, port.ID, nil)
+				return err
+			},
+			errorMsg: permissions.ErrPermissionDenied.Error(),
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+
+		t.Run(tt.TestName, func(t *testing.T) {
+			err := tt.Run(context.WithValue(ctx, permissions.CheckerCtxKey, tt.Checker))
+
+			if tt.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.errorMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+// TestPortNumberUniqueIndex checks the partial unique index on the port number directly against
+// the database, so it runs for every dialect the test database is configured with
+func TestPortNumberUniqueIndex(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	lb := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+
+	createPort := func() (*ent.Port, error) {
+		return EntClient.Port.Create().SetName("port8443").SetLoadBalancerID(lb.ID).SetNumber(8443).Save(ctx)
+	}
+
+	first, err := createPort()
+	require.NoError(t, err)
+
+	_, err = createPort()
+	require.Error(t, err)
+	assert.True(t, ent.IsConstraintError(err), "expected a unique violation, got %v", err)
+
+	// soft deleting the port frees its number
+	require.NoError(t, EntClient.Port.DeleteOneID(first.ID).Exec(ctx))
+
+	second, err := createPort()
+	require.NoError(t, err)
+
+	_, err = createPort()
+	require.Error(t, err)
+	assert.True(t, ent.IsConstraintError(err), "expected a unique violation, got %v", err)
+
+	// several deleted ports may share a number
+	require.NoError(t, EntClient.Port.DeleteOneID(second.ID).Exec(ctx))
+
+	_, err = createPort()
+	require.NoError(t, err)
+}
@@ -8,23 +8,51 @@ import (
 	"context"
 
 	"go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/provider"
 	_ "go.infratographer.com/load-balancer-api/internal/ent/generated/runtime"
-	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/load-balancer-api/internal/ent/schema/softdelete"
 	"go.infratographer.com/x/gidx"
 )
 
 // LoadBalancerProviderCreate is the resolver for the loadBalancerProviderCreate field.
-func (r *mutationResolver) LoadBalancerProviderCreate(ctx context.Context, input generated.CreateLoadBalancerProviderInput) (*LoadBalancerProviderCreatePayload, error) {
+func (r *mutationResolver) LoadBalancerProviderCreate(ctx context.Context, input CreateLoadBalancerProviderInput) (*LoadBalancerProviderCreatePayload, error) {
 	// check gidx owner format
 	if _, err := gidx.Parse(input.OwnerID.String()); err != nil {
 		return nil, err
 	}
 
-	if err := permissions.CheckAccess(ctx, input.OwnerID, actionLoadBalancerProviderCreate); err != nil {
+	if err := checkAccess(ctx, input.OwnerID, actionLoadBalancerProviderCreate); err != nil {
 		return nil, err
 	}
 
-	p, err := r.client.Provider.Create().SetInput(input).Save(ctx)
+	claim, err := r.claimIdempotencyKey(ctx, input.IdempotencyKey, input.OwnerID, "loadBalancerProviderCreate", input)
+	if err != nil {
+		return nil, err
+	}
+
+	if id, ok := claim.replayID(); ok {
+		p, err := r.client.Provider.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		return &LoadBalancerProviderCreatePayload{LoadBalancerProvider: p}, nil
+	}
+
+	defer claim.release(ctx)
+
+	var p *generated.Provider
+
+	err = r.createInTx(ctx, claim, func(tx *generated.Tx) (gidx.PrefixedID, error) {
+		var err error
+
+		p, err = tx.Provider.Create().SetInput(input.CreateLoadBalancerProviderInput).Save(ctx)
+		if err != nil {
+			return "", err
+		}
+
+		return p.ID, nil
+	})
 	if err != nil {
 		if generated.IsValidationError(err) {
 			return nil, err
@@ -34,11 +62,14 @@ func (r *mutationResolver) LoadBalancerProviderCreate(ctx context.Context, input
 		return nil, ErrInternalServerError
 	}
 
+	// detach the provider from the committed transaction so edges can still be resolved
+	p = p.Unwrap()
+
 	return &LoadBalancerProviderCreatePayload{LoadBalancerProvider: p}, nil
 }
 
 // LoadBalancerProviderUpdate is the resolver for the loadBalancerProviderUpdate field.
-func (r *mutationResolver) LoadBalancerProviderUpdate(ctx context.Context, id gidx.PrefixedID, input generated.UpdateLoadBalancerProviderInput) (*LoadBalancerProviderUpdatePayload, error) {
+func (r *mutationResolver) LoadBalancerProviderUpdate(ctx context.Context, id gidx.PrefixedID, input generated.UpdateLoadBalancerProviderInput, expectedVersion *int) (*LoadBalancerProviderUpdatePayload, error) {
 	logger := r.logger.With("loadbalancerProviderID", id.String())
 
 	// check gidx format
@@ -46,7 +77,7 @@ func (r *mutationResolver) LoadBalancerProviderUpdate(ctx context.Context, id gi
 		return nil, newInvalidFieldError("id", err)
 	}
 
-	if err := permissions.CheckAccess(ctx, id, actionLoadBalancerProviderUpdate); err != nil {
+	if err := checkAccess(ctx, id, actionLoadBalancerProviderUpdate); err != nil {
 		return nil, err
 	}
 
@@ -60,21 +91,33 @@ func (r *mutationResolver) LoadBalancerProviderUpdate(ctx context.Context, id gi
 		return nil, ErrInternalServerError
 	}
 
-	p, err = p.Update().SetInput(input).Save(ctx)
+	if err := validateVersion(expectedVersion, p.Version); err != nil {
+		return nil, err
+	}
+
+	update := p.Update().SetInput(input)
+	if expectedVersion != nil {
+		update.Where(provider.VersionEQ(*expectedVersion))
+	}
+
+	p, err = update.Save(ctx)
 	if err != nil {
-		if generated.IsValidationError(err) {
+		switch {
+		case generated.IsValidationError(err):
 			return nil, err
+		case generated.IsNotFound(err):
+			return nil, ErrVersionMismatch
+		default:
+			logger.Errorw("failed to update loadbalancer provider", "error", err)
+			return nil, ErrInternalServerError
 		}
-
-		logger.Errorw("failed to update loadbalancer provider", "error", err)
-		return nil, ErrInternalServerError
 	}
 
 	return &LoadBalancerProviderUpdatePayload{LoadBalancerProvider: p}, nil
 }
 
 // LoadBalancerProviderDelete is the resolver for the loadBalancerProviderDelete field.
-func (r *mutationResolver) LoadBalancerProviderDelete(ctx context.Context, id gidx.PrefixedID) (*LoadBalancerProviderDeletePayload, error) {
+func (r *mutationResolver) LoadBalancerProviderDelete(ctx context.Context, id gidx.PrefixedID, expectedVersion *int) (*LoadBalancerProviderDeletePayload, error) {
 	logger := r.logger.With("loadbalancerProviderID", id.String())
 
 	// check gidx format
@@ -82,12 +125,36 @@ func (r *mutationResolver) LoadBalancerProviderDelete(ctx context.Context, id gi
 		return nil, newInvalidFieldError("id", err)
 	}
 
-	if err := permissions.CheckAccess(ctx, id, actionLoadBalancerProviderDelete); err != nil {
+	if err := checkAccess(ctx, id, actionLoadBalancerProviderDelete); err != nil {
 		return nil, err
 	}
 
-	if err := r.client.Provider.DeleteOneID(id).Exec(ctx); err != nil {
+	del := r.client.Provider.DeleteOneID(id)
+
+	if expectedVersion != nil {
+		p, err := r.client.Provider.Get(ctx, id)
+		if err != nil {
+			if generated.IsNotFound(err) {
+				return nil, err
+			}
+
+			logger.Errorw("failed to get loadbalancer provider", "error", err)
+			return nil, ErrInternalServerError
+		}
+
+		if err := validateVersion(expectedVersion, p.Version); err != nil {
+			return nil, err
+		}
+
+		del.Where(provider.VersionEQ(*expectedVersion))
+	}
+
+	if err := del.Exec(ctx); err != nil {
 		if generated.IsNotFound(err) {
+			if expectedVersion != nil {
+				return nil, ErrVersionMismatch
+			}
+
 			return nil, err
 		}
 
@@ -107,7 +174,35 @@ func (r *queryResolver) LoadBalancerProvider(ctx context.Context, id gidx.Prefix
 		return nil, newInvalidFieldError("id", err)
 	}
 
-	if err := permissions.CheckAccess(ctx, id, actionLoadBalancerProviderGet); err != nil {
+	if err := checkAccess(ctx, id, actionLoadBalancerProviderGet); err != nil {
+		return nil, err
+	}
+
+	p, err := r.client.Provider.Get(ctx, id)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		logger.Errorw("failed to get loadbalancer provider", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return p, nil
+}
+
+// LoadBalancerProviderHistory is the resolver for the loadBalancerProviderHistory field.
+func (r *queryResolver) LoadBalancerProviderHistory(ctx context.Context, id gidx.PrefixedID) (*generated.Provider, error) {
+	ctx = softdelete.SkipSoftDelete(ctx)
+
+	logger := r.logger.With("loadbalancerProviderID", id.String())
+
+	// check gidx format
+	if err := validateGidx(id); err != nil {
+		return nil, newInvalidFieldError("id", err)
+	}
+
+	if err := checkAccess(ctx, id, actionLoadBalancerProviderGetHistory); err != nil {
 		return nil, err
 	}
 
@@ -6,8 +6,10 @@ import (
 
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/permissions-api/pkg/permissions/mockpermissions"
 	"go.infratographer.com/x/gidx"
 
 	ent "go.infratographer.com/load-balancer-api/internal/ent/generated"
@@ -17,6 +19,11 @@ import (
 
 func TestQuery_loadBalancerProvider(t *testing.T) {
 	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
 
 	// Permit request
 	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
@@ -76,8 +83,91 @@ func TestQuery_loadBalancerProvider(t *testing.T) {
 	}
 }
 
+func TestQuery_loadBalancerProviderHistory(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	p1 := (&testutils.ProviderBuilder{}).MustNew(ctx)
+	p2 := (&testutils.ProviderBuilder{}).MustNew(ctx)
+
+	EntClient.Provider.DeleteOneID(p2.ID).ExecX(ctx)
+
+	testCases := []struct {
+		TestName         string
+		QueryID          gidx.PrefixedID
+		Checker          permissions.Checker
+		ExpectedProvider *ent.Provider
+		Deleted          bool
+		errorMsg         string
+	}{
+		{
+			TestName:         "Happy Path - p1",
+			QueryID:          p1.ID,
+			ExpectedProvider: p1,
+		},
+		{
+			TestName:         "Happy Path - deleted p2",
+			QueryID:          p2.ID,
+			ExpectedProvider: p2,
+			Deleted:          true,
+		},
+		{
+			TestName: "No load balancer provider found with ID",
+			QueryID:  gidx.MustNewID("testing"),
+			errorMsg: "provider not found",
+		},
+		{
+			TestName: "Invalid load balancer provider ID",
+			QueryID:  gidx.PrefixedID("invalid"),
+			errorMsg: "invalid id",
+		},
+		{
+			TestName: "Permission denied",
+			QueryID:  p2.ID,
+			Checker:  denyActionChecker("loadbalancerprovider_get_history"),
+			errorMsg: permissions.ErrPermissionDenied.Error(),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			ctx := ctx
+			if tt.Checker != nil {
+				ctx = context.WithValue(ctx, permissions.CheckerCtxKey, tt.Checker)
+			}
+
+			resp, err := graphTestClient().GetLoadBalancerProviderHistory(ctx, tt.QueryID)
+
+			if tt.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.errorMsg)
+				assert.Nil(t, resp)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			assert.Equal(t, tt.ExpectedProvider.ID, resp.LoadBalancerProviderHistory.ID)
+			assert.Equal(t, tt.Deleted, resp.LoadBalancerProviderHistory.DeletedAt != nil)
+		})
+	}
+}
+
 func TestCreate_Provider(t *testing.T) {
 	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
 
 	// Permit request
 	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
@@ -151,6 +241,11 @@ func TestCreate_Provider(t *testing.T) {
 
 func TestUpdate_Provider(t *testing.T) {
 	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
 
 	// Permit request
 	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
@@ -209,7 +304,7 @@ func TestUpdate_Provider(t *testing.T) {
 
 	for _, tt := range testCases {
 		t.Run(tt.TestName, func(t *testing.T) {
-			resp, err := graphTestClient().LoadBalancerProviderUpdate(ctx, tt.ID, tt.Input)
+			resp, err := graphTestClient().LoadBalancerProviderUpdate(ctx, tt.ID, tt.Input, nil)
 
 			if tt.errorMsg != "" {
 				require.Error(t, err)
@@ -232,6 +327,11 @@ func TestUpdate_Provider(t *testing.T) {
 
 func TestDelete_Provider(t *testing.T) {
 	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
 
 	// Permit request
 	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
@@ -278,7 +378,7 @@ func TestDelete_Provider(t *testing.T) {
 
 	for _, tt := range testCases {
 		t.Run(tt.TestName, func(t *testing.T) {
-			resp, err := graphTestClient().LoadBalancerProviderDelete(ctx, tt.Input)
+			resp, err := graphTestClient().LoadBalancerProviderDelete(ctx, tt.Input, nil)
 
 			if tt.errorMsg != "" {
 				require.Error(t, err)
@@ -300,6 +400,11 @@ func TestDelete_Provider(t *testing.T) {
 
 func TestFullProviderLifecycle(t *testing.T) {
 	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
 
 	// Permit request
 	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
@@ -325,7 +430,7 @@ func TestFullProviderLifecycle(t *testing.T) {
 
 	// Update the Provider
 	newName := gofakeit.DomainName()
-	updatedLBResp, err := graphTestClient().LoadBalancerProviderUpdate(ctx, createdProv.ID, graphclient.UpdateLoadBalancerProviderInput{Name: &newName})
+	updatedLBResp, err := graphTestClient().LoadBalancerProviderUpdate(ctx, createdProv.ID, graphclient.UpdateLoadBalancerProviderInput{Name: &newName}, nil)
 
 	require.NoError(t, err)
 	require.NotNil(t, updatedLBResp)
@@ -343,7 +448,7 @@ func TestFullProviderLifecycle(t *testing.T) {
 	require.Equal(t, newName, queryLB.LoadBalancerProvider.Name)
 
 	// Delete the Provider
-	deletedResp, err := graphTestClient().LoadBalancerProviderDelete(ctx, createdProv.ID)
+	deletedResp, err := graphTestClient().LoadBalancerProviderDelete(ctx, createdProv.ID, nil)
 	require.NoError(t, err)
 	require.NotNil(t, deletedResp)
 	require.NotNil(t, deletedResp.LoadBalancerProviderDelete)
@@ -1,15 +1,23 @@
 package graphapi
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/labstack/echo/v4"
+	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/wundergraph/graphql-go-tools/pkg/playground"
 	"go.infratographer.com/x/gqlgenx/oteltracing"
 	"go.uber.org/zap"
 
+	"go.infratographer.com/load-balancer-api/internal/changefeed"
 	ent "go.infratographer.com/load-balancer-api/internal/ent/generated"
 )
 
@@ -20,6 +28,13 @@ import (
 const (
 	graphPath      = "query"
 	playgroundPath = "playground"
+
+	queryCacheSize                 = 1000
+	websocketKeepAlivePingInterval = 10 * time.Second
+
+	// resyncs requested through the api are bounded, the resync command has no limit
+	resyncDefaultLimit = 100
+	resyncMaxLimit     = 1000
 )
 
 var graphFullPath = fmt.Sprintf("/%s", graphPath)
@@ -29,6 +44,11 @@ type Resolver struct {
 	client   *ent.Client
 	logger   *zap.SugaredLogger
 	metadata Metadata
+	changes  *changefeed.Feed
+	limits   QueryLimits
+
+	persistedQueries graphql.Cache
+	allowlist        *PersistedQueryAllowlist
 }
 
 // Option is a function that modifies a resolver
@@ -57,6 +77,13 @@ func WithMetadataClient(m Metadata) func(*Resolver) {
 	}
 }
 
+// WithChangeFeed sets the feed subscriptions receive changes from
+func WithChangeFeed(f *changefeed.Feed) func(*Resolver) {
+	return func(r *Resolver) {
+		r.changes = f
+	}
+}
+
 // Handler is an http handler wrapping a Resolver
 type Handler struct {
 	r              *Resolver
@@ -67,7 +94,7 @@ type Handler struct {
 
 // Handler returns an http handler for a graph resolver
 func (r *Resolver) Handler(withPlayground bool, middleware ...echo.MiddlewareFunc) *Handler {
-	srv := handler.NewDefaultServer(
+	srv := handler.New(
 		NewExecutableSchema(
 			Config{
 				Resolvers: r,
@@ -75,6 +102,53 @@ func (r *Resolver) Handler(withPlayground bool, middleware ...echo.MiddlewareFun
 		),
 	)
 
+	// subscriptions are served over websockets, the other transports match handler.NewDefaultServer
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: websocketKeepAlivePingInterval,
+		InitFunc:              r.websocketInit(middleware),
+	})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{})
+
+	srv.SetQueryCache(lru.New(queryCacheSize))
+
+	if r.limits != (QueryLimits{}) {
+		srv.Use(queryLimiter{limits: r.limits})
+	}
+
+	srv.Use(extension.Introspection{})
+
+	persistedQueries := r.persistedQueries
+	if persistedQueries == nil {
+		persistedQueries = lru.New(DefaultPersistedQueryCacheSize)
+	}
+
+	// the allowlist resolves queries sent by their hash, and then rejects any other query
+	if r.allowlist != nil {
+		persistedQueries = r.allowlist
+	}
+
+	srv.Use(extension.AutomaticPersistedQuery{
+		Cache: persistedQueries,
+	})
+
+	if r.allowlist != nil {
+		srv.Use(r.allowlist)
+	}
+
+	// queries and mutations share permission decisions and loaders while they resolve, subscriptions
+	// are long lived and check access again for every change they send
+	srv.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		if op := graphql.GetOperationContext(ctx).Operation; op != nil && op.Operation == ast.Subscription {
+			return next(ctx)
+		}
+
+		return next(withLoaders(withAccessCache(ctx)))
+	})
+
+	srv.SetErrorPresenter(errorPresenter)
 	srv.Use(oteltracing.Tracer{})
 
 	h := &Handler{
@@ -101,13 +175,22 @@ func (h *Handler) Handler() http.HandlerFunc {
 
 // Routes ...
 func (h *Handler) Routes(e *echo.Group) {
-	e.Use(h.middleware...)
+	// websocket connections run the middleware when they are initialized
+	for _, m := range h.middleware {
+		e.Use(skipWebsocketUpgrades(m))
+	}
 
 	e.POST("/"+graphPath, func(c echo.Context) error {
 		h.graphqlHandler.ServeHTTP(c.Response(), c.Request())
 		return nil
 	})
 
+	// websocket connections for subscriptions are upgraded from GET requests
+	e.GET("/"+graphPath, func(c echo.Context) error {
+		h.graphqlHandler.ServeHTTP(c.Response(), withWebsocketAuthorization(c.Request()))
+		return nil
+	})
+
 	if h.playground != nil {
 		handlers, err := h.playground.Handlers()
 		if err != nil {
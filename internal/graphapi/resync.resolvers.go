@@ -0,0 +1,92 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.38
+
+import (
+	"context"
+	"errors"
+
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/load-balancer-api/internal/config"
+	"go.infratographer.com/load-balancer-api/internal/resync"
+)
+
+// LoadBalancerResync is the resolver for the loadBalancerResync field.
+func (r *mutationResolver) LoadBalancerResync(ctx context.Context, input LoadBalancerResyncInput) (*LoadBalancerResyncPayload, error) {
+	filter := resync.Filter{}
+	subjects := []gidx.PrefixedID{}
+
+	for _, f := range []struct {
+		name string
+		id   *gidx.PrefixedID
+		dst  *gidx.PrefixedID
+	}{
+		{"ownerID", input.OwnerID, &filter.OwnerID},
+		{"locationID", input.LocationID, &filter.LocationID},
+		{"providerID", input.ProviderID, &filter.ProviderID},
+	} {
+		if f.id == nil {
+			continue
+		}
+
+		if err := validateGidx(*f.id); err != nil {
+			return nil, newInvalidFieldError(f.name, err)
+		}
+
+		*f.dst = *f.id
+		subjects = append(subjects, *f.id)
+	}
+
+	if len(subjects) == 0 {
+		return nil, ErrResyncFilterRequired
+	}
+
+	// the subject must be allowed to resync every resource the filter matches
+	if err := checkAll(ctx, accessRequests(subjects, actionLoadBalancerResync)...); err != nil {
+		return nil, err
+	}
+
+	var after gidx.PrefixedID
+
+	if input.After != nil {
+		after = *input.After
+	}
+
+	limit := resyncDefaultLimit
+
+	if input.Limit != nil {
+		if *input.Limit <= 0 {
+			return nil, newInvalidFieldError("limit", ErrInvalidResyncLimit)
+		}
+
+		limit = min(*input.Limit, resyncMaxLimit)
+	}
+
+	opts := []resync.Option{resync.WithLogger(r.logger)}
+
+	if config.AppConfig.EventSnapshots.Enabled {
+		opts = append(opts, resync.WithSnapshots(config.AppConfig.EventSnapshots.MaxSize))
+	}
+
+	res, err := resync.New(r.client, r.client.EventsPublisher, opts...).Run(ctx, filter, after, limit)
+	if err != nil {
+		if errors.Is(err, resync.ErrInvalidCursor) {
+			return nil, newInvalidFieldError("after", err)
+		}
+
+		r.logger.Errorw("failed to resync load balancer resources", "error", err, "published", res.Published, "cursor", res.Cursor)
+
+		return nil, ErrInternalServerError
+	}
+
+	payload := &LoadBalancerResyncPayload{Published: res.Published}
+
+	if res.Cursor != "" {
+		payload.Cursor = &res.Cursor
+	}
+
+	return payload, nil
+}
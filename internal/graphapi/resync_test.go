@@ -0,0 +1,88 @@
+package graphapi_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/permissions-api/pkg/permissions/mockpermissions"
+	"go.infratographer.com/x/events"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/load-balancer-api/internal/graphclient"
+	"go.infratographer.com/load-balancer-api/internal/resync"
+	"go.infratographer.com/load-balancer-api/internal/testutils"
+)
+
+func TestMutate_LoadBalancerResync(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	ownerID := gidx.MustNewID(ownerPrefix)
+
+	lb := (&testutils.LoadBalancerBuilder{OwnerID: ownerID}).MustNew(ctx)
+	p := (&testutils.PoolBuilder{OwnerID: ownerID}).MustNew(ctx)
+	prt := (&testutils.PortBuilder{LoadBalancerID: lb.ID, PoolIDs: []gidx.PrefixedID{p.ID}}).MustNew(ctx)
+
+	t.Run("resources are republished", func(t *testing.T) {
+		changes, err := testutils.EventsConn.SubscribeChanges(ctx, resync.EventType+".load-balancer")
+		require.NoError(t, err)
+
+		limit := int64(2)
+
+		resp, err := graphTestClient().LoadBalancerResync(ctx, graphclient.LoadBalancerResyncInput{
+			OwnerID: &ownerID,
+			Limit:   &limit,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(2), resp.LoadBalancerResync.Published)
+		require.NotNil(t, resp.LoadBalancerResync.Cursor)
+		assert.Equal(t, prt.ID, *resp.LoadBalancerResync.Cursor)
+
+		msg := testutils.ChannelReceiveWithTimeout[events.Message[events.ChangeMessage]](t, changes, 5*time.Second)
+		assert.Equal(t, lb.ID, msg.Message().SubjectID)
+		assert.Equal(t, resync.EventType, msg.Message().EventType)
+
+		resp, err = graphTestClient().LoadBalancerResync(ctx, graphclient.LoadBalancerResyncInput{
+			OwnerID: &ownerID,
+			After:   resp.LoadBalancerResync.Cursor,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(1), resp.LoadBalancerResync.Published)
+		assert.Nil(t, resp.LoadBalancerResync.Cursor)
+	})
+
+	t.Run("a filter is required", func(t *testing.T) {
+		_, err := graphTestClient().LoadBalancerResync(ctx, graphclient.LoadBalancerResyncInput{})
+		require.ErrorContains(t, err, "an owner, location or provider is required")
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		_, err := graphTestClient().LoadBalancerResync(ctx, graphclient.LoadBalancerResyncInput{
+			OwnerID: &ownerID,
+			After:   &ownerID,
+		})
+		require.ErrorContains(t, err, resync.ErrInvalidCursor.Error())
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		ctx := context.WithValue(ctx, permissions.CheckerCtxKey, denyActionChecker("loadbalancer_resync"))
+
+		_, err := graphTestClient().LoadBalancerResync(ctx, graphclient.LoadBalancerResyncInput{
+			OwnerID: &ownerID,
+		})
+		require.ErrorContains(t, err, "subject doesn't have access")
+	})
+}
@@ -0,0 +1,95 @@
+package graphapi
+
+import (
+	"context"
+
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/x/events"
+	"go.infratographer.com/x/gidx"
+	"golang.org/x/exp/slices"
+
+	"go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/ent/schema"
+	"go.infratographer.com/load-balancer-api/internal/ent/schema/softdelete"
+)
+
+// watchLoadBalancers streams the changes to the load balancers accepted by match until ctx is
+// done. Access is checked again for every change, as it may have been revoked since subscribing.
+func (r *Resolver) watchLoadBalancers(ctx context.Context, match func(*generated.LoadBalancer) bool) (<-chan *LoadBalancerChange, error) {
+	if r.changes == nil {
+		return nil, ErrSubscriptionsUnavailable
+	}
+
+	changes := r.changes.Subscribe(ctx)
+	out := make(chan *LoadBalancerChange)
+
+	go func() {
+		defer close(out)
+
+		for change := range changes {
+			for _, id := range changedLoadBalancerIDs(change.Message) {
+				lbChange := r.loadBalancerChange(ctx, id, change.Message, match)
+				if lbChange == nil {
+					continue
+				}
+
+				select {
+				case out <- lbChange:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// loadBalancerChange returns the change to deliver for the load balancer, or nil when the
+// load balancer is not watched or the subject is no longer allowed to see it
+func (r *Resolver) loadBalancerChange(ctx context.Context, id gidx.PrefixedID, msg events.ChangeMessage, match func(*generated.LoadBalancer) bool) *LoadBalancerChange {
+	// soft deleted load balancers are loaded so their deletion is delivered as well
+	lb, err := r.client.LoadBalancer.Get(softdelete.SkipSoftDelete(ctx), id)
+	if err != nil {
+		if !generated.IsNotFound(err) {
+			r.logger.Errorw("failed to get loadbalancer for change", "error", err, "loadbalancerID", id)
+		}
+
+		return nil
+	}
+
+	if !match(lb) {
+		return nil
+	}
+
+	if err := permissions.CheckAccess(ctx, lb.OwnerID, actionLoadBalancerGet); err != nil {
+		r.logger.Debugw("not delivering change", "error", err, "loadbalancerID", id)
+		return nil
+	}
+
+	change := &LoadBalancerChange{
+		EventType:      msg.EventType,
+		SubjectID:      msg.SubjectID,
+		LoadBalancerID: id,
+		Timestamp:      msg.Timestamp,
+	}
+
+	if lb.DeletedAt.IsZero() {
+		change.LoadBalancer = lb
+	}
+
+	return change
+}
+
+// changedLoadBalancerIDs returns the IDs of the load balancers a change applies to
+func changedLoadBalancerIDs(msg events.ChangeMessage) []gidx.PrefixedID {
+	ids := []gidx.PrefixedID{}
+
+	for _, id := range append([]gidx.PrefixedID{msg.SubjectID}, msg.AdditionalSubjectIDs...) {
+		if id.Prefix() == schema.LoadBalancerPrefix && !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
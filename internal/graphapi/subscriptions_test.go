@@ -0,0 +1,400 @@
+package graphapi_test
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gqlclient "github.com/99designs/gqlgen/client"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/permissions-api/pkg/permissions/mockpermissions"
+	"go.infratographer.com/x/echojwtx"
+	"go.infratographer.com/x/gidx"
+	"go.infratographer.com/x/testing/auth"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+
+	metadata "go.infratographer.com/metadata-api/pkg/client"
+	"go.infratographer.com/metadata-api/pkg/client/mockmetadata"
+
+	"go.infratographer.com/load-balancer-api/internal/graphapi"
+	"go.infratographer.com/load-balancer-api/internal/graphclient"
+	"go.infratographer.com/load-balancer-api/internal/testutils"
+)
+
+const subscriptionTimeout = 5 * time.Second
+
+const loadBalancerChangedSubscription = `subscription($id: ID!) {
+  loadBalancerChanged(id: $id) { eventType subjectID loadBalancerID loadBalancer { name } }
+}`
+
+const ownerLoadBalancersChangedSubscription = `subscription($ownerID: ID!) {
+  ownerLoadBalancersChanged(ownerID: $ownerID) { eventType subjectID loadBalancerID loadBalancer { name } }
+}`
+
+type loadBalancerChange struct {
+	EventType      string
+	SubjectID      string
+	LoadBalancerID string
+	LoadBalancer   *struct {
+		Name string
+	}
+}
+
+type subscriptionResult struct {
+	change loadBalancerChange
+	err    error
+}
+
+// subscribe starts a subscription against a handler using the given permissions checker and
+// returns the delivered changes of the named subscription field
+func subscribe(t *testing.T, checker permissions.Checker, query, field string, options ...gqlclient.Option) <-chan subscriptionResult {
+	t.Helper()
+
+	metadataMock := new(mockmetadata.MockMetadata)
+	metadataMock.On("StatusUpdate", mock.Anything, mock.Anything).Return(&metadata.StatusUpdate{}, nil)
+
+	h := graphapi.NewResolver(EntClient, zap.NewNop().Sugar(),
+		graphapi.WithMetadataClient(metadataMock),
+		graphapi.WithChangeFeed(testutils.ChangeFeed),
+	).Handler(false).Handler()
+
+	// the permissions middleware is not part of the handler, so the checker is set on the upgrade request
+	withChecker := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), permissions.CheckerCtxKey, checker)))
+	})
+
+	return readChanges(t, gqlclient.New(withChecker).Websocket(query, options...), field)
+}
+
+// readChanges returns the changes delivered on the named subscription field
+func readChanges(t *testing.T, sub *gqlclient.Subscription, field string) <-chan subscriptionResult {
+	t.Helper()
+
+	t.Cleanup(func() { _ = sub.Close() })
+
+	results := make(chan subscriptionResult, 10)
+
+	go func() {
+		for {
+			var resp map[string]loadBalancerChange
+
+			err := sub.Next(&resp)
+			results <- subscriptionResult{change: resp[field], err: err}
+
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	return results
+}
+
+// nextChange repeats change until the subscription delivers, as the subscription is set up
+// asynchronously after the websocket connection was acknowledged
+func nextChange(t *testing.T, results <-chan subscriptionResult, change func()) loadBalancerChange {
+	t.Helper()
+
+	deadline := time.After(subscriptionTimeout)
+
+	for {
+		change()
+
+		select {
+		case res := <-results:
+			require.NoError(t, res.err)
+			return res.change
+		case <-time.After(100 * time.Millisecond):
+		case <-deadline:
+			require.FailNow(t, "timed out waiting for change")
+		}
+	}
+}
+
+func receiveChange(t *testing.T, results <-chan subscriptionResult) loadBalancerChange {
+	t.Helper()
+
+	select {
+	case res := <-results:
+		require.NoError(t, res.err)
+		return res.change
+	case <-time.After(subscriptionTimeout):
+		require.FailNow(t, "timed out waiting for change")
+	}
+
+	return loadBalancerChange{}
+}
+
+func TestSubscription_LoadBalancerChanged(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	lb := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+	other := (&testutils.LoadBalancerBuilder{OwnerID: lb.OwnerID}).MustNew(ctx)
+
+	rename := func(id gidx.PrefixedID, name string) func() {
+		return func() {
+			_, err := graphTestClient().LoadBalancerUpdate(ctx, id, graphclient.UpdateLoadBalancerInput{Name: &name}, nil)
+			require.NoError(t, err)
+		}
+	}
+
+	t.Run("changes to the load balancer and its ports are delivered", func(t *testing.T) {
+		results := subscribe(t, permissions.DefaultAllowChecker, loadBalancerChangedSubscription, "loadBalancerChanged", gqlclient.Var("id", lb.ID))
+
+		change := nextChange(t, results, rename(lb.ID, "renamed"))
+		assert.Equal(t, "update", change.EventType)
+		assert.Equal(t, lb.ID.String(), change.SubjectID)
+		assert.Equal(t, lb.ID.String(), change.LoadBalancerID)
+		require.NotNil(t, change.LoadBalancer)
+		assert.Equal(t, "renamed", change.LoadBalancer.Name)
+
+		// drain repeated renames
+		for len(results) > 0 {
+			<-results
+		}
+
+		// changes to other load balancers are not delivered
+		rename(other.ID, "other")()
+
+		resp, err := graphTestClient().LoadBalancerPortCreate(ctx, graphclient.CreateLoadBalancerPortInput{
+			Number:         8080,
+			LoadBalancerID: lb.ID,
+		})
+		require.NoError(t, err)
+
+		change = receiveChange(t, results)
+		assert.Equal(t, "create", change.EventType)
+		assert.Equal(t, resp.LoadBalancerPortCreate.LoadBalancerPort.ID.String(), change.SubjectID)
+		assert.Equal(t, lb.ID.String(), change.LoadBalancerID)
+	})
+
+	t.Run("access is checked for every change", func(t *testing.T) {
+		var deny atomic.Bool
+
+		checker := func(ctx context.Context, requests ...permissions.AccessRequest) error {
+			if deny.Load() {
+				return permissions.ErrPermissionDenied
+			}
+
+			return nil
+		}
+
+		results := subscribe(t, checker, loadBalancerChangedSubscription, "loadBalancerChanged", gqlclient.Var("id", lb.ID))
+
+		nextChange(t, results, rename(lb.ID, "before"))
+
+		for len(results) > 0 {
+			<-results
+		}
+
+		deny.Store(true)
+		rename(lb.ID, "denied")()
+		deny.Store(false)
+		rename(lb.ID, "after")()
+
+		change := receiveChange(t, results)
+		require.NotNil(t, change.LoadBalancer)
+		assert.Equal(t, "after", change.LoadBalancer.Name)
+	})
+
+	t.Run("deletion is delivered", func(t *testing.T) {
+		deleted := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+
+		results := subscribe(t, permissions.DefaultAllowChecker, loadBalancerChangedSubscription, "loadBalancerChanged", gqlclient.Var("id", deleted.ID))
+
+		nextChange(t, results, rename(deleted.ID, "deleted"))
+
+		for len(results) > 0 {
+			<-results
+		}
+
+		_, err := graphTestClient().LoadBalancerDelete(ctx, deleted.ID, nil)
+		require.NoError(t, err)
+
+		change := receiveChange(t, results)
+		assert.Equal(t, "delete", change.EventType)
+		assert.Equal(t, deleted.ID.String(), change.LoadBalancerID)
+		assert.Nil(t, change.LoadBalancer)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		results := subscribe(t, denyActionChecker("loadbalancer_get"), loadBalancerChangedSubscription, "loadBalancerChanged", gqlclient.Var("id", lb.ID))
+
+		select {
+		case res := <-results:
+			require.Error(t, res.err)
+			assert.ErrorContains(t, res.err, "subject doesn't have access")
+		case <-time.After(subscriptionTimeout):
+			require.FailNow(t, "timed out waiting for error")
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		results := subscribe(t, permissions.DefaultAllowChecker, loadBalancerChangedSubscription, "loadBalancerChanged", gqlclient.Var("id", gidx.MustNewID(lbPrefix)))
+
+		select {
+		case res := <-results:
+			require.Error(t, res.err)
+			assert.ErrorContains(t, res.err, "load_balancer not found")
+		case <-time.After(subscriptionTimeout):
+			require.FailNow(t, "timed out waiting for error")
+		}
+	})
+}
+
+func TestSubscription_OwnerLoadBalancersChanged(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	prov := (&testutils.ProviderBuilder{}).MustNew(ctx)
+	ownerID := gidx.MustNewID(ownerPrefix)
+	other := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+
+	results := subscribe(t, permissions.DefaultAllowChecker, ownerLoadBalancersChangedSubscription, "ownerLoadBalancersChanged", gqlclient.Var("ownerID", ownerID))
+
+	var created []gidx.PrefixedID
+
+	change := nextChange(t, results, func() {
+		// changes to load balancers of other owners are not delivered
+		name := "other"
+		_, err := graphTestClient().LoadBalancerUpdate(ctx, other.ID, graphclient.UpdateLoadBalancerInput{Name: &name}, nil)
+		require.NoError(t, err)
+
+		resp, err := graphTestClient().LoadBalancerCreate(ctx, graphclient.CreateLoadBalancerInput{
+			Name:       "owned",
+			OwnerID:    ownerID,
+			LocationID: gidx.MustNewID(locationPrefix),
+			ProviderID: prov.ID,
+		})
+		require.NoError(t, err)
+
+		created = append(created, resp.LoadBalancerCreate.LoadBalancer.ID)
+	})
+
+	assert.Equal(t, "create", change.EventType)
+	assert.Contains(t, created, gidx.PrefixedID(change.LoadBalancerID))
+	require.NotNil(t, change.LoadBalancer)
+	assert.Equal(t, "owned", change.LoadBalancer.Name)
+
+	t.Run("permission denied", func(t *testing.T) {
+		results := subscribe(t, denyActionChecker("loadbalancer_get"), ownerLoadBalancersChangedSubscription, "ownerLoadBalancersChanged", gqlclient.Var("ownerID", ownerID))
+
+		select {
+		case res := <-results:
+			require.Error(t, res.err)
+			assert.ErrorContains(t, res.err, "subject doesn't have access")
+		case <-time.After(subscriptionTimeout):
+			require.FailNow(t, "timed out waiting for error")
+		}
+	})
+}
+
+func TestSubscription_WebsocketAuthentication(t *testing.T) {
+	oauthCLI, issuer, oAuthClose := auth.OAuthTestClient("urn:test:loadbalancer", "")
+	defer oAuthClose()
+
+	token, err := oauthCLI.Transport.(*oauth2.Transport).Source.Token()
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	lb := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+
+	jwtAuth, err := echojwtx.NewAuth(ctx, echojwtx.AuthConfig{Issuer: issuer})
+	require.NoError(t, err)
+
+	permsMiddleware, err := permissions.New(permissions.Config{}, permissions.WithDefaultChecker(permissions.DefaultAllowChecker))
+	require.NoError(t, err)
+
+	metadataMock := new(mockmetadata.MockMetadata)
+	metadataMock.On("StatusUpdate", mock.Anything, mock.Anything).Return(&metadata.StatusUpdate{}, nil)
+
+	// the middleware authenticates websocket connections when they are initialized, the resolvers
+	// only check access with the checker the middleware set
+	e := echo.New()
+	graphapi.NewResolver(EntClient, zap.NewNop().Sugar(),
+		graphapi.WithMetadataClient(metadataMock),
+		graphapi.WithChangeFeed(testutils.ChangeFeed),
+	).Handler(false, jwtAuth.Middleware(), permsMiddleware.Middleware()).Routes(e.Group(""))
+
+	rename := func() {
+		name := "renamed"
+
+		_, err := graphTestClient().LoadBalancerUpdate(ctx, lb.ID, graphclient.UpdateLoadBalancerInput{Name: &name}, nil)
+		require.NoError(t, err)
+	}
+
+	testCases := []struct {
+		name         string
+		payload      map[string]any
+		options      []gqlclient.Option
+		unauthorized bool
+	}{
+		{
+			name:    "token in init payload",
+			payload: map[string]any{"Authorization": "Bearer " + token.AccessToken},
+		},
+		{
+			name:    "token in upgrade request",
+			options: []gqlclient.Option{gqlclient.AddHeader(echo.HeaderAuthorization, "Bearer "+token.AccessToken)},
+		},
+		{
+			name:         "invalid token",
+			payload:      map[string]any{"Authorization": "Bearer invalid"},
+			unauthorized: true,
+		},
+		{
+			name:         "no token",
+			unauthorized: true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			options := append([]gqlclient.Option{gqlclient.Path("/query"), gqlclient.Var("id", lb.ID)}, tt.options...)
+			sub := gqlclient.New(e).WebsocketWithPayload(loadBalancerChangedSubscription, tt.payload, options...)
+
+			results := readChanges(t, sub, "loadBalancerChanged")
+
+			// the connection is closed with an error instead of being acknowledged
+			if tt.unauthorized {
+				res := <-results
+				require.Error(t, res.err)
+				assert.ErrorContains(t, res.err, "connection_error")
+
+				return
+			}
+
+			change := nextChange(t, results, rename)
+			assert.Equal(t, lb.ID.String(), change.SubjectID)
+		})
+	}
+}
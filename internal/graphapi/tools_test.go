@@ -8,7 +8,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/labstack/echo/v4"
 	_ "github.com/lib/pq"
 	_ "github.com/mattn/go-sqlite3"
@@ -20,11 +19,12 @@ import (
 	"go.infratographer.com/permissions-api/pkg/permissions"
 	"go.infratographer.com/x/echojwtx"
 	"go.infratographer.com/x/echox"
+	"go.infratographer.com/x/gidx"
 
 	ent "go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/eventhooks"
 	"go.infratographer.com/load-balancer-api/internal/graphapi"
 	"go.infratographer.com/load-balancer-api/internal/graphclient"
-	"go.infratographer.com/load-balancer-api/internal/manualhooks"
 	"go.infratographer.com/load-balancer-api/internal/testutils"
 )
 
@@ -46,7 +46,7 @@ func TestMain(m *testing.M) {
 	EntClient = testutils.EntClient
 
 	// setup the resolver hooks
-	manualhooks.PubsubHooks(EntClient)
+	eventhooks.EventHooks(EntClient)
 
 	// run the tests
 	code := m.Run()
@@ -83,10 +83,9 @@ func graphTestClient(options ...graphClientOptions) graphclient.GraphClient {
 
 	g := &graphClient{
 		srvURL: "graph",
-		httpClient: &http.Client{Transport: localRoundTripper{handler: handler.NewDefaultServer(
-			graphapi.NewExecutableSchema(
-				graphapi.Config{Resolvers: graphapi.NewResolver(EntClient, zap.NewNop().Sugar(), graphapi.WithMetadataClient(metadataMock))},
-			))}},
+		httpClient: &http.Client{Transport: localRoundTripper{
+			handler: graphapi.NewResolver(EntClient, zap.NewNop().Sugar(), graphapi.WithMetadataClient(metadataMock)).Handler(false).Handler(),
+		}},
 	}
 
 	for _, opt := range options {
@@ -162,6 +161,75 @@ func newTestServer(options ...testServerOption) (*httptest.Server, error) {
 	return httptest.NewServer(srv.Handler()), nil
 }
 
+// denyActionChecker returns a permissions checker that denies the given action and allows all others
+func denyActionChecker(action string) permissions.Checker {
+	return func(_ context.Context, requests ...permissions.AccessRequest) error {
+		for _, req := range requests {
+			if req.Action == action {
+				return permissions.ErrPermissionDenied
+			}
+		}
+
+		return nil
+	}
+}
+
+// denyResourceActionChecker returns a permissions checker that denies the action on the given
+// resource and allows all other requests
+func denyResourceActionChecker(resource gidx.PrefixedID, action string) permissions.Checker {
+	return func(_ context.Context, requests ...permissions.AccessRequest) error {
+		for _, req := range requests {
+			if req.ResourceID == resource && req.Action == action {
+				return permissions.ErrPermissionDenied
+			}
+		}
+
+		return nil
+	}
+}
+
+// documentedPolicy is the ID prefix of the resource type each action is bound to in the policy
+// documented in docs/permissions.md
+var documentedPolicy = map[string]string{
+	"loadbalancer_create":              ownerPrefix,
+	"loadbalancer_update":              ownerPrefix,
+	"loadbalancer_delete":              ownerPrefix,
+	"loadbalancer_get":                 ownerPrefix,
+	"loadbalancer_get_history":         ownerPrefix,
+	"loadbalancerpool_create":          ownerPrefix,
+	"loadbalancerpool_update":          ownerPrefix,
+	"loadbalancerpool_delete":          ownerPrefix,
+	"loadbalancerpool_get":             ownerPrefix,
+	"loadbalancerpool_get_history":     ownerPrefix,
+	"loadbalancerport_create":          lbPrefix,
+	"loadbalancerport_update":          lbPrefix,
+	"loadbalancerport_delete":          lbPrefix,
+	"loadbalancerport_get":             lbPrefix,
+	"loadbalancerorigin_create":        "loadpol",
+	"loadbalancerorigin_update":        "loadpol",
+	"loadbalancerorigin_delete":        "loadpol",
+	"loadbalancerorigin_get":           "loadpol",
+	"loadbalancerprovider_create":      ownerPrefix,
+	"loadbalancerprovider_update":      "loadpvd",
+	"loadbalancerprovider_delete":      "loadpvd",
+	"loadbalancerprovider_get":         "loadpvd",
+	"loadbalancerprovider_get_history": "loadpvd",
+}
+
+// documentedPolicyChecker returns a permissions checker that allows an action only on the type of
+// resource it is bound to in the documented policy, so checks made on the wrong resource are denied
+func documentedPolicyChecker() permissions.Checker {
+	return func(_ context.Context, requests ...permissions.AccessRequest) error {
+		for _, req := range requests {
+			if prefix, ok := documentedPolicy[req.Action]; !ok || req.ResourceID.Prefix() != prefix {
+				return permissions.ErrPermissionDenied
+			}
+		}
+
+		return nil
+	}
+}
+
 func newString(s string) *string {
 	return &s
 }
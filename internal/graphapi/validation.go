@@ -1,10 +1,19 @@
 package graphapi
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
 	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/loadbalancer"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/origin"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/pool"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/port"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/predicate"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/provider"
+	"go.infratographer.com/load-balancer-api/internal/ent/schema/validations"
 )
 
 // validateGidx validates a gidx.PrefixedID
@@ -35,3 +44,62 @@ func sanitizeField(field string) string {
 
 	return s
 }
+
+// validateOriginTarget checks an origin target against the deny policy of every provider
+// the pool is reachable through
+func (r *Resolver) validateOriginTarget(ctx context.Context, poolID gidx.PrefixedID, target string) error {
+	providerIDs, err := r.loadBalancerProviderIDs(ctx, loadbalancer.HasPortsWith(port.HasPoolsWith(pool.IDEQ(poolID))))
+	if err != nil {
+		return err
+	}
+
+	if err := validations.OriginTarget(target, providerIDs...); err != nil {
+		return newInvalidFieldError("target", err)
+	}
+
+	return nil
+}
+
+// validateOriginTargets checks the targets of the origins matching where against the deny
+// policy of every provider of the load balancers matching lbs. It is used when pools and
+// origins become reachable through load balancers they were not reachable through before.
+func (r *Resolver) validateOriginTargets(ctx context.Context, field string, where predicate.Origin, lbs predicate.LoadBalancer) error {
+	providerIDs, err := r.loadBalancerProviderIDs(ctx, lbs)
+	if err != nil {
+		return err
+	}
+
+	if len(providerIDs) == 0 {
+		return nil
+	}
+
+	targets, err := r.client.Origin.Query().Where(where).Select(origin.FieldTarget).Strings(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, target := range targets {
+		if err := validations.OriginTarget(target, providerIDs...); err != nil {
+			return newInvalidFieldError(field, err)
+		}
+	}
+
+	return nil
+}
+
+// loadBalancerProviderIDs returns the providers of the load balancers matching lbs
+func (r *Resolver) loadBalancerProviderIDs(ctx context.Context, lbs predicate.LoadBalancer) ([]gidx.PrefixedID, error) {
+	return r.client.Provider.Query().
+		Where(provider.HasLoadBalancersWith(lbs)).
+		IDs(ctx)
+}
+
+// validateVersion checks the current version of a resource against the version the client
+// expects, when one is given
+func validateVersion(expected *int, current int) error {
+	if expected != nil && *expected != current {
+		return ErrVersionMismatch
+	}
+
+	return nil
+}
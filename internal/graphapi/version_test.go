@@ -0,0 +1,215 @@
+package graphapi_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/permissions-api/pkg/permissions/mockpermissions"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/loadbalancer"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/origin"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/pool"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/port"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/provider"
+	"go.infratographer.com/load-balancer-api/internal/graphclient"
+	"go.infratographer.com/load-balancer-api/internal/testutils"
+)
+
+func TestMutate_ExpectedVersion(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	name := "updated"
+
+	testCases := []struct {
+		TestName string
+		Table    string
+		Create   func(ctx context.Context) gidx.PrefixedID
+		Update   func(ctx context.Context, id gidx.PrefixedID, expectedVersion *int64) (int64, error)
+		Delete   func(ctx context.Context, id gidx.PrefixedID, expectedVersion *int64) error
+	}{
+		{
+			TestName: "loadBalancer",
+			Table:    loadbalancer.Table,
+			Create: func(ctx context.Context) gidx.PrefixedID {
+				return (&testutils.LoadBalancerBuilder{}).MustNew(ctx).ID
+			},
+			Update: func(ctx context.Context, id gidx.PrefixedID, expectedVersion *int64) (int64, error) {
+				resp, err := graphTestClient().LoadBalancerUpdate(ctx, id, graphclient.UpdateLoadBalancerInput{Name: &name}, expectedVersion)
+				if err != nil {
+					return 0, err
+				}
+
+				return resp.LoadBalancerUpdate.LoadBalancer.Version, nil
+			},
+			Delete: func(ctx context.Context, id gidx.PrefixedID, expectedVersion *int64) error {
+				_, err := graphTestClient().LoadBalancerDelete(ctx, id, expectedVersion)
+				return err
+			},
+		},
+		{
+			TestName: "loadBalancerPort",
+			Table:    port.Table,
+			Create: func(ctx context.Context) gidx.PrefixedID {
+				lb := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+				return (&testutils.PortBuilder{LoadBalancerID: lb.ID}).MustNew(ctx).ID
+			},
+			Update: func(ctx context.Context, id gidx.PrefixedID, expectedVersion *int64) (int64, error) {
+				resp, err := graphTestClient().LoadBalancerPortUpdate(ctx, id, graphclient.UpdateLoadBalancerPortInput{Name: &name}, expectedVersion)
+				if err != nil {
+					return 0, err
+				}
+
+				return resp.LoadBalancerPortUpdate.LoadBalancerPort.Version, nil
+			},
+			Delete: func(ctx context.Context, id gidx.PrefixedID, expectedVersion *int64) error {
+				_, err := graphTestClient().LoadBalancerPortDelete(ctx, id, expectedVersion)
+				return err
+			},
+		},
+		{
+			TestName: "loadBalancerPool",
+			Table:    pool.Table,
+			Create: func(ctx context.Context) gidx.PrefixedID {
+				return (&testutils.PoolBuilder{}).MustNew(ctx).ID
+			},
+			Update: func(ctx context.Context, id gidx.PrefixedID, expectedVersion *int64) (int64, error) {
+				resp, err := graphTestClient().LoadBalancerPoolUpdate(ctx, id, graphclient.UpdateLoadBalancerPoolInput{Name: &name}, expectedVersion)
+				if err != nil {
+					return 0, err
+				}
+
+				return resp.LoadBalancerPoolUpdate.LoadBalancerPool.Version, nil
+			},
+			Delete: func(ctx context.Context, id gidx.PrefixedID, expectedVersion *int64) error {
+				_, err := graphTestClient().LoadBalancerPoolDelete(ctx, id, expectedVersion)
+				return err
+			},
+		},
+		{
+			TestName: "loadBalancerOrigin",
+			Table:    origin.Table,
+			Create: func(ctx context.Context) gidx.PrefixedID {
+				p := (&testutils.PoolBuilder{}).MustNew(ctx)
+				return (&testutils.OriginBuilder{PoolID: p.ID}).MustNew(ctx).ID
+			},
+			Update: func(ctx context.Context, id gidx.PrefixedID, expectedVersion *int64) (int64, error) {
+				resp, err := graphTestClient().LoadBalancerOriginUpdate(ctx, id, graphclient.UpdateLoadBalancerOriginInput{Name: &name}, expectedVersion)
+				if err != nil {
+					return 0, err
+				}
+
+				return resp.LoadBalancerOriginUpdate.LoadBalancerOrigin.Version, nil
+			},
+			Delete: func(ctx context.Context, id gidx.PrefixedID, expectedVersion *int64) error {
+				_, err := graphTestClient().LoadBalancerOriginDelete(ctx, id, expectedVersion)
+				return err
+			},
+		},
+		{
+			TestName: "loadBalancerProvider",
+			Table:    provider.Table,
+			Create: func(ctx context.Context) gidx.PrefixedID {
+				return (&testutils.ProviderBuilder{}).MustNew(ctx).ID
+			},
+			Update: func(ctx context.Context, id gidx.PrefixedID, expectedVersion *int64) (int64, error) {
+				resp, err := graphTestClient().LoadBalancerProviderUpdate(ctx, id, graphclient.UpdateLoadBalancerProviderInput{Name: &name}, expectedVersion)
+				if err != nil {
+					return 0, err
+				}
+
+				return resp.LoadBalancerProviderUpdate.LoadBalancerProvider.Version, nil
+			},
+			Delete: func(ctx context.Context, id gidx.PrefixedID, expectedVersion *int64) error {
+				_, err := graphTestClient().LoadBalancerProviderDelete(ctx, id, expectedVersion)
+				return err
+			},
+		},
+	}
+
+	// concurrentChange is run right before the next update of the resource with the given id is
+	// stored, after the resolver has read and checked the version of the row
+	var concurrentChange atomic.Pointer[struct {
+		id     gidx.PrefixedID
+		change func()
+	}]
+
+	EntClient.Use(func(next generated.Mutator) generated.Mutator {
+		return generated.MutateFunc(func(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+			idm, ok := m.(interface {
+				ID() (gidx.PrefixedID, bool)
+			})
+			if c := concurrentChange.Load(); ok && c != nil && m.Op().Is(generated.OpUpdateOne) {
+				if id, _ := idm.ID(); id == c.id && concurrentChange.CompareAndSwap(c, nil) {
+					c.change()
+				}
+			}
+
+			return next.Mutate(ctx, m)
+		})
+	})
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			id := tt.Create(ctx)
+
+			stale := int64(1)
+
+			// updates without an expected version always apply
+			version, err := tt.Update(ctx, id, nil)
+			require.NoError(t, err)
+			assert.Equal(t, int64(2), version)
+
+			_, err = tt.Update(ctx, id, &stale)
+			require.Error(t, err)
+			assert.ErrorContains(t, err, "resource version does not match the expected version")
+
+			version, err = tt.Update(ctx, id, &version)
+			require.NoError(t, err)
+			assert.Equal(t, int64(3), version)
+
+			// the row is changed between the read of the resolver and the update, which must
+			// then be refused by the version predicate of the update
+			concurrentChange.Store(&struct {
+				id     gidx.PrefixedID
+				change func()
+			}{id: id, change: func() {
+				_, err := tt.Update(ctx, id, nil)
+				require.NoError(t, err)
+			}})
+
+			_, err = tt.Update(ctx, id, &version)
+			require.Error(t, err)
+			assert.ErrorContains(t, err, "resource version does not match the expected version")
+			assert.Nil(t, concurrentChange.Load(), "the concurrent change must have run")
+
+			version++
+
+			err = tt.Delete(ctx, id, &stale)
+			require.Error(t, err)
+			assert.ErrorContains(t, err, "resource version does not match the expected version")
+
+			_, err = EntClient.Noder(ctx, id, generated.WithFixedNodeType(tt.Table))
+			require.NoError(t, err, "resource must not be deleted on a version mismatch")
+
+			require.NoError(t, tt.Delete(ctx, id, &version))
+
+			_, err = EntClient.Noder(ctx, id, generated.WithFixedNodeType(tt.Table))
+			assert.True(t, generated.IsNotFound(err))
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package graphapi
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/labstack/echo/v4"
+)
+
+// websocketAuthorizationKey is the context key of the Authorization header of a websocket upgrade request
+type websocketAuthorizationKey struct{}
+
+// isWebsocketUpgrade reports whether the request upgrades to a websocket connection
+func isWebsocketUpgrade(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get(echo.HeaderUpgrade), "websocket")
+}
+
+// skipWebsocketUpgrades wraps middleware so that it is not run for websocket upgrade requests.
+// Browsers can not set headers on those requests, so websocket connections are authenticated
+// when they are initialized instead.
+func skipWebsocketUpgrades(middleware echo.MiddlewareFunc) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		withMiddleware := middleware(next)
+
+		return func(c echo.Context) error {
+			if isWebsocketUpgrade(c.Request()) {
+				return next(c)
+			}
+
+			return withMiddleware(c)
+		}
+	}
+}
+
+// withWebsocketAuthorization keeps the Authorization header of a websocket upgrade request in
+// its context, for connections that do not send their credentials in the init payload
+func withWebsocketAuthorization(r *http.Request) *http.Request {
+	if !isWebsocketUpgrade(r) {
+		return r
+	}
+
+	return r.WithContext(context.WithValue(r.Context(), websocketAuthorizationKey{}, r.Header.Get(echo.HeaderAuthorization)))
+}
+
+// discardResponseWriter drops responses written by middleware while a websocket is initialized
+type discardResponseWriter struct {
+	header http.Header
+}
+
+func (w *discardResponseWriter) Header() http.Header         { return w.header }
+func (w *discardResponseWriter) Write(b []byte) (int, error) { return len(b), nil }
+func (w *discardResponseWriter) WriteHeader(int)             {}
+
+// websocketInit returns the function authenticating websocket connections when they are
+// initialized. It runs the middleware for a request with the Authorization of the init payload,
+// or of the upgrade request if the payload has none, and the connection continues with the
+// context the middleware produced.
+func (r *Resolver) websocketInit(middleware []echo.MiddlewareFunc) transport.WebsocketInitFunc {
+	if len(middleware) == 0 {
+		return nil
+	}
+
+	e := echo.New()
+
+	return func(ctx context.Context, payload transport.InitPayload) (context.Context, error) {
+		authorization := payload.Authorization()
+		if authorization == "" {
+			authorization, _ = ctx.Value(websocketAuthorizationKey{}).(string)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, graphFullPath, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if authorization != "" {
+			req.Header.Set(echo.HeaderAuthorization, authorization)
+		}
+
+		var initCtx context.Context
+
+		next := func(c echo.Context) error {
+			initCtx = c.Request().Context()
+
+			return nil
+		}
+
+		for i := len(middleware) - 1; i >= 0; i-- {
+			next = middleware[i](next)
+		}
+
+		if err := next(e.NewContext(req, &discardResponseWriter{header: http.Header{}})); err != nil {
+			r.logger.Debugw("websocket connection not authorized", "error", err)
+
+			return nil, ErrWebsocketUnauthorized
+		}
+
+		if initCtx == nil {
+			return nil, ErrWebsocketUnauthorized
+		}
+
+		return initCtx, nil
+	}
+}
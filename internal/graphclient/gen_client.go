@@ -4,6 +4,7 @@ package graphclient
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"time"
 
@@ -13,27 +14,43 @@ import (
 
 type GraphClient interface {
 	GetLoadBalancer(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*GetLoadBalancer, error)
+	GetLoadBalancerAuditEvents(ctx context.Context, id gidx.PrefixedID, filter *AuditEventFilter, httpRequestOptions ...client.HTTPRequestOption) (*GetLoadBalancerAuditEvents, error)
+	GetLoadBalancerHistory(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*GetLoadBalancerHistory, error)
+	GetLoadBalancerOrigin(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*GetLoadBalancerOrigin, error)
+	GetLoadBalancerOriginHistory(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*GetLoadBalancerOriginHistory, error)
 	GetLoadBalancerPool(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*GetLoadBalancerPool, error)
+	GetLoadBalancerPoolAuditEvents(ctx context.Context, id gidx.PrefixedID, filter *AuditEventFilter, httpRequestOptions ...client.HTTPRequestOption) (*GetLoadBalancerPoolAuditEvents, error)
+	GetLoadBalancerPoolHistory(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*GetLoadBalancerPoolHistory, error)
 	GetLoadBalancerPoolOrigin(ctx context.Context, id gidx.PrefixedID, originid gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*GetLoadBalancerPoolOrigin, error)
+	GetLoadBalancerPoolOrigins(ctx context.Context, id gidx.PrefixedID, includeDeleted *bool, after *string, httpRequestOptions ...client.HTTPRequestOption) (*GetLoadBalancerPoolOrigins, error)
 	GetLoadBalancerPort(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*GetLoadBalancerPort, error)
+	GetLoadBalancerPortHistory(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*GetLoadBalancerPortHistory, error)
+	GetLoadBalancerPorts(ctx context.Context, id gidx.PrefixedID, includeDeleted *bool, after *string, httpRequestOptions ...client.HTTPRequestOption) (*GetLoadBalancerPorts, error)
 	GetLoadBalancerProvider(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*GetLoadBalancerProvider, error)
-	GetOwnerLoadBalancers(ctx context.Context, id gidx.PrefixedID, orderBy *LoadBalancerOrder, httpRequestOptions ...client.HTTPRequestOption) (*GetOwnerLoadBalancers, error)
+	GetLoadBalancerProviderAuditEvents(ctx context.Context, id gidx.PrefixedID, filter *AuditEventFilter, httpRequestOptions ...client.HTTPRequestOption) (*GetLoadBalancerProviderAuditEvents, error)
+	GetLoadBalancerProviderHistory(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*GetLoadBalancerProviderHistory, error)
+	GetOwnerLoadBalancerPools(ctx context.Context, id gidx.PrefixedID, after *string, httpRequestOptions ...client.HTTPRequestOption) (*GetOwnerLoadBalancerPools, error)
+	GetOwnerLoadBalancerProviders(ctx context.Context, id gidx.PrefixedID, orderBy *LoadBalancerProviderOrder, after *string, httpRequestOptions ...client.HTTPRequestOption) (*GetOwnerLoadBalancerProviders, error)
+	GetOwnerLoadBalancers(ctx context.Context, id gidx.PrefixedID, orderBy *LoadBalancerOrder, includeDeleted *bool, after *string, httpRequestOptions ...client.HTTPRequestOption) (*GetOwnerLoadBalancers, error)
 	GetPortByLoadBalancer(ctx context.Context, id gidx.PrefixedID, portid gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*GetPortByLoadBalancer, error)
+	LoadBalancerApply(ctx context.Context, spec json.RawMessage, dryRun *bool, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerApply, error)
 	LoadBalancerCreate(ctx context.Context, input CreateLoadBalancerInput, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerCreate, error)
-	LoadBalancerDelete(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerDelete, error)
+	LoadBalancerCreateWithChildren(ctx context.Context, input CreateLoadBalancerWithChildrenInput, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerCreateWithChildren, error)
+	LoadBalancerDelete(ctx context.Context, id gidx.PrefixedID, expectedVersion *int64, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerDelete, error)
 	LoadBalancerOriginCreate(ctx context.Context, input CreateLoadBalancerOriginInput, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerOriginCreate, error)
-	LoadBalancerOriginDelete(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerOriginDelete, error)
-	LoadBalancerOriginUpdate(ctx context.Context, id gidx.PrefixedID, input UpdateLoadBalancerOriginInput, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerOriginUpdate, error)
+	LoadBalancerOriginDelete(ctx context.Context, id gidx.PrefixedID, expectedVersion *int64, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerOriginDelete, error)
+	LoadBalancerOriginUpdate(ctx context.Context, id gidx.PrefixedID, input UpdateLoadBalancerOriginInput, expectedVersion *int64, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerOriginUpdate, error)
 	LoadBalancerPoolCreate(ctx context.Context, input CreateLoadBalancerPoolInput, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerPoolCreate, error)
-	LoadBalancerPoolDelete(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerPoolDelete, error)
-	LoadBalancerPoolUpdate(ctx context.Context, id gidx.PrefixedID, input UpdateLoadBalancerPoolInput, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerPoolUpdate, error)
+	LoadBalancerPoolDelete(ctx context.Context, id gidx.PrefixedID, expectedVersion *int64, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerPoolDelete, error)
+	LoadBalancerPoolUpdate(ctx context.Context, id gidx.PrefixedID, input UpdateLoadBalancerPoolInput, expectedVersion *int64, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerPoolUpdate, error)
 	LoadBalancerPortCreate(ctx context.Context, input CreateLoadBalancerPortInput, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerPortCreate, error)
-	LoadBalancerPortDelete(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerPortDelete, error)
-	LoadBalancerPortUpdate(ctx context.Context, id gidx.PrefixedID, input UpdateLoadBalancerPortInput, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerPortUpdate, error)
+	LoadBalancerPortDelete(ctx context.Context, id gidx.PrefixedID, expectedVersion *int64, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerPortDelete, error)
+	LoadBalancerPortUpdate(ctx context.Context, id gidx.PrefixedID, input UpdateLoadBalancerPortInput, expectedVersion *int64, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerPortUpdate, error)
 	LoadBalancerProviderCreate(ctx context.Context, input CreateLoadBalancerProviderInput, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerProviderCreate, error)
-	LoadBalancerProviderDelete(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerProviderDelete, error)
-	LoadBalancerProviderUpdate(ctx context.Context, id gidx.PrefixedID, input UpdateLoadBalancerProviderInput, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerProviderUpdate, error)
-	LoadBalancerUpdate(ctx context.Context, id gidx.PrefixedID, input UpdateLoadBalancerInput, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerUpdate, error)
+	LoadBalancerProviderDelete(ctx context.Context, id gidx.PrefixedID, expectedVersion *int64, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerProviderDelete, error)
+	LoadBalancerProviderUpdate(ctx context.Context, id gidx.PrefixedID, input UpdateLoadBalancerProviderInput, expectedVersion *int64, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerProviderUpdate, error)
+	LoadBalancerResync(ctx context.Context, input LoadBalancerResyncInput, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerResync, error)
+	LoadBalancerUpdate(ctx context.Context, id gidx.PrefixedID, input UpdateLoadBalancerInput, expectedVersion *int64, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerUpdate, error)
 }
 
 type Client struct {
@@ -45,30 +62,38 @@ func NewClient(cli *http.Client, baseURL string, options ...client.HTTPRequestOp
 }
 
 type Query struct {
-	LoadBalancer         LoadBalancer         "json:\"loadBalancer\" graphql:\"loadBalancer\""
-	LoadBalancerHistory  LoadBalancer         "json:\"loadBalancerHistory\" graphql:\"loadBalancerHistory\""
-	LoadBalancerPool     LoadBalancerPool     "json:\"loadBalancerPool\" graphql:\"loadBalancerPool\""
-	LoadBalancerPort     LoadBalancerPort     "json:\"loadBalancerPort\" graphql:\"loadBalancerPort\""
-	LoadBalancerProvider LoadBalancerProvider "json:\"loadBalancerProvider\" graphql:\"loadBalancerProvider\""
-	Entities             []Entity             "json:\"_entities\" graphql:\"_entities\""
-	Service              Service              "json:\"_service\" graphql:\"_service\""
+	LoadBalancer                LoadBalancer         "json:\"loadBalancer\" graphql:\"loadBalancer\""
+	LoadBalancerHistory         LoadBalancer         "json:\"loadBalancerHistory\" graphql:\"loadBalancerHistory\""
+	LoadBalancerOrigin          LoadBalancerOrigin   "json:\"loadBalancerOrigin\" graphql:\"loadBalancerOrigin\""
+	LoadBalancerOriginHistory   LoadBalancerOrigin   "json:\"loadBalancerOriginHistory\" graphql:\"loadBalancerOriginHistory\""
+	LoadBalancerPool            LoadBalancerPool     "json:\"loadBalancerPool\" graphql:\"loadBalancerPool\""
+	LoadBalancerPoolHistory     LoadBalancerPool     "json:\"loadBalancerPoolHistory\" graphql:\"loadBalancerPoolHistory\""
+	LoadBalancerPort            LoadBalancerPort     "json:\"loadBalancerPort\" graphql:\"loadBalancerPort\""
+	LoadBalancerPortHistory     LoadBalancerPort     "json:\"loadBalancerPortHistory\" graphql:\"loadBalancerPortHistory\""
+	LoadBalancerProvider        LoadBalancerProvider "json:\"loadBalancerProvider\" graphql:\"loadBalancerProvider\""
+	LoadBalancerProviderHistory LoadBalancerProvider "json:\"loadBalancerProviderHistory\" graphql:\"loadBalancerProviderHistory\""
+	Entities                    []Entity             "json:\"_entities\" graphql:\"_entities\""
+	Service                     Service              "json:\"_service\" graphql:\"_service\""
 }
 type Mutation struct {
-	LoadBalancerOriginCreate   LoadBalancerOriginCreatePayload   "json:\"loadBalancerOriginCreate\" graphql:\"loadBalancerOriginCreate\""
-	LoadBalancerOriginUpdate   LoadBalancerOriginUpdatePayload   "json:\"loadBalancerOriginUpdate\" graphql:\"loadBalancerOriginUpdate\""
-	LoadBalancerOriginDelete   LoadBalancerOriginDeletePayload   "json:\"loadBalancerOriginDelete\" graphql:\"loadBalancerOriginDelete\""
-	LoadBalancerCreate         LoadBalancerCreatePayload         "json:\"loadBalancerCreate\" graphql:\"loadBalancerCreate\""
-	LoadBalancerUpdate         LoadBalancerUpdatePayload         "json:\"loadBalancerUpdate\" graphql:\"loadBalancerUpdate\""
-	LoadBalancerDelete         LoadBalancerDeletePayload         "json:\"loadBalancerDelete\" graphql:\"loadBalancerDelete\""
-	LoadBalancerPoolCreate     LoadBalancerPoolCreatePayload     "json:\"loadBalancerPoolCreate\" graphql:\"loadBalancerPoolCreate\""
-	LoadBalancerPoolUpdate     LoadBalancerPoolUpdatePayload     "json:\"loadBalancerPoolUpdate\" graphql:\"loadBalancerPoolUpdate\""
-	LoadBalancerPoolDelete     LoadBalancerPoolDeletePayload     "json:\"loadBalancerPoolDelete\" graphql:\"loadBalancerPoolDelete\""
-	LoadBalancerPortCreate     LoadBalancerPortCreatePayload     "json:\"loadBalancerPortCreate\" graphql:\"loadBalancerPortCreate\""
-	LoadBalancerPortUpdate     LoadBalancerPortUpdatePayload     "json:\"loadBalancerPortUpdate\" graphql:\"loadBalancerPortUpdate\""
-	LoadBalancerPortDelete     LoadBalancerPortDeletePayload     "json:\"loadBalancerPortDelete\" graphql:\"loadBalancerPortDelete\""
-	LoadBalancerProviderCreate LoadBalancerProviderCreatePayload "json:\"loadBalancerProviderCreate\" graphql:\"loadBalancerProviderCreate\""
-	LoadBalancerProviderUpdate LoadBalancerProviderUpdatePayload "json:\"loadBalancerProviderUpdate\" graphql:\"loadBalancerProviderUpdate\""
-	LoadBalancerProviderDelete LoadBalancerProviderDeletePayload "json:\"loadBalancerProviderDelete\" graphql:\"loadBalancerProviderDelete\""
+	LoadBalancerOriginCreate       LoadBalancerOriginCreatePayload   "json:\"loadBalancerOriginCreate\" graphql:\"loadBalancerOriginCreate\""
+	LoadBalancerOriginUpdate       LoadBalancerOriginUpdatePayload   "json:\"loadBalancerOriginUpdate\" graphql:\"loadBalancerOriginUpdate\""
+	LoadBalancerOriginDelete       LoadBalancerOriginDeletePayload   "json:\"loadBalancerOriginDelete\" graphql:\"loadBalancerOriginDelete\""
+	LoadBalancerApply              LoadBalancerApplyPayload          "json:\"loadBalancerApply\" graphql:\"loadBalancerApply\""
+	LoadBalancerCreate             LoadBalancerCreatePayload         "json:\"loadBalancerCreate\" graphql:\"loadBalancerCreate\""
+	LoadBalancerCreateWithChildren LoadBalancerCreatePayload         "json:\"loadBalancerCreateWithChildren\" graphql:\"loadBalancerCreateWithChildren\""
+	LoadBalancerUpdate             LoadBalancerUpdatePayload         "json:\"loadBalancerUpdate\" graphql:\"loadBalancerUpdate\""
+	LoadBalancerDelete             LoadBalancerDeletePayload         "json:\"loadBalancerDelete\" graphql:\"loadBalancerDelete\""
+	LoadBalancerPoolCreate         LoadBalancerPoolCreatePayload     "json:\"loadBalancerPoolCreate\" graphql:\"loadBalancerPoolCreate\""
+	LoadBalancerPoolUpdate         LoadBalancerPoolUpdatePayload     "json:\"loadBalancerPoolUpdate\" graphql:\"loadBalancerPoolUpdate\""
+	LoadBalancerPoolDelete         LoadBalancerPoolDeletePayload     "json:\"loadBalancerPoolDelete\" graphql:\"loadBalancerPoolDelete\""
+	LoadBalancerPortCreate         LoadBalancerPortCreatePayload     "json:\"loadBalancerPortCreate\" graphql:\"loadBalancerPortCreate\""
+	LoadBalancerPortUpdate         LoadBalancerPortUpdatePayload     "json:\"loadBalancerPortUpdate\" graphql:\"loadBalancerPortUpdate\""
+	LoadBalancerPortDelete         LoadBalancerPortDeletePayload     "json:\"loadBalancerPortDelete\" graphql:\"loadBalancerPortDelete\""
+	LoadBalancerProviderCreate     LoadBalancerProviderCreatePayload "json:\"loadBalancerProviderCreate\" graphql:\"loadBalancerProviderCreate\""
+	LoadBalancerProviderUpdate     LoadBalancerProviderUpdatePayload "json:\"loadBalancerProviderUpdate\" graphql:\"loadBalancerProviderUpdate\""
+	LoadBalancerProviderDelete     LoadBalancerProviderDeletePayload "json:\"loadBalancerProviderDelete\" graphql:\"loadBalancerProviderDelete\""
+	LoadBalancerResync             LoadBalancerResyncPayload         "json:\"loadBalancerResync\" graphql:\"loadBalancerResync\""
 }
 type GetLoadBalancer struct {
 	LoadBalancer struct {
@@ -87,6 +112,60 @@ type GetLoadBalancer struct {
 		UpdatedAt time.Time "json:\"updatedAt\" graphql:\"updatedAt\""
 	} "json:\"loadBalancer\" graphql:\"loadBalancer\""
 }
+type GetLoadBalancerAuditEvents struct {
+	LoadBalancer struct {
+		AuditEvents struct {
+			Edges []*struct {
+				Node *struct {
+					ID        gidx.PrefixedID "json:\"id\" graphql:\"id\""
+					CreatedAt time.Time       "json:\"createdAt\" graphql:\"createdAt\""
+					Actor     string          "json:\"actor\" graphql:\"actor\""
+					Operation string          "json:\"operation\" graphql:\"operation\""
+					SubjectID gidx.PrefixedID "json:\"subjectID\" graphql:\"subjectID\""
+					Changeset []*struct {
+						Field         string "json:\"field\" graphql:\"field\""
+						PreviousValue string "json:\"previousValue\" graphql:\"previousValue\""
+						CurrentValue  string "json:\"currentValue\" graphql:\"currentValue\""
+					} "json:\"changeset\" graphql:\"changeset\""
+				} "json:\"node\" graphql:\"node\""
+			} "json:\"edges\" graphql:\"edges\""
+		} "json:\"auditEvents\" graphql:\"auditEvents\""
+	} "json:\"loadBalancer\" graphql:\"loadBalancer\""
+}
+type GetLoadBalancerHistory struct {
+	LoadBalancerHistory struct {
+		ID        gidx.PrefixedID "json:\"id\" graphql:\"id\""
+		Name      string          "json:\"name\" graphql:\"name\""
+		CreatedAt time.Time       "json:\"createdAt\" graphql:\"createdAt\""
+		UpdatedAt time.Time       "json:\"updatedAt\" graphql:\"updatedAt\""
+		DeletedAt *time.Time      "json:\"deletedAt\" graphql:\"deletedAt\""
+	} "json:\"loadBalancerHistory\" graphql:\"loadBalancerHistory\""
+}
+type GetLoadBalancerOrigin struct {
+	LoadBalancerOrigin struct {
+		ID         gidx.PrefixedID "json:\"id\" graphql:\"id\""
+		Name       string          "json:\"name\" graphql:\"name\""
+		Target     string          "json:\"target\" graphql:\"target\""
+		PortNumber int64           "json:\"portNumber\" graphql:\"portNumber\""
+		Active     bool            "json:\"active\" graphql:\"active\""
+		Weight     int64           "json:\"weight\" graphql:\"weight\""
+		PoolID     gidx.PrefixedID "json:\"poolID\" graphql:\"poolID\""
+		CreatedAt  time.Time       "json:\"createdAt\" graphql:\"createdAt\""
+		UpdatedAt  time.Time       "json:\"updatedAt\" graphql:\"updatedAt\""
+	} "json:\"loadBalancerOrigin\" graphql:\"loadBalancerOrigin\""
+}
+type GetLoadBalancerOriginHistory struct {
+	LoadBalancerOriginHistory struct {
+		ID         gidx.PrefixedID "json:\"id\" graphql:\"id\""
+		Name       string          "json:\"name\" graphql:\"name\""
+		Target     string          "json:\"target\" graphql:\"target\""
+		PortNumber int64           "json:\"portNumber\" graphql:\"portNumber\""
+		PoolID     gidx.PrefixedID "json:\"poolID\" graphql:\"poolID\""
+		CreatedAt  time.Time       "json:\"createdAt\" graphql:\"createdAt\""
+		UpdatedAt  time.Time       "json:\"updatedAt\" graphql:\"updatedAt\""
+		DeletedAt  *time.Time      "json:\"deletedAt\" graphql:\"deletedAt\""
+	} "json:\"loadBalancerOriginHistory\" graphql:\"loadBalancerOriginHistory\""
+}
 type GetLoadBalancerPool struct {
 	LoadBalancerPool struct {
 		ID        gidx.PrefixedID          "json:\"id\" graphql:\"id\""
@@ -97,6 +176,37 @@ type GetLoadBalancerPool struct {
 		UpdatedAt time.Time                "json:\"updatedAt\" graphql:\"updatedAt\""
 	} "json:\"loadBalancerPool\" graphql:\"loadBalancerPool\""
 }
+type GetLoadBalancerPoolAuditEvents struct {
+	LoadBalancerPool struct {
+		AuditEvents struct {
+			Edges []*struct {
+				Node *struct {
+					ID        gidx.PrefixedID "json:\"id\" graphql:\"id\""
+					CreatedAt time.Time       "json:\"createdAt\" graphql:\"createdAt\""
+					Actor     string          "json:\"actor\" graphql:\"actor\""
+					Operation string          "json:\"operation\" graphql:\"operation\""
+					SubjectID gidx.PrefixedID "json:\"subjectID\" graphql:\"subjectID\""
+					Changeset []*struct {
+						Field         string "json:\"field\" graphql:\"field\""
+						PreviousValue string "json:\"previousValue\" graphql:\"previousValue\""
+						CurrentValue  string "json:\"currentValue\" graphql:\"currentValue\""
+					} "json:\"changeset\" graphql:\"changeset\""
+				} "json:\"node\" graphql:\"node\""
+			} "json:\"edges\" graphql:\"edges\""
+		} "json:\"auditEvents\" graphql:\"auditEvents\""
+	} "json:\"loadBalancerPool\" graphql:\"loadBalancerPool\""
+}
+type GetLoadBalancerPoolHistory struct {
+	LoadBalancerPoolHistory struct {
+		ID        gidx.PrefixedID          "json:\"id\" graphql:\"id\""
+		Name      string                   "json:\"name\" graphql:\"name\""
+		Protocol  LoadBalancerPoolProtocol "json:\"protocol\" graphql:\"protocol\""
+		OwnerID   gidx.PrefixedID          "json:\"ownerID\" graphql:\"ownerID\""
+		CreatedAt time.Time                "json:\"createdAt\" graphql:\"createdAt\""
+		UpdatedAt time.Time                "json:\"updatedAt\" graphql:\"updatedAt\""
+		DeletedAt *time.Time               "json:\"deletedAt\" graphql:\"deletedAt\""
+	} "json:\"loadBalancerPoolHistory\" graphql:\"loadBalancerPoolHistory\""
+}
 type GetLoadBalancerPoolOrigin struct {
 	LoadBalancerPool struct {
 		Origins struct {
@@ -116,6 +226,27 @@ type GetLoadBalancerPoolOrigin struct {
 		} "json:\"origins\" graphql:\"origins\""
 	} "json:\"loadBalancerPool\" graphql:\"loadBalancerPool\""
 }
+type GetLoadBalancerPoolOrigins struct {
+	LoadBalancerPool struct {
+		Origins struct {
+			PageInfo struct {
+				HasNextPage bool    "json:\"hasNextPage\" graphql:\"hasNextPage\""
+				EndCursor   *string "json:\"endCursor\" graphql:\"endCursor\""
+			} "json:\"pageInfo\" graphql:\"pageInfo\""
+			Edges []*struct {
+				Node *struct {
+					ID         gidx.PrefixedID "json:\"id\" graphql:\"id\""
+					Name       string          "json:\"name\" graphql:\"name\""
+					Target     string          "json:\"target\" graphql:\"target\""
+					PortNumber int64           "json:\"portNumber\" graphql:\"portNumber\""
+					Weight     int64           "json:\"weight\" graphql:\"weight\""
+					Active     bool            "json:\"active\" graphql:\"active\""
+					DeletedAt  *time.Time      "json:\"deletedAt\" graphql:\"deletedAt\""
+				} "json:\"node\" graphql:\"node\""
+			} "json:\"edges\" graphql:\"edges\""
+		} "json:\"origins\" graphql:\"origins\""
+	} "json:\"loadBalancerPool\" graphql:\"loadBalancerPool\""
+}
 type GetLoadBalancerPort struct {
 	LoadBalancerPort struct {
 		ID             gidx.PrefixedID "json:\"id\" graphql:\"id\""
@@ -129,6 +260,35 @@ type GetLoadBalancerPort struct {
 		UpdatedAt time.Time "json:\"updatedAt\" graphql:\"updatedAt\""
 	} "json:\"loadBalancerPort\" graphql:\"loadBalancerPort\""
 }
+type GetLoadBalancerPortHistory struct {
+	LoadBalancerPortHistory struct {
+		ID             gidx.PrefixedID "json:\"id\" graphql:\"id\""
+		Number         int64           "json:\"number\" graphql:\"number\""
+		Name           *string         "json:\"name\" graphql:\"name\""
+		LoadBalancerID gidx.PrefixedID "json:\"loadBalancerID\" graphql:\"loadBalancerID\""
+		CreatedAt      time.Time       "json:\"createdAt\" graphql:\"createdAt\""
+		UpdatedAt      time.Time       "json:\"updatedAt\" graphql:\"updatedAt\""
+		DeletedAt      *time.Time      "json:\"deletedAt\" graphql:\"deletedAt\""
+	} "json:\"loadBalancerPortHistory\" graphql:\"loadBalancerPortHistory\""
+}
+type GetLoadBalancerPorts struct {
+	LoadBalancer struct {
+		Ports struct {
+			PageInfo struct {
+				HasNextPage bool    "json:\"hasNextPage\" graphql:\"hasNextPage\""
+				EndCursor   *string "json:\"endCursor\" graphql:\"endCursor\""
+			} "json:\"pageInfo\" graphql:\"pageInfo\""
+			Edges []*struct {
+				Node *struct {
+					ID        gidx.PrefixedID "json:\"id\" graphql:\"id\""
+					Number    int64           "json:\"number\" graphql:\"number\""
+					Name      *string         "json:\"name\" graphql:\"name\""
+					DeletedAt *time.Time      "json:\"deletedAt\" graphql:\"deletedAt\""
+				} "json:\"node\" graphql:\"node\""
+			} "json:\"edges\" graphql:\"edges\""
+		} "json:\"ports\" graphql:\"ports\""
+	} "json:\"loadBalancer\" graphql:\"loadBalancer\""
+}
 type GetLoadBalancerProvider struct {
 	LoadBalancerProvider struct {
 		ID    gidx.PrefixedID "json:\"id\" graphql:\"id\""
@@ -140,9 +300,79 @@ type GetLoadBalancerProvider struct {
 		UpdatedAt time.Time "json:\"updatedAt\" graphql:\"updatedAt\""
 	} "json:\"loadBalancerProvider\" graphql:\"loadBalancerProvider\""
 }
+type GetLoadBalancerProviderAuditEvents struct {
+	LoadBalancerProvider struct {
+		AuditEvents struct {
+			Edges []*struct {
+				Node *struct {
+					ID        gidx.PrefixedID "json:\"id\" graphql:\"id\""
+					CreatedAt time.Time       "json:\"createdAt\" graphql:\"createdAt\""
+					Actor     string          "json:\"actor\" graphql:\"actor\""
+					Operation string          "json:\"operation\" graphql:\"operation\""
+					SubjectID gidx.PrefixedID "json:\"subjectID\" graphql:\"subjectID\""
+					Changeset []*struct {
+						Field         string "json:\"field\" graphql:\"field\""
+						PreviousValue string "json:\"previousValue\" graphql:\"previousValue\""
+						CurrentValue  string "json:\"currentValue\" graphql:\"currentValue\""
+					} "json:\"changeset\" graphql:\"changeset\""
+				} "json:\"node\" graphql:\"node\""
+			} "json:\"edges\" graphql:\"edges\""
+		} "json:\"auditEvents\" graphql:\"auditEvents\""
+	} "json:\"loadBalancerProvider\" graphql:\"loadBalancerProvider\""
+}
+type GetLoadBalancerProviderHistory struct {
+	LoadBalancerProviderHistory struct {
+		ID    gidx.PrefixedID "json:\"id\" graphql:\"id\""
+		Name  string          "json:\"name\" graphql:\"name\""
+		Owner struct {
+			ID gidx.PrefixedID "json:\"id\" graphql:\"id\""
+		} "json:\"owner\" graphql:\"owner\""
+		CreatedAt time.Time  "json:\"createdAt\" graphql:\"createdAt\""
+		UpdatedAt time.Time  "json:\"updatedAt\" graphql:\"updatedAt\""
+		DeletedAt *time.Time "json:\"deletedAt\" graphql:\"deletedAt\""
+	} "json:\"loadBalancerProviderHistory\" graphql:\"loadBalancerProviderHistory\""
+}
+type GetOwnerLoadBalancerPools struct {
+	Entities []*struct {
+		LoadBalancerPools struct {
+			PageInfo struct {
+				HasNextPage bool    "json:\"hasNextPage\" graphql:\"hasNextPage\""
+				EndCursor   *string "json:\"endCursor\" graphql:\"endCursor\""
+			} "json:\"pageInfo\" graphql:\"pageInfo\""
+			Edges []*struct {
+				Node *struct {
+					ID       gidx.PrefixedID          "json:\"id\" graphql:\"id\""
+					Name     string                   "json:\"name\" graphql:\"name\""
+					Protocol LoadBalancerPoolProtocol "json:\"protocol\" graphql:\"protocol\""
+					OwnerID  gidx.PrefixedID          "json:\"ownerID\" graphql:\"ownerID\""
+				} "json:\"node\" graphql:\"node\""
+			} "json:\"edges\" graphql:\"edges\""
+		} "json:\"loadBalancerPools\" graphql:\"loadBalancerPools\""
+	} "json:\"_entities\" graphql:\"_entities\""
+}
+type GetOwnerLoadBalancerProviders struct {
+	Entities []*struct {
+		LoadBalancersProviders struct {
+			PageInfo struct {
+				HasNextPage bool    "json:\"hasNextPage\" graphql:\"hasNextPage\""
+				EndCursor   *string "json:\"endCursor\" graphql:\"endCursor\""
+			} "json:\"pageInfo\" graphql:\"pageInfo\""
+			Edges []*struct {
+				Node *struct {
+					ID   gidx.PrefixedID "json:\"id\" graphql:\"id\""
+					Name string          "json:\"name\" graphql:\"name\""
+				} "json:\"node\" graphql:\"node\""
+			} "json:\"edges\" graphql:\"edges\""
+		} "json:\"loadBalancersProviders\" graphql:\"loadBalancersProviders\""
+	} "json:\"_entities\" graphql:\"_entities\""
+}
 type GetOwnerLoadBalancers struct {
 	Entities []*struct {
 		LoadBalancers struct {
+			PageInfo struct {
+				HasNextPage bool    "json:\"hasNextPage\" graphql:\"hasNextPage\""
+				EndCursor   *string "json:\"endCursor\" graphql:\"endCursor\""
+			} "json:\"pageInfo\" graphql:\"pageInfo\""
 			Edges []*struct {
 				Node *struct {
 					ID   gidx.PrefixedID "json:\"id\" graphql:\"id\""
@@ -169,6 +399,52 @@ type GetPortByLoadBalancer struct {
 		} "json:\"ports\" graphql:\"ports\""
 	} "json:\"loadBalancer\" graphql:\"loadBalancer\""
 }
+type LoadBalancerApply struct {
+	LoadBalancerApply struct {
+		DryRun       bool "json:\"dryRun\" graphql:\"dryRun\""
+		LoadBalancer *struct {
+			ID    gidx.PrefixedID "json:\"id\" graphql:\"id\""
+			Name  string          "json:\"name\" graphql:\"name\""
+			Ports struct {
+				Edges []*struct {
+					Node *struct {
+						ID     gidx.PrefixedID "json:\"id\" graphql:\"id\""
+						Number int64           "json:\"number\" graphql:\"number\""
+						Name   *string         "json:\"name\" graphql:\"name\""
+						Pools  []*struct {
+							ID       gidx.PrefixedID          "json:\"id\" graphql:\"id\""
+							Name     string                   "json:\"name\" graphql:\"name\""
+							Protocol LoadBalancerPoolProtocol "json:\"protocol\" graphql:\"protocol\""
+							Origins  struct {
+								Edges []*struct {
+									Node *struct {
+										ID         gidx.PrefixedID "json:\"id\" graphql:\"id\""
+										Name       string          "json:\"name\" graphql:\"name\""
+										Target     string          "json:\"target\" graphql:\"target\""
+										PortNumber int64           "json:\"portNumber\" graphql:\"portNumber\""
+										Weight     int64           "json:\"weight\" graphql:\"weight\""
+										Active     bool            "json:\"active\" graphql:\"active\""
+									} "json:\"node\" graphql:\"node\""
+								} "json:\"edges\" graphql:\"edges\""
+							} "json:\"origins\" graphql:\"origins\""
+						} "json:\"pools\" graphql:\"pools\""
+					} "json:\"node\" graphql:\"node\""
+				} "json:\"edges\" graphql:\"edges\""
+			} "json:\"ports\" graphql:\"ports\""
+		} "json:\"loadBalancer\" graphql:\"loadBalancer\""
+		Changes []*struct {
+			Action       LoadBalancerApplyAction "json:\"action\" graphql:\"action\""
+			ResourceType string                  "json:\"resourceType\" graphql:\"resourceType\""
+			ID           *gidx.PrefixedID        "json:\"id\" graphql:\"id\""
+			Key          string                  "json:\"key\" graphql:\"key\""
+			Fields       []*struct {
+				Field         string "json:\"field\" graphql:\"field\""
+				PreviousValue string "json:\"previousValue\" graphql:\"previousValue\""
+				CurrentValue  string "json:\"currentValue\" graphql:\"currentValue\""
+			} "json:\"fields\" graphql:\"fields\""
+		} "json:\"changes\" graphql:\"changes\""
+	} "json:\"loadBalancerApply\" graphql:\"loadBalancerApply\""
+}
 type LoadBalancerCreate struct {
 	LoadBalancerCreate struct {
 		LoadBalancer struct {
@@ -188,6 +464,41 @@ type LoadBalancerCreate struct {
 		} "json:\"loadBalancer\" graphql:\"loadBalancer\""
 	} "json:\"loadBalancerCreate\" graphql:\"loadBalancerCreate\""
 }
+type LoadBalancerCreateWithChildren struct {
+	LoadBalancerCreateWithChildren struct {
+		LoadBalancer struct {
+			ID    gidx.PrefixedID "json:\"id\" graphql:\"id\""
+			Name  string          "json:\"name\" graphql:\"name\""
+			Owner struct {
+				ID gidx.PrefixedID "json:\"id\" graphql:\"id\""
+			} "json:\"owner\" graphql:\"owner\""
+			Ports struct {
+				Edges []*struct {
+					Node *struct {
+						ID     gidx.PrefixedID "json:\"id\" graphql:\"id\""
+						Number int64           "json:\"number\" graphql:\"number\""
+						Pools  []*struct {
+							ID       gidx.PrefixedID          "json:\"id\" graphql:\"id\""
+							Name     string                   "json:\"name\" graphql:\"name\""
+							Protocol LoadBalancerPoolProtocol "json:\"protocol\" graphql:\"protocol\""
+							Origins  struct {
+								Edges []*struct {
+									Node *struct {
+										ID         gidx.PrefixedID "json:\"id\" graphql:\"id\""
+										Name       string          "json:\"name\" graphql:\"name\""
+										Target     string          "json:\"target\" graphql:\"target\""
+										PortNumber int64           "json:\"portNumber\" graphql:\"portNumber\""
+										Weight     int64           "json:\"weight\" graphql:\"weight\""
+									} "json:\"node\" graphql:\"node\""
+								} "json:\"edges\" graphql:\"edges\""
+							} "json:\"origins\" graphql:\"origins\""
+						} "json:\"pools\" graphql:\"pools\""
+					} "json:\"node\" graphql:\"node\""
+				} "json:\"edges\" graphql:\"edges\""
+			} "json:\"ports\" graphql:\"ports\""
+		} "json:\"loadBalancer\" graphql:\"loadBalancer\""
+	} "json:\"loadBalancerCreateWithChildren\" graphql:\"loadBalancerCreateWithChildren\""
+}
 type LoadBalancerDelete struct {
 	LoadBalancerDelete struct {
 		DeletedID gidx.PrefixedID "json:\"deletedID\" graphql:\"deletedID\""
@@ -217,6 +528,7 @@ type LoadBalancerOriginUpdate struct {
 	LoadBalancerOriginUpdate struct {
 		LoadBalancerOrigin struct {
 			ID         gidx.PrefixedID "json:\"id\" graphql:\"id\""
+			Version    int64           "json:\"version\" graphql:\"version\""
 			Active     bool            "json:\"active\" graphql:\"active\""
 			Name       string          "json:\"name\" graphql:\"name\""
 			PortNumber int64           "json:\"portNumber\" graphql:\"portNumber\""
@@ -249,6 +561,7 @@ type LoadBalancerPoolUpdate struct {
 	LoadBalancerPoolUpdate struct {
 		LoadBalancerPool struct {
 			ID        gidx.PrefixedID          "json:\"id\" graphql:\"id\""
+			Version   int64                    "json:\"version\" graphql:\"version\""
 			Name      string                   "json:\"name\" graphql:\"name\""
 			Protocol  LoadBalancerPoolProtocol "json:\"protocol\" graphql:\"protocol\""
 			OwnerID   gidx.PrefixedID          "json:\"ownerID\" graphql:\"ownerID\""
@@ -280,6 +593,7 @@ type LoadBalancerPortUpdate struct {
 	LoadBalancerPortUpdate struct {
 		LoadBalancerPort struct {
 			ID        gidx.PrefixedID "json:\"id\" graphql:\"id\""
+			Version   int64           "json:\"version\" graphql:\"version\""
 			Name      *string         "json:\"name\" graphql:\"name\""
 			Number    int64           "json:\"number\" graphql:\"number\""
 			CreatedAt time.Time       "json:\"createdAt\" graphql:\"createdAt\""
@@ -309,16 +623,24 @@ type LoadBalancerProviderUpdate struct {
 	LoadBalancerProviderUpdate struct {
 		LoadBalancerProvider struct {
 			ID        gidx.PrefixedID "json:\"id\" graphql:\"id\""
+			Version   int64           "json:\"version\" graphql:\"version\""
 			Name      string          "json:\"name\" graphql:\"name\""
 			CreatedAt time.Time       "json:\"createdAt\" graphql:\"createdAt\""
 			UpdatedAt time.Time       "json:\"updatedAt\" graphql:\"updatedAt\""
 		} "json:\"loadBalancerProvider\" graphql:\"loadBalancerProvider\""
 	} "json:\"loadBalancerProviderUpdate\" graphql:\"loadBalancerProviderUpdate\""
 }
+type LoadBalancerResync struct {
+	LoadBalancerResync struct {
+		Published int64            "json:\"published\" graphql:\"published\""
+		Cursor    *gidx.PrefixedID "json:\"cursor\" graphql:\"cursor\""
+	} "json:\"loadBalancerResync\" graphql:\"loadBalancerResync\""
+}
 type LoadBalancerUpdate struct {
 	LoadBalancerUpdate struct {
 		LoadBalancer struct {
 			ID        gidx.PrefixedID "json:\"id\" graphql:\"id\""
+			Version   int64           "json:\"version\" graphql:\"version\""
 			Name      string          "json:\"name\" graphql:\"name\""
 			CreatedAt time.Time       "json:\"createdAt\" graphql:\"createdAt\""
 			UpdatedAt time.Time       "json:\"updatedAt\" graphql:\"updatedAt\""
@@ -358,6 +680,121 @@ func (c *Client) GetLoadBalancer(ctx context.Context, id gidx.PrefixedID, httpRe
 	return &res, nil
 }
 
+const GetLoadBalancerAuditEventsDocument = `query GetLoadBalancerAuditEvents ($id: ID!, $filter: AuditEventFilter) {
+	loadBalancer(id: $id) {
+		auditEvents(filter: $filter) {
+			edges {
+				node {
+					id
+					createdAt
+					actor
+					operation
+					subjectID
+					changeset {
+						field
+						previousValue
+						currentValue
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+func (c *Client) GetLoadBalancerAuditEvents(ctx context.Context, id gidx.PrefixedID, filter *AuditEventFilter, httpRequestOptions ...client.HTTPRequestOption) (*GetLoadBalancerAuditEvents, error) {
+	vars := map[string]interface{}{
+		"id":     id,
+		"filter": filter,
+	}
+
+	var res GetLoadBalancerAuditEvents
+	if err := c.Client.Post(ctx, "GetLoadBalancerAuditEvents", GetLoadBalancerAuditEventsDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetLoadBalancerHistoryDocument = `query GetLoadBalancerHistory ($id: ID!) {
+	loadBalancerHistory(id: $id) {
+		id
+		name
+		createdAt
+		updatedAt
+		deletedAt
+	}
+}
+`
+
+func (c *Client) GetLoadBalancerHistory(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*GetLoadBalancerHistory, error) {
+	vars := map[string]interface{}{
+		"id": id,
+	}
+
+	var res GetLoadBalancerHistory
+	if err := c.Client.Post(ctx, "GetLoadBalancerHistory", GetLoadBalancerHistoryDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetLoadBalancerOriginDocument = `query GetLoadBalancerOrigin ($id: ID!) {
+	loadBalancerOrigin(id: $id) {
+		id
+		name
+		target
+		portNumber
+		active
+		weight
+		poolID
+		createdAt
+		updatedAt
+	}
+}
+`
+
+func (c *Client) GetLoadBalancerOrigin(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*GetLoadBalancerOrigin, error) {
+	vars := map[string]interface{}{
+		"id": id,
+	}
+
+	var res GetLoadBalancerOrigin
+	if err := c.Client.Post(ctx, "GetLoadBalancerOrigin", GetLoadBalancerOriginDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetLoadBalancerOriginHistoryDocument = `query GetLoadBalancerOriginHistory ($id: ID!) {
+	loadBalancerOriginHistory(id: $id) {
+		id
+		name
+		target
+		portNumber
+		poolID
+		createdAt
+		updatedAt
+		deletedAt
+	}
+}
+`
+
+func (c *Client) GetLoadBalancerOriginHistory(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*GetLoadBalancerOriginHistory, error) {
+	vars := map[string]interface{}{
+		"id": id,
+	}
+
+	var res GetLoadBalancerOriginHistory
+	if err := c.Client.Post(ctx, "GetLoadBalancerOriginHistory", GetLoadBalancerOriginHistoryDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const GetLoadBalancerPoolDocument = `query GetLoadBalancerPool ($id: ID!) {
 	loadBalancerPool(id: $id) {
 		id
@@ -383,6 +820,68 @@ func (c *Client) GetLoadBalancerPool(ctx context.Context, id gidx.PrefixedID, ht
 	return &res, nil
 }
 
+const GetLoadBalancerPoolAuditEventsDocument = `query GetLoadBalancerPoolAuditEvents ($id: ID!, $filter: AuditEventFilter) {
+	loadBalancerPool(id: $id) {
+		auditEvents(filter: $filter) {
+			edges {
+				node {
+					id
+					createdAt
+					actor
+					operation
+					subjectID
+					changeset {
+						field
+						previousValue
+						currentValue
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+func (c *Client) GetLoadBalancerPoolAuditEvents(ctx context.Context, id gidx.PrefixedID, filter *AuditEventFilter, httpRequestOptions ...client.HTTPRequestOption) (*GetLoadBalancerPoolAuditEvents, error) {
+	vars := map[string]interface{}{
+		"id":     id,
+		"filter": filter,
+	}
+
+	var res GetLoadBalancerPoolAuditEvents
+	if err := c.Client.Post(ctx, "GetLoadBalancerPoolAuditEvents", GetLoadBalancerPoolAuditEventsDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetLoadBalancerPoolHistoryDocument = `query GetLoadBalancerPoolHistory ($id: ID!) {
+	loadBalancerPoolHistory(id: $id) {
+		id
+		name
+		protocol
+		ownerID
+		createdAt
+		updatedAt
+		deletedAt
+	}
+}
+`
+
+func (c *Client) GetLoadBalancerPoolHistory(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*GetLoadBalancerPoolHistory, error) {
+	vars := map[string]interface{}{
+		"id": id,
+	}
+
+	var res GetLoadBalancerPoolHistory
+	if err := c.Client.Post(ctx, "GetLoadBalancerPoolHistory", GetLoadBalancerPoolHistoryDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const GetLoadBalancerPoolOriginDocument = `query GetLoadBalancerPoolOrigin ($id: ID!, $originid: ID!) {
 	loadBalancerPool(id: $id) {
 		origins(where: {id:$originid}) {
@@ -418,6 +917,44 @@ func (c *Client) GetLoadBalancerPoolOrigin(ctx context.Context, id gidx.Prefixed
 	return &res, nil
 }
 
+const GetLoadBalancerPoolOriginsDocument = `query GetLoadBalancerPoolOrigins ($id: ID!, $includeDeleted: Boolean, $after: Cursor) {
+	loadBalancerPool(id: $id) {
+		origins(includeDeleted: $includeDeleted, after: $after) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			edges {
+				node {
+					id
+					name
+					target
+					portNumber
+					weight
+					active
+					deletedAt
+				}
+			}
+		}
+	}
+}
+`
+
+func (c *Client) GetLoadBalancerPoolOrigins(ctx context.Context, id gidx.PrefixedID, includeDeleted *bool, after *string, httpRequestOptions ...client.HTTPRequestOption) (*GetLoadBalancerPoolOrigins, error) {
+	vars := map[string]interface{}{
+		"id":             id,
+		"includeDeleted": includeDeleted,
+		"after":          after,
+	}
+
+	var res GetLoadBalancerPoolOrigins
+	if err := c.Client.Post(ctx, "GetLoadBalancerPoolOrigins", GetLoadBalancerPoolOriginsDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const GetLoadBalancerPortDocument = `query GetLoadBalancerPort ($id: ID!) {
 	loadBalancerPort(id: $id) {
 		id
@@ -446,6 +983,67 @@ func (c *Client) GetLoadBalancerPort(ctx context.Context, id gidx.PrefixedID, ht
 	return &res, nil
 }
 
+const GetLoadBalancerPortHistoryDocument = `query GetLoadBalancerPortHistory ($id: ID!) {
+	loadBalancerPortHistory(id: $id) {
+		id
+		number
+		name
+		loadBalancerID
+		createdAt
+		updatedAt
+		deletedAt
+	}
+}
+`
+
+func (c *Client) GetLoadBalancerPortHistory(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*GetLoadBalancerPortHistory, error) {
+	vars := map[string]interface{}{
+		"id": id,
+	}
+
+	var res GetLoadBalancerPortHistory
+	if err := c.Client.Post(ctx, "GetLoadBalancerPortHistory", GetLoadBalancerPortHistoryDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetLoadBalancerPortsDocument = `query GetLoadBalancerPorts ($id: ID!, $includeDeleted: Boolean, $after: Cursor) {
+	loadBalancer(id: $id) {
+		ports(includeDeleted: $includeDeleted, after: $after) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			edges {
+				node {
+					id
+					number
+					name
+					deletedAt
+				}
+			}
+		}
+	}
+}
+`
+
+func (c *Client) GetLoadBalancerPorts(ctx context.Context, id gidx.PrefixedID, includeDeleted *bool, after *string, httpRequestOptions ...client.HTTPRequestOption) (*GetLoadBalancerPorts, error) {
+	vars := map[string]interface{}{
+		"id":             id,
+		"includeDeleted": includeDeleted,
+		"after":          after,
+	}
+
+	var res GetLoadBalancerPorts
+	if err := c.Client.Post(ctx, "GetLoadBalancerPorts", GetLoadBalancerPortsDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const GetLoadBalancerProviderDocument = `query GetLoadBalancerProvider ($id: ID!) {
 	loadBalancerProvider(id: $id) {
 		id
@@ -472,14 +1070,83 @@ func (c *Client) GetLoadBalancerProvider(ctx context.Context, id gidx.PrefixedID
 	return &res, nil
 }
 
-const GetOwnerLoadBalancersDocument = `query GetOwnerLoadBalancers ($id: ID!, $orderBy: LoadBalancerOrder) {
+const GetLoadBalancerProviderAuditEventsDocument = `query GetLoadBalancerProviderAuditEvents ($id: ID!, $filter: AuditEventFilter) {
+	loadBalancerProvider(id: $id) {
+		auditEvents(filter: $filter) {
+			edges {
+				node {
+					id
+					createdAt
+					actor
+					operation
+					subjectID
+					changeset {
+						field
+						previousValue
+						currentValue
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+func (c *Client) GetLoadBalancerProviderAuditEvents(ctx context.Context, id gidx.PrefixedID, filter *AuditEventFilter, httpRequestOptions ...client.HTTPRequestOption) (*GetLoadBalancerProviderAuditEvents, error) {
+	vars := map[string]interface{}{
+		"id":     id,
+		"filter": filter,
+	}
+
+	var res GetLoadBalancerProviderAuditEvents
+	if err := c.Client.Post(ctx, "GetLoadBalancerProviderAuditEvents", GetLoadBalancerProviderAuditEventsDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetLoadBalancerProviderHistoryDocument = `query GetLoadBalancerProviderHistory ($id: ID!) {
+	loadBalancerProviderHistory(id: $id) {
+		id
+		name
+		owner {
+			id
+		}
+		createdAt
+		updatedAt
+		deletedAt
+	}
+}
+`
+
+func (c *Client) GetLoadBalancerProviderHistory(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*GetLoadBalancerProviderHistory, error) {
+	vars := map[string]interface{}{
+		"id": id,
+	}
+
+	var res GetLoadBalancerProviderHistory
+	if err := c.Client.Post(ctx, "GetLoadBalancerProviderHistory", GetLoadBalancerProviderHistoryDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetOwnerLoadBalancerPoolsDocument = `query GetOwnerLoadBalancerPools ($id: ID!, $after: Cursor) {
 	_entities(representations: {__typename:"ResourceOwner",id:$id}) {
 		... on ResourceOwner {
-			loadBalancers(orderBy: $orderBy) {
+			loadBalancerPools(after: $after) {
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
 				edges {
 					node {
 						id
 						name
+						protocol
+						ownerID
 					}
 				}
 			}
@@ -488,10 +1155,81 @@ const GetOwnerLoadBalancersDocument = `query GetOwnerLoadBalancers ($id: ID!, $o
 }
 `
 
-func (c *Client) GetOwnerLoadBalancers(ctx context.Context, id gidx.PrefixedID, orderBy *LoadBalancerOrder, httpRequestOptions ...client.HTTPRequestOption) (*GetOwnerLoadBalancers, error) {
+func (c *Client) GetOwnerLoadBalancerPools(ctx context.Context, id gidx.PrefixedID, after *string, httpRequestOptions ...client.HTTPRequestOption) (*GetOwnerLoadBalancerPools, error) {
+	vars := map[string]interface{}{
+		"id":    id,
+		"after": after,
+	}
+
+	var res GetOwnerLoadBalancerPools
+	if err := c.Client.Post(ctx, "GetOwnerLoadBalancerPools", GetOwnerLoadBalancerPoolsDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetOwnerLoadBalancerProvidersDocument = `query GetOwnerLoadBalancerProviders ($id: ID!, $orderBy: LoadBalancerProviderOrder, $after: Cursor) {
+	_entities(representations: {__typename:"ResourceOwner",id:$id}) {
+		... on ResourceOwner {
+			loadBalancersProviders(orderBy: $orderBy, after: $after) {
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+				edges {
+					node {
+						id
+						name
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+func (c *Client) GetOwnerLoadBalancerProviders(ctx context.Context, id gidx.PrefixedID, orderBy *LoadBalancerProviderOrder, after *string, httpRequestOptions ...client.HTTPRequestOption) (*GetOwnerLoadBalancerProviders, error) {
 	vars := map[string]interface{}{
 		"id":      id,
 		"orderBy": orderBy,
+		"after":   after,
+	}
+
+	var res GetOwnerLoadBalancerProviders
+	if err := c.Client.Post(ctx, "GetOwnerLoadBalancerProviders", GetOwnerLoadBalancerProvidersDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetOwnerLoadBalancersDocument = `query GetOwnerLoadBalancers ($id: ID!, $orderBy: LoadBalancerOrder, $includeDeleted: Boolean, $after: Cursor) {
+	_entities(representations: {__typename:"ResourceOwner",id:$id}) {
+		... on ResourceOwner {
+			loadBalancers(orderBy: $orderBy, includeDeleted: $includeDeleted, after: $after) {
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+				edges {
+					node {
+						id
+						name
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+func (c *Client) GetOwnerLoadBalancers(ctx context.Context, id gidx.PrefixedID, orderBy *LoadBalancerOrder, includeDeleted *bool, after *string, httpRequestOptions ...client.HTTPRequestOption) (*GetOwnerLoadBalancers, error) {
+	vars := map[string]interface{}{
+		"id":             id,
+		"orderBy":        orderBy,
+		"includeDeleted": includeDeleted,
+		"after":          after,
 	}
 
 	var res GetOwnerLoadBalancers
@@ -535,6 +1273,68 @@ func (c *Client) GetPortByLoadBalancer(ctx context.Context, id gidx.PrefixedID,
 	return &res, nil
 }
 
+const LoadBalancerApplyDocument = `mutation LoadBalancerApply ($spec: JSON!, $dryRun: Boolean) {
+	loadBalancerApply(spec: $spec, dryRun: $dryRun) {
+		dryRun
+		loadBalancer {
+			id
+			name
+			ports(first: 100) {
+				edges {
+					node {
+						id
+						number
+						name
+						pools {
+							id
+							name
+							protocol
+							origins(first: 1000) {
+								edges {
+									node {
+										id
+										name
+										target
+										portNumber
+										weight
+										active
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+		changes {
+			action
+			resourceType
+			id
+			key
+			fields {
+				field
+				previousValue
+				currentValue
+			}
+		}
+	}
+}
+`
+
+func (c *Client) LoadBalancerApply(ctx context.Context, spec json.RawMessage, dryRun *bool, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerApply, error) {
+	vars := map[string]interface{}{
+		"spec":   spec,
+		"dryRun": dryRun,
+	}
+
+	var res LoadBalancerApply
+	if err := c.Client.Post(ctx, "LoadBalancerApply", LoadBalancerApplyDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const LoadBalancerCreateDocument = `mutation LoadBalancerCreate ($input: CreateLoadBalancerInput!) {
 	loadBalancerCreate(input: $input) {
 		loadBalancer {
@@ -569,16 +1369,67 @@ func (c *Client) LoadBalancerCreate(ctx context.Context, input CreateLoadBalance
 	return &res, nil
 }
 
-const LoadBalancerDeleteDocument = `mutation LoadBalancerDelete ($id: ID!) {
-	loadBalancerDelete(id: $id) {
+const LoadBalancerCreateWithChildrenDocument = `mutation LoadBalancerCreateWithChildren ($input: CreateLoadBalancerWithChildrenInput!) {
+	loadBalancerCreateWithChildren(input: $input) {
+		loadBalancer {
+			id
+			name
+			owner {
+				id
+			}
+			ports(first: 100) {
+				edges {
+					node {
+						id
+						number
+						pools {
+							id
+							name
+							protocol
+							origins(first: 1000) {
+								edges {
+									node {
+										id
+										name
+										target
+										portNumber
+										weight
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+func (c *Client) LoadBalancerCreateWithChildren(ctx context.Context, input CreateLoadBalancerWithChildrenInput, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerCreateWithChildren, error) {
+	vars := map[string]interface{}{
+		"input": input,
+	}
+
+	var res LoadBalancerCreateWithChildren
+	if err := c.Client.Post(ctx, "LoadBalancerCreateWithChildren", LoadBalancerCreateWithChildrenDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const LoadBalancerDeleteDocument = `mutation LoadBalancerDelete ($id: ID!, $expectedVersion: Int) {
+	loadBalancerDelete(id: $id, expectedVersion: $expectedVersion) {
 		deletedID
 	}
 }
 `
 
-func (c *Client) LoadBalancerDelete(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerDelete, error) {
+func (c *Client) LoadBalancerDelete(ctx context.Context, id gidx.PrefixedID, expectedVersion *int64, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerDelete, error) {
 	vars := map[string]interface{}{
-		"id": id,
+		"id":              id,
+		"expectedVersion": expectedVersion,
 	}
 
 	var res LoadBalancerDelete
@@ -619,16 +1470,17 @@ func (c *Client) LoadBalancerOriginCreate(ctx context.Context, input CreateLoadB
 	return &res, nil
 }
 
-const LoadBalancerOriginDeleteDocument = `mutation LoadBalancerOriginDelete ($id: ID!) {
-	loadBalancerOriginDelete(id: $id) {
+const LoadBalancerOriginDeleteDocument = `mutation LoadBalancerOriginDelete ($id: ID!, $expectedVersion: Int) {
+	loadBalancerOriginDelete(id: $id, expectedVersion: $expectedVersion) {
 		deletedID
 	}
 }
 `
 
-func (c *Client) LoadBalancerOriginDelete(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerOriginDelete, error) {
+func (c *Client) LoadBalancerOriginDelete(ctx context.Context, id gidx.PrefixedID, expectedVersion *int64, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerOriginDelete, error) {
 	vars := map[string]interface{}{
-		"id": id,
+		"id":              id,
+		"expectedVersion": expectedVersion,
 	}
 
 	var res LoadBalancerOriginDelete
@@ -639,10 +1491,11 @@ func (c *Client) LoadBalancerOriginDelete(ctx context.Context, id gidx.PrefixedI
 	return &res, nil
 }
 
-const LoadBalancerOriginUpdateDocument = `mutation LoadBalancerOriginUpdate ($id: ID!, $input: UpdateLoadBalancerOriginInput!) {
-	loadBalancerOriginUpdate(id: $id, input: $input) {
+const LoadBalancerOriginUpdateDocument = `mutation LoadBalancerOriginUpdate ($id: ID!, $input: UpdateLoadBalancerOriginInput!, $expectedVersion: Int) {
+	loadBalancerOriginUpdate(id: $id, input: $input, expectedVersion: $expectedVersion) {
 		loadBalancerOrigin {
 			id
+			version
 			active
 			name
 			portNumber
@@ -656,10 +1509,11 @@ const LoadBalancerOriginUpdateDocument = `mutation LoadBalancerOriginUpdate ($id
 }
 `
 
-func (c *Client) LoadBalancerOriginUpdate(ctx context.Context, id gidx.PrefixedID, input UpdateLoadBalancerOriginInput, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerOriginUpdate, error) {
+func (c *Client) LoadBalancerOriginUpdate(ctx context.Context, id gidx.PrefixedID, input UpdateLoadBalancerOriginInput, expectedVersion *int64, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerOriginUpdate, error) {
 	vars := map[string]interface{}{
-		"id":    id,
-		"input": input,
+		"id":              id,
+		"input":           input,
+		"expectedVersion": expectedVersion,
 	}
 
 	var res LoadBalancerOriginUpdate
@@ -697,16 +1551,17 @@ func (c *Client) LoadBalancerPoolCreate(ctx context.Context, input CreateLoadBal
 	return &res, nil
 }
 
-const LoadBalancerPoolDeleteDocument = `mutation LoadBalancerPoolDelete ($id: ID!) {
-	loadBalancerPoolDelete(id: $id) {
+const LoadBalancerPoolDeleteDocument = `mutation LoadBalancerPoolDelete ($id: ID!, $expectedVersion: Int) {
+	loadBalancerPoolDelete(id: $id, expectedVersion: $expectedVersion) {
 		deletedID
 	}
 }
 `
 
-func (c *Client) LoadBalancerPoolDelete(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerPoolDelete, error) {
+func (c *Client) LoadBalancerPoolDelete(ctx context.Context, id gidx.PrefixedID, expectedVersion *int64, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerPoolDelete, error) {
 	vars := map[string]interface{}{
-		"id": id,
+		"id":              id,
+		"expectedVersion": expectedVersion,
 	}
 
 	var res LoadBalancerPoolDelete
@@ -717,10 +1572,11 @@ func (c *Client) LoadBalancerPoolDelete(ctx context.Context, id gidx.PrefixedID,
 	return &res, nil
 }
 
-const LoadBalancerPoolUpdateDocument = `mutation LoadBalancerPoolUpdate ($id: ID!, $input: UpdateLoadBalancerPoolInput!) {
-	loadBalancerPoolUpdate(id: $id, input: $input) {
+const LoadBalancerPoolUpdateDocument = `mutation LoadBalancerPoolUpdate ($id: ID!, $input: UpdateLoadBalancerPoolInput!, $expectedVersion: Int) {
+	loadBalancerPoolUpdate(id: $id, input: $input, expectedVersion: $expectedVersion) {
 		loadBalancerPool {
 			id
+			version
 			name
 			protocol
 			ownerID
@@ -731,10 +1587,11 @@ const LoadBalancerPoolUpdateDocument = `mutation LoadBalancerPoolUpdate ($id: ID
 }
 `
 
-func (c *Client) LoadBalancerPoolUpdate(ctx context.Context, id gidx.PrefixedID, input UpdateLoadBalancerPoolInput, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerPoolUpdate, error) {
+func (c *Client) LoadBalancerPoolUpdate(ctx context.Context, id gidx.PrefixedID, input UpdateLoadBalancerPoolInput, expectedVersion *int64, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerPoolUpdate, error) {
 	vars := map[string]interface{}{
-		"id":    id,
-		"input": input,
+		"id":              id,
+		"input":           input,
+		"expectedVersion": expectedVersion,
 	}
 
 	var res LoadBalancerPoolUpdate
@@ -774,16 +1631,17 @@ func (c *Client) LoadBalancerPortCreate(ctx context.Context, input CreateLoadBal
 	return &res, nil
 }
 
-const LoadBalancerPortDeleteDocument = `mutation LoadBalancerPortDelete ($id: ID!) {
-	loadBalancerPortDelete(id: $id) {
+const LoadBalancerPortDeleteDocument = `mutation LoadBalancerPortDelete ($id: ID!, $expectedVersion: Int) {
+	loadBalancerPortDelete(id: $id, expectedVersion: $expectedVersion) {
 		deletedID
 	}
 }
 `
 
-func (c *Client) LoadBalancerPortDelete(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerPortDelete, error) {
+func (c *Client) LoadBalancerPortDelete(ctx context.Context, id gidx.PrefixedID, expectedVersion *int64, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerPortDelete, error) {
 	vars := map[string]interface{}{
-		"id": id,
+		"id":              id,
+		"expectedVersion": expectedVersion,
 	}
 
 	var res LoadBalancerPortDelete
@@ -794,10 +1652,11 @@ func (c *Client) LoadBalancerPortDelete(ctx context.Context, id gidx.PrefixedID,
 	return &res, nil
 }
 
-const LoadBalancerPortUpdateDocument = `mutation LoadBalancerPortUpdate ($id: ID!, $input: UpdateLoadBalancerPortInput!) {
-	loadBalancerPortUpdate(id: $id, input: $input) {
+const LoadBalancerPortUpdateDocument = `mutation LoadBalancerPortUpdate ($id: ID!, $input: UpdateLoadBalancerPortInput!, $expectedVersion: Int) {
+	loadBalancerPortUpdate(id: $id, input: $input, expectedVersion: $expectedVersion) {
 		loadBalancerPort {
 			id
+			version
 			name
 			number
 			createdAt
@@ -807,10 +1666,11 @@ const LoadBalancerPortUpdateDocument = `mutation LoadBalancerPortUpdate ($id: ID
 }
 `
 
-func (c *Client) LoadBalancerPortUpdate(ctx context.Context, id gidx.PrefixedID, input UpdateLoadBalancerPortInput, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerPortUpdate, error) {
+func (c *Client) LoadBalancerPortUpdate(ctx context.Context, id gidx.PrefixedID, input UpdateLoadBalancerPortInput, expectedVersion *int64, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerPortUpdate, error) {
 	vars := map[string]interface{}{
-		"id":    id,
-		"input": input,
+		"id":              id,
+		"input":           input,
+		"expectedVersion": expectedVersion,
 	}
 
 	var res LoadBalancerPortUpdate
@@ -849,16 +1709,17 @@ func (c *Client) LoadBalancerProviderCreate(ctx context.Context, input CreateLoa
 	return &res, nil
 }
 
-const LoadBalancerProviderDeleteDocument = `mutation LoadBalancerProviderDelete ($id: ID!) {
-	loadBalancerProviderDelete(id: $id) {
+const LoadBalancerProviderDeleteDocument = `mutation LoadBalancerProviderDelete ($id: ID!, $expectedVersion: Int) {
+	loadBalancerProviderDelete(id: $id, expectedVersion: $expectedVersion) {
 		deletedID
 	}
 }
 `
 
-func (c *Client) LoadBalancerProviderDelete(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerProviderDelete, error) {
+func (c *Client) LoadBalancerProviderDelete(ctx context.Context, id gidx.PrefixedID, expectedVersion *int64, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerProviderDelete, error) {
 	vars := map[string]interface{}{
-		"id": id,
+		"id":              id,
+		"expectedVersion": expectedVersion,
 	}
 
 	var res LoadBalancerProviderDelete
@@ -869,10 +1730,11 @@ func (c *Client) LoadBalancerProviderDelete(ctx context.Context, id gidx.Prefixe
 	return &res, nil
 }
 
-const LoadBalancerProviderUpdateDocument = `mutation LoadBalancerProviderUpdate ($id: ID!, $input: UpdateLoadBalancerProviderInput!) {
-	loadBalancerProviderUpdate(id: $id, input: $input) {
+const LoadBalancerProviderUpdateDocument = `mutation LoadBalancerProviderUpdate ($id: ID!, $input: UpdateLoadBalancerProviderInput!, $expectedVersion: Int) {
+	loadBalancerProviderUpdate(id: $id, input: $input, expectedVersion: $expectedVersion) {
 		loadBalancerProvider {
 			id
+			version
 			name
 			createdAt
 			updatedAt
@@ -881,10 +1743,11 @@ const LoadBalancerProviderUpdateDocument = `mutation LoadBalancerProviderUpdate
 }
 `
 
-func (c *Client) LoadBalancerProviderUpdate(ctx context.Context, id gidx.PrefixedID, input UpdateLoadBalancerProviderInput, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerProviderUpdate, error) {
+func (c *Client) LoadBalancerProviderUpdate(ctx context.Context, id gidx.PrefixedID, input UpdateLoadBalancerProviderInput, expectedVersion *int64, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerProviderUpdate, error) {
 	vars := map[string]interface{}{
-		"id":    id,
-		"input": input,
+		"id":              id,
+		"input":           input,
+		"expectedVersion": expectedVersion,
 	}
 
 	var res LoadBalancerProviderUpdate
@@ -895,10 +1758,32 @@ func (c *Client) LoadBalancerProviderUpdate(ctx context.Context, id gidx.Prefixe
 	return &res, nil
 }
 
-const LoadBalancerUpdateDocument = `mutation LoadBalancerUpdate ($id: ID!, $input: UpdateLoadBalancerInput!) {
-	loadBalancerUpdate(id: $id, input: $input) {
+const LoadBalancerResyncDocument = `mutation LoadBalancerResync ($input: LoadBalancerResyncInput!) {
+	loadBalancerResync(input: $input) {
+		published
+		cursor
+	}
+}
+`
+
+func (c *Client) LoadBalancerResync(ctx context.Context, input LoadBalancerResyncInput, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerResync, error) {
+	vars := map[string]interface{}{
+		"input": input,
+	}
+
+	var res LoadBalancerResync
+	if err := c.Client.Post(ctx, "LoadBalancerResync", LoadBalancerResyncDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const LoadBalancerUpdateDocument = `mutation LoadBalancerUpdate ($id: ID!, $input: UpdateLoadBalancerInput!, $expectedVersion: Int) {
+	loadBalancerUpdate(id: $id, input: $input, expectedVersion: $expectedVersion) {
 		loadBalancer {
 			id
+			version
 			name
 			createdAt
 			updatedAt
@@ -907,10 +1792,11 @@ const LoadBalancerUpdateDocument = `mutation LoadBalancerUpdate ($id: ID!, $inpu
 }
 `
 
-func (c *Client) LoadBalancerUpdate(ctx context.Context, id gidx.PrefixedID, input UpdateLoadBalancerInput, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerUpdate, error) {
+func (c *Client) LoadBalancerUpdate(ctx context.Context, id gidx.PrefixedID, input UpdateLoadBalancerInput, expectedVersion *int64, httpRequestOptions ...client.HTTPRequestOption) (*LoadBalancerUpdate, error) {
 	vars := map[string]interface{}{
-		"id":    id,
-		"input": input,
+		"id":              id,
+		"input":           input,
+		"expectedVersion": expectedVersion,
 	}
 
 	var res LoadBalancerUpdate
@@ -33,6 +33,108 @@ type Entity interface {
 	IsEntity()
 }
 
+type AuditEvent struct {
+	// The ID for the audit event.
+	ID gidx.PrefixedID `json:"id"`
+	// The time the change was made.
+	CreatedAt time.Time `json:"createdAt"`
+	// The actor that made the change.
+	Actor string `json:"actor"`
+	// The operation that was performed, one of create, update or delete.
+	Operation string `json:"operation"`
+	// The ID of the resource that was changed.
+	SubjectID gidx.PrefixedID `json:"subjectID"`
+	// The field changes, with their previous and current values.
+	Changeset []*AuditFieldChange `json:"changeset"`
+}
+
+func (AuditEvent) IsNode() {}
+
+// The id of the object.
+func (this AuditEvent) GetID() gidx.PrefixedID { return this.ID }
+
+// A connection to a list of items.
+type AuditEventConnection struct {
+	// A list of edges.
+	Edges []*AuditEventEdge `json:"edges,omitempty"`
+	// Information to aid in pagination.
+	PageInfo PageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int64 `json:"totalCount"`
+}
+
+// An edge in a connection.
+type AuditEventEdge struct {
+	// The item at the end of the edge.
+	Node *AuditEvent `json:"node,omitempty"`
+	// A cursor for use in pagination.
+	Cursor string `json:"cursor"`
+}
+
+// Filtering options for audit events.
+type AuditEventFilter struct {
+	// Only return events made by the given actor.
+	Actor *string `json:"actor,omitempty"`
+	// Only return events that changed the given field.
+	Field *string `json:"field,omitempty"`
+	// Only return events made at or after the given time.
+	Since *time.Time `json:"since,omitempty"`
+	// Only return events made before the given time.
+	Until *time.Time `json:"until,omitempty"`
+}
+
+// Ordering options for AuditEvent connections
+type AuditEventOrder struct {
+	// The ordering direction.
+	Direction OrderDirection `json:"direction"`
+	// The field by which to order AuditEvents.
+	Field AuditEventOrderField `json:"field"`
+}
+
+// A single field change recorded in an audit event.
+type AuditFieldChange struct {
+	// The name of the field that changed.
+	Field string `json:"field"`
+	// The value of the field before the change.
+	PreviousValue string `json:"previousValue"`
+	// The value of the field after the change.
+	CurrentValue string `json:"currentValue"`
+}
+
+// Input information to create an origin as part of loadBalancerCreateWithChildren.
+type CreateLoadBalancerChildOriginInput struct {
+	// The name of the origin.
+	Name string `json:"name"`
+	// The weight of the origin.
+	Weight *int64 `json:"weight,omitempty"`
+	// The target of the origin.
+	Target string `json:"target"`
+	// The port number of the origin.
+	PortNumber int64 `json:"portNumber"`
+	// Whether the origin is active.
+	Active *bool `json:"active,omitempty"`
+}
+
+// Input information to create a pool as part of loadBalancerCreateWithChildren.
+type CreateLoadBalancerChildPoolInput struct {
+	// The name of the pool.
+	Name string `json:"name"`
+	// The protocol for the pool.
+	Protocol LoadBalancerPoolProtocol `json:"protocol"`
+	// The origins to create in the pool.
+	Origins []*CreateLoadBalancerChildOriginInput `json:"origins,omitempty"`
+}
+
+// Input information to create a port as part of loadBalancerCreateWithChildren.
+type CreateLoadBalancerChildPortInput struct {
+	// The port number.
+	Number int64 `json:"number"`
+	// The name of the port.
+	Name *string `json:"name,omitempty"`
+	// The pools to create and assign to the port.
+	Pools []*CreateLoadBalancerChildPoolInput `json:"pools,omitempty"`
+}
+
 // Input information to create a load balancer.
 type CreateLoadBalancerInput struct {
 	// The name of the load balancer.
@@ -43,6 +145,8 @@ type CreateLoadBalancerInput struct {
 	LocationID gidx.PrefixedID   `json:"locationID"`
 	PortIDs    []gidx.PrefixedID `json:"portIDs,omitempty"`
 	ProviderID gidx.PrefixedID   `json:"providerID"`
+	// A client provided key that makes the create safe to retry. Repeating the request with the same key returns the originally created resource.
+	IdempotencyKey *string `json:"idempotencyKey,omitempty"`
 }
 
 // CreateLoadBalancerOriginInput is used for create LoadBalancerOrigin object.
@@ -54,6 +158,8 @@ type CreateLoadBalancerOriginInput struct {
 	PortNumber int64           `json:"portNumber"`
 	Active     *bool           `json:"active,omitempty"`
 	PoolID     gidx.PrefixedID `json:"poolID"`
+	// A client provided key that makes the create safe to retry. Repeating the request with the same key returns the originally created resource.
+	IdempotencyKey *string `json:"idempotencyKey,omitempty"`
 }
 
 // CreateLoadBalancerPoolInput is used for create LoadBalancerPool object.
@@ -64,6 +170,8 @@ type CreateLoadBalancerPoolInput struct {
 	OwnerID   gidx.PrefixedID          `json:"ownerID"`
 	PortIDs   []gidx.PrefixedID        `json:"portIDs,omitempty"`
 	OriginIDs []gidx.PrefixedID        `json:"originIDs,omitempty"`
+	// A client provided key that makes the create safe to retry. Repeating the request with the same key returns the originally created resource.
+	IdempotencyKey *string `json:"idempotencyKey,omitempty"`
 }
 
 // CreateLoadBalancerPortInput is used for create LoadBalancerPort object.
@@ -73,6 +181,8 @@ type CreateLoadBalancerPortInput struct {
 	Name           *string           `json:"name,omitempty"`
 	PoolIDs        []gidx.PrefixedID `json:"poolIDs,omitempty"`
 	LoadBalancerID gidx.PrefixedID   `json:"loadBalancerID"`
+	// A client provided key that makes the create safe to retry. Repeating the request with the same key returns the originally created resource.
+	IdempotencyKey *string `json:"idempotencyKey,omitempty"`
 }
 
 // Input information to create a load balancer provider.
@@ -81,6 +191,24 @@ type CreateLoadBalancerProviderInput struct {
 	Name string `json:"name"`
 	// The ID for the owner for this load balancer.
 	OwnerID gidx.PrefixedID `json:"ownerID"`
+	// A client provided key that makes the create safe to retry. Repeating the request with the same key returns the originally created resource.
+	IdempotencyKey *string `json:"idempotencyKey,omitempty"`
+}
+
+// Input information to create a load balancer along with its ports, pools and origins.
+type CreateLoadBalancerWithChildrenInput struct {
+	// The name of the load balancer.
+	Name string `json:"name"`
+	// The ID for the owner for this load balancer and its pools.
+	OwnerID gidx.PrefixedID `json:"ownerID"`
+	// The ID for the location of this load balancer.
+	LocationID gidx.PrefixedID `json:"locationID"`
+	// The ID for the provider of this load balancer.
+	ProviderID gidx.PrefixedID `json:"providerID"`
+	// The ports to create on the load balancer.
+	Ports []*CreateLoadBalancerChildPortInput `json:"ports,omitempty"`
+	// A client provided key that makes the create safe to retry. Repeating the request with the same key returns the originally created resource.
+	IdempotencyKey *string `json:"idempotencyKey,omitempty"`
 }
 
 type LoadBalancer struct {
@@ -90,6 +218,7 @@ type LoadBalancer struct {
 	UpdatedAt time.Time       `json:"updatedAt"`
 	CreatedBy *string         `json:"createdBy,omitempty"`
 	UpdatedBy *string         `json:"updatedBy,omitempty"`
+	Version   int64           `json:"version"`
 	DeletedAt *time.Time      `json:"deletedAt,omitempty"`
 	DeletedBy *string         `json:"deletedBy,omitempty"`
 	// The name of the load balancer.
@@ -97,6 +226,8 @@ type LoadBalancer struct {
 	Ports LoadBalancerPortConnection `json:"ports"`
 	// The load balancer provider for the load balancer.
 	LoadBalancerProvider LoadBalancerProvider `json:"loadBalancerProvider"`
+	// The audit events for the load balancer and its ports.
+	AuditEvents AuditEventConnection `json:"auditEvents"`
 	// The location of the load balancer.
 	Location Location `json:"location"`
 	// The owner of the load balancer.
@@ -114,6 +245,45 @@ func (LoadBalancer) IsNode() {}
 
 func (LoadBalancer) IsEntity() {}
 
+// A single change made, or planned, when applying a load balancer spec.
+type LoadBalancerApplyChange struct {
+	// The type of change.
+	Action LoadBalancerApplyAction `json:"action"`
+	// The type of resource being changed, one of load-balancer, load-balancer-port, load-balancer-pool or load-balancer-origin.
+	ResourceType string `json:"resourceType"`
+	// The ID of the existing resource. Not set for resources that are created.
+	ID *gidx.PrefixedID `json:"id,omitempty"`
+	// The key used to match the resource to the spec: the load balancer name, the port number, the pool name or
+	// the pool and origin names separated by a slash.
+	Key string `json:"key"`
+	// The fields that are changed.
+	Fields []*AuditFieldChange `json:"fields"`
+}
+
+// Return response from loadBalancerApply
+type LoadBalancerApplyPayload struct {
+	// The load balancer. Not set on a dry run of a load balancer that does not exist yet.
+	LoadBalancer *LoadBalancer `json:"loadBalancer,omitempty"`
+	// Whether the changes were only planned.
+	DryRun bool `json:"dryRun"`
+	// The changes made, or planned on a dry run, in the order they are applied.
+	Changes []*LoadBalancerApplyChange `json:"changes"`
+}
+
+// A change to a load balancer or one of its ports, pools or origins.
+type LoadBalancerChange struct {
+	// The type of the change: create, update or delete.
+	EventType string `json:"eventType"`
+	// The ID of the changed resource, the load balancer or one of its ports, pools or origins.
+	SubjectID gidx.PrefixedID `json:"subjectID"`
+	// The ID of the load balancer the change applies to.
+	LoadBalancerID gidx.PrefixedID `json:"loadBalancerID"`
+	// The load balancer after the change, empty once it has been deleted.
+	LoadBalancer *LoadBalancer `json:"loadBalancer,omitempty"`
+	// The time the change was made.
+	Timestamp time.Time `json:"timestamp"`
+}
+
 // A connection to a list of items.
 type LoadBalancerConnection struct {
 	// A list of edges.
@@ -160,6 +330,7 @@ type LoadBalancerOrigin struct {
 	DeletedBy  *string          `json:"deletedBy,omitempty"`
 	CreatedBy  *string          `json:"createdBy,omitempty"`
 	UpdatedBy  *string          `json:"updatedBy,omitempty"`
+	Version    int64            `json:"version"`
 	Name       string           `json:"name"`
 	Weight     int64            `json:"weight"`
 	Target     string           `json:"target"`
@@ -372,6 +543,7 @@ type LoadBalancerPool struct {
 	UpdatedAt time.Time                    `json:"updatedAt"`
 	CreatedBy *string                      `json:"createdBy,omitempty"`
 	UpdatedBy *string                      `json:"updatedBy,omitempty"`
+	Version   int64                        `json:"version"`
 	DeletedAt *time.Time                   `json:"deletedAt,omitempty"`
 	DeletedBy *string                      `json:"deletedBy,omitempty"`
 	Name      string                       `json:"name"`
@@ -379,6 +551,8 @@ type LoadBalancerPool struct {
 	OwnerID   gidx.PrefixedID              `json:"ownerID"`
 	Ports     []*LoadBalancerPort          `json:"ports,omitempty"`
 	Origins   LoadBalancerOriginConnection `json:"origins"`
+	// The audit events for the load balancer pool and its origins.
+	AuditEvents AuditEventConnection `json:"auditEvents"`
 	// The owner of the load balancer pool.
 	Owner ResourceOwner `json:"owner"`
 }
@@ -561,6 +735,7 @@ type LoadBalancerPort struct {
 	DeletedBy      *string             `json:"deletedBy,omitempty"`
 	CreatedBy      *string             `json:"createdBy,omitempty"`
 	UpdatedBy      *string             `json:"updatedBy,omitempty"`
+	Version        int64               `json:"version"`
 	Number         int64               `json:"number"`
 	Name           *string             `json:"name,omitempty"`
 	LoadBalancerID gidx.PrefixedID     `json:"loadBalancerID"`
@@ -753,9 +928,12 @@ type LoadBalancerProvider struct {
 	DeletedBy *string         `json:"deletedBy,omitempty"`
 	CreatedBy *string         `json:"createdBy,omitempty"`
 	UpdatedBy *string         `json:"updatedBy,omitempty"`
+	Version   int64           `json:"version"`
 	// The name of the load balancer provider.
 	Name          string                 `json:"name"`
 	LoadBalancers LoadBalancerConnection `json:"loadBalancers"`
+	// The audit events for the load balancer provider and the load balancers of owners the caller may read the history of.
+	AuditEvents AuditEventConnection `json:"auditEvents"`
 	// The owner of the load balancer provider.
 	Owner ResourceOwner `json:"owner"`
 }
@@ -922,6 +1100,28 @@ type LoadBalancerProviderWhereInput struct {
 	HasLoadBalancersWith []*LoadBalancerWhereInput `json:"hasLoadBalancersWith,omitempty"`
 }
 
+// Input information to resync load balancer resources.
+type LoadBalancerResyncInput struct {
+	// Only resync the resources of this owner.
+	OwnerID *gidx.PrefixedID `json:"ownerID,omitempty"`
+	// Only resync the resources in this location.
+	LocationID *gidx.PrefixedID `json:"locationID,omitempty"`
+	// Only resync the resources of this provider.
+	ProviderID *gidx.PrefixedID `json:"providerID,omitempty"`
+	// The cursor returned by a previous resync to continue after.
+	After *gidx.PrefixedID `json:"after,omitempty"`
+	// The maximum number of messages to publish. Defaults to 100 and is capped at 1000.
+	Limit *int64 `json:"limit,omitempty"`
+}
+
+// Return response from loadBalancerResync
+type LoadBalancerResyncPayload struct {
+	// The number of messages published.
+	Published int64 `json:"published"`
+	// The cursor to continue the resync from. Not set once all resources have been published.
+	Cursor *gidx.PrefixedID `json:"cursor,omitempty"`
+}
+
 // Return response from loadBalancerUpdate
 type LoadBalancerUpdatePayload struct {
 	// The updated load balancer.
@@ -1124,6 +1324,92 @@ type Service struct {
 	Sdl *string `json:"sdl,omitempty"`
 }
 
+// Properties by which AuditEvent connections can be ordered.
+type AuditEventOrderField string
+
+const (
+	AuditEventOrderFieldCreatedAt AuditEventOrderField = "CREATED_AT"
+	AuditEventOrderFieldActor     AuditEventOrderField = "ACTOR"
+)
+
+var AllAuditEventOrderField = []AuditEventOrderField{
+	AuditEventOrderFieldCreatedAt,
+	AuditEventOrderFieldActor,
+}
+
+func (e AuditEventOrderField) IsValid() bool {
+	switch e {
+	case AuditEventOrderFieldCreatedAt, AuditEventOrderFieldActor:
+		return true
+	}
+	return false
+}
+
+func (e AuditEventOrderField) String() string {
+	return string(e)
+}
+
+func (e *AuditEventOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AuditEventOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AuditEventOrderField", str)
+	}
+	return nil
+}
+
+func (e AuditEventOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// The type of change made to a resource when applying a load balancer spec.
+type LoadBalancerApplyAction string
+
+const (
+	LoadBalancerApplyActionCreate LoadBalancerApplyAction = "CREATE"
+	LoadBalancerApplyActionUpdate LoadBalancerApplyAction = "UPDATE"
+	LoadBalancerApplyActionDelete LoadBalancerApplyAction = "DELETE"
+)
+
+var AllLoadBalancerApplyAction = []LoadBalancerApplyAction{
+	LoadBalancerApplyActionCreate,
+	LoadBalancerApplyActionUpdate,
+	LoadBalancerApplyActionDelete,
+}
+
+func (e LoadBalancerApplyAction) IsValid() bool {
+	switch e {
+	case LoadBalancerApplyActionCreate, LoadBalancerApplyActionUpdate, LoadBalancerApplyActionDelete:
+		return true
+	}
+	return false
+}
+
+func (e LoadBalancerApplyAction) String() string {
+	return string(e)
+}
+
+func (e *LoadBalancerApplyAction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LoadBalancerApplyAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LoadBalancerApplyAction", str)
+	}
+	return nil
+}
+
+func (e LoadBalancerApplyAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // Properties by which LoadBalancer connections can be ordered.
 type LoadBalancerOrderField string
 
@@ -0,0 +1,193 @@
+// Package outbox stores committed changes that could not be published and publishes them once the
+// message bus can be reached again
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.infratographer.com/x/events"
+	"go.uber.org/zap"
+
+	ent "go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/eventhooks"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/pendingchange"
+)
+
+const (
+	// DefaultInterval is how often publishing the pending changes is attempted
+	DefaultInterval = 10 * time.Second
+	// DefaultBatchSize is the number of pending changes loaded per query
+	DefaultBatchSize = 100
+	// DefaultLease is how long a change is claimed for while it is published, a change claimed by
+	// a replica that stopped is published again once the lease expired
+	DefaultLease = time.Minute
+)
+
+// Outbox stores the committed changes the event hooks could not publish and publishes them later
+type Outbox struct {
+	client    *ent.Client
+	publisher events.Publisher
+	logger    *zap.SugaredLogger
+	interval  time.Duration
+	batchSize int
+	lease     time.Duration
+}
+
+var _ eventhooks.Outbox = (*Outbox)(nil)
+
+// Option is a functional configuration option for the Outbox
+type Option func(o *Outbox)
+
+// WithLogger sets the logger for the outbox
+func WithLogger(l *zap.SugaredLogger) Option {
+	return func(o *Outbox) {
+		o.logger = l
+	}
+}
+
+// WithInterval sets how often publishing the pending changes is attempted
+func WithInterval(d time.Duration) Option {
+	return func(o *Outbox) {
+		if d > 0 {
+			o.interval = d
+		}
+	}
+}
+
+// WithBatchSize sets the number of pending changes loaded per query
+func WithBatchSize(n int) Option {
+	return func(o *Outbox) {
+		if n > 0 {
+			o.batchSize = n
+		}
+	}
+}
+
+// WithLease sets how long a change is claimed for while it is published
+func WithLease(d time.Duration) Option {
+	return func(o *Outbox) {
+		if d > 0 {
+			o.lease = d
+		}
+	}
+}
+
+// New returns a new Outbox storing changes with the client and publishing them with the publisher
+func New(client *ent.Client, publisher events.Publisher, opts ...Option) *Outbox {
+	o := &Outbox{
+		client:    client,
+		publisher: publisher,
+		logger:    zap.NewNop().Sugar(),
+		interval:  DefaultInterval,
+		batchSize: DefaultBatchSize,
+		lease:     DefaultLease,
+	}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+// Add implements eventhooks.Outbox
+func (o *Outbox) Add(ctx context.Context, subject string, msg events.ChangeMessage) error {
+	return o.client.PendingChange.Create().
+		SetSubject(subject).
+		SetMessage(msg).
+		Exec(ctx)
+}
+
+// Run publishes the pending changes every interval until the context is canceled
+func (o *Outbox) Run(ctx context.Context) {
+	ticker := time.NewTicker(o.interval)
+	defer ticker.Stop()
+
+	for {
+		n, err := o.Publish(ctx)
+		if err != nil && ctx.Err() == nil {
+			o.logger.Errorw("failed to publish pending changes", "error", err, "published", n)
+		} else if n != 0 {
+			o.logger.Infow("published pending changes", "published", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Publish publishes the pending changes, oldest first, and returns the number of changes
+// published. It stops at the first change that can't be published, so the remaining changes keep
+// their order. Each change is claimed before it is published, so replicas publishing at the same
+// time don't publish it twice.
+func (o *Outbox) Publish(ctx context.Context) (int, error) {
+	published := 0
+
+	for {
+		changes, err := o.client.PendingChange.Query().
+			Where(pendingchange.NextAttemptAtLTE(time.Now())).
+			Order(ent.Asc(pendingchange.FieldCreatedAt), ent.Asc(pendingchange.FieldID)).
+			Limit(o.batchSize).
+			All(ctx)
+		if err != nil {
+			return published, err
+		}
+
+		for _, c := range changes {
+			claimed, err := o.claim(ctx, c)
+			if err != nil {
+				return published, err
+			}
+
+			// another replica is publishing the change
+			if !claimed {
+				continue
+			}
+
+			if _, err := o.publisher.PublishChange(ctx, c.Subject, c.Message); err != nil {
+				// release the claim so the change is the first to be published next time
+				if uerr := o.client.PendingChange.UpdateOneID(c.ID).
+					SetLastError(err.Error()).
+					SetNextAttemptAt(time.Now()).
+					Exec(context.WithoutCancel(ctx)); uerr != nil {
+					o.logger.Errorw("failed to release pending change", "error", uerr, "pendingChangeID", c.ID)
+				}
+
+				return published, fmt.Errorf("failed to publish pending change %s: %w", c.ID, err)
+			}
+
+			if err := o.client.PendingChange.DeleteOneID(c.ID).Exec(context.WithoutCancel(ctx)); err != nil {
+				return published, err
+			}
+
+			published++
+		}
+
+		if len(changes) < o.batchSize {
+			return published, nil
+		}
+	}
+}
+
+// claim takes the change for the lease period, it returns false when another replica claimed it
+// since it was loaded
+func (o *Outbox) claim(ctx context.Context, c *ent.PendingChange) (bool, error) {
+	n, err := o.client.PendingChange.Update().
+		Where(
+			pendingchange.ID(c.ID),
+			pendingchange.NextAttemptAt(c.NextAttemptAt),
+		).
+		SetNextAttemptAt(time.Now().Add(o.lease)).
+		AddAttempts(1).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return n == 1, nil
+}
@@ -0,0 +1,186 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.infratographer.com/x/events"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/pendingchange"
+	"go.infratographer.com/load-balancer-api/internal/outbox"
+	"go.infratographer.com/load-balancer-api/internal/testutils"
+)
+
+var errPublish = errors.New("publish failed")
+
+func TestMain(m *testing.M) {
+	// setup the database
+	testutils.SetupDB()
+
+	// run the tests
+	code := m.Run()
+
+	// teardown the database
+	testutils.TeardownDB()
+
+	// return the test response code
+	os.Exit(code)
+}
+
+type published struct {
+	subject string
+	msg     events.ChangeMessage
+}
+
+// recorder is a publisher recording the published change messages, it fails once failAfter
+// messages have been published when set
+type recorder struct {
+	mu        sync.Mutex
+	changes   []published
+	failAfter int
+}
+
+func (r *recorder) PublishChange(_ context.Context, subject string, msg events.ChangeMessage) (events.Message[events.ChangeMessage], error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.failAfter > 0 && len(r.changes) == r.failAfter {
+		return nil, errPublish
+	}
+
+	r.changes = append(r.changes, published{subject: subject, msg: msg})
+
+	return nil, nil
+}
+
+func (r *recorder) PublishEvent(context.Context, string, events.EventMessage) (events.Message[events.EventMessage], error) {
+	return nil, nil
+}
+
+func changeMessage(eventType string) events.ChangeMessage {
+	return events.ChangeMessage{
+		EventType: eventType,
+		SubjectID: gidx.MustNewID("loadbal"),
+		Timestamp: time.Now().UTC().Truncate(time.Millisecond),
+	}
+}
+
+func cleanup(t *testing.T) {
+	t.Cleanup(func() {
+		testutils.EntClient.PendingChange.Delete().ExecX(context.Background())
+	})
+}
+
+func TestPublish(t *testing.T) {
+	ctx := context.Background()
+
+	cleanup(t)
+
+	pub := &recorder{}
+	ob := outbox.New(testutils.EntClient, pub, outbox.WithBatchSize(2))
+
+	msgs := []events.ChangeMessage{
+		changeMessage(string(events.CreateChangeType)),
+		changeMessage(string(events.UpdateChangeType)),
+		changeMessage(string(events.DeleteChangeType)),
+	}
+
+	for _, msg := range msgs {
+		require.NoError(t, ob.Add(ctx, "load-balancer", msg))
+	}
+
+	n, err := ob.Publish(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, n)
+	require.Len(t, pub.changes, 3)
+
+	for i, msg := range msgs {
+		assert.Equal(t, "load-balancer", pub.changes[i].subject)
+		assert.Equal(t, msg.SubjectID, pub.changes[i].msg.SubjectID, "changes are published in the order they were added")
+		assert.Equal(t, msg.EventType, pub.changes[i].msg.EventType)
+	}
+
+	assert.Zero(t, testutils.EntClient.PendingChange.Query().CountX(ctx), "published changes are removed")
+}
+
+func TestPublishFailure(t *testing.T) {
+	ctx := context.Background()
+
+	cleanup(t)
+
+	pub := &recorder{failAfter: 1}
+	ob := outbox.New(testutils.EntClient, pub)
+
+	first := changeMessage(string(events.CreateChangeType))
+	second := changeMessage(string(events.UpdateChangeType))
+
+	require.NoError(t, ob.Add(ctx, "load-balancer", first))
+	require.NoError(t, ob.Add(ctx, "load-balancer", second))
+
+	n, err := ob.Publish(ctx)
+	assert.ErrorIs(t, err, errPublish)
+	assert.Equal(t, 1, n)
+
+	pending := testutils.EntClient.PendingChange.Query().AllX(ctx)
+	require.Len(t, pending, 1)
+
+	assert.Equal(t, second.SubjectID, pending[0].Message.SubjectID)
+	assert.Equal(t, 1, pending[0].Attempts)
+	assert.Contains(t, pending[0].LastError, errPublish.Error())
+	assert.False(t, pending[0].NextAttemptAt.After(time.Now()), "the change is retried on the next run")
+
+	// the change is published once the publisher recovers
+	pub.failAfter = 0
+
+	n, err = ob.Publish(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, n)
+	assert.Equal(t, second.SubjectID, pub.changes[1].msg.SubjectID)
+	assert.Zero(t, testutils.EntClient.PendingChange.Query().CountX(ctx))
+}
+
+func TestPublishSkipsClaimedChanges(t *testing.T) {
+	ctx := context.Background()
+
+	cleanup(t)
+
+	pub := &recorder{}
+	ob := outbox.New(testutils.EntClient, pub)
+
+	msg := changeMessage(string(events.CreateChangeType))
+
+	require.NoError(t, ob.Add(ctx, "load-balancer", msg))
+
+	// another replica claimed the change
+	testutils.EntClient.PendingChange.Update().
+		SetNextAttemptAt(time.Now().Add(time.Minute)).
+		ExecX(ctx)
+
+	n, err := ob.Publish(ctx)
+	require.NoError(t, err)
+
+	assert.Zero(t, n)
+	assert.Empty(t, pub.changes)
+
+	// the replica stopped before publishing the change, it is published once the claim expired
+	testutils.EntClient.PendingChange.Update().
+		Where(pendingchange.SubjectEQ("load-balancer")).
+		SetNextAttemptAt(time.Now().Add(-time.Second)).
+		ExecX(ctx)
+
+	n, err = ob.Publish(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, n)
+	require.Len(t, pub.changes, 1)
+	assert.Equal(t, msg.SubjectID, pub.changes[0].msg.SubjectID)
+}
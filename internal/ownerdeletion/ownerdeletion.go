@@ -0,0 +1,332 @@
+// Package ownerdeletion removes the resources of owners that were deleted upstream
+package ownerdeletion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	metacli "go.infratographer.com/metadata-api/pkg/client"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/x/events"
+	"go.infratographer.com/x/gidx"
+	"go.uber.org/zap"
+
+	"go.infratographer.com/load-balancer-api/internal/config"
+	ent "go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/loadbalancer"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/origin"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/ownerdeletion"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/pool"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/port"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/provider"
+	_ "go.infratographer.com/load-balancer-api/internal/ent/generated/runtime" // imports the generated runtime package to register the soft delete hooks and interceptors
+	metastatus "go.infratographer.com/load-balancer-api/pkg/metadata"
+)
+
+const metadataStatusSource = "load-balancer-api"
+
+// Metadata updates the status of load balancers in the metadata service
+type Metadata interface {
+	StatusUpdate(ctx context.Context, input *metacli.StatusUpdateInput) (*metacli.StatusUpdate, error)
+}
+
+// Cleaner soft deletes the load balancers, pools and providers of deleted owners. Every deletion
+// is recorded before the first resource is removed, so it can be resumed when it was interrupted.
+// Pools and providers still used by other owners are kept, the deletion records their IDs.
+type Cleaner struct {
+	client   *ent.Client
+	logger   *zap.SugaredLogger
+	metadata Metadata
+}
+
+// Option is a functional configuration option for the Cleaner
+type Option func(c *Cleaner)
+
+// WithLogger sets the logger for the cleaner
+func WithLogger(l *zap.SugaredLogger) Option {
+	return func(c *Cleaner) {
+		c.logger = l
+	}
+}
+
+// WithMetadataClient sets the client used to update the metadata status of deleted load balancers
+func WithMetadataClient(m Metadata) Option {
+	return func(c *Cleaner) {
+		c.metadata = m
+	}
+}
+
+// New returns a new Cleaner. The client must have the pubsub hooks registered, they publish the
+// changes and remove the auth relationships of the deleted pools.
+func New(client *ent.Client, opts ...Option) *Cleaner {
+	c := &Cleaner{
+		client: client,
+		logger: zap.NewNop().Sugar(),
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// HandleChange removes the resources owned by the subject of delete change messages. It is meant
+// to be used as a worker.ChangeHandler for the topics owners are published on, messages for
+// subjects that don't own anything are ignored.
+func (c *Cleaner) HandleChange(ctx context.Context, msg events.ChangeMessage) error {
+	if msg.EventType != string(events.DeleteChangeType) {
+		return nil
+	}
+
+	return c.Delete(ctx, msg.SubjectID)
+}
+
+// Delete removes all resources of the owner. Deleting an owner again once it completed is a no-op.
+func (c *Cleaner) Delete(ctx context.Context, ownerID gidx.PrefixedID) error {
+	deletion, err := c.client.OwnerDeletion.Query().Where(ownerdeletion.OwnerIDEQ(ownerID)).Only(ctx)
+
+	switch {
+	case err == nil:
+		if deletion.CompletedAt != nil {
+			return nil
+		}
+	case ent.IsNotFound(err):
+		owned, err := c.ownsResources(ctx, ownerID)
+		if err != nil {
+			return err
+		}
+
+		if !owned {
+			return nil
+		}
+
+		deletion, err = c.client.OwnerDeletion.Create().SetOwnerID(ownerID).Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to record owner deletion: %w", err)
+		}
+	default:
+		return fmt.Errorf("failed to load owner deletion: %w", err)
+	}
+
+	return c.run(ctx, deletion)
+}
+
+// Resume finishes the owner deletions that were interrupted
+func (c *Cleaner) Resume(ctx context.Context) error {
+	deletions, err := c.client.OwnerDeletion.Query().Where(ownerdeletion.CompletedAtIsNil()).All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query unfinished owner deletions: %w", err)
+	}
+
+	var errs []error
+
+	for _, deletion := range deletions {
+		if err := c.run(ctx, deletion); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c *Cleaner) ownsResources(ctx context.Context, ownerID gidx.PrefixedID) (bool, error) {
+	lbs, err := c.client.LoadBalancer.Query().Where(loadbalancer.OwnerIDEQ(ownerID)).Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to query load balancers: %w", err)
+	}
+
+	pools, err := c.client.Pool.Query().Where(pool.OwnerIDEQ(ownerID)).Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to query pools: %w", err)
+	}
+
+	providers, err := c.client.Provider.Query().Where(provider.OwnerIDEQ(ownerID)).Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to query providers: %w", err)
+	}
+
+	return lbs || pools || providers, nil
+}
+
+// run removes the resources in dependency order, load balancers reference providers and their
+// ports reference pools. Only live resources are queried, so a resumed run picks up where the
+// interrupted one stopped. Pools and providers that are still used by resources of other owners
+// are kept and recorded as skipped, removing them would break load balancers of those owners.
+func (c *Cleaner) run(ctx context.Context, deletion *ent.OwnerDeletion) error {
+	logger := c.logger.With("ownerID", deletion.OwnerID)
+
+	logger.Info("deleting owner resources")
+
+	lbs, err := c.client.LoadBalancer.Query().Where(loadbalancer.OwnerIDEQ(deletion.OwnerID)).All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query load balancers: %w", err)
+	}
+
+	for _, lb := range lbs {
+		if err := c.deleteLoadBalancer(ctx, lb); err != nil {
+			return fmt.Errorf("failed to delete load balancer %s: %w", lb.ID, err)
+		}
+	}
+
+	var skipped []gidx.PrefixedID
+
+	pools, err := c.client.Pool.Query().Where(pool.OwnerIDEQ(deletion.OwnerID)).All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query pools: %w", err)
+	}
+
+	for _, p := range pools {
+		// the ports of the owner are deleted, remaining ports belong to other owners
+		ports, err := c.client.Port.Query().Where(port.HasPoolsWith(pool.IDEQ(p.ID))).IDs(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query ports of pool %s: %w", p.ID, err)
+		}
+
+		if len(ports) > 0 {
+			logger.Warnw("keeping pool used by ports of other owners", "poolID", p.ID, "portIDs", ports)
+
+			skipped = append(skipped, p.ID)
+
+			continue
+		}
+
+		if err := c.deletePool(ctx, p); err != nil {
+			return fmt.Errorf("failed to delete pool %s: %w", p.ID, err)
+		}
+	}
+
+	providers, err := c.client.Provider.Query().Where(provider.OwnerIDEQ(deletion.OwnerID)).All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query providers: %w", err)
+	}
+
+	for _, p := range providers {
+		// the load balancers of the owner are deleted, remaining ones belong to other owners
+		users, err := c.client.LoadBalancer.Query().Where(loadbalancer.ProviderIDEQ(p.ID)).IDs(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query load balancers of provider %s: %w", p.ID, err)
+		}
+
+		if len(users) > 0 {
+			logger.Warnw("keeping provider used by load balancers of other owners", "providerID", p.ID, "loadbalancerIDs", users)
+
+			skipped = append(skipped, p.ID)
+
+			continue
+		}
+
+		if err := c.client.Provider.DeleteOne(p).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete provider %s: %w", p.ID, err)
+		}
+	}
+
+	err = c.client.OwnerDeletion.UpdateOne(deletion).
+		SetCompletedAt(time.Now()).
+		SetSkippedIds(skipped).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to complete owner deletion: %w", err)
+	}
+
+	logger.Infow("deleted owner resources", "load-balancers", len(lbs), "pools", len(pools), "providers", len(providers), "skipped", skipped)
+
+	return nil
+}
+
+func (c *Cleaner) deleteLoadBalancer(ctx context.Context, lb *ent.LoadBalancer) error {
+	c.statusUpdate(ctx, lb.ID, metastatus.LoadBalancerStateTerminating)
+
+	relationships := []events.AuthRelationshipRelation{{
+		Relation:  "owner",
+		SubjectID: lb.OwnerID,
+	}}
+
+	relationships = append(relationships, config.ExtraRelationships("loadbalancer")...)
+
+	// relationships are removed first, so they aren't left behind when the deletion is interrupted
+	if err := permissions.DeleteAuthRelationships(ctx, "load-balancer", lb.ID, relationships...); err != nil {
+		return fmt.Errorf("failed to delete auth relationships: %w", err)
+	}
+
+	err := withTx(ctx, c.client, func(tx *ent.Tx) error {
+		ports, err := tx.Port.Query().Where(port.LoadBalancerIDEQ(lb.ID)).All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query ports: %w", err)
+		}
+
+		for _, p := range ports {
+			if err := tx.Port.DeleteOne(p).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to delete port %s: %w", p.ID, err)
+			}
+		}
+
+		return tx.LoadBalancer.DeleteOne(lb).Exec(ctx)
+	})
+	if err != nil {
+		return err
+	}
+
+	c.statusUpdate(ctx, lb.ID, metastatus.LoadBalancerStateDeleted)
+
+	return nil
+}
+
+func (c *Cleaner) deletePool(ctx context.Context, p *ent.Pool) error {
+	return withTx(ctx, c.client, func(tx *ent.Tx) error {
+		origins, err := tx.Origin.Query().Where(origin.PoolIDEQ(p.ID)).All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query origins: %w", err)
+		}
+
+		for _, o := range origins {
+			if err := tx.Origin.DeleteOne(o).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to delete origin %s: %w", o.ID, err)
+			}
+		}
+
+		return tx.Pool.DeleteOne(p).Exec(ctx)
+	})
+}
+
+// statusUpdate sets the metadata status of a load balancer, failures are logged as the load
+// balancer is deleted regardless
+func (c *Cleaner) statusUpdate(ctx context.Context, id gidx.PrefixedID, state metastatus.LoadBalancerState) {
+	if c.metadata == nil {
+		return
+	}
+
+	data, err := json.Marshal(&metastatus.LoadBalancerStatus{State: state})
+	if err == nil {
+		_, err = c.metadata.StatusUpdate(ctx, &metacli.StatusUpdateInput{
+			NodeID:      id.String(),
+			NamespaceID: config.AppConfig.Metadata.StatusNamespaceID.String(),
+			Source:      metadataStatusSource,
+			Data:        json.RawMessage(data),
+		})
+	}
+
+	if err != nil {
+		c.logger.Errorw("failed to update loadbalancer metadata status", "error", err, "loadbalancerID", id, "state", state)
+	}
+}
+
+func withTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return errors.Join(err, fmt.Errorf("failed to rollback transaction: %w", rerr))
+		}
+
+		return err
+	}
+
+	return tx.Commit()
+}
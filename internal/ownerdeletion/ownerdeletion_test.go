@@ -0,0 +1,234 @@
+package ownerdeletion_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metacli "go.infratographer.com/metadata-api/pkg/client"
+	"go.infratographer.com/metadata-api/pkg/client/mockmetadata"
+	"go.infratographer.com/permissions-api/pkg/permissions/mockpermissions"
+	"go.infratographer.com/x/events"
+	"go.infratographer.com/x/gidx"
+
+	ent "go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/eventhooks"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/ownerdeletion"
+	od "go.infratographer.com/load-balancer-api/internal/ownerdeletion"
+	"go.infratographer.com/load-balancer-api/internal/testutils"
+	metastatus "go.infratographer.com/load-balancer-api/pkg/metadata"
+)
+
+const ownerPrefix = "testown"
+
+func TestMain(m *testing.M) {
+	// setup the database
+	testutils.SetupDB()
+
+	// setup the pubsub hooks
+	eventhooks.EventHooks(testutils.EntClient)
+
+	// run the tests
+	code := m.Run()
+
+	// teardown the database
+	testutils.TeardownDB()
+
+	// return the test response code
+	os.Exit(code)
+}
+
+// statusUpdated matches metadata status updates of the load balancer to the given state
+func statusUpdated(id gidx.PrefixedID, state metastatus.LoadBalancerState) interface{} {
+	return mock.MatchedBy(func(input *metacli.StatusUpdateInput) bool {
+		var status metastatus.LoadBalancerStatus
+
+		if err := json.Unmarshal(input.Data, &status); err != nil {
+			return false
+		}
+
+		return input.NodeID == id.String() && status.State == state
+	})
+}
+
+func TestCleaner(t *testing.T) {
+	ctx := context.Background()
+
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	metadataMock := new(mockmetadata.MockMetadata)
+	metadataMock.On("StatusUpdate", mock.Anything, mock.Anything).Return(&metacli.StatusUpdate{}, nil)
+
+	cleaner := od.New(testutils.EntClient, od.WithMetadataClient(metadataMock))
+
+	ownerID := gidx.MustNewID(ownerPrefix)
+	otherOwnerID := gidx.MustNewID(ownerPrefix)
+
+	lb := (&testutils.LoadBalancerBuilder{OwnerID: ownerID}).MustNew(ctx)
+	p := (&testutils.PoolBuilder{OwnerID: ownerID}).MustNew(ctx)
+	prt := (&testutils.PortBuilder{LoadBalancerID: lb.ID, PoolIDs: []gidx.PrefixedID{p.ID}}).MustNew(ctx)
+	o := (&testutils.OriginBuilder{PoolID: p.ID}).MustNew(ctx)
+	prov := (&testutils.ProviderBuilder{OwnerID: ownerID}).MustNew(ctx)
+
+	otherLB := (&testutils.LoadBalancerBuilder{OwnerID: otherOwnerID}).MustNew(ctx)
+	otherPool := (&testutils.PoolBuilder{OwnerID: otherOwnerID}).MustNew(ctx)
+
+	deleteMsg := func(id gidx.PrefixedID) events.ChangeMessage {
+		return events.ChangeMessage{
+			SubjectID: id,
+			EventType: string(events.DeleteChangeType),
+			Timestamp: time.Now().UTC(),
+		}
+	}
+
+	t.Run("changes other than deletes are ignored", func(t *testing.T) {
+		msg := deleteMsg(ownerID)
+		msg.EventType = string(events.UpdateChangeType)
+
+		require.NoError(t, cleaner.HandleChange(ctx, msg))
+
+		_, err := testutils.EntClient.LoadBalancer.Get(ctx, lb.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("owners without resources are ignored", func(t *testing.T) {
+		unknown := gidx.MustNewID(ownerPrefix)
+
+		require.NoError(t, cleaner.HandleChange(ctx, deleteMsg(unknown)))
+
+		exists, err := testutils.EntClient.OwnerDeletion.Query().Where(ownerdeletion.OwnerIDEQ(unknown)).Exist(ctx)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("owner resources are deleted", func(t *testing.T) {
+		require.NoError(t, cleaner.HandleChange(ctx, deleteMsg(ownerID)))
+
+		_, err := testutils.EntClient.LoadBalancer.Get(ctx, lb.ID)
+		assert.True(t, ent.IsNotFound(err), "load balancer")
+		_, err = testutils.EntClient.Port.Get(ctx, prt.ID)
+		assert.True(t, ent.IsNotFound(err), "port")
+		_, err = testutils.EntClient.Pool.Get(ctx, p.ID)
+		assert.True(t, ent.IsNotFound(err), "pool")
+		_, err = testutils.EntClient.Origin.Get(ctx, o.ID)
+		assert.True(t, ent.IsNotFound(err), "origin")
+		_, err = testutils.EntClient.Provider.Get(ctx, prov.ID)
+		assert.True(t, ent.IsNotFound(err), "provider")
+		_, err = testutils.EntClient.Provider.Get(ctx, lb.ProviderID)
+		assert.True(t, ent.IsNotFound(err), "load balancer provider")
+
+		// resources of other owners are kept
+		_, err = testutils.EntClient.LoadBalancer.Get(ctx, otherLB.ID)
+		require.NoError(t, err)
+		_, err = testutils.EntClient.Pool.Get(ctx, otherPool.ID)
+		require.NoError(t, err)
+
+		perms.AssertCalled(t, "DeleteAuthRelationships", "load-balancer", lb.ID, events.AuthRelationshipRelation{Relation: "owner", SubjectID: ownerID})
+		perms.AssertCalled(t, "DeleteAuthRelationships", "load-balancer-pool", p.ID, events.AuthRelationshipRelation{Relation: "owner", SubjectID: ownerID})
+
+		metadataMock.AssertCalled(t, "StatusUpdate", mock.Anything, statusUpdated(lb.ID, metastatus.LoadBalancerStateTerminating))
+		metadataMock.AssertCalled(t, "StatusUpdate", mock.Anything, statusUpdated(lb.ID, metastatus.LoadBalancerStateDeleted))
+
+		deletion, err := testutils.EntClient.OwnerDeletion.Query().Where(ownerdeletion.OwnerIDEQ(ownerID)).Only(ctx)
+		require.NoError(t, err)
+		assert.NotNil(t, deletion.CompletedAt)
+	})
+
+	t.Run("pools used by ports of other owners are kept", func(t *testing.T) {
+		sharingOwnerID := gidx.MustNewID(ownerPrefix)
+
+		sharedPool := (&testutils.PoolBuilder{OwnerID: sharingOwnerID}).MustNew(ctx)
+		sharedOrigin := (&testutils.OriginBuilder{PoolID: sharedPool.ID}).MustNew(ctx)
+		unsharedPool := (&testutils.PoolBuilder{OwnerID: sharingOwnerID}).MustNew(ctx)
+
+		// the port of the owner using the pool is deleted with its load balancer
+		ownLB := (&testutils.LoadBalancerBuilder{OwnerID: sharingOwnerID}).MustNew(ctx)
+		(&testutils.PortBuilder{LoadBalancerID: ownLB.ID, PoolIDs: []gidx.PrefixedID{sharedPool.ID, unsharedPool.ID}}).MustNew(ctx)
+		otherPort := (&testutils.PortBuilder{LoadBalancerID: otherLB.ID, PoolIDs: []gidx.PrefixedID{sharedPool.ID}}).MustNew(ctx)
+
+		require.NoError(t, cleaner.HandleChange(ctx, deleteMsg(sharingOwnerID)))
+
+		_, err := testutils.EntClient.LoadBalancer.Get(ctx, ownLB.ID)
+		assert.True(t, ent.IsNotFound(err), "load balancer")
+		_, err = testutils.EntClient.Pool.Get(ctx, unsharedPool.ID)
+		assert.True(t, ent.IsNotFound(err), "unshared pool")
+
+		// the pool keeps serving the port of the other owner
+		_, err = testutils.EntClient.Pool.Get(ctx, sharedPool.ID)
+		require.NoError(t, err)
+		_, err = testutils.EntClient.Origin.Get(ctx, sharedOrigin.ID)
+		require.NoError(t, err)
+
+		pools, err := testutils.EntClient.Port.GetX(ctx, otherPort.ID).QueryPools().IDs(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []gidx.PrefixedID{sharedPool.ID}, pools)
+
+		deletion, err := testutils.EntClient.OwnerDeletion.Query().Where(ownerdeletion.OwnerIDEQ(sharingOwnerID)).Only(ctx)
+		require.NoError(t, err)
+		assert.NotNil(t, deletion.CompletedAt)
+		assert.Equal(t, []gidx.PrefixedID{sharedPool.ID}, deletion.SkippedIds)
+	})
+
+	t.Run("providers used by load balancers of other owners are kept", func(t *testing.T) {
+		sharingOwnerID := gidx.MustNewID(ownerPrefix)
+
+		sharedProvider := (&testutils.ProviderBuilder{OwnerID: sharingOwnerID}).MustNew(ctx)
+		unsharedProvider := (&testutils.ProviderBuilder{OwnerID: sharingOwnerID}).MustNew(ctx)
+
+		ownLB := (&testutils.LoadBalancerBuilder{OwnerID: sharingOwnerID, Provider: sharedProvider}).MustNew(ctx)
+		otherOwnerLB := (&testutils.LoadBalancerBuilder{OwnerID: otherOwnerID, Provider: sharedProvider}).MustNew(ctx)
+
+		require.NoError(t, cleaner.HandleChange(ctx, deleteMsg(sharingOwnerID)))
+
+		_, err := testutils.EntClient.LoadBalancer.Get(ctx, ownLB.ID)
+		assert.True(t, ent.IsNotFound(err), "load balancer")
+		_, err = testutils.EntClient.Provider.Get(ctx, unsharedProvider.ID)
+		assert.True(t, ent.IsNotFound(err), "unshared provider")
+
+		_, err = testutils.EntClient.Provider.Get(ctx, sharedProvider.ID)
+		require.NoError(t, err)
+		_, err = testutils.EntClient.LoadBalancer.Get(ctx, otherOwnerLB.ID)
+		require.NoError(t, err)
+
+		deletion, err := testutils.EntClient.OwnerDeletion.Query().Where(ownerdeletion.OwnerIDEQ(sharingOwnerID)).Only(ctx)
+		require.NoError(t, err)
+		assert.NotNil(t, deletion.CompletedAt)
+		assert.Equal(t, []gidx.PrefixedID{sharedProvider.ID}, deletion.SkippedIds)
+	})
+
+	t.Run("completed deletions are not repeated", func(t *testing.T) {
+		calls := len(metadataMock.Calls)
+
+		require.NoError(t, cleaner.HandleChange(ctx, deleteMsg(ownerID)))
+
+		assert.Len(t, metadataMock.Calls, calls)
+	})
+
+	t.Run("interrupted deletions are resumed", func(t *testing.T) {
+		interruptedOwnerID := gidx.MustNewID(ownerPrefix)
+		interruptedLB := (&testutils.LoadBalancerBuilder{OwnerID: interruptedOwnerID}).MustNew(ctx)
+
+		deletion := testutils.EntClient.OwnerDeletion.Create().SetOwnerID(interruptedOwnerID).SaveX(ctx)
+
+		require.NoError(t, cleaner.Resume(ctx))
+
+		_, err := testutils.EntClient.LoadBalancer.Get(ctx, interruptedLB.ID)
+		assert.True(t, ent.IsNotFound(err))
+
+		deletion = testutils.EntClient.OwnerDeletion.GetX(ctx, deletion.ID)
+		assert.NotNil(t, deletion.CompletedAt)
+
+		// the load balancer of the other owner has no deletion recorded
+		_, err = testutils.EntClient.LoadBalancer.Get(ctx, otherLB.ID)
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,29 @@
+package purge
+
+import (
+	"context"
+	"time"
+
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/idempotencykey"
+)
+
+// idempotencyKeys removes the idempotency keys of create mutations once they expire. Keys are not
+// soft deleted, so they are removed regardless of the retention period.
+func (p *Purger) idempotencyKeys(now time.Time) table {
+	where := idempotencykey.ExpiresAtLT(now)
+
+	return table{
+		name: idempotencykey.Table,
+		count: func(ctx context.Context) (int, error) {
+			return p.client.IdempotencyKey.Query().Where(where).Count(ctx)
+		},
+		ids: func(ctx context.Context, limit int) ([]gidx.PrefixedID, error) {
+			return p.client.IdempotencyKey.Query().Where(where).Limit(limit).IDs(ctx)
+		},
+		delete: func(ctx context.Context, ids []gidx.PrefixedID) (int, error) {
+			return p.client.IdempotencyKey.Delete().Where(idempotencykey.IDIn(ids...)).Exec(ctx)
+		},
+	}
+}
@@ -0,0 +1,49 @@
+package purge_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/idempotencykey"
+	"go.infratographer.com/load-balancer-api/internal/purge"
+	"go.infratographer.com/load-balancer-api/internal/testutils"
+)
+
+func TestPurgeIdempotencyKeys(t *testing.T) {
+	ctx := context.Background()
+	ownerID := gidx.MustNewID("testown")
+
+	// expired idempotency key should be purged, live key kept
+	key1 := testutils.EntClient.IdempotencyKey.Create().
+		SetKey("expired").SetOwnerID(ownerID).SetRequestHash("hash").SetExpiresAt(time.Now().Add(-time.Minute)).
+		SaveX(ctx)
+	key2 := testutils.EntClient.IdempotencyKey.Create().
+		SetKey("live").SetOwnerID(ownerID).SetRequestHash("hash").SetExpiresAt(time.Now().Add(time.Hour)).
+		SaveX(ctx)
+
+	t.Run("dry run", func(t *testing.T) {
+		p := purge.New(testutils.EntClient, purge.WithRetention(retention), purge.WithDryRun(true))
+
+		res, err := p.Run(ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, purge.Result{IdempotencyKeys: 1}, res)
+		assert.True(t, testutils.EntClient.IdempotencyKey.Query().Where(idempotencykey.ID(key1.ID)).ExistX(ctx))
+	})
+
+	t.Run("purge", func(t *testing.T) {
+		p := purge.New(testutils.EntClient, purge.WithRetention(retention))
+
+		res, err := p.Run(ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, purge.Result{IdempotencyKeys: 1}, res)
+		assert.False(t, testutils.EntClient.IdempotencyKey.Query().Where(idempotencykey.ID(key1.ID)).ExistX(ctx))
+		assert.True(t, testutils.EntClient.IdempotencyKey.Query().Where(idempotencykey.ID(key2.ID)).ExistX(ctx))
+	})
+}
@@ -0,0 +1,250 @@
+// Package purge permanently removes soft deleted records that are older than a retention period
+package purge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.infratographer.com/x/gidx"
+	"go.uber.org/zap"
+
+	ent "go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/loadbalancer"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/origin"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/pool"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/port"
+	_ "go.infratographer.com/load-balancer-api/internal/ent/generated/runtime" // imports the generated runtime package to register the soft delete hooks and interceptors
+	"go.infratographer.com/load-balancer-api/internal/ent/schema/softdelete"
+)
+
+const (
+	// DefaultRetention is how long soft deleted records are kept before being purged
+	DefaultRetention = 30 * 24 * time.Hour
+	// DefaultBatchSize is the number of records removed per delete statement
+	DefaultBatchSize = 100
+)
+
+// Purger permanently removes soft deleted records
+type Purger struct {
+	client    *ent.Client
+	logger    *zap.SugaredLogger
+	retention time.Duration
+	batchSize int
+	dryRun    bool
+}
+
+// Option is a functional configuration option for the Purger
+type Option func(p *Purger)
+
+// WithLogger sets the logger for the purger
+func WithLogger(l *zap.SugaredLogger) Option {
+	return func(p *Purger) {
+		p.logger = l
+	}
+}
+
+// WithRetention sets how long soft deleted records are kept before being purged
+func WithRetention(d time.Duration) Option {
+	return func(p *Purger) {
+		if d > 0 {
+			p.retention = d
+		}
+	}
+}
+
+// WithBatchSize sets the number of records removed per delete statement
+func WithBatchSize(n int) Option {
+	return func(p *Purger) {
+		if n > 0 {
+			p.batchSize = n
+		}
+	}
+}
+
+// WithDryRun only counts the records that would be purged without removing them
+func WithDryRun(dryRun bool) Option {
+	return func(p *Purger) {
+		p.dryRun = dryRun
+	}
+}
+
+// New returns a new Purger
+func New(client *ent.Client, opts ...Option) *Purger {
+	p := &Purger{
+		client:    client,
+		logger:    zap.NewNop().Sugar(),
+		retention: DefaultRetention,
+		batchSize: DefaultBatchSize,
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+// Result is the number of records purged, or that would be purged in a dry run, per table
+type Result struct {
+	Origins         int
+	Ports           int
+	Pools           int
+	LoadBalancers   int
+	IdempotencyKeys int
+}
+
+// table describes how to find and remove the purgeable records of a single table
+type table struct {
+	name   string
+	count  func(ctx context.Context) (int, error)
+	ids    func(ctx context.Context, limit int) ([]gidx.PrefixedID, error)
+	delete func(ctx context.Context, ids []gidx.PrefixedID) (int, error)
+}
+
+// Run purges all soft deleted records older than the retention period. Records are removed
+// in dependency order, origins, then ports, then pools, then load balancers. A parent is
+// only removed once none of its children are left behind, so a pool or load balancer that
+// still has live or recently deleted children is kept until a later run. Pools are also kept
+// while a live or recently deleted port still references them. Expired idempotency keys are
+// removed last.
+func (p *Purger) Run(ctx context.Context) (Result, error) {
+	var res Result
+
+	// include soft deleted records in queries and make deletes permanent
+	ctx = softdelete.SkipSoftDelete(ctx)
+
+	cutoff := time.Now().Add(-p.retention)
+
+	tables := []struct {
+		table
+		result *int
+	}{
+		{p.origins(cutoff), &res.Origins},
+		{p.ports(cutoff), &res.Ports},
+		{p.pools(cutoff), &res.Pools},
+		{p.loadBalancers(cutoff), &res.LoadBalancers},
+		{p.idempotencyKeys(time.Now()), &res.IdempotencyKeys},
+	}
+
+	for _, t := range tables {
+		n, err := p.purge(ctx, t.table)
+		if err != nil {
+			return res, fmt.Errorf("failed purging %s: %w", t.name, err)
+		}
+
+		*t.result = n
+
+		p.logger.Infow("purged records", "table", t.name, "count", n, "dry-run", p.dryRun, "cutoff", cutoff)
+	}
+
+	return res, nil
+}
+
+func (p *Purger) purge(ctx context.Context, t table) (int, error) {
+	if p.dryRun {
+		return t.count(ctx)
+	}
+
+	total := 0
+
+	for {
+		ids, err := t.ids(ctx, p.batchSize)
+		if err != nil {
+			return total, err
+		}
+
+		if len(ids) == 0 {
+			return total, nil
+		}
+
+		n, err := t.delete(ctx, ids)
+		if err != nil {
+			return total, err
+		}
+
+		total += n
+
+		p.logger.Debugw("purged batch", "table", t.name, "count", n)
+
+		// a batch that removed nothing would be selected again, stop instead of looping forever
+		if n == 0 || len(ids) < p.batchSize {
+			return total, nil
+		}
+	}
+}
+
+func (p *Purger) origins(cutoff time.Time) table {
+	where := origin.DeletedAtLT(cutoff)
+
+	return table{
+		name: origin.Table,
+		count: func(ctx context.Context) (int, error) {
+			return p.client.Origin.Query().Where(where).Count(ctx)
+		},
+		ids: func(ctx context.Context, limit int) ([]gidx.PrefixedID, error) {
+			return p.client.Origin.Query().Where(where).Limit(limit).IDs(ctx)
+		},
+		delete: func(ctx context.Context, ids []gidx.PrefixedID) (int, error) {
+			return p.client.Origin.Delete().Where(origin.IDIn(ids...)).Exec(ctx)
+		},
+	}
+}
+
+func (p *Purger) ports(cutoff time.Time) table {
+	where := port.DeletedAtLT(cutoff)
+
+	return table{
+		name: port.Table,
+		count: func(ctx context.Context) (int, error) {
+			return p.client.Port.Query().Where(where).Count(ctx)
+		},
+		ids: func(ctx context.Context, limit int) ([]gidx.PrefixedID, error) {
+			return p.client.Port.Query().Where(where).Limit(limit).IDs(ctx)
+		},
+		delete: func(ctx context.Context, ids []gidx.PrefixedID) (int, error) {
+			return p.client.Port.Delete().Where(port.IDIn(ids...)).Exec(ctx)
+		},
+	}
+}
+
+func (p *Purger) pools(cutoff time.Time) table {
+	where := pool.And(
+		pool.DeletedAtLT(cutoff),
+		pool.Not(pool.HasOriginsWith(origin.Or(origin.DeletedAtIsNil(), origin.DeletedAtGTE(cutoff)))),
+		pool.Not(pool.HasPortsWith(port.Or(port.DeletedAtIsNil(), port.DeletedAtGTE(cutoff)))),
+	)
+
+	return table{
+		name: pool.Table,
+		count: func(ctx context.Context) (int, error) {
+			return p.client.Pool.Query().Where(where).Count(ctx)
+		},
+		ids: func(ctx context.Context, limit int) ([]gidx.PrefixedID, error) {
+			return p.client.Pool.Query().Where(where).Limit(limit).IDs(ctx)
+		},
+		delete: func(ctx context.Context, ids []gidx.PrefixedID) (int, error) {
+			return p.client.Pool.Delete().Where(pool.IDIn(ids...)).Exec(ctx)
+		},
+	}
+}
+
+func (p *Purger) loadBalancers(cutoff time.Time) table {
+	where := loadbalancer.And(
+		loadbalancer.DeletedAtLT(cutoff),
+		loadbalancer.Not(loadbalancer.HasPortsWith(port.Or(port.DeletedAtIsNil(), port.DeletedAtGTE(cutoff)))),
+	)
+
+	return table{
+		name: loadbalancer.Table,
+		count: func(ctx context.Context) (int, error) {
+			return p.client.LoadBalancer.Query().Where(where).Count(ctx)
+		},
+		ids: func(ctx context.Context, limit int) ([]gidx.PrefixedID, error) {
+			return p.client.LoadBalancer.Query().Where(where).Limit(limit).IDs(ctx)
+		},
+		delete: func(ctx context.Context, ids []gidx.PrefixedID) (int, error) {
+			return p.client.LoadBalancer.Delete().Where(loadbalancer.IDIn(ids...)).Exec(ctx)
+		},
+	}
+}
@@ -0,0 +1,129 @@
+package purge_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/loadbalancer"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/origin"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/pool"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/port"
+	"go.infratographer.com/load-balancer-api/internal/ent/schema/softdelete"
+	"go.infratographer.com/load-balancer-api/internal/purge"
+	"go.infratographer.com/load-balancer-api/internal/testutils"
+)
+
+const retention = 24 * time.Hour
+
+func TestMain(m *testing.M) {
+	// setup the database
+	testutils.SetupDB()
+
+	// run the tests
+	code := m.Run()
+
+	// teardown the database
+	testutils.TeardownDB()
+
+	// return the test response code
+	os.Exit(code)
+}
+
+func TestPurge(t *testing.T) {
+	ctx := context.Background()
+	expired := time.Now().Add(-2 * retention)
+	recent := time.Now().Add(-retention / 2)
+
+	// fully deleted tree, everything should be purged
+	lb1 := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+	pool1 := (&testutils.PoolBuilder{OwnerID: lb1.OwnerID}).MustNew(ctx)
+	port1 := (&testutils.PortBuilder{LoadBalancerID: lb1.ID, PoolIDs: []gidx.PrefixedID{pool1.ID}}).MustNew(ctx)
+	origin1 := (&testutils.OriginBuilder{PoolID: pool1.ID}).MustNew(ctx)
+	origin2 := (&testutils.OriginBuilder{PoolID: pool1.ID}).MustNew(ctx)
+
+	testutils.EntClient.LoadBalancer.UpdateOneID(lb1.ID).SetDeletedAt(expired).ExecX(ctx)
+	testutils.EntClient.Pool.UpdateOneID(pool1.ID).SetDeletedAt(expired).ExecX(ctx)
+	testutils.EntClient.Port.UpdateOneID(port1.ID).SetDeletedAt(expired).ExecX(ctx)
+	testutils.EntClient.Origin.UpdateOneID(origin1.ID).SetDeletedAt(expired).ExecX(ctx)
+	testutils.EntClient.Origin.UpdateOneID(origin2.ID).SetDeletedAt(expired).ExecX(ctx)
+
+	// expired load balancer with a live port, both should be kept
+	lb2 := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+	port2 := (&testutils.PortBuilder{LoadBalancerID: lb2.ID}).MustNew(ctx)
+
+	testutils.EntClient.LoadBalancer.UpdateOneID(lb2.ID).SetDeletedAt(expired).ExecX(ctx)
+
+	// pool deleted within the retention period, should be kept
+	pool2 := (&testutils.PoolBuilder{}).MustNew(ctx)
+
+	testutils.EntClient.Pool.UpdateOneID(pool2.ID).SetDeletedAt(recent).ExecX(ctx)
+
+	// expired pool still referenced by a live port, should be kept
+	lb4 := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+	pool3 := (&testutils.PoolBuilder{OwnerID: lb4.OwnerID}).MustNew(ctx)
+	(&testutils.PortBuilder{LoadBalancerID: lb4.ID, PoolIDs: []gidx.PrefixedID{pool3.ID}}).MustNew(ctx)
+
+	testutils.EntClient.Pool.UpdateOneID(pool3.ID).SetDeletedAt(expired).ExecX(ctx)
+
+	// live load balancer, should be kept
+	lb3 := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+
+	expected := purge.Result{
+		Origins:       2,
+		Ports:         1,
+		Pools:         1,
+		LoadBalancers: 1,
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		p := purge.New(testutils.EntClient, purge.WithRetention(retention), purge.WithDryRun(true))
+
+		res, err := p.Run(ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, expected, res)
+
+		skipCtx := softdelete.SkipSoftDelete(ctx)
+
+		assert.True(t, testutils.EntClient.LoadBalancer.Query().Where(loadbalancer.ID(lb1.ID)).ExistX(skipCtx))
+		assert.True(t, testutils.EntClient.Pool.Query().Where(pool.ID(pool1.ID)).ExistX(skipCtx))
+		assert.True(t, testutils.EntClient.Port.Query().Where(port.ID(port1.ID)).ExistX(skipCtx))
+		assert.True(t, testutils.EntClient.Origin.Query().Where(origin.ID(origin1.ID)).ExistX(skipCtx))
+	})
+
+	t.Run("purge", func(t *testing.T) {
+		p := purge.New(testutils.EntClient, purge.WithRetention(retention), purge.WithBatchSize(1))
+
+		res, err := p.Run(ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, expected, res)
+
+		skipCtx := softdelete.SkipSoftDelete(ctx)
+
+		assert.False(t, testutils.EntClient.LoadBalancer.Query().Where(loadbalancer.ID(lb1.ID)).ExistX(skipCtx))
+		assert.False(t, testutils.EntClient.Pool.Query().Where(pool.ID(pool1.ID)).ExistX(skipCtx))
+		assert.False(t, testutils.EntClient.Port.Query().Where(port.ID(port1.ID)).ExistX(skipCtx))
+		assert.False(t, testutils.EntClient.Origin.Query().Where(origin.IDIn(origin1.ID, origin2.ID)).ExistX(skipCtx))
+
+		assert.Equal(t, 2, testutils.EntClient.LoadBalancer.Query().Where(loadbalancer.IDIn(lb2.ID, lb3.ID)).CountX(skipCtx))
+		assert.True(t, testutils.EntClient.Port.Query().Where(port.ID(port2.ID)).ExistX(skipCtx))
+		assert.True(t, testutils.EntClient.Pool.Query().Where(pool.ID(pool2.ID)).ExistX(skipCtx))
+		assert.True(t, testutils.EntClient.Pool.Query().Where(pool.ID(pool3.ID)).ExistX(skipCtx))
+	})
+
+	t.Run("nothing left to purge", func(t *testing.T) {
+		p := purge.New(testutils.EntClient, purge.WithRetention(retention))
+
+		res, err := p.Run(ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, purge.Result{}, res)
+	})
+}
@@ -0,0 +1,430 @@
+// Package resync republishes the state of all live resources, so downstream consumers that lost
+// their state can rebuild it
+package resync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.infratographer.com/x/events"
+	"go.infratographer.com/x/gidx"
+	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
+
+	ent "go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/loadbalancer"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/origin"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/pool"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/port"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/predicate"
+	_ "go.infratographer.com/load-balancer-api/internal/ent/generated/runtime" // imports the generated runtime package to register the soft delete hooks and interceptors
+	"go.infratographer.com/load-balancer-api/internal/ent/schema"
+	"go.infratographer.com/load-balancer-api/internal/snapshot"
+)
+
+const (
+	// EventType is the event type of the published change messages, it lets consumers tell a
+	// resync apart from a real change
+	EventType = "resync"
+
+	// DefaultBatchSize is the number of resources loaded per query
+	DefaultBatchSize = 100
+	// DefaultRate is the maximum number of messages published per second
+	DefaultRate = 100
+)
+
+// ErrInvalidCursor is returned when a cursor is not the ID of a resource that is resynced
+var ErrInvalidCursor = errors.New("cursor is not a load balancer, port, pool or origin ID")
+
+// Filter limits a resync to the resources of an owner, location or provider. Ports and origins
+// are matched by their load balancer and pool, pools by their own owner and the load balancers
+// they are assigned to.
+type Filter struct {
+	OwnerID    gidx.PrefixedID
+	LocationID gidx.PrefixedID
+	ProviderID gidx.PrefixedID
+}
+
+// Result reports the progress of a resync
+type Result struct {
+	// Published is the number of messages published
+	Published int
+	// Cursor is the ID of the last resource published, the resync continues after it when it is
+	// passed to Run again. It is empty once all resources have been published.
+	Cursor gidx.PrefixedID
+}
+
+// Resyncer publishes a change message for every live load balancer, port, pool and origin, in
+// that order and ordered by ID within each type
+type Resyncer struct {
+	client    *ent.Client
+	publisher events.Publisher
+	logger    *zap.SugaredLogger
+	batchSize int
+	rate      int
+
+	snapshots       bool
+	snapshotMaxSize int
+}
+
+// Option is a functional configuration option for the Resyncer
+type Option func(r *Resyncer)
+
+// WithLogger sets the logger for the resyncer
+func WithLogger(l *zap.SugaredLogger) Option {
+	return func(r *Resyncer) {
+		r.logger = l
+	}
+}
+
+// WithBatchSize sets the number of resources loaded per query
+func WithBatchSize(n int) Option {
+	return func(r *Resyncer) {
+		if n > 0 {
+			r.batchSize = n
+		}
+	}
+}
+
+// WithRate sets the maximum number of messages published per second, no limit is applied when
+// it is zero or less
+func WithRate(perSecond int) Option {
+	return func(r *Resyncer) {
+		r.rate = perSecond
+	}
+}
+
+// WithSnapshots embeds a snapshot of every resource of at most maxSize bytes in the messages,
+// snapshot.DefaultMaxSize is used when it is zero or less
+func WithSnapshots(maxSize int) Option {
+	return func(r *Resyncer) {
+		r.snapshots = true
+		r.snapshotMaxSize = maxSize
+
+		if maxSize <= 0 {
+			r.snapshotMaxSize = snapshot.DefaultMaxSize
+		}
+	}
+}
+
+// New returns a new Resyncer publishing to publisher
+func New(client *ent.Client, publisher events.Publisher, opts ...Option) *Resyncer {
+	r := &Resyncer{
+		client:    client,
+		publisher: publisher,
+		logger:    zap.NewNop().Sugar(),
+		batchSize: DefaultBatchSize,
+		rate:      DefaultRate,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// resource is a resynced resource and the subjects related to it
+type resource struct {
+	id       gidx.PrefixedID
+	subjects []gidx.PrefixedID
+}
+
+// kind is a type of resource that is resynced
+type kind struct {
+	prefix string
+	topic  string
+	page   func(ctx context.Context, client *ent.Client, f Filter, after gidx.PrefixedID, n int) ([]resource, error)
+}
+
+var kinds = []kind{
+	{prefix: schema.LoadBalancerPrefix, topic: "load-balancer", page: loadBalancers},
+	{prefix: schema.PortPrefix, topic: "load-balancer-port", page: ports},
+	{prefix: schema.PoolPrefix, topic: "load-balancer-pool", page: pools},
+	{prefix: schema.OriginPrefix, topic: "load-balancer-origin", page: origins},
+}
+
+// Run publishes the resources matching the filter, starting after the cursor when it is set. At
+// most limit messages are published when limit is greater than zero. The result is returned
+// on errors as well, so an interrupted resync can be resumed from its cursor.
+func (r *Resyncer) Run(ctx context.Context, filter Filter, after gidx.PrefixedID, limit int) (Result, error) {
+	res := Result{Cursor: after}
+
+	start := 0
+
+	if after != "" {
+		start = slices.IndexFunc(kinds, func(k kind) bool { return k.prefix == after.Prefix() })
+		if start < 0 {
+			return res, ErrInvalidCursor
+		}
+	}
+
+	var tick <-chan time.Time
+
+	if r.rate > 0 {
+		ticker := time.NewTicker(time.Second / time.Duration(r.rate))
+		defer ticker.Stop()
+
+		tick = ticker.C
+	}
+
+	for _, k := range kinds[start:] {
+		// the cursor only applies to the type it belongs to, later types start from the beginning
+		cursor := gidx.PrefixedID("")
+		if res.Cursor.Prefix() == k.prefix {
+			cursor = res.Cursor
+		}
+
+		for {
+			n := r.batchSize
+			if limit > 0 && limit-res.Published < n {
+				n = limit - res.Published
+			}
+
+			if n == 0 {
+				return res, nil
+			}
+
+			page, err := k.page(ctx, r.client, filter, cursor, n)
+			if err != nil {
+				return res, fmt.Errorf("failed to query %s resources: %w", k.topic, err)
+			}
+
+			for _, rsc := range page {
+				if tick != nil {
+					select {
+					case <-ctx.Done():
+						return res, ctx.Err()
+					case <-tick:
+					}
+				}
+
+				msg := events.ChangeMessage{
+					EventType:            EventType,
+					SubjectID:            rsc.id,
+					AdditionalSubjectIDs: rsc.subjects,
+					Timestamp:            time.Now().UTC(),
+				}
+
+				if r.snapshots {
+					if err := r.attachSnapshot(ctx, &msg); err != nil {
+						return res, err
+					}
+				}
+
+				if _, err := r.publisher.PublishChange(ctx, k.topic, msg); err != nil {
+					return res, fmt.Errorf("failed to publish change: %w", err)
+				}
+
+				res.Published++
+				res.Cursor = rsc.id
+				cursor = rsc.id
+			}
+
+			r.logger.Debugw("resync progress", "published", res.Published, "cursor", res.Cursor)
+
+			if len(page) < n {
+				break
+			}
+		}
+	}
+
+	// everything has been published
+	res.Cursor = ""
+
+	return res, nil
+}
+
+func (r *Resyncer) attachSnapshot(ctx context.Context, msg *events.ChangeMessage) error {
+	v, err := snapshot.Load(ctx, r.client, msg.SubjectID)
+	if err != nil {
+		return fmt.Errorf("failed to load snapshot: %w", err)
+	}
+
+	return snapshot.Attach(msg, v, r.snapshotMaxSize)
+}
+
+func appendSubjects(subjects []gidx.PrefixedID, ids ...gidx.PrefixedID) []gidx.PrefixedID {
+	for _, id := range ids {
+		if !slices.Contains(subjects, id) {
+			subjects = append(subjects, id)
+		}
+	}
+
+	return subjects
+}
+
+func loadBalancerPredicates(f Filter) []predicate.LoadBalancer {
+	var preds []predicate.LoadBalancer
+
+	if f.OwnerID != "" {
+		preds = append(preds, loadbalancer.OwnerIDEQ(f.OwnerID))
+	}
+
+	if f.LocationID != "" {
+		preds = append(preds, loadbalancer.LocationIDEQ(f.LocationID))
+	}
+
+	if f.ProviderID != "" {
+		preds = append(preds, loadbalancer.ProviderIDEQ(f.ProviderID))
+	}
+
+	return preds
+}
+
+func portPredicates(f Filter) []predicate.Port {
+	if preds := loadBalancerPredicates(f); len(preds) != 0 {
+		return []predicate.Port{port.HasLoadBalancerWith(preds...)}
+	}
+
+	return nil
+}
+
+func poolPredicates(f Filter) []predicate.Pool {
+	var preds []predicate.Pool
+
+	if f.OwnerID != "" {
+		preds = append(preds, pool.OwnerIDEQ(f.OwnerID))
+	}
+
+	// the owner is matched on the pool itself, the other filters on the load balancers it is assigned to
+	lbPreds := loadBalancerPredicates(Filter{LocationID: f.LocationID, ProviderID: f.ProviderID})
+	if len(lbPreds) != 0 {
+		preds = append(preds, pool.HasPortsWith(port.HasLoadBalancerWith(lbPreds...)))
+	}
+
+	return preds
+}
+
+func originPredicates(f Filter) []predicate.Origin {
+	if preds := poolPredicates(f); len(preds) != 0 {
+		return []predicate.Origin{origin.HasPoolWith(preds...)}
+	}
+
+	return nil
+}
+
+func loadBalancers(ctx context.Context, client *ent.Client, f Filter, after gidx.PrefixedID, n int) ([]resource, error) {
+	q := client.LoadBalancer.Query().Where(loadBalancerPredicates(f)...)
+
+	if after != "" {
+		q.Where(loadbalancer.IDGT(after))
+	}
+
+	lbs, err := q.Order(ent.Asc(loadbalancer.FieldID)).Limit(n).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page := make([]resource, len(lbs))
+
+	for i, lb := range lbs {
+		page[i] = resource{
+			id:       lb.ID,
+			subjects: appendSubjects(nil, lb.OwnerID, lb.LocationID, lb.ProviderID),
+		}
+	}
+
+	return page, nil
+}
+
+func ports(ctx context.Context, client *ent.Client, f Filter, after gidx.PrefixedID, n int) ([]resource, error) {
+	q := client.Port.Query().Where(portPredicates(f)...).WithLoadBalancer()
+
+	if after != "" {
+		q.Where(port.IDGT(after))
+	}
+
+	prts, err := q.Order(ent.Asc(port.FieldID)).Limit(n).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page := make([]resource, len(prts))
+
+	for i, p := range prts {
+		subjects := appendSubjects(nil, p.LoadBalancerID)
+
+		if lb := p.Edges.LoadBalancer; lb != nil {
+			subjects = appendSubjects(subjects, lb.LocationID, lb.OwnerID, lb.ProviderID)
+		}
+
+		page[i] = resource{id: p.ID, subjects: subjects}
+	}
+
+	return page, nil
+}
+
+func pools(ctx context.Context, client *ent.Client, f Filter, after gidx.PrefixedID, n int) ([]resource, error) {
+	q := client.Pool.Query().Where(poolPredicates(f)...).WithPorts(func(q *ent.PortQuery) {
+		q.WithLoadBalancer()
+	})
+
+	if after != "" {
+		q.Where(pool.IDGT(after))
+	}
+
+	pls, err := q.Order(ent.Asc(pool.FieldID)).Limit(n).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page := make([]resource, len(pls))
+
+	for i, p := range pls {
+		subjects := appendSubjects(nil, p.OwnerID)
+
+		for _, prt := range p.Edges.Ports {
+			subjects = appendSubjects(subjects, prt.LoadBalancerID)
+
+			if lb := prt.Edges.LoadBalancer; lb != nil {
+				subjects = appendSubjects(subjects, lb.LocationID, lb.ProviderID)
+			}
+		}
+
+		page[i] = resource{id: p.ID, subjects: subjects}
+	}
+
+	return page, nil
+}
+
+func origins(ctx context.Context, client *ent.Client, f Filter, after gidx.PrefixedID, n int) ([]resource, error) {
+	q := client.Origin.Query().Where(originPredicates(f)...).WithPool(func(q *ent.PoolQuery) {
+		q.WithPorts(func(q *ent.PortQuery) {
+			q.WithLoadBalancer()
+		})
+	})
+
+	if after != "" {
+		q.Where(origin.IDGT(after))
+	}
+
+	ogns, err := q.Order(ent.Asc(origin.FieldID)).Limit(n).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page := make([]resource, len(ogns))
+
+	for i, o := range ogns {
+		subjects := appendSubjects(nil, o.PoolID)
+
+		if p := o.Edges.Pool; p != nil {
+			subjects = appendSubjects(subjects, p.OwnerID)
+
+			for _, prt := range p.Edges.Ports {
+				subjects = appendSubjects(subjects, prt.LoadBalancerID)
+
+				if lb := prt.Edges.LoadBalancer; lb != nil {
+					subjects = appendSubjects(subjects, lb.LocationID, lb.ProviderID)
+				}
+			}
+		}
+
+		page[i] = resource{id: o.ID, subjects: subjects}
+	}
+
+	return page, nil
+}
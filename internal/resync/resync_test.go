@@ -0,0 +1,207 @@
+package resync_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.infratographer.com/x/events"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/load-balancer-api/internal/resync"
+	"go.infratographer.com/load-balancer-api/internal/snapshot"
+	"go.infratographer.com/load-balancer-api/internal/testutils"
+)
+
+const (
+	ownerPrefix    = "testown"
+	locationPrefix = "testloc"
+)
+
+var errPublish = errors.New("publish failed")
+
+func TestMain(m *testing.M) {
+	// setup the database
+	testutils.SetupDB()
+
+	// run the tests
+	code := m.Run()
+
+	// teardown the database
+	testutils.TeardownDB()
+
+	// return the test response code
+	os.Exit(code)
+}
+
+type published struct {
+	topic string
+	msg   events.ChangeMessage
+}
+
+// recorder is a publisher recording the published change messages, it fails once failAfter
+// messages have been published when set
+type recorder struct {
+	mu        sync.Mutex
+	changes   []published
+	failAfter int
+}
+
+func (r *recorder) PublishChange(_ context.Context, topic string, msg events.ChangeMessage) (events.Message[events.ChangeMessage], error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.failAfter > 0 && len(r.changes) == r.failAfter {
+		return nil, errPublish
+	}
+
+	r.changes = append(r.changes, published{topic: topic, msg: msg})
+
+	return nil, nil
+}
+
+func (r *recorder) PublishEvent(context.Context, string, events.EventMessage) (events.Message[events.EventMessage], error) {
+	return nil, nil
+}
+
+func (r *recorder) subjects() []gidx.PrefixedID {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ids := make([]gidx.PrefixedID, len(r.changes))
+
+	for i, c := range r.changes {
+		ids[i] = c.msg.SubjectID
+	}
+
+	return ids
+}
+
+func TestResync(t *testing.T) {
+	ctx := context.Background()
+
+	ownerID := gidx.MustNewID(ownerPrefix)
+	locationID := gidx.MustNewID(locationPrefix)
+
+	prov := (&testutils.ProviderBuilder{OwnerID: ownerID}).MustNew(ctx)
+	lb := (&testutils.LoadBalancerBuilder{OwnerID: ownerID, LocationID: locationID, Provider: prov}).MustNew(ctx)
+	p := (&testutils.PoolBuilder{OwnerID: ownerID}).MustNew(ctx)
+	prt := (&testutils.PortBuilder{LoadBalancerID: lb.ID, PoolIDs: []gidx.PrefixedID{p.ID}}).MustNew(ctx)
+	o := (&testutils.OriginBuilder{PoolID: p.ID}).MustNew(ctx)
+
+	// a load balancer in the same location owned by someone else
+	otherLB := (&testutils.LoadBalancerBuilder{LocationID: locationID}).MustNew(ctx)
+
+	// deleted resources are not published
+	deletedLB := (&testutils.LoadBalancerBuilder{OwnerID: ownerID, LocationID: locationID, Provider: prov}).MustNew(ctx)
+	testutils.EntClient.LoadBalancer.DeleteOneID(deletedLB.ID).ExecX(ctx)
+
+	t.Run("owner resources are published", func(t *testing.T) {
+		pub := &recorder{}
+
+		res, err := resync.New(testutils.EntClient, pub, resync.WithRate(0)).Run(ctx, resync.Filter{OwnerID: ownerID}, "", 0)
+		require.NoError(t, err)
+
+		assert.Equal(t, 4, res.Published)
+		assert.Empty(t, res.Cursor)
+
+		require.Len(t, pub.changes, 4)
+
+		expected := []struct {
+			topic    string
+			id       gidx.PrefixedID
+			subjects []gidx.PrefixedID
+		}{
+			{"load-balancer", lb.ID, []gidx.PrefixedID{ownerID, locationID, prov.ID}},
+			{"load-balancer-port", prt.ID, []gidx.PrefixedID{lb.ID, locationID, ownerID, prov.ID}},
+			{"load-balancer-pool", p.ID, []gidx.PrefixedID{ownerID, lb.ID, locationID, prov.ID}},
+			{"load-balancer-origin", o.ID, []gidx.PrefixedID{p.ID, ownerID, lb.ID, locationID, prov.ID}},
+		}
+
+		for i, e := range expected {
+			assert.Equal(t, e.topic, pub.changes[i].topic)
+			assert.Equal(t, resync.EventType, pub.changes[i].msg.EventType)
+			assert.Equal(t, e.id, pub.changes[i].msg.SubjectID)
+			assert.ElementsMatch(t, e.subjects, pub.changes[i].msg.AdditionalSubjectIDs)
+		}
+	})
+
+	t.Run("location filter", func(t *testing.T) {
+		pub := &recorder{}
+
+		_, err := resync.New(testutils.EntClient, pub, resync.WithRate(0)).Run(ctx, resync.Filter{LocationID: locationID}, "", 0)
+		require.NoError(t, err)
+
+		subjects := pub.subjects()
+		assert.Contains(t, subjects, lb.ID)
+		assert.Contains(t, subjects, otherLB.ID)
+		assert.Contains(t, subjects, o.ID)
+		assert.NotContains(t, subjects, deletedLB.ID)
+	})
+
+	t.Run("provider filter", func(t *testing.T) {
+		pub := &recorder{}
+
+		_, err := resync.New(testutils.EntClient, pub, resync.WithRate(0)).Run(ctx, resync.Filter{ProviderID: otherLB.ProviderID}, "", 0)
+		require.NoError(t, err)
+
+		assert.Equal(t, []gidx.PrefixedID{otherLB.ID}, pub.subjects())
+	})
+
+	t.Run("limited resyncs are resumed from the cursor", func(t *testing.T) {
+		pub := &recorder{}
+		r := resync.New(testutils.EntClient, pub, resync.WithRate(0), resync.WithBatchSize(1))
+		filter := resync.Filter{OwnerID: ownerID}
+
+		res, err := r.Run(ctx, filter, "", 3)
+		require.NoError(t, err)
+		assert.Equal(t, 3, res.Published)
+		assert.Equal(t, p.ID, res.Cursor)
+
+		res, err = r.Run(ctx, filter, res.Cursor, 3)
+		require.NoError(t, err)
+		assert.Equal(t, 1, res.Published)
+		assert.Empty(t, res.Cursor)
+
+		assert.Equal(t, []gidx.PrefixedID{lb.ID, prt.ID, p.ID, o.ID}, pub.subjects())
+	})
+
+	t.Run("failed resyncs return the cursor", func(t *testing.T) {
+		pub := &recorder{failAfter: 2}
+
+		res, err := resync.New(testutils.EntClient, pub, resync.WithRate(0)).Run(ctx, resync.Filter{OwnerID: ownerID}, "", 0)
+		require.ErrorIs(t, err, errPublish)
+		assert.Equal(t, 2, res.Published)
+		assert.Equal(t, prt.ID, res.Cursor)
+	})
+
+	t.Run("snapshots are embedded", func(t *testing.T) {
+		pub := &recorder{}
+
+		_, err := resync.New(testutils.EntClient, pub, resync.WithRate(0), resync.WithSnapshots(0)).Run(ctx, resync.Filter{OwnerID: ownerID}, "", 1)
+		require.NoError(t, err)
+
+		require.Len(t, pub.changes, 1)
+		assert.Contains(t, pub.changes[0].msg.AdditionalData, snapshot.AdditionalDataKey)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		_, err := resync.New(testutils.EntClient, &recorder{}).Run(ctx, resync.Filter{OwnerID: ownerID}, ownerID, 0)
+		require.ErrorIs(t, err, resync.ErrInvalidCursor)
+	})
+
+	t.Run("rate limited", func(t *testing.T) {
+		pub := &recorder{}
+
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		res, err := resync.New(testutils.EntClient, pub, resync.WithRate(1)).Run(ctx, resync.Filter{OwnerID: ownerID}, "", 0)
+		require.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 0, res.Published)
+	})
+}
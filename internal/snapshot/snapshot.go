@@ -0,0 +1,126 @@
+// Package snapshot embeds the full state of a resource in published change messages, so consumers
+// don't have to query the api for it on every change
+package snapshot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"go.infratographer.com/x/events"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/load-balancer-api/internal/config"
+	ent "go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/ent/generated/loadbalancer"
+	_ "go.infratographer.com/load-balancer-api/internal/ent/generated/runtime" // imports the generated runtime package to register the soft delete hooks and interceptors
+	"go.infratographer.com/load-balancer-api/internal/ent/schema"
+)
+
+const (
+	// AdditionalDataKey is the key of the snapshot in the additional data of change messages
+	AdditionalDataKey = "snapshot"
+	// TruncatedKey is set in the additional data of change messages when the snapshot was
+	// reduced or left out to stay under the size limit
+	TruncatedKey = "snapshot_truncated"
+
+	// DefaultMaxSize is the default maximum size of an encoded snapshot in bytes
+	DefaultMaxSize = 64 * 1024
+)
+
+// ErrUnsupportedID is returned when a snapshot is loaded for an ID that isn't a load balancer,
+// port, pool, origin or provider
+var ErrUnsupportedID = errors.New("snapshots are only available for load balancers, ports, pools, origins and providers")
+
+// Load returns the current state of the resource with the ID. Load balancers are returned with
+// their ports, the pools assigned to them and the origins of those pools.
+func Load(ctx context.Context, client *ent.Client, id gidx.PrefixedID) (any, error) {
+	switch id.Prefix() {
+	case schema.LoadBalancerPrefix:
+		return client.LoadBalancer.Query().
+			Where(loadbalancer.IDEQ(id)).
+			WithPorts(func(q *ent.PortQuery) {
+				q.WithPools(func(q *ent.PoolQuery) {
+					q.WithOrigins()
+				})
+			}).
+			Only(ctx)
+	case schema.PortPrefix:
+		return client.Port.Get(ctx, id)
+	case schema.PoolPrefix:
+		return client.Pool.Get(ctx, id)
+	case schema.OriginPrefix:
+		return client.Origin.Get(ctx, id)
+	case schema.LoadBalancerProviderPrefix:
+		return client.Provider.Get(ctx, id)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedID, id)
+	}
+}
+
+// Attach embeds the snapshot in the additional data of the message. Snapshots larger than maxSize
+// are left out, load balancers are embedded without their ports first. The message is marked as
+// truncated in both cases so consumers know to query the api instead.
+func Attach(msg *events.ChangeMessage, snapshot any, maxSize int) error {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+
+	truncated := false
+
+	if lb, ok := snapshot.(*ent.LoadBalancer); ok && len(data) > maxSize && len(lb.Edges.Ports) != 0 {
+		truncated = true
+
+		shallow := *lb
+		shallow.Edges = ent.LoadBalancerEdges{}
+
+		if data, err = json.Marshal(&shallow); err != nil {
+			return fmt.Errorf("failed to encode snapshot: %w", err)
+		}
+	}
+
+	if msg.AdditionalData == nil {
+		msg.AdditionalData = map[string]interface{}{}
+	}
+
+	if len(data) > maxSize {
+		msg.AdditionalData[TruncatedKey] = true
+
+		return nil
+	}
+
+	msg.AdditionalData[AdditionalDataKey] = json.RawMessage(data)
+
+	if truncated {
+		msg.AdditionalData[TruncatedKey] = true
+	}
+
+	return nil
+}
+
+// AttachChange embeds a snapshot of the subject in the message of the generated event hooks when
+// event snapshots are enabled. When no snapshot is given it is loaded with the client of the
+// mutation, so it includes the changes made in the same transaction.
+func AttachChange(ctx context.Context, c *ent.Client, msg *events.ChangeMessage, v any) error {
+	cfg := config.AppConfig.EventSnapshots
+	if !cfg.Enabled {
+		return nil
+	}
+
+	if v == nil {
+		var err error
+
+		if v, err = Load(ctx, c, msg.SubjectID); err != nil {
+			return fmt.Errorf("failed to load snapshot: %w", err)
+		}
+	}
+
+	maxSize := cfg.MaxSize
+	if maxSize <= 0 {
+		maxSize = DefaultMaxSize
+	}
+
+	return Attach(msg, v, maxSize)
+}
@@ -0,0 +1,114 @@
+package snapshot_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.infratographer.com/x/events"
+	"go.infratographer.com/x/gidx"
+
+	ent "go.infratographer.com/load-balancer-api/internal/ent/generated"
+	"go.infratographer.com/load-balancer-api/internal/snapshot"
+	"go.infratographer.com/load-balancer-api/internal/testutils"
+)
+
+func TestMain(m *testing.M) {
+	// setup the database
+	testutils.SetupDB()
+
+	// run the tests
+	code := m.Run()
+
+	// teardown the database
+	testutils.TeardownDB()
+
+	// return the test response code
+	os.Exit(code)
+}
+
+func TestLoad(t *testing.T) {
+	ctx := context.Background()
+
+	lb := (&testutils.LoadBalancerBuilder{}).MustNew(ctx)
+	p := (&testutils.PoolBuilder{}).MustNew(ctx)
+	prt := (&testutils.PortBuilder{LoadBalancerID: lb.ID, PoolIDs: []gidx.PrefixedID{p.ID}}).MustNew(ctx)
+	o := (&testutils.OriginBuilder{PoolID: p.ID}).MustNew(ctx)
+
+	t.Run("load balancers include their tree", func(t *testing.T) {
+		v, err := snapshot.Load(ctx, testutils.EntClient, lb.ID)
+		require.NoError(t, err)
+
+		got, ok := v.(*ent.LoadBalancer)
+		require.True(t, ok)
+
+		require.Len(t, got.Edges.Ports, 1)
+		assert.Equal(t, prt.ID, got.Edges.Ports[0].ID)
+		require.Len(t, got.Edges.Ports[0].Edges.Pools, 1)
+		assert.Equal(t, p.ID, got.Edges.Ports[0].Edges.Pools[0].ID)
+		require.Len(t, got.Edges.Ports[0].Edges.Pools[0].Edges.Origins, 1)
+		assert.Equal(t, o.ID, got.Edges.Ports[0].Edges.Pools[0].Edges.Origins[0].ID)
+	})
+
+	t.Run("other resources", func(t *testing.T) {
+		for _, id := range []gidx.PrefixedID{prt.ID, p.ID, o.ID, lb.ProviderID} {
+			_, err := snapshot.Load(ctx, testutils.EntClient, id)
+			require.NoError(t, err, id)
+		}
+	})
+
+	t.Run("unsupported id", func(t *testing.T) {
+		_, err := snapshot.Load(ctx, testutils.EntClient, lb.OwnerID)
+		require.ErrorIs(t, err, snapshot.ErrUnsupportedID)
+	})
+}
+
+func TestAttach(t *testing.T) {
+	lb := &ent.LoadBalancer{
+		ID:   gidx.MustNewID("loadbal"),
+		Name: "lb",
+		Edges: ent.LoadBalancerEdges{
+			Ports: []*ent.Port{{ID: gidx.MustNewID("loadprt"), Name: "port", Number: 443}},
+		},
+	}
+
+	full, err := json.Marshal(lb)
+	require.NoError(t, err)
+
+	t.Run("snapshots are embedded", func(t *testing.T) {
+		msg := events.ChangeMessage{}
+
+		require.NoError(t, snapshot.Attach(&msg, lb, len(full)))
+
+		assert.JSONEq(t, string(full), string(msg.AdditionalData[snapshot.AdditionalDataKey].(json.RawMessage)))
+		assert.NotContains(t, msg.AdditionalData, snapshot.TruncatedKey)
+	})
+
+	t.Run("large load balancers are embedded without their tree", func(t *testing.T) {
+		msg := events.ChangeMessage{}
+
+		require.NoError(t, snapshot.Attach(&msg, lb, len(full)-1))
+
+		var got ent.LoadBalancer
+
+		require.NoError(t, json.Unmarshal(msg.AdditionalData[snapshot.AdditionalDataKey].(json.RawMessage), &got))
+		assert.Equal(t, lb.ID, got.ID)
+		assert.Empty(t, got.Edges.Ports)
+		assert.Equal(t, true, msg.AdditionalData[snapshot.TruncatedKey])
+
+		// the original is left untouched
+		assert.Len(t, lb.Edges.Ports, 1)
+	})
+
+	t.Run("snapshots over the limit are left out", func(t *testing.T) {
+		msg := events.ChangeMessage{}
+
+		require.NoError(t, snapshot.Attach(&msg, lb, 10))
+
+		assert.NotContains(t, msg.AdditionalData, snapshot.AdditionalDataKey)
+		assert.Equal(t, true, msg.AdditionalData[snapshot.TruncatedKey])
+	})
+}
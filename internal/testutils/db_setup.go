@@ -17,6 +17,7 @@ import (
 	"go.infratographer.com/x/testing/eventtools"
 
 	"go.infratographer.com/load-balancer-api/db"
+	"go.infratographer.com/load-balancer-api/internal/changefeed"
 	ent "go.infratographer.com/load-balancer-api/internal/ent/generated"
 	"go.infratographer.com/load-balancer-api/x/testcontainersx"
 )
@@ -25,8 +26,11 @@ var (
 	testDBURI   = os.Getenv("LOADBALANCERAPI_TESTDB_URI")
 	NATSConn    *eventtools.TestNats         // NATSConn exported if needed for subscribers
 	EventsConn  events.Connection            // EventsConn exported if needed for subscribers
+	ChangeFeed  *changefeed.Feed             // ChangeFeed receives the changes published by EntClient from NATS
 	EntClient   *ent.Client                  // EntClient to use as ent client
 	DBContainer *testcontainersx.DBContainer // DBContainer to use through entire test suite
+
+	feedEventsConn events.Connection
 )
 
 // SetupDB sets up in-memory nats server/conn, database and ent client to interact with db
@@ -41,6 +45,18 @@ func SetupDB() {
 
 	IfErrPanic("failed to create events connection", err)
 
+	// the feed reads the changes with its own consumer, like every api replica does
+	feedConfig := nats.Config
+	feedConfig.NATS.QueueGroup = "changefeed"
+	feedConfig.NATS.SubscriberDeliveryPolicy = "new"
+
+	feedConn, err := events.NewConnection(feedConfig)
+	IfErrPanic("failed to create change feed events connection", err)
+
+	feed := changefeed.New()
+
+	IfErrPanic("failed to start change feed", feed.Start(ctx, feedConn, changefeed.DefaultTopics...))
+
 	// DB and EntClient setup
 	dia, uri, cntr := ParseDBURI(ctx)
 
@@ -61,6 +77,8 @@ func SetupDB() {
 	}
 
 	EventsConn = conn
+	feedEventsConn = feedConn
+	ChangeFeed = feed
 	EntClient = c
 	DBContainer = cntr
 	NATSConn = nats
@@ -79,6 +97,7 @@ func TeardownDB() {
 	}
 
 	_ = EventsConn.Shutdown(ctx)
+	_ = feedEventsConn.Shutdown(ctx)
 
 	NATSConn.Close()
 }
@@ -0,0 +1,20 @@
+package worker
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNoTopics is returned when the worker is run without any topics to subscribe to
+	ErrNoTopics = errors.New("no change or event topics configured")
+
+	// ErrPermanent marks handler errors that will not succeed on a retry, messages failing
+	// with it are terminated instead of being redelivered
+	ErrPermanent = errors.New("permanent failure")
+)
+
+// Permanent wraps err so the message being handled is not retried
+func Permanent(err error) error {
+	return fmt.Errorf("%w: %w", ErrPermanent, err)
+}
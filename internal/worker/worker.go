@@ -0,0 +1,258 @@
+// Package worker consumes the change and event messages published by other services and
+// dispatches them to handlers
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.infratographer.com/x/events"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultMaxDeliveries is how often a message is delivered before it is given up on
+	DefaultMaxDeliveries = 5
+	// DefaultRetryDelay is the delay before the first redelivery of a failed message, later
+	// redeliveries are delayed by a multiple of it
+	DefaultRetryDelay = 5 * time.Second
+)
+
+// ChangeHandler handles a change message received on one of the change topics. Handlers are
+// called for every message and must ignore the ones they are not interested in. Messages are
+// delivered at least once, so handlers must be idempotent.
+type ChangeHandler func(ctx context.Context, msg events.ChangeMessage) error
+
+// EventHandler handles an event message received on one of the event topics. Handlers are
+// called for every message and must ignore the ones they are not interested in. Messages are
+// delivered at least once, so handlers must be idempotent.
+type EventHandler func(ctx context.Context, msg events.EventMessage) error
+
+// Worker subscribes to change and event topics and passes the received messages to its handlers.
+// Messages are acked once all handlers succeeded and redelivered with a backoff when one of them
+// failed, unless the failure is permanent or the message was delivered too often.
+type Worker struct {
+	conn           events.Connection
+	logger         *zap.SugaredLogger
+	changeTopics   []string
+	eventTopics    []string
+	changeHandlers []ChangeHandler
+	eventHandlers  []EventHandler
+	maxDeliveries  uint64
+	retryDelay     time.Duration
+}
+
+// Option is a functional configuration option for the Worker
+type Option func(w *Worker)
+
+// WithLogger sets the logger for the worker
+func WithLogger(l *zap.SugaredLogger) Option {
+	return func(w *Worker) {
+		w.logger = l
+	}
+}
+
+// WithChangeTopics adds topics to subscribe to for change messages
+func WithChangeTopics(topics ...string) Option {
+	return func(w *Worker) {
+		w.changeTopics = append(w.changeTopics, topics...)
+	}
+}
+
+// WithEventTopics adds topics to subscribe to for event messages
+func WithEventTopics(topics ...string) Option {
+	return func(w *Worker) {
+		w.eventTopics = append(w.eventTopics, topics...)
+	}
+}
+
+// WithChangeHandler adds a handler for change messages
+func WithChangeHandler(h ChangeHandler) Option {
+	return func(w *Worker) {
+		w.changeHandlers = append(w.changeHandlers, h)
+	}
+}
+
+// WithEventHandler adds a handler for event messages
+func WithEventHandler(h EventHandler) Option {
+	return func(w *Worker) {
+		w.eventHandlers = append(w.eventHandlers, h)
+	}
+}
+
+// WithMaxDeliveries sets how often a message is delivered before it is given up on
+func WithMaxDeliveries(n int) Option {
+	return func(w *Worker) {
+		if n > 0 {
+			w.maxDeliveries = uint64(n)
+		}
+	}
+}
+
+// WithRetryDelay sets the delay before the first redelivery of a failed message
+func WithRetryDelay(d time.Duration) Option {
+	return func(w *Worker) {
+		if d > 0 {
+			w.retryDelay = d
+		}
+	}
+}
+
+// New returns a new Worker receiving messages from conn
+func New(conn events.Connection, opts ...Option) *Worker {
+	w := &Worker{
+		conn:          conn,
+		logger:        zap.NewNop().Sugar(),
+		maxDeliveries: DefaultMaxDeliveries,
+		retryDelay:    DefaultRetryDelay,
+	}
+
+	for _, opt := range opts {
+		opt(w)
+	}
+
+	return w
+}
+
+// Run subscribes to the configured topics and handles messages until ctx is done. Messages being
+// handled when ctx is done are finished before Run returns.
+func (w *Worker) Run(ctx context.Context) error {
+	if len(w.changeTopics) == 0 && len(w.eventTopics) == 0 {
+		return ErrNoTopics
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+
+	// stop the subscriptions already started when a later one fails
+	fail := func(err error) error {
+		cancel()
+		wg.Wait()
+
+		return err
+	}
+
+	for _, topic := range w.changeTopics {
+		msgs, err := w.conn.SubscribeChanges(ctx, topic)
+		if err != nil {
+			return fail(fmt.Errorf("subscribing to change topic %s: %w", topic, err))
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			consume(ctx, w, msgs, w.handleChange)
+		}()
+	}
+
+	for _, topic := range w.eventTopics {
+		msgs, err := w.conn.SubscribeEvents(ctx, topic)
+		if err != nil {
+			return fail(fmt.Errorf("subscribing to event topic %s: %w", topic, err))
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			consume(ctx, w, msgs, w.handleEvent)
+		}()
+	}
+
+	w.logger.Infow("worker started", "change-topics", w.changeTopics, "event-topics", w.eventTopics)
+
+	wg.Wait()
+
+	w.logger.Info("worker stopped")
+
+	return nil
+}
+
+func (w *Worker) handleChange(ctx context.Context, msg events.ChangeMessage) error {
+	var errs []error
+
+	for _, h := range w.changeHandlers {
+		errs = append(errs, h(ctx, msg))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (w *Worker) handleEvent(ctx context.Context, msg events.EventMessage) error {
+	var errs []error
+
+	for _, h := range w.eventHandlers {
+		errs = append(errs, h(ctx, msg))
+	}
+
+	return errors.Join(errs...)
+}
+
+// consume handles the messages received on a subscription until ctx is done
+func consume[T any](ctx context.Context, w *Worker, msgs <-chan events.Message[T], handle func(context.Context, T) error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-msgs:
+			if !ok {
+				return
+			}
+
+			// a message is finished on shutdown, so it isn't handled again after being redelivered
+			process(context.WithoutCancel(ctx), w, msg, handle)
+		}
+	}
+}
+
+// process handles a single message and acknowledges it according to the outcome
+func process[T any](ctx context.Context, w *Worker, msg events.Message[T], handle func(context.Context, T) error) {
+	logger := w.logger.With("topic", msg.Topic(), "message-id", msg.ID(), "deliveries", msg.Deliveries())
+
+	var ackErr error
+
+	if err := msg.Error(); err != nil {
+		logger.Errorw("terminating message that could not be decoded", "error", err)
+
+		if err := msg.Term(); err != nil {
+			logger.Warnw("failed to terminate message", "error", err)
+		}
+
+		return
+	}
+
+	err := handle(ctx, msg.Message())
+
+	switch {
+	case err == nil:
+		logger.Debug("message handled")
+
+		ackErr = msg.Ack()
+	case errors.Is(err, ErrPermanent):
+		logger.Errorw("terminating message after a permanent failure", "error", err)
+
+		ackErr = msg.Term()
+	case msg.Deliveries() >= w.maxDeliveries:
+		logger.Errorw("terminating message after reaching the maximum deliveries", "error", err)
+
+		ackErr = msg.Term()
+	default:
+		delay := w.retryDelay * time.Duration(msg.Deliveries())
+
+		logger.Warnw("failed to handle message, retrying", "error", err, "delay", delay)
+
+		ackErr = msg.Nak(delay)
+	}
+
+	if ackErr != nil {
+		logger.Warnw("failed to acknowledge message", "error", ackErr)
+	}
+}
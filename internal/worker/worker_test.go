@@ -0,0 +1,277 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.infratographer.com/x/events"
+	"go.infratographer.com/x/gidx"
+	"go.infratographer.com/x/testing/eventtools"
+
+	"go.infratographer.com/load-balancer-api/internal/testutils"
+	"go.infratographer.com/load-balancer-api/internal/worker"
+)
+
+const (
+	waitTimeout = 5 * time.Second
+	retryDelay  = 10 * time.Millisecond
+)
+
+var errTest = errors.New("test failure")
+
+func TestMain(m *testing.M) {
+	// setup the database
+	testutils.SetupDB()
+
+	// run the tests
+	code := m.Run()
+
+	// teardown the database
+	testutils.TeardownDB()
+
+	// return the test response code
+	os.Exit(code)
+}
+
+// runWorker runs a worker subscribed to a new change topic and returns the topic
+func runWorker(t *testing.T, opts ...worker.Option) string {
+	t.Helper()
+
+	topic := gidx.MustNewID("testtpc").String()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+
+	// change subjects are made up of the event type and the topic
+	opts = append(opts, worker.WithChangeTopics("*."+topic), worker.WithRetryDelay(retryDelay))
+
+	go func() {
+		done <- worker.New(testutils.EventsConn, opts...).Run(ctx)
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-done)
+	})
+
+	return topic
+}
+
+func publishChange(t *testing.T, topic string) events.ChangeMessage {
+	t.Helper()
+
+	msg := events.ChangeMessage{
+		SubjectID: gidx.MustNewID("testsub"),
+		EventType: "delete",
+		Timestamp: time.Now().UTC(),
+	}
+
+	_, err := testutils.EventsConn.PublishChange(context.Background(), topic, msg)
+	require.NoError(t, err)
+
+	return msg
+}
+
+func TestWorker(t *testing.T) {
+	t.Run("handled messages are acked", func(t *testing.T) {
+		var calls atomic.Int32
+
+		received := make(chan events.ChangeMessage, 10)
+
+		topic := runWorker(t, worker.WithChangeHandler(func(_ context.Context, msg events.ChangeMessage) error {
+			calls.Add(1)
+			received <- msg
+
+			return nil
+		}))
+
+		msg := publishChange(t, topic)
+
+		select {
+		case got := <-received:
+			assert.Equal(t, msg.SubjectID, got.SubjectID)
+			assert.Equal(t, msg.EventType, got.EventType)
+		case <-time.After(waitTimeout):
+			require.FailNow(t, "message not handled")
+		}
+
+		// an acked message is not redelivered
+		time.Sleep(10 * retryDelay)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("all handlers are called", func(t *testing.T) {
+		first := make(chan struct{}, 10)
+		second := make(chan struct{}, 10)
+
+		topic := runWorker(t,
+			worker.WithChangeHandler(func(context.Context, events.ChangeMessage) error {
+				first <- struct{}{}
+				return nil
+			}),
+			worker.WithChangeHandler(func(context.Context, events.ChangeMessage) error {
+				second <- struct{}{}
+				return nil
+			}),
+		)
+
+		publishChange(t, topic)
+
+		for _, ch := range []chan struct{}{first, second} {
+			select {
+			case <-ch:
+			case <-time.After(waitTimeout):
+				require.FailNow(t, "message not handled by all handlers")
+			}
+		}
+	})
+
+	t.Run("failed messages are retried", func(t *testing.T) {
+		var calls atomic.Int32
+
+		handled := make(chan struct{})
+
+		topic := runWorker(t, worker.WithChangeHandler(func(context.Context, events.ChangeMessage) error {
+			if calls.Add(1) < 3 {
+				return errTest
+			}
+
+			close(handled)
+
+			return nil
+		}))
+
+		publishChange(t, topic)
+
+		select {
+		case <-handled:
+		case <-time.After(waitTimeout):
+			require.FailNow(t, "message not retried")
+		}
+
+		time.Sleep(10 * retryDelay)
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("messages are given up on after the maximum deliveries", func(t *testing.T) {
+		var calls atomic.Int32
+
+		topic := runWorker(t,
+			worker.WithMaxDeliveries(2),
+			worker.WithChangeHandler(func(context.Context, events.ChangeMessage) error {
+				calls.Add(1)
+				return errTest
+			}),
+		)
+
+		publishChange(t, topic)
+
+		require.Eventually(t, func() bool { return calls.Load() == 2 }, waitTimeout, retryDelay)
+
+		time.Sleep(20 * retryDelay)
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("permanent failures are not retried", func(t *testing.T) {
+		var calls atomic.Int32
+
+		topic := runWorker(t, worker.WithChangeHandler(func(context.Context, events.ChangeMessage) error {
+			calls.Add(1)
+			return worker.Permanent(errTest)
+		}))
+
+		publishChange(t, topic)
+
+		require.Eventually(t, func() bool { return calls.Load() == 1 }, waitTimeout, retryDelay)
+
+		time.Sleep(10 * retryDelay)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("undecodable messages are not handled", func(t *testing.T) {
+		var calls atomic.Int32
+
+		topic := runWorker(t, worker.WithChangeHandler(func(context.Context, events.ChangeMessage) error {
+			calls.Add(1)
+			return nil
+		}))
+
+		_, err := testutils.NATSConn.JetStream.Publish(eventtools.Prefix+".changes.delete."+topic, []byte("not json"))
+		require.NoError(t, err)
+
+		msg := publishChange(t, topic)
+
+		// messages are handled in order, the valid one is handled after the broken one was terminated
+		require.Eventually(t, func() bool { return calls.Load() == 1 }, waitTimeout, retryDelay, msg.SubjectID)
+
+		time.Sleep(10 * retryDelay)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+}
+
+func TestWorker_Shutdown(t *testing.T) {
+	topic := gidx.MustNewID("testtpc").String()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	var liveCtx atomic.Bool
+
+	w := worker.New(testutils.EventsConn,
+		worker.WithChangeTopics("*."+topic),
+		worker.WithChangeHandler(func(ctx context.Context, _ events.ChangeMessage) error {
+			close(started)
+			<-release
+
+			// the message is finished with a live context after shutdown was requested
+			liveCtx.Store(ctx.Err() == nil)
+
+			return nil
+		}),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+
+	go func() {
+		done <- w.Run(ctx)
+	}()
+
+	publishChange(t, topic)
+
+	select {
+	case <-started:
+	case <-time.After(waitTimeout):
+		require.FailNow(t, "message not handled")
+	}
+
+	cancel()
+
+	select {
+	case <-done:
+		require.FailNow(t, "worker stopped before the message was handled")
+	case <-time.After(10 * retryDelay):
+	}
+
+	close(release)
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(waitTimeout):
+		require.FailNow(t, "worker did not stop")
+	}
+
+	assert.True(t, liveCtx.Load())
+}
+
+func TestWorker_NoTopics(t *testing.T) {
+	err := worker.New(testutils.EventsConn).Run(context.Background())
+	assert.ErrorIs(t, err, worker.ErrNoTopics)
+}
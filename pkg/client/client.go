@@ -2,6 +2,8 @@ package client
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -12,12 +14,14 @@ import (
 // GQLClient is an interface for a graphql client
 type GQLClient interface {
 	Query(tx context.Context, q interface{}, variables map[string]interface{}, options ...graphql.Option) error
+	Mutate(ctx context.Context, m interface{}, variables map[string]interface{}, options ...graphql.Option) error
 }
 
 // Client creates a new lb api client against a specific endpoint
 type Client struct {
 	gqlCli     GQLClient
 	httpClient *http.Client
+	pageSize   int
 }
 
 // Option is a function that modifies a client
@@ -27,6 +31,7 @@ type Option func(*Client)
 func NewClient(url string, opts ...Option) *Client {
 	c := &Client{
 		httpClient: http.DefaultClient,
+		pageSize:   DefaultPageSize,
 	}
 
 	for _, opt := range opts {
@@ -45,6 +50,15 @@ func WithHTTPClient(cli *http.Client) Option {
 	}
 }
 
+// WithPageSize functional option to set the number of nodes requested per page by list iterators
+func WithPageSize(size int) Option {
+	return func(c *Client) {
+		if size > 0 {
+			c.pageSize = size
+		}
+	}
+}
+
 // GetLoadBalancer returns a load balancer by id
 func (c Client) GetLoadBalancer(ctx context.Context, id string) (*LoadBalancer, error) {
 	_, err := gidx.Parse(id)
@@ -58,7 +72,7 @@ func (c Client) GetLoadBalancer(ctx context.Context, id string) (*LoadBalancer,
 
 	var q GetLoadBalancer
 	if err := c.gqlCli.Query(ctx, &q, vars); err != nil {
-		return nil, translateGQLErr(err)
+		return nil, translateLoadBalancerErr(err)
 	}
 
 	return &q.LoadBalancer, nil
@@ -106,10 +120,347 @@ func (c Client) NodeMetadata(ctx context.Context, id string) (*Metadata, error)
 	return &q.MetadataNode.Metadata, nil
 }
 
+// CreateLoadBalancer creates a load balancer
+func (c Client) CreateLoadBalancer(ctx context.Context, input CreateLoadBalancerInput) (*LoadBalancerNode, error) {
+	for _, id := range []string{input.OwnerID, input.LocationID, input.ProviderID} {
+		if _, err := gidx.Parse(id); err != nil {
+			return nil, err
+		}
+	}
+
+	vars := map[string]interface{}{
+		"input": input,
+	}
+
+	var m CreateLoadBalancer
+	if err := c.gqlCli.Mutate(ctx, &m, vars); err != nil {
+		return nil, translateGQLErr(err)
+	}
+
+	return &m.LoadBalancerCreate.LoadBalancer, nil
+}
+
+// UpdateLoadBalancer updates a load balancer by id
+func (c Client) UpdateLoadBalancer(ctx context.Context, id string, input UpdateLoadBalancerInput) (*LoadBalancerNode, error) {
+	vars, err := idVars(id)
+	if err != nil {
+		return nil, err
+	}
+
+	vars["input"] = input
+
+	var m UpdateLoadBalancer
+	if err := c.gqlCli.Mutate(ctx, &m, vars); err != nil {
+		return nil, translateLoadBalancerErr(err)
+	}
+
+	return &m.LoadBalancerUpdate.LoadBalancer, nil
+}
+
+// DeleteLoadBalancer deletes a load balancer by id
+func (c Client) DeleteLoadBalancer(ctx context.Context, id string) error {
+	vars, err := idVars(id)
+	if err != nil {
+		return err
+	}
+
+	var m DeleteLoadBalancer
+	if err := c.gqlCli.Mutate(ctx, &m, vars); err != nil {
+		return translateLoadBalancerErr(err)
+	}
+
+	return nil
+}
+
+// CreatePort creates a port on a load balancer
+func (c Client) CreatePort(ctx context.Context, input CreateLoadBalancerPortInput) (*PortNode, error) {
+	if _, err := gidx.Parse(input.LoadBalancerID); err != nil {
+		return nil, err
+	}
+
+	vars := map[string]interface{}{
+		"input": input,
+	}
+
+	var m CreatePort
+	if err := c.gqlCli.Mutate(ctx, &m, vars); err != nil {
+		return nil, translateGQLErr(err)
+	}
+
+	return &m.LoadBalancerPortCreate.LoadBalancerPort, nil
+}
+
+// UpdatePort updates a port by id
+func (c Client) UpdatePort(ctx context.Context, id string, input UpdateLoadBalancerPortInput) (*PortNode, error) {
+	vars, err := idVars(id)
+	if err != nil {
+		return nil, err
+	}
+
+	vars["input"] = input
+
+	var m UpdatePort
+	if err := c.gqlCli.Mutate(ctx, &m, vars); err != nil {
+		return nil, translateGQLErr(err)
+	}
+
+	return &m.LoadBalancerPortUpdate.LoadBalancerPort, nil
+}
+
+// DeletePort deletes a port by id
+func (c Client) DeletePort(ctx context.Context, id string) error {
+	vars, err := idVars(id)
+	if err != nil {
+		return err
+	}
+
+	var m DeletePort
+	if err := c.gqlCli.Mutate(ctx, &m, vars); err != nil {
+		return translateGQLErr(err)
+	}
+
+	return nil
+}
+
+// CreatePool creates a pool
+func (c Client) CreatePool(ctx context.Context, input CreateLoadBalancerPoolInput) (*Pool, error) {
+	if _, err := gidx.Parse(input.OwnerID); err != nil {
+		return nil, err
+	}
+
+	vars := map[string]interface{}{
+		"input": input,
+	}
+
+	var m CreatePool
+	if err := c.gqlCli.Mutate(ctx, &m, vars); err != nil {
+		return nil, translateGQLErr(err)
+	}
+
+	return &m.LoadBalancerPoolCreate.LoadBalancerPool, nil
+}
+
+// UpdatePool updates a pool by id
+func (c Client) UpdatePool(ctx context.Context, id string, input UpdateLoadBalancerPoolInput) (*Pool, error) {
+	vars, err := idVars(id)
+	if err != nil {
+		return nil, err
+	}
+
+	vars["input"] = input
+
+	var m UpdatePool
+	if err := c.gqlCli.Mutate(ctx, &m, vars); err != nil {
+		return nil, translateGQLErr(err)
+	}
+
+	return &m.LoadBalancerPoolUpdate.LoadBalancerPool, nil
+}
+
+// DeletePool deletes a pool by id
+func (c Client) DeletePool(ctx context.Context, id string) error {
+	vars, err := idVars(id)
+	if err != nil {
+		return err
+	}
+
+	var m DeletePool
+	if err := c.gqlCli.Mutate(ctx, &m, vars); err != nil {
+		return translateGQLErr(err)
+	}
+
+	return nil
+}
+
+// CreateOrigin creates an origin in a pool
+func (c Client) CreateOrigin(ctx context.Context, input CreateLoadBalancerOriginInput) (*OriginNode, error) {
+	if _, err := gidx.Parse(input.PoolID); err != nil {
+		return nil, err
+	}
+
+	vars := map[string]interface{}{
+		"input": input,
+	}
+
+	var m CreateOrigin
+	if err := c.gqlCli.Mutate(ctx, &m, vars); err != nil {
+		return nil, translateGQLErr(err)
+	}
+
+	return &m.LoadBalancerOriginCreate.LoadBalancerOrigin, nil
+}
+
+// UpdateOrigin updates an origin by id
+func (c Client) UpdateOrigin(ctx context.Context, id string, input UpdateLoadBalancerOriginInput) (*OriginNode, error) {
+	vars, err := idVars(id)
+	if err != nil {
+		return nil, err
+	}
+
+	vars["input"] = input
+
+	var m UpdateOrigin
+	if err := c.gqlCli.Mutate(ctx, &m, vars); err != nil {
+		return nil, translateGQLErr(err)
+	}
+
+	return &m.LoadBalancerOriginUpdate.LoadBalancerOrigin, nil
+}
+
+// DeleteOrigin deletes an origin by id
+func (c Client) DeleteOrigin(ctx context.Context, id string) error {
+	vars, err := idVars(id)
+	if err != nil {
+		return err
+	}
+
+	var m DeleteOrigin
+	if err := c.gqlCli.Mutate(ctx, &m, vars); err != nil {
+		return translateGQLErr(err)
+	}
+
+	return nil
+}
+
+// ListLoadBalancersByOwner returns an iterator over the load balancers of an owner
+func (c Client) ListLoadBalancersByOwner(ctx context.Context, ownerID string) (*Iterator[LoadBalancerNode], error) {
+	vars, err := idVars(ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return newIterator(c.pageSize, func(ctx context.Context, first int, after *Cursor) ([]LoadBalancerNode, PageInfo, error) {
+		var q ListOwnerLoadBalancers
+		if err := c.gqlCli.Query(ctx, &q, pageVars(vars, first, after)); err != nil {
+			return nil, PageInfo{}, translateGQLErr(err)
+		}
+
+		if len(q.Entities) == 0 {
+			return nil, PageInfo{}, nil
+		}
+
+		return loadBalancerNodes(q.Entities[0].ResourceOwner.LoadBalancers)
+	}), nil
+}
+
+// ListLoadBalancersByLocation returns an iterator over the load balancers in a location
+func (c Client) ListLoadBalancersByLocation(ctx context.Context, locationID string) (*Iterator[LoadBalancerNode], error) {
+	vars, err := idVars(locationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return newIterator(c.pageSize, func(ctx context.Context, first int, after *Cursor) ([]LoadBalancerNode, PageInfo, error) {
+		var q ListLocationLoadBalancers
+		if err := c.gqlCli.Query(ctx, &q, pageVars(vars, first, after)); err != nil {
+			return nil, PageInfo{}, translateGQLErr(err)
+		}
+
+		if len(q.Entities) == 0 {
+			return nil, PageInfo{}, nil
+		}
+
+		return loadBalancerNodes(q.Entities[0].Location.LoadBalancers)
+	}), nil
+}
+
+// ListPoolsByOwner returns an iterator over the pools of an owner
+func (c Client) ListPoolsByOwner(ctx context.Context, ownerID string) (*Iterator[Pool], error) {
+	vars, err := idVars(ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return newIterator(c.pageSize, func(ctx context.Context, first int, after *Cursor) ([]Pool, PageInfo, error) {
+		var q ListOwnerPools
+		if err := c.gqlCli.Query(ctx, &q, pageVars(vars, first, after)); err != nil {
+			return nil, PageInfo{}, translateGQLErr(err)
+		}
+
+		if len(q.Entities) == 0 {
+			return nil, PageInfo{}, nil
+		}
+
+		conn := q.Entities[0].ResourceOwner.LoadBalancerPools
+
+		pools := make([]Pool, 0, len(conn.Edges))
+		for _, edge := range conn.Edges {
+			pools = append(pools, edge.Node)
+		}
+
+		return pools, conn.PageInfo, nil
+	}), nil
+}
+
+func loadBalancerNodes(conn LoadBalancerConnection) ([]LoadBalancerNode, PageInfo, error) {
+	lbs := make([]LoadBalancerNode, 0, len(conn.Edges))
+	for _, edge := range conn.Edges {
+		lbs = append(lbs, edge.Node)
+	}
+
+	return lbs, conn.PageInfo, nil
+}
+
+// idVars validates id and returns the query variables for it
+func idVars(id string) (map[string]interface{}, error) {
+	if _, err := gidx.Parse(id); err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"id": graphql.ID(id),
+	}, nil
+}
+
+// pageVars returns a copy of vars with the pagination variables set
+func pageVars(vars map[string]interface{}, first int, after *Cursor) map[string]interface{} {
+	out := make(map[string]interface{}, len(vars)+2) //nolint:gomnd // first and after
+
+	for k, v := range vars {
+		out[k] = v
+	}
+
+	out["first"] = first
+	out["after"] = after
+
+	return out
+}
+
+// Error codes set by the server in the extensions of a GraphQL error
+const (
+	errCodeNotFound         = "NOT_FOUND"
+	errCodeValidation       = "VALIDATION"
+	errCodePermissionDenied = "PERMISSION_DENIED"
+	errCodeQuotaExceeded    = "QUOTA_EXCEEDED"
+	errCodeConflict         = "CONFLICT"
+	errCodeInternal         = "INTERNAL"
+)
+
 func translateGQLErr(err error) error {
+	var gqlErrs graphql.Errors
+	if errors.As(err, &gqlErrs) && len(gqlErrs) > 0 {
+		gqlErr := gqlErrs[0]
+		code, _ := gqlErr.Extensions["code"].(string)
+
+		switch code {
+		case errCodeNotFound:
+			return fmt.Errorf("%w: %s", ErrNotFound, gqlErr.Message)
+		case errCodeValidation:
+			return fmt.Errorf("%w: %s", ErrValidation, gqlErr.Message)
+		case errCodeConflict:
+			return fmt.Errorf("%w: %s", ErrConflict, gqlErr.Message)
+		case errCodeQuotaExceeded:
+			return fmt.Errorf("%w: %s", ErrQuotaExceeded, gqlErr.Message)
+		case errCodePermissionDenied:
+			return ErrPermissionDenied
+		case errCodeInternal:
+			return ErrInternalServerError
+		}
+	}
+
+	// errors returned before the request reaches the graph, such as by the
+	// auth middleware, are not GraphQL errors and carry no code
 	switch {
-	case strings.Contains(err.Error(), "load_balancer not found"):
-		return ErrLBNotfound
 	case strings.Contains(err.Error(), "invalid or expired jwt"):
 		return ErrUnauthorized
 	case strings.Contains(err.Error(), "subject doesn't have access"):
@@ -120,3 +471,14 @@ func translateGQLErr(err error) error {
 
 	return err
 }
+
+// translateLoadBalancerErr translates the error of a load balancer request,
+// reporting a missing load balancer as ErrLBNotfound
+func translateLoadBalancerErr(err error) error {
+	err = translateGQLErr(err)
+	if errors.Is(err, ErrNotFound) {
+		return fmt.Errorf("%w: %w", ErrLBNotfound, err)
+	}
+
+	return err
+}
@@ -2,6 +2,8 @@ package client
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -183,15 +185,16 @@ func TestGetLoadBalancer(t *testing.T) {
 
 	t.Run("not found", func(t *testing.T) {
 		respJSON := `{
-			"data": null
+			"data": null,
 			"errors": [
 				{
-					"message": "load_balancer not found"
+					"message": "load_balancer not found",
+					"extensions": {"code": "NOT_FOUND"}
 				}
 			]
 		}`
 
-		cli.gqlCli = mustNewGQLTestClient(respJSON, http.StatusUnauthorized)
+		cli.gqlCli = mustNewGQLTestClient(respJSON, http.StatusOK)
 
 		lb, err := cli.GetLoadBalancer(context.Background(), "loadbal-randovalue")
 		require.Nil(t, lb)
@@ -275,6 +278,456 @@ func TestNodeMetadata(t *testing.T) {
 	})
 }
 
+func TestLoadBalancerMutations(t *testing.T) {
+	lbJSON := `{
+		"id": "loadbal-testing",
+		"name": "some lb",
+		"owner": {"id": "testown-testing"},
+		"location": {"id": "lctnloc-testing"},
+		"loadBalancerProvider": {"id": "loadpvd-testing"}
+	}`
+
+	t.Run("create", func(t *testing.T) {
+		var req gqlRequest
+
+		cli := Client{gqlCli: mustNewGQLTestServer(t, func(r gqlRequest) string {
+			req = r
+
+			return `{"data": {"loadBalancerCreate": {"loadBalancer": ` + lbJSON + `}}}`
+		})}
+
+		lb, err := cli.CreateLoadBalancer(context.Background(), CreateLoadBalancerInput{
+			Name:       "some lb",
+			OwnerID:    "testown-testing",
+			LocationID: "lctnloc-testing",
+			ProviderID: "loadpvd-testing",
+		})
+		require.NoError(t, err)
+		require.NotNil(t, lb)
+
+		assert.Contains(t, req.Query, "$input:CreateLoadBalancerInput!")
+		assert.Contains(t, req.Query, "loadBalancerCreate(input: $input)")
+		assert.JSONEq(t, `{"name": "some lb", "ownerID": "testown-testing", "locationID": "lctnloc-testing", "providerID": "loadpvd-testing"}`, string(req.Variables["input"]))
+
+		assert.Equal(t, "loadbal-testing", lb.ID)
+		assert.Equal(t, "some lb", lb.Name)
+		assert.Equal(t, "testown-testing", lb.Owner.ID)
+		assert.Equal(t, "lctnloc-testing", lb.Location.ID)
+		assert.Equal(t, "loadpvd-testing", lb.Provider.ID)
+	})
+
+	t.Run("create with idempotency key", func(t *testing.T) {
+		var req gqlRequest
+
+		cli := Client{gqlCli: mustNewGQLTestServer(t, func(r gqlRequest) string {
+			req = r
+
+			return `{"data": {"loadBalancerCreate": {"loadBalancer": ` + lbJSON + `}}}`
+		})}
+
+		key := "create-some-lb"
+
+		_, err := cli.CreateLoadBalancer(context.Background(), CreateLoadBalancerInput{
+			Name:           "some lb",
+			OwnerID:        "testown-testing",
+			LocationID:     "lctnloc-testing",
+			ProviderID:     "loadpvd-testing",
+			IdempotencyKey: &key,
+		})
+		require.NoError(t, err)
+
+		assert.JSONEq(t, `{"name": "some lb", "ownerID": "testown-testing", "locationID": "lctnloc-testing", "providerID": "loadpvd-testing", "idempotencyKey": "create-some-lb"}`, string(req.Variables["input"]))
+	})
+
+	t.Run("create with invalid owner", func(t *testing.T) {
+		cli := Client{}
+
+		lb, err := cli.CreateLoadBalancer(context.Background(), CreateLoadBalancerInput{
+			Name:       "some lb",
+			OwnerID:    "bad",
+			LocationID: "lctnloc-testing",
+			ProviderID: "loadpvd-testing",
+		})
+		require.Error(t, err)
+		assert.Nil(t, lb)
+		assert.ErrorContains(t, err, "invalid id")
+	})
+
+	t.Run("update", func(t *testing.T) {
+		var req gqlRequest
+
+		cli := Client{gqlCli: mustNewGQLTestServer(t, func(r gqlRequest) string {
+			req = r
+
+			return `{"data": {"loadBalancerUpdate": {"loadBalancer": ` + lbJSON + `}}}`
+		})}
+
+		name := "some lb"
+
+		lb, err := cli.UpdateLoadBalancer(context.Background(), "loadbal-testing", UpdateLoadBalancerInput{Name: &name})
+		require.NoError(t, err)
+		require.NotNil(t, lb)
+
+		assert.Contains(t, req.Query, "loadBalancerUpdate(id: $id, input: $input)")
+		assert.JSONEq(t, `"loadbal-testing"`, string(req.Variables["id"]))
+		assert.JSONEq(t, `{"name": "some lb"}`, string(req.Variables["input"]))
+		assert.Equal(t, "some lb", lb.Name)
+	})
+
+	t.Run("update bad prefix", func(t *testing.T) {
+		cli := Client{}
+
+		lb, err := cli.UpdateLoadBalancer(context.Background(), "badprefix-test", UpdateLoadBalancerInput{})
+		require.Error(t, err)
+		assert.Nil(t, lb)
+		assert.ErrorContains(t, err, "invalid id")
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		var req gqlRequest
+
+		cli := Client{gqlCli: mustNewGQLTestServer(t, func(r gqlRequest) string {
+			req = r
+
+			return `{"data": {"loadBalancerDelete": {"deletedID": "loadbal-testing"}}}`
+		})}
+
+		err := cli.DeleteLoadBalancer(context.Background(), "loadbal-testing")
+		require.NoError(t, err)
+
+		assert.Contains(t, req.Query, "loadBalancerDelete(id: $id)")
+	})
+
+	t.Run("delete not found", func(t *testing.T) {
+		cli := Client{gqlCli: mustNewGQLTestServer(t, func(gqlRequest) string {
+			return `{"data": null, "errors": [{"message": "load_balancer not found", "extensions": {"code": "NOT_FOUND"}}]}`
+		})}
+
+		err := cli.DeleteLoadBalancer(context.Background(), "loadbal-testing")
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrLBNotfound)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestPortMutations(t *testing.T) {
+	portJSON := `{
+		"id": "loadprt-testing",
+		"name": "http",
+		"number": 80,
+		"pools": [{"id": "loadpol-testing", "name": "pooly", "protocol": "tcp", "origins": {"edges": []}}]
+	}`
+
+	var req gqlRequest
+
+	cli := Client{gqlCli: mustNewGQLTestServer(t, func(r gqlRequest) string {
+		req = r
+
+		switch {
+		case r.Variables["input"] == nil:
+			return `{"data": {"loadBalancerPortDelete": {"deletedID": "loadprt-testing"}}}`
+		case r.Variables["id"] == nil:
+			return `{"data": {"loadBalancerPortCreate": {"loadBalancerPort": ` + portJSON + `}}}`
+		default:
+			return `{"data": {"loadBalancerPortUpdate": {"loadBalancerPort": ` + portJSON + `}}}`
+		}
+	})}
+
+	name := "http"
+
+	port, err := cli.CreatePort(context.Background(), CreateLoadBalancerPortInput{
+		Number:         80,
+		Name:           &name,
+		LoadBalancerID: "loadbal-testing",
+		PoolIDs:        []string{"loadpol-testing"},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, port)
+
+	assert.Contains(t, req.Query, "$input:CreateLoadBalancerPortInput!")
+	assert.JSONEq(t, `{"number": 80, "name": "http", "loadBalancerID": "loadbal-testing", "poolIDs": ["loadpol-testing"]}`, string(req.Variables["input"]))
+	assert.Equal(t, "loadprt-testing", port.ID)
+	assert.Equal(t, int64(80), port.Number)
+	require.Len(t, port.Pools, 1)
+	assert.Equal(t, "loadpol-testing", port.Pools[0].ID)
+
+	_, err = cli.CreatePort(context.Background(), CreateLoadBalancerPortInput{Number: 80, LoadBalancerID: "bad"})
+	assert.ErrorContains(t, err, "invalid id")
+
+	clearPools := true
+
+	port, err = cli.UpdatePort(context.Background(), "loadprt-testing", UpdateLoadBalancerPortInput{ClearPools: &clearPools})
+	require.NoError(t, err)
+	require.NotNil(t, port)
+
+	assert.Contains(t, req.Query, "$input:UpdateLoadBalancerPortInput!")
+	assert.JSONEq(t, `{"clearPools": true}`, string(req.Variables["input"]))
+
+	require.NoError(t, cli.DeletePort(context.Background(), "loadprt-testing"))
+	assert.Contains(t, req.Query, "loadBalancerPortDelete(id: $id)")
+}
+
+func TestPoolMutations(t *testing.T) {
+	poolJSON := `{"id": "loadpol-testing", "name": "pooly", "protocol": "udp", "origins": {"edges": []}}`
+
+	var req gqlRequest
+
+	cli := Client{gqlCli: mustNewGQLTestServer(t, func(r gqlRequest) string {
+		req = r
+
+		switch {
+		case r.Variables["input"] == nil:
+			return `{"data": {"loadBalancerPoolDelete": {"deletedID": "loadpol-testing"}}}`
+		case r.Variables["id"] == nil:
+			return `{"data": {"loadBalancerPoolCreate": {"loadBalancerPool": ` + poolJSON + `}}}`
+		default:
+			return `{"data": {"loadBalancerPoolUpdate": {"loadBalancerPool": ` + poolJSON + `}}}`
+		}
+	})}
+
+	pool, err := cli.CreatePool(context.Background(), CreateLoadBalancerPoolInput{
+		Name:     "pooly",
+		Protocol: "udp",
+		OwnerID:  "testown-testing",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, pool)
+
+	assert.Contains(t, req.Query, "$input:CreateLoadBalancerPoolInput!")
+	assert.JSONEq(t, `{"name": "pooly", "protocol": "udp", "ownerID": "testown-testing"}`, string(req.Variables["input"]))
+	assert.Equal(t, "loadpol-testing", pool.ID)
+	assert.Equal(t, "udp", pool.Protocol)
+
+	protocol := "udp"
+
+	pool, err = cli.UpdatePool(context.Background(), "loadpol-testing", UpdateLoadBalancerPoolInput{Protocol: &protocol})
+	require.NoError(t, err)
+	require.NotNil(t, pool)
+
+	assert.Contains(t, req.Query, "$input:UpdateLoadBalancerPoolInput!")
+	assert.JSONEq(t, `{"protocol": "udp"}`, string(req.Variables["input"]))
+
+	require.NoError(t, cli.DeletePool(context.Background(), "loadpol-testing"))
+	assert.Contains(t, req.Query, "loadBalancerPoolDelete(id: $id)")
+
+	err = cli.DeletePool(context.Background(), "badprefix-test")
+	assert.ErrorContains(t, err, "invalid id")
+}
+
+func TestOriginMutations(t *testing.T) {
+	originJSON := `{"id": "loadogn-testing", "name": "origin", "target": "1.2.3.4", "portNumber": 80, "weight": 100, "active": true}`
+
+	var req gqlRequest
+
+	cli := Client{gqlCli: mustNewGQLTestServer(t, func(r gqlRequest) string {
+		req = r
+
+		switch {
+		case r.Variables["input"] == nil:
+			return `{"data": {"loadBalancerOriginDelete": {"deletedID": "loadogn-testing"}}}`
+		case r.Variables["id"] == nil:
+			return `{"data": {"loadBalancerOriginCreate": {"loadBalancerOrigin": ` + originJSON + `}}}`
+		default:
+			return `{"data": {"loadBalancerOriginUpdate": {"loadBalancerOrigin": ` + originJSON + `}}}`
+		}
+	})}
+
+	origin, err := cli.CreateOrigin(context.Background(), CreateLoadBalancerOriginInput{
+		Name:       "origin",
+		Target:     "1.2.3.4",
+		PortNumber: 80,
+		PoolID:     "loadpol-testing",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, origin)
+
+	assert.Contains(t, req.Query, "$input:CreateLoadBalancerOriginInput!")
+	assert.JSONEq(t, `{"name": "origin", "target": "1.2.3.4", "portNumber": 80, "poolID": "loadpol-testing"}`, string(req.Variables["input"]))
+	assert.Equal(t, "loadogn-testing", origin.ID)
+	assert.Equal(t, "1.2.3.4", origin.Target)
+	assert.Equal(t, int64(100), origin.Weight)
+	assert.True(t, origin.Active)
+
+	active := false
+
+	origin, err = cli.UpdateOrigin(context.Background(), "loadogn-testing", UpdateLoadBalancerOriginInput{Active: &active})
+	require.NoError(t, err)
+	require.NotNil(t, origin)
+
+	assert.Contains(t, req.Query, "$input:UpdateLoadBalancerOriginInput!")
+	assert.JSONEq(t, `{"active": false}`, string(req.Variables["input"]))
+
+	require.NoError(t, cli.DeleteOrigin(context.Background(), "loadogn-testing"))
+	assert.Contains(t, req.Query, "loadBalancerOriginDelete(id: $id)")
+}
+
+func TestListLoadBalancers(t *testing.T) {
+	// pages returns three pages of two load balancers each
+	pages := func(entity string) func(r gqlRequest) string {
+		return func(r gqlRequest) string {
+			var after *string
+
+			_ = json.Unmarshal(r.Variables["after"], &after)
+
+			page := 0
+			if after != nil {
+				_, _ = fmt.Sscanf(*after, "cursor-%d", &page)
+			}
+
+			return fmt.Sprintf(`{"data": {"_entities": [{"loadBalancers": {
+				"pageInfo": {"hasNextPage": %t, "endCursor": "cursor-%d"},
+				"edges": [
+					{"node": {"id": "loadbal-%s%d1", "name": "lb"}},
+					{"node": {"id": "loadbal-%s%d2", "name": "lb"}}
+				]
+			}}]}}`, page < 2, page+1, entity, page, entity, page)
+		}
+	}
+
+	t.Run("by owner", func(t *testing.T) {
+		requests := []gqlRequest{}
+
+		handler := pages("owner")
+
+		cli := Client{pageSize: 2, gqlCli: mustNewGQLTestServer(t, func(r gqlRequest) string {
+			requests = append(requests, r)
+
+			return handler(r)
+		})}
+
+		it, err := cli.ListLoadBalancersByOwner(context.Background(), "testown-testing")
+		require.NoError(t, err)
+
+		lbs, err := it.All(context.Background())
+		require.NoError(t, err)
+
+		require.Len(t, lbs, 6)
+		assert.Equal(t, "loadbal-owner01", lbs[0].ID)
+		assert.Equal(t, "loadbal-owner22", lbs[5].ID)
+
+		require.Len(t, requests, 3)
+		assert.Contains(t, requests[0].Query, `__typename: "ResourceOwner"`)
+		assert.Contains(t, requests[0].Query, "loadBalancers(first: $first, after: $after)")
+		assert.JSONEq(t, `"testown-testing"`, string(requests[0].Variables["id"]))
+		assert.JSONEq(t, `2`, string(requests[0].Variables["first"]))
+		assert.JSONEq(t, `null`, string(requests[0].Variables["after"]))
+		assert.JSONEq(t, `"cursor-1"`, string(requests[1].Variables["after"]))
+		assert.JSONEq(t, `"cursor-2"`, string(requests[2].Variables["after"]))
+	})
+
+	t.Run("by location", func(t *testing.T) {
+		var req gqlRequest
+
+		handler := pages("location")
+
+		cli := Client{pageSize: 2, gqlCli: mustNewGQLTestServer(t, func(r gqlRequest) string {
+			req = r
+
+			return handler(r)
+		})}
+
+		it, err := cli.ListLoadBalancersByLocation(context.Background(), "lctnloc-testing")
+		require.NoError(t, err)
+
+		count := 0
+		for it.Next(context.Background()) {
+			count++
+
+			assert.Contains(t, it.Value().ID, "loadbal-location")
+		}
+
+		require.NoError(t, it.Err())
+		assert.Equal(t, 6, count)
+		assert.Contains(t, req.Query, `__typename: "Location"`)
+	})
+
+	t.Run("bad prefix", func(t *testing.T) {
+		cli := Client{}
+
+		it, err := cli.ListLoadBalancersByOwner(context.Background(), "badprefix-test")
+		require.Error(t, err)
+		assert.Nil(t, it)
+		assert.ErrorContains(t, err, "invalid id")
+	})
+
+	t.Run("error stops iteration", func(t *testing.T) {
+		cli := Client{pageSize: 2, gqlCli: mustNewGQLTestServer(t, func(r gqlRequest) string {
+			if r.Variables["after"] != nil && string(r.Variables["after"]) != "null" {
+				return `{"data": null, "errors": [{"message": "subject doesn't have access", "extensions": {"code": "PERMISSION_DENIED"}}]}`
+			}
+
+			return pages("owner")(r)
+		})}
+
+		it, err := cli.ListLoadBalancersByOwner(context.Background(), "testown-testing")
+		require.NoError(t, err)
+
+		lbs, err := it.All(context.Background())
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+		assert.Len(t, lbs, 2)
+	})
+}
+
+func TestListPoolsByOwner(t *testing.T) {
+	var req gqlRequest
+
+	cli := Client{pageSize: 10, gqlCli: mustNewGQLTestServer(t, func(r gqlRequest) string {
+		req = r
+
+		return `{"data": {"_entities": [{"loadBalancerPools": {
+			"pageInfo": {"hasNextPage": false, "endCursor": "cursor-1"},
+			"edges": [
+				{"node": {"id": "loadpol-one", "name": "one", "protocol": "tcp", "origins": {"edges": []}}},
+				{"node": {"id": "loadpol-two", "name": "two", "protocol": "udp", "origins": {"edges": []}}}
+			]
+		}}]}}`
+	})}
+
+	it, err := cli.ListPoolsByOwner(context.Background(), "testown-testing")
+	require.NoError(t, err)
+
+	pools, err := it.All(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, pools, 2)
+	assert.Equal(t, "loadpol-one", pools[0].ID)
+	assert.Equal(t, "udp", pools[1].Protocol)
+
+	assert.Contains(t, req.Query, "loadBalancerPools(first: $first, after: $after)")
+	assert.JSONEq(t, `10`, string(req.Variables["first"]))
+}
+
+// gqlRequest is a graphql request received by the test server
+type gqlRequest struct {
+	Query     string                     `json:"query"`
+	Variables map[string]json.RawMessage `json:"variables"`
+}
+
+// mustNewGQLTestServer starts a graphql stub that responds with the result of
+// respond for each request it receives
+func mustNewGQLTestServer(t *testing.T, respond func(r gqlRequest) string) *graphql.Client {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req gqlRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		_, err := io.WriteString(w, respond(req))
+		if err != nil {
+			panic(err)
+		}
+	}))
+
+	t.Cleanup(srv.Close)
+
+	return graphql.NewClient(srv.URL+"/query", srv.Client())
+}
+
 func mustNewGQLTestClient(respJSON string, respCode int) *graphql.Client {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/query", func(w http.ResponseWriter, _ *http.Request) {
@@ -300,3 +753,42 @@ func (l localRoundTripper) RoundTrip(req *http.Request) (*http.Response, error)
 
 	return w.Result(), nil
 }
+
+func TestTranslateGQLErr(t *testing.T) {
+	testCases := []struct {
+		name        string
+		code        string
+		expectedErr error
+	}{
+		{name: "not found", code: "NOT_FOUND", expectedErr: ErrNotFound},
+		{name: "validation", code: "VALIDATION", expectedErr: ErrValidation},
+		{name: "permission denied", code: "PERMISSION_DENIED", expectedErr: ErrPermissionDenied},
+		{name: "quota exceeded", code: "QUOTA_EXCEEDED", expectedErr: ErrQuotaExceeded},
+		{name: "conflict", code: "CONFLICT", expectedErr: ErrConflict},
+		{name: "internal", code: "INTERNAL", expectedErr: ErrInternalServerError},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			cli := Client{gqlCli: mustNewGQLTestServer(t, func(gqlRequest) string {
+				// the message must not matter, only the code
+				return `{"data": null, "errors": [{"message": "something went wrong", "extensions": {"code": "` + tt.code + `"}}]}`
+			})}
+
+			_, err := cli.UpdatePool(context.Background(), "loadpol-testing", UpdateLoadBalancerPoolInput{})
+			require.Error(t, err)
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+
+	t.Run("unknown code", func(t *testing.T) {
+		cli := Client{gqlCli: mustNewGQLTestServer(t, func(gqlRequest) string {
+			return `{"data": null, "errors": [{"message": "load_balancer not found"}]}`
+		})}
+
+		err := cli.DeleteLoadBalancer(context.Background(), "loadbal-testing")
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrLBNotfound)
+		assert.ErrorContains(t, err, "load_balancer not found")
+	})
+}
@@ -20,6 +20,18 @@ var (
 	// ErrInternalServerError returned when the server returns an internal server error
 	ErrInternalServerError = errors.New("internal server error")
 
+	// ErrNotFound returned when the requested resource does not exist
+	ErrNotFound = errors.New("resource not found")
+
+	// ErrValidation returned when the request input is invalid
+	ErrValidation = errors.New("invalid input")
+
+	// ErrConflict returned when the request conflicts with an existing resource
+	ErrConflict = errors.New("resource conflict")
+
+	// ErrQuotaExceeded returned when the request would exceed a quota
+	ErrQuotaExceeded = errors.New("quota exceeded")
+
 	// ErrMetadataStatusNotFound returned when the status data is invalid
 	ErrMetadataStatusNotFound = errors.New("metadata status not found")
 )
@@ -0,0 +1,87 @@
+package client
+
+import "context"
+
+// DefaultPageSize is the number of nodes requested per page by list iterators
+const DefaultPageSize = 100
+
+// pageFetcher returns the page of nodes following the after cursor
+type pageFetcher[T any] func(ctx context.Context, first int, after *Cursor) ([]T, PageInfo, error)
+
+// Iterator walks a cursor paginated connection, requesting the next page when
+// the current one is exhausted.
+//
+//	it, err := cli.ListLoadBalancersByOwner(ctx, ownerID)
+//	for it.Next(ctx) {
+//		lb := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	fetch    pageFetcher[T]
+	pageSize int
+
+	page  []T
+	index int
+	value T
+
+	after *Cursor
+	done  bool
+	err   error
+}
+
+func newIterator[T any](pageSize int, fetch pageFetcher[T]) *Iterator[T] {
+	return &Iterator[T]{
+		fetch:    fetch,
+		pageSize: pageSize,
+	}
+}
+
+// Next advances the iterator to the next node, fetching the next page if
+// required. It returns false when there are no more nodes or an error occurred.
+func (it *Iterator[T]) Next(ctx context.Context) bool {
+	for it.index >= len(it.page) {
+		if it.done || it.err != nil {
+			return false
+		}
+
+		page, info, err := it.fetch(ctx, it.pageSize, it.after)
+		if err != nil {
+			it.err = err
+
+			return false
+		}
+
+		it.page = page
+		it.index = 0
+		it.after = info.EndCursor
+		it.done = !info.HasNextPage || info.EndCursor == nil
+	}
+
+	it.value = it.page[it.index]
+	it.index++
+
+	return true
+}
+
+// Value returns the current node
+func (it *Iterator[T]) Value() T {
+	return it.value
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// All consumes the iterator and returns all remaining nodes
+func (it *Iterator[T]) All(ctx context.Context) ([]T, error) {
+	var nodes []T
+
+	for it.Next(ctx) {
+		nodes = append(nodes, it.Value())
+	}
+
+	return nodes, it.Err()
+}
@@ -122,6 +122,250 @@ type GetMetadataNode struct {
 	MetadataNode MetadataNode `graphql:"node(id: $id)"`
 }
 
+// ProviderNode is a struct that represents the ProviderNode GraphQL type
+type ProviderNode struct {
+	ID string `graphql:"id" json:"id"`
+}
+
+// LoadBalancerNode is a struct that represents the LoadBalancer GraphQL type
+// as returned by mutations and list queries
+type LoadBalancerNode struct {
+	ID       string       `graphql:"id" json:"id"`
+	Name     string       `graphql:"name" json:"name"`
+	Owner    OwnerNode    `graphql:"owner" json:"owner"`
+	Location LocationNode `graphql:"location" json:"location"`
+	Provider ProviderNode `graphql:"loadBalancerProvider" json:"loadBalancerProvider"`
+}
+
+// Cursor is a struct that represents the Cursor GraphQL scalar
+type Cursor string
+
+// PageInfo is a struct that represents the PageInfo GraphQL type
+type PageInfo struct {
+	HasNextPage bool    `graphql:"hasNextPage" json:"hasNextPage"`
+	EndCursor   *Cursor `graphql:"endCursor" json:"endCursor"`
+}
+
+// LoadBalancerEdges is a struct that represents the LoadBalancerEdges GraphQL type
+type LoadBalancerEdges struct {
+	Node LoadBalancerNode `graphql:"node" json:"node"`
+}
+
+// LoadBalancerConnection is a struct that represents the LoadBalancerConnection GraphQL type
+type LoadBalancerConnection struct {
+	PageInfo PageInfo            `graphql:"pageInfo" json:"pageInfo"`
+	Edges    []LoadBalancerEdges `graphql:"edges" json:"edges"`
+}
+
+// PoolEdges is a struct that represents the PoolEdges GraphQL type
+type PoolEdges struct {
+	Node Pool `graphql:"node" json:"node"`
+}
+
+// PoolConnection is a struct that represents the PoolConnection GraphQL type
+type PoolConnection struct {
+	PageInfo PageInfo    `graphql:"pageInfo" json:"pageInfo"`
+	Edges    []PoolEdges `graphql:"edges" json:"edges"`
+}
+
+// ListOwnerLoadBalancers is a struct that represents the owner load balancers GraphQL query
+type ListOwnerLoadBalancers struct {
+	Entities []struct {
+		ResourceOwner struct {
+			LoadBalancers LoadBalancerConnection `graphql:"loadBalancers(first: $first, after: $after)"`
+		} `graphql:"... on ResourceOwner"`
+	} `graphql:"_entities(representations: [{__typename: \"ResourceOwner\", id: $id}])"`
+}
+
+// ListLocationLoadBalancers is a struct that represents the location load balancers GraphQL query
+type ListLocationLoadBalancers struct {
+	Entities []struct {
+		Location struct {
+			LoadBalancers LoadBalancerConnection `graphql:"loadBalancers(first: $first, after: $after)"`
+		} `graphql:"... on Location"`
+	} `graphql:"_entities(representations: [{__typename: \"Location\", id: $id}])"`
+}
+
+// ListOwnerPools is a struct that represents the owner pools GraphQL query
+type ListOwnerPools struct {
+	Entities []struct {
+		ResourceOwner struct {
+			LoadBalancerPools PoolConnection `graphql:"loadBalancerPools(first: $first, after: $after)"`
+		} `graphql:"... on ResourceOwner"`
+	} `graphql:"_entities(representations: [{__typename: \"ResourceOwner\", id: $id}])"`
+}
+
+// CreateLoadBalancerInput is a struct that represents the CreateLoadBalancerInput GraphQL input
+type CreateLoadBalancerInput struct {
+	Name       string   `json:"name"`
+	OwnerID    string   `json:"ownerID"`
+	LocationID string   `json:"locationID"`
+	ProviderID string   `json:"providerID"`
+	PortIDs    []string `json:"portIDs,omitempty"`
+
+	// IdempotencyKey makes the create safe to retry, a repeat with the same key returns the original load balancer
+	IdempotencyKey *string `json:"idempotencyKey,omitempty"`
+}
+
+// UpdateLoadBalancerInput is a struct that represents the UpdateLoadBalancerInput GraphQL input
+type UpdateLoadBalancerInput struct {
+	Name          *string  `json:"name,omitempty"`
+	AddPortIDs    []string `json:"addPortIDs,omitempty"`
+	RemovePortIDs []string `json:"removePortIDs,omitempty"`
+	ClearPorts    *bool    `json:"clearPorts,omitempty"`
+}
+
+// CreateLoadBalancerPortInput is a struct that represents the CreateLoadBalancerPortInput GraphQL input
+type CreateLoadBalancerPortInput struct {
+	Number         int64    `json:"number"`
+	Name           *string  `json:"name,omitempty"`
+	PoolIDs        []string `json:"poolIDs,omitempty"`
+	LoadBalancerID string   `json:"loadBalancerID"`
+
+	// IdempotencyKey makes the create safe to retry, a repeat with the same key returns the original port
+	IdempotencyKey *string `json:"idempotencyKey,omitempty"`
+}
+
+// UpdateLoadBalancerPortInput is a struct that represents the UpdateLoadBalancerPortInput GraphQL input
+type UpdateLoadBalancerPortInput struct {
+	Number        *int64   `json:"number,omitempty"`
+	Name          *string  `json:"name,omitempty"`
+	ClearName     *bool    `json:"clearName,omitempty"`
+	AddPoolIDs    []string `json:"addPoolIDs,omitempty"`
+	RemovePoolIDs []string `json:"removePoolIDs,omitempty"`
+	ClearPools    *bool    `json:"clearPools,omitempty"`
+}
+
+// CreateLoadBalancerPoolInput is a struct that represents the CreateLoadBalancerPoolInput GraphQL input
+type CreateLoadBalancerPoolInput struct {
+	Name      string   `json:"name"`
+	Protocol  string   `json:"protocol"`
+	OwnerID   string   `json:"ownerID"`
+	PortIDs   []string `json:"portIDs,omitempty"`
+	OriginIDs []string `json:"originIDs,omitempty"`
+
+	// IdempotencyKey makes the create safe to retry, a repeat with the same key returns the original pool
+	IdempotencyKey *string `json:"idempotencyKey,omitempty"`
+}
+
+// UpdateLoadBalancerPoolInput is a struct that represents the UpdateLoadBalancerPoolInput GraphQL input
+type UpdateLoadBalancerPoolInput struct {
+	Name            *string  `json:"name,omitempty"`
+	Protocol        *string  `json:"protocol,omitempty"`
+	AddPortIDs      []string `json:"addPortIDs,omitempty"`
+	RemovePortIDs   []string `json:"removePortIDs,omitempty"`
+	ClearPorts      *bool    `json:"clearPorts,omitempty"`
+	AddOriginIDs    []string `json:"addOriginIDs,omitempty"`
+	RemoveOriginIDs []string `json:"removeOriginIDs,omitempty"`
+	ClearOrigins    *bool    `json:"clearOrigins,omitempty"`
+}
+
+// CreateLoadBalancerOriginInput is a struct that represents the CreateLoadBalancerOriginInput GraphQL input
+type CreateLoadBalancerOriginInput struct {
+	Name       string `json:"name"`
+	Weight     *int64 `json:"weight,omitempty"`
+	Target     string `json:"target"`
+	PortNumber int64  `json:"portNumber"`
+	Active     *bool  `json:"active,omitempty"`
+	PoolID     string `json:"poolID"`
+
+	// IdempotencyKey makes the create safe to retry, a repeat with the same key returns the original origin
+	IdempotencyKey *string `json:"idempotencyKey,omitempty"`
+}
+
+// UpdateLoadBalancerOriginInput is a struct that represents the UpdateLoadBalancerOriginInput GraphQL input
+type UpdateLoadBalancerOriginInput struct {
+	Name       *string `json:"name,omitempty"`
+	Weight     *int64  `json:"weight,omitempty"`
+	Target     *string `json:"target,omitempty"`
+	PortNumber *int64  `json:"portNumber,omitempty"`
+	Active     *bool   `json:"active,omitempty"`
+}
+
+// CreateLoadBalancer is a struct that represents the loadBalancerCreate GraphQL mutation
+type CreateLoadBalancer struct {
+	LoadBalancerCreate struct {
+		LoadBalancer LoadBalancerNode `graphql:"loadBalancer" json:"loadBalancer"`
+	} `graphql:"loadBalancerCreate(input: $input)"`
+}
+
+// UpdateLoadBalancer is a struct that represents the loadBalancerUpdate GraphQL mutation
+type UpdateLoadBalancer struct {
+	LoadBalancerUpdate struct {
+		LoadBalancer LoadBalancerNode `graphql:"loadBalancer" json:"loadBalancer"`
+	} `graphql:"loadBalancerUpdate(id: $id, input: $input)"`
+}
+
+// DeleteLoadBalancer is a struct that represents the loadBalancerDelete GraphQL mutation
+type DeleteLoadBalancer struct {
+	LoadBalancerDelete struct {
+		DeletedID string `graphql:"deletedID" json:"deletedID"`
+	} `graphql:"loadBalancerDelete(id: $id)"`
+}
+
+// CreatePort is a struct that represents the loadBalancerPortCreate GraphQL mutation
+type CreatePort struct {
+	LoadBalancerPortCreate struct {
+		LoadBalancerPort PortNode `graphql:"loadBalancerPort" json:"loadBalancerPort"`
+	} `graphql:"loadBalancerPortCreate(input: $input)"`
+}
+
+// UpdatePort is a struct that represents the loadBalancerPortUpdate GraphQL mutation
+type UpdatePort struct {
+	LoadBalancerPortUpdate struct {
+		LoadBalancerPort PortNode `graphql:"loadBalancerPort" json:"loadBalancerPort"`
+	} `graphql:"loadBalancerPortUpdate(id: $id, input: $input)"`
+}
+
+// DeletePort is a struct that represents the loadBalancerPortDelete GraphQL mutation
+type DeletePort struct {
+	LoadBalancerPortDelete struct {
+		DeletedID string `graphql:"deletedID" json:"deletedID"`
+	} `graphql:"loadBalancerPortDelete(id: $id)"`
+}
+
+// CreatePool is a struct that represents the loadBalancerPoolCreate GraphQL mutation
+type CreatePool struct {
+	LoadBalancerPoolCreate struct {
+		LoadBalancerPool Pool `graphql:"loadBalancerPool" json:"loadBalancerPool"`
+	} `graphql:"loadBalancerPoolCreate(input: $input)"`
+}
+
+// UpdatePool is a struct that represents the loadBalancerPoolUpdate GraphQL mutation
+type UpdatePool struct {
+	LoadBalancerPoolUpdate struct {
+		LoadBalancerPool Pool `graphql:"loadBalancerPool" json:"loadBalancerPool"`
+	} `graphql:"loadBalancerPoolUpdate(id: $id, input: $input)"`
+}
+
+// DeletePool is a struct that represents the loadBalancerPoolDelete GraphQL mutation
+type DeletePool struct {
+	LoadBalancerPoolDelete struct {
+		DeletedID string `graphql:"deletedID" json:"deletedID"`
+	} `graphql:"loadBalancerPoolDelete(id: $id)"`
+}
+
+// CreateOrigin is a struct that represents the loadBalancerOriginCreate GraphQL mutation
+type CreateOrigin struct {
+	LoadBalancerOriginCreate struct {
+		LoadBalancerOrigin OriginNode `graphql:"loadBalancerOrigin" json:"loadBalancerOrigin"`
+	} `graphql:"loadBalancerOriginCreate(input: $input)"`
+}
+
+// UpdateOrigin is a struct that represents the loadBalancerOriginUpdate GraphQL mutation
+type UpdateOrigin struct {
+	LoadBalancerOriginUpdate struct {
+		LoadBalancerOrigin OriginNode `graphql:"loadBalancerOrigin" json:"loadBalancerOrigin"`
+	} `graphql:"loadBalancerOriginUpdate(id: $id, input: $input)"`
+}
+
+// DeleteOrigin is a struct that represents the loadBalancerOriginDelete GraphQL mutation
+type DeleteOrigin struct {
+	LoadBalancerOriginDelete struct {
+		DeletedID string `graphql:"deletedID" json:"deletedID"`
+	} `graphql:"loadBalancerOriginDelete(id: $id)"`
+}
+
 // Readable version of the above:
 // type GetLoadBalancer struct {
 // 	LoadBalancer struct {
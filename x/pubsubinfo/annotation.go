@@ -14,13 +14,34 @@
 
 package pubsubinfo
 
+import (
+	"strings"
+
+	"entgo.io/ent/schema"
+)
+
 // EventsHookAnnotationName is the value of the annotation when read during ent compilation
 var EventsHookAnnotationName = "INFRA9_EVENTHOOKS"
 
 // EventsHookAnnotation provides a ent.Annotation spec. These shouldn't be set directly, you should use EventsHookAdditionalSubject() and EventsHookSubjectName instead
 type EventsHookAnnotation struct {
 	SubjectName               string
+	AdditionalSubject         bool
 	AdditionalSubjectRelation string
+	SkipField                 bool
+	RelatedSubjects           []RelatedSubjects
+	ExtraRelationsKey         string
+	KeepRelationshipsOnDelete bool
+}
+
+// RelatedSubjects describes additional subjects that are loaded by following the edges of an object
+type RelatedSubjects struct {
+	// Edges is the path of edges followed from the object, the object itself is used when empty
+	Edges []string
+	// Fields are the ID fields of the objects at the end of the path that are added as subjects
+	Fields []string
+	// SkipDelete leaves the subjects out of delete messages
+	SkipDelete bool
 }
 
 // Name implements the ent Annotation interface.
@@ -28,16 +49,104 @@ func (a EventsHookAnnotation) Name() string {
 	return EventsHookAnnotationName
 }
 
-// EventsHookAdditionalSubject marks this field as a field to return as an additional subject
+// Merge implements the ent schema.Merger interface, allowing multiple event hook annotations on
+// the same node.
+func (a EventsHookAnnotation) Merge(other schema.Annotation) schema.Annotation {
+	var o EventsHookAnnotation
+
+	switch other := other.(type) {
+	case EventsHookAnnotation:
+		o = other
+	case *EventsHookAnnotation:
+		if other == nil {
+			return a
+		}
+
+		o = *other
+	default:
+		return a
+	}
+
+	if o.SubjectName != "" {
+		a.SubjectName = o.SubjectName
+	}
+
+	if o.AdditionalSubject {
+		a.AdditionalSubject = true
+		a.AdditionalSubjectRelation = o.AdditionalSubjectRelation
+	}
+
+	if o.ExtraRelationsKey != "" {
+		a.ExtraRelationsKey = o.ExtraRelationsKey
+	}
+
+	a.SkipField = a.SkipField || o.SkipField
+	a.KeepRelationshipsOnDelete = a.KeepRelationshipsOnDelete || o.KeepRelationshipsOnDelete
+	a.RelatedSubjects = append(a.RelatedSubjects, o.RelatedSubjects...)
+
+	return a
+}
+
+// SkipOnDelete leaves the related subjects of the annotation out of delete messages
+func (a *EventsHookAnnotation) SkipOnDelete() *EventsHookAnnotation {
+	for i := range a.RelatedSubjects {
+		a.RelatedSubjects[i].SkipDelete = true
+	}
+
+	return a
+}
+
+// EventsHookAdditionalSubject marks this field as a field to return as an additional subject. The
+// relation is created between the object and the subject, an empty relation only adds the subject.
 func EventsHookAdditionalSubject(relation string) *EventsHookAnnotation {
 	return &EventsHookAnnotation{
+		AdditionalSubject:         true,
 		AdditionalSubjectRelation: relation,
 	}
 }
 
+// EventsHookSkipField leaves this field out of the field changes of the messages
+func EventsHookSkipField() *EventsHookAnnotation {
+	return &EventsHookAnnotation{
+		SkipField: true,
+	}
+}
+
 // EventsHookSubjectName sets the subject name that is where the messages for this object will be sent
 func EventsHookSubjectName(s string) *EventsHookAnnotation {
 	return &EventsHookAnnotation{
 		SubjectName: s,
 	}
 }
+
+// EventsHookRelatedSubjects adds the given ID fields of the objects reached by following the
+// dot separated edge path as additional subjects, an empty path uses the object itself.
+func EventsHookRelatedSubjects(path string, fields ...string) *EventsHookAnnotation {
+	var edges []string
+
+	if path != "" {
+		edges = strings.Split(path, ".")
+	}
+
+	return &EventsHookAnnotation{
+		RelatedSubjects: []RelatedSubjects{{Edges: edges, Fields: fields}},
+	}
+}
+
+// EventsHookExtraRelations adds the relationships returned by the extra relations func of the
+// extension for the key to the relationships of the object
+func EventsHookExtraRelations(key string) *EventsHookAnnotation {
+	return &EventsHookAnnotation{
+		ExtraRelationsKey: key,
+	}
+}
+
+// EventsHookKeepRelationshipsOnDelete doesn't delete the relationships of the object when it is
+// deleted, for objects where they are cleaned up elsewhere
+func EventsHookKeepRelationshipsOnDelete() *EventsHookAnnotation {
+	return &EventsHookAnnotation{
+		KeepRelationshipsOnDelete: true,
+	}
+}
+
+var _ schema.Merger = EventsHookAnnotation{}
@@ -0,0 +1,60 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsubinfo
+
+// EventHooksConfigAnnotationName is the name of the event hooks config in the graph annotations
+var EventHooksConfigAnnotationName = "INFRA9_EVENTHOOKS_CONFIG"
+
+// EventHooksConfig configures the functions the generated event hooks call into. These shouldn't
+// be set directly, you should pass EventHooksOption to WithEventHooks instead.
+type EventHooksConfig struct {
+	MessageHandlers []GoFunc
+	ExtraRelations  *GoFunc
+}
+
+// GoFunc references a function in a go package, the package name must match the last element
+// of the package path
+type GoFunc struct {
+	PkgPath string
+	Name    string
+}
+
+// Name implements the ent Annotation interface.
+func (c EventHooksConfig) Name() string {
+	return EventHooksConfigAnnotationName
+}
+
+// EventHooksOption configures the generated event hooks
+type EventHooksOption func(*EventHooksConfig)
+
+// WithMessageHandler calls the function with the signature
+// func(context.Context, *generated.Client, *events.ChangeMessage, any) error
+// for every change message, in the transaction of the mutation and before the message is
+// published. The last argument is the deleted object for delete messages and nil otherwise.
+// Handlers are called in the order they are added.
+func WithMessageHandler(pkgPath, name string) EventHooksOption {
+	return func(c *EventHooksConfig) {
+		c.MessageHandlers = append(c.MessageHandlers, GoFunc{PkgPath: pkgPath, Name: name})
+	}
+}
+
+// WithExtraRelations uses the function with the signature
+// func(key string) []events.AuthRelationshipRelation
+// to load the extra relationships of objects annotated with EventsHookExtraRelations
+func WithExtraRelations(pkgPath, name string) EventHooksOption {
+	return func(c *EventHooksConfig) {
+		c.ExtraRelations = &GoFunc{PkgPath: pkgPath, Name: name}
+	}
+}
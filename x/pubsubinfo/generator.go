@@ -28,6 +28,8 @@ type Extension struct {
 	templates []*gen.Template
 
 	gqlSchemaHooks []entgql.SchemaHook
+
+	annotations []entc.Annotation
 }
 
 // ExtensionOption allow for control over the behavior of the generator
@@ -53,9 +55,16 @@ func WithJSONScalar() ExtensionOption {
 }
 
 // WithEventHooks adds the templates for generating event hooks
-func WithEventHooks() ExtensionOption {
+func WithEventHooks(opts ...EventHooksOption) ExtensionOption {
 	return func(ex *Extension) error {
+		cfg := &EventHooksConfig{}
+
+		for _, opt := range opts {
+			opt(cfg)
+		}
+
 		ex.templates = append(ex.templates, EventHooksTemplate)
+		ex.annotations = append(ex.annotations, cfg)
 
 		return nil
 	}
@@ -83,6 +92,11 @@ func (e *Extension) Templates() []*gen.Template {
 	return e.templates
 }
 
+// Annotations of the extension
+func (e *Extension) Annotations() []entc.Annotation {
+	return e.annotations
+}
+
 // GQLSchemaHooks of the extension to seamlessly edit the final gql interface.
 func (e *Extension) GQLSchemaHooks() []entgql.SchemaHook {
 	return e.gqlSchemaHooks
@@ -16,6 +16,7 @@ package pubsubinfo
 
 import (
 	"embed"
+	"fmt"
 	"strings"
 	"text/template"
 
@@ -31,7 +32,8 @@ var (
 
 	// TemplateFuncs contains the extra template functions used by entx.
 	TemplateFuncs = template.FuncMap{
-		"contains": strings.Contains,
+		"contains":        strings.Contains,
+		"relatedSubjects": relatedSubjects,
 	}
 
 	//go:embed template/*
@@ -43,3 +45,90 @@ func parseT(path string) *gen.Template {
 		Funcs(TemplateFuncs).
 		ParseFS(_templates, path))
 }
+
+// relatedQuery is the query of related subjects used by the event hooks template
+type relatedQuery struct {
+	// Query is the chain of query calls following the edges from the object
+	Query string
+	// Fields are the struct fields of the related objects that are added as subjects
+	Fields []string
+	// SkipDelete leaves the subjects out of delete messages
+	SkipDelete bool
+}
+
+// relatedSubjects resolves the RelatedSubjects of an annotation on t into the query loading them.
+// Skipping err113 linting since these errors are returned during generation and not runtime
+//
+//nolint:goerr113
+func relatedSubjects(t *gen.Type, v any) (*relatedQuery, error) {
+	rs, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("unexpected related subjects %T on %s", v, t.Name)
+	}
+
+	q := &relatedQuery{}
+
+	q.SkipDelete, _ = rs["SkipDelete"].(bool)
+
+	for _, name := range stringList(rs["Edges"]) {
+		var next *gen.Edge
+
+		for _, e := range t.Edges {
+			if e.Name == name {
+				next = e
+				break
+			}
+		}
+
+		if next == nil {
+			return nil, fmt.Errorf("unknown edge %s on %s", name, t.Name)
+		}
+
+		q.Query += ".Query" + next.StructField() + "()"
+		t = next.Type
+	}
+
+	for _, name := range stringList(rs["Fields"]) {
+		if name == t.ID.Name {
+			q.Fields = append(q.Fields, t.ID.StructField())
+			continue
+		}
+
+		f, err := findField(t, name)
+		if err != nil {
+			return nil, err
+		}
+
+		q.Fields = append(q.Fields, f.StructField())
+	}
+
+	if len(q.Fields) == 0 {
+		return nil, fmt.Errorf("related subjects on %s need at least one field", t.Name)
+	}
+
+	return q, nil
+}
+
+//nolint:goerr113
+func findField(t *gen.Type, name string) (*gen.Field, error) {
+	for _, f := range t.Fields {
+		if f.Name == name {
+			return f, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown field %s on %s", name, t.Name)
+}
+
+func stringList(v any) []string {
+	l, _ := v.([]any)
+	s := make([]string, 0, len(l))
+
+	for _, e := range l {
+		if str, ok := e.(string); ok {
+			s = append(s, str)
+		}
+	}
+
+	return s
+}